@@ -0,0 +1,137 @@
+package clients
+
+import (
+	"context"
+	"expvar"
+	"log"
+	"math/rand"
+	"strings"
+
+	"google.golang.org/grpc"
+)
+
+// Backends a canary-routed call can be served by
+const (
+	BackendPrimary = "primary"
+	BackendCanary  = "canary"
+)
+
+// readOnlyPrefixes mark RPCs that are safe to send to a canary by default
+var readOnlyPrefixes = []string{"Get", "List", "Search", "Filter"}
+
+// canaryCalls counts calls per backend and RPC, e.g. "canary.GetJobs", plus
+// ".errors" and "fallbacks" entries, for comparing the two deployments
+var canaryCalls = expvar.NewMap("canary_calls")
+
+// CanaryRoute carries one request's canary choice into the clients and reports back
+// which backend answered. Force is "always" or "never" to override the percentage.
+type CanaryRoute struct {
+	Force  string
+	Served string
+}
+
+type canaryRouteKey struct{}
+
+// WithCanaryRoute attaches route to ctx so calls made with ctx honour and update it
+func WithCanaryRoute(ctx context.Context, route *CanaryRoute) context.Context {
+	return context.WithValue(ctx, canaryRouteKey{}, route)
+}
+
+// CanaryRouteFrom returns the route attached to ctx, if any
+func CanaryRouteFrom(ctx context.Context) (*CanaryRoute, bool) {
+	route, ok := ctx.Value(canaryRouteKey{}).(*CanaryRoute)
+	return route, ok
+}
+
+// CanaryRouter sends a percentage of a service's read-only calls to a canary
+// deployment, and the rest to the primary. It is a grpc.ClientConnInterface, so the
+// generated client is built on it and handlers don't change.
+type CanaryRouter struct {
+	primary *grpc.ClientConn
+	canary  *grpc.ClientConn
+	service string
+	percent int
+	// fallback retries failed canary calls on the primary
+	fallback bool
+	// allowed lists mutating RPCs explicitly allowed on the canary
+	allowed map[string]bool
+}
+
+// NewCanaryRouter routes percent% of eligible calls to canary
+func NewCanaryRouter(service string, primary, canary *grpc.ClientConn, percent int, fallback bool, allowedMethods []string) *CanaryRouter {
+	allowed := make(map[string]bool, len(allowedMethods))
+	for _, method := range allowedMethods {
+		allowed[method] = true
+	}
+	return &CanaryRouter{
+		primary:  primary,
+		canary:   canary,
+		service:  service,
+		percent:  percent,
+		fallback: fallback,
+		allowed:  allowed,
+	}
+}
+
+func (r *CanaryRouter) Invoke(ctx context.Context, method string, args, reply interface{}, opts ...grpc.CallOption) error {
+	name := method[strings.LastIndex(method, "/")+1:]
+	route, _ := CanaryRouteFrom(ctx)
+	if !r.useCanary(name, route) {
+		return r.invoke(ctx, r.primary, BackendPrimary, name, route, method, args, reply, opts...)
+	}
+
+	err := r.invoke(ctx, r.canary, BackendCanary, name, route, method, args, reply, opts...)
+	if err == nil || !r.fallback || ctx.Err() != nil {
+		return err
+	}
+	log.Printf("Canary %s %s failed, falling back to primary: %v", r.service, name, err)
+	canaryCalls.Add("fallbacks", 1)
+	return r.invoke(ctx, r.primary, BackendPrimary, name, route, method, args, reply, opts...)
+}
+
+// NewStream always uses the primary; the gateway makes no streaming calls to canaries
+func (r *CanaryRouter) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return r.primary.NewStream(ctx, desc, method, opts...)
+}
+
+func (r *CanaryRouter) invoke(ctx context.Context, conn *grpc.ClientConn, backend, name string, route *CanaryRoute,
+	method string, args, reply interface{}, opts ...grpc.CallOption) error {
+	canaryCalls.Add(backend+"."+name, 1)
+	if route != nil {
+		route.Served = backend
+	}
+	err := conn.Invoke(ctx, method, args, reply, opts...)
+	if err != nil {
+		canaryCalls.Add(backend+"."+name+".errors", 1)
+	}
+	return err
+}
+
+// useCanary decides per call: mutating RPCs stay on the primary unless allowlisted,
+// and "X-Canary: always" only forces eligible calls
+func (r *CanaryRouter) useCanary(name string, route *CanaryRoute) bool {
+	if !r.eligible(name) {
+		return false
+	}
+	if route != nil {
+		switch route.Force {
+		case "always":
+			return true
+		case "never":
+			return false
+		}
+	}
+	return r.percent > 0 && rand.Intn(100) < r.percent
+}
+
+func (r *CanaryRouter) eligible(name string) bool {
+	if r.allowed[name] {
+		return true
+	}
+	for _, prefix := range readOnlyPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -4,7 +4,7 @@ import (
 	"fmt"
 	"google.golang.org/grpc"
 	"log"
-	"os"
+	"skillsync-api-gateway/config"
 	"github.com/shahal0/skillsync-protos/gen/authpb"
 	chatpb "github.com/shahal0/skillsync-protos/gen/chatpb"
 	jobpb "github.com/shahal0/skillsync-protos/gen/jobpb"
@@ -16,15 +16,14 @@ var (
 	JobServiceClient          jobpb.JobServiceClient
 	ChatServiceClient         chatpb.ChatServiceClient
 	NotificationServiceClient notificationpb.NotificationServiceClient
-)
 
-func getEnv(key, fallback string) string {
-	if value, ok := os.LookupEnv(key); ok {
-		return value
-	}
-	log.Printf("Environment variable %s not set, using default: %s", key, fallback)
-	return fallback
-}
+	// Raw connections, used where the gateway proxies calls without the typed clients
+	AuthConn *grpc.ClientConn
+	JobConn  *grpc.ClientConn
+
+	// JobCanaryConn is the optional canary job service (JOB_SERVICE_URL_CANARY)
+	JobCanaryConn *grpc.ClientConn
+)
 
 // GetChatClient returns the chat service client
 func GetChatClient() (chatpb.ChatServiceClient, error) {
@@ -39,25 +38,44 @@ func GetNotificationClient() notificationpb.NotificationServiceClient {
 	return NotificationServiceClient
 }
 
-func InitClients() {
+func InitClients(cfg *config.Config) {
 	// Auth Service Client
-	authConn, err := grpc.Dial(getEnv("AUTH_SERVICE_URL", "localhost:50051"), grpc.WithInsecure())
+	authConn, err := grpc.Dial(cfg.Services.AuthURL, dialOptions(cfg.Services.AuthCalls)...)
 	if err != nil {
 		log.Fatalf("Failed to connect to auth-service: %v", err)
 	}
-	AuthServiceClient = authpb.NewAuthServiceClient(authConn)
 
 	// Job Service Client
-	jobConn, err := grpc.Dial(getEnv("JOB_SERVICE_URL", "localhost:50052"), grpc.WithInsecure())
+	jobConn, err := grpc.Dial(cfg.Services.JobURL, dialOptions(cfg.Services.JobCalls)...)
 	if err != nil {
 		log.Fatalf("Failed to connect to job-service: %v", err)
 	}
-	JobServiceClient = jobpb.NewJobServiceClient(jobConn)
-	chatNotifConn, err := grpc.Dial(getEnv("CHAT_NOTIFICATION_SERVICE_URL", "localhost:50053"), grpc.WithInsecure())
+	chatNotifConn, err := grpc.Dial(cfg.Services.ChatNotificationURL, dialOptions(cfg.Services.ChatCalls)...)
 	if err != nil {
 		log.Fatalf("Failed to connect to chat-notification-service: %v", err)
 	}
+	Connect(authConn, jobConn, chatNotifConn)
+
+	// Job Service canary: JobServiceClient splits eligible calls between the two
+	if canary := cfg.Services.JobCanary; canary.URL != "" {
+		canaryConn, err := grpc.Dial(canary.URL, dialOptions(cfg.Services.JobCalls)...)
+		if err != nil {
+			log.Fatalf("Failed to connect to job-service canary: %v", err)
+		}
+		JobCanaryConn = canaryConn
+		JobServiceClient = jobpb.NewJobServiceClient(
+			NewCanaryRouter("job", jobConn, canaryConn, canary.Percent, canary.Fallback, canary.AllowedMethods))
+		log.Printf("Routing %d%% of read-only job-service calls to the canary at %s", canary.Percent, canary.URL)
+	}
+}
+
+// Connect sets the service clients from existing connections, e.g. in-process fakes
+// from the testsupport package. Chat and notifications share one backend.
+func Connect(authConn, jobConn, chatNotifConn *grpc.ClientConn) {
+	AuthServiceClient = authpb.NewAuthServiceClient(authConn)
+	AuthConn = authConn
+	JobServiceClient = jobpb.NewJobServiceClient(jobConn)
+	JobConn = jobConn
 	ChatServiceClient = chatpb.NewChatServiceClient(chatNotifConn)
 	NotificationServiceClient = notificationpb.NewNotificationServiceClient(chatNotifConn)
 }
-
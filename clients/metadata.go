@@ -0,0 +1,50 @@
+package clients
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/grpc/metadata"
+
+	"skillsync-api-gateway/config"
+	"skillsync-api-gateway/utils/i18n"
+)
+
+// LocaleMetadataKey carries the caller's language to backend services
+const LocaleMetadataKey = "accept-language"
+
+// dialOptions are shared by every backend connection the gateway opens, with the
+// message limits and compression of the backend's calls
+func dialOptions(calls config.CallConfig) []grpc.DialOption {
+	callOptions := []grpc.CallOption{
+		grpc.MaxCallRecvMsgSize(calls.MaxRecvMB << 20),
+		grpc.MaxCallSendMsgSize(calls.MaxSendMB << 20),
+	}
+	if calls.Compression == "gzip" {
+		callOptions = append(callOptions, grpc.UseCompressor(gzip.Name))
+	}
+	return []grpc.DialOption{
+		grpc.WithInsecure(),
+		grpc.WithDefaultCallOptions(callOptions...),
+		grpc.WithChainUnaryInterceptor(timingUnaryInterceptor, metadataUnaryInterceptor, retryUnaryInterceptor),
+		grpc.WithChainStreamInterceptor(metadataStreamInterceptor),
+	}
+}
+
+// withRequestMetadata adds request-scoped values from ctx to the outgoing metadata.
+// It appends, so metadata the handler set with NewOutgoingContext is kept.
+func withRequestMetadata(ctx context.Context) context.Context {
+	if locale := i18n.FromContext(ctx); locale != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, LocaleMetadataKey, locale)
+	}
+	return ctx
+}
+
+func metadataUnaryInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	return invoker(withRequestMetadata(ctx), method, req, reply, cc, opts...)
+}
+
+func metadataStreamInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return streamer(withRequestMetadata(ctx), desc, cc, method, opts...)
+}
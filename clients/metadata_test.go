@@ -0,0 +1,81 @@
+package clients
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+
+	authpb "github.com/shahal0/skillsync-protos/gen/authpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+
+	"skillsync-api-gateway/config"
+	"skillsync-api-gateway/utils"
+)
+
+// resumeServer accepts resumes of any size and reports how many bytes arrived
+type resumeServer struct {
+	authpb.UnimplementedAuthServiceServer
+}
+
+func (resumeServer) CandidateUploadResume(_ context.Context, req *authpb.UploadResumeRequest) (*authpb.GenericResponse, error) {
+	return &authpb.GenericResponse{Message: strings.Repeat("x", len(req.GetResume()))}, nil
+}
+
+// dialFake serves the fake auth service over bufconn and dials it with calls' options
+func dialFake(t *testing.T, calls config.CallConfig) authpb.AuthServiceClient {
+	t.Helper()
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer(grpc.MaxRecvMsgSize(64<<20), grpc.MaxSendMsgSize(64<<20))
+	authpb.RegisterAuthServiceServer(server, resumeServer{})
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	options := append(dialOptions(calls), grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+		return listener.DialContext(ctx)
+	}))
+	conn, err := grpc.Dial("bufnet", options...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return authpb.NewAuthServiceClient(conn)
+}
+
+func TestMessageSizeLimits(t *testing.T) {
+	tests := []struct {
+		name        string
+		calls       config.CallConfig
+		size        int
+		wantStatus  int
+		wantMessage string
+	}{
+		{"request within the limit", config.CallConfig{MaxRecvMB: 8, MaxSendMB: 8, Compression: "none"}, 5 << 20, http.StatusOK, ""},
+		{"request over the send limit", config.CallConfig{MaxRecvMB: 8, MaxSendMB: 1, Compression: "none"}, 2 << 20, http.StatusRequestEntityTooLarge, "larger than the 1.0 MB allowed"},
+		{"response over the receive limit", config.CallConfig{MaxRecvMB: 1, MaxSendMB: 8, Compression: "none"}, 2 << 20, http.StatusRequestEntityTooLarge, "larger than the 1.0 MB allowed"},
+		{"compressed request within the limit", config.CallConfig{MaxRecvMB: 8, MaxSendMB: 8, Compression: "gzip"}, 5 << 20, http.StatusOK, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := dialFake(t, tt.calls)
+			resp, err := client.CandidateUploadResume(context.Background(), &authpb.UploadResumeRequest{
+				Resume: bytes.Repeat([]byte{'r'}, tt.size),
+			})
+			if got := utils.HTTPStatusFromGRPC(err); got != tt.wantStatus {
+				t.Fatalf("status = %d, want %d (error: %v)", got, tt.wantStatus, err)
+			}
+			if err == nil {
+				if len(resp.GetMessage()) != tt.size {
+					t.Errorf("server received %d bytes, want %d", len(resp.GetMessage()), tt.size)
+				}
+				return
+			}
+			if got := utils.GRPCErrorMessage(err); !strings.Contains(got, tt.wantMessage) {
+				t.Errorf("message = %q, want it to contain %q", got, tt.wantMessage)
+			}
+		})
+	}
+}
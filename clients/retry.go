@@ -0,0 +1,69 @@
+package clients
+
+import (
+	"context"
+	"expvar"
+	"math/rand"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	"skillsync-api-gateway/config"
+)
+
+// retryBaseBackoff is the wait before the first retry; each later one doubles it
+const retryBaseBackoff = 50 * time.Millisecond
+
+// retriedCalls counts retries per RPC, for spotting backends that only succeed on retry
+var retriedCalls = expvar.NewMap("retried_calls")
+
+type policyKey struct{}
+
+// WithPolicy attaches a route's upstream policy to ctx so calls made with ctx are
+// retried by it
+func WithPolicy(ctx context.Context, policy config.UpstreamPolicy) context.Context {
+	return context.WithValue(ctx, policyKey{}, policy)
+}
+
+// PolicyFrom returns the policy attached to ctx, if any
+func PolicyFrom(ctx context.Context) (config.UpstreamPolicy, bool) {
+	policy, ok := ctx.Value(policyKey{}).(config.UpstreamPolicy)
+	return policy, ok
+}
+
+// retryUnaryInterceptor repeats a failed call up to the policy's MaxRetries when
+// its code is one the policy retries on. Calls without a policy, such as those
+// from background work, are made once.
+func retryUnaryInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	policy, ok := PolicyFrom(ctx)
+	err := invoker(ctx, method, req, reply, cc, opts...)
+	if !ok {
+		return err
+	}
+	for attempt := 0; err != nil && attempt < policy.MaxRetries && retryable(policy, err); attempt++ {
+		backoff := retryBaseBackoff << attempt
+		backoff += time.Duration(rand.Int63n(int64(backoff) / 2))
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return err
+		}
+		retriedCalls.Add(method[strings.LastIndex(method, "/")+1:], 1)
+		err = invoker(ctx, method, req, reply, cc, opts...)
+	}
+	return err
+}
+
+// retryable reports whether err's code is one policy retries on
+func retryable(policy config.UpstreamPolicy, err error) bool {
+	code := status.Code(err).String()
+	for _, name := range policy.RetryOn {
+		// codes.Code prints as Unavailable; policies name it UNAVAILABLE
+		if strings.EqualFold(strings.ReplaceAll(name, "_", ""), code) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,69 @@
+package clients
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// CallTiming records when a request's backend calls ran, for the breakdown of slow
+// requests. A request's calls may run concurrently, so it is updated atomically.
+type CallTiming struct {
+	first atomic.Int64 // start of the first call, in Unix nanoseconds
+	last  atomic.Int64 // end of the call that finished last
+	total atomic.Int64 // summed call durations, including retries
+	calls atomic.Int64
+}
+
+// CallTimes is a snapshot of a CallTiming
+type CallTimes struct {
+	Calls int64
+	// First and Last are when the first call started and the last one ended
+	First, Last time.Time
+	// Total is the calls' summed duration, which exceeds the wall time they took
+	// when they ran concurrently
+	Total time.Duration
+}
+
+type callTimingKey struct{}
+
+// WithCallTiming attaches timing to ctx so the backend calls made with ctx are recorded in it
+func WithCallTiming(ctx context.Context, timing *CallTiming) context.Context {
+	return context.WithValue(ctx, callTimingKey{}, timing)
+}
+
+// Times returns what was recorded so far; First and Last are zero without calls
+func (t *CallTiming) Times() CallTimes {
+	times := CallTimes{Calls: t.calls.Load(), Total: time.Duration(t.total.Load())}
+	if times.Calls > 0 {
+		times.First = time.Unix(0, t.first.Load())
+		times.Last = time.Unix(0, t.last.Load())
+	}
+	return times
+}
+
+func (t *CallTiming) record(start, end time.Time) {
+	t.first.CompareAndSwap(0, start.UnixNano())
+	for last := t.last.Load(); end.UnixNano() > last; last = t.last.Load() {
+		if t.last.CompareAndSwap(last, end.UnixNano()) {
+			break
+		}
+	}
+	t.total.Add(int64(end.Sub(start)))
+	t.calls.Add(1)
+}
+
+// timingUnaryInterceptor records each call, retries included, in the request's
+// CallTiming. Calls without one, such as those from background work, aren't timed.
+func timingUnaryInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	timing, ok := ctx.Value(callTimingKey{}).(*CallTiming)
+	if !ok {
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+	start := time.Now()
+	err := invoker(ctx, method, req, reply, cc, opts...)
+	timing.record(start, time.Now())
+	return err
+}
@@ -0,0 +1,97 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// CallbackProviderNames are the partners whose callbacks the gateway can handle
+var CallbackProviderNames = []string{"payments"}
+
+// CallbackAlgorithms are the accepted CALLBACK_<provider>_ALGORITHM values
+var CallbackAlgorithms = []string{"sha256", "sha512"}
+
+// CallbackConfig is the inbound partner callbacks served on POST /callbacks/:provider
+type CallbackConfig struct {
+	// Providers are the enabled partners, by name
+	Providers map[string]CallbackProvider
+	// Tolerance is how far a callback's timestamp may be from now; within it,
+	// a signature is only accepted once
+	Tolerance time.Duration
+}
+
+// CallbackProvider is how one partner signs its callbacks: the hex HMAC of
+// "<timestamp>.<body>", keyed with Secret, in SignatureHeader
+type CallbackProvider struct {
+	Secret          string
+	SignatureHeader string
+	TimestampHeader string
+	// Algorithm is the HMAC hash, one of CallbackAlgorithms
+	Algorithm string
+}
+
+// parseCallbackProviders reads CALLBACK_PROVIDERS, comma separated names, and each
+// provider's CALLBACK_<NAME>_SECRET, _SIGNATURE_HEADER, _TIMESTAMP_HEADER and _ALGORITHM
+func parseCallbackProviders(value string, lookup func(string) (string, bool)) map[string]CallbackProvider {
+	providers := make(map[string]CallbackProvider)
+	for _, name := range strings.Split(value, ",") {
+		if name = strings.ToLower(strings.TrimSpace(name)); name == "" {
+			continue
+		}
+		provider := CallbackProvider{
+			SignatureHeader: "X-Signature",
+			TimestampHeader: "X-Timestamp",
+			Algorithm:       "sha256",
+		}
+		prefix := "CALLBACK_" + strings.ToUpper(name) + "_"
+		for suffix, target := range map[string]*string{
+			"SECRET":           &provider.Secret,
+			"SIGNATURE_HEADER": &provider.SignatureHeader,
+			"TIMESTAMP_HEADER": &provider.TimestampHeader,
+			"ALGORITHM":        &provider.Algorithm,
+		} {
+			if setting, ok := lookup(prefix + suffix); ok && strings.TrimSpace(setting) != "" {
+				*target = strings.TrimSpace(setting)
+			}
+		}
+		provider.Algorithm = strings.ToLower(provider.Algorithm)
+		providers[name] = provider
+	}
+	return providers
+}
+
+// validateCallbacks checks every enabled provider can verify its callbacks
+func validateCallbacks(callbacks CallbackConfig) []error {
+	names := make([]string, 0, len(callbacks.Providers))
+	for name := range callbacks.Providers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var errs []error
+	for _, name := range names {
+		provider := callbacks.Providers[name]
+		prefix := "CALLBACK_" + strings.ToUpper(name) + "_"
+		if !contains(CallbackProviderNames, name) {
+			errs = append(errs, fmt.Errorf("CALLBACK_PROVIDERS: %q is not one of %s", name, strings.Join(CallbackProviderNames, ", ")))
+			continue
+		}
+		// A short secret can be guessed, which would let anyone forge callbacks
+		if len(provider.Secret) < 32 {
+			errs = append(errs, fmt.Errorf("%sSECRET must be set to at least 32 characters", prefix))
+		}
+		if !contains(CallbackAlgorithms, provider.Algorithm) {
+			errs = append(errs, fmt.Errorf("%sALGORITHM: %q must be one of %s", prefix, provider.Algorithm, strings.Join(CallbackAlgorithms, ", ")))
+		}
+		for _, header := range []struct{ key, value string }{
+			{prefix + "SIGNATURE_HEADER", provider.SignatureHeader},
+			{prefix + "TIMESTAMP_HEADER", provider.TimestampHeader},
+		} {
+			if strings.ContainsAny(header.value, " :") {
+				errs = append(errs, fmt.Errorf("%s: %q is not a header name", header.key, header.value))
+			}
+		}
+	}
+	return errs
+}
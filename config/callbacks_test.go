@@ -0,0 +1,77 @@
+package config
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseCallbackProviders(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		env   map[string]string
+		want  map[string]CallbackProvider
+	}{
+		{"none", " , ", nil, map[string]CallbackProvider{}},
+		{
+			"defaults",
+			" Payments ",
+			map[string]string{"CALLBACK_PAYMENTS_SECRET": " secret "},
+			map[string]CallbackProvider{"payments": {Secret: "secret", SignatureHeader: "X-Signature", TimestampHeader: "X-Timestamp", Algorithm: "sha256"}},
+		},
+		{
+			"overrides",
+			"payments",
+			map[string]string{
+				"CALLBACK_PAYMENTS_SIGNATURE_HEADER": "X-Pay-Signature",
+				"CALLBACK_PAYMENTS_TIMESTAMP_HEADER": "X-Pay-Time",
+				"CALLBACK_PAYMENTS_ALGORITHM":        "SHA512",
+				"CALLBACK_PAYMENTS_SECRET":           "   ",
+			},
+			map[string]CallbackProvider{"payments": {SignatureHeader: "X-Pay-Signature", TimestampHeader: "X-Pay-Time", Algorithm: "sha512"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lookup := func(key string) (string, bool) {
+				value, ok := tt.env[key]
+				return value, ok
+			}
+			if got := parseCallbackProviders(tt.value, lookup); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseCallbackProviders() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateCallbacks(t *testing.T) {
+	valid := CallbackProvider{Secret: strings.Repeat("s", 32), SignatureHeader: "X-Signature", TimestampHeader: "X-Timestamp", Algorithm: "sha256"}
+	with := func(change func(*CallbackProvider)) CallbackProvider {
+		provider := valid
+		change(&provider)
+		return provider
+	}
+	tests := []struct {
+		name      string
+		providers map[string]CallbackProvider
+		want      []string
+	}{
+		{"valid", map[string]CallbackProvider{"payments": valid}, nil},
+		{"unknown provider", map[string]CallbackProvider{"stripe": valid}, []string{`CALLBACK_PROVIDERS: "stripe" is not one of payments`}},
+		{"short secret", map[string]CallbackProvider{"payments": with(func(p *CallbackProvider) { p.Secret = "short" })}, []string{"CALLBACK_PAYMENTS_SECRET must be set to at least 32 characters"}},
+		{"algorithm", map[string]CallbackProvider{"payments": with(func(p *CallbackProvider) { p.Algorithm = "md5" })}, []string{`CALLBACK_PAYMENTS_ALGORITHM: "md5" must be one of sha256, sha512`}},
+		{"header name", map[string]CallbackProvider{"payments": with(func(p *CallbackProvider) { p.TimestampHeader = "X-Time: now" })}, []string{`CALLBACK_PAYMENTS_TIMESTAMP_HEADER: "X-Time: now" is not a header name`}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, err := range validateCallbacks(CallbackConfig{Providers: tt.providers}) {
+				got = append(got, err.Error())
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("validateCallbacks() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,1008 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/joho/godotenv"
+)
+
+// MaintenanceServiceNames are the backends that can be flagged for maintenance
+var MaintenanceServiceNames = []string{"auth", "job", "chat", "notification"}
+
+// CaptchaProviders are the accepted CAPTCHA_PROVIDER values
+var CaptchaProviders = []string{"turnstile", "recaptcha"}
+
+// AuditSinks are the accepted AUDIT_SINK values
+var AuditSinks = []string{"stdout", "file", "none"}
+
+// localeCode is the format of SUPPORTED_LOCALES entries: ISO 639 language codes
+var localeCode = regexp.MustCompile(`^[a-z]{2,3}$`)
+
+// DefaultLocale is what requests in an unsupported language fall back to
+const DefaultLocale = "en"
+
+// featureFlagName is the format of FEATURE_FLAGS names
+var featureFlagName = regexp.MustCompile(`^[a-z0-9_.-]{1,64}$`)
+
+// GRPCCompressions are the accepted GRPC_<service>_COMPRESSION values
+var GRPCCompressions = []string{"none", "gzip"}
+
+// OAuthProviderNames are the social logins the gateway knows how to route
+var OAuthProviderNames = []string{"google", "github", "linkedin"}
+
+// Config is every setting the gateway reads from its environment
+type Config struct {
+	Port      string
+	PprofAddr string
+
+	// ShutdownTimeout bounds finishing in-flight requests and queued notifications on SIGTERM
+	ShutdownTimeout time.Duration
+
+	// SkillTaxonomyRefresh is how often the skill taxonomy is reloaded from the job service
+	SkillTaxonomyRefresh time.Duration
+
+	// SitemapTTL is how long the generated job sitemap is served before it is
+	// regenerated in the background
+	SitemapTTL time.Duration
+
+	// JobViews batches job view counts before they are written to the job service
+	JobViews JobViewsConfig
+
+	// Usage meters each user's requests against a quota
+	Usage UsageConfig
+
+	// JobDeadlineMaxAhead is how far in the future a job's application deadline may be set
+	JobDeadlineMaxAhead time.Duration
+
+	// SignupDedupeWindow is how long a signup's response is replayed to an identical
+	// resubmission instead of signing up again
+	SignupDedupeWindow time.Duration
+
+	// PublicBaseURL is used for absolute links, e.g. in job feeds
+	PublicBaseURL string
+
+	// FrontendURL is the web app users are sent back to, e.g. after clicking an email link
+	FrontendURL string
+
+	// CalendarFeedSecret signs interview calendar feed URLs; feeds are off while it is empty
+	CalendarFeedSecret string
+
+	Services    ServiceConfig
+	JWT         JWTConfig
+	CORS        CORSConfig
+	Cookie      CookieConfig
+	AccessLog   AccessLogConfig
+	Compression CompressionConfig
+	Captcha     CaptchaConfig
+	Plans       PlanConfig
+	OAuth       OAuthConfig
+	Login       LoginThrottleConfig
+	OTPResend   OTPResendConfig
+	Password    PasswordPolicyConfig
+	Audit       AuditConfig
+	WebSocket   WebSocketConfig
+	ChatLimits  ChatLimitConfig
+	Storage     StorageConfig
+	WebPush     WebPushConfig
+	Callbacks   CallbackConfig
+
+	// Locales are the languages responses can be localized to (SUPPORTED_LOCALES)
+	Locales []string
+
+	// FeatureFlags maps flag names to the percentage of users they are on for
+	FeatureFlags map[string]int
+
+	// Policies set the timeout and retries of each route's backend calls (POLICY_<route>_*)
+	Policies PolicyTable
+
+	// SLOs set each route's latency target and error budget (SLO_<route>_*)
+	SLOs SLOTable
+
+	// ProxyRoutes forward path prefixes to REST backends (PROXY_ROUTES, PROXY_ROUTES_FILE)
+	ProxyRoutes []ProxyRoute
+
+	ContentSecurityPolicy string
+
+	// EnableDocs exposes internal diagnostics such as GET /debug/routes
+	EnableDocs bool
+
+	// MockMode serves sample data from in-process fakes instead of the backends
+	// (MOCK_MODE), for frontend development. It can't be used in release mode.
+	MockMode bool
+	// ReleaseMode is set when GIN_MODE is release
+	ReleaseMode bool
+
+	// LegacyResponses keeps the old id, message and token fields in login responses
+	// for clients that haven't moved to access_token. To be removed next release.
+	LegacyResponses bool
+
+	// ProtoRejectUnknownFields makes bodies decoded into proto messages fail on
+	// fields the message doesn't have, instead of ignoring them
+	ProtoRejectUnknownFields bool
+
+	// Feature flags and allowlists
+	MaintenanceServices []string
+	JobStatuses         []string
+	ApplicationStatuses []string
+	JobCategories       []string
+}
+
+// ServiceConfig holds the gRPC backend addresses
+type ServiceConfig struct {
+	AuthURL             string
+	JobURL              string
+	ChatNotificationURL string
+
+	// MaxInFlight caps the fan-out calls in flight to each backend, across requests
+	MaxInFlight int
+
+	// AuthCalls, JobCalls and ChatCalls shape the messages sent to each backend.
+	// Notifications share the chat backend's connection and settings.
+	AuthCalls CallConfig
+	JobCalls  CallConfig
+	ChatCalls CallConfig
+
+	JobCanary CanaryConfig
+}
+
+// CallConfig bounds the gRPC messages exchanged with one backend and how they are sent
+type CallConfig struct {
+	// MaxRecvMB and MaxSendMB are the largest response and request, in MB
+	MaxRecvMB int
+	MaxSendMB int
+	// Compression is gzip, which the backend must support, or none
+	Compression string
+}
+
+// CanaryConfig sends a share of a service's read-only calls to a second deployment
+type CanaryConfig struct {
+	// URL is the canary's host:port; empty disables canary routing
+	URL     string
+	Percent int
+	// Fallback retries a failed canary call on the primary within the same request
+	Fallback bool
+	// AllowedMethods are mutating RPCs that may also go to the canary
+	AllowedMethods []string
+}
+
+// JWTConfig holds the keys tokens are verified with, tried in order, so a token
+// signed with a retired secret keeps working while that secret is listed. The
+// first key signs.
+type JWTConfig struct {
+	Keys []JWTKey
+	// CacheSize is how many verified tokens are remembered so repeat requests skip
+	// verification; 0 disables the cache
+	CacheSize int
+}
+
+// JWTKey is one HMAC secret. ID, when set, is matched against a token's kid header.
+type JWTKey struct {
+	ID     string
+	Secret string
+}
+
+// jwtKeyID is the format of the kid in JWT_SECRETS entries written kid:secret
+var jwtKeyID = regexp.MustCompile(`^[A-Za-z0-9_.-]{1,64}$`)
+
+// parseJWTKey reads a JWT_SECRETS entry, either a bare secret or kid:secret
+func parseJWTKey(entry string) JWTKey {
+	if id, secret, ok := strings.Cut(entry, ":"); ok && jwtKeyID.MatchString(id) && secret != "" {
+		return JWTKey{ID: id, Secret: secret}
+	}
+	return JWTKey{Secret: entry}
+}
+
+// CheckJWTKeys reports a missing key or a kid given to more than one key
+func CheckJWTKeys(keys []JWTKey) error {
+	if len(keys) == 0 {
+		return errors.New("JWT_SECRET: is required, or JWT_SECRETS or JWT_SECRETS_FILE")
+	}
+	var errs []error
+	ids := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		if key.ID != "" && ids[key.ID] {
+			errs = append(errs, fmt.Errorf("JWT_SECRETS: kid %q is listed twice", key.ID))
+		}
+		ids[key.ID] = true
+	}
+	return errors.Join(errs...)
+}
+
+// LoadJWTKeys reads the JWT keys from the first of JWT_SECRETS_FILE (one entry per
+// line, # starts a comment), JWT_SECRETS (comma separated) and JWT_SECRET that is
+// set. JWT_SECRET is taken as a single secret; the others' entries may name a kid.
+// The gateway calls it again to reload the keys without a restart.
+func LoadJWTKeys(lookup func(string) (string, bool)) ([]JWTKey, error) {
+	var entries []string
+	if path, ok := lookup("JWT_SECRETS_FILE"); ok && strings.TrimSpace(path) != "" {
+		data, err := os.ReadFile(strings.TrimSpace(path))
+		if err != nil {
+			return nil, fmt.Errorf("JWT_SECRETS_FILE: %w", err)
+		}
+		for _, line := range strings.Split(string(data), "\n") {
+			if line = strings.TrimSpace(line); line != "" && !strings.HasPrefix(line, "#") {
+				entries = append(entries, line)
+			}
+		}
+	} else if value, ok := lookup("JWT_SECRETS"); ok && strings.TrimSpace(value) != "" {
+		for _, entry := range strings.Split(value, ",") {
+			if entry = strings.TrimSpace(entry); entry != "" {
+				entries = append(entries, entry)
+			}
+		}
+	} else if secret, ok := lookup("JWT_SECRET"); ok && strings.TrimSpace(secret) != "" {
+		return []JWTKey{{Secret: strings.TrimSpace(secret)}}, nil
+	}
+
+	keys := make([]JWTKey, 0, len(entries))
+	for _, entry := range entries {
+		keys = append(keys, parseJWTKey(entry))
+	}
+	return keys, nil
+}
+
+type CORSConfig struct {
+	AllowOrigins []string
+}
+
+// CookieConfig controls the attributes of cookies the gateway sets
+type CookieConfig struct {
+	Secure   bool
+	SameSite http.SameSite
+	Domain   string
+}
+
+// AccessLogConfig controls request/response body capture in the access log
+type AccessLogConfig struct {
+	// BodySampleRate is the fraction (0-1) of matching requests whose bodies are logged
+	BodySampleRate float64
+	// BodyRoutes are route templates (or prefixes ending in *) eligible for body capture
+	BodyRoutes []string
+	// MaxBodyKB truncates captured bodies
+	MaxBodyKB int
+}
+
+// CompressionConfig controls gzip/deflate response compression
+type CompressionConfig struct {
+	// Level is 1 (fastest) to 9 (smallest); 0 disables compression
+	Level int
+}
+
+// CaptchaConfig enables CAPTCHA checks on signup and OTP/password-reset routes
+type CaptchaConfig struct {
+	// Provider is turnstile or recaptcha; empty disables the checks
+	Provider string
+	Secret   string
+	// FailOpen lets requests through while the provider is unreachable
+	FailOpen bool
+}
+
+// PlanConfig controls the checks against an employer's subscription plan
+type PlanConfig struct {
+	// CacheTTL is how long an employer's plan is reused before asking the backend again
+	CacheTTL time.Duration
+	// FailOpen lets plan-gated requests through while the plan can't be loaded
+	FailOpen bool
+}
+
+// OAuthConfig controls social login
+type OAuthConfig struct {
+	// Providers are the enabled providers, a subset of OAuthProviderNames
+	Providers []string
+	// CandidateRedirectURI and EmployerRedirectURI are where providers send each
+	// role back to when the client doesn't pass redirect_uri; {provider} is replaced
+	// with the provider's name
+	CandidateRedirectURI string
+	EmployerRedirectURI  string
+	// AllowedRedirects are the redirect_uri values clients may pass besides the
+	// defaults. An entry with a path must match exactly; an origin allows any URI on it.
+	AllowedRedirects []string
+	// ErrorRedirectURL is the frontend page shown when the provider reports an error
+	ErrorRedirectURL string
+}
+
+// LoginThrottleConfig controls the lockout after repeated failed logins
+type LoginThrottleConfig struct {
+	// MaxFailures per email, and MaxFailuresPerIP per client IP, within Window
+	MaxFailures      int
+	MaxFailuresPerIP int
+	Window           time.Duration
+	// Lockout doubles with each lockout in a row, up to MaxLockout
+	Lockout    time.Duration
+	MaxLockout time.Duration
+}
+
+// JobViewsConfig is how often buffered job views are flushed: every FlushInterval,
+// or sooner once FlushThreshold views are waiting
+type JobViewsConfig struct {
+	FlushInterval  time.Duration
+	FlushThreshold int
+}
+
+// UsageConfig is each user's request quota: ReadQuota GET and HEAD requests and
+// WriteQuota others per Window, 0 for unlimited. Counts are flushed every FlushInterval.
+type UsageConfig struct {
+	Window        time.Duration
+	ReadQuota     int
+	WriteQuota    int
+	FlushInterval time.Duration
+}
+
+// OTPResendConfig spaces out resent verification OTPs per email
+type OTPResendConfig struct {
+	Cooldown   time.Duration
+	MaxPerHour int
+}
+
+// PasswordPolicyConfig is the rules new passwords are checked against at the gateway
+type PasswordPolicyConfig struct {
+	MinLength     int
+	RequireUpper  bool
+	RequireLower  bool
+	RequireDigit  bool
+	RequireSymbol bool
+	// BreachCheck looks passwords up in Have I Been Pwned, failing open on errors
+	BreachCheck bool
+}
+
+// AuditConfig controls where audit events of sensitive operations are written
+type AuditConfig struct {
+	// Sink is stdout, file or none. Events are kept in memory for GET /admin/audit
+	// unless the sink is a file, which is then queried instead.
+	Sink string
+	File string
+	// MemoryEvents is how many recent events the in-memory store keeps
+	MemoryEvents int
+}
+
+// WebSocketConfig bounds chat WebSocket connections
+type WebSocketConfig struct {
+	// MaxConnectionsPerUser and MaxConnections cap open connections per user and in total
+	MaxConnectionsPerUser int
+	MaxConnections        int
+	// SendBuffer is how many outgoing messages are queued per connection
+	SendBuffer int
+	// SlowClientTimeout is how long a full send queue is tolerated before the client is dropped
+	SlowClientTimeout time.Duration
+}
+
+// StorageConfig points at the S3-compatible bucket clients upload large files to
+// through presigned URLs. Direct uploads are used when Endpoint is empty.
+type StorageConfig struct {
+	Endpoint  string
+	Bucket    string
+	Region    string
+	AccessKey string
+	SecretKey string
+	// URLTTL is how long a presigned upload URL stays valid
+	URLTTL time.Duration
+}
+
+// ChatLimitConfig caps how fast users send chat messages, over REST and WebSocket
+type ChatLimitConfig struct {
+	// PerMinute caps a sender's messages a minute across all conversations
+	PerMinute int
+	// PerConversationPerMinute caps a sender's messages a minute to one person
+	PerConversationPerMinute int
+	// BulkPerMinute caps the messages a minute an employer sends through bulk sends
+	BulkPerMinute int
+	// FloodThreshold refusals within FloodWindow mute the sender for MuteFor
+	FloodThreshold int
+	FloodWindow    time.Duration
+	MuteFor        time.Duration
+}
+
+// WebPushConfig holds the VAPID keys browser push notifications are sent with.
+// Web push is disabled while PublicKey is empty.
+type WebPushConfig struct {
+	PublicKey  string
+	PrivateKey string
+	// Subject is a mailto: or https: contact for push services
+	Subject string
+	// AllowedHosts are the push services subscriptions may point at; a subdomain
+	// of a listed host is allowed too
+	AllowedHosts []string
+}
+
+// Default returns the configuration used when nothing is set, with a placeholder
+// JWT secret. It is meant for tests; Load requires a real secret.
+func Default() *Config {
+	return &Config{
+		Port:                 "8008",
+		PprofAddr:            "localhost:6062",
+		ShutdownTimeout:      15 * time.Second,
+		SkillTaxonomyRefresh: 10 * time.Minute,
+		SitemapTTL:           time.Hour,
+		SignupDedupeWindow:   10 * time.Second,
+		JobViews:             JobViewsConfig{FlushInterval: 30 * time.Second, FlushThreshold: 1000},
+		JobDeadlineMaxAhead:  365 * 24 * time.Hour,
+		LegacyResponses:      true,
+		PublicBaseURL:        "http://localhost:8008",
+		FrontendURL:          "http://localhost:8060",
+		Usage: UsageConfig{
+			Window:        24 * time.Hour,
+			ReadQuota:     100000,
+			WriteQuota:    10000,
+			FlushInterval: time.Minute,
+		},
+		Services: ServiceConfig{
+			AuthURL:             "localhost:50051",
+			JobURL:              "localhost:50052",
+			ChatNotificationURL: "localhost:50053",
+			MaxInFlight:         64,
+			// Resumes go through the auth service, so it gets room beyond gRPC's 4 MB
+			AuthCalls: CallConfig{MaxRecvMB: 16, MaxSendMB: 16, Compression: "none"},
+			JobCalls:  CallConfig{MaxRecvMB: 4, MaxSendMB: 4, Compression: "none"},
+			ChatCalls: CallConfig{MaxRecvMB: 4, MaxSendMB: 4, Compression: "none"},
+			JobCanary: CanaryConfig{Percent: 5, Fallback: true},
+		},
+		JWT:                   JWTConfig{Keys: []JWTKey{{Secret: "test-secret"}}, CacheSize: 10000},
+		CORS:                  CORSConfig{AllowOrigins: []string{"*"}},
+		Cookie:                CookieConfig{Secure: true, SameSite: http.SameSiteStrictMode},
+		ContentSecurityPolicy: "default-src 'none'; frame-ancestors 'none'",
+		AccessLog:             AccessLogConfig{MaxBodyKB: 4},
+		Compression:           CompressionConfig{Level: 6},
+		Audit:                 AuditConfig{Sink: "stdout", MemoryEvents: 10000},
+		WebSocket: WebSocketConfig{
+			MaxConnectionsPerUser: 5,
+			MaxConnections:        10000,
+			SendBuffer:            256,
+			SlowClientTimeout:     10 * time.Second,
+		},
+		Storage: StorageConfig{Region: "us-east-1", URLTTL: 15 * time.Minute},
+		ChatLimits: ChatLimitConfig{
+			PerMinute:                30,
+			PerConversationPerMinute: 15,
+			BulkPerMinute:            600,
+			FloodThreshold:           10,
+			FloodWindow:              time.Minute,
+			MuteFor:                  10 * time.Minute,
+		},
+		WebPush: WebPushConfig{
+			AllowedHosts: []string{
+				"fcm.googleapis.com", "updates.push.services.mozilla.com",
+				"notify.windows.com", "web.push.apple.com",
+			},
+		},
+		Callbacks: CallbackConfig{Tolerance: 5 * time.Minute},
+		Policies:  defaultPolicies(),
+		SLOs:      defaultSLOs(),
+		Login: LoginThrottleConfig{
+			MaxFailures:      5,
+			MaxFailuresPerIP: 20,
+			Window:           15 * time.Minute,
+			Lockout:          time.Minute,
+			MaxLockout:       time.Hour,
+		},
+		OTPResend: OTPResendConfig{Cooldown: time.Minute, MaxPerHour: 5},
+		// A billing outage shouldn't lock every employer out of paid features
+		Plans: PlanConfig{CacheTTL: time.Minute, FailOpen: true},
+		Password: PasswordPolicyConfig{
+			MinLength:    8,
+			RequireUpper: true,
+			RequireLower: true,
+			RequireDigit: true,
+		},
+		OAuth: OAuthConfig{
+			Providers:            []string{"google", "github", "linkedin"},
+			CandidateRedirectURI: "http://localhost:8060/candidate/auth/{provider}/callback",
+			EmployerRedirectURI:  "http://localhost:8060/employer/auth/{provider}/callback",
+			ErrorRedirectURL:     "http://localhost:8060/auth/error",
+		},
+		Locales:             []string{"en", "hi", "ar", "fr"},
+		JobStatuses:         []string{"OPEN", "CLOSED", "PAUSED", "DRAFT"},
+		ApplicationStatuses: []string{"PENDING", "REVIEWED", "SHORTLISTED", "INTERVIEW", "REJECTED", "HIRED", "WITHDRAWN"},
+		JobCategories: []string{
+			"Software Development", "Data Science", "Design", "Marketing", "Sales",
+			"Finance", "Human Resources", "Customer Support", "Operations", "Other",
+		},
+	}
+}
+
+// Load reads the configuration from the environment, after loading .env if present.
+// The returned error lists every missing or invalid variable at once.
+func Load() (*Config, error) {
+	if err := godotenv.Load(); err != nil {
+		log.Println("Warning: .env file not found, using environment variables")
+	}
+	keys := make([]string, 0, len(os.Environ()))
+	for _, pair := range os.Environ() {
+		keys = append(keys, strings.SplitN(pair, "=", 2)[0])
+	}
+	return fromLookup(os.LookupEnv, keys)
+}
+
+// FromLookup builds a config from any key lookup, so tests can supply a map.
+// POLICY_* overrides are only read by Load, which can list the variables set.
+func FromLookup(lookup func(string) (string, bool)) (*Config, error) {
+	return fromLookup(lookup, nil)
+}
+
+func fromLookup(lookup func(string) (string, bool), keys []string) (*Config, error) {
+	cfg := Default()
+	cfg.JWT.Keys = nil
+	var errs []error
+
+	str := func(key string, target *string) {
+		if value, ok := lookup(key); ok && strings.TrimSpace(value) != "" {
+			*target = strings.TrimSpace(value)
+		}
+	}
+	list := func(key string, target *[]string) {
+		value, ok := lookup(key)
+		if !ok || strings.TrimSpace(value) == "" {
+			return
+		}
+		var items []string
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+		*target = items
+	}
+	boolean := func(key string, target *bool) {
+		value, ok := lookup(key)
+		if !ok || strings.TrimSpace(value) == "" {
+			return
+		}
+		parsed, err := strconv.ParseBool(strings.TrimSpace(value))
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %q is not a boolean", key, value))
+			return
+		}
+		*target = parsed
+	}
+
+	positive := func(key string, target *int) {
+		value, ok := lookup(key)
+		if !ok || strings.TrimSpace(value) == "" {
+			return
+		}
+		n, err := strconv.Atoi(strings.TrimSpace(value))
+		if err != nil || n <= 0 {
+			errs = append(errs, fmt.Errorf("%s: %q must be a positive integer", key, value))
+			return
+		}
+		*target = n
+	}
+	duration := func(key string, target *time.Duration) {
+		value, ok := lookup(key)
+		if !ok || strings.TrimSpace(value) == "" {
+			return
+		}
+		d, err := time.ParseDuration(strings.TrimSpace(value))
+		if err != nil || d <= 0 {
+			errs = append(errs, fmt.Errorf("%s: %q must be a positive duration such as 15m", key, value))
+			return
+		}
+		*target = d
+	}
+
+	str("PORT", &cfg.Port)
+	str("PPROF_ADDR", &cfg.PprofAddr)
+	str("PUBLIC_BASE_URL", &cfg.PublicBaseURL)
+	str("CALENDAR_FEED_SECRET", &cfg.CalendarFeedSecret)
+	str("FRONTEND_URL", &cfg.FrontendURL)
+	str("AUTH_SERVICE_URL", &cfg.Services.AuthURL)
+	str("JOB_SERVICE_URL", &cfg.Services.JobURL)
+	str("CHAT_NOTIFICATION_SERVICE_URL", &cfg.Services.ChatNotificationURL)
+	positive("BACKEND_MAX_IN_FLIGHT", &cfg.Services.MaxInFlight)
+	for service, calls := range map[string]*CallConfig{
+		"AUTH": &cfg.Services.AuthCalls,
+		"JOB":  &cfg.Services.JobCalls,
+		"CHAT": &cfg.Services.ChatCalls,
+	} {
+		positive("GRPC_"+service+"_MAX_RECV_MB", &calls.MaxRecvMB)
+		positive("GRPC_"+service+"_MAX_SEND_MB", &calls.MaxSendMB)
+		str("GRPC_"+service+"_COMPRESSION", &calls.Compression)
+		calls.Compression = strings.ToLower(calls.Compression)
+	}
+	str("JOB_SERVICE_URL_CANARY", &cfg.Services.JobCanary.URL)
+	boolean("JOB_CANARY_FALLBACK", &cfg.Services.JobCanary.Fallback)
+	list("JOB_CANARY_METHODS", &cfg.Services.JobCanary.AllowedMethods)
+	if keys, err := LoadJWTKeys(lookup); err != nil {
+		errs = append(errs, err)
+	} else {
+		cfg.JWT.Keys = keys
+	}
+	if value, ok := lookup("JWT_CACHE_SIZE"); ok && strings.TrimSpace(value) != "" {
+		n, err := strconv.Atoi(strings.TrimSpace(value))
+		if err != nil || n < 0 {
+			errs = append(errs, fmt.Errorf("JWT_CACHE_SIZE: %q must be 0 to disable or a positive integer", value))
+		} else {
+			cfg.JWT.CacheSize = n
+		}
+	}
+	str("COOKIE_DOMAIN", &cfg.Cookie.Domain)
+	str("CONTENT_SECURITY_POLICY", &cfg.ContentSecurityPolicy)
+	str("CAPTCHA_PROVIDER", &cfg.Captcha.Provider)
+	str("CAPTCHA_SECRET", &cfg.Captcha.Secret)
+	boolean("CAPTCHA_FAIL_OPEN", &cfg.Captcha.FailOpen)
+	duration("PLAN_CACHE_TTL", &cfg.Plans.CacheTTL)
+	boolean("PLAN_CHECK_FAIL_OPEN", &cfg.Plans.FailOpen)
+	// The localhost redirect defaults only suit development; a release must set its own
+	if mode, _ := lookup("GIN_MODE"); strings.TrimSpace(mode) == "release" {
+		cfg.ReleaseMode = true
+		cfg.OAuth.CandidateRedirectURI, cfg.OAuth.EmployerRedirectURI = "", ""
+	}
+	// OAUTH_REDIRECT_BASE_URL predates the per-role settings, which take precedence
+	var redirectBase string
+	str("OAUTH_REDIRECT_BASE_URL", &redirectBase)
+	if redirectBase = strings.TrimSuffix(redirectBase, "/"); redirectBase != "" {
+		cfg.OAuth.CandidateRedirectURI = redirectBase + "/candidate/auth/{provider}/callback"
+		cfg.OAuth.EmployerRedirectURI = redirectBase + "/employer/auth/{provider}/callback"
+	}
+	str("OAUTH_CANDIDATE_REDIRECT_URI", &cfg.OAuth.CandidateRedirectURI)
+	str("OAUTH_EMPLOYER_REDIRECT_URI", &cfg.OAuth.EmployerRedirectURI)
+	list("OAUTH_ALLOWED_REDIRECTS", &cfg.OAuth.AllowedRedirects)
+	str("OAUTH_ERROR_REDIRECT_URL", &cfg.OAuth.ErrorRedirectURL)
+	list("OAUTH_PROVIDERS", &cfg.OAuth.Providers)
+	positive("LOGIN_MAX_FAILURES", &cfg.Login.MaxFailures)
+	positive("LOGIN_MAX_FAILURES_PER_IP", &cfg.Login.MaxFailuresPerIP)
+	duration("LOGIN_FAILURE_WINDOW", &cfg.Login.Window)
+	duration("LOGIN_LOCKOUT", &cfg.Login.Lockout)
+	duration("LOGIN_MAX_LOCKOUT", &cfg.Login.MaxLockout)
+	duration("OTP_RESEND_COOLDOWN", &cfg.OTPResend.Cooldown)
+	positive("OTP_RESEND_MAX_PER_HOUR", &cfg.OTPResend.MaxPerHour)
+	positive("PASSWORD_MIN_LENGTH", &cfg.Password.MinLength)
+	boolean("PASSWORD_REQUIRE_UPPER", &cfg.Password.RequireUpper)
+	boolean("PASSWORD_REQUIRE_LOWER", &cfg.Password.RequireLower)
+	boolean("PASSWORD_REQUIRE_DIGIT", &cfg.Password.RequireDigit)
+	boolean("PASSWORD_REQUIRE_SYMBOL", &cfg.Password.RequireSymbol)
+	boolean("HIBP_CHECK", &cfg.Password.BreachCheck)
+	boolean("COOKIE_SECURE", &cfg.Cookie.Secure)
+	boolean("ENABLE_DOCS", &cfg.EnableDocs)
+	boolean("MOCK_MODE", &cfg.MockMode)
+	boolean("LEGACY_RESPONSES", &cfg.LegacyResponses)
+	boolean("PROTO_REJECT_UNKNOWN_FIELDS", &cfg.ProtoRejectUnknownFields)
+	list("CORS_ALLOW_ORIGINS", &cfg.CORS.AllowOrigins)
+	list("MAINTENANCE_SERVICES", &cfg.MaintenanceServices)
+	list("ACCESS_LOG_BODY_ROUTES", &cfg.AccessLog.BodyRoutes)
+	positive("ACCESS_LOG_BODY_MAX_KB", &cfg.AccessLog.MaxBodyKB)
+	list("SUPPORTED_LOCALES", &cfg.Locales)
+	str("AUDIT_SINK", &cfg.Audit.Sink)
+	str("AUDIT_FILE", &cfg.Audit.File)
+	positive("AUDIT_MEMORY_EVENTS", &cfg.Audit.MemoryEvents)
+	duration("SHUTDOWN_TIMEOUT", &cfg.ShutdownTimeout)
+	duration("SKILL_TAXONOMY_REFRESH", &cfg.SkillTaxonomyRefresh)
+	duration("SITEMAP_CACHE_TTL", &cfg.SitemapTTL)
+	duration("SIGNUP_DEDUPE_WINDOW", &cfg.SignupDedupeWindow)
+	duration("JOB_VIEW_FLUSH_INTERVAL", &cfg.JobViews.FlushInterval)
+	positive("JOB_VIEW_FLUSH_THRESHOLD", &cfg.JobViews.FlushThreshold)
+	duration("JOB_DEADLINE_MAX_AHEAD", &cfg.JobDeadlineMaxAhead)
+	duration("USAGE_WINDOW", &cfg.Usage.Window)
+	duration("SLO_WINDOW", &cfg.SLOs.Window)
+	duration("USAGE_FLUSH_INTERVAL", &cfg.Usage.FlushInterval)
+	for _, quota := range []struct {
+		key    string
+		target *int
+	}{{"USAGE_READ_QUOTA", &cfg.Usage.ReadQuota}, {"USAGE_WRITE_QUOTA", &cfg.Usage.WriteQuota}} {
+		value, ok := lookup(quota.key)
+		if !ok || strings.TrimSpace(value) == "" {
+			continue
+		}
+		n, err := strconv.Atoi(strings.TrimSpace(value))
+		if err != nil || n < 0 {
+			errs = append(errs, fmt.Errorf("%s: %q must be 0 for unlimited or a positive integer", quota.key, value))
+			continue
+		}
+		*quota.target = n
+	}
+	positive("WS_MAX_CONNECTIONS_PER_USER", &cfg.WebSocket.MaxConnectionsPerUser)
+	positive("WS_MAX_CONNECTIONS", &cfg.WebSocket.MaxConnections)
+	positive("WS_SEND_BUFFER", &cfg.WebSocket.SendBuffer)
+	duration("WS_SLOW_CLIENT_TIMEOUT", &cfg.WebSocket.SlowClientTimeout)
+	positive("CHAT_SEND_PER_MINUTE", &cfg.ChatLimits.PerMinute)
+	positive("CHAT_SEND_PER_CONVERSATION_PER_MINUTE", &cfg.ChatLimits.PerConversationPerMinute)
+	positive("CHAT_BULK_SEND_PER_MINUTE", &cfg.ChatLimits.BulkPerMinute)
+	positive("CHAT_FLOOD_THRESHOLD", &cfg.ChatLimits.FloodThreshold)
+	duration("CHAT_FLOOD_WINDOW", &cfg.ChatLimits.FloodWindow)
+	duration("CHAT_FLOOD_MUTE", &cfg.ChatLimits.MuteFor)
+	str("STORAGE_ENDPOINT", &cfg.Storage.Endpoint)
+	str("STORAGE_BUCKET", &cfg.Storage.Bucket)
+	str("STORAGE_REGION", &cfg.Storage.Region)
+	str("STORAGE_ACCESS_KEY", &cfg.Storage.AccessKey)
+	str("STORAGE_SECRET_KEY", &cfg.Storage.SecretKey)
+	duration("STORAGE_URL_TTL", &cfg.Storage.URLTTL)
+	str("VAPID_PUBLIC_KEY", &cfg.WebPush.PublicKey)
+	str("VAPID_PRIVATE_KEY", &cfg.WebPush.PrivateKey)
+	str("VAPID_SUBJECT", &cfg.WebPush.Subject)
+	list("WEB_PUSH_ALLOWED_HOSTS", &cfg.WebPush.AllowedHosts)
+	duration("CALLBACK_TOLERANCE", &cfg.Callbacks.Tolerance)
+	list("JOB_STATUSES", &cfg.JobStatuses)
+	list("APPLICATION_STATUSES", &cfg.ApplicationStatuses)
+	list("JOB_CATEGORIES", &cfg.JobCategories)
+
+	if value, ok := lookup("ACCESS_LOG_BODY_SAMPLE_RATE"); ok && value != "" {
+		rate, err := strconv.ParseFloat(value, 64)
+		if err != nil || rate < 0 || rate > 1 {
+			errs = append(errs, fmt.Errorf("ACCESS_LOG_BODY_SAMPLE_RATE: %q must be a number between 0 and 1", value))
+		}
+		cfg.AccessLog.BodySampleRate = rate
+	}
+	if value, ok := lookup("JOB_CANARY_PERCENT"); ok && strings.TrimSpace(value) != "" {
+		percent, err := strconv.Atoi(strings.TrimSuffix(strings.TrimSpace(value), "%"))
+		if err != nil || percent < 0 || percent > 100 {
+			errs = append(errs, fmt.Errorf("JOB_CANARY_PERCENT: %q must be between 0 and 100", value))
+		}
+		cfg.Services.JobCanary.Percent = percent
+	}
+	if value, ok := lookup("COMPRESSION_LEVEL"); ok && value != "" {
+		level, err := strconv.Atoi(value)
+		if err != nil || level < 0 || level > 9 {
+			errs = append(errs, fmt.Errorf("COMPRESSION_LEVEL: %q must be between 0 and 9", value))
+		}
+		cfg.Compression.Level = level
+	}
+	if value, ok := lookup("COOKIE_SAMESITE"); ok && value != "" {
+		switch strings.ToLower(value) {
+		case "strict":
+			cfg.Cookie.SameSite = http.SameSiteStrictMode
+		case "lax":
+			cfg.Cookie.SameSite = http.SameSiteLaxMode
+		case "none":
+			cfg.Cookie.SameSite = http.SameSiteNoneMode
+		default:
+			errs = append(errs, fmt.Errorf("COOKIE_SAMESITE: %q must be strict, lax or none", value))
+		}
+	}
+
+	if value, ok := lookup("FEATURE_FLAGS"); ok && strings.TrimSpace(value) != "" {
+		featureFlags, err := parseFeatureFlags(value)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("FEATURE_FLAGS: %w", err))
+		}
+		cfg.FeatureFlags = featureFlags
+	}
+	if value, ok := lookup("PROXY_ROUTES"); ok && strings.TrimSpace(value) != "" {
+		proxyRoutes, err := parseProxyRoutes(value, lookup)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("PROXY_ROUTES: %w", err))
+		}
+		cfg.ProxyRoutes = append(cfg.ProxyRoutes, proxyRoutes...)
+	}
+	if value, ok := lookup("CALLBACK_PROVIDERS"); ok && strings.TrimSpace(value) != "" {
+		cfg.Callbacks.Providers = parseCallbackProviders(value, lookup)
+	}
+	if path, ok := lookup("PROXY_ROUTES_FILE"); ok && strings.TrimSpace(path) != "" {
+		proxyRoutes, err := loadProxyRoutesFile(strings.TrimSpace(path), lookup)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("PROXY_ROUTES_FILE: %w", err))
+		}
+		cfg.ProxyRoutes = append(cfg.ProxyRoutes, proxyRoutes...)
+	}
+	for i := range cfg.ProxyRoutes {
+		if cfg.ProxyRoutes[i].Timeout == 0 {
+			cfg.ProxyRoutes[i].Timeout = defaultProxyTimeout
+		}
+		// Proxied responses stream for as long as the backend sends, so the default
+		// policy's deadline mustn't cut them off; POLICY_* can still set one
+		if name := PolicyName(cfg.ProxyRoutes[i].Prefix); name != "" {
+			if _, ok := cfg.Policies.Routes[name]; !ok {
+				cfg.Policies.Routes[name] = UpstreamPolicy{}
+			}
+		}
+	}
+
+	errs = append(errs, policyOverrides(&cfg.Policies, keys, lookup)...)
+	errs = append(errs, sloOverrides(&cfg.SLOs, keys, lookup)...)
+
+	if err := cfg.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return cfg, nil
+}
+
+// Validate checks the settings that can't be defaulted safely
+func (c *Config) Validate() error {
+	var errs []error
+
+	if port, err := strconv.Atoi(c.Port); err != nil || port < 1 || port > 65535 {
+		errs = append(errs, fmt.Errorf("PORT: %q is not a valid port", c.Port))
+	}
+	if err := CheckJWTKeys(c.JWT.Keys); err != nil {
+		errs = append(errs, err)
+	}
+	for _, service := range []struct{ key, addr string }{
+		{"AUTH_SERVICE_URL", c.Services.AuthURL},
+		{"JOB_SERVICE_URL", c.Services.JobURL},
+		{"CHAT_NOTIFICATION_SERVICE_URL", c.Services.ChatNotificationURL},
+	} {
+		if !strings.Contains(service.addr, ":") {
+			errs = append(errs, fmt.Errorf("%s: %q must be host:port", service.key, service.addr))
+		}
+	}
+	for _, calls := range []struct {
+		service string
+		calls   CallConfig
+	}{{"AUTH", c.Services.AuthCalls}, {"JOB", c.Services.JobCalls}, {"CHAT", c.Services.ChatCalls}} {
+		if !contains(GRPCCompressions, calls.calls.Compression) {
+			errs = append(errs, fmt.Errorf("GRPC_%s_COMPRESSION: %q must be one of %s", calls.service, calls.calls.Compression, strings.Join(GRPCCompressions, ", ")))
+		}
+	}
+	if c.Services.JobCanary.URL != "" && !strings.Contains(c.Services.JobCanary.URL, ":") {
+		errs = append(errs, fmt.Errorf("JOB_SERVICE_URL_CANARY: %q must be host:port", c.Services.JobCanary.URL))
+	}
+	if parsed, err := url.Parse(c.PublicBaseURL); err != nil || parsed.Scheme == "" || parsed.Host == "" {
+		errs = append(errs, fmt.Errorf("PUBLIC_BASE_URL: %q must be an absolute URL", c.PublicBaseURL))
+	}
+	// Browsers drop SameSite=None cookies that aren't Secure
+	if c.Cookie.SameSite == http.SameSiteNoneMode && !c.Cookie.Secure {
+		errs = append(errs, errors.New("COOKIE_SAMESITE: none requires COOKIE_SECURE=true"))
+	}
+	for _, provider := range c.OAuth.Providers {
+		if !contains(OAuthProviderNames, provider) {
+			errs = append(errs, fmt.Errorf("OAUTH_PROVIDERS: unknown provider %q, expected one of %s",
+				provider, strings.Join(OAuthProviderNames, ", ")))
+		}
+	}
+	for _, setting := range []struct{ key, value string }{
+		{"OAUTH_CANDIDATE_REDIRECT_URI", c.OAuth.CandidateRedirectURI},
+		{"OAUTH_EMPLOYER_REDIRECT_URI", c.OAuth.EmployerRedirectURI},
+	} {
+		// Release mode has no default, see fromLookup
+		if setting.value == "" {
+			errs = append(errs, fmt.Errorf("%s: is required in release mode", setting.key))
+			continue
+		}
+		redirect := strings.ReplaceAll(setting.value, "{provider}", "google")
+		if parsed, err := url.Parse(redirect); err != nil || parsed.Scheme == "" || parsed.Host == "" {
+			errs = append(errs, fmt.Errorf("%s: %q must be an absolute URL", setting.key, setting.value))
+		}
+	}
+	for _, redirect := range c.OAuth.AllowedRedirects {
+		if parsed, err := url.Parse(redirect); err != nil || parsed.Scheme == "" || parsed.Host == "" {
+			errs = append(errs, fmt.Errorf("OAUTH_ALLOWED_REDIRECTS: %q must be an absolute URL", redirect))
+		}
+	}
+	for _, setting := range []struct{ key, value string }{
+		{"OAUTH_ERROR_REDIRECT_URL", c.OAuth.ErrorRedirectURL},
+		{"FRONTEND_URL", c.FrontendURL},
+	} {
+		if parsed, err := url.Parse(setting.value); err != nil || parsed.Scheme == "" || parsed.Host == "" {
+			errs = append(errs, fmt.Errorf("%s: %q must be an absolute URL", setting.key, setting.value))
+		}
+	}
+	if c.Login.MaxLockout < c.Login.Lockout {
+		errs = append(errs, fmt.Errorf("LOGIN_MAX_LOCKOUT: %s is shorter than LOGIN_LOCKOUT %s", c.Login.MaxLockout, c.Login.Lockout))
+	}
+	if c.Captcha.Provider != "" {
+		if !contains(CaptchaProviders, c.Captcha.Provider) {
+			errs = append(errs, fmt.Errorf("CAPTCHA_PROVIDER: %q must be one of %s", c.Captcha.Provider, strings.Join(CaptchaProviders, ", ")))
+		}
+		if c.Captcha.Secret == "" {
+			errs = append(errs, errors.New("CAPTCHA_SECRET: is required when CAPTCHA_PROVIDER is set"))
+		}
+	}
+	if !contains(AuditSinks, c.Audit.Sink) {
+		errs = append(errs, fmt.Errorf("AUDIT_SINK: %q must be one of %s", c.Audit.Sink, strings.Join(AuditSinks, ", ")))
+	}
+	if c.Audit.Sink == "file" && c.Audit.File == "" {
+		errs = append(errs, errors.New("AUDIT_FILE: is required when AUDIT_SINK is file"))
+	}
+	if c.WebSocket.MaxConnections < c.WebSocket.MaxConnectionsPerUser {
+		errs = append(errs, fmt.Errorf("WS_MAX_CONNECTIONS: %d is below WS_MAX_CONNECTIONS_PER_USER %d",
+			c.WebSocket.MaxConnections, c.WebSocket.MaxConnectionsPerUser))
+	}
+	if c.Storage.Endpoint != "" {
+		if parsed, err := url.Parse(c.Storage.Endpoint); err != nil || parsed.Scheme == "" || parsed.Host == "" {
+			errs = append(errs, fmt.Errorf("STORAGE_ENDPOINT: %q must be an absolute URL", c.Storage.Endpoint))
+		}
+		for _, setting := range []struct{ key, value string }{
+			{"STORAGE_BUCKET", c.Storage.Bucket},
+			{"STORAGE_REGION", c.Storage.Region},
+			{"STORAGE_ACCESS_KEY", c.Storage.AccessKey},
+			{"STORAGE_SECRET_KEY", c.Storage.SecretKey},
+		} {
+			if setting.value == "" {
+				errs = append(errs, fmt.Errorf("%s: is required when STORAGE_ENDPOINT is set", setting.key))
+			}
+		}
+		// SigV4 presigned URLs can't outlive a week
+		if c.Storage.URLTTL > 7*24*time.Hour {
+			errs = append(errs, fmt.Errorf("STORAGE_URL_TTL: %s exceeds the 7 day maximum", c.Storage.URLTTL))
+		}
+	}
+	if c.ChatLimits.PerMinute < c.ChatLimits.PerConversationPerMinute {
+		errs = append(errs, fmt.Errorf("CHAT_SEND_PER_MINUTE: %d is below CHAT_SEND_PER_CONVERSATION_PER_MINUTE %d",
+			c.ChatLimits.PerMinute, c.ChatLimits.PerConversationPerMinute))
+	}
+	if c.SLOs.Window < minSLOWindow || c.SLOs.Window > maxSLOWindow || c.SLOs.Window%time.Minute != 0 {
+		errs = append(errs, fmt.Errorf("SLO_WINDOW: %s must be whole minutes from %s to %s", c.SLOs.Window, minSLOWindow, maxSLOWindow))
+	}
+	if c.MockMode && c.ReleaseMode {
+		errs = append(errs, errors.New("MOCK_MODE: can't be enabled when GIN_MODE is release"))
+	}
+	if c.CalendarFeedSecret != "" && len(c.CalendarFeedSecret) < 32 {
+		errs = append(errs, errors.New("CALENDAR_FEED_SECRET: must be at least 32 characters"))
+	}
+	if c.WebPush.PublicKey != "" || c.WebPush.PrivateKey != "" {
+		if c.WebPush.PublicKey == "" || c.WebPush.PrivateKey == "" {
+			errs = append(errs, errors.New("VAPID_PUBLIC_KEY: and VAPID_PRIVATE_KEY must be set together"))
+		}
+		if !strings.HasPrefix(c.WebPush.Subject, "mailto:") && !strings.HasPrefix(c.WebPush.Subject, "https://") {
+			errs = append(errs, fmt.Errorf("VAPID_SUBJECT: %q must be a mailto: or https: URL when the VAPID keys are set", c.WebPush.Subject))
+		}
+	}
+	for _, locale := range c.Locales {
+		if !localeCode.MatchString(locale) {
+			errs = append(errs, fmt.Errorf("SUPPORTED_LOCALES: %q must be a lowercase language code such as fr", locale))
+		}
+	}
+	if !contains(c.Locales, DefaultLocale) {
+		errs = append(errs, fmt.Errorf("SUPPORTED_LOCALES: must include %s, the fallback", DefaultLocale))
+	}
+	errs = append(errs, validateProxyRoutes(c.ProxyRoutes)...)
+	errs = append(errs, validateCallbacks(c.Callbacks)...)
+	for _, service := range c.MaintenanceServices {
+		if !contains(MaintenanceServiceNames, strings.ToLower(service)) {
+			errs = append(errs, fmt.Errorf("MAINTENANCE_SERVICES: unknown service %q, expected one of %s",
+				service, strings.Join(MaintenanceServiceNames, ", ")))
+		}
+	}
+	for _, allowlist := range []struct {
+		key    string
+		values []string
+	}{
+		{"JOB_STATUSES", c.JobStatuses},
+		{"APPLICATION_STATUSES", c.ApplicationStatuses},
+		{"JOB_CATEGORIES", c.JobCategories},
+	} {
+		if len(allowlist.values) == 0 {
+			errs = append(errs, fmt.Errorf("%s: must list at least one value", allowlist.key))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// parseFeatureFlags reads comma separated "name=<rollout>" entries, where rollout
+// is on, off or a percentage such as 25 or 25%. A bare name is on for everyone.
+func parseFeatureFlags(value string) (map[string]int, error) {
+	featureFlags := make(map[string]int)
+	for _, entry := range strings.Split(value, ",") {
+		if entry = strings.TrimSpace(entry); entry == "" {
+			continue
+		}
+		name, rollout, hasRollout := strings.Cut(entry, "=")
+		name = strings.TrimSpace(name)
+		if !featureFlagName.MatchString(name) {
+			return nil, fmt.Errorf("invalid flag name %q", name)
+		}
+		percent := 100
+		switch rollout = strings.ToLower(strings.TrimSpace(rollout)); {
+		case !hasRollout || rollout == "on" || rollout == "true":
+		case rollout == "off" || rollout == "false":
+			percent = 0
+		default:
+			n, err := strconv.Atoi(strings.TrimSuffix(rollout, "%"))
+			if err != nil || n < 0 || n > 100 {
+				return nil, fmt.Errorf("%s: rollout %q must be on, off or a percentage from 0 to 100", name, rollout)
+			}
+			percent = n
+		}
+		featureFlags[name] = percent
+	}
+	return featureFlags, nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,76 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestLoadJWTKeys(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "jwt_secrets")
+	if err := os.WriteFile(file, []byte("# rotated 2024-06\n2024:new-secret\n\n  2023:old-secret  \n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		env     map[string]string
+		want    []JWTKey
+		wantErr bool
+	}{
+		{"single secret", map[string]string{"JWT_SECRET": " s3cret "}, []JWTKey{{Secret: "s3cret"}}, false},
+		{"single secret keeps its colon", map[string]string{"JWT_SECRET": "a:b"}, []JWTKey{{Secret: "a:b"}}, false},
+		{
+			"list",
+			map[string]string{"JWT_SECRETS": "2024:new, legacy ,", "JWT_SECRET": "ignored"},
+			[]JWTKey{{ID: "2024", Secret: "new"}, {Secret: "legacy"}},
+			false,
+		},
+		{"invalid kid is part of the secret", map[string]string{"JWT_SECRETS": "not a kid:secret"}, []JWTKey{{Secret: "not a kid:secret"}}, false},
+		{"empty secret after the kid", map[string]string{"JWT_SECRETS": "2024:"}, []JWTKey{{Secret: "2024:"}}, false},
+		{
+			"file first",
+			map[string]string{"JWT_SECRETS_FILE": file, "JWT_SECRETS": "ignored"},
+			[]JWTKey{{ID: "2024", Secret: "new-secret"}, {ID: "2023", Secret: "old-secret"}},
+			false,
+		},
+		{"missing file", map[string]string{"JWT_SECRETS_FILE": file + ".missing"}, nil, true},
+		{"nothing set", map[string]string{"JWT_SECRETS": "  "}, []JWTKey{}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lookup := func(key string) (string, bool) {
+				value, ok := tt.env[key]
+				return value, ok
+			}
+			got, err := LoadJWTKeys(lookup)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("LoadJWTKeys() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("LoadJWTKeys() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckJWTKeys(t *testing.T) {
+	tests := []struct {
+		name    string
+		keys    []JWTKey
+		wantErr bool
+	}{
+		{"none", nil, true},
+		{"unnamed keys", []JWTKey{{Secret: "a"}, {Secret: "b"}}, false},
+		{"distinct kids", []JWTKey{{ID: "1", Secret: "a"}, {ID: "2", Secret: "b"}}, false},
+		{"kid listed twice", []JWTKey{{ID: "1", Secret: "a"}, {ID: "1", Secret: "b"}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := CheckJWTKeys(tt.keys); (err != nil) != tt.wantErr {
+				t.Errorf("CheckJWTKeys() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
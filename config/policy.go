@@ -0,0 +1,181 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RetryableCodes are the gRPC codes a policy may retry on, as RETRY_ON accepts them
+var RetryableCodes = []string{"UNAVAILABLE", "DEADLINE_EXCEEDED", "RESOURCE_EXHAUSTED", "ABORTED", "INTERNAL", "UNKNOWN"}
+
+// maxPolicyRetries bounds MaxRetries so one request can't multiply into a storm
+const maxPolicyRetries = 5
+
+// UpstreamPolicy is how the backend calls made for a route behave
+type UpstreamPolicy struct {
+	// Timeout is the deadline for the whole request's backend calls; 0 leaves it to the handler
+	Timeout time.Duration `json:"timeout"`
+	// MaxRetries is how many times a failed call is repeated when its code is in RetryOn
+	MaxRetries int      `json:"max_retries"`
+	RetryOn    []string `json:"retry_on"`
+}
+
+// PolicyTable maps route names and route groups to upstream policies. A route
+// named jobs_application_id_status uses the first of that name, jobs_application_id,
+// jobs_application and jobs that has a policy, and Default otherwise.
+type PolicyTable struct {
+	Default UpstreamPolicy
+	Routes  map[string]UpstreamPolicy
+}
+
+// PolicyName is the name a gin route template is known by in the policy table
+// and in POLICY_<name>_* variables: its segments lowercased and joined with _,
+// with : and * dropped from parameters, e.g. /jobs/application/:id/status is
+// jobs_application_id_status
+func PolicyName(path string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(strings.Trim(path, "/")) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			b.WriteRune(r)
+		case r == ':' || r == '*':
+		default:
+			b.WriteRune('_')
+		}
+	}
+	return b.String()
+}
+
+// For returns the policy for a route name and the table entry it came from,
+// "default" when none matched
+func (t PolicyTable) For(name string) (UpstreamPolicy, string) {
+	return routeEntry(t.Routes, t.Default, name)
+}
+
+// routeEntry finds the entry for a route name in a table keyed by route names
+// and groups: the name itself, then each shorter group it belongs to, then
+// fallback under the key "default"
+func routeEntry[T any](routes map[string]T, fallback T, name string) (T, string) {
+	for key := name; key != ""; {
+		if entry, ok := routes[key]; ok {
+			return entry, key
+		}
+		i := strings.LastIndex(key, "_")
+		if i < 0 {
+			break
+		}
+		key = key[:i]
+	}
+	return fallback, "default"
+}
+
+// defaultPolicies are the built-in route policies; POLICY_* variables override them
+func defaultPolicies() PolicyTable {
+	return PolicyTable{
+		Default: UpstreamPolicy{Timeout: 10 * time.Second, RetryOn: []string{"UNAVAILABLE"}},
+		Routes: map[string]UpstreamPolicy{
+			// Logins fail fast rather than keep a user waiting on a struggling backend
+			"auth_candidate_login": {Timeout: time.Second},
+			"auth_employer_login":  {Timeout: time.Second},
+			"auth_candidate_upload_resume": {
+				Timeout: 30 * time.Second, RetryOn: []string{"UNAVAILABLE"},
+			},
+			// Dashboards degrade section by section, so a retry is cheap
+			"me_dashboard":        {Timeout: 3 * time.Second, MaxRetries: 1, RetryOn: []string{"UNAVAILABLE", "DEADLINE_EXCEEDED"}},
+			"jobs_employer_stats": {Timeout: 3 * time.Second, MaxRetries: 1, RetryOn: []string{"UNAVAILABLE", "DEADLINE_EXCEEDED"}},
+			// Long-running routes bound their own work
+			"auth_candidate_export":                          {RetryOn: []string{"UNAVAILABLE"}},
+			"chat_notification_chat_conversations_id_export": {RetryOn: []string{"UNAVAILABLE"}},
+			"chat_notification_chat_bulk_send":               {Timeout: time.Minute},
+			"jobs_bulk":                                      {},
+		},
+	}
+}
+
+// policyOverrides applies POLICY_<name>_TIMEOUT, _RETRIES and _RETRY_ON from
+// keys, the names of the variables set, on top of the policy the route would
+// otherwise use. Groups are applied before the routes in them, so a route
+// override inherits its group's.
+func policyOverrides(table *PolicyTable, keys []string, lookup func(string) (string, bool)) []error {
+	type override struct{ key, name, field string }
+	var (
+		errs      []error
+		overrides []override
+	)
+	for _, key := range keys {
+		if !strings.HasPrefix(key, "POLICY_") {
+			continue
+		}
+		rest := strings.TrimPrefix(key, "POLICY_")
+		o := override{key: key}
+		for _, suffix := range []string{"_RETRY_ON", "_TIMEOUT", "_RETRIES"} {
+			if strings.HasSuffix(rest, suffix) {
+				o.name, o.field = strings.ToLower(strings.TrimSuffix(rest, suffix)), suffix[1:]
+				break
+			}
+		}
+		if o.name == "" {
+			errs = append(errs, fmt.Errorf("%s: expected POLICY_<route>_TIMEOUT, _RETRIES or _RETRY_ON", key))
+			continue
+		}
+		overrides = append(overrides, o)
+	}
+	sort.Slice(overrides, func(i, j int) bool {
+		a, b := overrides[i], overrides[j]
+		if (a.name == "default") != (b.name == "default") {
+			return a.name == "default"
+		}
+		if len(a.name) != len(b.name) {
+			return len(a.name) < len(b.name)
+		}
+		return a.key < b.key
+	})
+
+	for _, o := range overrides {
+		value, _ := lookup(o.key)
+		value = strings.TrimSpace(value)
+		policy := table.Default
+		if o.name != "default" {
+			policy, _ = table.For(o.name)
+		}
+		switch o.field {
+		case "TIMEOUT":
+			d, err := time.ParseDuration(value)
+			if err != nil || d < 0 {
+				errs = append(errs, fmt.Errorf("%s: %q must be a duration such as 5s, or 0 for none", o.key, value))
+				continue
+			}
+			policy.Timeout = d
+		case "RETRIES":
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 0 || n > maxPolicyRetries {
+				errs = append(errs, fmt.Errorf("%s: %q must be a number from 0 to %d", o.key, value, maxPolicyRetries))
+				continue
+			}
+			policy.MaxRetries = n
+		case "RETRY_ON":
+			codes := []string{}
+			for _, code := range strings.Split(value, ",") {
+				code = strings.ToUpper(strings.TrimSpace(code))
+				if code == "" {
+					continue
+				}
+				if !contains(RetryableCodes, code) {
+					errs = append(errs, fmt.Errorf("%s: unknown code %q, expected some of %s", o.key, code, strings.Join(RetryableCodes, ", ")))
+					continue
+				}
+				codes = append(codes, code)
+			}
+			policy.RetryOn = codes
+		}
+		if o.name == "default" {
+			table.Default = policy
+		} else {
+			table.Routes[o.name] = policy
+		}
+	}
+	return errs
+}
@@ -0,0 +1,116 @@
+package config
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// defaultProxyTimeout applies to proxy routes that don't set their own
+const defaultProxyTimeout = 30 * time.Second
+
+// ProxyRoute forwards every request under Prefix to a backend that still speaks REST
+type ProxyRoute struct {
+	Prefix string `yaml:"prefix"`
+	// Target is the backend base URL; its path is prepended to the forwarded path
+	Target string `yaml:"target"`
+	// KeepPrefix forwards the path unchanged instead of stripping Prefix
+	KeepPrefix bool `yaml:"keep_prefix"`
+	RequireJWT bool `yaml:"require_jwt"`
+	// Timeout bounds how long the backend may take to send response headers
+	Timeout time.Duration `yaml:"timeout"`
+}
+
+// parseProxyRoutes reads PROXY_ROUTES: comma separated "<prefix>=><target>" entries,
+// each optionally followed by ";jwt", ";keep_prefix" or ";timeout=<duration>".
+// $VARS in targets are expanded with lookup.
+func parseProxyRoutes(value string, lookup func(string) (string, bool)) ([]ProxyRoute, error) {
+	var proxyRoutes []ProxyRoute
+	for _, entry := range strings.Split(value, ",") {
+		if entry = strings.TrimSpace(entry); entry == "" {
+			continue
+		}
+		options := strings.Split(entry, ";")
+		prefix, target, ok := strings.Cut(options[0], "=>")
+		if !ok {
+			return nil, fmt.Errorf("%q must look like /prefix=>http://host:port", entry)
+		}
+		route := ProxyRoute{
+			Prefix: strings.TrimSpace(prefix),
+			Target: expandTarget(target, lookup),
+		}
+		for _, option := range options[1:] {
+			name, arg, _ := strings.Cut(strings.TrimSpace(option), "=")
+			switch name {
+			case "jwt":
+				route.RequireJWT = true
+			case "keep_prefix":
+				route.KeepPrefix = true
+			case "timeout":
+				timeout, err := time.ParseDuration(arg)
+				if err != nil {
+					return nil, fmt.Errorf("%q: invalid timeout %q", entry, arg)
+				}
+				route.Timeout = timeout
+			default:
+				return nil, fmt.Errorf("%q: unknown option %q, expected jwt, keep_prefix or timeout=<duration>", entry, name)
+			}
+		}
+		proxyRoutes = append(proxyRoutes, route)
+	}
+	return proxyRoutes, nil
+}
+
+// loadProxyRoutesFile reads PROXY_ROUTES_FILE, a YAML list of routes
+func loadProxyRoutesFile(path string, lookup func(string) (string, bool)) ([]ProxyRoute, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var proxyRoutes []ProxyRoute
+	if err := yaml.Unmarshal(data, &proxyRoutes); err != nil {
+		return nil, err
+	}
+	for i := range proxyRoutes {
+		proxyRoutes[i].Target = expandTarget(proxyRoutes[i].Target, lookup)
+	}
+	return proxyRoutes, nil
+}
+
+// expandTarget substitutes $VARS so targets can reuse e.g. AUTH_HTTP_URL
+func expandTarget(target string, lookup func(string) (string, bool)) string {
+	return strings.TrimSpace(os.Expand(strings.TrimSpace(target), func(key string) string {
+		value, _ := lookup(key)
+		return value
+	}))
+}
+
+// validateProxyRoutes checks the prefixes and targets so a bad route stops startup
+// rather than failing its first request
+func validateProxyRoutes(proxyRoutes []ProxyRoute) []error {
+	var errs []error
+	for i, route := range proxyRoutes {
+		if !strings.HasPrefix(route.Prefix, "/") || route.Prefix == "/" || strings.HasSuffix(route.Prefix, "/") ||
+			strings.ContainsAny(route.Prefix, ":*?#") {
+			errs = append(errs, fmt.Errorf("PROXY_ROUTES: prefix %q must be a path such as /legacy/auth", route.Prefix))
+		}
+		target, err := url.Parse(route.Target)
+		if err != nil || (target.Scheme != "http" && target.Scheme != "https") || target.Host == "" || target.RawQuery != "" {
+			errs = append(errs, fmt.Errorf("PROXY_ROUTES: target %q for %s must be an http(s) URL without a query", route.Target, route.Prefix))
+		}
+		if route.Timeout < 0 {
+			errs = append(errs, fmt.Errorf("PROXY_ROUTES: timeout for %s must be positive", route.Prefix))
+		}
+		for _, other := range proxyRoutes[:i] {
+			if route.Prefix == other.Prefix || strings.HasPrefix(route.Prefix, other.Prefix+"/") ||
+				strings.HasPrefix(other.Prefix, route.Prefix+"/") {
+				errs = append(errs, fmt.Errorf("PROXY_ROUTES: prefixes %s and %s overlap", other.Prefix, route.Prefix))
+			}
+		}
+	}
+	return errs
+}
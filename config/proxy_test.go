@@ -0,0 +1,44 @@
+package config
+
+import (
+	"testing"
+	"time"
+)
+
+func TestProxyRoutePolicies(t *testing.T) {
+	tests := []struct {
+		name        string
+		env         map[string]string
+		route       string
+		wantTimeout time.Duration
+	}{
+		{"proxy routes have no overall deadline", map[string]string{}, "legacy_auth_path", 0},
+		{"POLICY_ overrides still apply", map[string]string{"POLICY_LEGACY_AUTH_TIMEOUT": "2m"}, "legacy_auth_path", 2 * time.Minute},
+		{"other routes keep the default", map[string]string{}, "jobs_get", 10 * time.Second},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := map[string]string{
+				"JWT_SECRET":   "test-secret-that-is-long-enough-for-validation",
+				"PROXY_ROUTES": "/legacy/auth=>http://auth.internal:8080",
+			}
+			for key, value := range tt.env {
+				env[key] = value
+			}
+			keys := make([]string, 0, len(env))
+			for key := range env {
+				keys = append(keys, key)
+			}
+			cfg, err := fromLookup(func(key string) (string, bool) {
+				value, ok := env[key]
+				return value, ok
+			}, keys)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if policy, _ := cfg.Policies.For(tt.route); policy.Timeout != tt.wantTimeout {
+				t.Errorf("timeout = %v, want %v", policy.Timeout, tt.wantTimeout)
+			}
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// minSLOWindow and maxSLOWindow bound SLO_WINDOW; outcomes are kept per minute
+	minSLOWindow = time.Minute
+	maxSLOWindow = time.Hour
+)
+
+// SLO is a route's service level objective
+type SLO struct {
+	// Latency is the p99 target: a request slower than it violates the objective,
+	// and more than 1% of requests doing so burns the budget. 0 sets no target.
+	Latency time.Duration `json:"latency"`
+	// ErrorBudget is the fraction of requests that may fail with a 5xx, e.g. 0.001
+	ErrorBudget float64 `json:"error_budget"`
+}
+
+// SLOTable maps route names and route groups to objectives, looked up like the
+// PolicyTable
+type SLOTable struct {
+	Default SLO
+	Routes  map[string]SLO
+	// Window is how many minutes of outcomes are kept for GET /admin/slo (SLO_WINDOW)
+	Window time.Duration
+}
+
+// For returns the objective for a route name and the table entry it came from,
+// "default" when none matched
+func (t SLOTable) For(name string) (SLO, string) {
+	return routeEntry(t.Routes, t.Default, name)
+}
+
+// defaultSLOs are the built-in objectives; SLO_* variables override them
+func defaultSLOs() SLOTable {
+	return SLOTable{
+		Default: SLO{Latency: time.Second, ErrorBudget: 0.001},
+		Routes: map[string]SLO{
+			"auth_candidate_login": {Latency: 500 * time.Millisecond, ErrorBudget: 0.001},
+			"auth_employer_login":  {Latency: 500 * time.Millisecond, ErrorBudget: 0.001},
+			// Dashboards fan out to every backend and degrade instead of failing
+			"me_dashboard":        {Latency: 2 * time.Second, ErrorBudget: 0.005},
+			"jobs_employer_stats": {Latency: 2 * time.Second, ErrorBudget: 0.005},
+			// Uploads, exports and bulk work take as long as their input
+			"auth_candidate_upload_resume":                   {ErrorBudget: 0.01},
+			"auth_candidate_export":                          {ErrorBudget: 0.01},
+			"chat_notification_chat_conversations_id_export": {ErrorBudget: 0.01},
+			"chat_notification_chat_bulk_send":               {ErrorBudget: 0.01},
+			"jobs_bulk":                                      {ErrorBudget: 0.01},
+		},
+		Window: 15 * time.Minute,
+	}
+}
+
+// sloOverrides applies SLO_<name>_LATENCY and SLO_<name>_ERROR_BUDGET from keys on
+// top of the objective the route would otherwise have, groups before the routes
+// in them like policyOverrides
+func sloOverrides(table *SLOTable, keys []string, lookup func(string) (string, bool)) []error {
+	type override struct{ key, name, field string }
+	var (
+		errs      []error
+		overrides []override
+	)
+	for _, key := range keys {
+		if !strings.HasPrefix(key, "SLO_") || key == "SLO_WINDOW" {
+			continue
+		}
+		rest := strings.TrimPrefix(key, "SLO_")
+		o := override{key: key}
+		for _, suffix := range []string{"_LATENCY", "_ERROR_BUDGET"} {
+			if strings.HasSuffix(rest, suffix) {
+				o.name, o.field = strings.ToLower(strings.TrimSuffix(rest, suffix)), suffix[1:]
+				break
+			}
+		}
+		if o.name == "" {
+			errs = append(errs, fmt.Errorf("%s: expected SLO_<route>_LATENCY or _ERROR_BUDGET", key))
+			continue
+		}
+		overrides = append(overrides, o)
+	}
+	sort.Slice(overrides, func(i, j int) bool {
+		a, b := overrides[i], overrides[j]
+		if (a.name == "default") != (b.name == "default") {
+			return a.name == "default"
+		}
+		if len(a.name) != len(b.name) {
+			return len(a.name) < len(b.name)
+		}
+		return a.key < b.key
+	})
+
+	for _, o := range overrides {
+		value, _ := lookup(o.key)
+		value = strings.TrimSpace(value)
+		objective := table.Default
+		if o.name != "default" {
+			objective, _ = table.For(o.name)
+		}
+		switch o.field {
+		case "LATENCY":
+			d, err := time.ParseDuration(value)
+			if err != nil || d < 0 {
+				errs = append(errs, fmt.Errorf("%s: %q must be a duration such as 300ms, or 0 for no target", o.key, value))
+				continue
+			}
+			objective.Latency = d
+		case "ERROR_BUDGET":
+			budget, err := strconv.ParseFloat(value, 64)
+			if err != nil || budget <= 0 || budget >= 1 {
+				errs = append(errs, fmt.Errorf("%s: %q must be a fraction between 0 and 1 such as 0.001", o.key, value))
+				continue
+			}
+			objective.ErrorBudget = budget
+		}
+		if o.name == "default" {
+			table.Default = objective
+		} else {
+			table.Routes[o.name] = objective
+		}
+	}
+	return errs
+}
@@ -0,0 +1,73 @@
+package config
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+)
+
+func TestSLOOverrides(t *testing.T) {
+	tests := []struct {
+		name     string
+		env      map[string]string
+		route    string
+		want     SLO
+		wantFrom string
+		wantErrs []string
+	}{
+		{"built in", nil, "auth_candidate_login", SLO{Latency: 500 * time.Millisecond, ErrorBudget: 0.001}, "auth_candidate_login", nil},
+		{"default", nil, "jobs_get", SLO{Latency: time.Second, ErrorBudget: 0.001}, "default", nil},
+		{
+			"default override",
+			map[string]string{"SLO_DEFAULT_LATENCY": "300ms", "SLO_WINDOW": "5m"},
+			"jobs_get", SLO{Latency: 300 * time.Millisecond, ErrorBudget: 0.001}, "default", nil,
+		},
+		{
+			"group then route",
+			map[string]string{"SLO_JOBS_ERROR_BUDGET": "0.02", "SLO_JOBS_BULK_LATENCY": "30s"},
+			"jobs_bulk", SLO{Latency: 30 * time.Second, ErrorBudget: 0.01}, "jobs_bulk", nil,
+		},
+		{
+			"group covers its routes",
+			map[string]string{"SLO_JOBS_ERROR_BUDGET": "0.02", "SLO_DEFAULT_LATENCY": "2s"},
+			"jobs_get", SLO{Latency: 2 * time.Second, ErrorBudget: 0.02}, "jobs", nil,
+		},
+		{"no latency target", map[string]string{"SLO_ME_DASHBOARD_LATENCY": "0"}, "me_dashboard", SLO{ErrorBudget: 0.005}, "me_dashboard", nil},
+		{
+			"invalid values",
+			map[string]string{"SLO_JOBS_LATENCY": "-1s", "SLO_JOBS_ERROR_BUDGET": "1", "SLO_JOBS_TIMEOUT": "1s"},
+			"jobs_get", SLO{Latency: time.Second, ErrorBudget: 0.001}, "default",
+			[]string{
+				"SLO_JOBS_TIMEOUT: expected SLO_<route>_LATENCY or _ERROR_BUDGET",
+				`SLO_JOBS_ERROR_BUDGET: "1" must be a fraction between 0 and 1 such as 0.001`,
+				`SLO_JOBS_LATENCY: "-1s" must be a duration such as 300ms, or 0 for no target`,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keys := make([]string, 0, len(tt.env))
+			for key := range tt.env {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			table := defaultSLOs()
+			errs := sloOverrides(&table, keys, func(key string) (string, bool) {
+				value, ok := tt.env[key]
+				return value, ok
+			})
+			var gotErrs []string
+			for _, err := range errs {
+				gotErrs = append(gotErrs, err.Error())
+			}
+			if !reflect.DeepEqual(gotErrs, tt.wantErrs) {
+				t.Errorf("sloOverrides() errors = %q, want %q", gotErrs, tt.wantErrs)
+			}
+			got, from := table.For(tt.route)
+			if got != tt.want || from != tt.wantFrom {
+				t.Errorf("For(%q) = %+v from %s, want %+v from %s", tt.route, got, from, tt.want, tt.wantFrom)
+			}
+		})
+	}
+}
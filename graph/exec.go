@@ -0,0 +1,338 @@
+package graph
+
+import (
+	"bytes"
+	"context"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"github.com/vektah/gqlparser/v2/validator"
+)
+
+//go:embed schema.graphqls
+var schemaSource string
+
+var schema = gqlparser.MustLoadSchema(&ast.Source{Name: "schema.graphqls", Input: schemaSource})
+
+// Response is what a query answers, as the GraphQL over HTTP spec lays it out
+type Response struct {
+	Data   json.RawMessage `json:"data,omitempty"`
+	Errors gqlerror.List   `json:"errors,omitempty"`
+}
+
+// Params are a request's query, operation and variables
+type Params struct {
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName"`
+	Variables     map[string]any `json:"variables"`
+}
+
+// prepare parses and validates params and applies the depth and complexity limits.
+// The errors are the client's, so the query is refused before anything runs.
+func prepare(params Params) (*ast.OperationDefinition, *ast.QueryDocument, map[string]any, gqlerror.List) {
+	doc, errs := gqlparser.LoadQueryWithRules(schema, params.Query, nil)
+	if len(errs) > 0 {
+		return nil, nil, nil, errs
+	}
+	operation := doc.Operations.ForName(params.OperationName)
+	if operation == nil {
+		if params.OperationName == "" {
+			return nil, nil, nil, gqlerror.List{gqlerror.Errorf("operationName is required when the query has several operations")}
+		}
+		return nil, nil, nil, gqlerror.List{gqlerror.Errorf("operation %s not found", params.OperationName)}
+	}
+	if operation.Operation != ast.Query {
+		return nil, nil, nil, gqlerror.List{gqlerror.Errorf("only queries are supported")}
+	}
+	vars, err := validator.VariableValues(schema, operation, params.Variables)
+	if err != nil {
+		return nil, nil, nil, gqlerror.List{gqlerror.WrapIfUnwrapped(err)}
+	}
+	if depth := selectionDepth(operation.SelectionSet, doc.Fragments, 0); depth > MaxDepth {
+		return nil, nil, nil, gqlerror.List{gqlerror.Errorf("query depth %d exceeds the limit of %d", depth, MaxDepth)}
+	}
+	if complexity := selectionComplexity(operation.SelectionSet, doc.Fragments, vars); complexity > MaxComplexity {
+		return nil, nil, nil, gqlerror.List{gqlerror.Errorf("operation has complexity %d, which exceeds the limit of %d", complexity, MaxComplexity)}
+	}
+	return operation, doc, vars, nil
+}
+
+func selectionDepth(selections ast.SelectionSet, fragments ast.FragmentDefinitionList, depth int) int {
+	deepest := depth
+	for _, selection := range selections {
+		var nested int
+		switch s := selection.(type) {
+		case *ast.Field:
+			if len(s.SelectionSet) == 0 {
+				nested = depth + 1
+			} else {
+				nested = selectionDepth(s.SelectionSet, fragments, depth+1)
+			}
+		case *ast.InlineFragment:
+			nested = selectionDepth(s.SelectionSet, fragments, depth)
+		case *ast.FragmentSpread:
+			// Fragments can't be recursive in a valid document, which is checked first
+			if fragment := fragments.ForName(s.Name); fragment != nil {
+				nested = selectionDepth(fragment.SelectionSet, fragments, depth)
+			}
+		}
+		if nested > deepest {
+			deepest = nested
+		}
+	}
+	return deepest
+}
+
+// selectionComplexity counts every selected field once per item it is resolved
+// for, fragments included, so a list's fields count once for each item its page
+// can hold
+func selectionComplexity(selections ast.SelectionSet, fragments ast.FragmentDefinitionList, vars map[string]any) int {
+	complexity := 0
+	for _, selection := range selections {
+		switch s := selection.(type) {
+		case *ast.Field:
+			complexity += 1 + listSize(s, vars)*selectionComplexity(s.SelectionSet, fragments, vars)
+		case *ast.InlineFragment:
+			complexity += selectionComplexity(s.SelectionSet, fragments, vars)
+		case *ast.FragmentSpread:
+			if fragment := fragments.ForName(s.Name); fragment != nil {
+				complexity += selectionComplexity(fragment.SelectionSet, fragments, vars)
+			}
+		}
+	}
+	return complexity
+}
+
+// listSize is how many items a field's selection is resolved for: one for an
+// object, the page its first: argument asks for on a paged list and
+// unpagedListSize on any other list
+func listSize(field *ast.Field, vars map[string]any) int {
+	if field.Definition == nil || field.Definition.Type.Elem == nil {
+		return 1
+	}
+	if field.Definition.Arguments.ForName("first") == nil {
+		return unpagedListSize
+	}
+	return first(intArg(field.ArgumentMap(vars)["first"]))
+}
+
+// Execute runs a prepared query. Fields of a selection are resolved concurrently,
+// so the employers of a list of jobs land in one loader batch.
+func (r *Resolver) Execute(ctx context.Context, params Params) (*Response, bool) {
+	operation, doc, vars, errs := prepare(params)
+	if errs != nil {
+		return &Response{Errors: errs}, false
+	}
+	e := &executor{resolver: r, vars: vars, fragments: doc.Fragments}
+	data, _ := e.selectionSet(ctx, "Query", nil, operation.SelectionSet, nil)
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		e.fail(nil, err)
+		encoded = []byte("null")
+	}
+	return &Response{Data: encoded, Errors: e.errors}, true
+}
+
+type executor struct {
+	resolver  *Resolver
+	vars      map[string]any
+	fragments ast.FragmentDefinitionList
+
+	mutex  sync.Mutex
+	errors gqlerror.List
+}
+
+func (e *executor) fail(path ast.Path, err error) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	e.errors = append(e.errors, gqlerror.WrapPath(append(ast.Path(nil), path...), err))
+}
+
+// object keeps a selection's fields in the order they were asked for
+type object struct {
+	keys   []string
+	values []any
+}
+
+func (o *object) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, key := range o.keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		name, _ := json.Marshal(key)
+		value, err := json.Marshal(o.values[i])
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(name)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// selectionSet resolves the fields of obj, a value of typeName. It returns false
+// when a non-null field came back null, which makes the whole object null.
+func (e *executor) selectionSet(ctx context.Context, typeName string, obj any, selections ast.SelectionSet, path ast.Path) (*object, bool) {
+	fields := e.collectFields(typeName, selections, nil)
+	result := &object{keys: make([]string, len(fields)), values: make([]any, len(fields))}
+	ok := make([]bool, len(fields))
+	var wg sync.WaitGroup
+	for i, field := range fields {
+		result.keys[i] = field.Alias
+		wg.Add(1)
+		go func(i int, field *ast.Field) {
+			defer wg.Done()
+			result.values[i], ok[i] = e.field(ctx, typeName, obj, field, append(append(ast.Path(nil), path...), ast.PathName(field.Alias)))
+		}(i, field)
+	}
+	wg.Wait()
+	for _, fieldOK := range ok {
+		if !fieldOK {
+			return nil, false
+		}
+	}
+	return result, true
+}
+
+// collectFields flattens fragments and drops fields skipped by @skip or @include.
+// Fields asked for twice under one name are merged, as validation allows.
+func (e *executor) collectFields(typeName string, selections ast.SelectionSet, fields []*ast.Field) []*ast.Field {
+	for _, selection := range selections {
+		switch s := selection.(type) {
+		case *ast.Field:
+			if !e.included(s.Directives) {
+				continue
+			}
+			merged := false
+			for i, field := range fields {
+				if field.Alias == s.Alias {
+					copied := *field
+					copied.SelectionSet = append(append(ast.SelectionSet(nil), field.SelectionSet...), s.SelectionSet...)
+					fields[i] = &copied
+					merged = true
+					break
+				}
+			}
+			if !merged {
+				fields = append(fields, s)
+			}
+		case *ast.InlineFragment:
+			if e.included(s.Directives) && (s.TypeCondition == "" || s.TypeCondition == typeName) {
+				fields = e.collectFields(typeName, s.SelectionSet, fields)
+			}
+		case *ast.FragmentSpread:
+			fragment := e.fragments.ForName(s.Name)
+			if fragment != nil && e.included(s.Directives) && fragment.TypeCondition == typeName {
+				fields = e.collectFields(typeName, fragment.SelectionSet, fields)
+			}
+		}
+	}
+	return fields
+}
+
+func (e *executor) included(directives ast.DirectiveList) bool {
+	if skip := directives.ForName("skip"); skip != nil && skip.ArgumentMap(e.vars)["if"] == true {
+		return false
+	}
+	if include := directives.ForName("include"); include != nil && include.ArgumentMap(e.vars)["if"] != true {
+		return false
+	}
+	return true
+}
+
+// field resolves and completes one field. It returns false when a non-null field
+// ends up null.
+func (e *executor) field(ctx context.Context, typeName string, obj any, field *ast.Field, path ast.Path) (any, bool) {
+	if field.Name == "__typename" {
+		return typeName, true
+	}
+	if strings.HasPrefix(field.Name, "__") {
+		e.fail(path, errors.New("introspection is disabled"))
+		return nil, false
+	}
+	value, err := e.resolver.resolve(ctx, typeName, obj, field.Name, field.ArgumentMap(e.vars))
+	if err != nil {
+		e.fail(path, err)
+		return nil, !field.Definition.Type.NonNull
+	}
+	return e.complete(ctx, field, field.Definition.Type, value, path)
+}
+
+func (e *executor) complete(ctx context.Context, field *ast.Field, typ *ast.Type, value any, path ast.Path) (any, bool) {
+	if isNil(value) {
+		if typ.NonNull {
+			e.fail(path, errors.New("must not be null"))
+			return nil, false
+		}
+		return nil, true
+	}
+	if typ.Elem != nil {
+		items := reflect.ValueOf(value)
+		list := make([]any, items.Len())
+		ok := make([]bool, items.Len())
+		var wg sync.WaitGroup
+		for i := range list {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				list[i], ok[i] = e.complete(ctx, field, typ.Elem, items.Index(i).Interface(), append(append(ast.Path(nil), path...), ast.PathIndex(i)))
+			}(i)
+		}
+		wg.Wait()
+		for _, itemOK := range ok {
+			if !itemOK {
+				return nil, !typ.NonNull
+			}
+		}
+		return list, true
+	}
+	if len(field.SelectionSet) == 0 {
+		return value, true
+	}
+	result, ok := e.selectionSet(ctx, typ.NamedType, value, field.SelectionSet, path)
+	if !ok {
+		return nil, !typ.NonNull
+	}
+	return result, true
+}
+
+func isNil(value any) bool {
+	if value == nil {
+		return true
+	}
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Slice, reflect.Map, reflect.Interface:
+		return v.IsNil()
+	}
+	return false
+}
+
+// modelField reads the field of a model struct whose json tag is name
+func modelField(obj any, name string) (any, error) {
+	v := reflect.ValueOf(obj)
+	if v.Kind() == reflect.Pointer {
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("no field %s on %T", name, obj)
+	}
+	for i := 0; i < v.NumField(); i++ {
+		if tag, _, _ := strings.Cut(v.Type().Field(i).Tag.Get("json"), ","); tag == name {
+			return v.Field(i).Interface(), nil
+		}
+	}
+	return nil, fmt.Errorf("no field %s on %T", name, obj)
+}
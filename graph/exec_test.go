@@ -0,0 +1,266 @@
+package graph
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+
+	authpb "github.com/shahal0/skillsync-protos/gen/authpb"
+	jobpb "github.com/shahal0/skillsync-protos/gen/jobpb"
+	"github.com/vektah/gqlparser/v2"
+	"google.golang.org/grpc"
+
+	"skillsync-api-gateway/clients"
+)
+
+type fakeJobs struct {
+	jobpb.JobServiceClient
+	jobs []*jobpb.Job
+}
+
+func (f *fakeJobs) GetJobs(context.Context, *jobpb.GetJobsRequest, ...grpc.CallOption) (*jobpb.GetJobsResponse, error) {
+	return &jobpb.GetJobsResponse{Jobs: f.jobs}, nil
+}
+
+// testResolver serves employers e1 and e2 and counts the batches they are fetched in
+func testResolver(t *testing.T) (*Resolver, *[][]string) {
+	t.Helper()
+	previous := clients.JobServiceClient
+	clients.JobServiceClient = &fakeJobs{jobs: []*jobpb.Job{
+		{Id: 1, Title: "Go developer", EmployerId: "e1", SalaryMin: 10},
+		{Id: 2, Title: "SRE", EmployerId: "e2"},
+		{Id: 3, Title: "Backend", EmployerId: "e1"},
+	}}
+	t.Cleanup(func() { clients.JobServiceClient = previous })
+
+	var mutex sync.Mutex
+	var batches [][]string
+	resolver := &Resolver{EmployerProfiles: func(_ context.Context, ids []string) map[string]*authpb.EmployerPublicProfileResponse {
+		mutex.Lock()
+		batches = append(batches, ids)
+		mutex.Unlock()
+		profiles := make(map[string]*authpb.EmployerPublicProfileResponse)
+		for _, id := range ids {
+			if id == "e1" || id == "e2" {
+				profiles[id] = &authpb.EmployerPublicProfileResponse{EmployerId: id, CompanyName: "Company " + id}
+			}
+		}
+		return profiles
+	}}
+	return resolver, &batches
+}
+
+func TestExecute(t *testing.T) {
+	tests := []struct {
+		name      string
+		params    Params
+		role      string
+		wantOK    bool
+		wantData  string
+		wantError string
+	}{
+		{
+			name:     "nested fields and aliases in order",
+			params:   Params{Query: `{ jobs(first: 2) { title id boss: employer { companyName } } }`},
+			wantOK:   true,
+			wantData: `{"jobs":[{"title":"Go developer","id":"1","boss":{"companyName":"Company e1"}},{"title":"SRE","id":"2","boss":{"companyName":"Company e2"}}]}`,
+		},
+		{
+			name: "fragments, variables and directives",
+			params: Params{
+				Query:     `query Q($n: Int, $salary: Boolean!) { jobs(first: $n) { ...F salaryMin @include(if: $salary) __typename } } fragment F on Job { title }`,
+				Variables: map[string]any{"n": json.Number("1"), "salary": false},
+			},
+			wantOK:   true,
+			wantData: `{"jobs":[{"title":"Go developer","__typename":"Job"}]}`,
+		},
+		{
+			name:     "unknown employer is null",
+			params:   Params{Query: `{ employer(id: "e9") { id } }`},
+			wantOK:   true,
+			wantData: `{"employer":null}`,
+		},
+		{
+			name:      "resolver error nulls a nullable field",
+			params:    Params{Query: `{ me { id } employer(id: "e2") { companyName } }`},
+			role:      "employer",
+			wantOK:    true,
+			wantData:  `{"me":null,"employer":{"companyName":"Company e2"}}`,
+			wantError: "only candidates can read this field",
+		},
+		{
+			name:      "resolver error in a non-null list nulls the data",
+			params:    Params{Query: `{ myApplications { id } }`},
+			role:      "employer",
+			wantOK:    true,
+			wantData:  `null`,
+			wantError: "only candidates can read this field",
+		},
+		{
+			name:      "syntax error",
+			params:    Params{Query: `{ jobs { id }`},
+			wantError: "Expected Name, found <EOF>",
+		},
+		{
+			name:      "unknown field",
+			params:    Params{Query: `{ jobs { salary } }`},
+			wantError: `Cannot query field "salary" on type "Job".`,
+		},
+		{
+			name:      "mutations aren't in the schema",
+			params:    Params{Query: `mutation { jobs { id } }`},
+			wantError: "Schema does not support operation type \"mutation\"",
+		},
+		{
+			name:      "operation name required",
+			params:    Params{Query: `query A { jobs { id } } query B { jobs { title } }`},
+			wantError: "operationName is required",
+		},
+		{
+			name:     "operation by name",
+			params:   Params{Query: `query A { jobs(first: 1) { id } } query B { jobs(first: 1) { title } }`, OperationName: "B"},
+			wantOK:   true,
+			wantData: `{"jobs":[{"title":"Go developer"}]}`,
+		},
+		{
+			name:      "bad variable",
+			params:    Params{Query: `query Q($n: Int) { jobs(first: $n) { id } }`, Variables: map[string]any{"n": "many"}},
+			wantError: "cannot use string as Int",
+		},
+		{
+			name:      "too deep",
+			params:    Params{Query: `{ myApplications { job { viewerApplication { job { viewerApplication { job { viewerApplication { job { id } } } } } } } } }`},
+			wantError: "query depth 9 exceeds the limit of 8",
+		},
+		{
+			name:      "introspection",
+			params:    Params{Query: `{ __schema { queryType { name } } }`},
+			wantOK:    true,
+			wantData:  `null`,
+			wantError: "introspection is disabled",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resolver, _ := testResolver(t)
+			ctx := resolver.WithRequest(context.Background(), Viewer{ID: "u1", Role: tt.role})
+			response, ok := resolver.Execute(ctx, tt.params)
+			if ok != tt.wantOK {
+				t.Fatalf("Execute() ok = %v, want %v (errors %v)", ok, tt.wantOK, response.Errors)
+			}
+			if string(response.Data) != tt.wantData {
+				t.Errorf("data = %s, want %s", response.Data, tt.wantData)
+			}
+			if tt.wantError == "" && len(response.Errors) > 0 {
+				t.Errorf("unexpected errors %v", response.Errors)
+			}
+			if tt.wantError != "" && !strings.Contains(response.Errors.Error(), tt.wantError) {
+				t.Errorf("errors = %v, want one containing %q", response.Errors, tt.wantError)
+			}
+		})
+	}
+}
+
+func TestExecuteBatchesEmployers(t *testing.T) {
+	resolver, batches := testResolver(t)
+	ctx := resolver.WithRequest(context.Background(), Viewer{ID: "u1", Role: "candidate"})
+	response, ok := resolver.Execute(ctx, Params{Query: `{ jobs { employer { id } } }`})
+	if !ok || len(response.Errors) > 0 {
+		t.Fatalf("Execute() = %v, %v", ok, response.Errors)
+	}
+	if len(*batches) != 1 || len((*batches)[0]) != 2 {
+		t.Errorf("employer batches = %v, want one batch of e1 and e2", *batches)
+	}
+}
+
+func TestSelectionLimits(t *testing.T) {
+	fields := strings.Repeat("id title ", 250)
+	jobFields := "id title description category location status salaryMin salaryMax experienceRequired"
+	employerFields := "id companyName logoUrl website industry location isVerified"
+	tests := []struct {
+		name       string
+		query      string
+		vars       map[string]any
+		depth      int
+		complexity int
+	}{
+		{"flat", `{ jobs { id title } }`, nil, 2, 41},
+		{"nested", `{ jobs { employer { companyName } } }`, nil, 3, 41},
+		{"fragment", `{ jobs { ...F } } fragment F on Job { id employer { id } }`, nil, 3, 61},
+		{"inline fragment", `{ jobs { ... on Job { id } } }`, nil, 2, 21},
+		{"object counted once", `{ job(id: 1) { id employer { id } } }`, nil, 3, 4},
+		{"scalar list", `{ me { skills } }`, nil, 2, 2},
+		{"unpaged list", `{ myApplications { job { employer { id } } } }`, nil, 4, 61},
+		{"wide", `{ job(id: 1) { ` + fields + `} }`, nil, 2, 501},
+		{"page of jobs with employers", `{ jobs { ` + jobFields + ` employer { ` + employerFields + ` } } }`, nil, 3, 341},
+		{"100 job titles", `{ jobs(first: 100) { id title } }`, nil, 2, 201},
+		{"100 jobs with employers", `{ jobs(first: 100) { id employer { id companyName logoUrl website } } }`, nil, 3, 601},
+		{"first over the cap", `{ jobs(first: 1000) { id employer { id companyName logoUrl website } } }`, nil, 3, 601},
+		{"first from a variable", `query Q($n: Int) { jobs(first: $n) { id employer { id companyName logoUrl website } } }`, map[string]any{"n": int64(100)}, 3, 601},
+		{"small first from a variable", `query Q($n: Int) { jobs(first: $n) { id employer { id companyName logoUrl website } } }`, map[string]any{"n": int64(10)}, 3, 61},
+		{"nested pages", `{ jobs(first: 50) { id } conversations(first: 100) { id jobTitle } }`, nil, 2, 252},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, errs := gqlparser.LoadQuery(schema, tt.query)
+			if errs != nil {
+				t.Fatal(errs)
+			}
+			_, _, _, errs = prepare(Params{Query: tt.query, Variables: tt.vars})
+			operation := doc.Operations[0]
+			if got := selectionDepth(operation.SelectionSet, doc.Fragments, 0); got != tt.depth {
+				t.Errorf("depth = %d, want %d", got, tt.depth)
+			}
+			if got := selectionComplexity(operation.SelectionSet, doc.Fragments, tt.vars); got != tt.complexity {
+				t.Errorf("complexity = %d, want %d", got, tt.complexity)
+			}
+			if refused := errs != nil; refused != (tt.complexity > MaxComplexity) {
+				t.Errorf("prepare() errors = %v with complexity %d", errs, tt.complexity)
+			}
+		})
+	}
+}
+
+func TestHandler(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		target     string
+		body       string
+		wantStatus int
+		wantBody   string
+	}{
+		{"post", http.MethodPost, "/", `{"query":"{ jobs(first: 1) { id } }"}`, http.StatusOK, `{"data":{"jobs":[{"id":"1"}]}}`},
+		{"post with variables", http.MethodPost, "/", `{"query":"query Q($n: Int) { jobs(first: $n) { id } }","variables":{"n":2}}`, http.StatusOK, `{"data":{"jobs":[{"id":"1"},{"id":"2"}]}}`},
+		{"get", http.MethodGet, "/?query=" + url.QueryEscape(`query Q($n: Int) { jobs(first: $n) { id } }`) + "&variables=" + url.QueryEscape(`{"n":1}`), "", http.StatusOK, `{"data":{"jobs":[{"id":"1"}]}}`},
+		{"malformed body", http.MethodPost, "/", `{"query":`, http.StatusBadRequest, `{"errors":[{"message":"body must be a JSON object with a query"}]}`},
+		{"malformed variables", http.MethodGet, "/?query=%7Bjobs%7Bid%7D%7D&variables=1", "", http.StatusBadRequest, `{"errors":[{"message":"variables must be a JSON object"}]}`},
+		{"no query", http.MethodPost, "/", `{}`, http.StatusBadRequest, `{"errors":[{"message":"no query"}]}`},
+		{"invalid query", http.MethodPost, "/", `{"query":"{ nope }"}`, http.StatusUnprocessableEntity, ""},
+		{"other method", http.MethodPut, "/", "", http.StatusMethodNotAllowed, `{"errors":[{"message":"use GET or POST"}]}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resolver, _ := testResolver(t)
+			handler := Handler(resolver)
+			req := httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body))
+			req = req.WithContext(resolver.WithRequest(req.Context(), Viewer{ID: "u1", Role: "candidate"}))
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req)
+			if w.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d (%s)", w.Code, tt.wantStatus, w.Body)
+			}
+			if got := w.Header().Get("Content-Type"); got != "application/json" {
+				t.Errorf("Content-Type = %q", got)
+			}
+			if tt.wantBody != "" && strings.TrimSpace(w.Body.String()) != tt.wantBody {
+				t.Errorf("body = %s, want %s", w.Body, tt.wantBody)
+			}
+		})
+	}
+}
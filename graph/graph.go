@@ -0,0 +1,179 @@
+// Package graph serves read-only GraphQL over the gateway's gRPC clients.
+// Queries are parsed and validated against schema.graphqls by gqlparser and run
+// by a small executor that calls the resolvers in resolver.go.
+package graph
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	authpb "github.com/shahal0/skillsync-protos/gen/authpb"
+	chatpb "github.com/shahal0/skillsync-protos/gen/chatpb"
+	jobpb "github.com/shahal0/skillsync-protos/gen/jobpb"
+	notificationpb "github.com/shahal0/skillsync-protos/gen/notificationpb"
+	"google.golang.org/grpc/metadata"
+
+	"skillsync-api-gateway/graph/model"
+)
+
+const (
+	// MaxDepth and MaxComplexity bound a single query. A full page of 20 jobs
+	// with their employers fits; 100 jobs with their employers doesn't.
+	MaxDepth      = 8
+	MaxComplexity = 500
+
+	// MaxFirst caps the first: argument of list fields
+	MaxFirst = 100
+	// unpagedListSize is what a list without a first: argument counts as in a
+	// query's complexity
+	unpagedListSize = 20
+
+	employerLoaderWait  = 2 * time.Millisecond
+	employerLoaderBatch = 100
+)
+
+// Viewer is the authenticated caller, from the JWT
+type Viewer struct {
+	ID   string
+	Role string
+}
+
+// Resolver is the root resolver. EmployerProfiles looks up many employers at
+// once; it is what the per-request employer loader batches into.
+type Resolver struct {
+	EmployerProfiles func(ctx context.Context, ids []string) map[string]*authpb.EmployerPublicProfileResponse
+}
+
+// request is the state shared by the resolvers of one query
+type request struct {
+	viewer    Viewer
+	employers *Loader[string, *model.Employer]
+
+	// applications of the viewer by job ID, loaded once for viewerApplication
+	applicationsOnce sync.Once
+	applications     map[string]*model.Application
+	applicationsErr  error
+}
+
+type requestKey struct{}
+
+// WithRequest prepares ctx for one GraphQL request by viewer
+func (r *Resolver) WithRequest(ctx context.Context, viewer Viewer) context.Context {
+	state := &request{viewer: viewer}
+	state.employers = NewLoader(employerLoaderWait, employerLoaderBatch, func(ctx context.Context, ids []string) map[string]*model.Employer {
+		employers := make(map[string]*model.Employer, len(ids))
+		for id, profile := range r.EmployerProfiles(ctx, ids) {
+			employers[id] = employerModel(profile)
+		}
+		return employers
+	})
+	return context.WithValue(ctx, requestKey{}, state)
+}
+
+func requestFrom(ctx context.Context) *request {
+	state, _ := ctx.Value(requestKey{}).(*request)
+	if state == nil {
+		return &request{}
+	}
+	return state
+}
+
+// outgoing forwards the viewer's identity to the backends, like the REST handlers do
+func outgoing(ctx context.Context) context.Context {
+	viewer := requestFrom(ctx).viewer
+	return metadata.NewOutgoingContext(ctx, metadata.New(map[string]string{
+		"user-id": viewer.ID,
+		"role":    viewer.Role,
+	}))
+}
+
+// first applies a list field's first: argument
+func first(n *int) int {
+	if n == nil || *n <= 0 || *n > MaxFirst {
+		return MaxFirst
+	}
+	return *n
+}
+
+func jobModel(job *jobpb.Job) *model.Job {
+	return &model.Job{
+		ID:                 strconv.FormatUint(job.GetId(), 10),
+		Title:              job.GetTitle(),
+		Description:        job.GetDescription(),
+		Category:           job.GetCategory(),
+		Location:           job.GetLocation(),
+		Status:             job.GetStatus(),
+		SalaryMin:          int(job.GetSalaryMin()),
+		SalaryMax:          int(job.GetSalaryMax()),
+		ExperienceRequired: int(job.GetExperienceRequired()),
+		EmployerID:         job.GetEmployerId(),
+	}
+}
+
+func employerModel(profile *authpb.EmployerPublicProfileResponse) *model.Employer {
+	return &model.Employer{
+		ID:          profile.GetEmployerId(),
+		CompanyName: profile.GetCompanyName(),
+		LogoURL:     profile.GetLogoUrl(),
+		Website:     profile.GetWebsite(),
+		Industry:    profile.GetIndustry(),
+		Location:    profile.GetLocation(),
+		IsVerified:  profile.GetIsVerified(),
+	}
+}
+
+func candidateProfileModel(profile *authpb.CandidateProfileResponse) *model.CandidateProfile {
+	skills := make([]string, 0, len(profile.GetSkills()))
+	for _, skill := range profile.GetSkills() {
+		skills = append(skills, skill.GetSkill())
+	}
+	return &model.CandidateProfile{
+		ID:              profile.GetId(),
+		Name:            profile.GetName(),
+		Email:           profile.GetEmail(),
+		CurrentLocation: profile.GetCurrentLocation(),
+		Experience:      int(profile.GetExperience()),
+		Skills:          skills,
+		HasResume:       profile.GetResume() != "",
+		IsVerified:      profile.GetIsVerified(),
+	}
+}
+
+func applicationModel(application *jobpb.ApplicationResponse) *model.Application {
+	jobID := application.GetJobId()
+	if jobID == 0 {
+		jobID = application.GetJob().GetId()
+	}
+	return &model.Application{
+		ID:     strconv.FormatUint(application.GetId(), 10),
+		JobID:  strconv.FormatUint(jobID, 10),
+		Status: application.GetStatus(),
+	}
+}
+
+func conversationModel(conversation *chatpb.Conversation) *model.Conversation {
+	return &model.Conversation{
+		ID:          conversation.GetId(),
+		JobID:       conversation.GetJobId(),
+		JobTitle:    conversation.GetJobTitle(),
+		EmployerID:  conversation.GetEmployerId(),
+		CandidateID: conversation.GetCandidateId(),
+		UnreadCount: int(conversation.GetUnreadCount()),
+		Muted:       conversation.GetMuted(),
+		Archived:    conversation.GetArchived(),
+		Pinned:      conversation.GetPinned(),
+	}
+}
+
+func notificationModel(notification *notificationpb.Notification) *model.Notification {
+	return &model.Notification{
+		ID:       notification.GetId(),
+		Type:     notification.GetType(),
+		Title:    notification.GetTitle(),
+		Message:  notification.GetMessage(),
+		SourceID: notification.GetSourceId(),
+		IsRead:   notification.GetIsRead(),
+	}
+}
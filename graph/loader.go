@@ -0,0 +1,87 @@
+package graph
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Loader batches the keys requested within a short wait into one fetch and
+// remembers the results, so resolving the employer of every job in a list costs
+// one lookup per distinct employer. A Loader lives for one request.
+type Loader[K comparable, V any] struct {
+	fetch    func(ctx context.Context, keys []K) map[K]V
+	wait     time.Duration
+	maxBatch int
+
+	mutex sync.Mutex
+	batch *loaderBatch[K, V]
+	cache map[K]V
+}
+
+type loaderBatch[K comparable, V any] struct {
+	keys    []K
+	seen    map[K]bool
+	once    sync.Once
+	done    chan struct{}
+	results map[K]V
+}
+
+// NewLoader batches keys for up to wait, or until maxBatch keys are waiting.
+// fetch leaves out the keys it couldn't load.
+func NewLoader[K comparable, V any](wait time.Duration, maxBatch int, fetch func(ctx context.Context, keys []K) map[K]V) *Loader[K, V] {
+	return &Loader[K, V]{fetch: fetch, wait: wait, maxBatch: maxBatch, cache: make(map[K]V)}
+}
+
+// Load returns the value for key and whether it was found
+func (l *Loader[K, V]) Load(ctx context.Context, key K) (V, bool) {
+	l.mutex.Lock()
+	if value, ok := l.cache[key]; ok {
+		l.mutex.Unlock()
+		return value, true
+	}
+	b := l.batch
+	if b == nil {
+		b = &loaderBatch[K, V]{seen: make(map[K]bool), done: make(chan struct{})}
+		l.batch = b
+		time.AfterFunc(l.wait, func() { l.dispatch(ctx, b) })
+	}
+	if !b.seen[key] {
+		b.seen[key] = true
+		b.keys = append(b.keys, key)
+	}
+	if len(b.keys) >= l.maxBatch {
+		go l.dispatch(ctx, b)
+	}
+	l.mutex.Unlock()
+
+	select {
+	case <-b.done:
+	case <-ctx.Done():
+		var zero V
+		return zero, false
+	}
+	value, ok := b.results[key]
+	return value, ok
+}
+
+// dispatch runs a batch's fetch once, whichever of the timer or a full batch comes first
+func (l *Loader[K, V]) dispatch(ctx context.Context, b *loaderBatch[K, V]) {
+	b.once.Do(func() {
+		// Detach the batch so later keys start a new one
+		l.mutex.Lock()
+		if l.batch == b {
+			l.batch = nil
+		}
+		l.mutex.Unlock()
+
+		b.results = l.fetch(ctx, b.keys)
+
+		l.mutex.Lock()
+		for key, value := range b.results {
+			l.cache[key] = value
+		}
+		l.mutex.Unlock()
+		close(b.done)
+	})
+}
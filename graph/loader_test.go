@@ -0,0 +1,89 @@
+package graph
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestLoader(t *testing.T) {
+	tests := []struct {
+		name     string
+		maxBatch int
+		rounds   [][]string
+		want     [][]string
+	}{
+		{"one batch, duplicates once", 10, [][]string{{"a", "b", "a", "c"}}, [][]string{{"a", "b", "c"}}},
+		{"full batch dispatches early", 2, [][]string{{"a", "b", "c"}}, [][]string{{"a", "b"}, {"c"}}},
+		{"cached keys aren't fetched again", 10, [][]string{{"a", "b"}, {"a", "c"}}, [][]string{{"a", "b"}, {"c"}}},
+		{"missing keys are fetched again", 10, [][]string{{"a", "missing"}, {"missing"}}, [][]string{{"a", "missing"}, {"missing"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var mutex sync.Mutex
+			var fetched [][]string
+			loader := NewLoader(50*time.Millisecond, tt.maxBatch, func(_ context.Context, keys []string) map[string]string {
+				mutex.Lock()
+				fetched = append(fetched, append([]string(nil), keys...))
+				mutex.Unlock()
+				values := make(map[string]string)
+				for _, key := range keys {
+					if key != "missing" {
+						values[key] = "value " + key
+					}
+				}
+				return values
+			})
+			for _, round := range tt.rounds {
+				var wg sync.WaitGroup
+				for _, key := range round {
+					wg.Add(1)
+					go func(key string) {
+						defer wg.Done()
+						value, ok := loader.Load(context.Background(), key)
+						if wantOK := key != "missing"; ok != wantOK || (ok && value != "value "+key) {
+							t.Errorf("Load(%q) = %q, %v", key, value, ok)
+						}
+					}(key)
+					// Keep the keys in order so which batch a key lands in is known
+					time.Sleep(time.Millisecond)
+				}
+				wg.Wait()
+			}
+			if len(fetched) != len(tt.want) {
+				t.Fatalf("fetched %v, want %v", fetched, tt.want)
+			}
+			for i := range fetched {
+				sort.Strings(fetched[i])
+				if !equalKeys(fetched[i], tt.want[i]) {
+					t.Errorf("batch %d = %v, want %v", i, fetched[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestLoaderCancelled(t *testing.T) {
+	loader := NewLoader(time.Hour, 10, func(context.Context, []string) map[string]int {
+		return map[string]int{"a": 1}
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if value, ok := loader.Load(ctx, "a"); ok || value != 0 {
+		t.Errorf("Load() after cancel = %d, %v, want 0, false", value, ok)
+	}
+}
+
+func equalKeys(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
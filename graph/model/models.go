@@ -0,0 +1,66 @@
+// Package model holds the GraphQL types. The executor reads schema fields from
+// these structs by json tag; fields that call the backends are not here.
+package model
+
+type Job struct {
+	ID                 string `json:"id"`
+	Title              string `json:"title"`
+	Description        string `json:"description"`
+	Category           string `json:"category"`
+	Location           string `json:"location"`
+	Status             string `json:"status"`
+	SalaryMin          int    `json:"salaryMin"`
+	SalaryMax          int    `json:"salaryMax"`
+	ExperienceRequired int    `json:"experienceRequired"`
+
+	// EmployerID is resolved to Employer through the batching loader
+	EmployerID string `json:"-"`
+}
+
+type Employer struct {
+	ID          string `json:"id"`
+	CompanyName string `json:"companyName"`
+	LogoURL     string `json:"logoUrl"`
+	Website     string `json:"website"`
+	Industry    string `json:"industry"`
+	Location    string `json:"location"`
+	IsVerified  bool   `json:"isVerified"`
+}
+
+type CandidateProfile struct {
+	ID              string   `json:"id"`
+	Name            string   `json:"name"`
+	Email           string   `json:"email"`
+	CurrentLocation string   `json:"currentLocation"`
+	Experience      int      `json:"experience"`
+	Skills          []string `json:"skills"`
+	HasResume       bool     `json:"hasResume"`
+	IsVerified      bool     `json:"isVerified"`
+}
+
+type Application struct {
+	ID     string `json:"id"`
+	JobID  string `json:"jobId"`
+	Status string `json:"status"`
+}
+
+type Conversation struct {
+	ID          string `json:"id"`
+	JobID       string `json:"jobId"`
+	JobTitle    string `json:"jobTitle"`
+	EmployerID  string `json:"employerId"`
+	CandidateID string `json:"candidateId"`
+	UnreadCount int    `json:"unreadCount"`
+	Muted       bool   `json:"muted"`
+	Archived    bool   `json:"archived"`
+	Pinned      bool   `json:"pinned"`
+}
+
+type Notification struct {
+	ID       string `json:"id"`
+	Type     string `json:"type"`
+	Title    string `json:"title"`
+	Message  string `json:"message"`
+	SourceID string `json:"sourceId"`
+	IsRead   bool   `json:"isRead"`
+}
@@ -0,0 +1,231 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	chatpb "github.com/shahal0/skillsync-protos/gen/chatpb"
+	jobpb "github.com/shahal0/skillsync-protos/gen/jobpb"
+	notificationpb "github.com/shahal0/skillsync-protos/gen/notificationpb"
+
+	"skillsync-api-gateway/clients"
+	"skillsync-api-gateway/graph/model"
+	"skillsync-api-gateway/utils/profilecache"
+)
+
+var errCandidatesOnly = errors.New("only candidates can read this field")
+
+// resolve returns field of obj, a value of typeName: a resolver's result for the
+// fields that call the backends, or the model struct's field for the rest
+func (r *Resolver) resolve(ctx context.Context, typeName string, obj any, field string, args map[string]any) (any, error) {
+	query := &queryResolver{r}
+	switch typeName + "." + field {
+	case "Query.jobs":
+		return query.Jobs(ctx, stringArg(args["category"]), stringArg(args["keyword"]), stringArg(args["location"]), intArg(args["first"]))
+	case "Query.job":
+		id, _ := args["id"].(string)
+		return query.Job(ctx, id)
+	case "Query.employer":
+		id, _ := args["id"].(string)
+		return query.Employer(ctx, id)
+	case "Query.me":
+		return query.Me(ctx)
+	case "Query.myApplications":
+		return query.MyApplications(ctx)
+	case "Query.conversations":
+		return query.Conversations(ctx, intArg(args["first"]))
+	case "Query.notifications":
+		return query.Notifications(ctx, intArg(args["first"]))
+	case "Job.employer":
+		return (&jobResolver{r}).Employer(ctx, obj.(*model.Job))
+	case "Job.viewerApplication":
+		return (&jobResolver{r}).ViewerApplication(ctx, obj.(*model.Job))
+	case "Application.job":
+		return (&applicationResolver{r}).Job(ctx, obj.(*model.Application))
+	}
+	return modelField(obj, field)
+}
+
+func stringArg(value any) *string {
+	s, ok := value.(string)
+	if !ok {
+		return nil
+	}
+	return &s
+}
+
+// intArg reads an Int argument, which is an int64 from a literal or a variable
+func intArg(value any) *int {
+	n, ok := value.(int64)
+	if !ok {
+		return nil
+	}
+	i := int(n)
+	return &i
+}
+
+type queryResolver struct{ *Resolver }
+
+func (r *queryResolver) Jobs(ctx context.Context, category, keyword, location *string, n *int) ([]*model.Job, error) {
+	req := &jobpb.GetJobsRequest{}
+	if category != nil {
+		req.Category = *category
+	}
+	if keyword != nil {
+		req.Keyword = *keyword
+	}
+	if location != nil {
+		req.Location = *location
+	}
+	resp, err := clients.JobServiceClient.GetJobs(outgoing(ctx), req)
+	if err != nil {
+		return nil, err
+	}
+	jobs := resp.GetJobs()
+	if limit := first(n); len(jobs) > limit {
+		jobs = jobs[:limit]
+	}
+	result := make([]*model.Job, 0, len(jobs))
+	for _, job := range jobs {
+		result = append(result, jobModel(job))
+	}
+	return result, nil
+}
+
+func (r *queryResolver) Job(ctx context.Context, id string) (*model.Job, error) {
+	return loadJob(ctx, id)
+}
+
+func (r *queryResolver) Employer(ctx context.Context, id string) (*model.Employer, error) {
+	employer, _ := requestFrom(ctx).employers.Load(ctx, id)
+	return employer, nil
+}
+
+func (r *queryResolver) Me(ctx context.Context) (*model.CandidateProfile, error) {
+	if requestFrom(ctx).viewer.Role != "candidate" {
+		return nil, errCandidatesOnly
+	}
+	resp, err := profilecache.Get(outgoing(ctx), requestFrom(ctx).viewer.ID)
+	if err != nil {
+		return nil, err
+	}
+	return candidateProfileModel(resp), nil
+}
+
+func (r *queryResolver) MyApplications(ctx context.Context) ([]*model.Application, error) {
+	applications, err := viewerApplications(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*model.Application, 0, len(applications))
+	for _, application := range applications {
+		result = append(result, application)
+	}
+	return result, nil
+}
+
+func (r *queryResolver) Conversations(ctx context.Context, n *int) ([]*model.Conversation, error) {
+	chatClient, err := clients.GetChatClient()
+	if err != nil {
+		return nil, err
+	}
+	resp, err := chatClient.ListConversations(outgoing(ctx), &chatpb.ListConversationsRequest{
+		UserId: requestFrom(ctx).viewer.ID,
+		Page:   1,
+		Limit:  int32(first(n)),
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*model.Conversation, 0, len(resp.GetConversations()))
+	for _, conversation := range resp.GetConversations() {
+		result = append(result, conversationModel(conversation))
+	}
+	return result, nil
+}
+
+func (r *queryResolver) Notifications(ctx context.Context, n *int) ([]*model.Notification, error) {
+	notificationClient := clients.GetNotificationClient()
+	if notificationClient == nil {
+		return nil, errors.New("notification service unavailable")
+	}
+	resp, err := notificationClient.GetNotifications(outgoing(ctx), &notificationpb.GetNotificationsRequest{
+		UserId: requestFrom(ctx).viewer.ID,
+		Page:   1,
+		Limit:  int32(first(n)),
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*model.Notification, 0, len(resp.GetNotifications()))
+	for _, notification := range resp.GetNotifications() {
+		result = append(result, notificationModel(notification))
+	}
+	return result, nil
+}
+
+type jobResolver struct{ *Resolver }
+
+// Employer goes through the request's loader, so a list of jobs looks each employer up once
+func (r *jobResolver) Employer(ctx context.Context, obj *model.Job) (*model.Employer, error) {
+	if obj.EmployerID == "" {
+		return nil, nil
+	}
+	employer, _ := requestFrom(ctx).employers.Load(ctx, obj.EmployerID)
+	return employer, nil
+}
+
+// ViewerApplication is null for anyone but candidates
+func (r *jobResolver) ViewerApplication(ctx context.Context, obj *model.Job) (*model.Application, error) {
+	if requestFrom(ctx).viewer.Role != "candidate" {
+		return nil, nil
+	}
+	applications, err := viewerApplications(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return applications[obj.ID], nil
+}
+
+type applicationResolver struct{ *Resolver }
+
+func (r *applicationResolver) Job(ctx context.Context, obj *model.Application) (*model.Job, error) {
+	return loadJob(ctx, obj.JobID)
+}
+
+func loadJob(ctx context.Context, id string) (*model.Job, error) {
+	jobID, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return nil, errors.New("job id must be a positive integer")
+	}
+	resp, err := clients.JobServiceClient.GetJobById(outgoing(ctx), &jobpb.GetJobByIdRequest{JobId: jobID})
+	if err != nil {
+		return nil, err
+	}
+	if resp.GetJob() == nil {
+		return nil, nil
+	}
+	return jobModel(resp.GetJob()), nil
+}
+
+// viewerApplications loads the candidate's applications once per request, keyed by job ID
+func viewerApplications(ctx context.Context) (map[string]*model.Application, error) {
+	state := requestFrom(ctx)
+	if state.viewer.Role != "candidate" {
+		return nil, errCandidatesOnly
+	}
+	state.applicationsOnce.Do(func() {
+		resp, err := clients.JobServiceClient.GetApplications(outgoing(ctx), &jobpb.GetApplicationsRequest{CandidateId: state.viewer.ID})
+		if err != nil {
+			state.applicationsErr = err
+			return
+		}
+		state.applications = make(map[string]*model.Application, len(resp.GetApplications()))
+		for _, application := range resp.GetApplications() {
+			entry := applicationModel(application)
+			state.applications[entry.JobID] = entry
+		}
+	})
+	return state.applications, state.applicationsErr
+}
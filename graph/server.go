@@ -0,0 +1,73 @@
+package graph
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// maxBody bounds a POSTed query
+const maxBody = 1 << 20
+
+// Handler serves queries over GET and POST with the depth and complexity limits.
+// Requests must be prepared with WithRequest.
+func Handler(r *Resolver) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		var params Params
+		switch req.Method {
+		case http.MethodGet:
+			query := req.URL.Query()
+			params.Query = query.Get("query")
+			params.OperationName = query.Get("operationName")
+			if variables := query.Get("variables"); variables != "" {
+				if err := decode(strings.NewReader(variables), &params.Variables); err != nil {
+					writeResponse(w, http.StatusBadRequest, &Response{Errors: gqlerror.List{gqlerror.Errorf("variables must be a JSON object")}})
+					return
+				}
+			}
+		case http.MethodPost:
+			if err := decode(http.MaxBytesReader(w, req.Body, maxBody), &params); err != nil {
+				writeResponse(w, http.StatusBadRequest, &Response{Errors: gqlerror.List{gqlerror.Errorf("body must be a JSON object with a query")}})
+				return
+			}
+		default:
+			w.Header().Set("Allow", "GET, POST")
+			writeResponse(w, http.StatusMethodNotAllowed, &Response{Errors: gqlerror.List{gqlerror.Errorf("use GET or POST")}})
+			return
+		}
+		if strings.TrimSpace(params.Query) == "" {
+			writeResponse(w, http.StatusBadRequest, &Response{Errors: gqlerror.List{gqlerror.Errorf("no query")}})
+			return
+		}
+
+		response, ok := r.Execute(req.Context(), params)
+		if !ok {
+			writeResponse(w, http.StatusUnprocessableEntity, response)
+			return
+		}
+		writeResponse(w, http.StatusOK, response)
+	})
+}
+
+// decode keeps numbers as json.Number, so Int variables arrive as integers
+func decode(body io.Reader, v any) error {
+	decoder := json.NewDecoder(body)
+	decoder.UseNumber()
+	return decoder.Decode(v)
+}
+
+func writeResponse(w http.ResponseWriter, status int, response *Response) {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		status = http.StatusInternalServerError
+		buf.Reset()
+		buf.WriteString(`{"errors":[{"message":"response could not be encoded"}]}`)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write(buf.Bytes())
+}
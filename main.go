@@ -1,61 +1,101 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"log"
 	"net/http"
 	"os"
+	"os/signal"
+	"syscall"
+
 	"skillsync-api-gateway/clients"
+	"skillsync-api-gateway/config"
+	"skillsync-api-gateway/middlewares"
 	"skillsync-api-gateway/routes"
-	"time"
+	"skillsync-api-gateway/testsupport/mock"
+	"skillsync-api-gateway/utils"
 
-	"github.com/gin-contrib/cors"
-	"github.com/gin-gonic/gin"
-	"github.com/joho/godotenv"
 	_ "net/http/pprof" // Import pprof for profiling
 )
 
 func main() {
-	// Load environment variables
-	if err := godotenv.Load(); err != nil {
-		log.Println("Warning: .env file not found, using environment variables")
+	// Load and validate configuration from the environment and .env
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatalf("Invalid configuration:\n%v", err)
 	}
+	middlewares.Configure(cfg)
+	utils.Configure(cfg)
+	routes.Configure(cfg)
+
+	// Register custom request validators
+	utils.RegisterValidators()
 
-	// Initialize gRPC clients
-	clients.InitClients()
-
-	// Create Gin router with default middleware
-	r := gin.Default()
-
-	r.Use(cors.New(cors.Config{
-		AllowOrigins:     []string{"*"}, // Allow all origins
-		AllowMethods:     []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
-		AllowHeaders:     []string{"Origin", "Content-Type", "Accept", "Authorization"},
-		ExposeHeaders:    []string{"Content-Length"},
-		AllowCredentials: true,
-		MaxAge:           12 * time.Hour,
-	}))
-
-	// Setup API routes
-	routes.SetupRoutes(r)     // Auth routes
-	routes.SetupJobRoutes(r)  // Job routes
-
-	// Get port from environment variable or use default
-	port := os.Getenv("PORT")
-	if port == "" {
-		port = "8008"
+	// Initialize gRPC clients, or serve sample data in process in mock mode
+	if cfg.MockMode {
+		backend, err := mock.Start()
+		if err != nil {
+			log.Fatalf("Mock mode: %v", err)
+		}
+		clients.Connect(backend.Conn, backend.Conn, backend.Conn)
+		log.Printf("MOCK MODE: no backends are called, responses come from sample data. Log in with any seeded account and password %q", mock.Password)
+	} else {
+		clients.InitClients(cfg)
 	}
 
+	// Flush usage counts in the background; stopped after the server below
+	meter := middlewares.UsageMeter()
+	meter.Start()
+
+	// Create Gin router with global middleware and all route groups
+	r := routes.NewRouter(cfg)
+
+	port := cfg.Port
+
 	// Start pprof HTTP server for profiling
 	go func() {
-		log.Println("Starting pprof profiling server on port 6062")
-		if err := http.ListenAndServe("localhost:6062", nil); err != nil {
+		log.Printf("Starting pprof profiling server on %s", cfg.PprofAddr)
+		if err := http.ListenAndServe(cfg.PprofAddr, nil); err != nil {
 			log.Printf("Pprof server failed: %v", err)
 		}
 	}()
 
 	// Start the server
-	log.Printf("Starting API Gateway server on port %s", port)
-	if err := r.Run(":" + port); err != nil {
-		log.Fatalf("Failed to start server: %v", err)
+	srv := &http.Server{Addr: ":" + port, Handler: r}
+	go func() {
+		log.Printf("Starting API Gateway server on port %s", port)
+		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Fatalf("Failed to start server: %v", err)
+		}
+	}()
+
+	// SIGHUP reloads the JWT secrets, e.g. after JWT_SECRETS_FILE was rotated
+	hangup := make(chan os.Signal, 1)
+	signal.Notify(hangup, syscall.SIGHUP)
+	go func() {
+		for range hangup {
+			if _, err := middlewares.ReloadJWTKeys(); err != nil {
+				log.Printf("JWT keys not reloaded, keeping the current ones: %v", err)
+			}
+		}
+	}()
+
+	// On SIGINT or SIGTERM, finish in-flight requests, then send queued notifications
+	stop, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+	<-stop.Done()
+	log.Printf("Shutting down, waiting up to %s", cfg.ShutdownTimeout)
+
+	ctx, cancelShutdown := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+	defer cancelShutdown()
+	if err := srv.Shutdown(ctx); err != nil {
+		log.Printf("Server shutdown: %v", err)
+	}
+	if err := meter.Stop(ctx); err != nil {
+		log.Printf("Usage counts lost on shutdown: %v", err)
+	}
+	if err := routes.Shutdown(ctx); err != nil {
+		log.Printf("Queued notifications not sent before the shutdown deadline: %v", err)
 	}
 }
@@ -3,7 +3,6 @@ package middlewares
 import (
 	"log"
 	"net/http"
-	"os"
 	"strings"
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v5"
@@ -13,6 +12,7 @@ func JWTMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		// Log the request path to help with debugging
 		log.Printf("JWT Middleware: Processing request for path: %s", c.Request.URL.Path)
+		NoStore(c)
 		
 		authorizationHeader := c.GetHeader("Authorization")
 		if authorizationHeader == "" {
@@ -20,51 +20,56 @@ func JWTMiddleware() gin.HandlerFunc {
 			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Missing authorization header"})
 			return
 		}
-		log.Printf("JWT Middleware: Authorization header found: %s", authorizationHeader)
 
 		// Check if the Authorization header has the Bearer prefix
 		parts := strings.Split(authorizationHeader, " ")
 		if len(parts) != 2 || parts[0] != "Bearer" {
-			log.Printf("JWT Middleware ERROR: Invalid Authorization format. Got: %s", authorizationHeader)
+			log.Printf("JWT Middleware ERROR: Invalid Authorization format")
 			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Authorization header must be in format 'Bearer {token}'"})
 			return
 		}
 
 		// Extract the actual token
 		tokenString := parts[1]
-		log.Printf("JWT Middleware: Token extracted: %s", tokenString)
 
-		jwtSecret := os.Getenv("JWT_SECRET")
-		if jwtSecret == "" {
-			jwtSecret = "your_jwt_secret" 
-			log.Printf("JWT_SECRET environment variable not set, using fallback secret")
-		}
-		log.Printf("JWT Middleware: Using secret key: %s", jwtSecret)
-
-		// Parse and validate the token
-		token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-			return []byte(jwtSecret), nil
-		})
-		if err != nil {
-			log.Printf("JWT Middleware ERROR: Token parsing failed: %v", err)
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Invalid token: " + err.Error()})
+		// Reject tokens revoked by logout or account deletion
+		if IsTokenBlacklisted(tokenString) {
+			log.Printf("JWT Middleware ERROR: Token has been revoked")
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Token has been revoked"})
 			return
 		}
-		if !token.Valid {
-			log.Printf("JWT Middleware ERROR: Token is invalid")
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Invalid token"})
-			return
+
+		// A token verified by an earlier request is taken from the cache
+		cacheKey := hashToken(tokenString)
+		claims, cached := verifiedTokens.get(cacheKey)
+		if !cached {
+			generation := verifiedTokens.start()
+
+			// Parse and validate the token against each accepted key
+			token, err := ParseToken(tokenString, jwt.MapClaims{})
+			if err != nil {
+				log.Printf("JWT Middleware ERROR: Token parsing failed: %v", err)
+				c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Invalid token: " + err.Error()})
+				return
+			}
+			if !token.Valid {
+				log.Printf("JWT Middleware ERROR: Token is invalid")
+				c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Invalid token"})
+				return
+			}
+			log.Printf("JWT Middleware: Token validated successfully")
+
+			var ok bool
+			claims, ok = token.Claims.(jwt.MapClaims)
+			if !ok {
+				log.Printf("JWT Middleware ERROR: Failed to extract claims from token")
+				c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Failed to extract claims from token"})
+				return
+			}
+			verifiedTokens.put(cacheKey, claims, generation)
 		}
-		log.Printf("JWT Middleware: Token validated successfully")
 
 		// Extract user ID from token claims and set it in the context
-		claims, ok := token.Claims.(jwt.MapClaims)
-		if !ok {
-			log.Printf("JWT Middleware ERROR: Failed to extract claims from token")
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Failed to extract claims from token"})
-			return
-		}
-		log.Printf("JWT Middleware: Claims extracted: %+v", claims)
 
 		userID, ok := claims["user_id"].(string)
 		if !ok {
@@ -76,14 +81,49 @@ func JWTMiddleware() gin.HandlerFunc {
 
 		// Set user ID in context for downstream handlers
 		c.Set("user_id", userID)
+
+		// Tokens from a session revoked on another device are rejected too
+		if sessionID, ok := claims["sid"].(string); ok {
+			if IsSessionRevoked(sessionID) {
+				log.Printf("JWT Middleware ERROR: Session has been revoked")
+				c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Session has been revoked"})
+				return
+			}
+			c.Set("session_id", sessionID)
+		}
+
+		// Support staff acting as the user are recorded alongside them
+		if impersonatorID, ok := claims["impersonator_id"].(string); ok && impersonatorID != "" {
+			log.Printf("JWT Middleware: Request made by admin %s impersonating user %s", impersonatorID, userID)
+			c.Set("impersonator_id", impersonatorID)
+		}
+
+		// Tokens that carry the email let handlers check it without a profile lookup
+		if email, ok := claims["email"].(string); ok {
+			c.Set("user_email", email)
+		}
+
+		// Keep the raw token and its expiry so handlers can revoke it
+		c.Set("token", tokenString)
+		if exp, err := claims.GetExpirationTime(); err == nil && exp != nil {
+			c.Set("token_exp", exp.Time)
+		}
 		
 		// Extract and set role in context if available
 		if role, ok := claims["role"].(string); ok {
 			c.Set("user_role", role)
 			log.Printf("JWT Middleware: Role extracted and set in context: %s", role)
 		}
+
+		// Employer team members act for the company account they belong to
+		if !setTeamClaims(c, claims, userID) {
+			return
+		}
 		
 		log.Printf("JWT Middleware: Authentication successful, proceeding to handler")
+		if !meterUsage(c) {
+			return
+		}
 
 		c.Next()
 	}
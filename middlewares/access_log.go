@@ -0,0 +1,221 @@
+package middlewares
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"math/rand"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/gin-gonic/gin"
+
+	"skillsync-api-gateway/config"
+	"skillsync-api-gateway/utils/flags"
+)
+
+const redactedValue = "[REDACTED]"
+
+// sensitiveRouteParts keep a route out of body capture even if the allowlist matches it
+var sensitiveRouteParts = []string{"login", "password", "otp", "token", "verify", "callback", "api-keys", "2fa"}
+
+// sensitiveFieldParts are redacted wherever they appear in a JSON key
+var sensitiveFieldParts = []string{"password", "otp", "token", "secret", "authorization", "api_key", "apikey"}
+
+// sensitiveJSONPair catches "key": value pairs in bodies that aren't valid JSON, e.g. truncated ones
+var sensitiveJSONPair = regexp.MustCompile(`(?i)("[^"]*(?:password|otp|token|secret|authorization|api_?key)[^"]*"\s*:\s*)("(?:[^"\\]|\\.)*"?|[^,}\]\s]+)`)
+
+// AccessLog logs one line per request with the method, route template, status,
+// latency, a hash of the user ID and the request ID. Request and response bodies
+// are added for a sampled fraction of requests to allowlisted routes
+// (ACCESS_LOG_BODY_ROUTES, ACCESS_LOG_BODY_SAMPLE_RATE), truncated to
+// ACCESS_LOG_BODY_MAX_KB and with credentials redacted. Auth routes are never captured.
+func AccessLog() gin.HandlerFunc {
+	settings := cfg.AccessLog
+	maxBody := settings.MaxBodyKB << 10
+	return func(c *gin.Context) {
+		start := time.Now()
+		route := c.FullPath()
+
+		var requestBody []byte
+		var recorder *bodyRecorder
+		if captureBodies(settings, route) {
+			requestBody = peekRequestBody(c, maxBody)
+			recorder = &bodyRecorder{ResponseWriter: c.Writer, limit: maxBody}
+			c.Writer = recorder
+		}
+
+		c.Next()
+
+		if route == "" {
+			route = "-"
+		}
+		line := fmt.Sprintf("[ACCESS] method=%s route=%s status=%d latency=%s bytes=%d user=%s request_id=%s",
+			c.Request.Method, route, c.Writer.Status(), time.Since(start).Round(time.Microsecond),
+			max(c.Writer.Size(), 0), hashUserID(c.GetString("user_id")), c.GetString("request_id"))
+		if method := c.GetString("auth_method"); method != "" {
+			line += " auth=" + method
+		}
+		if impersonator := Impersonator(c); impersonator != "" {
+			line += " impersonator=" + hashUserID(impersonator)
+		}
+		if evaluated := flags.Evaluated(c); evaluated != "" {
+			line += " flags=" + evaluated
+		}
+		if errs := c.Errors.ByType(gin.ErrorTypePrivate).String(); errs != "" {
+			line += fmt.Sprintf(" errors=%q", strings.TrimSpace(errs))
+		}
+		if recorder != nil {
+			line += fmt.Sprintf(" req_body=%q resp_body=%q",
+				scrubBody(requestBody, maxBody), scrubBody(recorder.body.Bytes(), maxBody))
+		}
+		log.Print(line)
+	}
+}
+
+// captureBodies decides whether this request's bodies are logged
+func captureBodies(settings config.AccessLogConfig, route string) bool {
+	if settings.BodySampleRate <= 0 || route == "" || sensitiveRoute(route) {
+		return false
+	}
+	for _, allowed := range settings.BodyRoutes {
+		prefix, wildcard := strings.CutSuffix(allowed, "*")
+		if route == allowed || (wildcard && strings.HasPrefix(route, prefix)) {
+			return rand.Float64() < settings.BodySampleRate
+		}
+	}
+	return false
+}
+
+func sensitiveRoute(route string) bool {
+	route = strings.ToLower(route)
+	if strings.HasPrefix(route, "/auth/") {
+		return true
+	}
+	for _, part := range sensitiveRouteParts {
+		if strings.Contains(route, part) {
+			return true
+		}
+	}
+	return false
+}
+
+// peekRequestBody reads up to limit+1 bytes for the log and puts them back in front
+// of the rest of the body, so handlers still see the whole request
+func peekRequestBody(c *gin.Context, limit int) []byte {
+	if c.Request.Body == nil {
+		return nil
+	}
+	head, _ := io.ReadAll(io.LimitReader(c.Request.Body, int64(limit)+1))
+	c.Request.Body = readCloser{io.MultiReader(bytes.NewReader(head), c.Request.Body), c.Request.Body}
+	return head
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// bodyRecorder keeps the first limit+1 bytes written to the response
+type bodyRecorder struct {
+	gin.ResponseWriter
+	body  bytes.Buffer
+	limit int
+}
+
+func (w *bodyRecorder) Write(b []byte) (int, error) {
+	w.record(b)
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *bodyRecorder) WriteString(s string) (int, error) {
+	w.record([]byte(s))
+	return w.ResponseWriter.WriteString(s)
+}
+
+func (w *bodyRecorder) record(b []byte) {
+	if room := w.limit + 1 - w.body.Len(); room > 0 {
+		if len(b) > room {
+			b = b[:room]
+		}
+		w.body.Write(b)
+	}
+}
+
+// scrubBody redacts credentials and truncates the body to limit bytes
+func scrubBody(body []byte, limit int) string {
+	if len(body) == 0 {
+		return ""
+	}
+	truncated := len(body) > limit
+	if truncated {
+		body = body[:limit]
+		// The cut may split a character, which doesn't make the body binary
+		for i := 1; i < utf8.UTFMax && len(body) > 0 && !utf8.Valid(body); i++ {
+			body = body[:len(body)-1]
+		}
+	}
+	if !utf8.Valid(body) {
+		if truncated {
+			return fmt.Sprintf("[over %d bytes of binary data]", limit)
+		}
+		return fmt.Sprintf("[%d bytes of binary data]", len(body))
+	}
+
+	var out string
+	var doc interface{}
+	if !truncated && json.Unmarshal(body, &doc) == nil {
+		redacted, _ := json.Marshal(redactJSON(doc))
+		out = string(redacted)
+	} else {
+		out = sensitiveJSONPair.ReplaceAllString(string(body), `${1}"`+redactedValue+`"`)
+	}
+	if truncated {
+		out += "...[truncated]"
+	}
+	return out
+}
+
+// redactJSON replaces the value of every sensitive key, at any depth
+func redactJSON(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, inner := range v {
+			if sensitiveField(key) {
+				v[key] = redactedValue
+			} else {
+				v[key] = redactJSON(inner)
+			}
+		}
+	case []interface{}:
+		for i, inner := range v {
+			v[i] = redactJSON(inner)
+		}
+	}
+	return value
+}
+
+func sensitiveField(key string) bool {
+	key = strings.ToLower(key)
+	for _, part := range sensitiveFieldParts {
+		if strings.Contains(key, part) {
+			return true
+		}
+	}
+	return false
+}
+
+// hashUserID lets log lines for one user be correlated without logging the ID itself
+func hashUserID(userID string) string {
+	if userID == "" {
+		return "-"
+	}
+	sum := sha256.Sum256([]byte(userID))
+	return hex.EncodeToString(sum[:6])
+}
@@ -0,0 +1,122 @@
+package middlewares
+
+import (
+	"bytes"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"skillsync-api-gateway/config"
+)
+
+func TestScrubBody(t *testing.T) {
+	tests := []struct {
+		name  string
+		body  string
+		limit int
+		want  string
+	}{
+		{"empty", "", 100, ""},
+		{"nothing sensitive", `{"title":"Go developer"}`, 100, `{"title":"Go developer"}`},
+		{"top level", `{"email":"a@b.c","password":"hunter2"}`, 100, `{"email":"a@b.c","password":"[REDACTED]"}`},
+		{"key case and affixes", `{"newPassword":"x","Refresh_Token":"y","OTP":"123456"}`, 100, `{"OTP":"[REDACTED]","Refresh_Token":"[REDACTED]","newPassword":"[REDACTED]"}`},
+		{"numeric value", `{"otp":123456}`, 100, `{"otp":"[REDACTED]"}`},
+		{"nested", `{"user":{"auth":{"token":"abc"},"name":"Ada"}}`, 100, `{"user":{"auth":{"token":"[REDACTED]"},"name":"Ada"}}`},
+		{"object value", `{"secrets":{"a":"b"}}`, 100, `{"secrets":"[REDACTED]"}`},
+		{"inside arrays", `{"members":[{"id":1,"api_key":"k1"},{"id":2,"apiKey":"k2"}]}`, 100, `{"members":[{"api_key":"[REDACTED]","id":1},{"apiKey":"[REDACTED]","id":2}]}`},
+		{"top level array", `[{"password":"a"},[{"otp":"1"}]]`, 100, `[{"password":"[REDACTED]"},[{"otp":"[REDACTED]"}]]`},
+		{"not json", `password=hunter2`, 100, `password=hunter2`},
+		{"truncated inside a sensitive value", `{"email":"a@b.c","password":"hunter2hunter2"}`, 35, `{"email":"a@b.c","password":"[REDACTED]"...[truncated]`},
+		{"truncated inside a nested value", `{"items":[{"token":"abcdefghij"}]}`, 25, `{"items":[{"token":"[REDACTED]"...[truncated]`},
+		{"truncated inside a number", `{"otp":123456}`, 10, `{"otp":"[REDACTED]"...[truncated]`},
+		{"truncated with the secret whole", `{"password":"a\"b","name":"Ada Lovelace"}`, 30, `{"password":"[REDACTED]","name":"Ada...[truncated]`},
+		{"truncated mid-character", `{"name":"Zoë"}`, 12, `{"name":"Zo...[truncated]`},
+		{"binary", "\x89PNG\r\n\x1a\n\x00\x00\xff", 100, "[11 bytes of binary data]"},
+		{"truncated binary", "\x89PNG\r\n\x1a\n\x00\x00\xff\xfe\xfd", 12, "[over 12 bytes of binary data]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := scrubBody([]byte(tt.body), tt.limit); got != tt.want {
+				t.Errorf("scrubBody(%q, %d) = %q, want %q", tt.body, tt.limit, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSensitiveRoute(t *testing.T) {
+	tests := []struct {
+		route string
+		want  bool
+	}{
+		{"/auth/candidate/profile", true},
+		{"/AUTH/employer/signup", true},
+		{"/candidates/login", true},
+		{"/admin/users/:id/2fa/reset", true},
+		{"/me/interviews/feed-token", true},
+		{"/employer/api-keys", true},
+		{"/jobs/:id/verify", true},
+		{"/jobs/:id", false},
+		{"/chat-notification/chat/messages", false},
+		{"/authors", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.route, func(t *testing.T) {
+			if got := sensitiveRoute(tt.route); got != tt.want {
+				t.Errorf("sensitiveRoute(%q) = %v, want %v", tt.route, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestAccessLogNeverCapturesAuth allowlists every route and checks auth, login
+// and 2FA bodies still stay out of the log
+func TestAccessLogNeverCapturesAuth(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	previous := cfg.AccessLog
+	defer func() { cfg.AccessLog = previous }()
+	var logged bytes.Buffer
+	log.SetOutput(&logged)
+	defer log.SetOutput(os.Stderr)
+
+	tests := []struct {
+		name        string
+		allowlist   []string
+		route       string
+		wantCapture bool
+	}{
+		{"auth route under a wildcard", []string{"*"}, "/auth/candidate/login", false},
+		{"auth route allowlisted exactly", []string{"/auth/candidate/login"}, "/auth/candidate/login", false},
+		{"auth prefix allowlisted", []string{"/auth/*"}, "/auth/employer/profile/update", false},
+		{"login outside /auth", []string{"*"}, "/admin/login", false},
+		{"2fa outside /auth", []string{"/admin/*"}, "/admin/2fa/verify", false},
+		{"allowlisted route", []string{"/jobs/*"}, "/jobs/post", true},
+		{"not allowlisted", []string{"/jobs/*"}, "/applications/apply", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg.AccessLog = config.AccessLogConfig{BodySampleRate: 1, BodyRoutes: tt.allowlist, MaxBodyKB: 1}
+			r := gin.New()
+			r.Use(AccessLog())
+			r.POST(tt.route, func(c *gin.Context) { c.JSON(http.StatusOK, gin.H{"access_token": "t0ken"}) })
+
+			logged.Reset()
+			req := httptest.NewRequest(http.MethodPost, tt.route, strings.NewReader(`{"email":"a@b.c","password":"hunter2","otp":"123456"}`))
+			r.ServeHTTP(httptest.NewRecorder(), req)
+
+			line := logged.String()
+			if captured := strings.Contains(line, "req_body="); captured != tt.wantCapture {
+				t.Errorf("bodies captured = %v, want %v: %s", captured, tt.wantCapture, line)
+			}
+			for _, secret := range []string{"hunter2", "123456", "t0ken"} {
+				if strings.Contains(line, secret) {
+					t.Errorf("log line leaks %q: %s", secret, line)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package middlewares
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	authpb "github.com/shahal0/skillsync-protos/gen/authpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"skillsync-api-gateway/clients"
+	"skillsync-api-gateway/utils/cache"
+)
+
+const (
+	// APIKeyHeader carries a machine-to-machine key instead of a Bearer JWT
+	APIKeyHeader = "X-API-Key"
+
+	APIKeyScopeRead      = "read"
+	APIKeyScopeReadWrite = "read_write"
+
+	defaultAPIKeyRateLimit = 60
+	apiKeyLookupTimeout    = 2 * time.Second
+)
+
+// apiKeyCache avoids an auth service round trip on every keyed request.
+// Revocations on another gateway instance take effect once the entry expires.
+var apiKeyCache = cache.NewTTLCache[*authpb.ApiKey](60 * time.Second)
+
+// HashAPIKey is how keys are stored and looked up; the plaintext never leaves the gateway
+func HashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// InvalidateAPIKeyCache drops cached keys so a revocation applies immediately on this instance
+func InvalidateAPIKeyCache() {
+	apiKeyCache.Clear()
+}
+
+// JWTOrAPIKeyMiddleware authenticates with an X-API-Key when one is sent and falls back to
+// JWTMiddleware otherwise. Both paths set user_id and user_role; keyed requests also get
+// auth_method=api_key and api_key_id so they can be told apart in logs.
+func JWTOrAPIKeyMiddleware() gin.HandlerFunc {
+	jwtMiddleware := JWTMiddleware()
+	return func(c *gin.Context) {
+		if c.GetHeader(APIKeyHeader) == "" {
+			c.Set("auth_method", "jwt")
+			jwtMiddleware(c)
+			return
+		}
+		authenticateAPIKey(c)
+	}
+}
+
+// OptionalAPIKey authenticates and rate limits public routes when a key is sent, and lets
+// anonymous requests through unchanged
+func OptionalAPIKey() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.GetHeader(APIKeyHeader) == "" {
+			c.Next()
+			return
+		}
+		authenticateAPIKey(c)
+	}
+}
+
+func authenticateAPIKey(c *gin.Context) {
+	NoStore(c)
+	key, err := lookupAPIKey(c.GetHeader(APIKeyHeader))
+	if err != nil {
+		log.Printf("API key auth ERROR: %v", err)
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Invalid API key"})
+		return
+	}
+	if key.GetRevoked() {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "API key has been revoked"})
+		return
+	}
+
+	// Read-only keys may only use safe methods
+	if key.GetScope() != APIKeyScopeReadWrite {
+		switch c.Request.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+		default:
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "API key is read-only"})
+			return
+		}
+	}
+
+	limit := int(key.GetRateLimitPerMinute())
+	if limit <= 0 {
+		limit = defaultAPIKeyRateLimit
+	}
+	if allowed, retryAfter := apiKeyLimiter.allow(key.GetId(), limit); !allowed {
+		c.Header("Retry-After", strconv.Itoa(int(retryAfter.Seconds())+1))
+		c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{"error": "API key rate limit exceeded"})
+		return
+	}
+
+	log.Printf("API key auth: key=%s owner=%s role=%s %s %s", key.GetId(), key.GetOwnerId(), key.GetRole(), c.Request.Method, c.Request.URL.Path)
+	c.Set("user_id", key.GetOwnerId())
+	c.Set("user_role", key.GetRole())
+	c.Set("auth_method", "api_key")
+	c.Set("api_key_id", key.GetId())
+	if !meterUsage(c) {
+		return
+	}
+	c.Next()
+}
+
+func lookupAPIKey(plaintext string) (*authpb.ApiKey, error) {
+	hash := HashAPIKey(plaintext)
+	if key, ok := apiKeyCache.Get(hash); ok {
+		return key, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), apiKeyLookupTimeout)
+	defer cancel()
+	resp, err := clients.AuthServiceClient.GetApiKeyByHash(ctx, &authpb.GetApiKeyByHashRequest{KeyHash: hash})
+	if err != nil {
+		return nil, err
+	}
+	if resp.GetApiKey() == nil {
+		return nil, status.Error(codes.NotFound, "api key not found")
+	}
+	apiKeyCache.Set(hash, resp.GetApiKey())
+	return resp.GetApiKey(), nil
+}
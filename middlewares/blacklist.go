@@ -0,0 +1,101 @@
+package middlewares
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// tokenBlacklist keeps revoked tokens until they would have expired anyway
+type tokenBlacklist struct {
+	mutex   sync.RWMutex
+	entries map[string]time.Time
+}
+
+var blacklist = &tokenBlacklist{entries: make(map[string]time.Time)}
+
+// hashToken avoids keeping raw bearer tokens in memory
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// BlacklistToken revokes a token until its expiry time
+func BlacklistToken(token string, expiresAt time.Time) {
+	if token == "" {
+		return
+	}
+	if expiresAt.IsZero() {
+		expiresAt = time.Now().Add(24 * time.Hour)
+	}
+
+	blacklist.mutex.Lock()
+	defer blacklist.mutex.Unlock()
+
+	// Drop entries whose tokens have expired on their own
+	now := time.Now()
+	for key, exp := range blacklist.entries {
+		if now.After(exp) {
+			delete(blacklist.entries, key)
+		}
+	}
+	blacklist.entries[hashToken(token)] = expiresAt
+	verifiedTokens.remove(hashToken(token))
+}
+
+// IsTokenBlacklisted reports whether a token has been revoked
+func IsTokenBlacklisted(token string) bool {
+	blacklist.mutex.RLock()
+	defer blacklist.mutex.RUnlock()
+
+	exp, ok := blacklist.entries[hashToken(token)]
+	return ok && time.Now().Before(exp)
+}
+
+// RevokeCurrentToken blacklists the token that authenticated the current request
+func RevokeCurrentToken(c *gin.Context) {
+	token := c.GetString("token")
+	if token == "" {
+		return
+	}
+	var expiresAt time.Time
+	if exp, ok := c.Get("token_exp"); ok {
+		expiresAt, _ = exp.(time.Time)
+	}
+	BlacklistToken(token, expiresAt)
+}
+
+// sessionKey keeps revoked session IDs apart from token hashes in the same blacklist
+func sessionKey(sessionID string) string {
+	return "session:" + sessionID
+}
+
+// RevokeSession rejects every access token issued for a session (its "sid" claim)
+// until expiresAt, by which time they would have expired anyway
+func RevokeSession(sessionID string, expiresAt time.Time) {
+	if sessionID == "" {
+		return
+	}
+	if expiresAt.IsZero() {
+		expiresAt = time.Now().Add(24 * time.Hour)
+	}
+
+	blacklist.mutex.Lock()
+	defer blacklist.mutex.Unlock()
+	blacklist.entries[sessionKey(sessionID)] = expiresAt
+}
+
+// IsSessionRevoked reports whether a session has been revoked
+func IsSessionRevoked(sessionID string) bool {
+	if sessionID == "" {
+		return false
+	}
+	blacklist.mutex.RLock()
+	defer blacklist.mutex.RUnlock()
+
+	exp, ok := blacklist.entries[sessionKey(sessionID)]
+	return ok && time.Now().Before(exp)
+}
@@ -0,0 +1,119 @@
+package middlewares
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"expvar"
+	"hash"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"skillsync-api-gateway/config"
+	"skillsync-api-gateway/utils/cache"
+)
+
+// maxCallbackBodySize bounds a callback's body, which is read whole to check its signature
+const maxCallbackBodySize = 1 << 20
+
+// callbackMetrics are published on the pprof server at /debug/vars
+var callbackMetrics = expvar.NewMap("callbacks")
+
+var (
+	// callbackSignatures holds the signatures accepted within the tolerance, so each
+	// is only accepted once. Configure sizes it to the tolerance.
+	callbackSignatures     = cache.NewTTLCache[bool](2 * config.Default().Callbacks.Tolerance)
+	callbackSignaturesLock sync.Mutex
+)
+
+// callbackMAC returns the hex HMAC a provider signs "<timestamp>.<body>" with
+func callbackMAC(provider config.CallbackProvider, timestamp string, body []byte) string {
+	newHash := sha256.New
+	if provider.Algorithm == "sha512" {
+		newHash = func() hash.Hash { return sha512.New() }
+	}
+	mac := hmac.New(newHash, []byte(provider.Secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// rejectCallback aborts a callback, counting why
+func rejectCallback(c *gin.Context, status int, code, message string) {
+	callbackMetrics.Add("rejected_"+code, 1)
+	c.AbortWithStatusJSON(status, gin.H{"error": message, "error_code": code})
+}
+
+// CallbackSignature verifies a partner callback on a :provider route before its
+// handler runs. Providers that aren't enabled get 404 and bad signatures 401. A
+// callback whose timestamp is outside CALLBACK_TOLERANCE, or whose signature was
+// already accepted, is a replay and gets 409. The body is restored for the handler.
+// A callback the handler fails with a 5xx may be retried with the same signature.
+func CallbackSignature() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		name := strings.ToLower(c.Param("provider"))
+		provider, ok := cfg.Callbacks.Providers[name]
+		if !ok {
+			rejectCallback(c, http.StatusNotFound, "unknown_provider", "Unknown callback provider")
+			return
+		}
+
+		body, err := io.ReadAll(io.LimitReader(c.Request.Body, maxCallbackBodySize+1))
+		if err != nil {
+			rejectCallback(c, http.StatusBadRequest, "unreadable_body", "Failed to read request body")
+			return
+		}
+		if len(body) > maxCallbackBodySize {
+			rejectCallback(c, http.StatusRequestEntityTooLarge, "body_too_large", "Request body too large")
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		timestamp := strings.TrimSpace(c.GetHeader(provider.TimestampHeader))
+		signature := strings.ToLower(strings.TrimSpace(c.GetHeader(provider.SignatureHeader)))
+		signature = strings.TrimPrefix(signature, provider.Algorithm+"=")
+		expected := callbackMAC(provider, timestamp, body)
+		// Compared in constant time so the signature can't be guessed byte by byte
+		if timestamp == "" || !hmac.Equal([]byte(signature), []byte(expected)) {
+			rejectCallback(c, http.StatusUnauthorized, "invalid_signature", "Invalid callback signature")
+			return
+		}
+
+		seconds, err := strconv.ParseInt(timestamp, 10, 64)
+		if err != nil {
+			rejectCallback(c, http.StatusUnauthorized, "invalid_signature", "Invalid callback timestamp")
+			return
+		}
+		if age := time.Since(time.Unix(seconds, 0)); age > cfg.Callbacks.Tolerance || age < -cfg.Callbacks.Tolerance {
+			rejectCallback(c, http.StatusConflict, "callback_replayed", "Callback timestamp is outside the accepted window")
+			return
+		}
+		key := name + ":" + signature
+		callbackSignaturesLock.Lock()
+		_, seen := callbackSignatures.Get(key)
+		if !seen {
+			callbackSignatures.Set(key, true)
+		}
+		callbackSignaturesLock.Unlock()
+		if seen {
+			rejectCallback(c, http.StatusConflict, "callback_replayed", "Callback was already received")
+			return
+		}
+
+		callbackMetrics.Add("verified", 1)
+		c.Set("callback_provider", name)
+		c.Next()
+		if c.Writer.Status() >= http.StatusInternalServerError {
+			callbackSignatures.Delete(key)
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package middlewares
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"skillsync-api-gateway/config"
+)
+
+func TestCallbackSignature(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	previous := cfg.Callbacks
+	defer func() { cfg.Callbacks = previous }()
+	payments := config.CallbackProvider{Secret: strings.Repeat("s", 32), SignatureHeader: "X-Signature", TimestampHeader: "X-Timestamp", Algorithm: "sha256"}
+	ledger := config.CallbackProvider{Secret: strings.Repeat("l", 32), SignatureHeader: "X-Ledger-Signature", TimestampHeader: "X-Ledger-Time", Algorithm: "sha512"}
+	cfg.Callbacks = config.CallbackConfig{Providers: map[string]config.CallbackProvider{"payments": payments, "ledger": ledger}, Tolerance: 5 * time.Minute}
+
+	now := strconv.FormatInt(time.Now().Unix(), 10)
+	stale := strconv.FormatInt(time.Now().Add(-time.Hour).Unix(), 10)
+	body := `{"event":"paid"}`
+	tests := []struct {
+		name          string
+		provider      string
+		timestamp     string
+		signature     string
+		body          string
+		handlerStatus int
+		wantStatus    int
+		wantErrorCode string
+	}{
+		{"signed", "payments", now, callbackMAC(payments, now, []byte(body)), body, http.StatusOK, http.StatusOK, ""},
+		{"prefixed and upper case", "payments", now, "SHA256=" + strings.ToUpper(callbackMAC(payments, now, []byte(body+" "))), body + " ", http.StatusOK, http.StatusOK, ""},
+		{"sha512 with its own headers", "ledger", now, callbackMAC(ledger, now, []byte(body)), body, http.StatusOK, http.StatusOK, ""},
+		{"unknown provider", "stripe", now, "x", body, http.StatusOK, http.StatusNotFound, "unknown_provider"},
+		{"wrong secret", "payments", now, callbackMAC(ledger, now, []byte(body)), body, http.StatusOK, http.StatusUnauthorized, "invalid_signature"},
+		{"body changed", "payments", now, callbackMAC(payments, now, []byte(body)), `{"event":"refunded"}`, http.StatusOK, http.StatusUnauthorized, "invalid_signature"},
+		{"no timestamp", "payments", "", callbackMAC(payments, "", []byte(body)), body, http.StatusOK, http.StatusUnauthorized, "invalid_signature"},
+		{"signed timestamp that isn't a number", "payments", "soon", callbackMAC(payments, "soon", []byte(body)), body, http.StatusOK, http.StatusUnauthorized, "invalid_signature"},
+		{"outside the tolerance", "payments", stale, callbackMAC(payments, stale, []byte(body)), body, http.StatusOK, http.StatusConflict, "callback_replayed"},
+		{"too large", "payments", now, "x", strings.Repeat("a", maxCallbackBodySize+1), http.StatusOK, http.StatusRequestEntityTooLarge, "body_too_large"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var handled string
+			r := gin.New()
+			r.POST("/callbacks/:provider", CallbackSignature(), func(c *gin.Context) {
+				data, _ := io.ReadAll(c.Request.Body)
+				handled = string(data)
+				c.Status(tt.handlerStatus)
+			})
+			req := httptest.NewRequest(http.MethodPost, "/callbacks/"+tt.provider, strings.NewReader(tt.body))
+			provider := cfg.Callbacks.Providers[tt.provider]
+			if provider.SignatureHeader == "" {
+				provider = payments
+			}
+			req.Header.Set(provider.TimestampHeader, tt.timestamp)
+			req.Header.Set(provider.SignatureHeader, tt.signature)
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d (%s)", w.Code, tt.wantStatus, w.Body)
+			}
+			if tt.wantErrorCode != "" && !strings.Contains(w.Body.String(), `"error_code":"`+tt.wantErrorCode+`"`) {
+				t.Errorf("body = %s, want error_code %s", w.Body, tt.wantErrorCode)
+			}
+			if tt.wantErrorCode == "" && handled != tt.body {
+				t.Errorf("handler read %q, want the body restored", handled)
+			}
+		})
+	}
+}
+
+func TestCallbackSignatureReplay(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	previous := cfg.Callbacks
+	defer func() { cfg.Callbacks = previous }()
+	payments := config.CallbackProvider{Secret: strings.Repeat("r", 32), SignatureHeader: "X-Signature", TimestampHeader: "X-Timestamp", Algorithm: "sha256"}
+	cfg.Callbacks = config.CallbackConfig{Providers: map[string]config.CallbackProvider{"payments": payments}, Tolerance: 5 * time.Minute}
+
+	now := strconv.FormatInt(time.Now().Unix(), 10)
+	handlerStatus := http.StatusServiceUnavailable
+	r := gin.New()
+	r.POST("/callbacks/:provider", CallbackSignature(), func(c *gin.Context) { c.Status(handlerStatus) })
+	send := func(body string) int {
+		req := httptest.NewRequest(http.MethodPost, "/callbacks/payments", strings.NewReader(body))
+		req.Header.Set("X-Timestamp", now)
+		req.Header.Set("X-Signature", callbackMAC(payments, now, []byte(body)))
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	steps := []struct {
+		name          string
+		handlerStatus int
+		wantStatus    int
+	}{
+		{"handler fails", http.StatusServiceUnavailable, http.StatusServiceUnavailable},
+		{"retry after a 5xx", http.StatusOK, http.StatusOK},
+		{"replay", http.StatusOK, http.StatusConflict},
+	}
+	for _, step := range steps {
+		handlerStatus = step.handlerStatus
+		if got := send(`{"event":"paid","id":"replay"}`); got != step.wantStatus {
+			t.Errorf("%s: status = %d, want %d", step.name, got, step.wantStatus)
+		}
+	}
+}
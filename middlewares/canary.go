@@ -0,0 +1,63 @@
+package middlewares
+
+import (
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"skillsync-api-gateway/clients"
+)
+
+// CanaryHeader lets internal testers force ("always") or avoid ("never") the canary
+const CanaryHeader = "X-Canary"
+
+// ServedByHeader reports which deployment answered: primary or canary
+const ServedByHeader = "X-Served-By"
+
+// Canary attaches a clients.CanaryRoute to the request so canary-routed clients
+// honour X-Canary, and adds X-Served-By to the response once a backend has answered.
+// Handlers must pass c.Request.Context() to the client for the route to apply.
+func Canary() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if clients.JobCanaryConn == nil {
+			c.Next()
+			return
+		}
+		route := &clients.CanaryRoute{Force: strings.ToLower(strings.TrimSpace(c.GetHeader(CanaryHeader)))}
+		c.Request = c.Request.WithContext(clients.WithCanaryRoute(c.Request.Context(), route))
+		c.Writer = &servedByWriter{ResponseWriter: c.Writer, route: route}
+		c.Next()
+	}
+}
+
+// servedByWriter sets X-Served-By just before the headers go out
+type servedByWriter struct {
+	gin.ResponseWriter
+	route *clients.CanaryRoute
+}
+
+func (w *servedByWriter) setHeader() {
+	if w.route.Served != "" && !w.Written() {
+		w.Header().Set(ServedByHeader, w.route.Served)
+	}
+}
+
+func (w *servedByWriter) WriteHeader(code int) {
+	w.setHeader()
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *servedByWriter) WriteHeaderNow() {
+	w.setHeader()
+	w.ResponseWriter.WriteHeaderNow()
+}
+
+func (w *servedByWriter) Write(b []byte) (int, error) {
+	w.setHeader()
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *servedByWriter) WriteString(s string) (int, error) {
+	w.setHeader()
+	return w.ResponseWriter.WriteString(s)
+}
@@ -0,0 +1,82 @@
+package middlewares
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"skillsync-api-gateway/utils/captcha"
+)
+
+const (
+	captchaTimeout = 3 * time.Second
+	// maxCaptchaBodySize bounds how much of the JSON body is read to find the token
+	maxCaptchaBodySize = 1 << 20
+)
+
+// captchaVerifier is built from CAPTCHA_PROVIDER by Configure; nil means no CAPTCHA
+var captchaVerifier captcha.Verifier
+
+// SetCaptchaVerifier replaces the verifier, e.g. with a fake in tests. nil disables checks.
+func SetCaptchaVerifier(v captcha.Verifier) {
+	captchaVerifier = v
+}
+
+// CaptchaEnabled reports whether a CAPTCHA provider is configured
+func CaptchaEnabled() bool {
+	return captchaVerifier != nil
+}
+
+// Captcha requires a solved "captcha_token" in the JSON body when a provider is
+// configured, and does nothing otherwise. The body is restored for the handler.
+// Provider outages are answered with 503 unless CAPTCHA_FAIL_OPEN is set.
+func Captcha() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		verifier := captchaVerifier
+		if verifier == nil {
+			c.Next()
+			return
+		}
+
+		body, err := io.ReadAll(io.LimitReader(c.Request.Body, maxCaptchaBodySize))
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Failed to read request body"})
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		var fields struct {
+			CaptchaToken string `json:"captcha_token"`
+		}
+		_ = json.Unmarshal(body, &fields)
+
+		ctx, cancel := context.WithTimeout(c.Request.Context(), captchaTimeout)
+		defer cancel()
+		err = verifier.Verify(ctx, fields.CaptchaToken, c.ClientIP())
+		switch {
+		case err == nil:
+			c.Next()
+		case errors.Is(err, captcha.ErrUnavailable) && cfg.Captcha.FailOpen:
+			log.Printf("CAPTCHA provider unavailable, letting %s %s through: %v", c.Request.Method, c.FullPath(), err)
+			c.Next()
+		case errors.Is(err, captcha.ErrUnavailable):
+			log.Printf("CAPTCHA provider unavailable: %v", err)
+			c.AbortWithStatusJSON(http.StatusServiceUnavailable, gin.H{
+				"error":      "CAPTCHA verification is temporarily unavailable, please try again",
+				"error_code": "captcha_unavailable",
+			})
+		default:
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+				"error":      "CAPTCHA verification failed",
+				"error_code": "captcha_failed",
+			})
+		}
+	}
+}
@@ -0,0 +1,233 @@
+package middlewares
+
+import (
+	"compress/flate"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+)
+
+// minCompressSize is the smallest body worth compressing
+const minCompressSize = 1024
+
+// incompressibleTypes are already compressed or streamed and are sent as is
+var incompressibleTypes = []string{
+	"image/", "video/", "audio/", "font/woff",
+	"application/zip", "application/gzip", "application/x-gzip", "application/pdf",
+	"application/octet-stream", "application/grpc", "text/event-stream",
+}
+
+// NoCompression opts a route or group out of response compression, e.g. for
+// streaming downloads that must not be buffered
+func NoCompression() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set("compression_disabled", true)
+		c.Next()
+	}
+}
+
+// Compress gzip- or deflate-encodes responses when the client accepts it, at
+// COMPRESSION_LEVEL (0 disables it). The body is buffered until it reaches 1 KB,
+// so small responses, compressed content types, SSE and WebSocket upgrades pass
+// through untouched. Middlewares and handlers below it, such as the idempotency
+// cache, always see the uncompressed body.
+func Compress() gin.HandlerFunc {
+	level := cfg.Compression.Level
+	if level == 0 {
+		return func(c *gin.Context) { c.Next() }
+	}
+	gzipPool := sync.Pool{New: func() interface{} {
+		w, _ := gzip.NewWriterLevel(io.Discard, level)
+		return w
+	}}
+	flatePool := sync.Pool{New: func() interface{} {
+		w, _ := flate.NewWriter(io.Discard, level)
+		return w
+	}}
+
+	return func(c *gin.Context) {
+		if c.GetHeader("Upgrade") != "" {
+			c.Next()
+			return
+		}
+		encoding := negotiateEncoding(c.GetHeader("Accept-Encoding"))
+		if encoding == "" {
+			c.Next()
+			return
+		}
+		c.Header("Vary", "Accept-Encoding")
+
+		writer := &compressWriter{ResponseWriter: c.Writer, c: c, encoding: encoding}
+		c.Writer = writer
+		defer func() {
+			writer.finish()
+			switch w := writer.encoder.(type) {
+			case *gzip.Writer:
+				gzipPool.Put(w)
+			case *flate.Writer:
+				flatePool.Put(w)
+			}
+			writer.encoder = nil
+			c.Writer = writer.ResponseWriter
+		}()
+		writer.newEncoder = func(dst io.Writer) io.WriteCloser {
+			if encoding == "gzip" {
+				w := gzipPool.Get().(*gzip.Writer)
+				w.Reset(dst)
+				return w
+			}
+			w := flatePool.Get().(*flate.Writer)
+			w.Reset(dst)
+			return w
+		}
+		c.Next()
+	}
+}
+
+// negotiateEncoding picks gzip, then deflate, from an Accept-Encoding header
+func negotiateEncoding(header string) string {
+	accepted := map[string]bool{}
+	for _, part := range strings.Split(header, ",") {
+		name, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		name = strings.ToLower(strings.TrimSpace(name))
+		if q, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			if weight, err := strconv.ParseFloat(q, 64); err == nil && weight == 0 {
+				continue
+			}
+		}
+		accepted[name] = true
+	}
+	for _, encoding := range []string{"gzip", "deflate"} {
+		if accepted[encoding] || accepted["*"] {
+			return encoding
+		}
+	}
+	return ""
+}
+
+// compressWriter holds the first minCompressSize bytes back so it can decide
+// whether compression is worthwhile before any header is sent
+type compressWriter struct {
+	gin.ResponseWriter
+	c          *gin.Context
+	encoding   string
+	newEncoder func(io.Writer) io.WriteCloser
+	encoder    io.WriteCloser
+	buf        []byte
+	size       int
+	decided    bool
+}
+
+func (w *compressWriter) Write(b []byte) (int, error) {
+	w.size += len(b)
+	if !w.decided {
+		w.buf = append(w.buf, b...)
+		if len(w.buf) < minCompressSize {
+			return len(b), nil
+		}
+		if err := w.decide(); err != nil {
+			return 0, err
+		}
+		return len(b), nil
+	}
+	if w.encoder != nil {
+		return w.encoder.Write(b)
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *compressWriter) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
+
+// WriteHeaderNow is used for bodiless and streamed responses, which are never compressed
+func (w *compressWriter) WriteHeaderNow() {
+	if !w.decided {
+		w.decide()
+	}
+	w.ResponseWriter.WriteHeaderNow()
+}
+
+func (w *compressWriter) Flush() {
+	if !w.decided {
+		w.decide()
+	}
+	if flusher, ok := w.encoder.(interface{ Flush() error }); ok {
+		flusher.Flush()
+	}
+	w.ResponseWriter.Flush()
+}
+
+// Size is the uncompressed size written so far, as handlers expect
+func (w *compressWriter) Size() int {
+	if w.size == 0 {
+		return w.ResponseWriter.Size()
+	}
+	return w.size
+}
+
+func (w *compressWriter) Written() bool {
+	return w.size > 0 || w.ResponseWriter.Written()
+}
+
+// decide starts the encoder if the buffered body qualifies, then writes the buffer
+func (w *compressWriter) decide() error {
+	w.decided = true
+	if w.shouldCompress() {
+		header := w.Header()
+		header.Set("Content-Encoding", w.encoding)
+		header.Del("Content-Length")
+		// The representation changes, so a strong validator no longer applies byte for byte
+		if etag := header.Get("ETag"); etag != "" && !strings.HasPrefix(etag, "W/") {
+			header.Set("ETag", "W/"+etag)
+		}
+		w.encoder = w.newEncoder(w.ResponseWriter)
+	}
+	buf := w.buf
+	w.buf = nil
+	if len(buf) == 0 {
+		return nil
+	}
+	if w.encoder != nil {
+		_, err := w.encoder.Write(buf)
+		return err
+	}
+	_, err := w.ResponseWriter.Write(buf)
+	return err
+}
+
+func (w *compressWriter) shouldCompress() bool {
+	if len(w.buf) < minCompressSize || w.c.GetBool("compression_disabled") {
+		return false
+	}
+	status := w.Status()
+	if status < http.StatusOK || status == http.StatusNoContent || status == http.StatusNotModified {
+		return false
+	}
+	header := w.Header()
+	if header.Get("Content-Encoding") != "" {
+		return false
+	}
+	contentType := strings.ToLower(header.Get("Content-Type"))
+	for _, prefix := range incompressibleTypes {
+		if strings.HasPrefix(contentType, prefix) {
+			return false
+		}
+	}
+	return true
+}
+
+// finish flushes a body that never reached minCompressSize and closes the encoder
+func (w *compressWriter) finish() {
+	if !w.decided {
+		w.decide()
+	}
+	if w.encoder != nil {
+		w.encoder.Close()
+	}
+}
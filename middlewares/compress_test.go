@@ -0,0 +1,129 @@
+package middlewares
+
+import (
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	jobpb "github.com/shahal0/skillsync-protos/gen/jobpb"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"skillsync-api-gateway/config"
+)
+
+// jobsPage is a GET /jobs/ body for a page of n jobs, with the employer and skill
+// details the listing carries
+func jobsPage(tb testing.TB, n int) []byte {
+	tb.Helper()
+	resp := &jobpb.GetJobsResponse{Total: int32(n)}
+	categories := []string{"Engineering", "Design", "Marketing", "Sales"}
+	titles := []string{"Senior Backend Engineer", "Product Designer", "Growth Marketer", "Account Executive", "Data Analyst"}
+	locations := []string{"Bengaluru", "Kochi", "Pune", "Remote", "Hyderabad", "Chennai"}
+	duties := []string{
+		"own APIs end to end, review code and mentor engineers on Go, gRPC and PostgreSQL",
+		"shape the candidate experience from first search to signed offer, working closely with research",
+		"plan and run campaigns across search, social and email, and report on what they bring in",
+		"build relationships with hiring teams at mid-sized companies and close annual contracts",
+		"turn hiring funnel data into dashboards and experiments the product team acts on",
+	}
+	for i := 0; i < n; i++ {
+		resp.Jobs = append(resp.Jobs, &jobpb.Job{
+			Id:          uint64(i + 1),
+			EmployerId:  fmt.Sprintf("employer-%d", i%7),
+			Title:       titles[i%len(titles)],
+			Description: fmt.Sprintf("Company %d is hiring. You'll %s. %d+ years of experience preferred; reference %08x.", i%7, duties[i%len(duties)], i%8, uint32(i)*2654435761),
+			Category:    categories[i%len(categories)],
+			RequiredSkills: []*jobpb.JobSkill{
+				{JobId: fmt.Sprint(i + 1), Skill: "Go", Proficiency: "Expert"},
+				{JobId: fmt.Sprint(i + 1), Skill: "PostgreSQL", Proficiency: "Intermediate"},
+			},
+			SalaryMin:          int64(60000 + i*1000),
+			SalaryMax:          int64(90000 + i*1000),
+			Location:           locations[i%len(locations)],
+			ExperienceRequired: int32(i % 8),
+			Status:             "OPEN",
+			EmployerProfile: &jobpb.EmployerProfile{
+				CompanyName: fmt.Sprintf("Company %d", i%7),
+				Email:       fmt.Sprintf("hiring%d@example.com", i%7),
+				Industry:    "Software",
+				Website:     fmt.Sprintf("https://company%d.example.com", i%7),
+				Location:    locations[i%7%len(locations)],
+				IsVerified:  true,
+			},
+			Deadline:  "2026-12-31T00:00:00Z",
+			UpdatedAt: "2026-10-01T09:30:00Z",
+			CreatedAt: "2026-09-15T09:30:00Z",
+		})
+	}
+	body, err := protojson.Marshal(resp)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	return body
+}
+
+// BenchmarkCompress compares the size and time of a page of jobs sent gzipped,
+// deflated and as is. resp-bytes is the size on the wire.
+func BenchmarkCompress(b *testing.B) {
+	gin.SetMode(gin.TestMode)
+	previous := cfg.Compression
+	b.Cleanup(func() { cfg.Compression = previous })
+	cfg.Compression = config.CompressionConfig{Level: gzip.DefaultCompression}
+
+	body := jobsPage(b, 20)
+	r := gin.New()
+	r.Use(Compress())
+	r.GET("/jobs/", func(c *gin.Context) {
+		c.Data(http.StatusOK, "application/json; charset=utf-8", body)
+	})
+
+	for _, bb := range []struct {
+		name       string
+		encoding   string
+		decompress func(io.Reader) (io.Reader, error)
+	}{
+		{"gzip", "gzip", func(r io.Reader) (io.Reader, error) { return gzip.NewReader(r) }},
+		{"deflate", "deflate", func(r io.Reader) (io.Reader, error) { return flate.NewReader(r), nil }},
+		{"plain", "", func(r io.Reader) (io.Reader, error) { return r, nil }},
+	} {
+		b.Run(bb.name, func(b *testing.B) {
+			serve := func() *httptest.ResponseRecorder {
+				req := httptest.NewRequest(http.MethodGet, "/jobs/", nil)
+				if bb.encoding != "" {
+					req.Header.Set("Accept-Encoding", bb.encoding)
+				}
+				w := httptest.NewRecorder()
+				r.ServeHTTP(w, req)
+				return w
+			}
+
+			w := serve()
+			if got := w.Header().Get("Content-Encoding"); got != bb.encoding {
+				b.Fatalf("Content-Encoding = %q, want %q", got, bb.encoding)
+			}
+			decoded, err := bb.decompress(bytes.NewReader(w.Body.Bytes()))
+			if err != nil {
+				b.Fatal(err)
+			}
+			if got, err := io.ReadAll(decoded); err != nil || !bytes.Equal(got, body) {
+				b.Fatalf("body didn't decode back to the page of jobs: %v", err)
+			}
+			size := w.Body.Len()
+
+			b.SetBytes(int64(len(body)))
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				serve()
+			}
+			b.ReportMetric(float64(size), "resp-bytes")
+			b.ReportMetric(float64(size)/float64(len(body)), "ratio")
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package middlewares
+
+import (
+	"log"
+
+	authpb "github.com/shahal0/skillsync-protos/gen/authpb"
+
+	"skillsync-api-gateway/config"
+	"skillsync-api-gateway/utils/cache"
+	"skillsync-api-gateway/utils/captcha"
+	"skillsync-api-gateway/utils/flags"
+	"skillsync-api-gateway/utils/slo"
+)
+
+// cfg is the configuration the middlewares read; it defaults to config.Default until Configure runs
+var cfg = config.Default()
+
+// Configure sets the configuration used by the middlewares. Call it before registering routes.
+func Configure(c *config.Config) {
+	cfg = c
+	verifiedTokens = nil
+	if c.JWT.CacheSize > 0 {
+		verifiedTokens = newTokenCache(c.JWT.CacheSize)
+	}
+	storeJWTKeys(c.JWT.Keys)
+
+	verifier, err := captcha.New(c.Captcha.Provider, c.Captcha.Secret, captchaTimeout)
+	if err != nil {
+		log.Printf("CAPTCHA disabled: %v", err)
+	}
+	captchaVerifier = verifier
+
+	flags.Load(c.FeatureFlags)
+	usageMeter = newUsageMeter(c.Usage)
+	sloTracker = slo.New(c.SLOs.Window)
+	planCache = cache.NewTTLCache[*authpb.EmployerPlanResponse](c.Plans.CacheTTL)
+	// A signature can't be replayed once its timestamp is outside the tolerance
+	callbackSignatures = cache.NewTTLCache[bool](2 * c.Callbacks.Tolerance)
+}
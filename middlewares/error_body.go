@@ -0,0 +1,86 @@
+package middlewares
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// errorBodyWriter holds back JSON error bodies so they can be rewritten once complete.
+// rewrite gets the decoded {"error": ...} envelope and returns the body to send, or
+// nil to send the original. Successful and non-JSON responses pass straight through.
+type errorBodyWriter struct {
+	gin.ResponseWriter
+	rewrite func(envelope map[string]interface{}) []byte
+	decided bool
+	capture bool
+	buf     []byte
+}
+
+func (w *errorBodyWriter) decide() {
+	w.decided = true
+	contentType := w.Header().Get("Content-Type")
+	w.capture = w.Status() >= http.StatusBadRequest && strings.HasPrefix(contentType, "application/json")
+}
+
+func (w *errorBodyWriter) Write(b []byte) (int, error) {
+	if !w.decided {
+		w.decide()
+	}
+	if w.capture {
+		w.buf = append(w.buf, b...)
+		return len(b), nil
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *errorBodyWriter) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
+
+// WriteHeaderNow can't send the headers of a captured body early
+func (w *errorBodyWriter) WriteHeaderNow() {
+	if w.capture {
+		return
+	}
+	w.ResponseWriter.WriteHeaderNow()
+}
+
+func (w *errorBodyWriter) Flush() {
+	if w.capture {
+		return
+	}
+	w.ResponseWriter.Flush()
+}
+
+func (w *errorBodyWriter) Size() int {
+	if w.capture {
+		return len(w.buf)
+	}
+	return w.ResponseWriter.Size()
+}
+
+func (w *errorBodyWriter) Written() bool {
+	return len(w.buf) > 0 || w.ResponseWriter.Written()
+}
+
+// finish writes the captured error, rewritten when it is a JSON object
+func (w *errorBodyWriter) finish() {
+	if !w.capture {
+		return
+	}
+	body := w.buf
+	w.capture = false
+	w.buf = nil
+
+	var envelope map[string]interface{}
+	if err := json.Unmarshal(body, &envelope); err == nil {
+		if rewritten := w.rewrite(envelope); rewritten != nil {
+			body = rewritten
+			w.Header().Del("Content-Length")
+		}
+	}
+	w.ResponseWriter.Write(body)
+}
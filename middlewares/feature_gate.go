@@ -0,0 +1,25 @@
+package middlewares
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"skillsync-api-gateway/utils/flags"
+)
+
+// FeatureGate hides a route behind a feature flag: while the flag is off for the
+// caller the route answers exactly like one that doesn't exist. Put it after the
+// JWT middleware so percentage rollouts are keyed by user rather than client IP.
+func FeatureGate(name string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !flags.Enabled(c, name) {
+			c.AbortWithStatusJSON(http.StatusNotFound, gin.H{
+				"error":      "No route for " + c.Request.Method + " " + c.Request.URL.Path,
+				"error_code": "not_found",
+			})
+			return
+		}
+		c.Next()
+	}
+}
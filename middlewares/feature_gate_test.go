@@ -0,0 +1,37 @@
+package middlewares
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"skillsync-api-gateway/utils/flags"
+)
+
+func TestFeatureGate(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	flags.Load(map[string]int{"on": 100, "off": 0})
+	defer flags.Load(nil)
+
+	tests := []struct {
+		flag       string
+		wantStatus int
+	}{
+		{"on", http.StatusNoContent},
+		{"off", http.StatusNotFound},
+		{"unknown", http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.flag, func(t *testing.T) {
+			r := gin.New()
+			r.GET("/beta", FeatureGate(tt.flag), func(c *gin.Context) { c.Status(http.StatusNoContent) })
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/beta", nil))
+			if w.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", w.Code, tt.wantStatus)
+			}
+		})
+	}
+}
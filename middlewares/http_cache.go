@@ -0,0 +1,139 @@
+package middlewares
+
+import (
+	"fmt"
+	"hash/fnv"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"skillsync-api-gateway/utils/cache"
+)
+
+const (
+	// staleWhileRevalidate is how long shared caches may keep serving a public
+	// response while they fetch a fresh one
+	staleWhileRevalidate = 5 * time.Minute
+	// publicVersionTTL is how long a URL's response digest is remembered without requests
+	publicVersionTTL = time.Hour
+)
+
+// publicVersion is a digest of the last public response for a URL and when it was
+// first served, which is its Last-Modified
+type publicVersion struct {
+	digest   uint64
+	modified time.Time
+}
+
+// publicVersions are kept per instance, so instances can give the same response
+// different Last-Modified times; a mismatch only costs a full response
+var publicVersions = cache.NewTTLCache[publicVersion](publicVersionTTL)
+
+// PublicCacheControl is the Cache-Control of a public response shared caches may keep for maxAge
+func PublicCacheControl(maxAge time.Duration) string {
+	return fmt.Sprintf("public, max-age=%d, stale-while-revalidate=%d", int(maxAge.Seconds()), int(staleWhileRevalidate.Seconds()))
+}
+
+// NoStore marks a response as private to the caller, for authenticated routes
+func NoStore(c *gin.Context) {
+	c.Header("Cache-Control", "private, no-store")
+}
+
+// PublicCache lets browsers and CDNs cache a group's anonymous GET responses for
+// maxAge, and answers If-Modified-Since with 304 while the response is unchanged.
+// A Cache-Control or Last-Modified set by the handler is kept. Requests carrying a
+// bearer token or API key get private, no-store, since their responses (and
+// headers such as the rate limit) belong to the caller.
+func PublicCache(maxAge time.Duration) gin.HandlerFunc {
+	cacheControl := PublicCacheControl(maxAge)
+	return func(c *gin.Context) {
+		if c.Request.Method != http.MethodGet && c.Request.Method != http.MethodHead {
+			c.Next()
+			return
+		}
+		if c.GetHeader("Authorization") != "" || c.GetHeader(APIKeyHeader) != "" {
+			NoStore(c)
+			c.Next()
+			return
+		}
+		writer := &cacheableWriter{ResponseWriter: c.Writer}
+		c.Writer = writer
+		c.Next()
+		c.Writer = writer.ResponseWriter
+		writer.finish(c.Request, cacheControl)
+	}
+}
+
+// cacheableWriter holds back the response so its caching headers can be set from the complete body
+type cacheableWriter struct {
+	gin.ResponseWriter
+	buf []byte
+}
+
+func (w *cacheableWriter) Write(b []byte) (int, error) {
+	w.buf = append(w.buf, b...)
+	return len(b), nil
+}
+
+func (w *cacheableWriter) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
+
+// WriteHeaderNow can't send the headers before the body is complete
+func (w *cacheableWriter) WriteHeaderNow() {}
+
+func (w *cacheableWriter) Flush() {}
+
+func (w *cacheableWriter) Size() int {
+	return len(w.buf)
+}
+
+func (w *cacheableWriter) Written() bool {
+	return len(w.buf) > 0 || w.ResponseWriter.Written()
+}
+
+// finish adds the caching headers to a successful response and sends it, or a 304
+// when the client's copy is still current. Other responses go out unchanged.
+func (w *cacheableWriter) finish(r *http.Request, cacheControl string) {
+	header := w.Header()
+	if w.Status() == http.StatusOK {
+		if header.Get("Cache-Control") == "" {
+			header.Set("Cache-Control", cacheControl)
+		}
+		// Compressed and plain variants are cached separately
+		if !strings.Contains(header.Get("Vary"), "Accept-Encoding") {
+			header.Add("Vary", "Accept-Encoding")
+		}
+		modified, err := http.ParseTime(header.Get("Last-Modified"))
+		if err != nil {
+			modified = lastModified(r.URL.RequestURI(), w.buf)
+			header.Set("Last-Modified", modified.Format(http.TimeFormat))
+		}
+		if since, err := http.ParseTime(r.Header.Get("If-Modified-Since")); err == nil && !modified.After(since) {
+			header.Del("Content-Length")
+			w.ResponseWriter.WriteHeader(http.StatusNotModified)
+			w.ResponseWriter.WriteHeaderNow()
+			return
+		}
+	}
+	if len(w.buf) == 0 {
+		w.ResponseWriter.WriteHeaderNow()
+		return
+	}
+	w.ResponseWriter.Write(w.buf)
+}
+
+// lastModified is when this instance first served body for uri, or now if the body changed
+func lastModified(uri string, body []byte) time.Time {
+	hash := fnv.New64a()
+	hash.Write(body)
+	digest := hash.Sum64()
+	if version, ok := publicVersions.Get(uri); ok && version.digest == digest {
+		return version.modified
+	}
+	modified := time.Now().UTC().Truncate(time.Second)
+	publicVersions.Set(uri, publicVersion{digest: digest, modified: modified})
+	return modified
+}
@@ -0,0 +1,94 @@
+package middlewares
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestPublicCache(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	public := "public, max-age=60, stale-while-revalidate=300"
+	handlerModified := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name             string
+		method           string
+		path             string
+		headers          map[string]string
+		wantStatus       int
+		wantCacheControl string
+		wantBody         string
+	}{
+		{"anonymous", http.MethodGet, "/jobs?page=1", nil, http.StatusOK, public, `{"ok":true}`},
+		{"bearer token", http.MethodGet, "/jobs?page=2", map[string]string{"Authorization": "Bearer t"}, http.StatusOK, "private, no-store", `{"ok":true}`},
+		{"api key", http.MethodGet, "/jobs?page=3", map[string]string{APIKeyHeader: "k"}, http.StatusOK, "private, no-store", `{"ok":true}`},
+		{"post", http.MethodPost, "/jobs?page=4", nil, http.StatusOK, "", `{"ok":true}`},
+		{"not found", http.MethodGet, "/missing", nil, http.StatusNotFound, "", `{"error":"not found"}`},
+		{"handler's own cache control", http.MethodGet, "/fixed", nil, http.StatusOK, "public, max-age=5", `{"ok":true}`},
+		{
+			"unchanged since", http.MethodGet, "/modified",
+			map[string]string{"If-Modified-Since": handlerModified.Format(http.TimeFormat)},
+			http.StatusNotModified, public, "",
+		},
+		{
+			"changed since", http.MethodGet, "/modified",
+			map[string]string{"If-Modified-Since": handlerModified.Add(-time.Hour).Format(http.TimeFormat)},
+			http.StatusOK, public, `{"ok":true}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+			r.Use(PublicCache(time.Minute))
+			ok := func(c *gin.Context) { c.JSON(http.StatusOK, gin.H{"ok": true}) }
+			r.Handle(tt.method, "/jobs", ok)
+			r.GET("/missing", func(c *gin.Context) { c.JSON(http.StatusNotFound, gin.H{"error": "not found"}) })
+			r.GET("/fixed", func(c *gin.Context) {
+				c.Header("Cache-Control", "public, max-age=5")
+				ok(c)
+			})
+			r.GET("/modified", func(c *gin.Context) {
+				c.Header("Last-Modified", handlerModified.Format(http.TimeFormat))
+				ok(c)
+			})
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			for key, value := range tt.headers {
+				req.Header.Set(key, value)
+			}
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", w.Code, tt.wantStatus)
+			}
+			if got := w.Header().Get("Cache-Control"); got != tt.wantCacheControl {
+				t.Errorf("Cache-Control = %q, want %q", got, tt.wantCacheControl)
+			}
+			if got := w.Body.String(); got != tt.wantBody {
+				t.Errorf("body = %q, want %q", got, tt.wantBody)
+			}
+			if tt.wantCacheControl == public && (w.Header().Get("Last-Modified") == "" || w.Header().Get("Vary") != "Accept-Encoding") {
+				t.Errorf("Last-Modified, Vary = %q, %q", w.Header().Get("Last-Modified"), w.Header().Get("Vary"))
+			}
+		})
+	}
+}
+
+func TestLastModified(t *testing.T) {
+	uri := "/test-last-modified"
+	first := lastModified(uri, []byte("v1"))
+	if got := lastModified(uri, []byte("v1")); !got.Equal(first) {
+		t.Errorf("lastModified() = %v for the same body, want %v", got, first)
+	}
+	// Age the remembered version so a new body's time is visibly later
+	version, _ := publicVersions.Get(uri)
+	version.modified = first.Add(-time.Hour)
+	publicVersions.Set(uri, version)
+	if got := lastModified(uri, []byte("v2")); got.Before(first) {
+		t.Errorf("lastModified() = %v for a new body, want now", got)
+	}
+}
@@ -0,0 +1,169 @@
+package middlewares
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	IdempotencyKeyHeader      = "Idempotency-Key"
+	IdempotencyReplayedHeader = "Idempotency-Replayed"
+
+	maxIdempotencyKeyLength = 255
+)
+
+// ErrIdempotencyInFlight is returned by Reserve while another request holds the key
+var ErrIdempotencyInFlight = errors.New("request with this idempotency key is in progress")
+
+// IdempotentResponse is what gets replayed for a repeated key
+type IdempotentResponse struct {
+	Status      int
+	ContentType string
+	Body        []byte
+}
+
+// IdempotencyStore records responses by key. It is an interface so a shared store
+// (e.g. Redis) can replace the in-memory one when the gateway runs on several instances.
+type IdempotencyStore interface {
+	// Reserve claims key for a new request. It returns the stored response when the key
+	// was already completed, ErrIdempotencyInFlight when another request holds it, and
+	// (nil, nil) when the caller now owns the key.
+	Reserve(ctx context.Context, key string, ttl time.Duration) (*IdempotentResponse, error)
+	// Save stores the response for the owned key
+	Save(ctx context.Context, key string, response *IdempotentResponse, ttl time.Duration) error
+	// Release gives up an owned key without storing anything so the request can be retried
+	Release(ctx context.Context, key string) error
+}
+
+type memoryIdempotencyEntry struct {
+	response  *IdempotentResponse
+	expiresAt time.Time
+}
+
+// MemoryIdempotencyStore keeps idempotency records in process
+type MemoryIdempotencyStore struct {
+	mutex   sync.Mutex
+	entries map[string]*memoryIdempotencyEntry
+}
+
+func NewMemoryIdempotencyStore() *MemoryIdempotencyStore {
+	return &MemoryIdempotencyStore{entries: make(map[string]*memoryIdempotencyEntry)}
+}
+
+func (s *MemoryIdempotencyStore) Reserve(_ context.Context, key string, ttl time.Duration) (*IdempotentResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	now := time.Now()
+	// Expired entries are swept here rather than by a background goroutine
+	for k, entry := range s.entries {
+		if now.After(entry.expiresAt) {
+			delete(s.entries, k)
+		}
+	}
+	if entry, ok := s.entries[key]; ok {
+		if entry.response == nil {
+			return nil, ErrIdempotencyInFlight
+		}
+		return entry.response, nil
+	}
+	s.entries[key] = &memoryIdempotencyEntry{expiresAt: now.Add(ttl)}
+	return nil, nil
+}
+
+func (s *MemoryIdempotencyStore) Save(_ context.Context, key string, response *IdempotentResponse, ttl time.Duration) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.entries[key] = &memoryIdempotencyEntry{response: response, expiresAt: time.Now().Add(ttl)}
+	return nil
+}
+
+func (s *MemoryIdempotencyStore) Release(_ context.Context, key string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	delete(s.entries, key)
+	return nil
+}
+
+// DefaultIdempotencyStore backs the Idempotency middleware unless a route passes its own
+var DefaultIdempotencyStore IdempotencyStore = NewMemoryIdempotencyStore()
+
+// capturingWriter records the body written by the handler while still sending it
+type capturingWriter struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *capturingWriter) Write(b []byte) (int, error) {
+	w.body.Write(b)
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *capturingWriter) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
+
+// Idempotency replays the first response for a repeated Idempotency-Key on POST/PUT.
+// Keys are scoped to the user and route, so it must run after JWTMiddleware. Server
+// errors are not stored, so those requests can be retried with the same key.
+func Idempotency(store IdempotencyStore, ttl time.Duration) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := c.GetHeader(IdempotencyKeyHeader)
+		if key == "" || (c.Request.Method != http.MethodPost && c.Request.Method != http.MethodPut) {
+			c.Next()
+			return
+		}
+		if len(key) > maxIdempotencyKeyLength {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Idempotency-Key must be at most 255 characters"})
+			return
+		}
+
+		storeKey := c.GetString("user_id") + "|" + c.Request.Method + " " + c.FullPath() + "|" + key
+		ctx := c.Request.Context()
+		stored, err := store.Reserve(ctx, storeKey, ttl)
+		if errors.Is(err, ErrIdempotencyInFlight) {
+			c.AbortWithStatusJSON(http.StatusConflict, gin.H{"error": "A request with this Idempotency-Key is already in progress"})
+			return
+		}
+		if err != nil {
+			// A broken store shouldn't take the endpoint down; the request runs unprotected
+			log.Printf("Idempotency: store unavailable, processing request without it: %v", err)
+			c.Next()
+			return
+		}
+		if stored != nil {
+			c.Header(IdempotencyReplayedHeader, "true")
+			c.Data(stored.Status, stored.ContentType, stored.Body)
+			c.Abort()
+			return
+		}
+
+		writer := &capturingWriter{ResponseWriter: c.Writer}
+		c.Writer = writer
+		c.Next()
+
+		status := writer.Status()
+		if status >= http.StatusInternalServerError {
+			if err := store.Release(context.Background(), storeKey); err != nil {
+				log.Printf("Idempotency: failed to release key: %v", err)
+			}
+			return
+		}
+		response := &IdempotentResponse{
+			Status:      status,
+			ContentType: writer.Header().Get("Content-Type"),
+			Body:        writer.body.Bytes(),
+		}
+		if err := store.Save(context.Background(), storeKey, response, ttl); err != nil {
+			log.Printf("Idempotency: failed to save response: %v", err)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package middlewares
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"skillsync-api-gateway/config"
+)
+
+func TestParseImpersonationToken(t *testing.T) {
+	previous := jwtKeys.Load()
+	defer jwtKeys.Store(previous)
+	storeJWTKeys([]config.JWTKey{{ID: "2024", Secret: "new-secret"}})
+
+	minted, expiresAt, err := MintImpersonationToken("admin-1", "c1", "candidate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d := time.Until(expiresAt); d <= 0 || d > ImpersonationTTL {
+		t.Errorf("expires in %v, want within %v", d, ImpersonationTTL)
+	}
+
+	tests := []struct {
+		name    string
+		token   string
+		want    Impersonation
+		wantErr bool
+	}{
+		{"minted", minted, Impersonation{ImpersonatorID: "admin-1", UserID: "c1", ExpiresAt: expiresAt.Truncate(time.Second)}, false},
+		{"user token", signToken(t, "2024", "new-secret"), Impersonation{}, true},
+		{"other key", signToken(t, "", "stolen-secret"), Impersonation{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseImpersonationToken(tt.token)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseImpersonationToken() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got.ImpersonatorID != tt.want.ImpersonatorID || got.UserID != tt.want.UserID || !got.ExpiresAt.Equal(tt.want.ExpiresAt) {
+				t.Errorf("ParseImpersonationToken() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRejectImpersonation(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	tests := []struct {
+		name         string
+		impersonator string
+		wantStatus   int
+	}{
+		{"user", "", http.StatusNoContent},
+		{"impersonated", "admin-1", http.StatusForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+			r.DELETE("/account", func(c *gin.Context) {
+				if tt.impersonator != "" {
+					c.Set("impersonator_id", tt.impersonator)
+				}
+			}, RejectImpersonation(), func(c *gin.Context) { c.Status(http.StatusNoContent) })
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, httptest.NewRequest(http.MethodDelete, "/account", nil))
+			if w.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", w.Code, tt.wantStatus)
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package middlewares
+
+import (
+	"errors"
+	"log"
+	"os"
+	"sync/atomic"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"skillsync-api-gateway/config"
+)
+
+// jwtKeys are the keys tokens are verified with, swapped as a whole on reload. It
+// is nil until Configure runs, when cfg's keys are used.
+var jwtKeys atomic.Pointer[[]config.JWTKey]
+
+func currentJWTKeys() []config.JWTKey {
+	if keys := jwtKeys.Load(); keys != nil {
+		return *keys
+	}
+	return cfg.JWT.Keys
+}
+
+// storeJWTKeys swaps the keys in use. Cached tokens were verified with the old
+// ones, so they are dropped.
+func storeJWTKeys(keys []config.JWTKey) {
+	jwtKeys.Store(&keys)
+	verifiedTokens.purge()
+}
+
+// SigningKey is the key new tokens are signed with, the first one configured
+func SigningKey() config.JWTKey {
+	return currentJWTKeys()[0]
+}
+
+// ParseToken verifies tokenString and decodes its claims into claims. A token whose
+// kid header names a key is checked against that key only; any other token is
+// tried against every key in order, so tokens signed before a rotation stay valid
+// until their secret is taken off the list.
+func ParseToken(tokenString string, claims jwt.Claims) (*jwt.Token, error) {
+	keys := currentJWTKeys()
+	if unverified, _, err := jwt.NewParser().ParseUnverified(tokenString, jwt.MapClaims{}); err == nil {
+		if kid, _ := unverified.Header["kid"].(string); kid != "" {
+			for _, key := range keys {
+				if key.ID == kid {
+					keys = []config.JWTKey{key}
+					break
+				}
+			}
+		}
+	}
+
+	var token *jwt.Token
+	err := errors.New("no JWT keys configured")
+	for _, key := range keys {
+		secret := []byte(key.Secret)
+		token, err = jwt.ParseWithClaims(tokenString, claims, func(*jwt.Token) (interface{}, error) {
+			return secret, nil
+		})
+		// Any other error means the signature matched, or the token is malformed
+		if !errors.Is(err, jwt.ErrTokenSignatureInvalid) {
+			return token, err
+		}
+	}
+	return token, err
+}
+
+// ReloadJWTKeys rereads the keys from JWT_SECRETS_FILE, JWT_SECRETS or JWT_SECRET
+// and returns how many are now in use. The environment of a running process
+// doesn't change, so rotating without a restart needs JWT_SECRETS_FILE. The
+// current keys are kept if the new ones are invalid.
+func ReloadJWTKeys() (int, error) {
+	keys, err := config.LoadJWTKeys(os.LookupEnv)
+	if err == nil {
+		err = config.CheckJWTKeys(keys)
+	}
+	if err != nil {
+		return 0, err
+	}
+	storeJWTKeys(keys)
+	log.Printf("JWT keys reloaded: %d in use", len(keys))
+	return len(keys), nil
+}
@@ -0,0 +1,80 @@
+package middlewares
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"skillsync-api-gateway/config"
+)
+
+func signToken(t testing.TB, kid, secret string) string {
+	t.Helper()
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"user_id": "u1", "exp": time.Now().Add(time.Hour).Unix()})
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	signed, err := token.SignedString([]byte(secret))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signed
+}
+
+func TestParseToken(t *testing.T) {
+	previous := jwtKeys.Load()
+	defer jwtKeys.Store(previous)
+	storeJWTKeys([]config.JWTKey{{ID: "2024", Secret: "new-secret"}, {ID: "2023", Secret: "old-secret"}, {Secret: "legacy-secret"}})
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr error
+	}{
+		{"current key by kid", signToken(t, "2024", "new-secret"), nil},
+		{"rotated key by kid", signToken(t, "2023", "old-secret"), nil},
+		{"no kid tries every key", signToken(t, "", "legacy-secret"), nil},
+		{"unknown kid tries every key", signToken(t, "2022", "old-secret"), nil},
+		{"kid pins its key", signToken(t, "2024", "old-secret"), jwt.ErrTokenSignatureInvalid},
+		{"unknown secret", signToken(t, "", "stolen-secret"), jwt.ErrTokenSignatureInvalid},
+		{"malformed", "not.a.token", jwt.ErrTokenMalformed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims := jwt.MapClaims{}
+			_, err := ParseToken(tt.token, claims)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseToken() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && claims["user_id"] != "u1" {
+				t.Errorf("claims = %v", claims)
+			}
+		})
+	}
+}
+
+func TestSigningKeyFollowsReload(t *testing.T) {
+	previous := jwtKeys.Load()
+	defer jwtKeys.Store(previous)
+
+	storeJWTKeys([]config.JWTKey{{ID: "a", Secret: "first"}})
+	t.Setenv("JWT_SECRETS_FILE", "")
+	t.Setenv("JWT_SECRETS", "b:second, a:first")
+	n, err := ReloadJWTKeys()
+	if err != nil || n != 2 {
+		t.Fatalf("ReloadJWTKeys() = %d, %v, want 2 keys", n, err)
+	}
+	if key := SigningKey(); key.ID != "b" || key.Secret != "second" {
+		t.Errorf("SigningKey() = %+v, want kid b", key)
+	}
+
+	t.Setenv("JWT_SECRETS", "b:one,b:two")
+	if _, err := ReloadJWTKeys(); err == nil {
+		t.Fatal("ReloadJWTKeys() accepted a kid listed twice")
+	}
+	if key := SigningKey(); key.ID != "b" || key.Secret != "second" {
+		t.Errorf("SigningKey() = %+v after a failed reload, want the previous keys", key)
+	}
+}
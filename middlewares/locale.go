@@ -0,0 +1,63 @@
+package middlewares
+
+import (
+	"encoding/json"
+
+	"github.com/gin-gonic/gin"
+
+	"skillsync-api-gateway/config"
+	"skillsync-api-gateway/utils/i18n"
+)
+
+// LocaleQueryParam overrides Accept-Language, e.g. ?lang=fr
+const LocaleQueryParam = "lang"
+
+// Locale picks the response language from ?lang= or Accept-Language among the
+// SUPPORTED_LOCALES, falling back to English. It exposes it as "locale", attaches it
+// to the request context so the gRPC clients forward it as accept-language metadata,
+// sets Content-Language, and translates JSON errors that carry an error_code.
+// Handlers must pass c.Request.Context() to the clients for the locale to be sent.
+func Locale() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		locale := negotiateLocale(c)
+		c.Set("locale", locale)
+		c.Request = c.Request.WithContext(i18n.WithLocale(c.Request.Context(), locale))
+		c.Header("Content-Language", locale)
+		c.Writer.Header().Add("Vary", "Accept-Language")
+
+		if !i18n.HasCatalog(locale) {
+			c.Next()
+			return
+		}
+		writer := &errorBodyWriter{ResponseWriter: c.Writer}
+		writer.rewrite = func(envelope map[string]interface{}) []byte {
+			if !i18n.Localize(locale, envelope) {
+				return nil
+			}
+			body, err := json.Marshal(envelope)
+			if err != nil {
+				return nil
+			}
+			return body
+		}
+		c.Writer = writer
+		defer func() {
+			writer.finish()
+			c.Writer = writer.ResponseWriter
+		}()
+		c.Next()
+	}
+}
+
+// negotiateLocale prefers a supported ?lang= over Accept-Language
+func negotiateLocale(c *gin.Context) string {
+	if lang := c.Query(LocaleQueryParam); lang != "" {
+		if locale := i18n.Match(lang, cfg.Locales); locale != "" {
+			return locale
+		}
+	}
+	if locale := i18n.Negotiate(c.GetHeader("Accept-Language"), cfg.Locales); locale != "" {
+		return locale
+	}
+	return config.DefaultLocale
+}
@@ -0,0 +1,56 @@
+package middlewares
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"skillsync-api-gateway/utils/i18n"
+)
+
+func TestLocale(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	french, _ := i18n.Message("fr", "captcha_failed")
+	tests := []struct {
+		name         string
+		target       string
+		accept       string
+		wantLanguage string
+		wantBody     string
+	}{
+		{"default", "/", "", "en", "CAPTCHA failed"},
+		{"header", "/", "fr-FR,fr;q=0.9", "fr", french},
+		{"query overrides header", "/?lang=en", "fr", "en", "CAPTCHA failed"},
+		{"unsupported query falls back to header", "/?lang=de", "fr", "fr", french},
+		{"unsupported", "/", "de", "en", "CAPTCHA failed"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ctxLocale string
+			r := gin.New()
+			r.Use(Locale())
+			r.GET("/", func(c *gin.Context) {
+				ctxLocale = i18n.FromContext(c.Request.Context())
+				c.JSON(http.StatusBadRequest, gin.H{"error": "CAPTCHA failed", "error_code": "captcha_failed"})
+			})
+			req := httptest.NewRequest(http.MethodGet, tt.target, nil)
+			if tt.accept != "" {
+				req.Header.Set("Accept-Language", tt.accept)
+			}
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+			if got := w.Header().Get("Content-Language"); got != tt.wantLanguage {
+				t.Errorf("Content-Language = %q, want %q", got, tt.wantLanguage)
+			}
+			if ctxLocale != tt.wantLanguage {
+				t.Errorf("context locale = %q, want %q", ctxLocale, tt.wantLanguage)
+			}
+			if !strings.Contains(w.Body.String(), tt.wantBody) {
+				t.Errorf("body = %s, want it to contain %q", w.Body, tt.wantBody)
+			}
+		})
+	}
+}
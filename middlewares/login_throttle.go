@@ -0,0 +1,236 @@
+package middlewares
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"expvar"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// maxLoginBodySize bounds how much of the login body is read to find the email
+	maxLoginBodySize = 64 << 10
+	// lockoutMemory is how long without failures before lockouts stop escalating
+	lockoutMemory = 24 * time.Hour
+)
+
+// loginThrottleMetrics are published on the pprof server at /debug/vars
+var loginThrottleMetrics = expvar.NewMap("login_throttle")
+
+// LockoutPolicy is when and for how long an identifier gets locked out
+type LockoutPolicy struct {
+	MaxFailures int
+	Window      time.Duration
+	Lockout     time.Duration
+	MaxLockout  time.Duration
+}
+
+// LoginAttemptStore records failed logins per identifier ("email:..." or "ip:...").
+// It is an interface so a shared store can replace the in-memory one when the
+// gateway runs on several instances.
+type LoginAttemptStore interface {
+	// LockedFor returns how much longer key is locked out, or 0
+	LockedFor(ctx context.Context, key string) (time.Duration, error)
+	// RecordFailure counts a failure and returns the lockout it started, or 0
+	RecordFailure(ctx context.Context, key string, policy LockoutPolicy) (time.Duration, error)
+	// Reset forgets key's failures and lockouts
+	Reset(ctx context.Context, key string) error
+}
+
+type loginAttempts struct {
+	failures    int
+	windowStart time.Time
+	lastFailure time.Time
+	lockouts    int
+	lockedUntil time.Time
+}
+
+// MemoryLoginAttemptStore keeps login failures in process
+type MemoryLoginAttemptStore struct {
+	mutex   sync.Mutex
+	entries map[string]*loginAttempts
+}
+
+func NewMemoryLoginAttemptStore() *MemoryLoginAttemptStore {
+	return &MemoryLoginAttemptStore{entries: make(map[string]*loginAttempts)}
+}
+
+func (s *MemoryLoginAttemptStore) LockedFor(_ context.Context, key string) (time.Duration, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	entry, ok := s.entries[key]
+	if !ok {
+		return 0, nil
+	}
+	if remaining := time.Until(entry.lockedUntil); remaining > 0 {
+		return remaining, nil
+	}
+	return 0, nil
+}
+
+func (s *MemoryLoginAttemptStore) RecordFailure(_ context.Context, key string, policy LockoutPolicy) (time.Duration, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	now := time.Now()
+	// Entries idle for a day are swept here rather than by a background goroutine
+	for k, entry := range s.entries {
+		if now.Sub(entry.lastFailure) > lockoutMemory && now.After(entry.lockedUntil) {
+			delete(s.entries, k)
+		}
+	}
+
+	entry, ok := s.entries[key]
+	if !ok {
+		entry = &loginAttempts{windowStart: now}
+		s.entries[key] = entry
+	}
+	if now.Sub(entry.windowStart) > policy.Window {
+		entry.failures = 0
+		entry.windowStart = now
+	}
+	entry.failures++
+	entry.lastFailure = now
+	if entry.failures < policy.MaxFailures {
+		return 0, nil
+	}
+
+	// Each lockout in a row doubles the previous one
+	lockout := policy.Lockout << entry.lockouts
+	if lockout > policy.MaxLockout || lockout <= 0 {
+		lockout = policy.MaxLockout
+	}
+	entry.lockouts++
+	entry.failures = 0
+	entry.windowStart = now
+	entry.lockedUntil = now.Add(lockout)
+	return lockout, nil
+}
+
+func (s *MemoryLoginAttemptStore) Reset(_ context.Context, key string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	delete(s.entries, key)
+	return nil
+}
+
+// DefaultLoginAttemptStore backs LoginThrottle and the admin lockout endpoint
+var DefaultLoginAttemptStore LoginAttemptStore = NewMemoryLoginAttemptStore()
+
+// LoginEmailKey and LoginIPKey name the identifiers kept in a LoginAttemptStore
+func LoginEmailKey(email string) string {
+	return "email:" + strings.ToLower(strings.TrimSpace(email))
+}
+
+func LoginIPKey(ip string) string {
+	return "ip:" + ip
+}
+
+// LoginThrottle locks out an email or client IP after repeated failed logins
+// (LOGIN_MAX_FAILURES, LOGIN_MAX_FAILURES_PER_IP within LOGIN_FAILURE_WINDOW), for a
+// lockout that doubles each time up to LOGIN_MAX_LOCKOUT. Locked requests get 429
+// before the auth service is called, with the same answer whether or not the email
+// exists. A 401, 403 or 404 from the login handler counts as a failure. Only a
+// login that issued tokens, see LoginIssued, resets the email's counter; a 2FA
+// challenge doesn't, since the password alone isn't a completed login.
+func LoginThrottle(store LoginAttemptStore) gin.HandlerFunc {
+	settings := cfg.Login
+	emailPolicy := LockoutPolicy{
+		MaxFailures: settings.MaxFailures,
+		Window:      settings.Window,
+		Lockout:     settings.Lockout,
+		MaxLockout:  settings.MaxLockout,
+	}
+	ipPolicy := emailPolicy
+	ipPolicy.MaxFailures = settings.MaxFailuresPerIP
+
+	return func(c *gin.Context) {
+		body, err := io.ReadAll(io.LimitReader(c.Request.Body, maxLoginBodySize))
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Failed to read request body"})
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+		var fields struct {
+			Email string `json:"email"`
+		}
+		_ = json.Unmarshal(body, &fields)
+
+		ctx := c.Request.Context()
+		keys := []string{LoginIPKey(c.ClientIP())}
+		if strings.TrimSpace(fields.Email) != "" {
+			keys = append(keys, LoginEmailKey(fields.Email))
+		}
+
+		var locked time.Duration
+		for _, key := range keys {
+			remaining, err := store.LockedFor(ctx, key)
+			if err != nil {
+				// A broken store shouldn't lock everyone out
+				log.Printf("Login throttle: lookup failed: %v", err)
+				continue
+			}
+			if remaining > locked {
+				locked = remaining
+			}
+		}
+		if locked > 0 {
+			loginThrottleMetrics.Add("rejected", 1)
+			abortLoginLocked(c, locked)
+			return
+		}
+
+		c.Next()
+
+		switch code := c.Writer.Status(); {
+		case code < http.StatusMultipleChoices:
+			if c.GetBool(loginIssuedKey) && len(keys) > 1 {
+				store.Reset(ctx, keys[1])
+			}
+		case code == http.StatusUnauthorized || code == http.StatusForbidden || code == http.StatusNotFound:
+			loginThrottleMetrics.Add("failures", 1)
+			for i, key := range keys {
+				policy := ipPolicy
+				if i > 0 {
+					policy = emailPolicy
+				}
+				lockout, err := store.RecordFailure(ctx, key, policy)
+				if err != nil {
+					log.Printf("Login throttle: recording failure failed: %v", err)
+					continue
+				}
+				if lockout > 0 {
+					loginThrottleMetrics.Add("lockouts", 1)
+					log.Printf("Login throttle: %s locked out for %s", strings.SplitN(key, ":", 2)[0], lockout)
+				}
+			}
+		}
+	}
+}
+
+// loginIssuedKey marks a request whose login handler issued tokens
+const loginIssuedKey = "login_issued"
+
+// LoginIssued tells LoginThrottle the login issued tokens, which clears the
+// email's failures
+func LoginIssued(c *gin.Context) {
+	c.Set(loginIssuedKey, true)
+}
+
+func abortLoginLocked(c *gin.Context, remaining time.Duration) {
+	c.Header("Retry-After", strconv.Itoa(int(remaining.Seconds())+1))
+	c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{
+		"error":      "Too many failed login attempts, please try again later",
+		"error_code": "login_locked",
+	})
+}
@@ -0,0 +1,65 @@
+package middlewares
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"skillsync-api-gateway/config"
+)
+
+func TestLoginThrottle(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	previous := cfg.Login
+	defer func() { cfg.Login = previous }()
+	cfg.Login = config.LoginThrottleConfig{MaxFailures: 3, MaxFailuresPerIP: 100, Window: time.Minute, Lockout: time.Minute, MaxLockout: time.Hour}
+
+	// Logins answer by password: "wrong" fails, "2fa" gets a challenge and
+	// anything else issues tokens
+	login := func(c *gin.Context) {
+		var body struct {
+			Password string `json:"password"`
+		}
+		c.ShouldBindJSON(&body)
+		switch body.Password {
+		case "wrong":
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid email or password"})
+		case "2fa":
+			c.JSON(http.StatusOK, gin.H{"2fa_required": true, "challenge_token": "c"})
+		default:
+			LoginIssued(c)
+			c.JSON(http.StatusOK, gin.H{"access_token": "t"})
+		}
+	}
+
+	tests := []struct {
+		name      string
+		passwords []string
+		wantLast  int
+	}{
+		{"locked after the failures", []string{"wrong", "wrong", "wrong", "right"}, http.StatusTooManyRequests},
+		{"tokens reset the failures", []string{"wrong", "wrong", "right", "wrong", "wrong", "right"}, http.StatusOK},
+		{"2fa challenge doesn't reset them", []string{"wrong", "wrong", "2fa", "wrong", "2fa"}, http.StatusTooManyRequests},
+		{"challenges aren't failures", []string{"2fa", "2fa", "2fa", "2fa"}, http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+			r.POST("/login", LoginThrottle(NewMemoryLoginAttemptStore()), login)
+			var w *httptest.ResponseRecorder
+			for _, password := range tt.passwords {
+				w = httptest.NewRecorder()
+				req := httptest.NewRequest(http.MethodPost, "/login", strings.NewReader(`{"email":"ada@example.com","password":"`+password+`"}`))
+				req.Header.Set("Content-Type", "application/json")
+				r.ServeHTTP(w, req)
+			}
+			if w.Code != tt.wantLast {
+				t.Errorf("last login status = %d (%s), want %d", w.Code, w.Body, tt.wantLast)
+			}
+		})
+	}
+}
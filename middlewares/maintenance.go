@@ -0,0 +1,131 @@
+package middlewares
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"skillsync-api-gateway/config"
+)
+
+const defaultMaintenanceRetryAfter = 300
+
+// MaintenanceServices are the backends that can be put into maintenance
+var MaintenanceServices = config.MaintenanceServiceNames
+
+// MaintenanceFlag is the runtime maintenance state of one backend
+type MaintenanceFlag struct {
+	Service    string    `json:"service"`
+	Enabled    bool      `json:"maintenance"`
+	Message    string    `json:"message,omitempty"`
+	RetryAfter int       `json:"retry_after_seconds"`
+	UpdatedBy  string    `json:"updated_by,omitempty"`
+	UpdatedAt  time.Time `json:"updated_at,omitempty"`
+}
+
+var (
+	maintenanceOnce  sync.Once
+	maintenanceMutex sync.RWMutex
+	maintenanceFlags map[string]*MaintenanceFlag
+)
+
+// loadMaintenanceFlags seeds the flags from the configured MAINTENANCE_SERVICES on first use
+func loadMaintenanceFlags() {
+	maintenanceOnce.Do(func() {
+		maintenanceFlags = make(map[string]*MaintenanceFlag, len(MaintenanceServices))
+		for _, service := range MaintenanceServices {
+			maintenanceFlags[service] = &MaintenanceFlag{Service: service, RetryAfter: defaultMaintenanceRetryAfter}
+		}
+		for _, service := range cfg.MaintenanceServices {
+			service = strings.ToLower(service)
+			if flag, ok := maintenanceFlags[service]; ok {
+				flag.Enabled = true
+				flag.UpdatedBy = "env"
+				flag.UpdatedAt = time.Now()
+				log.Printf("Maintenance: %s is in maintenance (MAINTENANCE_SERVICES)", service)
+			}
+		}
+	})
+}
+
+// SetMaintenance toggles maintenance for a backend at runtime and logs who did it
+func SetMaintenance(service string, enabled bool, message string, retryAfter int, updatedBy string) (MaintenanceFlag, error) {
+	loadMaintenanceFlags()
+	maintenanceMutex.Lock()
+	defer maintenanceMutex.Unlock()
+
+	flag, ok := maintenanceFlags[service]
+	if !ok {
+		return MaintenanceFlag{}, fmt.Errorf("unknown service %q; expected one of %s", service, strings.Join(MaintenanceServices, ", "))
+	}
+	if retryAfter <= 0 {
+		retryAfter = defaultMaintenanceRetryAfter
+	}
+	flag.Enabled = enabled
+	flag.Message = message
+	flag.RetryAfter = retryAfter
+	flag.UpdatedBy = updatedBy
+	flag.UpdatedAt = time.Now()
+	log.Printf("Maintenance: %s set to %t by %s", service, enabled, updatedBy)
+	return *flag, nil
+}
+
+// MaintenanceFlags returns a snapshot of every backend's flag, sorted by service
+func MaintenanceFlags() []MaintenanceFlag {
+	loadMaintenanceFlags()
+	maintenanceMutex.RLock()
+	defer maintenanceMutex.RUnlock()
+
+	flags := make([]MaintenanceFlag, 0, len(maintenanceFlags))
+	for _, flag := range maintenanceFlags {
+		flags = append(flags, *flag)
+	}
+	sort.Slice(flags, func(i, j int) bool { return flags[i].Service < flags[j].Service })
+	return flags
+}
+
+// InMaintenance reports whether service is in maintenance, with its flag
+func InMaintenance(service string) (MaintenanceFlag, bool) {
+	loadMaintenanceFlags()
+	maintenanceMutex.RLock()
+	defer maintenanceMutex.RUnlock()
+
+	flag, ok := maintenanceFlags[service]
+	if !ok || !flag.Enabled {
+		return MaintenanceFlag{}, false
+	}
+	return *flag, true
+}
+
+// AbortForMaintenance sends the 503 maintenance response
+func AbortForMaintenance(c *gin.Context, flag MaintenanceFlag) {
+	message := flag.Message
+	if message == "" {
+		message = "This feature is temporarily unavailable for maintenance. Please try again later."
+	}
+	c.Header("Retry-After", strconv.Itoa(flag.RetryAfter))
+	c.AbortWithStatusJSON(http.StatusServiceUnavailable, gin.H{
+		"error":       message,
+		"maintenance": true,
+		"service":     flag.Service,
+	})
+}
+
+// Maintenance short-circuits with 503 while service is in maintenance. It should run
+// first in a group so no gRPC call (including API key lookups) is attempted.
+func Maintenance(service string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if flag, ok := InMaintenance(service); ok {
+			AbortForMaintenance(c, flag)
+			return
+		}
+		c.Next()
+	}
+}
@@ -0,0 +1,12 @@
+package middlewares
+
+import "github.com/gin-gonic/gin"
+
+// MockMode marks every response as served from sample data (MOCK_MODE), so a
+// mock response is never mistaken for a real one
+func MockMode() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Header("X-Mock-Mode", "true")
+		c.Next()
+	}
+}
@@ -0,0 +1,109 @@
+package middlewares
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	authpb "github.com/shahal0/skillsync-protos/gen/authpb"
+	"google.golang.org/grpc/metadata"
+
+	"skillsync-api-gateway/clients"
+	"skillsync-api-gateway/utils/cache"
+)
+
+// Subscription plans of an employer account
+const (
+	PlanFree = "free"
+	PlanPro  = "pro"
+)
+
+const planLookupTimeout = 3 * time.Second
+
+// planCache keeps each employer's plan for PLAN_CACHE_TTL, so gated routes don't
+// ask the billing backend on every request
+var planCache = cache.NewTTLCache[*authpb.EmployerPlanResponse](time.Minute)
+
+// EmployerPlan returns an employer's subscription plan, from the cache if it was
+// read recently. The plan is shared, so callers must not modify it.
+func EmployerPlan(ctx context.Context, employerID string) (*authpb.EmployerPlanResponse, error) {
+	if plan, ok := planCache.Get(employerID); ok {
+		return plan, nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, planLookupTimeout)
+	defer cancel()
+	ctx = metadata.NewOutgoingContext(ctx, metadata.New(map[string]string{
+		"user-id": employerID,
+		"role":    "employer",
+	}))
+	plan, err := clients.AuthServiceClient.GetEmployerPlan(ctx, &authpb.GetEmployerPlanRequest{EmployerId: employerID})
+	if err != nil {
+		return nil, err
+	}
+	planCache.Set(employerID, plan)
+	return plan, nil
+}
+
+// PlanName is the plan's name, free when the backend doesn't name one
+func PlanName(plan *authpb.EmployerPlanResponse) string {
+	if name := strings.ToLower(strings.TrimSpace(plan.GetPlan())); name != "" {
+		return name
+	}
+	return PlanFree
+}
+
+// UpgradeURL is where an employer upgrades their plan
+func UpgradeURL() string {
+	return strings.TrimRight(cfg.FrontendURL, "/") + "/billing/upgrade"
+}
+
+// PlanUnavailable answers a request whose plan check couldn't be made, unless
+// PLAN_CHECK_FAIL_OPEN lets it through. It reports whether the request may go on.
+func PlanUnavailable(c *gin.Context, err error) bool {
+	if cfg.Plans.FailOpen {
+		log.Printf("Plan unavailable, letting %s %s through: %v", c.Request.Method, c.FullPath(), err)
+		return true
+	}
+	log.Printf("Plan unavailable: %v", err)
+	c.AbortWithStatusJSON(http.StatusServiceUnavailable, gin.H{
+		"error":      "Your plan can't be checked right now, please try again",
+		"error_code": "plan_unavailable",
+	})
+	return false
+}
+
+// RequirePlan allows employers through only on one of plans, answering others
+// with 402 and where to upgrade. Other users are left to the route's own checks.
+// It must run after JWTMiddleware.
+func RequirePlan(plans ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.GetString("user_role") != "employer" {
+			c.Next()
+			return
+		}
+		plan, err := EmployerPlan(c.Request.Context(), c.GetString("user_id"))
+		if err != nil {
+			if PlanUnavailable(c, err) {
+				c.Next()
+			}
+			return
+		}
+		current := PlanName(plan)
+		for _, allowed := range plans {
+			if current == allowed {
+				c.Next()
+				return
+			}
+		}
+		c.AbortWithStatusJSON(http.StatusPaymentRequired, gin.H{
+			"error":         "Your plan does not include this feature, upgrade to " + strings.Join(plans, " or ") + " to use it",
+			"error_code":    "plan_upgrade_required",
+			"current_plan":  current,
+			"required_plan": plans,
+			"upgrade_url":   UpgradeURL(),
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package middlewares
+
+import (
+	"encoding/json"
+
+	"github.com/gin-gonic/gin"
+
+	"skillsync-api-gateway/utils"
+)
+
+// ProblemDetails renders JSON error responses as RFC 7807 problem documents for
+// clients that send "Accept: application/problem+json"; everyone else keeps the
+// {"error": ...} envelope. It converts responses after the fact, so handlers,
+// middlewares (including JWT 401s) and the 404/405 fallbacks need no changes.
+func ProblemDetails() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !utils.WantsProblemJSON(c.GetHeader("Accept")) {
+			c.Next()
+			return
+		}
+		c.Writer.Header().Add("Vary", "Accept")
+
+		writer := &errorBodyWriter{ResponseWriter: c.Writer}
+		writer.rewrite = func(envelope map[string]interface{}) []byte {
+			problem := utils.ProblemFromEnvelope(writer.Status(), envelope, c.GetString("request_id"))
+			doc, err := json.Marshal(problem)
+			if err != nil {
+				return nil
+			}
+			writer.Header().Set("Content-Type", utils.ProblemContentType)
+			return doc
+		}
+		c.Writer = writer
+		defer func() {
+			writer.finish()
+			c.Writer = writer.ResponseWriter
+		}()
+		c.Next()
+	}
+}
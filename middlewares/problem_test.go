@@ -0,0 +1,48 @@
+package middlewares
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestProblemDetails(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	tests := []struct {
+		name            string
+		accept          string
+		path            string
+		wantContentType string
+		wantBody        string
+	}{
+		{"problem error", "application/problem+json", "/fail", "application/problem+json", `"type":"https://api.skillsync.dev/errors/job_closed"`},
+		{"plain error", "application/json", "/fail", "application/json; charset=utf-8", `{"error":"Job is closed","error_code":"job_closed"}`},
+		{"problem success untouched", "application/problem+json", "/ok", "application/json; charset=utf-8", `{"id":1}`},
+		{"text error untouched", "application/problem+json", "/text", "text/plain; charset=utf-8", "down"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+			r.Use(ProblemDetails())
+			r.GET("/fail", func(c *gin.Context) {
+				c.JSON(http.StatusConflict, gin.H{"error": "Job is closed", "error_code": "job_closed"})
+			})
+			r.GET("/ok", func(c *gin.Context) { c.JSON(http.StatusOK, gin.H{"id": 1}) })
+			r.GET("/text", func(c *gin.Context) { c.String(http.StatusServiceUnavailable, "down") })
+
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			req.Header.Set("Accept", tt.accept)
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+			if got := w.Header().Get("Content-Type"); got != tt.wantContentType {
+				t.Errorf("Content-Type = %q, want %q", got, tt.wantContentType)
+			}
+			if !strings.Contains(w.Body.String(), tt.wantBody) {
+				t.Errorf("body = %s, want it to contain %s", w.Body, tt.wantBody)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package middlewares
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// fixedWindowLimiter counts requests per key in fixed windows
+type fixedWindowLimiter struct {
+	mutex   sync.Mutex
+	window  time.Duration
+	windows map[string]*rateWindow
+}
+
+type rateWindow struct {
+	start time.Time
+	count int
+}
+
+func newFixedWindowLimiter(window time.Duration) *fixedWindowLimiter {
+	return &fixedWindowLimiter{window: window, windows: make(map[string]*rateWindow)}
+}
+
+var apiKeyLimiter = newFixedWindowLimiter(time.Minute)
+
+func (l *fixedWindowLimiter) allow(id string, limit int) (bool, time.Duration) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	now := time.Now()
+	window, ok := l.windows[id]
+	if !ok || now.Sub(window.start) >= l.window {
+		// Drop windows that ended, so idle keys don't accumulate
+		for key, w := range l.windows {
+			if now.Sub(w.start) >= l.window {
+				delete(l.windows, key)
+			}
+		}
+		l.windows[id] = &rateWindow{start: now, count: 1}
+		return true, 0
+	}
+	if window.count >= limit {
+		return false, l.window - now.Sub(window.start)
+	}
+	window.count++
+	return true, 0
+}
+
+// RateLimitPerUser allows each user (or client IP before authentication) at most
+// limit requests to the route per window, answering 429 with Retry-After beyond that.
+// Each call gets its own counters, so limits on different routes don't add up.
+func RateLimitPerUser(limit int, window time.Duration) gin.HandlerFunc {
+	limiter := newFixedWindowLimiter(window)
+	return func(c *gin.Context) {
+		key := c.GetString("user_id")
+		if key == "" {
+			key = "ip:" + c.ClientIP()
+		}
+		if allowed, retryAfter := limiter.allow(key, limit); !allowed {
+			c.Header("Retry-After", strconv.Itoa(int(retryAfter.Seconds())+1))
+			c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{
+				"error":      "Too many attempts, please try again later",
+				"error_code": "rate_limited",
+			})
+			return
+		}
+		c.Next()
+	}
+}
@@ -0,0 +1,58 @@
+package middlewares
+
+import (
+	"sync"
+	"time"
+)
+
+// SendThrottle spaces out messages sent on a user's behalf, such as OTP emails: at
+// most one per cooldown and limit per hour for each key. The hourly count shares
+// the rate limiter's fixed windows.
+type SendThrottle struct {
+	mutex    sync.Mutex
+	cooldown time.Duration
+	limit    int
+	last     map[string]time.Time
+	hourly   *fixedWindowLimiter
+}
+
+// NewSendThrottle allows one send per cooldown and limit sends per hour for each key
+func NewSendThrottle(cooldown time.Duration, limit int) *SendThrottle {
+	return &SendThrottle{
+		cooldown: cooldown,
+		limit:    limit,
+		last:     make(map[string]time.Time),
+		hourly:   newFixedWindowLimiter(time.Hour),
+	}
+}
+
+// Allow reserves a send for key and returns when the next one may be made, or
+// reports false and how long to wait when key is cooling down or used up its hour
+func (t *SendThrottle) Allow(key string) (bool, time.Duration) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	now := time.Now()
+	if remaining := t.cooldown - now.Sub(t.last[key]); remaining > 0 {
+		return false, remaining
+	}
+	if allowed, retryAfter := t.hourly.allow(key, t.limit); !allowed {
+		return false, retryAfter
+	}
+	// Drop cooldowns that ended, so idle keys don't accumulate
+	for k, sent := range t.last {
+		if now.Sub(sent) >= t.cooldown {
+			delete(t.last, k)
+		}
+	}
+	t.last[key] = now
+	return true, t.cooldown
+}
+
+// Release lifts key's cooldown after a send that didn't happen, e.g. because the
+// backend failed, so the user can try again at once. It still counts toward the hour.
+func (t *SendThrottle) Release(key string) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	delete(t.last, key)
+}
@@ -0,0 +1,83 @@
+package middlewares
+
+import (
+	"expvar"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"skillsync-api-gateway/clients"
+	"skillsync-api-gateway/config"
+	"skillsync-api-gateway/utils/slo"
+)
+
+var (
+	// sloLatencyViolations counts requests slower than their route's target, per route
+	sloLatencyViolations = expvar.NewMap("slo_latency_violations")
+	// sloErrors counts requests that failed with a 5xx, per route
+	sloErrors = expvar.NewMap("slo_errors")
+)
+
+// sloTracker keeps the last SLO_WINDOW of outcomes for GET /admin/slo
+var sloTracker = slo.New(cfg.SLOs.Window)
+
+// SLOTracker is the tracker request outcomes are recorded in
+func SLOTracker() *slo.Tracker {
+	return sloTracker
+}
+
+// SLORoute is the name a request's outcomes are tracked under, e.g. GET /jobs/:id
+func SLORoute(method, route string) string {
+	return method + " " + route
+}
+
+// SLO times each request against its route's objective (SLO_*). Requests slower
+// than the route's latency target are logged with where the time went, using the
+// backend call times the clients record, and every outcome is counted for GET
+// /admin/slo. Paths no route matched aren't tracked.
+func SLO() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		timing := &clients.CallTiming{}
+		c.Request = c.Request.WithContext(clients.WithCallTiming(c.Request.Context(), timing))
+
+		c.Next()
+
+		route := c.FullPath()
+		if route == "" {
+			return
+		}
+		end := time.Now()
+		latency := end.Sub(start)
+		objective, _ := cfg.SLOs.For(config.PolicyName(route))
+		name := SLORoute(c.Request.Method, route)
+		failed := c.Writer.Status() >= http.StatusInternalServerError
+		slow := objective.Latency > 0 && latency > objective.Latency
+		sloTracker.Record(name, latency, failed, slow)
+
+		if failed {
+			sloErrors.Add(name, 1)
+		}
+		if slow {
+			sloLatencyViolations.Add(name, 1)
+			logSlowRequest(c, name, latency, objective.Latency, start, end, timing.Times())
+		}
+	}
+}
+
+// logSlowRequest breaks a slow request down into the time before its first backend
+// call, the backend calls and the time after the last one, which is mostly the
+// response being built and serialized
+func logSlowRequest(c *gin.Context, name string, latency, target time.Duration, start, end time.Time, calls clients.CallTimes) {
+	if calls.Calls == 0 {
+		log.Printf("[SLO] WARN slow request route=%q status=%d latency=%s target=%s backend_calls=0 request_id=%s",
+			name, c.Writer.Status(), latency.Round(time.Microsecond), target, c.GetString("request_id"))
+		return
+	}
+	log.Printf("[SLO] WARN slow request route=%q status=%d latency=%s target=%s to_backend=%s backend=%s backend_calls=%d after_backend=%s request_id=%s",
+		name, c.Writer.Status(), latency.Round(time.Microsecond), target,
+		calls.First.Sub(start).Round(time.Microsecond), calls.Total.Round(time.Microsecond), calls.Calls,
+		end.Sub(calls.Last).Round(time.Microsecond), c.GetString("request_id"))
+}
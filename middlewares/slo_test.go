@@ -0,0 +1,61 @@
+package middlewares
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"skillsync-api-gateway/utils/slo"
+)
+
+// BenchmarkSLO measures what SLO adds to a request against the same route served
+// without it, from one goroutine and from many recording into the same route
+func BenchmarkSLO(b *testing.B) {
+	gin.SetMode(gin.TestMode)
+	previous := sloTracker
+	sloTracker = slo.New(cfg.SLOs.Window)
+	b.Cleanup(func() { sloTracker = previous })
+
+	handler := func(c *gin.Context) { c.Status(http.StatusOK) }
+	bare := gin.New()
+	bare.GET("/jobs/:id", handler)
+	tracked := gin.New()
+	tracked.Use(SLO())
+	tracked.GET("/jobs/:id", handler)
+
+	for _, bb := range []struct {
+		name     string
+		engine   *gin.Engine
+		parallel bool
+	}{
+		{"without", bare, false},
+		{"with", tracked, false},
+		{"without parallel", bare, true},
+		{"with parallel", tracked, true},
+	} {
+		b.Run(bb.name, func(b *testing.B) {
+			serve := func() {
+				w := httptest.NewRecorder()
+				bb.engine.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/jobs/42", nil))
+				if w.Code != http.StatusOK {
+					b.Errorf("status = %d, want 200", w.Code)
+				}
+			}
+			b.ReportAllocs()
+			b.ResetTimer()
+			if bb.parallel {
+				b.RunParallel(func(pb *testing.PB) {
+					for pb.Next() {
+						serve()
+					}
+				})
+				return
+			}
+			for i := 0; i < b.N; i++ {
+				serve()
+			}
+		})
+	}
+}
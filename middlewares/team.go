@@ -0,0 +1,139 @@
+package middlewares
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Team roles of the people sharing an employer account. Tokens issued before
+// teams existed have no team_role claim and belong to the account's owner.
+const (
+	TeamRoleOwner     = "owner"
+	TeamRoleRecruiter = "recruiter"
+	TeamRoleViewer    = "viewer"
+)
+
+// TeamRoles are the roles a member can be given
+var TeamRoles = []string{TeamRoleOwner, TeamRoleRecruiter, TeamRoleViewer}
+
+// memberTokenLifetime bounds how long a member's access token can live, so a
+// change to their membership only needs remembering that long
+const memberTokenLifetime = 24 * time.Hour
+
+// memberRevocations holds, per member, when their membership last changed. Tokens
+// issued before then carry a stale role and are refused.
+var memberRevocations = struct {
+	mutex     sync.RWMutex
+	changedAt map[string]time.Time
+}{changedAt: make(map[string]time.Time)}
+
+// RevokeTeamMember refuses the member's current tokens, after their role changed
+// or they were removed, so the next request needs a fresh login
+func RevokeTeamMember(memberID string) {
+	if memberID == "" {
+		return
+	}
+	memberRevocations.mutex.Lock()
+	defer memberRevocations.mutex.Unlock()
+
+	now := time.Now()
+	for id, changedAt := range memberRevocations.changedAt {
+		if now.Sub(changedAt) > memberTokenLifetime {
+			delete(memberRevocations.changedAt, id)
+		}
+	}
+	memberRevocations.changedAt[memberID] = now
+}
+
+// memberRevoked reports whether a token issued at issuedAt predates a change to
+// the member's membership. iat has whole seconds, so a token from the second of
+// the change is still accepted rather than refusing the login that follows it.
+func memberRevoked(memberID string, issuedAt time.Time) bool {
+	memberRevocations.mutex.RLock()
+	defer memberRevocations.mutex.RUnlock()
+	changedAt, ok := memberRevocations.changedAt[memberID]
+	return ok && issuedAt.Before(changedAt.Truncate(time.Second))
+}
+
+// setTeamClaims scopes an employer team member's request to the company account:
+// user_id becomes the account's ID, so every employer route acts for the company,
+// and the member's own ID is kept as member_id. It reports false, having aborted
+// the request, when the member's token predates a membership change.
+func setTeamClaims(c *gin.Context, claims jwt.MapClaims, userID string) bool {
+	if role, _ := claims["role"].(string); role != "employer" {
+		return true
+	}
+	teamRole, _ := claims["team_role"].(string)
+	if teamRole == "" {
+		teamRole = TeamRoleOwner
+	}
+	c.Set("team_role", teamRole)
+
+	employerID, _ := claims["employer_id"].(string)
+	if employerID == "" || employerID == userID {
+		return true
+	}
+	var issuedAt time.Time
+	if iat, err := claims.GetIssuedAt(); err == nil && iat != nil {
+		issuedAt = iat.Time
+	}
+	if memberRevoked(userID, issuedAt) {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+			"error":      "Your team membership changed, please sign in again",
+			"error_code": "team_membership_changed",
+		})
+		return false
+	}
+	c.Set("member_id", userID)
+	c.Set("user_id", employerID)
+	return true
+}
+
+// TeamRole is the caller's role on their employer account; employers without a
+// team_role claim, including API keys, own theirs
+func TeamRole(c *gin.Context) string {
+	if role := c.GetString("team_role"); role != "" {
+		return role
+	}
+	return TeamRoleOwner
+}
+
+// RequireTeamRole allows employers through only with one of roles on their
+// account. Other users are left to the route's own checks. It must run after
+// JWTMiddleware.
+func RequireTeamRole(roles ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.GetString("user_role") != "employer" {
+			c.Next()
+			return
+		}
+		role := TeamRole(c)
+		for _, allowed := range roles {
+			if role == allowed {
+				c.Next()
+				return
+			}
+		}
+		c.AbortWithStatusJSON(http.StatusForbidden, gin.H{
+			"error":      "Your team role does not allow this",
+			"error_code": "team_role_forbidden",
+		})
+	}
+}
+
+// ReadOnlyForViewers lets team viewers make only safe (GET, HEAD) requests, so a
+// group's write routes need a recruiter or owner without listing each of them
+func ReadOnlyForViewers() gin.HandlerFunc {
+	writer := RequireTeamRole(TeamRoleOwner, TeamRoleRecruiter)
+	return func(c *gin.Context) {
+		if c.Request.Method == http.MethodGet || c.Request.Method == http.MethodHead {
+			c.Next()
+			return
+		}
+		writer(c)
+	}
+}
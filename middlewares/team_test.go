@@ -0,0 +1,101 @@
+package middlewares
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func TestSetTeamClaims(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	RevokeTeamMember("m-revoked")
+	changedAt := memberRevocations.changedAt["m-revoked"]
+
+	tests := []struct {
+		name         string
+		claims       jwt.MapClaims
+		userID       string
+		wantOK       bool
+		wantUserID   string
+		wantMemberID string
+		wantTeamRole string
+	}{
+		{"candidate", jwt.MapClaims{"role": "candidate", "team_role": "viewer"}, "c1", true, "c1", "", ""},
+		{"employer before teams", jwt.MapClaims{"role": "employer"}, "e1", true, "e1", "", TeamRoleOwner},
+		{"owner of their own account", jwt.MapClaims{"role": "employer", "team_role": "owner", "employer_id": "e1"}, "e1", true, "e1", "", TeamRoleOwner},
+		{"member", jwt.MapClaims{"role": "employer", "team_role": "viewer", "employer_id": "e1"}, "m1", true, "e1", "m1", TeamRoleViewer},
+		{
+			"member token from before the change",
+			jwt.MapClaims{"role": "employer", "team_role": "recruiter", "employer_id": "e1", "iat": float64(changedAt.Add(-time.Hour).Unix())},
+			"m-revoked", false, "m-revoked", "", TeamRoleRecruiter,
+		},
+		{
+			"member token from the second of the change",
+			jwt.MapClaims{"role": "employer", "team_role": "recruiter", "employer_id": "e1", "iat": float64(changedAt.Unix())},
+			"m-revoked", true, "e1", "m-revoked", TeamRoleRecruiter,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Set("user_id", tt.userID)
+
+			if ok := setTeamClaims(c, tt.claims, tt.userID); ok != tt.wantOK {
+				t.Fatalf("setTeamClaims() = %v, want %v", ok, tt.wantOK)
+			}
+			if !tt.wantOK && w.Code != http.StatusUnauthorized {
+				t.Errorf("status = %d, want 401", w.Code)
+			}
+			if got := c.GetString("user_id"); got != tt.wantUserID {
+				t.Errorf("user_id = %q, want %q", got, tt.wantUserID)
+			}
+			if got := c.GetString("member_id"); got != tt.wantMemberID {
+				t.Errorf("member_id = %q, want %q", got, tt.wantMemberID)
+			}
+			if got := c.GetString("team_role"); got != tt.wantTeamRole {
+				t.Errorf("team_role = %q, want %q", got, tt.wantTeamRole)
+			}
+		})
+	}
+}
+
+func TestRequireTeamRole(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	tests := []struct {
+		name       string
+		handler    gin.HandlerFunc
+		method     string
+		userRole   string
+		teamRole   string
+		wantStatus int
+	}{
+		{"owner", RequireTeamRole(TeamRoleOwner), http.MethodPost, "employer", TeamRoleOwner, http.StatusNoContent},
+		{"no team role is the owner", RequireTeamRole(TeamRoleOwner), http.MethodPost, "employer", "", http.StatusNoContent},
+		{"recruiter refused", RequireTeamRole(TeamRoleOwner), http.MethodPost, "employer", TeamRoleRecruiter, http.StatusForbidden},
+		{"candidate left to the route", RequireTeamRole(TeamRoleOwner), http.MethodPost, "candidate", "", http.StatusNoContent},
+		{"viewer reads", ReadOnlyForViewers(), http.MethodGet, "employer", TeamRoleViewer, http.StatusNoContent},
+		{"viewer writes", ReadOnlyForViewers(), http.MethodDelete, "employer", TeamRoleViewer, http.StatusForbidden},
+		{"recruiter writes", ReadOnlyForViewers(), http.MethodPut, "employer", TeamRoleRecruiter, http.StatusNoContent},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+			r.Handle(tt.method, "/team", func(c *gin.Context) {
+				c.Set("user_role", tt.userRole)
+				if tt.teamRole != "" {
+					c.Set("team_role", tt.teamRole)
+				}
+			}, tt.handler, func(c *gin.Context) { c.Status(http.StatusNoContent) })
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, httptest.NewRequest(tt.method, "/team", nil))
+			if w.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", w.Code, tt.wantStatus)
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package middlewares
+
+import (
+	"container/list"
+	"expvar"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// tokenCacheMetrics are published on the pprof server at /debug/vars
+var tokenCacheMetrics = expvar.NewMap("jwt_cache")
+
+// verifiedToken is a token whose signature and claims were checked
+type verifiedToken struct {
+	key       string
+	claims    jwt.MapClaims
+	expiresAt time.Time
+}
+
+// tokenCache remembers the claims of tokens that passed verification, so an
+// active user's requests skip re-parsing and re-verifying the same token. Only
+// valid tokens with an exp are cached, and only until then. The least recently
+// used token is dropped when the cache is full.
+type tokenCache struct {
+	mutex      sync.Mutex
+	size       int
+	order      *list.List // of *verifiedToken, most recently used first
+	entries    map[string]*list.Element
+	generation uint64
+}
+
+func newTokenCache(size int) *tokenCache {
+	return &tokenCache{size: size, order: list.New(), entries: make(map[string]*list.Element)}
+}
+
+// verifiedTokens is sized by JWT_CACHE_SIZE; nil, the default, disables caching
+var verifiedTokens *tokenCache
+
+// get returns the claims of the token hashed to key, if it was verified under the
+// current keys and hasn't expired
+func (c *tokenCache) get(key string) (jwt.MapClaims, bool) {
+	if c == nil {
+		return nil, false
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	element, ok := c.entries[key]
+	if !ok {
+		tokenCacheMetrics.Add("misses", 1)
+		return nil, false
+	}
+	token := element.Value.(*verifiedToken)
+	if !time.Now().Before(token.expiresAt) {
+		c.removeElement(element)
+		tokenCacheMetrics.Add("misses", 1)
+		return nil, false
+	}
+	c.order.MoveToFront(element)
+	tokenCacheMetrics.Add("hits", 1)
+	return token.claims, true
+}
+
+// start returns the generation to pass to put, taken before verifying a token
+func (c *tokenCache) start() uint64 {
+	if c == nil {
+		return 0
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.generation
+}
+
+// put caches the claims of a token verified since start returned generation. A
+// key change in the meantime means it was verified against keys no longer in use,
+// so it isn't cached.
+func (c *tokenCache) put(key string, claims jwt.MapClaims, generation uint64) {
+	if c == nil {
+		return
+	}
+	exp, err := claims.GetExpirationTime()
+	if err != nil || exp == nil || !time.Now().Before(exp.Time) {
+		return
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if generation != c.generation {
+		return
+	}
+	if element, ok := c.entries[key]; ok {
+		c.removeElement(element)
+	}
+	c.entries[key] = c.order.PushFront(&verifiedToken{key: key, claims: claims, expiresAt: exp.Time})
+	for c.order.Len() > c.size {
+		c.removeElement(c.order.Back())
+	}
+}
+
+// remove forgets one token, e.g. once it is revoked
+func (c *tokenCache) remove(key string) {
+	if c == nil {
+		return
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if element, ok := c.entries[key]; ok {
+		c.removeElement(element)
+	}
+}
+
+// purge forgets every token, when the keys they were verified with change
+func (c *tokenCache) purge() {
+	if c == nil {
+		return
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.generation++
+	c.order.Init()
+	c.entries = make(map[string]*list.Element)
+}
+
+func (c *tokenCache) removeElement(element *list.Element) {
+	c.order.Remove(element)
+	delete(c.entries, element.Value.(*verifiedToken).key)
+}
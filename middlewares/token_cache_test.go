@@ -0,0 +1,217 @@
+package middlewares
+
+import (
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+
+	"skillsync-api-gateway/config"
+)
+
+func claimsExpiringIn(d time.Duration) jwt.MapClaims {
+	return jwt.MapClaims{"user_id": "u1", "exp": float64(time.Now().Add(d).Unix())}
+}
+
+func TestTokenCache(t *testing.T) {
+	valid := claimsExpiringIn(time.Hour)
+	tests := []struct {
+		name string
+		run  func(c *tokenCache)
+		want map[string]bool
+	}{
+		{
+			"cached",
+			func(c *tokenCache) { c.put("a", valid, c.start()) },
+			map[string]bool{"a": true, "b": false},
+		},
+		{
+			"no exp isn't cached",
+			func(c *tokenCache) { c.put("a", jwt.MapClaims{"user_id": "u1"}, c.start()) },
+			map[string]bool{"a": false},
+		},
+		{
+			"expired isn't cached",
+			func(c *tokenCache) { c.put("a", claimsExpiringIn(-time.Minute), c.start()) },
+			map[string]bool{"a": false},
+		},
+		{
+			"least recently used dropped",
+			func(c *tokenCache) {
+				c.put("a", valid, c.start())
+				c.put("b", valid, c.start())
+				c.get("a")
+				c.put("c", valid, c.start())
+			},
+			map[string]bool{"a": true, "b": false, "c": true},
+		},
+		{
+			"put again refreshes",
+			func(c *tokenCache) {
+				c.put("a", valid, c.start())
+				c.put("b", valid, c.start())
+				c.put("a", valid, c.start())
+				c.put("c", valid, c.start())
+			},
+			map[string]bool{"a": true, "b": false, "c": true},
+		},
+		{
+			"removed",
+			func(c *tokenCache) {
+				c.put("a", valid, c.start())
+				c.put("b", valid, c.start())
+				c.remove("a")
+			},
+			map[string]bool{"a": false, "b": true},
+		},
+		{
+			"purged",
+			func(c *tokenCache) {
+				c.put("a", valid, c.start())
+				c.purge()
+			},
+			map[string]bool{"a": false},
+		},
+		{
+			"verified under the old keys",
+			func(c *tokenCache) {
+				generation := c.start()
+				c.purge()
+				c.put("a", valid, generation)
+			},
+			map[string]bool{"a": false},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTokenCache(2)
+			tt.run(c)
+			for key, want := range tt.want {
+				claims, ok := c.get(key)
+				if ok != want {
+					t.Errorf("get(%q) ok = %v, want %v", key, ok, want)
+				}
+				if ok && claims["user_id"] != "u1" {
+					t.Errorf("get(%q) = %v", key, claims)
+				}
+			}
+		})
+	}
+}
+
+func TestTokenCacheExpiry(t *testing.T) {
+	c := newTokenCache(2)
+	c.put("a", claimsExpiringIn(time.Hour), c.start())
+	c.order.Front().Value.(*verifiedToken).expiresAt = time.Now().Add(-time.Second)
+	if _, ok := c.get("a"); ok {
+		t.Fatal("get() returned a token past its exp")
+	}
+	if len(c.entries) != 0 || c.order.Len() != 0 {
+		t.Errorf("expired token kept: %d entries", len(c.entries))
+	}
+}
+
+func TestNilTokenCache(t *testing.T) {
+	var c *tokenCache
+	c.put("a", claimsExpiringIn(time.Hour), c.start())
+	c.remove("a")
+	c.purge()
+	if _, ok := c.get("a"); ok {
+		t.Error("get() on a disabled cache found a token")
+	}
+}
+
+// useTokenCache runs the test with the given keys and a fresh cache of verified tokens
+func useTokenCache(tb testing.TB, keys []config.JWTKey) {
+	previousKeys, previousCache := jwtKeys.Load(), verifiedTokens
+	verifiedTokens = newTokenCache(16)
+	storeJWTKeys(keys)
+	tb.Cleanup(func() {
+		jwtKeys.Store(previousKeys)
+		verifiedTokens = previousCache
+	})
+}
+
+func TestJWTMiddlewareBlacklistedCachedToken(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	useTokenCache(t, []config.JWTKey{{ID: "2024", Secret: "secret"}})
+	token := signToken(t, "2024", "secret")
+	t.Cleanup(func() {
+		blacklist.mutex.Lock()
+		delete(blacklist.entries, hashToken(token))
+		blacklist.mutex.Unlock()
+	})
+
+	r := gin.New()
+	r.GET("/", JWTMiddleware(), func(c *gin.Context) { c.Status(http.StatusOK) })
+	serve := func() *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	if w := serve(); w.Code != http.StatusOK {
+		t.Fatalf("status = %d (%s), want 200", w.Code, w.Body)
+	}
+	claims, cached := verifiedTokens.get(hashToken(token))
+	if !cached {
+		t.Fatal("token not cached after a verified request")
+	}
+
+	BlacklistToken(token, time.Now().Add(time.Hour))
+	// A request verifying the token as it was revoked may cache it again
+	verifiedTokens.put(hashToken(token), claims, verifiedTokens.start())
+	if w := serve(); w.Code != http.StatusUnauthorized {
+		t.Errorf("status = %d (%s), want 401 for a revoked token still in the cache", w.Code, w.Body)
+	}
+}
+
+// BenchmarkJWTMiddleware compares a request whose token is taken from the cache
+// with one that parses and verifies it
+func BenchmarkJWTMiddleware(b *testing.B) {
+	gin.SetMode(gin.TestMode)
+	log.SetOutput(io.Discard)
+	b.Cleanup(func() { log.SetOutput(os.Stderr) })
+	useTokenCache(b, []config.JWTKey{{ID: "2024", Secret: "secret"}})
+	// Admins aren't metered, so the quota doesn't cut the run short
+	unsigned := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"user_id": "u1", "role": "admin", "exp": float64(time.Now().Add(time.Hour).Unix())})
+	unsigned.Header["kid"] = "2024"
+	token, err := unsigned.SignedString([]byte("secret"))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	handler := JWTMiddleware()
+	cache := verifiedTokens
+	for _, bb := range []struct {
+		name  string
+		cache *tokenCache
+	}{
+		{"cached", cache},
+		{"parsed", nil},
+	} {
+		b.Run(bb.name, func(b *testing.B) {
+			verifiedTokens = bb.cache
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.Header.Set("Authorization", "Bearer "+token)
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				c, _ := gin.CreateTestContext(httptest.NewRecorder())
+				c.Request = req
+				handler(c)
+				if c.IsAborted() {
+					b.Fatalf("request refused: %d", c.Writer.Status())
+				}
+			}
+		})
+	}
+}
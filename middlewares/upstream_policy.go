@@ -0,0 +1,27 @@
+package middlewares
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+
+	"skillsync-api-gateway/clients"
+	"skillsync-api-gateway/config"
+)
+
+// UpstreamPolicy looks up the matched route's policy in the policy table, bounds
+// the request's backend calls by its timeout and attaches it so the clients retry
+// by it. Unmatched paths get the default policy.
+func UpstreamPolicy() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		policy, _ := cfg.Policies.For(config.PolicyName(c.FullPath()))
+		ctx := clients.WithPolicy(c.Request.Context(), policy)
+		if policy.Timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, policy.Timeout)
+			defer cancel()
+		}
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+	}
+}
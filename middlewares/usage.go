@@ -0,0 +1,70 @@
+package middlewares
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"skillsync-api-gateway/config"
+	"skillsync-api-gateway/utils/metering"
+)
+
+// usageKeptWindows is how many quota windows of history the in-memory store keeps
+const usageKeptWindows = 30
+
+// usageMeter counts authenticated requests against each user's quota (USAGE_*)
+var usageMeter = newUsageMeter(cfg.Usage)
+
+func newUsageMeter(usage config.UsageConfig) *metering.Meter {
+	return metering.New(metering.NewMemoryStore(usageKeptWindows), metering.Options{
+		Window: usage.Window,
+		Quotas: map[string]int64{
+			metering.Reads:  int64(usage.ReadQuota),
+			metering.Writes: int64(usage.WriteQuota),
+		},
+		FlushInterval: usage.FlushInterval,
+	})
+}
+
+// UsageMeter is the meter requests are counted with, for usage reports and shutdown
+func UsageMeter() *metering.Meter {
+	return usageMeter
+}
+
+// UsagePrincipal is whom usage is counted against. Team members count against
+// their company account, since user_id is the account's.
+func UsagePrincipal(role, userID string) string {
+	return role + ":" + userID
+}
+
+// meterUsage counts an authenticated request against the caller's quota and sets
+// the X-RateLimit headers. Over quota it answers 429 and reports false. Admins
+// aren't metered.
+func meterUsage(c *gin.Context) bool {
+	role := c.GetString("user_role")
+	if role == "admin" {
+		return true
+	}
+	class := metering.Writes
+	switch c.Request.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		class = metering.Reads
+	}
+	decision := usageMeter.Allow(UsagePrincipal(role, c.GetString("user_id")), class)
+	if decision.Limit > 0 {
+		c.Header("X-RateLimit-Limit", strconv.FormatInt(decision.Limit, 10))
+		c.Header("X-RateLimit-Remaining", strconv.FormatInt(decision.Remaining, 10))
+		c.Header("X-RateLimit-Reset", strconv.FormatInt(decision.Reset.Unix(), 10))
+	}
+	if !decision.Allowed {
+		c.Header("Retry-After", strconv.Itoa(int(time.Until(decision.Reset).Seconds())+1))
+		c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{
+			"error":      "You have used your request quota, it resets at " + decision.Reset.UTC().Format(time.RFC3339),
+			"error_code": "quota_exceeded",
+		})
+		return false
+	}
+	return true
+}
@@ -0,0 +1,93 @@
+package middlewares
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"skillsync-api-gateway/config"
+)
+
+func TestMeterUsage(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	previous := usageMeter
+	defer func() { usageMeter = previous }()
+
+	tests := []struct {
+		name        string
+		role        string
+		method      string
+		requests    int
+		wantAllowed bool
+		wantHeaders bool
+	}{
+		{"read within the quota", "candidate", http.MethodGet, 3, true, true},
+		{"read over the quota", "candidate", http.MethodGet, 4, false, true},
+		{"writes have their own quota", "employer", http.MethodPost, 2, true, true},
+		{"write over the quota", "employer", http.MethodDelete, 3, false, true},
+		{"admins aren't metered", "admin", http.MethodPost, 10, true, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			usageMeter = newUsageMeter(config.UsageConfig{Window: time.Hour, ReadQuota: 3, WriteQuota: 2, FlushInterval: time.Minute})
+			var w *httptest.ResponseRecorder
+			var allowed bool
+			for i := 0; i < tt.requests; i++ {
+				w = httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(w)
+				c.Request = httptest.NewRequest(tt.method, "/", nil)
+				c.Set("user_id", "u1")
+				c.Set("user_role", tt.role)
+				allowed = meterUsage(c)
+			}
+			if allowed != tt.wantAllowed {
+				t.Fatalf("meterUsage() = %v, want %v", allowed, tt.wantAllowed)
+			}
+			if got := w.Header().Get("X-RateLimit-Limit") != ""; got != tt.wantHeaders {
+				t.Errorf("X-RateLimit headers set = %v, want %v", got, tt.wantHeaders)
+			}
+			if !allowed {
+				retry, err := strconv.Atoi(w.Header().Get("Retry-After"))
+				if w.Code != http.StatusTooManyRequests || err != nil || retry < 1 {
+					t.Errorf("status = %d with Retry-After %q, want 429 with a retry time", w.Code, w.Header().Get("Retry-After"))
+				}
+				if remaining := w.Header().Get("X-RateLimit-Remaining"); remaining != "0" {
+					t.Errorf("X-RateLimit-Remaining = %q, want 0", remaining)
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkMeterUsage is the per-request cost of metering, from many users at once
+func BenchmarkMeterUsage(b *testing.B) {
+	gin.SetMode(gin.TestMode)
+	previous := usageMeter
+	defer func() { usageMeter = previous }()
+	// Quotas high enough that every request is allowed
+	usageMeter = newUsageMeter(config.UsageConfig{Window: time.Hour, ReadQuota: 1 << 40, WriteQuota: 1 << 40, FlushInterval: time.Minute})
+	users := make([]string, 1000)
+	for i := range users {
+		users[i] = "u" + strconv.Itoa(i)
+	}
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+		c.Request = req
+		c.Set("user_role", "candidate")
+		i := 0
+		for pb.Next() {
+			c.Set("user_id", users[i%len(users)])
+			if !meterUsage(c) {
+				b.Fatal("request refused")
+			}
+			i++
+		}
+	})
+}
@@ -0,0 +1,85 @@
+package routes
+
+import (
+	"context"
+	"net/http"
+	"skillsync-api-gateway/clients"
+	"skillsync-api-gateway/middlewares"
+	"skillsync-api-gateway/utils"
+
+	"github.com/gin-gonic/gin"
+	authpb "github.com/shahal0/skillsync-protos/gen/authpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// deleteAccountRPC is the shape shared by the candidate and employer delete RPCs
+type deleteAccountRPC func(ctx context.Context, in *authpb.DeleteAccountRequest, opts ...grpc.CallOption) (*authpb.DeleteAccountResponse, error)
+
+func candidateDeleteAccount(c *gin.Context) {
+	deleteAccount(c, clients.AuthServiceClient.CandidateDeleteAccount)
+}
+
+func employerDeleteAccount(c *gin.Context) {
+	deleteAccount(c, clients.AuthServiceClient.EmployerDeleteAccount)
+}
+
+// deleteAccount confirms the request with the current password (or an OTP for
+// OAuth-only accounts), then revokes the caller's token and auth cookie
+func deleteAccount(c *gin.Context, rpc deleteAccountRPC) {
+	// Extract user ID from context (set by JWTMiddleware)
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	// Parse request body
+	var req authpb.DeleteAccountRequest
+	if err := utils.BindProto(c, &req); err != nil {
+		utils.RespondWithValidationError(c, err)
+		return
+	}
+	if req.Password == "" && req.Otp == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Current password or OTP is required to delete the account"})
+		return
+	}
+
+	// Create context with metadata for auth service
+	ctx := metadata.NewOutgoingContext(
+		c.Request.Context(),
+		metadata.New(map[string]string{"user-id": userID.(string)}),
+	)
+
+	resp, err := rpc(ctx, &req)
+	if err != nil {
+		switch status.Code(err) {
+		case codes.Unauthenticated, codes.PermissionDenied:
+			c.JSON(http.StatusUnauthorized, gin.H{
+				"error":      "Password or OTP is incorrect",
+				"error_code": "invalid_credentials",
+			})
+		case codes.FailedPrecondition:
+			// e.g. an employer that still has open jobs
+			c.JSON(http.StatusConflict, gin.H{
+				"error":      "Account cannot be deleted yet",
+				"error_code": "deletion_blocked",
+				"blocking":   utils.GRPCErrorMessage(err),
+			})
+		default:
+			c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": utils.GRPCErrorMessage(err)})
+		}
+		return
+	}
+
+	// The account is gone, so its token and cookie must be too
+	middlewares.RevokeCurrentToken(c)
+	utils.ClearAuthCookie(c)
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": resp.GetMessage(),
+		"deleted": true,
+	})
+}
@@ -0,0 +1,122 @@
+package routes
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	authpb "github.com/shahal0/skillsync-protos/gen/authpb"
+	"google.golang.org/grpc"
+
+	"skillsync-api-gateway/clients"
+	"skillsync-api-gateway/middlewares"
+)
+
+const (
+	// unlockRequestLimit and unlockRequestWindow cap unlock requests per client IP
+	unlockRequestLimit  = 5
+	unlockRequestWindow = time.Hour
+)
+
+// unlockLinkThrottle sends at most one unlock link a minute, and three an hour, per
+// role and email, so the endpoint can't be used to flood someone's inbox
+var unlockLinkThrottle = middlewares.NewSendThrottle(time.Minute, 3)
+
+// unlockRequestedMessage answers every unlock request, so it doesn't reveal whether
+// the email has an account or is locked out
+const unlockRequestedMessage = "If this account is locked, an unlock link has been sent to its email"
+
+type sendUnlockLinkRPC func(ctx context.Context, in *authpb.SendUnlockLinkRequest, opts ...grpc.CallOption) (*authpb.SendUnlockLinkResponse, error)
+
+type verifyUnlockTokenRPC func(ctx context.Context, in *authpb.VerifyUnlockTokenRequest, opts ...grpc.CallOption) (*authpb.VerifyUnlockTokenResponse, error)
+
+func sendUnlockLinkRPCFor(role string) sendUnlockLinkRPC {
+	if role == "employer" {
+		return clients.AuthServiceClient.EmployerSendUnlockLink
+	}
+	return clients.AuthServiceClient.CandidateSendUnlockLink
+}
+
+func verifyUnlockTokenRPCFor(role string) verifyUnlockTokenRPC {
+	if role == "employer" {
+		return clients.AuthServiceClient.EmployerVerifyUnlockToken
+	}
+	return clients.AuthServiceClient.CandidateVerifyUnlockToken
+}
+
+// requestUnlock asks the auth service to email an unlock link when the email is
+// locked out of login. The answer is the same whatever happened.
+func requestUnlock(role string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var body struct {
+			Email string `json:"email" binding:"required,email,max=254"`
+		}
+		if err := c.ShouldBindJSON(&body); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		sendUnlockLink(c, role, strings.ToLower(strings.TrimSpace(body.Email)))
+		c.JSON(http.StatusAccepted, gin.H{"message": unlockRequestedMessage})
+	}
+}
+
+// sendUnlockLink has an unlock link emailed if email is locked out and hasn't had
+// too many already. Failures are only logged.
+func sendUnlockLink(c *gin.Context, role, email string) {
+	locked, err := middlewares.DefaultLoginAttemptStore.LockedFor(c.Request.Context(), middlewares.LoginEmailKey(email))
+	if err != nil {
+		log.Printf("Unlock request: lockout lookup failed: %v", err)
+		return
+	}
+	if locked == 0 {
+		return
+	}
+	key := role + ":" + email
+	if allowed, _ := unlockLinkThrottle.Allow(key); !allowed {
+		return
+	}
+	if _, err := sendUnlockLinkRPCFor(role)(c.Request.Context(), &authpb.SendUnlockLinkRequest{Email: email}); err != nil {
+		unlockLinkThrottle.Release(key)
+		log.Printf("Unlock request: sending the %s unlock link failed: %v", role, err)
+	}
+}
+
+// unlockLink handles the link in an unlock email: once the auth service accepts the
+// token, the email's lockout is lifted, along with that of the IP the link was
+// opened from, and the browser goes to FRONTEND_URL/unlocked with the outcome
+func unlockLink(role string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		token := strings.TrimSpace(c.Query("token"))
+		if token == "" {
+			redirectUnlocked(c, role, verifyLinkInvalid)
+			return
+		}
+		resp, err := verifyUnlockTokenRPCFor(role)(c.Request.Context(), &authpb.VerifyUnlockTokenRequest{Token: token})
+		if err != nil {
+			outcome := verifyLinkOutcome(err)
+			if outcome == verifyLinkError {
+				log.Printf("Unlock link for %s could not be checked: %v", role, err)
+			}
+			redirectUnlocked(c, role, outcome)
+			return
+		}
+		ctx := c.Request.Context()
+		for _, key := range []string{middlewares.LoginEmailKey(resp.GetEmail()), middlewares.LoginIPKey(c.ClientIP())} {
+			if err := middlewares.DefaultLoginAttemptStore.Reset(ctx, key); err != nil {
+				log.Printf("Unlock link: clearing the lockout failed: %v", err)
+				redirectUnlocked(c, role, verifyLinkError)
+				return
+			}
+		}
+		redirectUnlocked(c, role, verifyLinkSuccess)
+	}
+}
+
+// redirectUnlocked sends the browser to FRONTEND_URL/unlocked
+func redirectUnlocked(c *gin.Context, role, outcome string) {
+	redirectFrontend(c, "/unlocked", url.Values{"status": {outcome}, "role": {role}})
+}
@@ -0,0 +1,125 @@
+package routes
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	authpb "github.com/shahal0/skillsync-protos/gen/authpb"
+	"google.golang.org/grpc/metadata"
+
+	"skillsync-api-gateway/clients"
+	"skillsync-api-gateway/middlewares"
+	"skillsync-api-gateway/utils"
+)
+
+func SetupAdminRoutes(r *gin.Engine) {
+	admin := r.Group("/admin")
+	admin.Use(middlewares.JWTMiddleware(), middlewares.RequireRole("admin"))
+	{
+		admin.GET("/employers/pending-verification", ListPendingEmployerVerifications)
+		admin.PUT("/employers/:id/verification", ReviewEmployerVerification)
+
+		admin.POST("/api-keys", CreateAPIKey)
+		admin.GET("/api-keys", ListAPIKeys)
+		admin.DELETE("/api-keys/:id", RevokeAPIKey)
+
+		admin.GET("/flags", GetMaintenanceFlags)
+		admin.PUT("/flags/:service", UpdateMaintenanceFlag)
+
+		admin.GET("/features", ListFeatureFlags)
+		admin.PUT("/features/:name", UpdateFeatureFlag)
+
+		admin.DELETE("/lockouts", ClearLockout)
+		admin.POST("/jwt-keys/reload", ReloadJWTKeys)
+
+		admin.POST("/impersonate", StartImpersonation)
+		admin.DELETE("/impersonate", StopImpersonation)
+
+		admin.GET("/audit", GetAuditEvents)
+		admin.GET("/usage", GetUserUsage)
+		admin.GET("/slo", GetSLOSummary)
+
+		admin.GET("/reports", ListChatReports)
+
+		admin.POST("/announcements", middlewares.Idempotency(middlewares.DefaultIdempotencyStore, idempotencyWindow), CreateAnnouncement)
+		admin.GET("/announcements/:id/status", GetAnnouncementStatus)
+
+		admin.POST("/skills/aliases", AddSkillAlias)
+
+		admin.GET("/jobs", ListModerationJobs)
+		admin.PUT("/jobs/:id/approve", ModerateJob("approve"))
+		admin.PUT("/jobs/:id/reject", ModerateJob("reject"))
+		admin.PUT("/jobs/:id/takedown", ModerateJob("takedown"))
+
+		admin.GET("/reviews", ListReviewsForModeration)
+		admin.DELETE("/reviews/:id", RemoveEmployerReview)
+	}
+}
+
+// adminContext forwards the admin identity to backend services
+func adminContext(c *gin.Context) context.Context {
+	return metadata.NewOutgoingContext(
+		c.Request.Context(),
+		metadata.New(map[string]string{
+			"user-id": c.GetString("user_id"),
+			"role":    "admin",
+		}),
+	)
+}
+
+func ListPendingEmployerVerifications(c *gin.Context) {
+	var req authpb.ListPendingVerificationsRequest
+
+	// Handle query parameters directly
+	if page, err := strconv.Atoi(c.DefaultQuery("page", "1")); err == nil && page > 0 {
+		req.Page = int32(page)
+	}
+	if limit, err := strconv.Atoi(c.DefaultQuery("limit", "20")); err == nil && limit > 0 && limit <= 100 {
+		req.Limit = int32(limit)
+	}
+
+	resp, err := clients.AuthServiceClient.ListPendingEmployerVerifications(adminContext(c), &req)
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": utils.GRPCErrorMessage(err)})
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+func ReviewEmployerVerification(c *gin.Context) {
+	employerID := c.Param("id")
+
+	var body struct {
+		Decision string `json:"decision" binding:"required,oneof=approve reject"`
+		Reason   string `json:"reason"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if body.Decision == "reject" && body.Reason == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "A reason is required when rejecting a verification"})
+		return
+	}
+
+	resp, err := clients.AuthServiceClient.ReviewEmployerVerification(adminContext(c), &authpb.ReviewVerificationRequest{
+		EmployerId: employerID,
+		Approved:   body.Decision == "approve",
+		Reason:     body.Reason,
+	})
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": utils.GRPCErrorMessage(err)})
+		return
+	}
+
+	// Let the employer know they are verified; a failed notification doesn't undo the review
+	if body.Decision == "approve" {
+		notifyUser(c.Request.Context(), employerID, "employer_verification", "Company verified",
+			"Your company has been verified. Candidates will now see a verified badge on your jobs.", employerID)
+	}
+
+	recordAudit(c, "admin.employer_verification", "employer:"+employerID, map[string]string{"decision": body.Decision})
+	c.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,255 @@
+package routes
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	authpb "github.com/shahal0/skillsync-protos/gen/authpb"
+	notificationpb "github.com/shahal0/skillsync-protos/gen/notificationpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"skillsync-api-gateway/clients"
+	"skillsync-api-gateway/utils"
+	"skillsync-api-gateway/utils/cache"
+	"skillsync-api-gateway/utils/notifier"
+	"skillsync-api-gateway/utils/websocket"
+)
+
+const (
+	announcementNotificationType = "announcement"
+	announcementUserPageSize     = 500
+	// announcementDispatchTimeout bounds queueing a per-user dispatch in the background
+	announcementDispatchTimeout = 30 * time.Minute
+	// Status can be checked for a week after an announcement is sent
+	announcementStatusTTL = 7 * 24 * time.Hour
+)
+
+// announcementRoles maps the target of an announcement to the role it reaches; "" is everyone
+var announcementRoles = map[string]string{
+	"all":        "",
+	"candidates": "candidate",
+	"employers":  "employer",
+}
+
+// announcements keeps dispatch progress for GET /admin/announcements/:id/status
+var announcements = cache.NewTTLCache[*announcement](announcementStatusTTL)
+
+// announcement tracks the dispatch of one announcement
+type announcement struct {
+	mutex sync.Mutex
+
+	id        string
+	target    string
+	title     string
+	expiresAt *time.Time
+	createdAt time.Time
+
+	// method is "bulk" when the notification service fanned it out itself, or
+	// "per_user" when the gateway queues one notification per user
+	method      string
+	status      string // dispatching, completed or failed
+	targeted    int
+	queued      int
+	failed      int
+	websocket   int
+	lastError   string
+	completedAt *time.Time
+}
+
+func (a *announcement) snapshot() gin.H {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	return gin.H{
+		"id":                  a.id,
+		"target":              a.target,
+		"title":               a.title,
+		"expires_at":          a.expiresAt,
+		"created_at":          a.createdAt,
+		"method":              a.method,
+		"status":              a.status,
+		"targeted":            a.targeted,
+		"queued":              a.queued,
+		"failed":              a.failed,
+		"websocket_delivered": a.websocket,
+		"error":               a.lastError,
+		"completed_at":        a.completedAt,
+	}
+}
+
+// finish records the end of the dispatch
+func (a *announcement) finish(err error) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	now := time.Now().UTC()
+	a.completedAt = &now
+	a.status = "completed"
+	if err != nil {
+		a.status = "failed"
+		a.lastError = utils.GRPCErrorMessage(err)
+	}
+}
+
+// CreateAnnouncement notifies every user, or every candidate or employer, and pushes
+// the announcement to matching users connected over WebSocket. The notification
+// service fans it out itself when it supports broadcasts; otherwise the gateway
+// pages through the users and queues one notification each in the background.
+// Repeat requests are replayed with an Idempotency-Key.
+func CreateAnnouncement(c *gin.Context) {
+	var body struct {
+		Title     string     `json:"title" binding:"required,max=200"`
+		Body      string     `json:"body" binding:"required,max=2000"`
+		Target    string     `json:"target" binding:"required,oneof=all candidates employers"`
+		ExpiresAt *time.Time `json:"expires_at"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		utils.RespondWithValidationError(c, err)
+		return
+	}
+	if body.ExpiresAt != nil && !body.ExpiresAt.After(time.Now()) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "expires_at must be in the future"})
+		return
+	}
+	notificationClient := clients.GetNotificationClient()
+	if notificationClient == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Notification service unavailable"})
+		return
+	}
+	random := make([]byte, 8)
+	if _, err := rand.Read(random); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create announcement ID"})
+		return
+	}
+	role := announcementRoles[body.Target]
+	a := &announcement{
+		id:        hex.EncodeToString(random),
+		target:    body.Target,
+		title:     body.Title,
+		expiresAt: body.ExpiresAt,
+		createdAt: time.Now().UTC(),
+		status:    "dispatching",
+	}
+	var expiresAt string
+	if body.ExpiresAt != nil {
+		expiresAt = body.ExpiresAt.UTC().Format(time.RFC3339)
+	}
+
+	ctx := adminContext(c)
+	broadcast, err := notificationClient.BroadcastNotification(ctx, &notificationpb.BroadcastNotificationRequest{
+		Role:      role,
+		Type:      announcementNotificationType,
+		Title:     body.Title,
+		Message:   body.Body,
+		SourceId:  a.id,
+		ExpiresAt: expiresAt,
+	})
+	switch {
+	case err == nil:
+		a.method = "bulk"
+		a.targeted = int(broadcast.GetRecipients())
+		a.queued = a.targeted
+		a.finish(nil)
+	case status.Code(err) == codes.Unimplemented:
+		// The first page is read here so the response can say how many users are targeted
+		first, err := clients.AuthServiceClient.ListUserIds(ctx, &authpb.ListUserIdsRequest{Role: role, Page: 1, Limit: announcementUserPageSize})
+		if err != nil {
+			c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to list users: " + utils.GRPCErrorMessage(err)})
+			return
+		}
+		a.method = "per_user"
+		a.targeted = int(first.GetTotal())
+		go dispatchAnnouncement(a, c.GetString("user_id"), role, body.Body, first)
+	default:
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to send announcement: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+	announcements.Set(a.id, a)
+
+	pushMetadata := map[string]string{"announcement_id": a.id, "title": body.Title}
+	if expiresAt != "" {
+		pushMetadata["expires_at"] = expiresAt
+	}
+	delivered := websocket.GetManager().BroadcastToRole(role, &websocket.Message{
+		Type:     announcementNotificationType,
+		Content:  body.Body,
+		SentTime: a.createdAt.Format(time.RFC3339),
+		Metadata: pushMetadata,
+	})
+	a.mutex.Lock()
+	a.websocket = delivered
+	a.mutex.Unlock()
+
+	recordAudit(c, "admin.announcement", "announcement:"+a.id, map[string]string{"target": body.Target})
+	c.JSON(http.StatusAccepted, gin.H{
+		"id":                  a.id,
+		"targeted":            a.targeted,
+		"method":              a.method,
+		"websocket_delivered": delivered,
+		"status_url":          "/admin/announcements/" + a.id + "/status",
+	})
+}
+
+// dispatchAnnouncement queues one notification per user, page by page, waiting
+// for room in the outbox rather than dropping events when it is full
+func dispatchAnnouncement(a *announcement, adminID, role, message string, page *authpb.ListUserIdsResponse) {
+	// The request is over, so the admin identity is forwarded on a fresh context
+	ctx, cancel := context.WithTimeout(metadata.NewOutgoingContext(
+		context.Background(),
+		metadata.New(map[string]string{"user-id": adminID, "role": "admin"}),
+	), announcementDispatchTimeout)
+	defer cancel()
+
+	outbox := notificationOutbox()
+	seen := 0
+	for n := int32(1); ; n++ {
+		for _, userID := range page.GetUserIds() {
+			event := &notifier.Event{
+				UserID:   userID,
+				Type:     announcementNotificationType,
+				Title:    a.title,
+				Message:  message,
+				SourceID: a.id,
+			}
+			if a.expiresAt != nil {
+				event.ExpiresAt = *a.expiresAt
+			}
+			err := outbox.EnqueueWait(ctx, event)
+			a.mutex.Lock()
+			if err != nil {
+				a.failed++
+			} else {
+				a.queued++
+			}
+			a.mutex.Unlock()
+		}
+		seen += len(page.GetUserIds())
+		if len(page.GetUserIds()) < announcementUserPageSize || seen >= int(page.GetTotal()) {
+			a.finish(nil)
+			return
+		}
+		var err error
+		page, err = clients.AuthServiceClient.ListUserIds(ctx, &authpb.ListUserIdsRequest{Role: role, Page: n + 1, Limit: announcementUserPageSize})
+		if err != nil {
+			log.Printf("Announcement %s: listing users stopped after %d: %v", a.id, seen, err)
+			a.finish(err)
+			return
+		}
+	}
+}
+
+// GetAnnouncementStatus reports how far an announcement's dispatch has got
+func GetAnnouncementStatus(c *gin.Context) {
+	a, ok := announcements.Get(c.Param("id"))
+	if !ok {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Announcement not found"})
+		return
+	}
+	c.JSON(http.StatusOK, a.snapshot())
+}
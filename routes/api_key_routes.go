@@ -0,0 +1,88 @@
+package routes
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	authpb "github.com/shahal0/skillsync-protos/gen/authpb"
+
+	"skillsync-api-gateway/clients"
+	"skillsync-api-gateway/middlewares"
+	"skillsync-api-gateway/utils"
+)
+
+// newAPIKeySecret returns a random key; its first characters double as a display prefix
+func newAPIKeySecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return "sk_" + hex.EncodeToString(b), nil
+}
+
+func CreateAPIKey(c *gin.Context) {
+	var body struct {
+		Name               string `json:"name" binding:"required,max=100"`
+		OwnerID            string `json:"owner_id" binding:"required"`
+		Role               string `json:"role" binding:"required,oneof=candidate employer"`
+		Scope              string `json:"scope" binding:"required,oneof=read read_write"`
+		RateLimitPerMinute int32  `json:"rate_limit_per_minute" binding:"min=0,max=10000"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		utils.RespondWithValidationError(c, err)
+		return
+	}
+
+	secret, err := newAPIKeySecret()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate API key"})
+		return
+	}
+	// Only the hash is stored; the secret is returned once and can't be recovered
+	resp, err := clients.AuthServiceClient.CreateApiKey(adminContext(c), &authpb.CreateApiKeyRequest{
+		Name:               body.Name,
+		OwnerId:            body.OwnerID,
+		Role:               body.Role,
+		Scope:              body.Scope,
+		RateLimitPerMinute: body.RateLimitPerMinute,
+		KeyHash:            middlewares.HashAPIKey(secret),
+		Prefix:             secret[:11],
+	})
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to create API key: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+	recordAudit(c, "admin.api_key_create", "api_key:"+resp.GetApiKey().GetId(), map[string]string{
+		"owner_id": body.OwnerID,
+		"scope":    body.Scope,
+	})
+	c.JSON(http.StatusCreated, gin.H{
+		"api_key": resp.GetApiKey(),
+		"secret":  secret,
+		"message": "Store this secret now; it will not be shown again",
+	})
+}
+
+func ListAPIKeys(c *gin.Context) {
+	resp, err := clients.AuthServiceClient.ListApiKeys(adminContext(c), &authpb.ListApiKeysRequest{
+		OwnerId: c.Query("owner_id"),
+	})
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to list API keys: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+func RevokeAPIKey(c *gin.Context) {
+	resp, err := clients.AuthServiceClient.RevokeApiKey(adminContext(c), &authpb.RevokeApiKeyRequest{Id: c.Param("id")})
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to revoke API key: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+	middlewares.InvalidateAPIKeyCache()
+	recordAudit(c, "admin.api_key_revoke", "api_key:"+c.Param("id"), nil)
+	c.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,201 @@
+package routes
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	jobpb "github.com/shahal0/skillsync-protos/gen/jobpb"
+
+	"skillsync-api-gateway/clients"
+	"skillsync-api-gateway/utils"
+	"skillsync-api-gateway/utils/fanout"
+)
+
+const (
+	defaultInboxLimit = 20
+	maxInboxLimit     = 100
+	inboxConcurrency  = 8
+	inboxCallTimeout  = 3 * time.Second
+)
+
+// inboxEntry is an application with what the inbox sorts and labels it by
+type inboxEntry struct {
+	application *jobpb.ApplicationResponse
+	jobTitle    string
+	appliedAt   time.Time
+}
+
+// GetApplicationsInbox lists applications across all of the employer's jobs,
+// newest first. The job service only lists applications per job, so the gateway
+// lists the employer's jobs, fetches each job's applications concurrently and
+// merges them. Jobs whose applications can't be fetched are left out and the
+// response is marked partial. Ordering by applied_at then ID keeps pages stable.
+func GetApplicationsInbox(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in context"})
+		return
+	}
+	employerID := userID.(string)
+
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil || page < 1 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "page must be a positive integer"})
+		return
+	}
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", strconv.Itoa(defaultInboxLimit)))
+	if err != nil || limit < 1 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "limit must be a positive integer"})
+		return
+	}
+	if limit > maxInboxLimit {
+		limit = maxInboxLimit
+	}
+	var status string
+	if c.Query("status") != "" {
+		status, err = utils.ApplicationStatuses().Normalize(c.Query("status"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":           err.Error(),
+				"accepted_values": utils.ApplicationStatuses().Allowed(),
+			})
+			return
+		}
+	}
+	var jobFilter uint64
+	if value := c.Query("job_id"); value != "" {
+		jobFilter, err = strconv.ParseUint(value, 10, 64)
+		if err != nil || jobFilter == 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid job ID"})
+			return
+		}
+	}
+	seenFilter := c.Query("seen")
+	if seenFilter != "" && seenFilter != "true" && seenFilter != "false" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "seen must be true or false"})
+		return
+	}
+
+	ctx := jobOwnerContext(c, employerID)
+	jobs, err := clients.JobServiceClient.ListEmployerJobs(ctx, &jobpb.ListEmployerJobsRequest{EmployerId: employerID})
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to list your jobs: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+	var selected []*jobpb.Job
+	for _, job := range jobs.GetJobs() {
+		if jobFilter == 0 || job.GetId() == jobFilter {
+			selected = append(selected, job)
+		}
+	}
+	if jobFilter != 0 && len(selected) == 0 {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Job not found among your jobs"})
+		return
+	}
+
+	fetch := func(ctx context.Context, job *jobpb.Job) (*jobpb.GetApplicationsResponse, error) {
+		return clients.JobServiceClient.GetApplications(ctx, &jobpb.GetApplicationsRequest{JobId: job.GetId(), Status: status})
+	}
+	var entries []inboxEntry
+	failedJobs := []uint64{}
+	for i, result := range fanout.RunWith(ctx, selected, inboxConcurrency, fetch, fanout.Options{ItemTimeout: inboxCallTimeout, Limiter: fanout.Backend("job")}) {
+		job := selected[i]
+		if result.Err != nil {
+			log.Printf("Applications inbox: job %d unavailable for %s: %v", job.GetId(), employerID, result.Err)
+			failedJobs = append(failedJobs, job.GetId())
+			continue
+		}
+		for _, application := range result.Value.GetApplications() {
+			// Unparseable times sort last
+			appliedAt, _ := time.Parse(time.RFC3339, application.GetAppliedAt())
+			entries = append(entries, inboxEntry{
+				application: application,
+				jobTitle:    job.GetTitle(),
+				appliedAt:   appliedAt,
+			})
+		}
+	}
+
+	unseen := 0
+	filtered := entries[:0]
+	for _, entry := range entries {
+		seen := entry.application.GetSeen()
+		if !seen {
+			unseen++
+		}
+		if seenFilter == "" || (seenFilter == "true") == seen {
+			filtered = append(filtered, entry)
+		}
+	}
+	sort.Slice(filtered, func(i, j int) bool {
+		a, b := filtered[i], filtered[j]
+		if !a.appliedAt.Equal(b.appliedAt) {
+			return a.appliedAt.After(b.appliedAt)
+		}
+		return a.application.GetId() > b.application.GetId()
+	})
+
+	start := (page - 1) * limit
+	if start > len(filtered) {
+		start = len(filtered)
+	}
+	end := min(start+limit, len(filtered))
+	applications := make([]gin.H, 0, end-start)
+	for _, entry := range filtered[start:end] {
+		application := entry.application
+		applications = append(applications, gin.H{
+			"id":           application.GetId(),
+			"job_id":       application.GetJobId(),
+			"job_title":    entry.jobTitle,
+			"candidate_id": application.GetCandidateId(),
+			"status":       application.GetStatus(),
+			"resume_url":   application.GetResumeUrl(),
+			"applied_at":   entry.appliedAt,
+			"seen":         application.GetSeen(),
+		})
+	}
+
+	body := gin.H{
+		"applications": applications,
+		"total":        len(filtered),
+		"unseen":       unseen,
+		"page":         page,
+		"limit":        limit,
+	}
+	if len(failedJobs) > 0 {
+		sort.Slice(failedJobs, func(i, j int) bool { return failedJobs[i] < failedJobs[j] })
+		body["failed_job_ids"] = failedJobs
+		utils.MarkPartial(body, "applications")
+	}
+	utils.WarnPartial(c, body)
+	c.JSON(http.StatusOK, body)
+}
+
+// MarkApplicationSeen clears an application's unseen marker in the inbox. Marking
+// it again is a no-op.
+func MarkApplicationSeen(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in context"})
+		return
+	}
+	applicationID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || applicationID == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid application ID"})
+		return
+	}
+	_, err = clients.JobServiceClient.MarkApplicationSeen(jobOwnerContext(c, userID.(string)), &jobpb.MarkApplicationSeenRequest{
+		ApplicationId: applicationID,
+		EmployerId:    userID.(string),
+	})
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to mark application seen: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"id": applicationID, "seen": true})
+}
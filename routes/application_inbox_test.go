@@ -0,0 +1,95 @@
+package routes
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	jobpb "github.com/shahal0/skillsync-protos/gen/jobpb"
+	"google.golang.org/grpc"
+
+	"skillsync-api-gateway/clients"
+)
+
+// fakeInboxJobs lists jobs 1 to 3; job 2's applications can't be fetched
+type fakeInboxJobs struct {
+	jobpb.JobServiceClient
+}
+
+func (fakeInboxJobs) ListEmployerJobs(context.Context, *jobpb.ListEmployerJobsRequest, ...grpc.CallOption) (*jobpb.ListEmployerJobsResponse, error) {
+	return &jobpb.ListEmployerJobsResponse{Jobs: []*jobpb.Job{
+		{Id: 1, Title: "Backend engineer"},
+		{Id: 2, Title: "Designer"},
+		{Id: 3, Title: "Data analyst"},
+	}}, nil
+}
+
+func (fakeInboxJobs) GetApplications(_ context.Context, req *jobpb.GetApplicationsRequest, _ ...grpc.CallOption) (*jobpb.GetApplicationsResponse, error) {
+	switch req.JobId {
+	case 1:
+		return &jobpb.GetApplicationsResponse{Applications: []*jobpb.ApplicationResponse{
+			{Id: 10, JobId: 1, AppliedAt: "2026-10-01T10:00:00Z"},
+			{Id: 11, JobId: 1, AppliedAt: "2026-10-03T10:00:00Z", Seen: true},
+		}}, nil
+	case 3:
+		return &jobpb.GetApplicationsResponse{Applications: []*jobpb.ApplicationResponse{
+			{Id: 30, JobId: 3, AppliedAt: "2026-10-02T10:00:00Z"},
+		}}, nil
+	}
+	return nil, errors.New("job service unavailable")
+}
+
+func TestApplicationsInboxMergesJobs(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	log.SetOutput(io.Discard)
+	t.Cleanup(func() { log.SetOutput(os.Stderr) })
+	previous := clients.JobServiceClient
+	clients.JobServiceClient = fakeInboxJobs{}
+	t.Cleanup(func() { clients.JobServiceClient = previous })
+
+	r := gin.New()
+	r.GET("/inbox", func(c *gin.Context) { c.Set("user_id", "e1") }, GetApplicationsInbox)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/inbox", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d (%s), want 200", w.Code, w.Body)
+	}
+
+	var body struct {
+		Applications []struct {
+			ID       uint64 `json:"id"`
+			JobTitle string `json:"job_title"`
+		} `json:"applications"`
+		Unseen       int      `json:"unseen"`
+		FailedJobIDs []uint64 `json:"failed_job_ids"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+		t.Fatal(err)
+	}
+	var ids []uint64
+	for _, application := range body.Applications {
+		ids = append(ids, application.ID)
+	}
+	if len(ids) != 3 || ids[0] != 11 || ids[1] != 30 || ids[2] != 10 {
+		t.Errorf("applications = %v, want [11 30 10], newest first", ids)
+	}
+	if len(body.Applications) == 3 && body.Applications[1].JobTitle != "Data analyst" {
+		t.Errorf("job_title = %q, want Data analyst", body.Applications[1].JobTitle)
+	}
+	if body.Unseen != 2 {
+		t.Errorf("unseen = %d, want 2", body.Unseen)
+	}
+	if len(body.FailedJobIDs) != 1 || body.FailedJobIDs[0] != 2 {
+		t.Errorf("failed_job_ids = %v, want [2]", body.FailedJobIDs)
+	}
+	if w.Header().Get("Warning") == "" {
+		t.Error("partial inbox has no Warning header")
+	}
+}
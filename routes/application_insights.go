@@ -0,0 +1,191 @@
+package routes
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	authpb "github.com/shahal0/skillsync-protos/gen/authpb"
+	jobpb "github.com/shahal0/skillsync-protos/gen/jobpb"
+	"golang.org/x/sync/errgroup"
+
+	"skillsync-api-gateway/clients"
+	"skillsync-api-gateway/utils"
+	"skillsync-api-gateway/utils/cache"
+	"skillsync-api-gateway/utils/profilecache"
+)
+
+const applicationInsightsTimeout = 3 * time.Second
+
+// applicationInsightsCache keeps each application's insights for a few minutes; the
+// applicant count and skills change slowly
+var applicationInsightsCache = cache.NewTTLCache[gin.H](3 * time.Minute)
+
+// applicantBucket reports how many applied in a range, so candidates get context
+// without learning an employer's exact numbers
+func applicantBucket(count int64) string {
+	switch {
+	case count <= 10:
+		return "1–10"
+	case count <= 50:
+		return "10–50"
+	default:
+		return "50+"
+	}
+}
+
+// jobRequiredSkills reads the required skill names from a cached job detail
+func jobRequiredSkills(body map[string]interface{}) []string {
+	job, _ := body["job"].(map[string]interface{})
+	required, _ := job["required_skills"].([]interface{})
+	names := make([]string, 0, len(required))
+	for _, entry := range required {
+		skill, _ := entry.(map[string]interface{})
+		if name, _ := skill["skill"].(string); strings.TrimSpace(name) != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// skillMatch compares a job's required skills with a candidate's by canonical name.
+// The percentage is nil for a job that requires no skills.
+func skillMatch(required []string, candidate []*authpb.Skill) gin.H {
+	has := make(map[string]bool, len(candidate))
+	for _, skill := range candidate {
+		name, _ := canonicalSkill(strings.TrimSpace(skill.GetSkill()))
+		has[strings.ToLower(name)] = true
+	}
+	matched, missing := []string{}, []string{}
+	seen := make(map[string]bool, len(required))
+	for _, skill := range required {
+		name, _ := canonicalSkill(strings.TrimSpace(skill))
+		key := strings.ToLower(name)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		if has[key] {
+			matched = append(matched, name)
+		} else {
+			missing = append(missing, name)
+		}
+	}
+	var percentage interface{}
+	if total := len(matched) + len(missing); total > 0 {
+		percentage = len(matched) * 100 / total
+	}
+	return gin.H{
+		"match_percentage": percentage,
+		"matched":          matched,
+		"missing":          missing,
+	}
+}
+
+// GetApplicationInsights tells a candidate where they stand on one of their
+// applications: its status, how well their skills fit the job and roughly how many
+// others applied. Sections that can't be loaded are null and listed in missing.
+func GetApplicationInsights(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in context"})
+		return
+	}
+	candidateID := userID.(string)
+	applicationID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || applicationID == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid application ID"})
+		return
+	}
+
+	cacheKey := candidateID + "|" + strconv.FormatUint(applicationID, 10)
+	if cached, ok := applicationInsightsCache.Get(cacheKey); ok {
+		c.JSON(http.StatusOK, cached)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(candidateContext(c, candidateID), applicationInsightsTimeout)
+	defer cancel()
+
+	resp, err := clients.JobServiceClient.GetApplication(ctx, &jobpb.GetApplicationRequest{ApplicationId: applicationID})
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to get application: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+	application := resp.GetApplication()
+	// Another candidate's application is reported as missing rather than forbidden
+	if application == nil || application.GetCandidateId() != candidateID {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Application not found"})
+		return
+	}
+
+	var (
+		mutex       sync.Mutex
+		unavailable = []string{}
+		job         map[string]interface{}
+		profile     *authpb.CandidateProfileResponse
+		applicants  *jobpb.JobApplicantCountResponse
+	)
+	markUnavailable := func(section string, err error) {
+		log.Printf("Application insights: %s unavailable for application %d: %v", section, applicationID, err)
+		mutex.Lock()
+		unavailable = append(unavailable, section)
+		mutex.Unlock()
+	}
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		body, err := cachedJobDetail(gctx, application.GetJobId())
+		if err != nil {
+			markUnavailable("job", err)
+			return nil
+		}
+		job = body
+		return nil
+	})
+	g.Go(func() error {
+		resp, err := profilecache.Get(gctx, candidateID)
+		if err != nil {
+			markUnavailable("candidate_profile", err)
+			return nil
+		}
+		profile = resp
+		return nil
+	})
+	g.Go(func() error {
+		resp, err := clients.JobServiceClient.GetJobApplicantCount(gctx, &jobpb.JobApplicantCountRequest{JobId: application.GetJobId()})
+		if err != nil {
+			markUnavailable("applicants", err)
+			return nil
+		}
+		applicants = resp
+		return nil
+	})
+	// Sections never return errors, they only mark themselves unavailable
+	_ = g.Wait()
+
+	insights := gin.H{
+		"application_id": applicationID,
+		"job_id":         application.GetJobId(),
+		"status":         application.GetStatus(),
+		"applicants":     nil,
+		"skills":         nil,
+	}
+	if applicants != nil {
+		insights["applicants"] = applicantBucket(applicants.GetCount())
+	}
+	if job != nil && profile != nil {
+		insights["skills"] = skillMatch(jobRequiredSkills(job), profile.GetSkills())
+	}
+
+	// Only complete insights are cached so a transient failure isn't served for minutes
+	if len(unavailable) == 0 {
+		applicationInsightsCache.Set(cacheKey, insights)
+	}
+	utils.RespondPartial(c, http.StatusOK, insights, unavailable...)
+}
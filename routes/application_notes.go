@@ -0,0 +1,194 @@
+package routes
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	jobpb "github.com/shahal0/skillsync-protos/gen/jobpb"
+
+	"skillsync-api-gateway/clients"
+	"skillsync-api-gateway/utils"
+)
+
+const (
+	defaultNotesLimit = 20
+	maxNotesLimit     = 100
+)
+
+// employerOnlyApplicationFields are what hiring teams record about an application
+// for themselves. Candidates must never see them, whatever the backend returns.
+var employerOnlyApplicationFields = []string{"notes", "rating", "note_count"}
+
+// AddApplicationNote adds a private note to an application. The note is attributed
+// to the user writing it, which for a team member isn't the employer account.
+func AddApplicationNote(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in context"})
+		return
+	}
+	applicationID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || applicationID == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid application ID"})
+		return
+	}
+	var body struct {
+		Body string `json:"body" binding:"required,max=5000"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		utils.RespondWithValidationError(c, err)
+		return
+	}
+	if strings.TrimSpace(body.Body) == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "body must not be blank"})
+		return
+	}
+	// Team members write on the company's behalf but the note is theirs
+	authorID := c.GetString("member_id")
+	if authorID == "" {
+		authorID = userID.(string)
+	}
+	resp, err := clients.JobServiceClient.AddApplicationNote(jobOwnerContext(c, userID.(string)), &jobpb.AddApplicationNoteRequest{
+		ApplicationId: applicationID,
+		EmployerId:    userID.(string),
+		AuthorId:      authorID,
+		Body:          strings.TrimSpace(body.Body),
+	})
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to add note: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+	c.JSON(http.StatusCreated, resp.GetNote())
+}
+
+// GetApplicationNotes lists an application's notes, newest first
+func GetApplicationNotes(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in context"})
+		return
+	}
+	applicationID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || applicationID == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid application ID"})
+		return
+	}
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil || page < 1 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "page must be a positive integer"})
+		return
+	}
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", strconv.Itoa(defaultNotesLimit)))
+	if err != nil || limit < 1 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "limit must be a positive integer"})
+		return
+	}
+	if limit > maxNotesLimit {
+		limit = maxNotesLimit
+	}
+	resp, err := clients.JobServiceClient.ListApplicationNotes(jobOwnerContext(c, userID.(string)), &jobpb.ListApplicationNotesRequest{
+		ApplicationId: applicationID,
+		EmployerId:    userID.(string),
+		Page:          int32(page),
+		Limit:         int32(limit),
+	})
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to list notes: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+	notes := resp.GetNotes()
+	if notes == nil {
+		notes = []*jobpb.ApplicationNote{}
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"notes": notes,
+		"total": resp.GetTotal(),
+		"page":  page,
+		"limit": limit,
+	})
+}
+
+// DeleteApplicationNote removes a note from an application the employer owns
+func DeleteApplicationNote(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in context"})
+		return
+	}
+	noteID, err := strconv.ParseUint(c.Param("note_id"), 10, 64)
+	if err != nil || noteID == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid note ID"})
+		return
+	}
+	_, err = clients.JobServiceClient.DeleteApplicationNote(jobOwnerContext(c, userID.(string)), &jobpb.DeleteApplicationNoteRequest{
+		NoteId:     noteID,
+		EmployerId: userID.(string),
+	})
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to delete note: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// RateApplication sets the employer's 1 to 5 rating of an application
+func RateApplication(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in context"})
+		return
+	}
+	applicationID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || applicationID == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid application ID"})
+		return
+	}
+	var body struct {
+		Rating int32 `json:"rating" binding:"required,min=1,max=5"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		utils.RespondWithValidationError(c, err)
+		return
+	}
+	_, err = clients.JobServiceClient.RateApplication(jobOwnerContext(c, userID.(string)), &jobpb.RateApplicationRequest{
+		ApplicationId: applicationID,
+		EmployerId:    userID.(string),
+		Rating:        body.Rating,
+	})
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to rate application: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"id": applicationID, "rating": body.Rating})
+}
+
+// redactForCandidate converts an application response to JSON and removes the
+// employer-only fields at any depth, so a candidate never sees notes or ratings
+// even if the backend returns them
+func redactForCandidate(resp interface{}) (map[string]interface{}, error) {
+	body, err := toMap(resp)
+	if err != nil {
+		return nil, err
+	}
+	redactFields(body, employerOnlyApplicationFields)
+	return body, nil
+}
+
+// redactFields deletes fields from every object in v, at any depth
+func redactFields(v interface{}, fields []string) {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		for _, field := range fields {
+			delete(value, field)
+		}
+		for _, child := range value {
+			redactFields(child, fields)
+		}
+	case []interface{}:
+		for _, child := range value {
+			redactFields(child, fields)
+		}
+	}
+}
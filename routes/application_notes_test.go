@@ -0,0 +1,122 @@
+package routes
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	jobpb "github.com/shahal0/skillsync-protos/gen/jobpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"skillsync-api-gateway/clients"
+)
+
+func TestRedactForCandidate(t *testing.T) {
+	tests := []struct {
+		name string
+		resp interface{}
+		want map[string]interface{}
+	}{
+		{
+			"top level",
+			map[string]interface{}{"id": 1, "status": "APPLIED", "notes": []string{"strong"}, "rating": 4, "note_count": 1},
+			map[string]interface{}{"id": float64(1), "status": "APPLIED"},
+		},
+		{
+			"nested in a list",
+			map[string]interface{}{"applications": []interface{}{
+				map[string]interface{}{"id": 1, "rating": 2},
+				map[string]interface{}{"id": 2, "job": map[string]interface{}{"title": "SRE", "notes": "x"}},
+			}},
+			map[string]interface{}{"applications": []interface{}{
+				map[string]interface{}{"id": float64(1)},
+				map[string]interface{}{"id": float64(2), "job": map[string]interface{}{"title": "SRE"}},
+			}},
+		},
+		{"nothing to redact", &jobpb.ApplicationNote{Id: 3, Body: "kept"}, map[string]interface{}{"id": float64(3), "body": "kept"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := redactForCandidate(tt.resp)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("redactForCandidate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+type fakeNotesJobs struct {
+	jobpb.JobServiceClient
+	req  *jobpb.AddApplicationNoteRequest
+	role string
+}
+
+func (f *fakeNotesJobs) AddApplicationNote(ctx context.Context, req *jobpb.AddApplicationNoteRequest, _ ...grpc.CallOption) (*jobpb.AddApplicationNoteResponse, error) {
+	f.req = req
+	md, _ := metadata.FromOutgoingContext(ctx)
+	f.role = strings.Join(md.Get("role"), ",")
+	return &jobpb.AddApplicationNoteResponse{Note: &jobpb.ApplicationNote{Id: 9, AuthorId: req.GetAuthorId(), Body: req.GetBody()}}, nil
+}
+
+func TestAddApplicationNote(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	previous := clients.JobServiceClient
+	defer func() { clients.JobServiceClient = previous }()
+
+	tests := []struct {
+		name       string
+		id         string
+		memberID   string
+		body       string
+		wantStatus int
+		wantAuthor string
+		wantBody   string
+	}{
+		{"employer", "7", "", `{"body":"  Strong Go skills "}`, http.StatusCreated, "e1", "Strong Go skills"},
+		{"team member", "7", "m1", `{"body":"Call back"}`, http.StatusCreated, "m1", "Call back"},
+		{"blank", "7", "", `{"body":"   "}`, http.StatusBadRequest, "", ""},
+		{"missing body", "7", "", `{}`, http.StatusBadRequest, "", ""},
+		{"too long", "7", "", `{"body":"` + strings.Repeat("a", 5001) + `"}`, http.StatusBadRequest, "", ""},
+		{"bad id", "0", "", `{"body":"x"}`, http.StatusBadRequest, "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeNotesJobs{}
+			clients.JobServiceClient = fake
+			r := gin.New()
+			r.POST("/applications/:id/notes", func(c *gin.Context) {
+				c.Set("user_id", "e1")
+				if tt.memberID != "" {
+					c.Set("member_id", tt.memberID)
+				}
+				AddApplicationNote(c)
+			})
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/applications/"+tt.id+"/notes", strings.NewReader(tt.body)))
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d (%s)", w.Code, tt.wantStatus, w.Body)
+			}
+			if tt.wantStatus != http.StatusCreated {
+				if fake.req != nil {
+					t.Errorf("job service called for a rejected note")
+				}
+				return
+			}
+			if fake.req.GetEmployerId() != "e1" || fake.req.GetAuthorId() != tt.wantAuthor || fake.req.GetBody() != tt.wantBody {
+				t.Errorf("request = %v, want employer e1, author %s, body %q", fake.req, tt.wantAuthor, tt.wantBody)
+			}
+			if fake.role != "employer" {
+				t.Errorf("role metadata = %q, want employer", fake.role)
+			}
+		})
+	}
+}
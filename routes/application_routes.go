@@ -0,0 +1,76 @@
+package routes
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	jobpb "github.com/shahal0/skillsync-protos/gen/jobpb"
+	"google.golang.org/grpc/metadata"
+
+	"skillsync-api-gateway/clients"
+	"skillsync-api-gateway/utils"
+)
+
+func UpdateApplicationStatus(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	applicationID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || applicationID == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid application ID"})
+		return
+	}
+
+	var body struct {
+		Status string `json:"status"`
+		Reason string `json:"reason"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	status, err := utils.ApplicationStatuses().Normalize(body.Status)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":           err.Error(),
+			"accepted_values": utils.ApplicationStatuses().Allowed(),
+		})
+		return
+	}
+
+	ctx := metadata.NewOutgoingContext(
+		c.Request.Context(),
+		metadata.New(map[string]string{
+			"user-id": userID.(string),
+			"role":    "employer",
+		}),
+	)
+	resp, err := clients.JobServiceClient.UpdateApplicationStatus(ctx, &jobpb.UpdateApplicationStatusRequest{
+		ApplicationId: strconv.FormatUint(applicationID, 10),
+		Status:        status,
+		EmployerId:    userID.(string),
+		Reason:        body.Reason,
+	})
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to update application status: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+
+	application := resp.GetApplication()
+	notifyUser(c.Request.Context(), application.GetCandidateId(), "application_status", "Application update",
+		"Your application status changed to "+status, strconv.FormatUint(applicationID, 10))
+	publishApplicationEvent("application.status_changed", userID.(string), application.GetJobId(), gin.H{
+		"application_id": applicationID,
+		"job_id":         application.GetJobId(),
+		"candidate_id":   application.GetCandidateId(),
+		"status":         status,
+		"reason":         body.Reason,
+	})
+
+	recordAudit(c, "application.status_change", "application:"+strconv.FormatUint(applicationID, 10), map[string]string{"status": status})
+	c.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,104 @@
+package routes
+
+import (
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"skillsync-api-gateway/config"
+	"skillsync-api-gateway/middlewares"
+	"skillsync-api-gateway/utils/audit"
+)
+
+const maxAuditPageSize = 200
+
+// auditLog records sensitive operations; Configure rebuilds it from AUDIT_SINK
+var auditLog = newAuditLogger(cfg.Audit)
+
+// newAuditLogger writes to the configured sink. A file sink is also the store
+// queried by GET /admin/audit; otherwise recent events are kept in memory. If the
+// file can't be opened the gateway logs it and keeps events in memory instead.
+func newAuditLogger(c config.AuditConfig) *audit.Logger {
+	memory := audit.NewMemoryStore(c.MemoryEvents)
+	switch c.Sink {
+	case "stdout":
+		return audit.NewLogger(memory, audit.NewWriterSink(os.Stdout))
+	case "file":
+		file, err := audit.OpenFileStore(c.File)
+		if err != nil {
+			log.Printf("Failed to open audit file %s, keeping audit events in memory: %v", c.File, err)
+			return audit.NewLogger(memory)
+		}
+		return audit.NewLogger(file)
+	default:
+		return audit.NewLogger(memory)
+	}
+}
+
+// recordAudit records a successful sensitive operation by the authenticated caller,
+// the team member rather than their company account when there is one.
+// details must never carry passwords, tokens or other credentials.
+func recordAudit(c *gin.Context, action, target string, details map[string]string) {
+	actorID := c.GetString("member_id")
+	if actorID == "" {
+		actorID = c.GetString("user_id")
+	}
+	auditLog.Record(audit.Event{
+		ActorID:        actorID,
+		ImpersonatorID: middlewares.Impersonator(c),
+		Role:           c.GetString("user_role"),
+		Action:         action,
+		Target:         target,
+		IP:             c.ClientIP(),
+		RequestID:      c.GetString("request_id"),
+		Details:        details,
+	})
+}
+
+// GetAuditEvents lists audit events, newest first, filtered by actor, action and an
+// RFC 3339 from/to time range
+func GetAuditEvents(c *gin.Context) {
+	query := audit.Query{
+		Actor:  c.Query("actor"),
+		Action: c.Query("action"),
+		Page:   1,
+		Limit:  50,
+	}
+	for _, bound := range []struct {
+		name   string
+		target *time.Time
+	}{{"from", &query.From}, {"to", &query.To}} {
+		value := c.Query(bound.name)
+		if value == "" {
+			continue
+		}
+		parsed, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": bound.name + " must be an RFC 3339 time such as 2024-01-02T15:04:05Z"})
+			return
+		}
+		*bound.target = parsed
+	}
+	if page, err := strconv.Atoi(c.DefaultQuery("page", "1")); err == nil && page > 0 {
+		query.Page = page
+	}
+	if limit, err := strconv.Atoi(c.DefaultQuery("limit", "50")); err == nil && limit > 0 && limit <= maxAuditPageSize {
+		query.Limit = limit
+	}
+
+	events, total, err := auditLog.Query(query)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to read audit events: " + err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"events": events,
+		"total":  total,
+		"page":   query.Page,
+		"limit":  query.Limit,
+	})
+}
@@ -4,137 +4,227 @@ import (
 	"context"
 	"log"
 	"net/http"
+	"strconv"
 	"skillsync-api-gateway/clients"
 	"skillsync-api-gateway/middlewares"
-	//"skillsync-api-gateway/utils"
+	"skillsync-api-gateway/utils"
+	"skillsync-api-gateway/utils/profilecache"
 	"github.com/gin-gonic/gin"
 	authpb "github.com/shahal0/skillsync-protos/gen/authpb"
 	"google.golang.org/grpc/metadata"
 )
 
+// Upload routes override the default body handling with these caps
+const (
+	maxResumeRequestSize = 10 << 20
+	maxLogoRequestSize   = maxLogoSize + 64<<10 // room for multipart framing
+)
+
+// Fields selectable with ?fields= on the profile reads
+var (
+	candidateProfileFieldSelector = utils.NewFieldSelector(&authpb.CandidateProfileResponse{}, "phone_verified")
+	employerProfileFieldSelector  = utils.NewFieldSelector(&authpb.EmployerProfileResponse{}, "phone_verified")
+)
+
 func SetupRoutes(r *gin.Engine) {
 	auth := r.Group("/auth")
+	auth.Use(middlewares.Maintenance("auth"))
+	captcha := middlewares.Captcha()
+	loginThrottle := middlewares.LoginThrottle(middlewares.DefaultLoginAttemptStore)
+	unlockLimit := middlewares.RateLimitPerUser(unlockRequestLimit, unlockRequestWindow)
+	// Support acting as a user must not be able to lock them out of their account
+	notImpersonated := middlewares.RejectImpersonation()
 
 	// Public candidate routes (no authentication required)
 	candidatePublic := auth.Group("/candidate")
 	{
-		candidatePublic.POST("/signup", candidateSignup)
-		candidatePublic.POST("/login", candidateLogin)
+		candidatePublic.POST("/signup", captcha, candidateSignup)
+		candidatePublic.POST("/login", loginThrottle, candidateLogin)
 		candidatePublic.POST("/verify-email", candidateVerifyEmail)
-		candidatePublic.POST("/resend-otp", candidateResendOtp)
-		candidatePublic.POST("/forgot-password", candidateForgotPassword)
+		candidatePublic.GET("/verify", verifyEmailLink("candidate"))
+		candidatePublic.POST("/resend-otp", captcha, candidateResendOtp)
+		candidatePublic.POST("/forgot-password", captcha, candidateForgotPassword)
 		candidatePublic.PUT("/reset-password", candidateResetPassword)
-		candidatePublic.GET("/google/login", candidateGoogleLogin)
-		candidatePublic.GET("/google/callback", candidateGoogleCallback)
+		candidatePublic.GET("/oauth/:provider/login", oauthLogin("candidate", ""))
+		candidatePublic.GET("/oauth/:provider/callback", oauthCallback("candidate", ""))
+		// Kept for existing clients; same as /oauth/google/...
+		candidatePublic.GET("/google/login", oauthLogin("candidate", "google"))
+		candidatePublic.GET("/google/callback", oauthCallback("candidate", "google"))
+		candidatePublic.POST("/login/2fa", twoFactorLoginLimit(), candidateLoginTwoFactor)
+		candidatePublic.POST("/unlock", unlockLimit, requestUnlock("candidate"))
+		candidatePublic.GET("/unlock", unlockLink("candidate"))
 	}
 
 	// Protected candidate routes (authentication required)
 	candidateProtected := auth.Group("/candidate")
 	candidateProtected.Use(middlewares.JWTMiddleware())
 	{
-		candidateProtected.PATCH("/change-password", candidateChangePassword)
+		candidateProtected.PATCH("/change-password", notImpersonated, candidateChangePassword)
 		candidateProtected.GET("/profile", candidateProfile)
 		candidateProtected.PUT("/profile/update", candidateProfileUpdate)
 		candidateProtected.PUT("/Skills/update", candidateSkillsUpdate)
 		candidateProtected.PUT("/Education/update", candidateEducationUpdate)
-		candidateProtected.POST("/upload/resume", candidateUploadResume)
+		candidateProtected.GET("/visibility", middlewares.RequireRole("candidate"), candidateGetVisibility)
+		candidateProtected.PUT("/visibility", middlewares.RequireRole("candidate"), candidateUpdateVisibility)
+		candidateProtected.POST("/upload/resume", middlewares.MaxBodySize(maxResumeRequestSize), candidateUploadResume)
+		candidateProtected.DELETE("/account", notImpersonated, candidateDeleteAccount)
+		candidateProtected.GET("/export", middlewares.RequireRole("candidate"), middlewares.RateLimitPerUser(candidateExportLimit, candidateExportWindow), candidateExportData)
+		candidateProtected.POST("/change-email", notImpersonated, middlewares.RateLimitPerUser(emailChangeRequestLimit, emailChangeLimitWindow), candidateRequestEmailChange)
+		candidateProtected.POST("/confirm-email-change", middlewares.RateLimitPerUser(emailChangeConfirmLimit, emailChangeLimitWindow), candidateConfirmEmailChange)
+		candidateProtected.POST("/phone", middlewares.RateLimitPerUser(1, phoneResendCooldown), candidateAddPhone)
+		candidateProtected.POST("/phone/verify", middlewares.RateLimitPerUser(phoneVerifyLimit, phoneVerifyWindow), candidateVerifyPhone)
+		candidateProtected.DELETE("/phone", candidateRemovePhone)
+		candidateProtected.POST("/2fa/setup", notImpersonated, candidateSetupTwoFactor)
+		candidateProtected.POST("/2fa/enable", notImpersonated, candidateEnableTwoFactor)
+		candidateProtected.POST("/2fa/disable", notImpersonated, candidateDisableTwoFactor)
+		candidateProtected.GET("/sessions", candidateListSessions)
+		candidateProtected.DELETE("/sessions/:id", notImpersonated, candidateRevokeSession)
 	}
 
 	// Public employer routes (no authentication required)
 	employerPublic := auth.Group("/employer")
 	{
-		employerPublic.POST("/signup", employerSignup)
-		employerPublic.POST("/login", employerLogin)
+		employerPublic.POST("/signup", captcha, employerSignup)
+		employerPublic.POST("/login", loginThrottle, employerLogin)
 		employerPublic.POST("/verify-email", employerVerifyEmail)
-		employerPublic.POST("/resend-otp", employerResendOtp)
-		employerPublic.POST("/forgot-password", employerForgotPassword)
+		employerPublic.GET("/verify", verifyEmailLink("employer"))
+		employerPublic.POST("/resend-otp", captcha, employerResendOtp)
+		employerPublic.POST("/forgot-password", captcha, employerForgotPassword)
 		employerPublic.PUT("/reset-password", employerResetPassword)
-		employerPublic.GET("/google/login", employerGoogleLogin)
-		employerPublic.GET("/google/callback", employerGoogleCallback)
+		employerPublic.GET("/oauth/:provider/login", oauthLogin("employer", ""))
+		employerPublic.GET("/oauth/:provider/callback", oauthCallback("employer", ""))
+		// Kept for existing clients; same as /oauth/google/...
+		employerPublic.GET("/google/login", oauthLogin("employer", "google"))
+		employerPublic.GET("/google/callback", oauthCallback("employer", "google"))
+		employerPublic.POST("/login/2fa", twoFactorLoginLimit(), employerLoginTwoFactor)
+		employerPublic.POST("/unlock", unlockLimit, requestUnlock("employer"))
+		employerPublic.GET("/unlock", unlockLink("employer"))
 	}
 
 	// Protected employer routes (authentication required)
+	// Team members share the account, so only its owners change its settings
+	ownerOnly := middlewares.RequireTeamRole(middlewares.TeamRoleOwner)
 	employerProtected := auth.Group("/employer")
-	employerProtected.Use(middlewares.JWTMiddleware())
+	employerProtected.Use(middlewares.JWTMiddleware(), middlewares.ReadOnlyForViewers())
 	{
-		employerProtected.PATCH("/change-password", employerChangePassword)
+		employerProtected.PATCH("/change-password", ownerOnly, notImpersonated, employerChangePassword)
 		employerProtected.GET("/profile", employerProfile)
-		employerProtected.PUT("/profile/update", employerProfileUpdate)
+		employerProtected.GET("/plan", middlewares.RequireRole("employer"), getEmployerPlan)
+		employerProtected.PUT("/profile/update", ownerOnly, employerProfileUpdate)
+		employerProtected.DELETE("/account", ownerOnly, notImpersonated, employerDeleteAccount)
+		employerProtected.POST("/change-email", ownerOnly, notImpersonated, middlewares.RateLimitPerUser(emailChangeRequestLimit, emailChangeLimitWindow), employerRequestEmailChange)
+		employerProtected.POST("/confirm-email-change", ownerOnly, middlewares.RateLimitPerUser(emailChangeConfirmLimit, emailChangeLimitWindow), employerConfirmEmailChange)
+		employerProtected.POST("/phone", ownerOnly, middlewares.RateLimitPerUser(1, phoneResendCooldown), employerAddPhone)
+		employerProtected.POST("/phone/verify", ownerOnly, middlewares.RateLimitPerUser(phoneVerifyLimit, phoneVerifyWindow), employerVerifyPhone)
+		employerProtected.DELETE("/phone", ownerOnly, employerRemovePhone)
+		employerProtected.POST("/2fa/setup", ownerOnly, notImpersonated, employerSetupTwoFactor)
+		employerProtected.POST("/2fa/enable", ownerOnly, notImpersonated, employerEnableTwoFactor)
+		employerProtected.POST("/2fa/disable", ownerOnly, notImpersonated, employerDisableTwoFactor)
+		employerProtected.GET("/sessions", ownerOnly, employerListSessions)
+		employerProtected.DELETE("/sessions/:id", ownerOnly, notImpersonated, employerRevokeSession)
+		employerProtected.POST("/upload/logo", ownerOnly, middlewares.MaxBodySize(maxLogoRequestSize), employerUploadLogo)
+		employerProtected.GET("/team", ownerOnly, listTeamMembers)
+		employerProtected.POST("/team/invite", ownerOnly, inviteTeamMember)
+		employerProtected.PUT("/team/:member_id/role", ownerOnly, updateTeamMemberRole)
+		employerProtected.DELETE("/team/:member_id", ownerOnly, removeTeamMember)
+	}
+
+	// Employer verification (KYC) routes
+	employerVerification := auth.Group("/employer/verification")
+	employerVerification.Use(middlewares.JWTMiddleware(), middlewares.RequireRole("employer"), middlewares.ReadOnlyForViewers())
+	{
+		employerVerification.POST("/documents", ownerOnly, middlewares.MaxBodySize(maxVerificationRequestSize()), employerUploadVerificationDocuments)
+		employerVerification.GET("/status", employerVerificationStatus)
 	}
 }
 
 func candidateSignup(c *gin.Context) {
 	var req authpb.CandidateSignupRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	if err := utils.BindProto(c, &req); err != nil {
+		utils.RespondWithValidationError(c, err)
+		return
+	}
+	if utils.RejectWeakPassword(c, "password", req.Password, req.Email) {
 		return
 	}
-	// Call the CandidateSignup method
-	authResp, err := clients.AuthServiceClient.CandidateSignup(context.Background(), &req)
+	// Call the CandidateSignup method, once for a double-submitted form
+	authResp, replayed, err := dedupeSignup(c.Request.Context(), "candidate", req.Email, &req, func(ctx context.Context) (*authpb.CandidateSignupResponse, error) {
+		return clients.AuthServiceClient.CandidateSignup(ctx, &req)
+	})
 	if err != nil {
-		c.JSON(http.StatusBadGateway, gin.H{"error": err.Error()})
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": utils.GRPCErrorMessage(err)})
 		return
 	}
+	if replayed {
+		c.Header(SignupReplayedHeader, "true")
+	}
 	// Return only id and message as per user preference
 	c.JSON(http.StatusOK, authResp)
 }
 
 func candidateLogin(c *gin.Context) {
 	var req authpb.CandidateLoginRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	if err := utils.BindProto(c, &req); err != nil {
+		utils.RespondWithValidationError(c, err)
 		return
 	}
-	resp, err := clients.AuthServiceClient.CandidateLogin(context.Background(), &req)
+	resp, err := clients.AuthServiceClient.CandidateLogin(loginContext(c), &req)
 	if err != nil {
-		c.JSON(http.StatusBadGateway, gin.H{"error": err.Error()})
+		respondLoginError(c, err)
+		return
+	}
+	if resp.GetTwoFactorRequired() {
+		respondTwoFactorChallenge(c, "candidate", resp.GetChallengeToken(), false)
 		return
 	}
-	log.Println(resp)
-	c.JSON(http.StatusOK, gin.H{
-		"id":      resp.Id,
-		"message": resp.Message,
-		"token":   resp.Token,
+	respondLogin(c, loginResult{
+		ID:            resp.GetId(),
+		Role:          "candidate",
+		Token:         resp.GetToken(),
+		RefreshToken:  resp.GetRefreshToken(),
+		Message:       resp.GetMessage(),
+		EmailVerified: resp.GetEmailVerified(),
 	})
 }
 
+// respondLoginError keeps the upstream status so LoginThrottle can tell bad
+// credentials from outages, but answers an unknown email like a wrong password
+func respondLoginError(c *gin.Context, err error) {
+	status := utils.HTTPStatusFromGRPC(err)
+	if status == http.StatusUnauthorized || status == http.StatusNotFound {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid email or password"})
+		return
+	}
+	c.JSON(status, gin.H{"error": utils.GRPCErrorMessage(err)})
+}
+
 func candidateVerifyEmail(c *gin.Context) {
 	var req authpb.VerifyEmailRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	if err := utils.BindProto(c, &req); err != nil {
+		utils.RespondWithValidationError(c, err)
 		return
 	}
-	resp, err := clients.AuthServiceClient.CandidateVerifyEmail(context.Background(), &req)
+	resp, err := clients.AuthServiceClient.CandidateVerifyEmail(c.Request.Context(), &req)
 	if err != nil {
-		c.JSON(http.StatusBadGateway, gin.H{"error": err.Error()})
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": utils.GRPCErrorMessage(err)})
 		return
 	}
 	c.JSON(http.StatusOK, resp)
 }
 
 func candidateResendOtp(c *gin.Context) {
-	var req authpb.ResendOtpRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
-		return
-	}
-	resp, err := clients.AuthServiceClient.CandidateResendOtp(context.Background(), &req)
-	if err != nil {
-		c.JSON(http.StatusBadGateway, gin.H{"error": err.Error()})
-		return
-	}
-	c.JSON(http.StatusOK, resp)
+	resendOtp(c, "candidate", clients.AuthServiceClient.CandidateResendOtp)
 }
 
 func candidateForgotPassword(c *gin.Context) {
 	var req authpb.ForgotPasswordRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	if err := utils.BindProto(c, &req); err != nil {
+		utils.RespondWithValidationError(c, err)
 		return
 	}
-	resp, err := clients.AuthServiceClient.CandidateForgotPassword(context.Background(), &req)
+	resp, err := clients.AuthServiceClient.CandidateForgotPassword(c.Request.Context(), &req)
 	if err != nil {
-		c.JSON(http.StatusBadGateway, gin.H{"error": err.Error()})
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": utils.GRPCErrorMessage(err)})
 		return
 	}
 	c.JSON(http.StatusOK, resp)
@@ -142,13 +232,16 @@ func candidateForgotPassword(c *gin.Context) {
 
 func candidateResetPassword(c *gin.Context) {
 	var req authpb.ResetPasswordRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	if err := utils.BindProto(c, &req); err != nil {
+		utils.RespondWithValidationError(c, err)
+		return
+	}
+	if utils.RejectWeakPassword(c, "new_password", req.NewPassword, req.Email) {
 		return
 	}
-	resp, err := clients.AuthServiceClient.CandidateResetPassword(context.Background(), &req)
+	resp, err := clients.AuthServiceClient.CandidateResetPassword(c.Request.Context(), &req)
 	if err != nil {
-		c.JSON(http.StatusBadGateway, gin.H{"error": err.Error()})
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": utils.GRPCErrorMessage(err)})
 		return
 	}
 	c.JSON(http.StatusOK, resp)
@@ -164,23 +257,27 @@ func candidateChangePassword(c *gin.Context) {
 
 	// Parse request body
 	var req authpb.ChangePasswordRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	if err := utils.BindProto(c, &req); err != nil {
+		utils.RespondWithValidationError(c, err)
+		return
+	}
+	if utils.RejectWeakPassword(c, "new_password", req.NewPassword, c.GetString("user_email")) {
 		return
 	}
 
 	// Create context with metadata for auth service
 	ctx := metadata.NewOutgoingContext(
-		context.Background(),
+		c.Request.Context(),
 		metadata.New(map[string]string{"user-id": userID.(string)}),
 	)
 
 	// Call gRPC service with metadata context
 	resp, err := clients.AuthServiceClient.CandidateChangePassword(ctx, &req)
 	if err != nil {
-		c.JSON(http.StatusBadGateway, gin.H{"error": err.Error()})
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": utils.GRPCErrorMessage(err)})
 		return
 	}
+	recordAudit(c, "password.change", "candidate:"+userID.(string), nil)
 	c.JSON(http.StatusOK, resp)
 }
 
@@ -197,7 +294,7 @@ func candidateProfile(c *gin.Context) {
 
 	// Create context with metadata for auth service
 	ctx := metadata.NewOutgoingContext(
-		context.Background(),
+		c.Request.Context(),
 		metadata.New(map[string]string{"user-id": userID.(string)}),
 	)
 
@@ -206,12 +303,13 @@ func candidateProfile(c *gin.Context) {
 
 	resp, err := clients.AuthServiceClient.CandidateProfile(ctx, req)
 	if err != nil {
-		c.JSON(http.StatusBadGateway, gin.H{"error": err.Error()})
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": utils.GRPCErrorMessage(err)})
 		return
 	}
 	// Log successful response
 	log.Printf("Received successful response from CandidateProfile gRPC method")
-	c.JSON(http.StatusOK, resp)
+	c.Header("ETag", profileETag(resp))
+	utils.RespondWithFields(c, http.StatusOK, withPhoneVerified(resp, resp.GetPhoneVerified()), "", candidateProfileFieldSelector)
 }
 
 func candidateProfileUpdate(c *gin.Context) {
@@ -224,24 +322,32 @@ func candidateProfileUpdate(c *gin.Context) {
 
 	// Parse request body
 	var req authpb.CandidateProfileUpdateRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	if err := utils.BindProto(c, &req); err != nil {
+		utils.RespondWithValidationError(c, err)
 		return
 	}
 
 	// Create context with metadata for auth service
 	ctx := metadata.NewOutgoingContext(
-		context.Background(),
+		c.Request.Context(),
 		metadata.New(map[string]string{"user-id": userID.(string)}),
 	)
 
+	// With If-Match, refuse to overwrite changes made since the client read the profile
+	if !checkProfileVersion(ctx, c, "candidate") {
+		return
+	}
+
 	// Call gRPC service with metadata context
 	resp, err := clients.AuthServiceClient.CandidateProfileUpdate(ctx, &req)
 	if err != nil {
-		c.JSON(http.StatusBadGateway, gin.H{"error": err.Error()})
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": utils.GRPCErrorMessage(err)})
 		return
 	}
 
+	profilecache.Invalidate(c.Request.Context(), userID.(string))
+	recordAudit(c, "profile.update", "candidate:"+userID.(string), nil)
+	setUpdatedProfileETag(ctx, c, "candidate")
 	c.JSON(http.StatusOK, resp)
 }
 
@@ -254,24 +360,33 @@ func candidateSkillsUpdate(c *gin.Context) {
 	}
 	// Parse request body
 	var req authpb.SkillsUpdateRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	if err := utils.BindProto(c, &req); err != nil {
+		utils.RespondWithValidationError(c, err)
+		return
+	}
+	submitted := len(req.Skills)
+	var unrecognized []string
+	req.Skills, unrecognized = normalizeCandidateSkills(req.Skills)
+	if submitted > 0 && len(req.Skills) == 0 {
+		respondUnrecognizedSkills(c, unrecognized)
 		return
 	}
 
 	// Create context with metadata for auth service
 	ctx := metadata.NewOutgoingContext(
-		context.Background(),
+		c.Request.Context(),
 		metadata.New(map[string]string{"user-id": userID.(string)}),
 	)
 
 	// Call gRPC service with metadata context
 	resp, err := clients.AuthServiceClient.CandidateSkillsUpdate(ctx, &req)
 	if err != nil {
-		c.JSON(http.StatusBadGateway, gin.H{"error": err.Error()})
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": utils.GRPCErrorMessage(err)})
 		return
 	}
-	c.JSON(http.StatusOK, resp)
+	profilecache.Invalidate(c.Request.Context(), userID.(string))
+	recordAudit(c, "profile.update", "candidate:"+userID.(string), map[string]string{"section": "skills"})
+	c.JSON(http.StatusOK, withUnrecognizedSkills(resp, unrecognized))
 }
 
 func candidateEducationUpdate(c *gin.Context) {
@@ -285,23 +400,25 @@ func candidateEducationUpdate(c *gin.Context) {
 
 	// Parse request body
 	var req authpb.EducationUpdateRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	if err := utils.BindProto(c, &req); err != nil {
+		utils.RespondWithValidationError(c, err)
 		return
 	}
 
 	// Create context with metadata for auth service
 	ctx := metadata.NewOutgoingContext(
-		context.Background(),
+		c.Request.Context(),
 		metadata.New(map[string]string{"user-id": userID.(string)}),
 	)
 
 	// Call gRPC service with metadata context
 	resp, err := clients.AuthServiceClient.CandidateEducationUpdate(ctx, &req)
 	if err != nil {
-		c.JSON(http.StatusBadGateway, gin.H{"error": err.Error()})
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": utils.GRPCErrorMessage(err)})
 		return
 	}
+	profilecache.Invalidate(c.Request.Context(), userID.(string))
+	recordAudit(c, "profile.update", "candidate:"+userID.(string), map[string]string{"section": "education"})
 	c.JSON(http.StatusOK, resp)
 }
 
@@ -314,181 +431,111 @@ func candidateUploadResume(c *gin.Context) {
 	}
 	log.Printf("Using user ID from JWT context: %s", userID)
 
-	// Parse request body
+	// Parse request body: either the file inline or the object_key of a presigned upload
 	var req authpb.UploadResumeRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	var objectKey string
+	if err := utils.BindProtoWithExtras(c, &req, map[string]interface{}{"object_key": &objectKey}); err != nil {
+		utils.RespondWithValidationError(c, err)
 		return
 	}
+	if objectKey != "" {
+		if _, ok := verifyUpload(c, userID.(string), uploadPurposeResume, objectKey); !ok {
+			return
+		}
+		req.ResumeUrl = uploadStorage.ObjectURL(objectKey)
+	}
 
 	// Create context with metadata for auth service
 	ctx := metadata.NewOutgoingContext(
-		context.Background(),
+		c.Request.Context(),
 		metadata.New(map[string]string{"user-id": userID.(string)}),
 	)
 
 	// Call gRPC service with metadata context
 	resp, err := clients.AuthServiceClient.CandidateUploadResume(ctx, &req)
 	if err != nil {
-		c.JSON(http.StatusBadGateway, gin.H{"error": err.Error()})
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": utils.GRPCErrorMessage(err)})
 		return
 	}
+	profilecache.Invalidate(c.Request.Context(), userID.(string))
 	c.JSON(http.StatusOK, resp)
 }
 
-func candidateGoogleLogin(c *gin.Context) {
-	// Get the redirect URI from query parameters or use a default one
-	redirectURI := c.Query("redirect_uri")
-	if redirectURI == "" {
-		// Must use the complete URL that's registered in Google Cloud Console
-		redirectURI = "http://localhost:8060/candidate/auth/google/callback"
-	}
-	
-	// Log the redirect URI for debugging
-	log.Printf("Candidate Google login using redirect URI: %s", redirectURI)
-	
-	// Create the request with the redirect URI
-	req := &authpb.GoogleLoginRequest{	
-		RedirectUrl: redirectURI,
-	}
-	
-	// Call the Auth Service to get the Google authorization URL
-	resp, err := clients.AuthServiceClient.CandidateGoogleLogin(context.Background(), req)
-	if err != nil {
-		c.JSON(http.StatusBadGateway, gin.H{"error": err.Error()})
-		return
-	}
-	
-	// The message field contains the authorization URL
-	authURL := resp.GetMessage()
-	if authURL == "" {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate Google authorization URL"})
-		return
-	}
-	
-	// Redirect the user to the Google authorization URL
-	c.Redirect(http.StatusTemporaryRedirect, authURL)
-}
-
-func candidateGoogleCallback(c *gin.Context) {
-	// Get the authorization code from the query parameters
-	code := c.Query("code")
-	
-	if code == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Missing authorization code"})
-		return
-	}
-	
-	// Create the callback request with the code
-	req := &authpb.GoogleCallbackRequest{
-		Code: code,
-	}
-	
-	// Call the Auth Service to exchange the code for tokens
-	resp, err := clients.AuthServiceClient.CandidateGoogleCallback(context.Background(), req)
-	if err != nil {
-		c.JSON(http.StatusBadGateway, gin.H{"error": err.Error()})
+func employerSignup(c *gin.Context) {
+	var req authpb.EmployerSignupRequest
+	if err := utils.BindProto(c, &req); err != nil {
+		utils.RespondWithValidationError(c, err)
 		return
 	}
-	
-	// Check if we got a valid token
-	if resp.GetToken() == "" {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to authenticate with Google"})
+	if utils.RejectWeakPassword(c, "password", req.Password, req.Email) {
 		return
 	}
-	
-	// Set the token as a cookie or return it in the response
-	// Option 1: Set as cookie
-	c.SetCookie(
-		"auth_token",
-		resp.GetToken(),
-		3600*24, // 24 hours
-		"/",
-		"", // domain
-		true,  // secure
-		true,  // httpOnly
-	)
-	
-	// Option 2: Return in response
-	c.JSON(http.StatusOK, gin.H{
-		"token": resp.GetToken(),
-		"message": resp.GetMessage(),
+	resp, replayed, err := dedupeSignup(c.Request.Context(), "employer", req.Email, &req, func(ctx context.Context) (*authpb.EmployerSignupResponse, error) {
+		return clients.AuthServiceClient.EmployerSignup(ctx, &req)
 	})
-}
-
-func employerSignup(c *gin.Context) {
-	var req authpb.EmployerSignupRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
-		return
-	}
-	resp, err := clients.AuthServiceClient.EmployerSignup(context.Background(), &req)
 	if err != nil {
-		c.JSON(http.StatusBadGateway, gin.H{"error": err.Error()})
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": utils.GRPCErrorMessage(err)})
 		return
 	}
+	if replayed {
+		c.Header(SignupReplayedHeader, "true")
+	}
 	c.JSON(http.StatusOK, resp)
 }
 
 func employerLogin(c *gin.Context) {
 	var req authpb.EmployerLoginRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	if err := utils.BindProto(c, &req); err != nil {
+		utils.RespondWithValidationError(c, err)
 		return
 	}
-	resp, err := clients.AuthServiceClient.EmployerLogin(context.Background(), &req)
+	resp, err := clients.AuthServiceClient.EmployerLogin(loginContext(c), &req)
 	if err != nil {
-		c.JSON(http.StatusBadGateway, gin.H{"error": err.Error()})
+		respondLoginError(c, err)
 		return
 	}
-	// Log the response for debugging
-	log.Println("Employer login response:", resp)
-	
-	// Explicitly include all fields in the response
-	c.JSON(http.StatusOK, gin.H{
-		"id":      resp.Id,
-		"token":   resp.Token,
-		"message": resp.Message,
+	if resp.GetTwoFactorRequired() {
+		respondTwoFactorChallenge(c, "employer", resp.GetChallengeToken(), false)
+		return
+	}
+	respondLogin(c, loginResult{
+		ID:            strconv.FormatInt(resp.GetId(), 10),
+		LegacyID:      resp.GetId(),
+		Role:          "employer",
+		Token:         resp.GetToken(),
+		RefreshToken:  resp.GetRefreshToken(),
+		Message:       resp.GetMessage(),
+		EmailVerified: resp.GetEmailVerified(),
 	})
 }
 
 func employerVerifyEmail(c *gin.Context) {
 	var req authpb.VerifyEmailRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	if err := utils.BindProto(c, &req); err != nil {
+		utils.RespondWithValidationError(c, err)
 		return
 	}
-	resp, err := clients.AuthServiceClient.EmployerVerifyEmail(context.Background(), &req)
+	resp, err := clients.AuthServiceClient.EmployerVerifyEmail(c.Request.Context(), &req)
 	if err != nil {
-		c.JSON(http.StatusBadGateway, gin.H{"error": err.Error()})
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": utils.GRPCErrorMessage(err)})
 		return
 	}
 	c.JSON(http.StatusOK, resp)
 }
 
 func employerResendOtp(c *gin.Context) {
-	var req authpb.ResendOtpRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
-		return
-	}
-	resp, err := clients.AuthServiceClient.EmployerResendOtp(context.Background(), &req)
-	if err != nil {
-		c.JSON(http.StatusBadGateway, gin.H{"error": err.Error()})
-		return
-	}
-	c.JSON(http.StatusOK, resp)
+	resendOtp(c, "employer", clients.AuthServiceClient.EmployerResendOtp)
 }
 
 func employerForgotPassword(c *gin.Context) {
 	var req authpb.ForgotPasswordRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	if err := utils.BindProto(c, &req); err != nil {
+		utils.RespondWithValidationError(c, err)
 		return
 	}
-	resp, err := clients.AuthServiceClient.EmployerForgotPassword(context.Background(), &req)
+	resp, err := clients.AuthServiceClient.EmployerForgotPassword(c.Request.Context(), &req)
 	if err != nil {
-		c.JSON(http.StatusBadGateway, gin.H{"error": err.Error()})
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": utils.GRPCErrorMessage(err)})
 		return
 	}
 	c.JSON(http.StatusOK, resp)
@@ -496,13 +543,16 @@ func employerForgotPassword(c *gin.Context) {
 
 func employerResetPassword(c *gin.Context) {
 	var req authpb.ResetPasswordRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	if err := utils.BindProto(c, &req); err != nil {
+		utils.RespondWithValidationError(c, err)
 		return
 	}
-	resp, err := clients.AuthServiceClient.EmployerResetPassword(context.Background(), &req)
+	if utils.RejectWeakPassword(c, "new_password", req.NewPassword, req.Email) {
+		return
+	}
+	resp, err := clients.AuthServiceClient.EmployerResetPassword(c.Request.Context(), &req)
 	if err != nil {
-		c.JSON(http.StatusBadGateway, gin.H{"error": err.Error()})
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": utils.GRPCErrorMessage(err)})
 		return
 	}
 	c.JSON(http.StatusOK, resp)
@@ -519,23 +569,27 @@ func employerChangePassword(c *gin.Context) {
 
 	// Parse request body
 	var req authpb.ChangePasswordRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	if err := utils.BindProto(c, &req); err != nil {
+		utils.RespondWithValidationError(c, err)
+		return
+	}
+	if utils.RejectWeakPassword(c, "new_password", req.NewPassword, c.GetString("user_email")) {
 		return
 	}
 
 	// Create context with metadata for auth service
 	ctx := metadata.NewOutgoingContext(
-		context.Background(),
+		c.Request.Context(),
 		metadata.New(map[string]string{"user-id": userID.(string)}),
 	)
 
 	// Call gRPC service with metadata context
 	resp, err := clients.AuthServiceClient.EmployerChangePassword(ctx, &req)
 	if err != nil {
-		c.JSON(http.StatusBadGateway, gin.H{"error": err.Error()})
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": utils.GRPCErrorMessage(err)})
 		return
 	}
+	recordAudit(c, "password.change", "employer:"+userID.(string), nil)
 	c.JSON(http.StatusOK, resp)
 }
 
@@ -550,7 +604,7 @@ func employerProfile(c *gin.Context) {
 
 	// Create context with metadata for auth service
 	ctx := metadata.NewOutgoingContext(
-		context.Background(),
+		c.Request.Context(),
 		metadata.New(map[string]string{"user-id": userID.(string)}),
 	)
 
@@ -559,10 +613,11 @@ func employerProfile(c *gin.Context) {
 
 	resp, err := clients.AuthServiceClient.EmployerProfile(ctx, req)
 	if err != nil {
-		c.JSON(http.StatusBadGateway, gin.H{"error": err.Error()})
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": utils.GRPCErrorMessage(err)})
 		return
 	}
-	c.JSON(http.StatusOK, resp)
+	c.Header("ETag", profileETag(resp))
+	utils.RespondWithFields(c, http.StatusOK, withPhoneVerified(resp, resp.GetPhoneVerified()), "", employerProfileFieldSelector)
 }
 
 func employerProfileUpdate(c *gin.Context) {
@@ -576,101 +631,30 @@ func employerProfileUpdate(c *gin.Context) {
 
 	// Parse request body
 	var req authpb.EmployerProfileUpdateRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	if err := utils.BindProto(c, &req); err != nil {
+		utils.RespondWithValidationError(c, err)
 		return
 	}
 
 	// Create context with metadata for auth service
 	ctx := metadata.NewOutgoingContext(
-		context.Background(),
+		c.Request.Context(),
 		metadata.New(map[string]string{"user-id": userID.(string)}),
 	)
 
-	// Call gRPC service with metadata context
-	resp, err := clients.AuthServiceClient.EmployerProfileUpdate(ctx, &req)
-	if err != nil {
-		c.JSON(http.StatusBadGateway, gin.H{"error": err.Error()})
+	// With If-Match, refuse to overwrite changes made since the client read the profile
+	if !checkProfileVersion(ctx, c, "employer") {
 		return
 	}
 
-	c.JSON(http.StatusOK, resp)
-}
-
-func employerGoogleLogin(c *gin.Context) {
-	// Get the redirect URI from query parameters or use a default one
-	redirectURI := c.Query("redirect_uri")
-	if redirectURI == "" {
-		// Must use the complete URL that's registered in Google Cloud Console
-		redirectURI = "http://localhost:8060/employer/auth/google/callback"
-	}
-	
-	// Log the redirect URI for debugging
-	log.Printf("Employer Google login using redirect URI: %s", redirectURI)
-	
-	// Create the request with the redirect URI
-	req := &authpb.GoogleLoginRequest{	
-		RedirectUrl: redirectURI,
-	}
-	
-	// Call the Auth Service to get the Google authorization URL
-	resp, err := clients.AuthServiceClient.EmployerGoogleLogin(context.Background(), req)
+	// Call gRPC service with metadata context
+	resp, err := clients.AuthServiceClient.EmployerProfileUpdate(ctx, &req)
 	if err != nil {
-		c.JSON(http.StatusBadGateway, gin.H{"error": err.Error()})
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": utils.GRPCErrorMessage(err)})
 		return
 	}
-	
-	// The message field contains the authorization URL
-	authURL := resp.GetMessage()
-	if authURL == "" {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate Google authorization URL"})
-		return
-	}
-	
-	// Redirect the user to the Google authorization URL
-	c.Redirect(http.StatusTemporaryRedirect, authURL)
-}
 
-func employerGoogleCallback(c *gin.Context) {
-	// Get the authorization code from the query parameters
-	code := c.Query("code")
-	
-	if code == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Missing authorization code"})
-		return
-	}
-	
-	// Create the callback request with the code
-	req := &authpb.GoogleCallbackRequest{
-		Code: code,
-	}
-	
-	// Call the Auth Service to exchange the code for tokens
-	resp, err := clients.AuthServiceClient.EmployerGoogleCallback(context.Background(), req)
-	if err != nil {
-		c.JSON(http.StatusBadGateway, gin.H{"error": err.Error()})
-		return
-	}
-	
-	// Check if we got a valid token
-	if resp.GetToken() == "" {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to authenticate with Google"})
-		return
-	}
-	
-	c.SetCookie(
-		"auth_token",
-		resp.GetToken(),
-		3600*24, // 24 hours
-		"/",
-		"", // domain
-		true,  // secure
-		true,  // httpOnly
-	)
-	
-	// Option 2: Return in response
-	c.JSON(http.StatusOK, gin.H{
-		"token": resp.GetToken(),
-		"message": resp.GetMessage(),
-	})
+	recordAudit(c, "profile.update", "employer:"+userID.(string), nil)
+	setUpdatedProfileETag(ctx, c, "employer")
+	c.JSON(http.StatusOK, resp)
 }
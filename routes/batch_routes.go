@@ -0,0 +1,176 @@
+package routes
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"skillsync-api-gateway/middlewares"
+	"skillsync-api-gateway/utils"
+	"skillsync-api-gateway/utils/fanout"
+)
+
+const (
+	// maxBatchRequests caps the sub-requests of one batch
+	maxBatchRequests = 10
+	// batchRequestTimeout bounds each sub-request
+	batchRequestTimeout = 10 * time.Second
+	// maxBatchBodySize caps the whole batch, sub-request bodies included
+	maxBatchBodySize = 1 << 20
+)
+
+// batchForwardedHeaders are passed from the batch to each sub-request, so they
+// run as the same caller from the same client
+var batchForwardedHeaders = []string{
+	"Authorization", "X-API-Key", "Cookie", "Accept-Language",
+	"X-Forwarded-For", "X-Real-IP", "User-Agent", "X-User-Agent",
+}
+
+// nonIdempotentMethods may have a different effect when repeated, so a client
+// retrying a batch must opt in to sending them
+var nonIdempotentMethods = map[string]bool{http.MethodPost: true, http.MethodPatch: true}
+
+type batchSubRequest struct {
+	Method string          `json:"method" binding:"required,oneof=GET HEAD POST PUT PATCH DELETE"`
+	Path   string          `json:"path" binding:"required,startswith=/,max=2048"`
+	Body   json.RawMessage `json:"body"`
+}
+
+type batchRequest struct {
+	Requests []batchSubRequest `json:"requests" binding:"required,min=1,max=10,dive"`
+	// AllowNonIdempotent lets the batch include POST and PATCH sub-requests
+	AllowNonIdempotent bool `json:"allow_non_idempotent"`
+}
+
+type batchResponse struct {
+	Status  int               `json:"status"`
+	Headers map[string]string `json:"headers"`
+	Body    json.RawMessage   `json:"body"`
+}
+
+func SetupBatchRoutes(r *gin.Engine) {
+	r.POST("/batch", middlewares.MaxBodySize(maxBatchBodySize), Batch(r))
+}
+
+// batchTarget checks a sub-request's path and returns it cleaned, with its query
+func batchTarget(raw string) (string, bool) {
+	target, err := url.Parse(raw)
+	if err != nil || target.Scheme != "" || target.Host != "" || !strings.HasPrefix(target.Path, "/") {
+		return "", false
+	}
+	cleaned := path.Clean(target.Path)
+	// A batch inside a batch would get around the sub-request cap
+	if cleaned == "/batch" {
+		return "", false
+	}
+	// Routes such as GET /jobs/ are registered with their trailing slash
+	if strings.HasSuffix(target.Path, "/") && cleaned != "/" {
+		cleaned += "/"
+	}
+	target.Path = cleaned
+	return target.RequestURI(), true
+}
+
+// Batch runs up to 10 independent gateway calls concurrently through the router,
+// so mobile clients can make them in one round trip. Each sub-request carries the
+// batch's credentials and goes through every middleware on its route, so it is
+// authenticated, rate limited and metered on its own. The responses are returned
+// in the order of the sub-requests.
+func Batch(router http.Handler) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var body batchRequest
+		if err := c.ShouldBindJSON(&body); err != nil {
+			utils.RespondWithValidationError(c, err)
+			return
+		}
+		targets := make([]string, len(body.Requests))
+		for i, sub := range body.Requests {
+			target, ok := batchTarget(sub.Path)
+			if !ok {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"error":      "requests[" + strconv.Itoa(i) + "].path must be a gateway path other than /batch",
+					"error_code": "invalid_batch_path",
+				})
+				return
+			}
+			if nonIdempotentMethods[sub.Method] && !body.AllowNonIdempotent {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"error":      "requests[" + strconv.Itoa(i) + "] is a " + sub.Method + "; set allow_non_idempotent to send it in a batch",
+					"error_code": "non_idempotent_batch_request",
+				})
+				return
+			}
+			targets[i] = target
+		}
+
+		indexes := make([]int, len(body.Requests))
+		for i := range indexes {
+			indexes[i] = i
+		}
+		dispatch := func(ctx context.Context, i int) (batchResponse, error) {
+			return dispatchBatchRequest(ctx, c, router, i, body.Requests[i], targets[i])
+		}
+		results := fanout.RunWith(c.Request.Context(), indexes, maxBatchRequests, dispatch, fanout.Options{ItemTimeout: batchRequestTimeout})
+
+		responses := make([]batchResponse, len(results))
+		for i, result := range results {
+			if result.Err != nil {
+				errorBody, _ := json.Marshal(gin.H{"error": "Request not completed: " + result.Err.Error()})
+				responses[i] = batchResponse{Status: http.StatusGatewayTimeout, Headers: map[string]string{}, Body: errorBody}
+				continue
+			}
+			responses[i] = result.Value
+		}
+		c.JSON(http.StatusMultiStatus, gin.H{"responses": responses})
+	}
+}
+
+// dispatchBatchRequest serves one sub-request through the router and records its
+// response. Sub-requests share nothing but the batch's headers and client address.
+func dispatchBatchRequest(ctx context.Context, c *gin.Context, router http.Handler, i int, sub batchSubRequest, target string) (batchResponse, error) {
+	var requestBody []byte
+	if len(sub.Body) > 0 && string(sub.Body) != "null" {
+		requestBody = sub.Body
+	}
+	req, err := http.NewRequestWithContext(ctx, sub.Method, target, bytes.NewReader(requestBody))
+	if err != nil {
+		return batchResponse{}, err
+	}
+	for _, name := range batchForwardedHeaders {
+		if value := c.GetHeader(name); value != "" {
+			req.Header.Set(name, value)
+		}
+	}
+	if requestBody != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set(middlewares.RequestIDHeader, c.GetString("request_id")+"."+strconv.Itoa(i))
+	req.RemoteAddr = c.Request.RemoteAddr
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, req)
+
+	headers := make(map[string]string, len(recorder.Header()))
+	for name, values := range recorder.Header() {
+		headers[name] = strings.Join(values, ", ")
+	}
+	responseBody := recorder.Body.Bytes()
+	switch {
+	case len(responseBody) == 0:
+		responseBody = []byte("null")
+	case !json.Valid(responseBody):
+		// Non-JSON responses, such as CSV or feeds, are returned as a string
+		responseBody, _ = json.Marshal(string(responseBody))
+	}
+	return batchResponse{Status: recorder.Code, Headers: headers, Body: responseBody}, nil
+}
@@ -0,0 +1,131 @@
+package routes
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestBatchTarget(t *testing.T) {
+	tests := []struct {
+		raw    string
+		want   string
+		wantOK bool
+	}{
+		{"/jobs/?page=2", "/jobs/?page=2", true},
+		{"/jobs/../auth/candidate/profile", "/auth/candidate/profile", true},
+		{"/jobs//saved/", "/jobs/saved/", true},
+		{"/", "/", true},
+		{"/batch", "", false},
+		{"/jobs/../batch", "", false},
+		{"/batch/", "", false},
+		{"https://evil.example/jobs", "", false},
+		{"//evil.example/jobs", "", false},
+		{"jobs", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			got, ok := batchTarget(tt.raw)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("batchTarget(%q) = %q, %v, want %q, %v", tt.raw, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+// batchRouter serves a few routes that show what each sub-request received
+func batchRouter() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(func(c *gin.Context) { c.Set("request_id", "req-1") })
+	r.GET("/whoami", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"authorization": c.GetHeader("Authorization"), "request_id": c.GetHeader("X-Request-ID")})
+	})
+	r.POST("/echo", func(c *gin.Context) {
+		body, _ := io.ReadAll(c.Request.Body)
+		c.Data(http.StatusCreated, "application/json", body)
+	})
+	r.GET("/feed.csv", func(c *gin.Context) { c.String(http.StatusOK, "id,title\n1,SRE\n") })
+	r.DELETE("/things/:id", func(c *gin.Context) { c.Status(http.StatusNoContent) })
+	SetupBatchRoutes(r)
+	return r
+}
+
+func TestBatch(t *testing.T) {
+	tests := []struct {
+		name          string
+		body          string
+		wantStatus    int
+		wantResponses []batchResponse
+		wantErrorCode string
+	}{
+		{
+			name:       "responses in order",
+			body:       `{"requests":[{"method":"GET","path":"/whoami"},{"method":"GET","path":"/feed.csv"},{"method":"DELETE","path":"/things/1"},{"method":"GET","path":"/nowhere"}]}`,
+			wantStatus: http.StatusMultiStatus,
+			wantResponses: []batchResponse{
+				{Status: http.StatusOK, Body: json.RawMessage(`{"authorization":"Bearer t","request_id":"req-1.0"}`)},
+				{Status: http.StatusOK, Body: json.RawMessage(`"id,title\n1,SRE\n"`)},
+				{Status: http.StatusNoContent, Body: json.RawMessage(`null`)},
+				{Status: http.StatusNotFound, Body: json.RawMessage(`"404 page not found"`)},
+			},
+		},
+		{
+			name:          "post needs opting in",
+			body:          `{"requests":[{"method":"POST","path":"/echo","body":{"a":1}}]}`,
+			wantStatus:    http.StatusBadRequest,
+			wantErrorCode: "non_idempotent_batch_request",
+		},
+		{
+			name:          "post opted in",
+			body:          `{"requests":[{"method":"POST","path":"/echo","body":{"a":1}}],"allow_non_idempotent":true}`,
+			wantStatus:    http.StatusMultiStatus,
+			wantResponses: []batchResponse{{Status: http.StatusCreated, Body: json.RawMessage(`{"a":1}`)}},
+		},
+		{
+			name:          "nested batch",
+			body:          `{"requests":[{"method":"GET","path":"/whoami"},{"method":"POST","path":"/batch"}],"allow_non_idempotent":true}`,
+			wantStatus:    http.StatusBadRequest,
+			wantErrorCode: "invalid_batch_path",
+		},
+		{"no requests", `{"requests":[]}`, http.StatusBadRequest, nil, ""},
+		{"too many requests", `{"requests":[` + strings.Repeat(`{"method":"GET","path":"/whoami"},`, 10) + `{"method":"GET","path":"/whoami"}]}`, http.StatusBadRequest, nil, ""},
+		{"unknown method", `{"requests":[{"method":"TRACE","path":"/whoami"}]}`, http.StatusBadRequest, nil, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := batchRouter()
+			req := httptest.NewRequest(http.MethodPost, "/batch", strings.NewReader(tt.body))
+			req.Header.Set("Authorization", "Bearer t")
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d (%s)", w.Code, tt.wantStatus, w.Body)
+			}
+			var got struct {
+				Responses []batchResponse `json:"responses"`
+				ErrorCode string          `json:"error_code"`
+			}
+			if err := json.Unmarshal(w.Body.Bytes(), &got); err != nil {
+				t.Fatal(err)
+			}
+			if got.ErrorCode != tt.wantErrorCode {
+				t.Errorf("error_code = %q, want %q", got.ErrorCode, tt.wantErrorCode)
+			}
+			if len(got.Responses) != len(tt.wantResponses) {
+				t.Fatalf("%d responses, want %d", len(got.Responses), len(tt.wantResponses))
+			}
+			for i, want := range tt.wantResponses {
+				if got.Responses[i].Status != want.Status || string(got.Responses[i].Body) != string(want.Body) {
+					t.Errorf("responses[%d] = %d %s, want %d %s", i, got.Responses[i].Status, got.Responses[i].Body, want.Status, want.Body)
+				}
+			}
+		})
+	}
+}
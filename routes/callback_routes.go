@@ -0,0 +1,153 @@
+package routes
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	jobpb "github.com/shahal0/skillsync-protos/gen/jobpb"
+
+	"skillsync-api-gateway/clients"
+	"skillsync-api-gateway/middlewares"
+	"skillsync-api-gateway/utils"
+	"skillsync-api-gateway/utils/audit"
+)
+
+// maxArchivedPayload bounds how much of a callback's payload is kept in the audit log
+const maxArchivedPayload = 16 << 10
+
+// callbackSecretFields are payload keys whose values are redacted before archiving
+var callbackSecretFields = []string{"password", "token", "secret", "signature", "card", "cvc", "account_number", "iban"}
+
+// callbackHandlers translate a verified callback of each provider into backend calls
+var callbackHandlers = map[string]gin.HandlerFunc{
+	"payments": HandlePaymentCallback,
+}
+
+func SetupCallbackRoutes(r *gin.Engine) {
+	r.POST("/callbacks/:provider", middlewares.CallbackSignature(), archiveCallback, func(c *gin.Context) {
+		handler, ok := callbackHandlers[c.GetString("callback_provider")]
+		if !ok {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Unknown callback provider", "error_code": "unknown_provider"})
+			return
+		}
+		handler(c)
+	})
+}
+
+// redactCallbackPayload replaces the values of secret-looking keys anywhere in a
+// decoded JSON payload
+func redactCallbackPayload(value interface{}) interface{} {
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		for key, field := range typed {
+			lower := strings.ToLower(key)
+			redacted := false
+			for _, secret := range callbackSecretFields {
+				if strings.Contains(lower, secret) {
+					typed[key], redacted = "[redacted]", true
+					break
+				}
+			}
+			if !redacted {
+				typed[key] = redactCallbackPayload(field)
+			}
+		}
+	case []interface{}:
+		for i, item := range typed {
+			typed[i] = redactCallbackPayload(item)
+		}
+	}
+	return value
+}
+
+// archiveCallback records a verified callback's payload in the audit log, minus
+// secrets, so disputes with the provider can be looked into later. Payloads that
+// aren't JSON are only described, since they can't be redacted.
+func archiveCallback(c *gin.Context) {
+	provider := c.GetString("callback_provider")
+	body, err := c.GetRawData()
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Failed to read request body"})
+		return
+	}
+	c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+	details := map[string]string{"size": strconv.Itoa(len(body))}
+	var payload interface{}
+	if err := json.Unmarshal(body, &payload); err == nil {
+		redacted, _ := json.Marshal(redactCallbackPayload(payload))
+		if len(redacted) > maxArchivedPayload {
+			redacted, details["truncated"] = redacted[:maxArchivedPayload], "true"
+		}
+		details["payload"] = string(redacted)
+	} else {
+		details["payload"] = "not JSON, " + c.ContentType()
+	}
+	auditLog.Record(audit.Event{
+		ActorID:   "callback:" + provider,
+		Role:      "partner",
+		Action:    "callback.receive",
+		Target:    "callback:" + provider,
+		IP:        c.ClientIP(),
+		RequestID: c.GetString("request_id"),
+		Details:   details,
+	})
+	c.Next()
+}
+
+type paymentCallback struct {
+	ID   string `json:"id" binding:"required,max=255"`
+	Type string `json:"type" binding:"required,max=100"`
+	// Data is only read from payment.succeeded events
+	Data *paymentCallbackData `json:"data"`
+}
+
+type paymentCallbackData struct {
+	PaymentID  string `json:"payment_id" binding:"required,max=255"`
+	EmployerID string `json:"employer_id" binding:"required,max=255"`
+	JobID      uint64 `json:"job_id" binding:"required"`
+	Amount     int64  `json:"amount"`
+	Currency   string `json:"currency" binding:"max=3"`
+}
+
+// HandlePaymentCallback makes a job premium once the payment provider reports its
+// employer paid for it. Other payment events are acknowledged and ignored. A
+// failed backend call answers 5xx, so the provider retries it.
+func HandlePaymentCallback(c *gin.Context) {
+	var event paymentCallback
+	if err := c.ShouldBindJSON(&event); err != nil {
+		utils.RespondWithValidationError(c, err)
+		return
+	}
+	if event.Type != "payment.succeeded" {
+		c.JSON(http.StatusOK, gin.H{"received": true, "handled": false})
+		return
+	}
+	if event.Data == nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "data is required for payment.succeeded"})
+		return
+	}
+
+	employerID := event.Data.EmployerID
+	_, err := clients.JobServiceClient.MarkJobPremium(jobOwnerContext(c, employerID), &jobpb.MarkJobPremiumRequest{
+		JobId:      event.Data.JobID,
+		EmployerId: employerID,
+		PaymentId:  event.Data.PaymentID,
+		Amount:     event.Data.Amount,
+		Currency:   strings.ToUpper(event.Data.Currency),
+	})
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to mark job premium: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+	invalidateJobCaches()
+	jobID := strconv.FormatUint(event.Data.JobID, 10)
+	notifyUser(c.Request.Context(), employerID, "job_premium", "Your job is now premium",
+		"Thanks for your payment, job "+jobID+" is now a premium posting", jobID)
+	c.JSON(http.StatusOK, gin.H{"received": true, "handled": true})
+}
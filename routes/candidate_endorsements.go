@@ -0,0 +1,185 @@
+package routes
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	authpb "github.com/shahal0/skillsync-protos/gen/authpb"
+	jobpb "github.com/shahal0/skillsync-protos/gen/jobpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"skillsync-api-gateway/clients"
+	"skillsync-api-gateway/utils"
+)
+
+type endorseRequest struct {
+	Skills  []string `json:"skills" binding:"required,min=1,max=20,dive,required,max=100"`
+	Comment string   `json:"comment" binding:"max=1000"`
+}
+
+// employerHiredCandidate reports whether one of the employer's jobs has an
+// application from the candidate marked HIRED
+func employerHiredCandidate(c *gin.Context, employerID, candidateID string) (bool, error) {
+	ctx := jobOwnerContext(c, employerID)
+	jobs, err := clients.JobServiceClient.ListEmployerJobs(ctx, &jobpb.ListEmployerJobsRequest{EmployerId: employerID})
+	if err != nil {
+		return false, err
+	}
+	owned := make(map[uint64]bool, len(jobs.GetJobs()))
+	for _, job := range jobs.GetJobs() {
+		owned[job.GetId()] = true
+	}
+	hired, err := clients.JobServiceClient.GetApplications(ctx, &jobpb.GetApplicationsRequest{
+		CandidateId: candidateID,
+		Status:      "HIRED",
+	})
+	if err != nil {
+		return false, err
+	}
+	for _, application := range hired.GetApplications() {
+		if application.GetCandidateId() == candidateID && owned[application.GetJobId()] {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// unlistedSkills returns the endorsed skills the candidate doesn't list, and the
+// others under their canonical names without duplicates
+func unlistedSkills(endorsed []string, listed []*authpb.Skill) (skills, unlisted []string) {
+	has := make(map[string]bool, len(listed))
+	for _, skill := range listed {
+		name, _ := canonicalSkill(strings.TrimSpace(skill.GetSkill()))
+		has[strings.ToLower(name)] = true
+	}
+	seen := make(map[string]bool, len(endorsed))
+	unlisted = []string{}
+	for _, skill := range endorsed {
+		name, _ := canonicalSkill(strings.TrimSpace(skill))
+		key := strings.ToLower(name)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		if has[key] {
+			skills = append(skills, name)
+		} else {
+			unlisted = append(unlisted, strings.TrimSpace(skill))
+		}
+	}
+	return skills, unlisted
+}
+
+// EndorseCandidate lets an employer who hired a candidate vouch for skills the
+// candidate lists. The candidate is notified.
+func EndorseCandidate(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in context"})
+		return
+	}
+	employerID := userID.(string)
+	candidateID := c.Param("id")
+
+	var body endorseRequest
+	if err := c.ShouldBindJSON(&body); err != nil {
+		utils.RespondWithValidationError(c, err)
+		return
+	}
+
+	hired, err := employerHiredCandidate(c, employerID, candidateID)
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to check your hires: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+	if !hired {
+		c.JSON(http.StatusForbidden, gin.H{
+			"error":      "You can only endorse candidates you hired",
+			"error_code": "not_hired",
+		})
+		return
+	}
+
+	profile, err := getCandidatePublicProfile(c.Request.Context(), candidateID)
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to get candidate profile: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+	skills, unlisted := unlistedSkills(body.Skills, profile.GetSkills())
+	if len(unlisted) > 0 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":           "The candidate doesn't list these skills: " + strings.Join(unlisted, ", "),
+			"error_code":      "skills_not_listed",
+			"unlisted_skills": unlisted,
+		})
+		return
+	}
+
+	resp, err := clients.AuthServiceClient.EndorseCandidateSkills(employerContext(c, employerID), &authpb.EndorseCandidateSkillsRequest{
+		CandidateId: candidateID,
+		EmployerId:  employerID,
+		Skills:      skills,
+		Comment:     strings.TrimSpace(body.Comment),
+	})
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to endorse candidate: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+	// The public profile carries endorsements; this instance shows the new one at once
+	candidateProfileCache.Delete(candidateID)
+	endorsement := resp.GetEndorsement()
+	recordAudit(c, "candidate.endorse", "candidate:"+candidateID, map[string]string{
+		"skills": strings.Join(skills, ","),
+	})
+	notifyUser(c.Request.Context(), candidateID, "skill_endorsement", "New skill endorsement",
+		"An employer who hired you endorsed your skills: "+strings.Join(skills, ", "), endorsement.GetId())
+	c.JSON(http.StatusCreated, resp)
+}
+
+// GetCandidateEndorsements lists the endorsements a candidate received
+func GetCandidateEndorsements(c *gin.Context) {
+	resp, err := clients.AuthServiceClient.ListCandidateEndorsements(c.Request.Context(), &authpb.ListCandidateEndorsementsRequest{
+		CandidateId: c.Param("id"),
+	})
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to get endorsements: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+	endorsements := resp.GetEndorsements()
+	if endorsements == nil {
+		endorsements = []*authpb.Endorsement{}
+	}
+	c.JSON(http.StatusOK, gin.H{"endorsements": endorsements})
+}
+
+// RetractEndorsement withdraws one of the caller's endorsements. Someone else's
+// endorsement is reported as missing.
+func RetractEndorsement(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in context"})
+		return
+	}
+	employerID := userID.(string)
+	candidateID := c.Param("id")
+	_, err := clients.AuthServiceClient.RetractEndorsement(employerContext(c, employerID), &authpb.RetractEndorsementRequest{
+		EndorsementId: c.Param("endorsement_id"),
+		CandidateId:   candidateID,
+		EmployerId:    employerID,
+	})
+	if status.Code(err) == codes.NotFound || status.Code(err) == codes.PermissionDenied {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Endorsement not found"})
+		return
+	}
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to retract endorsement: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+	candidateProfileCache.Delete(candidateID)
+	recordAudit(c, "candidate.endorsement_retract", "candidate:"+candidateID, map[string]string{
+		"endorsement_id": c.Param("endorsement_id"),
+	})
+	c.Status(http.StatusNoContent)
+}
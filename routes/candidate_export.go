@@ -0,0 +1,213 @@
+package routes
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	authpb "github.com/shahal0/skillsync-protos/gen/authpb"
+	chatpb "github.com/shahal0/skillsync-protos/gen/chatpb"
+	jobpb "github.com/shahal0/skillsync-protos/gen/jobpb"
+	notificationpb "github.com/shahal0/skillsync-protos/gen/notificationpb"
+	"golang.org/x/sync/errgroup"
+
+	"skillsync-api-gateway/clients"
+	"skillsync-api-gateway/utils"
+	"skillsync-api-gateway/utils/profilecache"
+)
+
+const (
+	// An export reads everything the candidate has, so it gets far longer than
+	// the dashboards' few seconds
+	candidateExportTimeout  = 60 * time.Second
+	candidateExportCallTime = 30 * time.Second
+	candidateExportLimit    = 1
+	candidateExportWindow   = 24 * time.Hour
+	candidateExportPageSize = 100
+	// candidateExportMaxPages stops paging a runaway listing; the manifest says when it was hit
+	candidateExportMaxPages = 100
+)
+
+// exportSection is one file of the archive
+type exportSection struct {
+	data      interface{}
+	fetchedAt time.Time
+	truncated bool
+}
+
+// candidateExportData streams a ZIP of everything the gateway can gather about the
+// caller: one JSON file per section plus manifest.json. Sections are fetched
+// concurrently; ones that fail are listed in errors.json instead of failing the export.
+func candidateExportData(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in context"})
+		return
+	}
+	candidateID := userID.(string)
+	started := time.Now().UTC()
+
+	ctx, cancel := context.WithTimeout(candidateContext(c, candidateID), candidateExportTimeout)
+	defer cancel()
+
+	var (
+		mutex         sync.Mutex
+		sections      = map[string]*exportSection{}
+		sectionErrors = []gin.H{}
+	)
+	g, gctx := errgroup.WithContext(ctx)
+	// section runs one fetch under its own timeout; fetch returns the data and whether it was cut short
+	section := func(name string, fetch func(ctx context.Context) (interface{}, bool, error)) {
+		g.Go(func() error {
+			callCtx, cancel := context.WithTimeout(gctx, candidateExportCallTime)
+			defer cancel()
+			data, truncated, err := fetch(callCtx)
+			mutex.Lock()
+			defer mutex.Unlock()
+			if err != nil {
+				log.Printf("Candidate export: %s unavailable for %s: %v", name, candidateID, err)
+				sectionErrors = append(sectionErrors, gin.H{"section": name, "error": utils.GRPCErrorMessage(err)})
+				return nil
+			}
+			sections[name] = &exportSection{data: data, fetchedAt: time.Now().UTC(), truncated: truncated}
+			return nil
+		})
+	}
+
+	// Skills and education come with the profile, but get their own files
+	var profile *authpb.CandidateProfileResponse
+	section("profile", func(ctx context.Context) (interface{}, bool, error) {
+		resp, err := profilecache.Get(ctx, candidateID)
+		profile = resp
+		return resp, false, err
+	})
+	section("applications", func(ctx context.Context) (interface{}, bool, error) {
+		resp, err := clients.JobServiceClient.GetApplications(ctx, &jobpb.GetApplicationsRequest{CandidateId: candidateID})
+		if err != nil {
+			return nil, false, err
+		}
+		redacted, err := redactForCandidate(resp)
+		return redacted["applications"], false, err
+	})
+	section("saved_jobs", func(ctx context.Context) (interface{}, bool, error) {
+		resp, err := clients.JobServiceClient.ListSavedJobs(ctx, &jobpb.ListSavedJobsRequest{CandidateId: candidateID})
+		return resp.GetSavedJobs(), false, err
+	})
+	section("conversations", func(ctx context.Context) (interface{}, bool, error) {
+		chatClient, err := clients.GetChatClient()
+		if err != nil {
+			return nil, false, err
+		}
+		var conversations []gin.H
+		for page := int32(1); page <= candidateExportMaxPages; page++ {
+			resp, err := chatClient.ListConversations(ctx, &chatpb.ListConversationsRequest{
+				UserId:          candidateID,
+				Page:            page,
+				Limit:           candidateExportPageSize,
+				IncludeArchived: true,
+			})
+			if err != nil {
+				return nil, false, err
+			}
+			// Metadata only; message history is exported per conversation
+			for _, conversation := range resp.GetConversations() {
+				conversations = append(conversations, chatConversationJSON(conversation))
+			}
+			if len(resp.GetConversations()) < candidateExportPageSize || len(conversations) >= int(resp.GetTotal()) {
+				return conversations, false, nil
+			}
+		}
+		return conversations, true, nil
+	})
+	section("notifications", func(ctx context.Context) (interface{}, bool, error) {
+		notificationClient := clients.GetNotificationClient()
+		if notificationClient == nil {
+			return nil, false, errors.New("notification service unavailable")
+		}
+		var notifications []*notificationpb.Notification
+		for page := int32(1); page <= candidateExportMaxPages; page++ {
+			resp, err := notificationClient.GetNotifications(ctx, &notificationpb.GetNotificationsRequest{
+				UserId: candidateID,
+				Page:   page,
+				Limit:  candidateExportPageSize,
+			})
+			if err != nil {
+				return nil, false, err
+			}
+			notifications = append(notifications, resp.GetNotifications()...)
+			if len(resp.GetNotifications()) < candidateExportPageSize || len(notifications) >= int(resp.GetTotal()) {
+				return notifications, false, nil
+			}
+		}
+		return notifications, true, nil
+	})
+	// Sections never return errors, they only record themselves in sectionErrors
+	_ = g.Wait()
+	if _, ok := sections["profile"]; ok {
+		fetchedAt := sections["profile"].fetchedAt
+		sections["skills"] = &exportSection{data: profile.GetSkills(), fetchedAt: fetchedAt}
+		sections["education"] = &exportSection{data: profile.GetEducation(), fetchedAt: fetchedAt}
+	}
+
+	filename := "skillsync-export-" + started.Format("20060102") + ".zip"
+	c.Header("Content-Type", "application/zip")
+	c.Header("Content-Disposition", `attachment; filename="`+filename+`"`)
+	c.Header("Cache-Control", "no-store")
+	c.Status(http.StatusOK)
+
+	archive := zip.NewWriter(c.Writer)
+	manifest := gin.H{
+		"user_id":    candidateID,
+		"started_at": started,
+		"sections":   []gin.H{},
+		"failed":     len(sectionErrors),
+	}
+	for _, name := range []string{"profile", "skills", "education", "applications", "saved_jobs", "conversations", "notifications"} {
+		s, ok := sections[name]
+		if !ok {
+			continue
+		}
+		if err := writeExportFile(archive, name+".json", s.data, s.fetchedAt); err != nil {
+			// The status is already sent; a truncated ZIP fails to open, which is the signal
+			log.Printf("Candidate export for %s failed writing %s: %v", candidateID, name, err)
+			return
+		}
+		manifest["sections"] = append(manifest["sections"].([]gin.H), gin.H{
+			"name":       name,
+			"file":       name + ".json",
+			"fetched_at": s.fetchedAt,
+			"truncated":  s.truncated,
+		})
+	}
+	if len(sectionErrors) > 0 {
+		if err := writeExportFile(archive, "errors.json", sectionErrors, time.Now().UTC()); err != nil {
+			log.Printf("Candidate export for %s failed writing errors.json: %v", candidateID, err)
+			return
+		}
+	}
+	manifest["completed_at"] = time.Now().UTC()
+	if err := writeExportFile(archive, "manifest.json", manifest, time.Now().UTC()); err != nil {
+		log.Printf("Candidate export for %s failed writing manifest.json: %v", candidateID, err)
+		return
+	}
+	if err := archive.Close(); err != nil {
+		log.Printf("Candidate export for %s failed: %v", candidateID, err)
+	}
+}
+
+// writeExportFile adds one indented JSON file to the archive, compressed as it is written
+func writeExportFile(archive *zip.Writer, name string, data interface{}, modified time.Time) error {
+	file, err := archive.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Deflate, Modified: modified})
+	if err != nil {
+		return err
+	}
+	encoder := json.NewEncoder(file)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(data)
+}
@@ -0,0 +1,267 @@
+package routes
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	authpb "github.com/shahal0/skillsync-protos/gen/authpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"skillsync-api-gateway/clients"
+	"skillsync-api-gateway/middlewares"
+	"skillsync-api-gateway/utils"
+)
+
+const (
+	maxSearchSkills      = 20
+	defaultSearchLimit   = 20
+	maxSearchLimit       = 50
+	maxSearchPage        = 100
+	maxSearchKeywordSize = 200
+)
+
+func SetupCandidateRoutes(r *gin.Engine) {
+	candidates := r.Group("/candidates")
+	candidates.Use(middlewares.Maintenance("auth"), middlewares.JWTMiddleware(), middlewares.RequireRole("employer", "admin"), middlewares.ReadOnlyForViewers())
+	{
+		candidates.GET("/search", middlewares.RequirePlan(middlewares.PlanPro), SearchCandidates)
+
+		// Talent pool bookmarks belong to the employer account only
+		candidates.POST("/save", middlewares.RequireRole("employer"), SaveCandidate)
+		candidates.DELETE("/save/:candidate_id", middlewares.RequireRole("employer"), UnsaveCandidate)
+		candidates.GET("/saved", middlewares.RequireRole("employer"), GetSavedCandidates)
+
+		// Only employers who hired the candidate can endorse them
+		candidates.POST("/:id/endorse", middlewares.RequireRole("employer"), EndorseCandidate)
+		candidates.DELETE("/:id/endorsements/:endorsement_id", middlewares.RequireRole("employer"), RetractEndorsement)
+	}
+
+	// Endorsements are public, like the profile they appear on
+	publicCandidates := r.Group("/candidates")
+	publicCandidates.Use(middlewares.Maintenance("auth"), middlewares.PublicCache(publicCacheMaxAge))
+	{
+		publicCandidates.GET("/:id/endorsements", GetCandidateEndorsements)
+	}
+}
+
+// querySkills accepts both repeated (?skills=a&skills=b) and comma separated (?skills=a,b) values
+func querySkills(c *gin.Context) []string {
+	var skills []string
+	for _, value := range c.QueryArray("skills") {
+		for _, skill := range strings.Split(value, ",") {
+			if skill = strings.TrimSpace(skill); skill != "" {
+				skills = append(skills, skill)
+			}
+		}
+	}
+	return skills
+}
+
+func SearchCandidates(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	var req authpb.SearchCandidatesRequest
+
+	// Handle query parameters directly
+	req.Skills = querySkills(c)
+	if len(req.Skills) > maxSearchSkills {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "At most 20 skills can be searched at once"})
+		return
+	}
+	if minExp := c.Query("min_experience"); minExp != "" {
+		years, err := strconv.Atoi(minExp)
+		if err != nil || years < 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "min_experience must be a non-negative integer"})
+			return
+		}
+		req.MinExperience = int32(years)
+	}
+	req.Location = strings.TrimSpace(c.Query("location"))
+	req.Keyword = strings.TrimSpace(c.Query("keyword"))
+	if len(req.Keyword) > maxSearchKeywordSize {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "keyword is too long"})
+		return
+	}
+
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil || page < 1 || page > maxSearchPage {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "page must be between 1 and 100"})
+		return
+	}
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", strconv.Itoa(defaultSearchLimit)))
+	if err != nil || limit < 1 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "limit must be a positive integer"})
+		return
+	}
+	if limit > maxSearchLimit {
+		limit = maxSearchLimit
+	}
+	req.Page = int32(page)
+	req.Limit = int32(limit)
+	// Candidates who opted out of search are left out by the auth service, and
+	// filtered again below in case it predates the setting
+	req.SearchableOnly = true
+
+	// The searching employer is forwarded so the auth service can account for usage
+	ctx := metadata.NewOutgoingContext(
+		c.Request.Context(),
+		metadata.New(map[string]string{
+			"user-id": userID.(string),
+			"role":    c.GetString("user_role"),
+		}),
+	)
+	resp, err := clients.AuthServiceClient.SearchCandidates(ctx, &req)
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to search candidates: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+
+	results := make([]gin.H, 0, len(resp.GetCandidates()))
+	for _, candidate := range resp.GetCandidates() {
+		if !candidateSearchable(candidate.GetVisibility()) {
+			continue
+		}
+		// Search has no application to reveal anonymous candidates
+		results = append(results, candidateForEmployer(candidate, false))
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"candidates": results,
+		"total":      resp.GetTotal(),
+		"page":       page,
+		"limit":      limit,
+	})
+}
+
+// employerContext forwards the authenticated employer to backend services
+func employerContext(c *gin.Context, employerID string) context.Context {
+	return metadata.NewOutgoingContext(
+		c.Request.Context(),
+		metadata.New(map[string]string{
+			"user-id": employerID,
+			"role":    "employer",
+		}),
+	)
+}
+
+func SaveCandidate(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	var body struct {
+		CandidateID string `json:"candidate_id" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := clients.AuthServiceClient.SaveCandidate(employerContext(c, userID.(string)), &authpb.SaveCandidateRequest{
+		EmployerId:  userID.(string),
+		CandidateId: body.CandidateID,
+	})
+	if err != nil {
+		// Saving twice is not an error
+		if status.Code(err) == codes.AlreadyExists {
+			c.JSON(http.StatusOK, gin.H{"message": "Candidate already saved", "candidate_id": body.CandidateID})
+			return
+		}
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to save candidate: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": resp.GetMessage(), "candidate_id": body.CandidateID})
+}
+
+func UnsaveCandidate(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in context"})
+		return
+	}
+	candidateID := c.Param("candidate_id")
+
+	resp, err := clients.AuthServiceClient.UnsaveCandidate(employerContext(c, userID.(string)), &authpb.UnsaveCandidateRequest{
+		EmployerId:  userID.(string),
+		CandidateId: candidateID,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			c.JSON(http.StatusOK, gin.H{"message": "Candidate was not saved", "candidate_id": candidateID})
+			return
+		}
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to remove saved candidate: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": resp.GetMessage(), "candidate_id": candidateID})
+}
+
+func GetSavedCandidates(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil || page < 1 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "page must be a positive integer"})
+		return
+	}
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", strconv.Itoa(defaultSearchLimit)))
+	if err != nil || limit < 1 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "limit must be a positive integer"})
+		return
+	}
+	if limit > maxSearchLimit {
+		limit = maxSearchLimit
+	}
+
+	resp, err := clients.AuthServiceClient.ListSavedCandidates(employerContext(c, userID.(string)), &authpb.ListSavedCandidatesRequest{
+		EmployerId: userID.(string),
+		Page:       int32(page),
+		Limit:      int32(limit),
+	})
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to list saved candidates: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+
+	candidateIDs := make([]string, 0, len(resp.GetSaved()))
+	for _, saved := range resp.GetSaved() {
+		candidateIDs = append(candidateIDs, saved.GetCandidateId())
+	}
+	profiles := fetchCandidateProfiles(candidateIDs)
+
+	// Entries whose profile couldn't be loaded are skipped rather than failing the list
+	results := make([]gin.H, 0, len(resp.GetSaved()))
+	for _, saved := range resp.GetSaved() {
+		profile, ok := profiles[saved.GetCandidateId()]
+		if !ok {
+			continue
+		}
+		entry := candidateForEmployer(profile, false)
+		entry["saved_at"] = saved.GetSavedAt()
+		results = append(results, entry)
+	}
+	body := gin.H{
+		"candidates": results,
+		"total":      resp.GetTotal(),
+		"page":       page,
+		"limit":      limit,
+	}
+	if len(results) < len(resp.GetSaved()) {
+		utils.MarkPartial(body, "candidate_profile")
+	}
+	utils.RespondPartial(c, http.StatusOK, body)
+}
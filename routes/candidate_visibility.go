@@ -0,0 +1,150 @@
+package routes
+
+import (
+	"fmt"
+	"hash/fnv"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	authpb "github.com/shahal0/skillsync-protos/gen/authpb"
+	jobpb "github.com/shahal0/skillsync-protos/gen/jobpb"
+
+	"skillsync-api-gateway/clients"
+	"skillsync-api-gateway/utils"
+)
+
+// revealedStatuses are the application statuses from the shortlist on, at which
+// an anonymous candidate's name and photo are shown to the employer
+var revealedStatuses = map[string]bool{"SHORTLISTED": true, "INTERVIEW": true, "HIRED": true}
+
+// applicationRevealsCandidate reports whether an application has reached the shortlist
+func applicationRevealsCandidate(status string) bool {
+	return revealedStatuses[strings.ToUpper(strings.TrimSpace(status))]
+}
+
+// candidateSearchable reports whether a candidate may appear in employer search.
+// Candidates who never saved their visibility are searchable.
+func candidateSearchable(visibility *authpb.CandidateVisibility) bool {
+	return visibility == nil || visibility.Searchable == nil || visibility.GetSearchable()
+}
+
+// anonymousCandidateName stands in for an anonymous candidate's name. It is stable
+// per candidate, so an employer can tell anonymous candidates apart.
+func anonymousCandidateName(candidateID string) string {
+	hash := fnv.New32a()
+	hash.Write([]byte(candidateID))
+	return fmt.Sprintf("Candidate #%04d", hash.Sum32()%10000)
+}
+
+// candidateForEmployer is the view of a candidate every employer-facing response
+// uses. Contact details and the resume link itself are never included; the
+// current employer is left out when the candidate hides it, and the name and
+// photo until revealed when they asked to stay anonymous until shortlisted.
+func candidateForEmployer(profile *authpb.CandidatePublicProfile, revealed bool) gin.H {
+	view := gin.H{
+		"id":               profile.GetCandidateId(),
+		"name":             profile.GetName(),
+		"profile_picture":  profile.GetProfilePicture(),
+		"current_employer": profile.GetCurrentEmployer(),
+		"skills":           profile.GetSkills(),
+		"experience":       profile.GetExperience(),
+		"location":         profile.GetLocation(),
+		"has_resume":       profile.GetResumeUrl() != "",
+		"endorsements":     profile.GetEndorsements(),
+		"anonymous":        false,
+	}
+	visibility := profile.GetVisibility()
+	if visibility.GetHideCurrentEmployer() {
+		view["current_employer"] = nil
+	}
+	if visibility.GetAnonymousUntilShortlist() && !revealed {
+		view["name"] = anonymousCandidateName(profile.GetCandidateId())
+		view["profile_picture"] = nil
+		view["anonymous"] = true
+	}
+	return view
+}
+
+// respondApplicationWithCandidate answers an employer's application read with the
+// applicant's profile as candidate, seen as candidateForEmployer allows for the
+// application's status. An unavailable profile leaves candidate null.
+func respondApplicationWithCandidate(c *gin.Context, resp *jobpb.GetApplicationResponse) {
+	body, err := toMap(resp)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to encode application"})
+		return
+	}
+	application := resp.GetApplication()
+	body["candidate"] = nil
+	profile, err := getCandidatePublicProfile(c.Request.Context(), application.GetCandidateId())
+	if err != nil {
+		log.Printf("Candidate profile unavailable for application %d: %v", application.GetId(), err)
+		utils.RespondPartial(c, http.StatusOK, body, "candidate_profile")
+		return
+	}
+	body["candidate"] = candidateForEmployer(profile, applicationRevealsCandidate(application.GetStatus()))
+	c.JSON(http.StatusOK, body)
+}
+
+// candidateGetVisibility returns the caller's visibility settings
+func candidateGetVisibility(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in context"})
+		return
+	}
+	resp, err := clients.AuthServiceClient.GetCandidateVisibility(candidateContext(c, userID.(string)), &authpb.GetCandidateVisibilityRequest{})
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to get visibility: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"searchable":                candidateSearchable(resp.GetVisibility()),
+		"hide_current_employer":     resp.GetVisibility().GetHideCurrentEmployer(),
+		"anonymous_until_shortlist": resp.GetVisibility().GetAnonymousUntilShortlist(),
+	})
+}
+
+// candidateUpdateVisibility replaces the caller's visibility settings. Employers
+// see the change once the cached public profile expires.
+func candidateUpdateVisibility(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in context"})
+		return
+	}
+	var body struct {
+		Searchable              *bool `json:"searchable" binding:"required"`
+		HideCurrentEmployer     *bool `json:"hide_current_employer" binding:"required"`
+		AnonymousUntilShortlist *bool `json:"anonymous_until_shortlist" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		utils.RespondWithValidationError(c, err)
+		return
+	}
+	_, err := clients.AuthServiceClient.UpdateCandidateVisibility(candidateContext(c, userID.(string)), &authpb.UpdateCandidateVisibilityRequest{
+		Visibility: &authpb.CandidateVisibility{
+			Searchable:              body.Searchable,
+			HideCurrentEmployer:     *body.HideCurrentEmployer,
+			AnonymousUntilShortlist: *body.AnonymousUntilShortlist,
+		},
+	})
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to update visibility: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+	// This instance applies the settings at once; others when their copy expires
+	candidateProfileCache.Delete(userID.(string))
+	recordAudit(c, "profile.visibility_update", "candidate:"+userID.(string), map[string]string{
+		"searchable":                fmt.Sprint(*body.Searchable),
+		"hide_current_employer":     fmt.Sprint(*body.HideCurrentEmployer),
+		"anonymous_until_shortlist": fmt.Sprint(*body.AnonymousUntilShortlist),
+	})
+	c.JSON(http.StatusOK, gin.H{
+		"searchable":                *body.Searchable,
+		"hide_current_employer":     *body.HideCurrentEmployer,
+		"anonymous_until_shortlist": *body.AnonymousUntilShortlist,
+	})
+}
@@ -0,0 +1,111 @@
+package routes
+
+import (
+	"strings"
+	"testing"
+
+	authpb "github.com/shahal0/skillsync-protos/gen/authpb"
+)
+
+func TestCandidateSearchable(t *testing.T) {
+	yes, no := true, false
+	tests := []struct {
+		name       string
+		visibility *authpb.CandidateVisibility
+		want       bool
+	}{
+		{"never saved", nil, true},
+		{"searchable unset", &authpb.CandidateVisibility{HideCurrentEmployer: true}, true},
+		{"searchable", &authpb.CandidateVisibility{Searchable: &yes}, true},
+		{"hidden", &authpb.CandidateVisibility{Searchable: &no}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := candidateSearchable(tt.visibility); got != tt.want {
+				t.Errorf("candidateSearchable() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplicationRevealsCandidate(t *testing.T) {
+	tests := []struct {
+		status string
+		want   bool
+	}{
+		{"APPLIED", false},
+		{"REVIEWING", false},
+		{"REJECTED", false},
+		{"SHORTLISTED", true},
+		{" interview ", true},
+		{"HIRED", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.status, func(t *testing.T) {
+			if got := applicationRevealsCandidate(tt.status); got != tt.want {
+				t.Errorf("applicationRevealsCandidate(%q) = %v, want %v", tt.status, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCandidateForEmployer(t *testing.T) {
+	profile := func(visibility *authpb.CandidateVisibility) *authpb.CandidatePublicProfile {
+		return &authpb.CandidatePublicProfile{
+			CandidateId:     "c1",
+			Name:            "Ada Lovelace",
+			ProfilePicture:  "https://cdn.example/ada.png",
+			CurrentEmployer: "Analytical Engines",
+			ResumeUrl:       "https://cdn.example/ada.pdf",
+			Visibility:      visibility,
+		}
+	}
+	tests := []struct {
+		name          string
+		visibility    *authpb.CandidateVisibility
+		revealed      bool
+		wantAnonymous bool
+		wantEmployer  interface{}
+	}{
+		{"default", nil, false, false, "Analytical Engines"},
+		{"employer hidden", &authpb.CandidateVisibility{HideCurrentEmployer: true}, true, false, nil},
+		{"anonymous before the shortlist", &authpb.CandidateVisibility{AnonymousUntilShortlist: true}, false, true, "Analytical Engines"},
+		{"anonymous once shortlisted", &authpb.CandidateVisibility{AnonymousUntilShortlist: true}, true, false, "Analytical Engines"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			view := candidateForEmployer(profile(tt.visibility), tt.revealed)
+			if view["current_employer"] != tt.wantEmployer {
+				t.Errorf("current_employer = %v, want %v", view["current_employer"], tt.wantEmployer)
+			}
+			if view["anonymous"] != tt.wantAnonymous {
+				t.Errorf("anonymous = %v, want %v", view["anonymous"], tt.wantAnonymous)
+			}
+			name, _ := view["name"].(string)
+			if tt.wantAnonymous {
+				if name != anonymousCandidateName("c1") || !strings.HasPrefix(name, "Candidate #") || view["profile_picture"] != nil {
+					t.Errorf("name, picture = %q, %v, want an anonymous name and no picture", name, view["profile_picture"])
+				}
+			} else if name != "Ada Lovelace" {
+				t.Errorf("name = %q, want the candidate's", name)
+			}
+			if view["has_resume"] != true {
+				t.Errorf("has_resume = %v, want true", view["has_resume"])
+			}
+			for _, field := range []string{"resume_url", "email", "phone"} {
+				if _, ok := view[field]; ok {
+					t.Errorf("view includes %s", field)
+				}
+			}
+		})
+	}
+}
+
+func TestAnonymousCandidateName(t *testing.T) {
+	if a, b := anonymousCandidateName("c1"), anonymousCandidateName("c1"); a != b {
+		t.Errorf("anonymousCandidateName() = %q then %q, want it stable", a, b)
+	}
+	if anonymousCandidateName("c1") == anonymousCandidateName("c2") {
+		t.Errorf("anonymousCandidateName() is the same for c1 and c2")
+	}
+}
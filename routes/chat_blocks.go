@@ -0,0 +1,337 @@
+package routes
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	chatpb "github.com/shahal0/skillsync-protos/gen/chatpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"skillsync-api-gateway/clients"
+	"skillsync-api-gateway/utils"
+	"skillsync-api-gateway/utils/cache"
+	"skillsync-api-gateway/utils/websocket"
+)
+
+const (
+	chatBlockCacheTTL   = time.Minute
+	chatBlockLookupTime = 2 * time.Second
+)
+
+// chatBlockCache maps a user ID to the set of users they blocked
+var chatBlockCache = cache.NewTTLCache[map[string]bool](chatBlockCacheTTL)
+
+// blockedUsers returns who userID blocked, from the cache or the chat service
+func blockedUsers(ctx context.Context, userID string) (map[string]bool, error) {
+	if blocked, ok := chatBlockCache.Get(userID); ok {
+		return blocked, nil
+	}
+	chatClient, err := clients.GetChatClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx = metadata.AppendToOutgoingContext(ctx, "user-id", userID)
+	resp, err := chatClient.ListBlockedUsers(ctx, &chatpb.ListBlockedUsersRequest{UserId: userID})
+	if err != nil {
+		return nil, err
+	}
+	blocked := make(map[string]bool, len(resp.GetBlockedUserIds()))
+	for _, id := range resp.GetBlockedUserIds() {
+		blocked[id] = true
+	}
+	chatBlockCache.Set(userID, blocked)
+	return blocked, nil
+}
+
+// chatPairBlocked reports whether either user blocked the other. A failed lookup
+// lets the message through, since the chat service enforces blocks as well.
+func chatPairBlocked(ctx context.Context, senderID, receiverID string) bool {
+	for _, pair := range [][2]string{{senderID, receiverID}, {receiverID, senderID}} {
+		blocked, err := blockedUsers(ctx, pair[0])
+		if err != nil {
+			log.Printf("Failed to look up users blocked by %s: %v", pair[0], err)
+			continue
+		}
+		if blocked[pair[1]] {
+			return true
+		}
+	}
+	return false
+}
+
+// relayBlocked is the WebSocket manager's block filter
+func relayBlocked(senderID, receiverID string) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), chatBlockLookupTime)
+	defer cancel()
+	return chatPairBlocked(ctx, senderID, receiverID)
+}
+
+func respondUserBlocked(c *gin.Context) {
+	c.JSON(http.StatusForbidden, gin.H{
+		"error":      "You can't message this user",
+		"error_code": "user_blocked",
+	})
+}
+
+// BlockUser stops all messages between the caller and another user. Blocking
+// someone twice, or someone the caller never talked to, succeeds.
+func BlockUser(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in context"})
+		return
+	}
+	var body struct {
+		UserID string `json:"user_id" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		utils.RespondWithValidationError(c, err)
+		return
+	}
+	target := strings.TrimSpace(body.UserID)
+	if target == userID.(string) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "You can't block yourself"})
+		return
+	}
+
+	chatClient, err := clients.GetChatClient()
+	if err != nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": err.Error()})
+		return
+	}
+	_, err = chatClient.BlockUser(chatContext(c, userID.(string)), &chatpb.BlockUserRequest{
+		UserId:        userID.(string),
+		BlockedUserId: target,
+	})
+	if err != nil && status.Code(err) != codes.AlreadyExists {
+		respondChatError(c, "Failed to block user", err)
+		return
+	}
+	chatBlockCache.Delete(userID.(string))
+	c.JSON(http.StatusOK, gin.H{"user_id": target, "blocked": true})
+}
+
+// UnblockUser lifts a block; unblocking someone who isn't blocked succeeds
+func UnblockUser(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in context"})
+		return
+	}
+	target := c.Param("user_id")
+
+	chatClient, err := clients.GetChatClient()
+	if err != nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": err.Error()})
+		return
+	}
+	_, err = chatClient.UnblockUser(chatContext(c, userID.(string)), &chatpb.UnblockUserRequest{
+		UserId:        userID.(string),
+		BlockedUserId: target,
+	})
+	if err != nil && status.Code(err) != codes.NotFound {
+		respondChatError(c, "Failed to unblock user", err)
+		return
+	}
+	chatBlockCache.Delete(userID.(string))
+	c.JSON(http.StatusOK, gin.H{"user_id": target, "blocked": false})
+}
+
+func GetBlockedUsers(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	// Always read through so the list reflects blocks made on other instances
+	chatBlockCache.Delete(userID.(string))
+	blocked, err := blockedUsers(c.Request.Context(), userID.(string))
+	if err != nil {
+		respondChatError(c, "Failed to get blocked users", err)
+		return
+	}
+	ids := make([]string, 0, len(blocked))
+	for id := range blocked {
+		ids = append(ids, id)
+	}
+	c.JSON(http.StatusOK, gin.H{"blocked_user_ids": ids})
+}
+
+// SendChatMessage sends a message in a conversation the caller takes part in.
+// Messages between users who blocked each other are refused here with 403.
+func SendChatMessage(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in context"})
+		return
+	}
+	var body struct {
+		ConversationID string `json:"conversation_id" binding:"required"`
+		Content        string `json:"content" binding:"required,max=5000"`
+		// AttachmentKeys are object_keys from POST /uploads/presign
+		AttachmentKeys []string `json:"attachment_keys" binding:"max=10,dive,required"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		utils.RespondWithValidationError(c, err)
+		return
+	}
+	var attachments []*chatpb.Attachment
+	for _, key := range body.AttachmentKeys {
+		object, ok := verifyUpload(c, userID.(string), uploadPurposeChat, key)
+		if !ok {
+			return
+		}
+		attachments = append(attachments, &chatpb.Attachment{
+			Url:         uploadStorage.ObjectURL(key),
+			ContentType: object.ContentType,
+			Size:        object.Size,
+		})
+	}
+
+	chatClient, err := clients.GetChatClient()
+	if err != nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": err.Error()})
+		return
+	}
+	ctx := chatContext(c, userID.(string))
+	conversation, err := chatClient.GetConversation(ctx, &chatpb.GetConversationRequest{
+		ConversationId: body.ConversationID,
+		UserId:         userID.(string),
+	})
+	if err != nil {
+		respondChatError(c, "Failed to send message", err)
+		return
+	}
+	receiverID := conversation.GetConversation().GetCandidateId()
+	switch userID.(string) {
+	case receiverID:
+		receiverID = conversation.GetConversation().GetEmployerId()
+	case conversation.GetConversation().GetEmployerId():
+	default:
+		c.JSON(http.StatusForbidden, gin.H{"error": "You are not a participant in this conversation"})
+		return
+	}
+	if chatPairBlocked(c.Request.Context(), userID.(string), receiverID) {
+		respondUserBlocked(c)
+		return
+	}
+	if decision := chatSendLimiter.Allow(userID.(string), receiverID); !decision.Allowed {
+		respondChatThrottled(c, decision)
+		return
+	}
+
+	resp, err := chatClient.SendMessage(ctx, &chatpb.SendMessageRequest{
+		ConversationId: body.ConversationID,
+		SenderId:       userID.(string),
+		Content:        body.Content,
+		Attachments:    attachments,
+	})
+	if err != nil {
+		respondChatError(c, "Failed to send message", err)
+		return
+	}
+	message := resp.GetMessage()
+	websocket.GetManager().SendToUser(receiverID, &websocket.Message{
+		Type:           "message",
+		SenderID:       userID.(string),
+		ReceiverID:     receiverID,
+		ConversationID: body.ConversationID,
+		Content:        message.GetContent(),
+		SenderRole:     c.GetString("user_role"),
+		SentTime:       message.GetSentTime(),
+	})
+	queuePush(c.Request.Context(), receiverID, "new_message", body.ConversationID)
+	c.JSON(http.StatusCreated, gin.H{"message": chatMessageJSON(message)})
+}
+
+// chatReportReasons are the accepted report reasons
+var chatReportReasons = map[string]chatpb.ReportReason{
+	"spam":          chatpb.ReportReason_REPORT_REASON_SPAM,
+	"harassment":    chatpb.ReportReason_REPORT_REASON_HARASSMENT,
+	"scam":          chatpb.ReportReason_REPORT_REASON_SCAM,
+	"fake_profile":  chatpb.ReportReason_REPORT_REASON_FAKE_PROFILE,
+	"inappropriate": chatpb.ReportReason_REPORT_REASON_INAPPROPRIATE,
+	"other":         chatpb.ReportReason_REPORT_REASON_OTHER,
+}
+
+// ReportUser files a report that admins review under GET /admin/reports
+func ReportUser(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in context"})
+		return
+	}
+	var body struct {
+		UserID         string `json:"user_id" binding:"required"`
+		Reason         string `json:"reason" binding:"required,oneof=spam harassment scam fake_profile inappropriate other"`
+		ConversationID string `json:"conversation_id"`
+		MessageID      string `json:"message_id"`
+		Details        string `json:"details" binding:"max=1000"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		utils.RespondWithValidationError(c, err)
+		return
+	}
+	if body.Reason == "other" && strings.TrimSpace(body.Details) == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "details are required when the reason is other"})
+		return
+	}
+
+	chatClient, err := clients.GetChatClient()
+	if err != nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": err.Error()})
+		return
+	}
+	resp, err := chatClient.ReportUser(chatContext(c, userID.(string)), &chatpb.ReportUserRequest{
+		ReporterId:     userID.(string),
+		ReportedUserId: body.UserID,
+		Reason:         chatReportReasons[body.Reason],
+		ConversationId: body.ConversationID,
+		MessageId:      body.MessageID,
+		Details:        strings.TrimSpace(body.Details),
+	})
+	if err != nil {
+		respondChatError(c, "Failed to report user", err)
+		return
+	}
+	c.JSON(http.StatusCreated, gin.H{"report_id": resp.GetReport().GetId()})
+}
+
+// ListChatReports lists user reports for admins, newest first, optionally by reason
+func ListChatReports(c *gin.Context) {
+	var req chatpb.ListReportsRequest
+	if page, err := strconv.Atoi(c.DefaultQuery("page", "1")); err == nil && page > 0 {
+		req.Page = int32(page)
+	}
+	if limit, err := strconv.Atoi(c.DefaultQuery("limit", "20")); err == nil && limit > 0 && limit <= 100 {
+		req.Limit = int32(limit)
+	}
+	if reason := c.Query("reason"); reason != "" {
+		value, ok := chatReportReasons[reason]
+		if !ok {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "reason must be one of spam, harassment, scam, fake_profile, inappropriate, other"})
+			return
+		}
+		req.Reason = value
+	}
+
+	chatClient, err := clients.GetChatClient()
+	if err != nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": err.Error()})
+		return
+	}
+	resp, err := chatClient.ListReports(adminContext(c), &req)
+	if err != nil {
+		respondChatError(c, "Failed to list reports", err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,175 @@
+package routes
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	chatpb "github.com/shahal0/skillsync-protos/gen/chatpb"
+	jobpb "github.com/shahal0/skillsync-protos/gen/jobpb"
+
+	"skillsync-api-gateway/clients"
+	"skillsync-api-gateway/utils"
+	"skillsync-api-gateway/utils/fanout"
+	"skillsync-api-gateway/utils/websocket"
+)
+
+const (
+	bulkSendConcurrency = 8
+
+	// bulkSendFallbackName replaces {{candidate_name}} when the name can't be looked up,
+	// so "Hi {{candidate_name}}," still reads as a greeting
+	bulkSendFallbackName = "there"
+)
+
+// templateVariable matches {{name}} placeholders in a bulk message
+var templateVariable = regexp.MustCompile(`{{\s*([a-zA-Z_]+)\s*}}`)
+
+// BulkSendMessages sends an individual message to each selected candidate about
+// one of the employer's jobs, starting a conversation where there is none. Each
+// message is sent once and never retried; the per-recipient results say which
+// ones to resend. Repeat requests are replayed with an Idempotency-Key.
+func BulkSendMessages(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in context"})
+		return
+	}
+	var body struct {
+		// At most 200 recipients per request
+		CandidateIDs []string `json:"candidate_ids" binding:"required,min=1,max=200,dive,required"`
+		JobID        uint64   `json:"job_id" binding:"required"`
+		Content      string   `json:"content" binding:"required,max=5000"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		utils.RespondWithValidationError(c, err)
+		return
+	}
+	for _, match := range templateVariable.FindAllStringSubmatch(body.Content, -1) {
+		if match[1] != "candidate_name" {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Unknown template variable {{" + match[1] + "}}; only {{candidate_name}} is supported"})
+			return
+		}
+	}
+	seen := make(map[string]bool, len(body.CandidateIDs))
+	var candidateIDs []string
+	for _, id := range body.CandidateIDs {
+		if id = strings.TrimSpace(id); id != "" && !seen[id] {
+			seen[id] = true
+			candidateIDs = append(candidateIDs, id)
+		}
+	}
+	if len(candidateIDs) == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "At least one candidate ID is required"})
+		return
+	}
+
+	ctx := jobOwnerContext(c, userID.(string))
+	job, err := clients.JobServiceClient.GetJobById(ctx, &jobpb.GetJobByIdRequest{JobId: body.JobID})
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to get job: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+	if job.GetJob().GetEmployerId() != userID.(string) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "You can only message candidates about your own jobs"})
+		return
+	}
+	// Bulk sends have their own, larger budget than one-to-one messages
+	if decision := chatSendLimiter.AllowBulk(userID.(string), len(candidateIDs)); !decision.Allowed {
+		respondChatThrottled(c, decision)
+		return
+	}
+	chatClient, err := clients.GetChatClient()
+	if err != nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": err.Error()})
+		return
+	}
+
+	// Names are only looked up when the template uses them
+	profiles := map[string]string{}
+	if templateVariable.MatchString(body.Content) {
+		for id, profile := range fetchCandidateProfiles(candidateIDs) {
+			// The message lands in the employer's own history, so anonymous
+			// candidates are greeted without their name
+			if view := candidateForEmployer(profile, false); view["anonymous"] == false {
+				profiles[id] = profile.GetName()
+			}
+		}
+	}
+
+	send := func(ctx context.Context, candidateID string) (gin.H, error) {
+		result := gin.H{"candidate_id": candidateID}
+		if chatPairBlocked(ctx, userID.(string), candidateID) {
+			result["status"] = "blocked"
+			return result, nil
+		}
+		name := strings.TrimSpace(profiles[candidateID])
+		if name == "" {
+			name = bulkSendFallbackName
+		}
+		content := templateVariable.ReplaceAllLiteralString(body.Content, name)
+
+		conversation, err := chatClient.StartConversation(ctx, &chatpb.StartConversationRequest{
+			JobId:       strconv.FormatUint(body.JobID, 10),
+			EmployerId:  userID.(string),
+			CandidateId: candidateID,
+			JobTitle:    job.GetJob().GetTitle(),
+		})
+		if err != nil {
+			result["status"] = "failed"
+			result["error"] = utils.GRPCErrorMessage(err)
+			return result, nil
+		}
+		conversationID := conversation.GetConversation().GetId()
+		result["conversation_id"] = conversationID
+
+		sent, err := chatClient.SendMessage(ctx, &chatpb.SendMessageRequest{
+			ConversationId: conversationID,
+			SenderId:       userID.(string),
+			Content:        content,
+		})
+		if err != nil {
+			result["status"] = "failed"
+			result["error"] = utils.GRPCErrorMessage(err)
+			return result, nil
+		}
+		result["status"] = "sent"
+		result["message_id"] = sent.GetMessage().GetId()
+
+		websocket.GetManager().SendToUser(candidateID, &websocket.Message{
+			Type:           "message",
+			SenderID:       userID.(string),
+			ReceiverID:     candidateID,
+			ConversationID: conversationID,
+			Content:        sent.GetMessage().GetContent(),
+			SenderRole:     "employer",
+			SentTime:       sent.GetMessage().GetSentTime(),
+		})
+		return result, nil
+	}
+	outcomes := fanout.RunWith(chatContext(c, userID.(string)), candidateIDs, bulkSendConcurrency, send,
+		fanout.Options{Limiter: fanout.Backend("chat")})
+	results := make([]gin.H, len(outcomes))
+	for i, outcome := range outcomes {
+		results[i] = outcome.Value
+		// Candidates never reached, e.g. after the client went away, count as failed
+		if outcome.Err != nil {
+			results[i] = gin.H{"candidate_id": candidateIDs[i], "status": "failed", "error": outcome.Err.Error()}
+		}
+	}
+
+	counts := map[string]int{"sent": 0, "failed": 0, "blocked": 0}
+	for _, result := range results {
+		counts[result["status"].(string)]++
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"job_id":  body.JobID,
+		"results": results,
+		"sent":    counts["sent"],
+		"failed":  counts["failed"],
+		"blocked": counts["blocked"],
+	})
+}
@@ -0,0 +1,160 @@
+package routes
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	chatpb "github.com/shahal0/skillsync-protos/gen/chatpb"
+	jobpb "github.com/shahal0/skillsync-protos/gen/jobpb"
+
+	"skillsync-api-gateway/clients"
+	"skillsync-api-gateway/utils"
+)
+
+// conversationApplication is the application a conversation is about, once the
+// caller has been checked against it
+type conversationApplication struct {
+	applicationID uint64
+	jobID         uint64
+	jobTitle      string
+	employerID    string
+	candidateID   string
+}
+
+// StartConversation opens the conversation between the caller and participant_id
+// about an application, or returns the one they already have. Conversations are
+// only between a candidate and the employer of a job they applied to, which is
+// checked with the job service first. The response is 201 for a new conversation
+// and 200 for an existing one.
+func StartConversation(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in context"})
+		return
+	}
+	var body struct {
+		ParticipantID string `json:"participant_id" binding:"required,max=64"`
+		ApplicationID uint64 `json:"application_id"`
+		JobID         uint64 `json:"job_id"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		utils.RespondWithValidationError(c, err)
+		return
+	}
+	participantID := strings.TrimSpace(body.ParticipantID)
+	if participantID == "" || participantID == userID.(string) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "participant_id must be another user"})
+		return
+	}
+	if body.ApplicationID == 0 && body.JobID == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "application_id or job_id is required"})
+		return
+	}
+
+	var candidateID, employerID string
+	switch c.GetString("user_role") {
+	case "employer":
+		candidateID, employerID = participantID, userID.(string)
+	case "candidate":
+		candidateID, employerID = userID.(string), participantID
+	default:
+		c.JSON(http.StatusForbidden, gin.H{"error": "Only candidates and employers can start conversations"})
+		return
+	}
+	application, ok := resolveConversationApplication(c, candidateID, employerID, body.ApplicationID, body.JobID)
+	if !ok {
+		return
+	}
+	if chatPairBlocked(c.Request.Context(), userID.(string), participantID) {
+		respondUserBlocked(c)
+		return
+	}
+
+	chatClient, err := clients.GetChatClient()
+	if err != nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": err.Error()})
+		return
+	}
+	resp, err := chatClient.StartConversation(chatContext(c, userID.(string)), &chatpb.StartConversationRequest{
+		JobId:         strconv.FormatUint(application.jobID, 10),
+		EmployerId:    application.employerID,
+		CandidateId:   application.candidateID,
+		JobTitle:      application.jobTitle,
+		ApplicationId: strconv.FormatUint(application.applicationID, 10),
+	})
+	if err != nil {
+		respondChatError(c, "Failed to start conversation", err)
+		return
+	}
+	status := http.StatusOK
+	if resp.GetCreated() {
+		status = http.StatusCreated
+	}
+	c.JSON(status, chatConversationJSON(resp.GetConversation()))
+}
+
+// resolveConversationApplication finds the candidate's application to the
+// employer's job, by application_id or job_id (checked to agree when both are
+// given). It answers the request and reports false when there is none.
+func resolveConversationApplication(c *gin.Context, candidateID, employerID string, applicationID, jobID uint64) (conversationApplication, bool) {
+	// Forwarding the caller lets the job service refuse applications they can't see
+	ctx := interviewContext(c)
+	var application *jobpb.ApplicationResponse
+	if applicationID != 0 {
+		resp, err := clients.JobServiceClient.GetApplication(ctx, &jobpb.GetApplicationRequest{ApplicationId: applicationID})
+		if err != nil {
+			c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to get application: " + utils.GRPCErrorMessage(err)})
+			return conversationApplication{}, false
+		}
+		application = resp.GetApplication()
+		if application != nil && jobID != 0 && application.GetJobId() != jobID {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "application_id is not for job_id"})
+			return conversationApplication{}, false
+		}
+	} else {
+		resp, err := clients.JobServiceClient.GetApplications(ctx, &jobpb.GetApplicationsRequest{
+			JobId:       jobID,
+			CandidateId: candidateID,
+			Limit:       1,
+		})
+		if err != nil {
+			c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to get applications: " + utils.GRPCErrorMessage(err)})
+			return conversationApplication{}, false
+		}
+		if applications := resp.GetApplications(); len(applications) > 0 {
+			application = applications[0]
+		}
+	}
+	if application == nil || application.GetCandidateId() != candidateID {
+		respondNotApplied(c)
+		return conversationApplication{}, false
+	}
+
+	job, err := clients.JobServiceClient.GetJobById(ctx, &jobpb.GetJobByIdRequest{JobId: application.GetJobId()})
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to get job: " + utils.GRPCErrorMessage(err)})
+		return conversationApplication{}, false
+	}
+	if job.GetJob().GetEmployerId() != employerID {
+		respondNotApplied(c)
+		return conversationApplication{}, false
+	}
+	return conversationApplication{
+		applicationID: application.GetId(),
+		jobID:         application.GetJobId(),
+		jobTitle:      job.GetJob().GetTitle(),
+		employerID:    employerID,
+		candidateID:   candidateID,
+	}, true
+}
+
+// respondNotApplied refuses a conversation without an application behind it, the
+// same way whether the application is missing or someone else's
+func respondNotApplied(c *gin.Context) {
+	c.JSON(http.StatusForbidden, gin.H{
+		"error":      "Conversations can only be started about an application to the employer's job",
+		"error_code": "no_application",
+	})
+}
@@ -0,0 +1,161 @@
+package routes
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"regexp"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	chatpb "github.com/shahal0/skillsync-protos/gen/chatpb"
+
+	"skillsync-api-gateway/clients"
+)
+
+const (
+	chatExportPageSize = 100
+	// Exports page through a whole conversation, so each user gets a few per hour
+	chatExportLimit  = 10
+	chatExportWindow = time.Hour
+)
+
+// unsafeFilename matches characters that can't go in a Content-Disposition filename
+var unsafeFilename = regexp.MustCompile(`[^A-Za-z0-9_-]`)
+
+// chatExportFormats maps ?format= to the download's content type and extension
+var chatExportFormats = map[string]struct{ contentType, extension string }{
+	"json": {"application/x-ndjson", "jsonl"},
+	"txt":  {"text/plain; charset=utf-8", "txt"},
+}
+
+// ExportConversation downloads a conversation's full history as JSON Lines or a
+// text transcript. Messages are written a page at a time as they arrive from the
+// chat service, so large conversations are never held in memory. Attachments are
+// listed by URL only.
+func ExportConversation(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in context"})
+		return
+	}
+	format, ok := chatExportFormats[c.DefaultQuery("format", "json")]
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "format must be json or txt"})
+		return
+	}
+	textFormat := format.extension == "txt"
+
+	chatClient, err := clients.GetChatClient()
+	if err != nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": err.Error()})
+		return
+	}
+	ctx := chatContext(c, userID.(string))
+	conversationID := c.Param("id")
+	resp, err := chatClient.GetConversation(ctx, &chatpb.GetConversationRequest{ConversationId: conversationID, UserId: userID.(string)})
+	if err != nil {
+		respondChatError(c, "Failed to export conversation", err)
+		return
+	}
+	conversation := resp.GetConversation()
+	if conversation.GetEmployerId() != userID.(string) && conversation.GetCandidateId() != userID.(string) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "You are not a participant in this conversation"})
+		return
+	}
+
+	// Errors on the first page can still be reported with a status code
+	page, err := chatClient.ListMessages(ctx, &chatpb.ListMessagesRequest{
+		ConversationId: conversationID,
+		UserId:         userID.(string),
+		Page:           1,
+		Limit:          chatExportPageSize,
+	})
+	if err != nil {
+		respondChatError(c, "Failed to export conversation", err)
+		return
+	}
+
+	filename := fmt.Sprintf("conversation-%s.%s", unsafeFilename.ReplaceAllString(conversationID, "_"), format.extension)
+	c.Header("Content-Type", format.contentType)
+	c.Header("Content-Disposition", `attachment; filename="`+filename+`"`)
+	c.Status(http.StatusOK)
+
+	out := bufio.NewWriter(c.Writer)
+	writeExportHeader(out, conversation, textFormat)
+	for n := int32(1); ; n++ {
+		for _, message := range page.GetMessages() {
+			writeExportMessage(out, message, textFormat)
+		}
+		out.Flush()
+		c.Writer.Flush()
+
+		if len(page.GetMessages()) < chatExportPageSize || int(n)*chatExportPageSize >= int(page.GetTotal()) {
+			return
+		}
+		page, err = chatClient.ListMessages(ctx, &chatpb.ListMessagesRequest{
+			ConversationId: conversationID,
+			UserId:         userID.(string),
+			Page:           n + 1,
+			Limit:          chatExportPageSize,
+		})
+		if err != nil {
+			// The status is already sent; end the file with a marker so it isn't mistaken for complete
+			log.Printf("Conversation export %s for %s failed after %d pages: %v", conversationID, userID, n, err)
+			writeExportError(out, textFormat)
+			out.Flush()
+			return
+		}
+	}
+}
+
+func writeExportHeader(out *bufio.Writer, conversation *chatpb.Conversation, textFormat bool) {
+	if textFormat {
+		fmt.Fprintf(out, "Conversation %s\nJob: %s (%s)\nEmployer: %s\nCandidate: %s\nExported: %s\n\n",
+			conversation.GetId(), conversation.GetJobTitle(), conversation.GetJobId(),
+			conversation.GetEmployerId(), conversation.GetCandidateId(), time.Now().UTC().Format(time.RFC3339))
+		return
+	}
+	line := chatConversationContext(conversation)
+	line["type"] = "conversation"
+	writeJSONLine(out, line)
+}
+
+func writeExportMessage(out *bufio.Writer, message *chatpb.Message, textFormat bool) {
+	var attachments []string
+	for _, attachment := range message.GetAttachments() {
+		attachments = append(attachments, attachment.GetUrl())
+	}
+	if textFormat {
+		fmt.Fprintf(out, "[%s] %s %s: %s\n", message.GetSentTime(), message.GetSenderRole().String(), message.GetSenderId(), message.GetContent())
+		for _, url := range attachments {
+			fmt.Fprintf(out, "    attachment: %s\n", url)
+		}
+		return
+	}
+	line := chatMessageJSON(message)
+	line["type"] = "message"
+	if len(attachments) > 0 {
+		line["attachments"] = attachments
+	}
+	writeJSONLine(out, line)
+}
+
+func writeExportError(out *bufio.Writer, textFormat bool) {
+	if textFormat {
+		fmt.Fprintln(out, "\n[Export incomplete: the chat service stopped responding. Please try again.]")
+		return
+	}
+	writeJSONLine(out, gin.H{"type": "error", "error": "Export incomplete: the chat service stopped responding"})
+}
+
+func writeJSONLine(out *bufio.Writer, line gin.H) {
+	data, err := json.Marshal(line)
+	if err != nil {
+		return
+	}
+	out.Write(data)
+	out.WriteByte('\n')
+}
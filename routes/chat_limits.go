@@ -0,0 +1,42 @@
+package routes
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"skillsync-api-gateway/config"
+	"skillsync-api-gateway/utils/chatlimit"
+)
+
+// chatSendLimiter is shared by the REST send endpoints and the WebSocket relay;
+// Configure rebuilds it from the CHAT_* limits
+var chatSendLimiter = newChatSendLimiter(cfg.ChatLimits)
+
+func newChatSendLimiter(limits config.ChatLimitConfig) *chatlimit.Limiter {
+	return chatlimit.New(chatlimit.Options{
+		PerSender:      limits.PerMinute,
+		PerRecipient:   limits.PerConversationPerMinute,
+		BulkPerSender:  limits.BulkPerMinute,
+		FloodThreshold: limits.FloodThreshold,
+		FloodWindow:    limits.FloodWindow,
+		MuteFor:        limits.MuteFor,
+	})
+}
+
+// respondChatThrottled refuses a send the limiter didn't allow with 429, saying
+// why and when the sender may try again
+func respondChatThrottled(c *gin.Context, decision chatlimit.Decision) {
+	retryAfter := int(decision.RetryAfter.Seconds()) + 1
+	message := "You are sending messages too fast, please slow down"
+	if decision.Reason == chatlimit.Muted {
+		message = "You sent too many messages and can't send more for a while"
+	}
+	c.Header("Retry-After", strconv.Itoa(retryAfter))
+	c.JSON(http.StatusTooManyRequests, gin.H{
+		"error":               message,
+		"error_code":          decision.Reason,
+		"retry_after_seconds": retryAfter,
+	})
+}
@@ -0,0 +1,102 @@
+package routes
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	chatpb "github.com/shahal0/skillsync-protos/gen/chatpb"
+	"google.golang.org/grpc"
+
+	"skillsync-api-gateway/clients"
+	"skillsync-api-gateway/config"
+)
+
+// fakeSendChat has one conversation per candidate, cN with eN, and blocks no one
+type fakeSendChat struct {
+	chatpb.ChatServiceClient
+	sent map[string]int
+}
+
+func (f *fakeSendChat) GetConversation(_ context.Context, req *chatpb.GetConversationRequest, _ ...grpc.CallOption) (*chatpb.GetConversationResponse, error) {
+	candidate := strings.TrimPrefix(req.ConversationId, "conv-")
+	return &chatpb.GetConversationResponse{Conversation: &chatpb.Conversation{
+		Id:          req.ConversationId,
+		CandidateId: candidate,
+		EmployerId:  "e" + strings.TrimPrefix(candidate, "c"),
+	}}, nil
+}
+
+func (f *fakeSendChat) ListBlockedUsers(context.Context, *chatpb.ListBlockedUsersRequest, ...grpc.CallOption) (*chatpb.ListBlockedUsersResponse, error) {
+	return &chatpb.ListBlockedUsersResponse{}, nil
+}
+
+func (f *fakeSendChat) SendMessage(_ context.Context, req *chatpb.SendMessageRequest, _ ...grpc.CallOption) (*chatpb.SendMessageResponse, error) {
+	f.sent[req.SenderId]++
+	return &chatpb.SendMessageResponse{Message: &chatpb.Message{Id: "m1", ConversationId: req.ConversationId, SenderId: req.SenderId, Content: req.Content}}, nil
+}
+
+// TestChatSendFlood floods POST /chat-notification/chat/messages from one user
+// and checks they are throttled, then muted, while another user still gets through
+func TestChatSendFlood(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	chat := &fakeSendChat{sent: map[string]int{}}
+	previousChat, previousLimiter := clients.ChatServiceClient, chatSendLimiter
+	clients.ChatServiceClient = chat
+	chatSendLimiter = newChatSendLimiter(config.ChatLimitConfig{
+		PerMinute:                5,
+		PerConversationPerMinute: 5,
+		FloodThreshold:           3,
+		FloodWindow:              time.Minute,
+		MuteFor:                  time.Minute,
+	})
+	t.Cleanup(func() { clients.ChatServiceClient, chatSendLimiter = previousChat, previousLimiter })
+
+	r := gin.New()
+	SetupChatRoutes(r)
+	send := func(userID string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/chat-notification/chat/messages",
+			strings.NewReader(`{"conversation_id":"conv-`+userID+`","content":"hello"}`))
+		req.Header.Set("Authorization", "Bearer "+candidateToken(t, userID))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	var codes []string
+	for i := 0; i < 10; i++ {
+		w := send("c1")
+		if i < 5 {
+			if w.Code != http.StatusCreated {
+				t.Fatalf("message %d: status = %d (%s), want 201 within the budget", i, w.Code, w.Body)
+			}
+			continue
+		}
+		var body struct {
+			Code       string `json:"error_code"`
+			RetryAfter int    `json:"retry_after_seconds"`
+		}
+		json.Unmarshal(w.Body.Bytes(), &body)
+		if w.Code != http.StatusTooManyRequests || w.Header().Get("Retry-After") == "" || body.RetryAfter < 1 {
+			t.Fatalf("message %d: status = %d (%s), want 429 with a retry time", i, w.Code, w.Body)
+		}
+		codes = append(codes, body.Code)
+	}
+	want := []string{"chat_rate_limited", "chat_rate_limited", "chat_flood_muted", "chat_flood_muted", "chat_flood_muted"}
+	if strings.Join(codes, ",") != strings.Join(want, ",") {
+		t.Errorf("refusals = %v, want %v", codes, want)
+	}
+	if chat.sent["c1"] != 5 {
+		t.Errorf("chat service got %d messages from the flooder, want 5", chat.sent["c1"])
+	}
+
+	if w := send("c2"); w.Code != http.StatusCreated {
+		t.Errorf("other user: status = %d (%s), want 201", w.Code, w.Body)
+	}
+}
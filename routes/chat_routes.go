@@ -0,0 +1,192 @@
+package routes
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	chatpb "github.com/shahal0/skillsync-protos/gen/chatpb"
+	"google.golang.org/grpc/metadata"
+
+	"skillsync-api-gateway/clients"
+	"skillsync-api-gateway/middlewares"
+	"skillsync-api-gateway/utils/websocket"
+)
+
+const (
+	defaultConversationLimit = 20
+	maxConversationLimit     = 100
+)
+
+func SetupChatRoutes(r *gin.Engine) {
+	websocket.GetManager().SetBlockFilter(relayBlocked)
+
+	chat := r.Group("/chat-notification/chat")
+	chat.Use(middlewares.Maintenance("chat"), middlewares.JWTMiddleware())
+	{
+		chat.GET("/search", SearchMessages)
+		chat.GET("/conversations", GetConversations)
+		chat.POST("/conversations", StartConversation)
+		chat.POST("/messages", SendChatMessage)
+		chat.POST("/bulk-send", middlewares.RequireRole("employer"), middlewares.RequirePlan(middlewares.PlanPro),
+			middlewares.Idempotency(middlewares.DefaultIdempotencyStore, idempotencyWindow), BulkSendMessages)
+		chat.GET("/conversations/:id/export", middlewares.RateLimitPerUser(chatExportLimit, chatExportWindow), ExportConversation)
+
+		chat.POST("/block", BlockUser)
+		chat.DELETE("/block/:user_id", UnblockUser)
+		chat.GET("/blocked", GetBlockedUsers)
+		chat.POST("/report", ReportUser)
+
+		// Per-user view state; each PUT has a DELETE that undoes it
+		chat.PUT("/conversations/:id/mute", setConversationState(chatpb.ConversationState_CONVERSATION_STATE_MUTED, true))
+		chat.DELETE("/conversations/:id/mute", setConversationState(chatpb.ConversationState_CONVERSATION_STATE_MUTED, false))
+		chat.PUT("/conversations/:id/archive", setConversationState(chatpb.ConversationState_CONVERSATION_STATE_ARCHIVED, true))
+		chat.DELETE("/conversations/:id/archive", setConversationState(chatpb.ConversationState_CONVERSATION_STATE_ARCHIVED, false))
+		chat.PUT("/conversations/:id/pin", setConversationState(chatpb.ConversationState_CONVERSATION_STATE_PINNED, true))
+		chat.DELETE("/conversations/:id/pin", setConversationState(chatpb.ConversationState_CONVERSATION_STATE_PINNED, false))
+	}
+}
+
+// chatContext forwards the authenticated user so the chat service can check participation
+func chatContext(c *gin.Context, userID string) context.Context {
+	return metadata.NewOutgoingContext(
+		c.Request.Context(),
+		metadata.New(map[string]string{
+			"user-id": userID,
+			"role":    c.GetString("user_role"),
+		}),
+	)
+}
+
+// GetConversations lists the caller's conversations with their own muted, archived
+// and pinned flags. Archived conversations are left out unless include_archived=true.
+func GetConversations(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil || page < 1 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "page must be a positive integer"})
+		return
+	}
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", strconv.Itoa(defaultConversationLimit)))
+	if err != nil || limit < 1 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "limit must be a positive integer"})
+		return
+	}
+	if limit > maxConversationLimit {
+		limit = maxConversationLimit
+	}
+	includeArchived := c.Query("include_archived") == "true"
+
+	chatClient, err := clients.GetChatClient()
+	if err != nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": err.Error()})
+		return
+	}
+	ctx := chatContext(c, userID.(string))
+	manager := websocket.GetManager()
+
+	// A stream lists every conversation, so page and limit don't apply
+	if streamRequested(c) {
+		streamList(c, ctx, "conversations",
+			func(ctx context.Context, page int32) ([]*chatpb.Conversation, int64, error) {
+				resp, err := chatClient.ListConversations(ctx, &chatpb.ListConversationsRequest{
+					UserId:          userID.(string),
+					Page:            page,
+					Limit:           streamPageSize,
+					IncludeArchived: includeArchived,
+				})
+				return resp.GetConversations(), int64(resp.GetTotal()), err
+			},
+			func(conversation *chatpb.Conversation) interface{} {
+				manager.SetMuted(userID.(string), conversation.GetId(), conversation.GetMuted())
+				if conversation.GetArchived() && !includeArchived {
+					return nil
+				}
+				return chatConversationJSON(conversation)
+			},
+			func(err error) { respondChatError(c, "Failed to get conversations", err) })
+		return
+	}
+
+	resp, err := chatClient.ListConversations(ctx, &chatpb.ListConversationsRequest{
+		UserId:          userID.(string),
+		Page:            int32(page),
+		Limit:           int32(limit),
+		IncludeArchived: includeArchived,
+	})
+	if err != nil {
+		respondChatError(c, "Failed to get conversations", err)
+		return
+	}
+
+	conversations := make([]gin.H, 0, len(resp.GetConversations()))
+	for _, conversation := range resp.GetConversations() {
+		// The chat service is the source of truth; keep the push layer in step with it
+		manager.SetMuted(userID.(string), conversation.GetId(), conversation.GetMuted())
+		if conversation.GetArchived() && !includeArchived {
+			continue
+		}
+		conversations = append(conversations, chatConversationJSON(conversation))
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"conversations": conversations,
+		"total":         resp.GetTotal(),
+		"page":          page,
+		"limit":         limit,
+	})
+}
+
+// setConversationState turns one of the caller's view flags on a conversation on or
+// off. The chat service only lets participants change their own view, so others get 403.
+func setConversationState(state chatpb.ConversationState, enabled bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in context"})
+			return
+		}
+		conversationID := c.Param("id")
+
+		chatClient, err := clients.GetChatClient()
+		if err != nil {
+			c.JSON(http.StatusServiceUnavailable, gin.H{"error": err.Error()})
+			return
+		}
+		resp, err := chatClient.SetConversationState(chatContext(c, userID.(string)), &chatpb.SetConversationStateRequest{
+			ConversationId: conversationID,
+			UserId:         userID.(string),
+			State:          state,
+			Enabled:        enabled,
+		})
+		if err != nil {
+			respondChatError(c, "Failed to update conversation", err)
+			return
+		}
+
+		if state == chatpb.ConversationState_CONVERSATION_STATE_MUTED {
+			websocket.GetManager().SetMuted(userID.(string), conversationID, enabled)
+		}
+		c.JSON(http.StatusOK, chatConversationJSON(resp.GetConversation()))
+	}
+}
+
+func chatConversationJSON(conversation *chatpb.Conversation) gin.H {
+	view := chatConversationContext(conversation)
+	view["status"] = conversation.GetStatus()
+	view["unread_count"] = conversation.GetUnreadCount()
+	view["created_at"] = conversation.GetCreatedAt().AsTime()
+	view["updated_at"] = conversation.GetUpdatedAt().AsTime()
+	if last := conversation.GetLastMessage(); last != nil {
+		view["last_message"] = chatMessageJSON(last)
+	}
+	view["muted"] = conversation.GetMuted()
+	view["archived"] = conversation.GetArchived()
+	view["pinned"] = conversation.GetPinned()
+	return view
+}
@@ -0,0 +1,258 @@
+package routes
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/gin-gonic/gin"
+	chatpb "github.com/shahal0/skillsync-protos/gen/chatpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"skillsync-api-gateway/clients"
+	"skillsync-api-gateway/utils"
+)
+
+const (
+	minMessageQueryLength    = 2
+	maxMessageQueryLength    = 200
+	defaultMessageSearchSize = 20
+	maxMessageSearchSize     = 50
+	maxMessageSearchPage     = 100
+
+	// The fallback search stops after this many messages, across all conversations
+	maxFallbackScannedMessages = 2000
+	fallbackConversationLimit  = 100
+	fallbackMessagePageSize    = 100
+)
+
+// messageMatch is one search result with the conversation it belongs to
+type messageMatch struct {
+	message      *chatpb.Message
+	conversation *chatpb.Conversation
+}
+
+// SearchMessages finds messages containing q in the caller's conversations. The
+// chat service's search RPC is used when it has one; otherwise the gateway pages
+// through the conversations itself, up to maxFallbackScannedMessages, and marks
+// the response "truncated" if it had to stop early.
+func SearchMessages(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	query := strings.TrimSpace(c.Query("q"))
+	switch length := utf8.RuneCountInString(query); {
+	case length == 0:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "q is required"})
+		return
+	case length < minMessageQueryLength:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "q must be at least 2 characters"})
+		return
+	case length > maxMessageQueryLength:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "q is too long"})
+		return
+	}
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil || page < 1 || page > maxMessageSearchPage {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "page must be between 1 and 100"})
+		return
+	}
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", strconv.Itoa(defaultMessageSearchSize)))
+	if err != nil || limit < 1 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "limit must be a positive integer"})
+		return
+	}
+	if limit > maxMessageSearchSize {
+		limit = maxMessageSearchSize
+	}
+	conversationID := strings.TrimSpace(c.Query("conversation_id"))
+
+	chatClient, err := clients.GetChatClient()
+	if err != nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": err.Error()})
+		return
+	}
+	ctx := chatContext(c, userID.(string))
+
+	var (
+		matches   []messageMatch
+		total     int
+		truncated bool
+	)
+	resp, err := chatClient.SearchMessages(ctx, &chatpb.SearchMessagesRequest{
+		UserId:         userID.(string),
+		Query:          query,
+		ConversationId: conversationID,
+		Page:           int32(page),
+		Limit:          int32(limit),
+	})
+	switch {
+	case err == nil:
+		for _, match := range resp.GetMatches() {
+			matches = append(matches, messageMatch{message: match.GetMessage(), conversation: match.GetConversation()})
+		}
+		total = int(resp.GetTotal())
+	case status.Code(err) == codes.Unimplemented:
+		var all []messageMatch
+		all, truncated, err = scanMessages(ctx, chatClient, userID.(string), conversationID, query)
+		if err != nil {
+			respondChatError(c, "Failed to search messages", err)
+			return
+		}
+		total = len(all)
+		if start := (page - 1) * limit; start < total {
+			matches = all[start:min(start+limit, total)]
+		}
+	default:
+		respondChatError(c, "Failed to search messages", err)
+		return
+	}
+
+	results := make([]gin.H, 0, len(matches))
+	for _, match := range matches {
+		results = append(results, gin.H{
+			"message":      chatMessageJSON(match.message),
+			"conversation": chatConversationContext(match.conversation),
+			"highlights":   highlightOffsets(match.message.GetContent(), query),
+		})
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"results":   results,
+		"total":     total,
+		"page":      page,
+		"limit":     limit,
+		"truncated": truncated,
+	})
+}
+
+// scanMessages is the gateway-side search for chat services without a search RPC.
+// It reads the caller's conversations (or just conversationID) newest page first
+// and reports truncated when it stops at the scan cap.
+func scanMessages(ctx context.Context, chatClient chatpb.ChatServiceClient, userID, conversationID, query string) ([]messageMatch, bool, error) {
+	var conversations []*chatpb.Conversation
+	truncated := false
+	if conversationID != "" {
+		resp, err := chatClient.GetConversation(ctx, &chatpb.GetConversationRequest{ConversationId: conversationID, UserId: userID})
+		if err != nil {
+			return nil, false, err
+		}
+		conversation := resp.GetConversation()
+		if conversation.GetEmployerId() != userID && conversation.GetCandidateId() != userID {
+			return nil, false, status.Error(codes.PermissionDenied, "not a participant in this conversation")
+		}
+		conversations = append(conversations, conversation)
+	} else {
+		resp, err := chatClient.ListConversations(ctx, &chatpb.ListConversationsRequest{UserId: userID, Page: 1, Limit: fallbackConversationLimit})
+		if err != nil {
+			return nil, false, err
+		}
+		conversations = resp.GetConversations()
+		truncated = int(resp.GetTotal()) > len(conversations)
+	}
+
+	var matches []messageMatch
+	scanned := 0
+	for _, conversation := range conversations {
+		for page := int32(1); ; page++ {
+			if scanned >= maxFallbackScannedMessages {
+				return matches, true, nil
+			}
+			resp, err := chatClient.ListMessages(ctx, &chatpb.ListMessagesRequest{
+				ConversationId: conversation.GetId(),
+				UserId:         userID,
+				Page:           page,
+				Limit:          fallbackMessagePageSize,
+			})
+			if err != nil {
+				return nil, false, err
+			}
+			for _, message := range resp.GetMessages() {
+				if scanned >= maxFallbackScannedMessages {
+					return matches, true, nil
+				}
+				scanned++
+				if len(highlightOffsets(message.GetContent(), query)) > 0 {
+					matches = append(matches, messageMatch{message: message, conversation: conversation})
+				}
+			}
+			if len(resp.GetMessages()) < fallbackMessagePageSize || int(page)*fallbackMessagePageSize >= int(resp.GetTotal()) {
+				break
+			}
+		}
+	}
+	return matches, truncated, nil
+}
+
+// highlightOffsets returns the [start, end) character offsets of every
+// non-overlapping, case-insensitive occurrence of query in text
+func highlightOffsets(text, query string) [][2]int {
+	haystack := foldRunes(text)
+	needle := foldRunes(query)
+	offsets := [][2]int{}
+	if len(needle) == 0 {
+		return offsets
+	}
+	for i := 0; i+len(needle) <= len(haystack); {
+		if runesEqual(haystack[i:i+len(needle)], needle) {
+			offsets = append(offsets, [2]int{i, i + len(needle)})
+			i += len(needle)
+			continue
+		}
+		i++
+	}
+	return offsets
+}
+
+// foldRunes lowercases rune by rune so offsets line up with the original text
+func foldRunes(s string) []rune {
+	runes := []rune(s)
+	for i, r := range runes {
+		runes[i] = unicode.ToLower(r)
+	}
+	return runes
+}
+
+func runesEqual(a, b []rune) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func chatMessageJSON(message *chatpb.Message) gin.H {
+	return gin.H{
+		"id":              message.GetId(),
+		"conversation_id": message.GetConversationId(),
+		"sender_id":       message.GetSenderId(),
+		"sender_role":     message.GetSenderRole().String(),
+		"content":         message.GetContent(),
+		"sent_time":       message.GetSentTime(),
+	}
+}
+
+// chatConversationContext is the part of a conversation a search result needs to link to it
+func chatConversationContext(conversation *chatpb.Conversation) gin.H {
+	return gin.H{
+		"id":             conversation.GetId(),
+		"job_id":         conversation.GetJobId(),
+		"job_title":      conversation.GetJobTitle(),
+		"application_id": conversation.GetApplicationId(),
+		"employer_id":    conversation.GetEmployerId(),
+		"candidate_id":   conversation.GetCandidateId(),
+	}
+}
+
+// respondChatError answers with the status of the chat service's gRPC code, so
+// callers outside a conversation (PermissionDenied) get 403
+func respondChatError(c *gin.Context, message string, err error) {
+	c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": message + ": " + utils.GRPCErrorMessage(err)})
+}
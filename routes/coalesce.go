@@ -0,0 +1,54 @@
+package routes
+
+import (
+	"context"
+	"expvar"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+	"google.golang.org/grpc/status"
+
+	"skillsync-api-gateway/clients"
+)
+
+// coalescedCallTimeout bounds a shared call for routes whose policy sets no timeout
+const coalescedCallTimeout = 10 * time.Second
+
+// readGroup shares one in-flight backend call between concurrent identical reads.
+// Keys must include everything that changes the answer; the public reads using it
+// only depend on their query.
+var readGroup singleflight.Group
+
+// coalescedRequests counts, per endpoint, the requests that waited on another
+// request's backend call instead of making their own
+var coalescedRequests = expvar.NewMap("coalesced_requests")
+
+// coalesce runs load once for all concurrent callers with the same key. The shared
+// call outlives the caller that started it, bounded by the route's policy timeout,
+// and each caller stops waiting when its own ctx is done.
+func coalesce[V any](ctx context.Context, endpoint, key string, load func(context.Context) (V, error)) (V, error) {
+	leader := false
+	results := readGroup.DoChan(key, func() (interface{}, error) {
+		leader = true
+		timeout := coalescedCallTimeout
+		if policy, ok := clients.PolicyFrom(ctx); ok && policy.Timeout > 0 {
+			timeout = policy.Timeout
+		}
+		callCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), timeout)
+		defer cancel()
+		return load(callCtx)
+	})
+	var zero V
+	select {
+	case result := <-results:
+		if !leader {
+			coalescedRequests.Add(endpoint, 1)
+		}
+		if result.Err != nil {
+			return zero, result.Err
+		}
+		return result.Val.(V), nil
+	case <-ctx.Done():
+		return zero, status.FromContextError(ctx.Err()).Err()
+	}
+}
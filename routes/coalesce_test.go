@@ -0,0 +1,165 @@
+package routes
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	jobpb "github.com/shahal0/skillsync-protos/gen/jobpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"skillsync-api-gateway/clients"
+	"skillsync-api-gateway/config"
+)
+
+func TestCoalesceWaiterStopsOnItsOwnContext(t *testing.T) {
+	release := make(chan struct{})
+	started := make(chan struct{})
+	done := make(chan error, 1)
+	go func() {
+		_, err := coalesce(context.Background(), "test", "coalesce|waiter", func(ctx context.Context) (int, error) {
+			close(started)
+			<-release
+			return 1, ctx.Err()
+		})
+		done <- err
+	}()
+	<-started
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := coalesce(ctx, "test", "coalesce|waiter", func(context.Context) (int, error) {
+		t.Error("waiter ran its own load")
+		return 0, nil
+	})
+	if status.Code(err) != codes.Canceled {
+		t.Errorf("waiter error = %v, want Canceled", err)
+	}
+
+	close(release)
+	if err := <-done; err != nil {
+		t.Errorf("leader error = %v, want the shared call to outlive the waiter", err)
+	}
+}
+
+func TestCoalesceBoundsTheSharedCall(t *testing.T) {
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		bounds time.Duration
+	}{
+		{"policy timeout", clients.WithPolicy(context.Background(), config.UpstreamPolicy{Timeout: time.Second}), time.Second},
+		{"no policy", context.Background(), coalescedCallTimeout},
+		{"policy without timeout", clients.WithPolicy(context.Background(), config.UpstreamPolicy{}), coalescedCallTimeout},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// The caller's cancellation doesn't reach the shared call
+			ctx, cancel := context.WithCancel(tt.ctx)
+			type observed struct {
+				remaining time.Duration
+				err       error
+			}
+			seen := make(chan observed, 1)
+			coalesce(ctx, "test", "coalesce|"+tt.name, func(ctx context.Context) (int, error) {
+				cancel()
+				deadline, ok := ctx.Deadline()
+				if !ok {
+					t.Error("shared call has no deadline")
+				}
+				seen <- observed{time.Until(deadline), ctx.Err()}
+				return 0, nil
+			})
+			got := <-seen
+			if got.err != nil {
+				t.Errorf("shared call context: %v", got.err)
+			}
+			if got.remaining <= 0 || got.remaining > tt.bounds {
+				t.Errorf("shared call deadline in %v, want at most %v", got.remaining, tt.bounds)
+			}
+		})
+	}
+}
+
+// fakeCountingJobs counts GetJobs calls, each taking delay so concurrent requests overlap
+type fakeCountingJobs struct {
+	jobpb.JobServiceClient
+	delay time.Duration
+	calls atomic.Int64
+}
+
+func (f *fakeCountingJobs) GetJobs(context.Context, *jobpb.GetJobsRequest, ...grpc.CallOption) (*jobpb.GetJobsResponse, error) {
+	f.calls.Add(1)
+	time.Sleep(f.delay)
+	resp := &jobpb.GetJobsResponse{Total: 20}
+	for i := 1; i <= 20; i++ {
+		resp.Jobs = append(resp.Jobs, &jobpb.Job{Id: uint64(i), Title: "Backend engineer", Status: "OPEN"})
+	}
+	return resp, nil
+}
+
+// BenchmarkCoalescedGetJobs sends bursts of concurrent GET /jobs/ requests with
+// the listing cache empty. backend-calls/op is how many GetJobs calls a burst
+// made: one when the requests are identical, one per request when they aren't.
+func BenchmarkCoalescedGetJobs(b *testing.B) {
+	gin.SetMode(gin.TestMode)
+	const concurrent = 50
+	jobs := &fakeCountingJobs{delay: 2 * time.Millisecond}
+	previous := clients.JobServiceClient
+	clients.JobServiceClient = jobs
+	b.Cleanup(func() {
+		clients.JobServiceClient = previous
+		invalidateJobCaches()
+	})
+	r := gin.New()
+	r.GET("/jobs/", GetJobs)
+
+	for _, bb := range []struct {
+		name      string
+		identical bool
+	}{
+		{"identical", true},
+		{"distinct", false},
+	} {
+		b.Run(bb.name, func(b *testing.B) {
+			jobs.calls.Store(0)
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				invalidateJobCaches()
+				start := make(chan struct{})
+				var wg sync.WaitGroup
+				for j := 0; j < concurrent; j++ {
+					keyword := "engineer"
+					if !bb.identical {
+						keyword += strconv.Itoa(j)
+					}
+					wg.Add(1)
+					go func() {
+						defer wg.Done()
+						req := httptest.NewRequest(http.MethodGet, "/jobs/?keyword="+keyword, nil)
+						w := httptest.NewRecorder()
+						<-start
+						r.ServeHTTP(w, req)
+						if w.Code != http.StatusOK {
+							b.Errorf("status = %d (%s), want 200", w.Code, w.Body)
+						}
+					}()
+				}
+				close(start)
+				wg.Wait()
+			}
+			b.StopTimer()
+			b.ReportMetric(concurrent, "requests/op")
+			b.ReportMetric(float64(jobs.calls.Load())/float64(b.N), "backend-calls/op")
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package routes
+
+import (
+	"context"
+	"log"
+
+	"skillsync-api-gateway/config"
+	"skillsync-api-gateway/middlewares"
+	"skillsync-api-gateway/utils/cache"
+	"skillsync-api-gateway/utils/fanout"
+	"skillsync-api-gateway/utils/webpush"
+	"skillsync-api-gateway/utils/websocket"
+)
+
+// cfg is the configuration route handlers read; it defaults to config.Default until Configure runs
+var cfg = config.Default()
+
+// Configure sets the configuration used by the route handlers. Call it before setting up routes.
+func Configure(c *config.Config) {
+	cfg = c
+	auditLog = newAuditLogger(c.Audit)
+	uploadStorage = newUploadStorage(c.Storage)
+	recentSignups = cache.NewTTLCache[interface{}](c.SignupDedupeWindow)
+	otpResendThrottle = middlewares.NewSendThrottle(c.OTPResend.Cooldown, c.OTPResend.MaxPerHour)
+	fanout.Configure(c.Services.MaxInFlight)
+	chatSendLimiter = newChatSendLimiter(c.ChatLimits)
+	websocket.GetManager().SetSendLimiter(chatSendLimiter)
+	pushSender = webpush.New(webpush.Options{
+		PublicKey:  c.WebPush.PublicKey,
+		PrivateKey: c.WebPush.PrivateKey,
+		Subject:    c.WebPush.Subject,
+	})
+	websocket.GetManager().Configure(websocket.Options{
+		MaxConnectionsPerUser: c.WebSocket.MaxConnectionsPerUser,
+		MaxConnections:        c.WebSocket.MaxConnections,
+		SendBuffer:            c.WebSocket.SendBuffer,
+		SlowClientTimeout:     c.WebSocket.SlowClientTimeout,
+		AllowedOrigins:        c.CORS.AllowOrigins,
+	})
+}
+
+// Shutdown finishes the handlers' background work, such as queued notifications,
+// within ctx's deadline. Call it after the HTTP server has stopped.
+func Shutdown(ctx context.Context) error {
+	stopSkillTaxonomyRefresh()
+	// Flushed views may queue notifications, so they go first
+	if err := stopJobViewFlush(ctx); err != nil {
+		log.Printf("Job views lost on shutdown: %v", err)
+	}
+	// Sent notifications queue pushes, so pushes are drained last
+	if err := drainNotifications(ctx); err != nil {
+		return err
+	}
+	return drainPushes(ctx)
+}
@@ -0,0 +1,139 @@
+package routes
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	authpb "github.com/shahal0/skillsync-protos/gen/authpb"
+	jobpb "github.com/shahal0/skillsync-protos/gen/jobpb"
+	notificationpb "github.com/shahal0/skillsync-protos/gen/notificationpb"
+	"google.golang.org/grpc"
+
+	"skillsync-api-gateway/clients"
+	"skillsync-api-gateway/middlewares"
+	"skillsync-api-gateway/utils/profilecache"
+)
+
+// fakeDashboardAuth stores one candidate profile that updates change
+type fakeDashboardAuth struct {
+	authpb.AuthServiceClient
+	mutex sync.Mutex
+	name  string
+	reads int
+}
+
+func (f *fakeDashboardAuth) CandidateProfile(context.Context, *authpb.CandidateProfileRequest, ...grpc.CallOption) (*authpb.CandidateProfileResponse, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.reads++
+	return &authpb.CandidateProfileResponse{Id: "c1", Name: f.name}, nil
+}
+
+func (f *fakeDashboardAuth) CandidateProfileUpdate(_ context.Context, req *authpb.CandidateProfileUpdateRequest, _ ...grpc.CallOption) (*authpb.GenericResponse, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.name = req.Name
+	return &authpb.GenericResponse{Message: "updated"}, nil
+}
+
+type fakeDashboardJobs struct {
+	jobpb.JobServiceClient
+}
+
+func (fakeDashboardJobs) GetApplications(context.Context, *jobpb.GetApplicationsRequest, ...grpc.CallOption) (*jobpb.GetApplicationsResponse, error) {
+	return &jobpb.GetApplicationsResponse{}, nil
+}
+
+func (fakeDashboardJobs) GetRecommendedJobsCount(context.Context, *jobpb.RecommendedJobsCountRequest, ...grpc.CallOption) (*jobpb.RecommendedJobsCountResponse, error) {
+	return &jobpb.RecommendedJobsCountResponse{}, nil
+}
+
+type fakeDashboardNotifications struct {
+	notificationpb.NotificationServiceClient
+}
+
+func (fakeDashboardNotifications) GetNotifications(context.Context, *notificationpb.GetNotificationsRequest, ...grpc.CallOption) (*notificationpb.GetNotificationsResponse, error) {
+	return &notificationpb.GetNotificationsResponse{}, nil
+}
+
+func candidateToken(t *testing.T, userID string) string {
+	t.Helper()
+	key := middlewares.SigningKey()
+	unsigned := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"user_id": userID,
+		"role":    "candidate",
+		"iat":     float64(time.Now().Unix()),
+		"exp":     float64(time.Now().Add(time.Hour).Unix()),
+	})
+	if key.ID != "" {
+		unsigned.Header["kid"] = key.ID
+	}
+	token, err := unsigned.SignedString([]byte(key.Secret))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+// TestDashboardAfterProfileUpdate checks the dashboard shows a profile update made
+// on this instance at once, instead of the copy cached by the read before it
+func TestDashboardAfterProfileUpdate(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	auth := &fakeDashboardAuth{name: "Ada"}
+	previousAuth, previousJobs, previousNotifications := clients.AuthServiceClient, clients.JobServiceClient, clients.NotificationServiceClient
+	clients.AuthServiceClient, clients.JobServiceClient, clients.NotificationServiceClient = auth, fakeDashboardJobs{}, fakeDashboardNotifications{}
+	t.Cleanup(func() {
+		clients.AuthServiceClient, clients.JobServiceClient, clients.NotificationServiceClient = previousAuth, previousJobs, previousNotifications
+		profilecache.Invalidate(context.Background(), "c1")
+	})
+	profilecache.Invalidate(context.Background(), "c1")
+
+	r := gin.New()
+	r.Use(profilecache.Scope())
+	SetupRoutes(r)
+	SetupMeRoutes(r)
+	token := candidateToken(t, "c1")
+	serve := func(method, path, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set("Authorization", "Bearer "+token)
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+	dashboardName := func() string {
+		t.Helper()
+		w := serve(http.MethodGet, "/me/dashboard", "")
+		var body struct {
+			Profile struct {
+				Name string `json:"name"`
+			} `json:"profile"`
+		}
+		if w.Code != http.StatusOK || json.Unmarshal(w.Body.Bytes(), &body) != nil {
+			t.Fatalf("dashboard status = %d (%s), want 200", w.Code, w.Body)
+		}
+		return body.Profile.Name
+	}
+
+	if name := dashboardName(); name != "Ada" {
+		t.Fatalf("profile name = %q, want Ada", name)
+	}
+	if name := dashboardName(); name != "Ada" || auth.reads != 1 {
+		t.Fatalf("profile name = %q after %d reads, want Ada from the cache after 1", name, auth.reads)
+	}
+
+	if w := serve(http.MethodPut, "/auth/candidate/profile/update", `{"name":"Grace"}`); w.Code != http.StatusOK {
+		t.Fatalf("update status = %d (%s), want 200", w.Code, w.Body)
+	}
+	if name := dashboardName(); name != "Grace" {
+		t.Errorf("profile name = %q after the update, want Grace", name)
+	}
+}
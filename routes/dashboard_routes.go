@@ -0,0 +1,282 @@
+package routes
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	authpb "github.com/shahal0/skillsync-protos/gen/authpb"
+	chatpb "github.com/shahal0/skillsync-protos/gen/chatpb"
+	jobpb "github.com/shahal0/skillsync-protos/gen/jobpb"
+	notificationpb "github.com/shahal0/skillsync-protos/gen/notificationpb"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc/metadata"
+
+	"skillsync-api-gateway/clients"
+	"skillsync-api-gateway/utils"
+	"skillsync-api-gateway/utils/cache"
+	"skillsync-api-gateway/utils/profilecache"
+)
+
+const (
+	employerStatsTimeout = 3 * time.Second
+	// candidateDashboardTimeout bounds the whole dashboard; each section gets its own shorter slice
+	candidateDashboardTimeout  = 2 * time.Second
+	candidateDashboardCallTime = 1 * time.Second
+	dashboardNotificationLimit = 5
+)
+
+// employerStatsCache keeps each employer's dashboard for a minute since the UI polls it
+var employerStatsCache = cache.NewTTLCache[gin.H](60 * time.Second)
+
+// validStatsPeriods are the reporting windows the backends understand
+var validStatsPeriods = map[string]bool{"7d": true, "30d": true, "all": true}
+
+func GetEmployerStats(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in context"})
+		return
+	}
+	employerID := userID.(string)
+
+	period := c.DefaultQuery("period", "all")
+	if !validStatsPeriods[period] {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "period must be one of 7d, 30d, all"})
+		return
+	}
+
+	cacheKey := employerID + "|" + period
+	if cached, ok := employerStatsCache.Get(cacheKey); ok {
+		c.JSON(http.StatusOK, cached)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), employerStatsTimeout)
+	defer cancel()
+	ctx = metadata.NewOutgoingContext(ctx, metadata.New(map[string]string{
+		"user-id": employerID,
+		"role":    "employer",
+	}))
+
+	var (
+		mutex       sync.Mutex
+		unavailable = []string{}
+		jobStats    *jobpb.EmployerJobStatsResponse
+		appStats    *jobpb.EmployerApplicationStatsResponse
+		unread      *chatpb.GetUnreadCountResponse
+	)
+	// markUnavailable records a failed section; the rest of the dashboard still renders
+	markUnavailable := func(section string, err error) {
+		log.Printf("Employer stats: %s unavailable for %s: %v", section, employerID, err)
+		mutex.Lock()
+		unavailable = append(unavailable, section)
+		mutex.Unlock()
+	}
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		resp, err := clients.JobServiceClient.GetEmployerJobStats(gctx, &jobpb.EmployerJobStatsRequest{
+			EmployerId: employerID,
+			Period:     period,
+		})
+		if err != nil {
+			markUnavailable("jobs", err)
+			return nil
+		}
+		jobStats = resp
+		return nil
+	})
+	g.Go(func() error {
+		resp, err := clients.JobServiceClient.GetEmployerApplicationStats(gctx, &jobpb.EmployerApplicationStatsRequest{
+			EmployerId: employerID,
+			Period:     period,
+		})
+		if err != nil {
+			markUnavailable("applications", err)
+			return nil
+		}
+		appStats = resp
+		return nil
+	})
+	g.Go(func() error {
+		chatClient, err := clients.GetChatClient()
+		if err != nil {
+			markUnavailable("messages", err)
+			return nil
+		}
+		resp, err := chatClient.GetUnreadCount(gctx, &chatpb.GetUnreadCountRequest{UserId: employerID})
+		if err != nil {
+			markUnavailable("messages", err)
+			return nil
+		}
+		unread = resp
+		return nil
+	})
+	// Sections never return errors, they only mark themselves unavailable
+	_ = g.Wait()
+
+	stats := gin.H{
+		"period":       period,
+		"jobs":         nil,
+		"applications": nil,
+		"messages":     nil,
+		"unavailable":  unavailable,
+	}
+	if jobStats != nil {
+		stats["jobs"] = gin.H{
+			"total":  jobStats.GetTotalJobs(),
+			"open":   jobStats.GetOpenJobs(),
+			"closed": jobStats.GetClosedJobs(),
+		}
+	}
+	if appStats != nil {
+		stats["applications"] = gin.H{
+			"total":     appStats.GetTotalApplications(),
+			"per_job":   appStats.GetPerJob(),
+			"by_status": appStats.GetByStatus(),
+		}
+	}
+	if unread != nil {
+		stats["messages"] = gin.H{"unread": unread.GetCount()}
+	}
+
+	// Only cache complete dashboards so a transient failure isn't served for a minute
+	if len(unavailable) == 0 {
+		employerStatsCache.Set(cacheKey, stats)
+	}
+	utils.RespondPartial(c, http.StatusOK, stats, unavailable...)
+}
+
+// GetCandidateDashboard collects everything the candidate home screen needs in one call.
+// Every section degrades to null with an entry in errors, so the response is always a 200.
+func GetCandidateDashboard(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in context"})
+		return
+	}
+	candidateID := userID.(string)
+
+	ctx, cancel := context.WithTimeout(candidateContext(c, candidateID), candidateDashboardTimeout)
+	defer cancel()
+
+	var (
+		mutex         sync.Mutex
+		sectionErrors = []gin.H{}
+		profile       *authpb.CandidateProfileResponse
+		applications  *jobpb.GetApplicationsResponse
+		notifications *notificationpb.GetNotificationsResponse
+		unread        *chatpb.GetUnreadCountResponse
+		recommended   *jobpb.RecommendedJobsCountResponse
+	)
+	sectionFailed := func(section string, err error) {
+		log.Printf("Candidate dashboard: %s unavailable for %s: %v", section, candidateID, err)
+		mutex.Lock()
+		sectionErrors = append(sectionErrors, gin.H{"section": section, "error": utils.GRPCErrorMessage(err)})
+		mutex.Unlock()
+	}
+
+	g, gctx := errgroup.WithContext(ctx)
+	// section runs one backend call under its own timeout within the shared budget
+	section := func(name string, call func(ctx context.Context) error) {
+		g.Go(func() error {
+			callCtx, cancel := context.WithTimeout(gctx, candidateDashboardCallTime)
+			defer cancel()
+			if err := call(callCtx); err != nil {
+				sectionFailed(name, err)
+			}
+			return nil
+		})
+	}
+	section("profile", func(ctx context.Context) error {
+		resp, err := profilecache.Get(ctx, candidateID)
+		profile = resp
+		return err
+	})
+	section("applications", func(ctx context.Context) error {
+		resp, err := clients.JobServiceClient.GetApplications(ctx, &jobpb.GetApplicationsRequest{CandidateId: candidateID})
+		applications = resp
+		return err
+	})
+	section("notifications", func(ctx context.Context) error {
+		resp, err := clients.GetNotificationClient().GetNotifications(ctx, &notificationpb.GetNotificationsRequest{
+			UserId: candidateID,
+			Page:   1,
+			Limit:  dashboardNotificationLimit,
+		})
+		notifications = resp
+		return err
+	})
+	section("messages", func(ctx context.Context) error {
+		chatClient, err := clients.GetChatClient()
+		if err != nil {
+			return err
+		}
+		resp, err := chatClient.GetUnreadCount(ctx, &chatpb.GetUnreadCountRequest{UserId: candidateID})
+		unread = resp
+		return err
+	})
+	section("recommended_jobs", func(ctx context.Context) error {
+		resp, err := clients.JobServiceClient.GetRecommendedJobsCount(ctx, &jobpb.RecommendedJobsCountRequest{CandidateId: candidateID})
+		recommended = resp
+		return err
+	})
+	// Sections never return errors, they only record themselves in sectionErrors
+	_ = g.Wait()
+
+	// Each section is only read if its call succeeded
+	failed := make(map[string]bool, len(sectionErrors))
+	for _, e := range sectionErrors {
+		failed[e["section"].(string)] = true
+	}
+	dashboard := gin.H{
+		"profile":          nil,
+		"applications":     nil,
+		"notifications":    nil,
+		"messages":         nil,
+		"recommended_jobs": nil,
+		"errors":           sectionErrors,
+	}
+	if !failed["profile"] {
+		dashboard["profile"] = gin.H{
+			"id":          profile.GetId(),
+			"name":        profile.GetName(),
+			"email":       profile.GetEmail(),
+			"location":    profile.GetCurrentLocation(),
+			"skills":      len(profile.GetSkills()),
+			"has_resume":  profile.GetResume() != "",
+			"is_verified": profile.GetIsVerified(),
+		}
+	}
+	if !failed["applications"] {
+		byStatus := map[string]int{}
+		for _, application := range applications.GetApplications() {
+			byStatus[application.GetStatus()]++
+		}
+		dashboard["applications"] = gin.H{
+			"total":     len(applications.GetApplications()),
+			"by_status": byStatus,
+		}
+	}
+	if !failed["notifications"] {
+		dashboard["notifications"] = gin.H{
+			"latest": notifications.GetNotifications(),
+			"total":  notifications.GetTotal(),
+		}
+	}
+	if !failed["messages"] {
+		dashboard["messages"] = gin.H{"unread": unread.GetCount()}
+	}
+	if !failed["recommended_jobs"] {
+		dashboard["recommended_jobs"] = gin.H{"count": recommended.GetCount()}
+	}
+	missing := make([]string, 0, len(sectionErrors))
+	for _, e := range sectionErrors {
+		missing = append(missing, e["section"].(string))
+	}
+	utils.RespondPartial(c, http.StatusOK, dashboard, missing...)
+}
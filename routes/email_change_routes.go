@@ -0,0 +1,159 @@
+package routes
+
+import (
+	"context"
+	"net/http"
+	"net/mail"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	authpb "github.com/shahal0/skillsync-protos/gen/authpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"skillsync-api-gateway/clients"
+	"skillsync-api-gateway/middlewares"
+	"skillsync-api-gateway/utils"
+)
+
+// Each user gets a few OTP emails and confirmation attempts before having to wait
+const (
+	emailChangeRequestLimit = 5
+	emailChangeConfirmLimit = 5
+	emailChangeLimitWindow  = 15 * time.Minute
+)
+
+type requestEmailChangeRPC func(ctx context.Context, in *authpb.RequestEmailChangeRequest, opts ...grpc.CallOption) (*authpb.RequestEmailChangeResponse, error)
+
+type confirmEmailChangeRPC func(ctx context.Context, in *authpb.ConfirmEmailChangeRequest, opts ...grpc.CallOption) (*authpb.ConfirmEmailChangeResponse, error)
+
+func candidateRequestEmailChange(c *gin.Context) {
+	requestEmailChange(c, clients.AuthServiceClient.CandidateRequestEmailChange)
+}
+
+func candidateConfirmEmailChange(c *gin.Context) {
+	confirmEmailChange(c, clients.AuthServiceClient.CandidateConfirmEmailChange)
+}
+
+func employerRequestEmailChange(c *gin.Context) {
+	requestEmailChange(c, clients.AuthServiceClient.EmployerRequestEmailChange)
+}
+
+func employerConfirmEmailChange(c *gin.Context) {
+	confirmEmailChange(c, clients.AuthServiceClient.EmployerConfirmEmailChange)
+}
+
+// normalizeEmail trims and lower-cases an address and checks it is a bare addr-spec
+func normalizeEmail(email string) (string, bool) {
+	email = strings.ToLower(strings.TrimSpace(email))
+	parsed, err := mail.ParseAddress(email)
+	if err != nil || parsed.Address != email || len(email) > 254 {
+		return "", false
+	}
+	return email, true
+}
+
+// requestEmailChange checks the current password and has the auth service send an
+// OTP to the new address. The login email only changes once the OTP is confirmed.
+func requestEmailChange(c *gin.Context, rpc requestEmailChangeRPC) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	var body struct {
+		NewEmail string `json:"new_email" binding:"required"`
+		Password string `json:"password" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		utils.RespondWithValidationError(c, err)
+		return
+	}
+	newEmail, ok := normalizeEmail(body.NewEmail)
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "new_email must be a valid email address"})
+		return
+	}
+
+	ctx := metadata.NewOutgoingContext(
+		c.Request.Context(),
+		metadata.New(map[string]string{"user-id": userID.(string)}),
+	)
+	resp, err := rpc(ctx, &authpb.RequestEmailChangeRequest{NewEmail: newEmail, Password: body.Password})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.Unauthenticated, codes.PermissionDenied:
+			c.JSON(http.StatusUnauthorized, gin.H{
+				"error":      "Password is incorrect",
+				"error_code": "invalid_credentials",
+			})
+		case codes.AlreadyExists:
+			c.JSON(http.StatusConflict, gin.H{
+				"error":      "That email address is already in use",
+				"error_code": "email_taken",
+			})
+		default:
+			c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to start email change: " + utils.GRPCErrorMessage(err)})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":   resp.GetMessage(),
+		"new_email": newEmail,
+	})
+}
+
+// confirmEmailChange completes the switch with the OTP sent to the new address. The
+// caller's token carries the old email, so it is revoked and the client must log in again.
+func confirmEmailChange(c *gin.Context, rpc confirmEmailChangeRPC) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	var body struct {
+		Otp string `json:"otp" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		utils.RespondWithValidationError(c, err)
+		return
+	}
+
+	ctx := metadata.NewOutgoingContext(
+		c.Request.Context(),
+		metadata.New(map[string]string{"user-id": userID.(string)}),
+	)
+	resp, err := rpc(ctx, &authpb.ConfirmEmailChangeRequest{Otp: strings.TrimSpace(body.Otp)})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.InvalidArgument, codes.Unauthenticated, codes.PermissionDenied:
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":      "The code is invalid or has expired",
+				"error_code": "invalid_otp",
+			})
+		case codes.NotFound:
+			c.JSON(http.StatusNotFound, gin.H{
+				"error":      "No email change is pending",
+				"error_code": "no_pending_change",
+			})
+		default:
+			c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to confirm email change: " + utils.GRPCErrorMessage(err)})
+		}
+		return
+	}
+
+	middlewares.RevokeCurrentToken(c)
+	utils.ClearAuthCookie(c)
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":          resp.GetMessage(),
+		"email":            resp.GetEmail(),
+		"relogin_required": true,
+	})
+}
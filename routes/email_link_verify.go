@@ -0,0 +1,107 @@
+package routes
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	authpb "github.com/shahal0/skillsync-protos/gen/authpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"skillsync-api-gateway/clients"
+)
+
+// emailHintMetadataKey is the trailer the auth service names the account in when a
+// verification token has expired, so the frontend can offer to resend it
+const emailHintMetadataKey = "email-hint"
+
+// Outcomes of a verification link, passed to the frontend as ?status=
+const (
+	verifyLinkSuccess = "success"
+	verifyLinkExpired = "expired"
+	verifyLinkInvalid = "invalid"
+	// The token couldn't be checked, e.g. the auth service is down; the link may still work later
+	verifyLinkError = "error"
+)
+
+type verifyEmailRPC func(context.Context, *authpb.VerifyEmailRequest, ...grpc.CallOption) (*authpb.VerifyEmailResponse, error)
+
+func verifyEmailRPCFor(role string) verifyEmailRPC {
+	if role == "employer" {
+		return clients.AuthServiceClient.EmployerVerifyEmail
+	}
+	return clients.AuthServiceClient.CandidateVerifyEmail
+}
+
+// verifyEmailLink handles the link in a verification email. The click comes from a
+// mail client, so instead of JSON it redirects to FRONTEND_URL/verified with the
+// outcome, and for an expired token the email to resend to when the auth service
+// names it.
+func verifyEmailLink(role string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		token := strings.TrimSpace(c.Query("token"))
+		if token == "" {
+			redirectVerified(c, role, verifyLinkInvalid, "")
+			return
+		}
+		var trailer metadata.MD
+		_, err := verifyEmailRPCFor(role)(c.Request.Context(), &authpb.VerifyEmailRequest{Token: token}, grpc.Trailer(&trailer))
+		if err == nil {
+			redirectVerified(c, role, verifyLinkSuccess, "")
+			return
+		}
+		outcome := verifyLinkOutcome(err)
+		if outcome == verifyLinkError {
+			log.Printf("Email verification link for %s could not be checked: %v", role, err)
+		}
+		var emailHint string
+		if hints := trailer.Get(emailHintMetadataKey); outcome == verifyLinkExpired && len(hints) > 0 {
+			emailHint = hints[0]
+		}
+		redirectVerified(c, role, outcome, emailHint)
+	}
+}
+
+// verifyLinkOutcome classifies a failed verification
+func verifyLinkOutcome(err error) string {
+	st := status.Convert(err)
+	switch st.Code() {
+	case codes.FailedPrecondition:
+		return verifyLinkExpired
+	case codes.InvalidArgument, codes.NotFound, codes.Unauthenticated, codes.PermissionDenied, codes.AlreadyExists:
+		if strings.Contains(strings.ToLower(st.Message()), "expired") {
+			return verifyLinkExpired
+		}
+		return verifyLinkInvalid
+	default:
+		return verifyLinkError
+	}
+}
+
+// redirectVerified sends the browser to FRONTEND_URL/verified
+func redirectVerified(c *gin.Context, role, outcome, emailHint string) {
+	query := url.Values{"status": {outcome}, "role": {role}}
+	if emailHint != "" {
+		query.Set("email", emailHint)
+	}
+	redirectFrontend(c, "/verified", query)
+}
+
+// redirectFrontend sends the browser from an emailed link to path on FRONTEND_URL
+func redirectFrontend(c *gin.Context, path string, query url.Values) {
+	target, err := url.Parse(strings.TrimRight(cfg.FrontendURL, "/") + path)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "FRONTEND_URL is invalid"})
+		return
+	}
+	target.RawQuery = query.Encode()
+	// The token is in the URL; keep it out of Referer headers on the frontend
+	c.Header("Referrer-Policy", "no-referrer")
+	c.Redirect(http.StatusFound, target.String())
+}
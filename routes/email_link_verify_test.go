@@ -0,0 +1,116 @@
+package routes
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	authpb "github.com/shahal0/skillsync-protos/gen/authpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"skillsync-api-gateway/clients"
+)
+
+func TestVerifyLinkOutcome(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{"failed precondition", status.Error(codes.FailedPrecondition, "token used"), verifyLinkExpired},
+		{"expired in the message", status.Error(codes.InvalidArgument, "Token Expired"), verifyLinkExpired},
+		{"invalid", status.Error(codes.InvalidArgument, "bad token"), verifyLinkInvalid},
+		{"not found", status.Error(codes.NotFound, "no such token"), verifyLinkInvalid},
+		{"already verified", status.Error(codes.AlreadyExists, "already verified"), verifyLinkInvalid},
+		{"unavailable", status.Error(codes.Unavailable, "connection refused"), verifyLinkError},
+		{"not a status", errors.New("boom"), verifyLinkError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := verifyLinkOutcome(tt.err); got != tt.want {
+				t.Errorf("verifyLinkOutcome() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+type fakeVerifyAuth struct {
+	authpb.AuthServiceClient
+	err     error
+	trailer metadata.MD
+	token   string
+}
+
+func (f *fakeVerifyAuth) CandidateVerifyEmail(_ context.Context, req *authpb.VerifyEmailRequest, opts ...grpc.CallOption) (*authpb.VerifyEmailResponse, error) {
+	f.token = req.GetToken()
+	for _, opt := range opts {
+		if trailer, ok := opt.(grpc.TrailerCallOption); ok {
+			*trailer.TrailerAddr = f.trailer
+		}
+	}
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &authpb.VerifyEmailResponse{}, nil
+}
+
+func TestVerifyEmailLink(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	previousAuth, previousURL := clients.AuthServiceClient, cfg.FrontendURL
+	defer func() { clients.AuthServiceClient, cfg.FrontendURL = previousAuth, previousURL }()
+	cfg.FrontendURL = "https://app.skillsync.dev/"
+
+	tests := []struct {
+		name      string
+		query     string
+		auth      *fakeVerifyAuth
+		wantQuery url.Values
+	}{
+		{"verified", "?token=t1", &fakeVerifyAuth{}, url.Values{"status": {"success"}, "role": {"candidate"}}},
+		{"no token", "?token=%20", &fakeVerifyAuth{}, url.Values{"status": {"invalid"}, "role": {"candidate"}}},
+		{
+			"expired with a hint", "?token=t1",
+			&fakeVerifyAuth{err: status.Error(codes.FailedPrecondition, "expired"), trailer: metadata.Pairs(emailHintMetadataKey, "c***@example.com")},
+			url.Values{"status": {"expired"}, "role": {"candidate"}, "email": {"c***@example.com"}},
+		},
+		{
+			"invalid ignores a hint", "?token=t1",
+			&fakeVerifyAuth{err: status.Error(codes.NotFound, "no such token"), trailer: metadata.Pairs(emailHintMetadataKey, "c***@example.com")},
+			url.Values{"status": {"invalid"}, "role": {"candidate"}},
+		},
+		{"auth service down", "?token=t1", &fakeVerifyAuth{err: status.Error(codes.Unavailable, "down")}, url.Values{"status": {"error"}, "role": {"candidate"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clients.AuthServiceClient = tt.auth
+			r := gin.New()
+			r.GET("/verify", verifyEmailLink("candidate"))
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/verify"+tt.query, nil))
+
+			if w.Code != http.StatusFound {
+				t.Fatalf("status = %d, want 302", w.Code)
+			}
+			if w.Header().Get("Referrer-Policy") != "no-referrer" {
+				t.Errorf("Referrer-Policy = %q", w.Header().Get("Referrer-Policy"))
+			}
+			location, err := url.Parse(w.Header().Get("Location"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := location.Scheme + "://" + location.Host + location.Path; got != "https://app.skillsync.dev/verified" {
+				t.Errorf("redirect to %s", got)
+			}
+			if got := location.Query(); got.Encode() != tt.wantQuery.Encode() {
+				t.Errorf("query = %v, want %v", got, tt.wantQuery)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package routes
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	jobpb "github.com/shahal0/skillsync-protos/gen/jobpb"
+
+	"skillsync-api-gateway/clients"
+	"skillsync-api-gateway/middlewares"
+	"skillsync-api-gateway/utils"
+)
+
+// getEmployerPlan returns the caller's subscription plan and what it allows
+func getEmployerPlan(c *gin.Context) {
+	employerID := c.GetString("user_id")
+	plan, err := middlewares.EmployerPlan(c.Request.Context(), employerID)
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to get your plan: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+	c.JSON(http.StatusOK, plan)
+}
+
+// activeJobCount counts the open jobs whose deadline hasn't passed
+func activeJobCount(jobs []*jobpb.Job) int {
+	now := time.Now()
+	active := 0
+	for _, job := range jobs {
+		if strings.EqualFold(job.GetStatus(), "OPEN") && !utils.DeadlinePassed(job.GetDeadline(), now) {
+			active++
+		}
+	}
+	return active
+}
+
+// withinActiveJobLimit checks that the employer's plan allows adding jobs more
+// active jobs. When it doesn't, the request is answered with 402 and the limit.
+func withinActiveJobLimit(c *gin.Context, employerID string, jobs int) bool {
+	plan, err := middlewares.EmployerPlan(c.Request.Context(), employerID)
+	if err != nil {
+		return middlewares.PlanUnavailable(c, err)
+	}
+	limit := int(plan.GetActiveJobLimit())
+	if limit <= 0 {
+		return true
+	}
+	resp, err := clients.JobServiceClient.ListEmployerJobs(jobOwnerContext(c, employerID), &jobpb.ListEmployerJobsRequest{EmployerId: employerID})
+	if err != nil {
+		return middlewares.PlanUnavailable(c, err)
+	}
+	active := activeJobCount(resp.GetJobs())
+	if active+jobs <= limit {
+		return true
+	}
+	c.AbortWithStatusJSON(http.StatusPaymentRequired, gin.H{
+		"error":            "Your plan allows " + strconv.Itoa(limit) + " active jobs, close one or upgrade to post more",
+		"error_code":       "active_job_limit_reached",
+		"current_plan":     middlewares.PlanName(plan),
+		"active_jobs":      active,
+		"active_job_limit": limit,
+		"upgrade_url":      middlewares.UpgradeURL(),
+	})
+	return false
+}
@@ -0,0 +1,240 @@
+package routes
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	authpb "github.com/shahal0/skillsync-protos/gen/authpb"
+	jobpb "github.com/shahal0/skillsync-protos/gen/jobpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"skillsync-api-gateway/clients"
+	"skillsync-api-gateway/utils"
+)
+
+type employerReviewRequest struct {
+	Rating    int32  `json:"rating" binding:"required,min=1,max=5"`
+	Text      string `json:"text" binding:"required,min=20,max=2000,no_profanity"`
+	Anonymous bool   `json:"anonymous"`
+}
+
+// candidateAppliedToEmployer reports whether the candidate applied to any of the
+// employer's jobs
+func candidateAppliedToEmployer(c *gin.Context, candidateID, employerID string) (bool, error) {
+	ctx := candidateContext(c, candidateID)
+	jobs, err := clients.JobServiceClient.ListEmployerJobs(ctx, &jobpb.ListEmployerJobsRequest{EmployerId: employerID})
+	if err != nil {
+		return false, err
+	}
+	employerJobs := make(map[uint64]bool, len(jobs.GetJobs()))
+	for _, job := range jobs.GetJobs() {
+		employerJobs[job.GetId()] = true
+	}
+	applications, err := clients.JobServiceClient.GetApplications(ctx, &jobpb.GetApplicationsRequest{CandidateId: candidateID})
+	if err != nil {
+		return false, err
+	}
+	for _, application := range applications.GetApplications() {
+		if application.GetCandidateId() == candidateID && employerJobs[application.GetJobId()] {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// publicEmployerReview is a review as anyone may see it. Anonymous reviews keep
+// the rating and text but not who wrote them.
+func publicEmployerReview(review *authpb.EmployerReview, profiles map[string]*authpb.CandidatePublicProfile) gin.H {
+	view := gin.H{
+		"id":             review.GetId(),
+		"rating":         review.GetRating(),
+		"text":           review.GetText(),
+		"anonymous":      review.GetAnonymous(),
+		"created_at":     review.GetCreatedAt(),
+		"candidate_id":   nil,
+		"candidate_name": nil,
+	}
+	if !review.GetAnonymous() {
+		view["candidate_id"] = review.GetCandidateId()
+		if profile, ok := profiles[review.GetCandidateId()]; ok {
+			view["candidate_name"] = profile.GetName()
+		}
+	}
+	return view
+}
+
+// reviewPage reads the page and limit of a review listing
+func reviewPage(c *gin.Context) (int32, int32) {
+	page, limit := int32(1), int32(20)
+	if value, err := strconv.Atoi(c.DefaultQuery("page", "1")); err == nil && value > 0 {
+		page = int32(value)
+	}
+	if value, err := strconv.Atoi(c.DefaultQuery("limit", "20")); err == nil && value > 0 && value <= 100 {
+		limit = int32(value)
+	}
+	return page, limit
+}
+
+// CreateEmployerReview lets a candidate who applied to one of the employer's jobs
+// rate the employer, once
+func CreateEmployerReview(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in context"})
+		return
+	}
+	candidateID := userID.(string)
+	employerID := c.Param("id")
+
+	var body employerReviewRequest
+	if err := c.ShouldBindJSON(&body); err != nil {
+		utils.RespondWithValidationError(c, err)
+		return
+	}
+
+	applied, err := candidateAppliedToEmployer(c, candidateID, employerID)
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to check your applications: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+	if !applied {
+		c.JSON(http.StatusForbidden, gin.H{
+			"error":      "You can only review employers you applied to",
+			"error_code": "not_applied",
+		})
+		return
+	}
+
+	resp, err := clients.AuthServiceClient.CreateEmployerReview(candidateContext(c, candidateID), &authpb.CreateEmployerReviewRequest{
+		EmployerId:  employerID,
+		CandidateId: candidateID,
+		Rating:      body.Rating,
+		Text:        strings.TrimSpace(body.Text),
+		Anonymous:   body.Anonymous,
+	})
+	if status.Code(err) == codes.AlreadyExists {
+		c.JSON(http.StatusConflict, gin.H{
+			"error":      "You already reviewed this employer",
+			"error_code": "review_exists",
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to save review: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+	// The public profile carries the rating; this instance shows the new one at once
+	employerProfileCache.Delete(employerID)
+	recordAudit(c, "employer.review", "employer:"+employerID, map[string]string{
+		"review_id": resp.GetReview().GetId(),
+		"rating":    strconv.Itoa(int(body.Rating)),
+	})
+	c.JSON(http.StatusCreated, gin.H{"review": publicEmployerReview(resp.GetReview(), nil)})
+}
+
+// GetEmployerReviews lists an employer's reviews, newest first, with their average rating
+func GetEmployerReviews(c *gin.Context) {
+	employerID := c.Param("id")
+	page, limit := reviewPage(c)
+	resp, err := clients.AuthServiceClient.ListEmployerReviews(c.Request.Context(), &authpb.ListEmployerReviewsRequest{
+		EmployerId: employerID,
+		Page:       page,
+		Limit:      limit,
+	})
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to get reviews: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+
+	seen := make(map[string]bool)
+	var candidateIDs []string
+	for _, review := range resp.GetReviews() {
+		if id := review.GetCandidateId(); !review.GetAnonymous() && id != "" && !seen[id] {
+			seen[id] = true
+			candidateIDs = append(candidateIDs, id)
+		}
+	}
+	profiles := fetchCandidateProfiles(candidateIDs)
+	reviews := make([]gin.H, 0, len(resp.GetReviews()))
+	for _, review := range resp.GetReviews() {
+		reviews = append(reviews, publicEmployerReview(review, profiles))
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"reviews":        reviews,
+		"average_rating": resp.GetAverageRating(),
+		"review_count":   resp.GetTotal(),
+		"page":           page,
+		"limit":          limit,
+	})
+}
+
+// DeleteMyEmployerReview withdraws the caller's review of the employer
+func DeleteMyEmployerReview(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in context"})
+		return
+	}
+	candidateID := userID.(string)
+	employerID := c.Param("id")
+	_, err := clients.AuthServiceClient.DeleteEmployerReview(candidateContext(c, candidateID), &authpb.DeleteEmployerReviewRequest{
+		EmployerId:  employerID,
+		CandidateId: candidateID,
+	})
+	if status.Code(err) == codes.NotFound {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Review not found"})
+		return
+	}
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to delete review: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+	employerProfileCache.Delete(employerID)
+	recordAudit(c, "employer.review_delete", "employer:"+employerID, nil)
+	c.Status(http.StatusNoContent)
+}
+
+// ListReviewsForModeration lists reviews for admins, optionally of one employer.
+// Unlike the public listing it names the author of anonymous reviews.
+func ListReviewsForModeration(c *gin.Context) {
+	page, limit := reviewPage(c)
+	resp, err := clients.AuthServiceClient.ListEmployerReviews(adminContext(c), &authpb.ListEmployerReviewsRequest{
+		EmployerId: c.Query("employer_id"),
+		Page:       page,
+		Limit:      limit,
+	})
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to get reviews: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+	reviews := resp.GetReviews()
+	if reviews == nil {
+		reviews = []*authpb.EmployerReview{}
+	}
+	c.JSON(http.StatusOK, gin.H{"reviews": reviews, "total": resp.GetTotal(), "page": page, "limit": limit})
+}
+
+// RemoveEmployerReview takes down a review that breaks the rules
+func RemoveEmployerReview(c *gin.Context) {
+	var body struct {
+		Reason string `json:"reason" binding:"required,max=500"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		utils.RespondWithValidationError(c, err)
+		return
+	}
+	resp, err := clients.AuthServiceClient.RemoveEmployerReview(adminContext(c), &authpb.RemoveEmployerReviewRequest{
+		ReviewId: c.Param("id"),
+		Reason:   strings.TrimSpace(body.Reason),
+	})
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to remove review: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+	employerProfileCache.Delete(resp.GetEmployerId())
+	recordAudit(c, "admin.review_remove", "review:"+c.Param("id"), map[string]string{"reason": strings.TrimSpace(body.Reason)})
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,223 @@
+package routes
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"skillsync-api-gateway/clients"
+	"skillsync-api-gateway/utils"
+
+	"github.com/gin-gonic/gin"
+	authpb "github.com/shahal0/skillsync-protos/gen/authpb"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	maxLogoSize      = 2 << 20 // 2 MB
+	minLogoDimension = 64
+	maxLogoDimension = 4096
+)
+
+func employerUploadLogo(c *gin.Context) {
+	// Extract user ID from context (set by JWTMiddleware)
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	fileHeader, err := c.FormFile("logo")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Logo file is required in the 'logo' form field"})
+		return
+	}
+	if fileHeader.Size > maxLogoSize {
+		c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": "Logo must be at most 2 MB"})
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(io.LimitReader(file, maxLogoSize+1))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if len(data) > maxLogoSize {
+		c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": "Logo must be at most 2 MB"})
+		return
+	}
+
+	// Only the header is decoded, which is enough to check format and size
+	info, err := utils.InspectImage(data)
+	if err != nil {
+		if errors.Is(err, utils.ErrUnsupportedImage) {
+			c.JSON(http.StatusUnsupportedMediaType, gin.H{"error": "Logo must be a PNG, JPEG or WebP image"})
+			return
+		}
+		c.JSON(http.StatusUnsupportedMediaType, gin.H{"error": "Logo image could not be read: " + err.Error()})
+		return
+	}
+	if info.Width < minLogoDimension || info.Height < minLogoDimension ||
+		info.Width > maxLogoDimension || info.Height > maxLogoDimension {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Logo dimensions must be between 64x64 and 4096x4096 pixels"})
+		return
+	}
+
+	// Create context with metadata for auth service
+	ctx := metadata.NewOutgoingContext(
+		c.Request.Context(),
+		metadata.New(map[string]string{"user-id": userID.(string)}),
+	)
+
+	// The auth service replaces any previously stored logo
+	resp, err := clients.AuthServiceClient.EmployerUploadLogo(ctx, &authpb.UploadLogoRequest{
+		Logo:        data,
+		FileName:    fileHeader.Filename,
+		ContentType: info.ContentType,
+	})
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": utils.GRPCErrorMessage(err)})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":  resp.GetMessage(),
+		"logo_url": resp.GetLogoUrl(),
+	})
+}
+
+const (
+	maxVerificationDocuments     = 5
+	verificationDocumentsFormKey = "documents"
+	// verificationMessageOverhead is the room left in the auth service request for
+	// file names, content types and framing around the documents
+	verificationMessageOverhead = 64 << 10
+)
+
+// maxVerificationTotalSize is the most document content one upload may carry. All
+// documents go to the auth service in one request, so it follows GRPC_AUTH_MAX_SEND_MB.
+func maxVerificationTotalSize() int64 {
+	return int64(cfg.Services.AuthCalls.MaxSendMB)<<20 - verificationMessageOverhead
+}
+
+// maxVerificationRequestSize caps the multipart body, with room for its framing
+func maxVerificationRequestSize() int64 {
+	return maxVerificationTotalSize() + 256<<10
+}
+
+// allowedVerificationTypes are the sniffed content types accepted as KYC documents
+var allowedVerificationTypes = map[string]bool{
+	"application/pdf": true,
+	"image/png":       true,
+	"image/jpeg":      true,
+	"image/webp":      true,
+}
+
+func employerUploadVerificationDocuments(c *gin.Context) {
+	// Extract user ID from context (set by JWTMiddleware)
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	form, err := c.MultipartForm()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Expected a multipart form: " + err.Error()})
+		return
+	}
+	files := form.File[verificationDocumentsFormKey]
+	if len(files) == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "At least one document is required in the 'documents' form field"})
+		return
+	}
+	if len(files) > maxVerificationDocuments {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "At most 5 documents can be uploaded at once"})
+		return
+	}
+
+	// Check the declared sizes before reading anything
+	var totalSize int64
+	for _, fh := range files {
+		totalSize += fh.Size
+	}
+	if limit := maxVerificationTotalSize(); totalSize > limit {
+		c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": fmt.Sprintf("Documents must be at most %.1f MB in total", float64(limit)/(1<<20))})
+		return
+	}
+
+	documents := make([]*authpb.VerificationDocument, 0, len(files))
+	for _, fh := range files {
+		file, err := fh.Open()
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		data, err := io.ReadAll(file)
+		file.Close()
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		contentType := http.DetectContentType(data)
+		if !allowedVerificationTypes[contentType] {
+			c.JSON(http.StatusUnsupportedMediaType, gin.H{"error": "Document " + fh.Filename + " must be a PDF or an image"})
+			return
+		}
+		documents = append(documents, &authpb.VerificationDocument{
+			FileName:    fh.Filename,
+			ContentType: contentType,
+			Content:     data,
+		})
+	}
+
+	// Create context with metadata for auth service
+	ctx := metadata.NewOutgoingContext(
+		c.Request.Context(),
+		metadata.New(map[string]string{"user-id": userID.(string)}),
+	)
+
+	resp, err := clients.AuthServiceClient.EmployerUploadVerificationDocuments(ctx, &authpb.UploadVerificationDocumentsRequest{
+		Documents: documents,
+	})
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": utils.GRPCErrorMessage(err)})
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+func employerVerificationStatus(c *gin.Context) {
+	// Extract user ID from context (set by JWTMiddleware)
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	// Create context with metadata for auth service
+	ctx := metadata.NewOutgoingContext(
+		c.Request.Context(),
+		metadata.New(map[string]string{"user-id": userID.(string)}),
+	)
+
+	resp, err := clients.AuthServiceClient.EmployerVerificationStatus(ctx, &authpb.VerificationStatusRequest{})
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": utils.GRPCErrorMessage(err)})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"status":         resp.GetStatus(),
+		"reviewer_notes": resp.GetReviewerNotes(),
+		"submitted_at":   resp.GetSubmittedAt(),
+		"reviewed_at":    resp.GetReviewedAt(),
+	})
+}
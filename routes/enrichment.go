@@ -0,0 +1,151 @@
+package routes
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	authpb "github.com/shahal0/skillsync-protos/gen/authpb"
+	jobpb "github.com/shahal0/skillsync-protos/gen/jobpb"
+
+	"skillsync-api-gateway/clients"
+	"skillsync-api-gateway/utils/cache"
+	"skillsync-api-gateway/utils/fanout"
+)
+
+const (
+	// profileLookupConcurrency bounds the parallel profile lookups for one page of results
+	profileLookupConcurrency = 8
+	profileLookupTimeout     = 2 * time.Second
+)
+
+// employerProfileCache holds public employer profiles shared by the public
+// profile endpoint and job enrichment
+var employerProfileCache = cache.NewTTLCache[*authpb.EmployerPublicProfileResponse](5 * time.Minute)
+
+// getEmployerPublicProfile returns the sanitized profile for an employer, using the cache when possible.
+// Concurrent misses share one call, which outlives any single caller's cancellation
+// up to the route's policy timeout.
+func getEmployerPublicProfile(ctx context.Context, employerID string) (*authpb.EmployerPublicProfileResponse, error) {
+	if profile, ok := employerProfileCache.Get(employerID); ok {
+		return profile, nil
+	}
+	return coalesce(ctx, "employer_public_profile", "employer|"+employerID, func(ctx context.Context) (*authpb.EmployerPublicProfileResponse, error) {
+		profile, err := clients.AuthServiceClient.GetEmployerPublicProfile(ctx, &authpb.EmployerPublicProfileRequest{
+			EmployerId: employerID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		employerProfileCache.Set(employerID, profile)
+		return profile, nil
+	})
+}
+
+// candidateProfileCache holds public candidate profiles used to enrich employer-facing lists
+var candidateProfileCache = cache.NewTTLCache[*authpb.CandidatePublicProfile](5 * time.Minute)
+
+// getCandidatePublicProfile returns the public profile for a candidate, using the cache when possible
+func getCandidatePublicProfile(ctx context.Context, candidateID string) (*authpb.CandidatePublicProfile, error) {
+	if profile, ok := candidateProfileCache.Get(candidateID); ok {
+		return profile, nil
+	}
+	profile, err := clients.AuthServiceClient.GetCandidatePublicProfile(ctx, &authpb.CandidatePublicProfileRequest{
+		CandidateId: candidateID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	candidateProfileCache.Set(candidateID, profile)
+	return profile, nil
+}
+
+// fetchEmployerProfiles looks up the distinct employers concurrently. Employers
+// whose lookup fails are simply missing from the result.
+func fetchEmployerProfiles(employerIDs []string) map[string]*authpb.EmployerPublicProfileResponse {
+	return fetchProfiles(employerIDs, "employer", getEmployerPublicProfile)
+}
+
+// fetchCandidateProfiles looks up public candidate profiles concurrently,
+// skipping candidates whose lookup fails
+func fetchCandidateProfiles(candidateIDs []string) map[string]*authpb.CandidatePublicProfile {
+	return fetchProfiles(candidateIDs, "candidate", getCandidatePublicProfile)
+}
+
+// fetchProfiles runs lookup for every ID with bounded concurrency under a shared timeout
+func fetchProfiles[P any](ids []string, kind string, lookup func(context.Context, string) (P, error)) map[string]P {
+	ctx, cancel := context.WithTimeout(context.Background(), profileLookupTimeout)
+	defer cancel()
+
+	results := fanout.RunWith(ctx, ids, profileLookupConcurrency, lookup, fanout.Options{Limiter: fanout.Backend("auth")})
+	profiles := make(map[string]P, len(ids))
+	for i, result := range results {
+		if result.Err != nil {
+			log.Printf("Failed to fetch public profile for %s %s: %v", kind, ids[i], result.Err)
+			continue
+		}
+		profiles[ids[i]] = result.Value
+	}
+	return profiles
+}
+
+// toMap converts a response into a generic JSON object so the gateway can add fields to it
+func toMap(v interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// enrichJobs embeds company_name and company_logo on every job. Jobs whose
+// employer couldn't be looked up keep those fields as null, and complete is false.
+func enrichJobs(jobs []*jobpb.Job) (enriched []map[string]interface{}, complete bool) {
+	seen := make(map[string]bool)
+	var employerIDs []string
+	for _, job := range jobs {
+		if id := job.GetEmployerId(); id != "" && !seen[id] {
+			seen[id] = true
+			employerIDs = append(employerIDs, id)
+		}
+	}
+	profiles := fetchEmployerProfiles(employerIDs)
+
+	enriched = make([]map[string]interface{}, 0, len(jobs))
+	for _, job := range jobs {
+		m, err := toMap(job)
+		if err != nil {
+			log.Printf("Failed to convert job %d for enrichment: %v", job.GetId(), err)
+			continue
+		}
+		m["company_name"] = nil
+		m["company_logo"] = nil
+		if profile, ok := profiles[job.GetEmployerId()]; ok {
+			m["company_name"] = profile.GetCompanyName()
+			m["company_logo"] = profile.GetLogoUrl()
+		}
+		enriched = append(enriched, m)
+	}
+	return enriched, len(profiles) == len(employerIDs)
+}
+
+// publicEmployerProfile is the allowlisted view of an employer that anyone may see
+func publicEmployerProfile(profile *authpb.EmployerPublicProfileResponse) gin.H {
+	return gin.H{
+		"id":             profile.GetEmployerId(),
+		"company_name":   profile.GetCompanyName(),
+		"logo_url":       profile.GetLogoUrl(),
+		"website":        profile.GetWebsite(),
+		"industry":       profile.GetIndustry(),
+		"location":       profile.GetLocation(),
+		"is_verified":    profile.GetIsVerified(),
+		"average_rating": profile.GetAverageRating(),
+		"review_count":   profile.GetReviewCount(),
+	}
+}
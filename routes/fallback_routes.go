@@ -0,0 +1,109 @@
+package routes
+
+import (
+	"log"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"skillsync-api-gateway/config"
+)
+
+// SetupFallbackRoutes answers unknown paths and wrong methods with JSON instead of
+// gin's plain-text pages, and registers GET /debug/routes when ENABLE_DOCS is set or
+// gin runs in debug mode. Call it after every other Setup function.
+func SetupFallbackRoutes(r *gin.Engine) {
+	r.HandleMethodNotAllowed = true
+	r.NoRoute(func(c *gin.Context) {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error":      "No route for " + c.Request.Method + " " + c.Request.URL.Path,
+			"error_code": "not_found",
+		})
+	})
+	r.NoMethod(func(c *gin.Context) {
+		allowed := allowedMethods(r.Routes(), c.Request.URL.Path)
+		c.Header("Allow", strings.Join(allowed, ", "))
+		c.JSON(http.StatusMethodNotAllowed, gin.H{
+			"error":      "Method " + c.Request.Method + " is not allowed for " + c.Request.URL.Path,
+			"error_code": "method_not_allowed",
+			"allowed":    allowed,
+		})
+	})
+
+	if gin.IsDebugging() {
+		logRoutePolicies(r.Routes())
+	}
+	if cfg.EnableDocs || gin.IsDebugging() {
+		r.GET("/debug/routes", func(c *gin.Context) {
+			c.JSON(http.StatusOK, gin.H{"routes": routeTable(r.Routes())})
+		})
+	}
+}
+
+// allowedMethods lists the methods registered for any route template matching path
+func allowedMethods(routes gin.RoutesInfo, path string) []string {
+	seen := map[string]bool{}
+	var methods []string
+	for _, route := range routes {
+		if !seen[route.Method] && routeMatches(route.Path, path) {
+			seen[route.Method] = true
+			methods = append(methods, route.Method)
+		}
+	}
+	sort.Strings(methods)
+	return methods
+}
+
+// routeMatches compares a gin route template with a concrete path
+func routeMatches(template, path string) bool {
+	want := strings.Split(strings.Trim(template, "/"), "/")
+	got := strings.Split(strings.Trim(path, "/"), "/")
+	for i, segment := range want {
+		if strings.HasPrefix(segment, "*") {
+			return true
+		}
+		if i >= len(got) || (!strings.HasPrefix(segment, ":") && segment != got[i]) {
+			return false
+		}
+	}
+	return len(want) == len(got)
+}
+
+// routeTable is the registered routes sorted by path, then method
+func routeTable(routes gin.RoutesInfo) []gin.H {
+	sorted := append(gin.RoutesInfo(nil), routes...)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Path != sorted[j].Path {
+			return sorted[i].Path < sorted[j].Path
+		}
+		return sorted[i].Method < sorted[j].Method
+	})
+	table := make([]gin.H, 0, len(sorted))
+	for _, route := range sorted {
+		policy, source := cfg.Policies.For(config.PolicyName(route.Path))
+		table = append(table, gin.H{
+			"method":  route.Method,
+			"path":    route.Path,
+			"handler": route.Handler,
+			"policy": gin.H{
+				"timeout":     policy.Timeout.String(),
+				"max_retries": policy.MaxRetries,
+				"retry_on":    policy.RetryOn,
+				"source":      source,
+			},
+		})
+	}
+	return table
+}
+
+// logRoutePolicies prints the upstream policy each route ends up with, so an
+// override that matches nothing or too much shows at startup
+func logRoutePolicies(routes gin.RoutesInfo) {
+	for _, route := range routeTable(routes) {
+		policy := route["policy"].(gin.H)
+		log.Printf("[policy] %-6s %-60s timeout=%s retries=%d retry_on=%v (%s)",
+			route["method"], route["path"], policy["timeout"], policy["max_retries"], policy["retry_on"], policy["source"])
+	}
+}
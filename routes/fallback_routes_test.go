@@ -0,0 +1,91 @@
+package routes
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestRouteMatches(t *testing.T) {
+	tests := []struct {
+		template string
+		path     string
+		want     bool
+	}{
+		{"/jobs", "/jobs", true},
+		{"/jobs/", "/jobs", true},
+		{"/jobs/:id", "/jobs/42", true},
+		{"/jobs/:id", "/jobs", false},
+		{"/jobs/:id", "/jobs/42/apply", false},
+		{"/jobs/post", "/jobs/42", false},
+		{"/static/*filepath", "/static/css/app.css", true},
+		{"/static/*filepath", "/static", true},
+		{"/", "/", true},
+		{"/", "/jobs", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.template+" "+tt.path, func(t *testing.T) {
+			if got := routeMatches(tt.template, tt.path); got != tt.want {
+				t.Errorf("routeMatches(%q, %q) = %v, want %v", tt.template, tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFallbackRoutes(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	ok := func(c *gin.Context) { c.Status(http.StatusNoContent) }
+	r.GET("/jobs/:id", ok)
+	r.PUT("/jobs/:id", ok)
+	r.DELETE("/jobs/:id", ok)
+	r.POST("/jobs/post", ok)
+	SetupFallbackRoutes(r)
+
+	tests := []struct {
+		name        string
+		method      string
+		path        string
+		wantStatus  int
+		wantCode    string
+		wantAllowed []string
+	}{
+		{"registered", http.MethodGet, "/jobs/1", http.StatusNoContent, "", nil},
+		{"unknown path", http.MethodGet, "/nowhere", http.StatusNotFound, "not_found", nil},
+		{"wrong method", http.MethodPost, "/jobs/1", http.StatusMethodNotAllowed, "method_not_allowed", []string{"DELETE", "GET", "PUT"}},
+		{"wrong method on paths of two routes", http.MethodPatch, "/jobs/post", http.StatusMethodNotAllowed, "method_not_allowed", []string{"DELETE", "GET", "POST", "PUT"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, httptest.NewRequest(tt.method, tt.path, nil))
+			if w.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", w.Code, tt.wantStatus)
+			}
+			if tt.wantCode == "" {
+				return
+			}
+			var body struct {
+				ErrorCode string   `json:"error_code"`
+				Allowed   []string `json:"allowed"`
+			}
+			if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+				t.Fatalf("body %s is not JSON: %v", w.Body, err)
+			}
+			if body.ErrorCode != tt.wantCode {
+				t.Errorf("error_code = %q, want %q", body.ErrorCode, tt.wantCode)
+			}
+			if !reflect.DeepEqual(body.Allowed, tt.wantAllowed) {
+				t.Errorf("allowed = %v, want %v", body.Allowed, tt.wantAllowed)
+			}
+			if got, want := w.Header().Get("Allow"), strings.Join(tt.wantAllowed, ", "); got != want {
+				t.Errorf("Allow = %q, want %q", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package routes
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"skillsync-api-gateway/utils"
+	"skillsync-api-gateway/utils/flags"
+)
+
+func ListFeatureFlags(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{"flags": flags.List()})
+}
+
+// UpdateFeatureFlag creates a flag or changes its rollout; it applies immediately
+// but, like maintenance flags, only on this gateway instance until restart
+func UpdateFeatureFlag(c *gin.Context) {
+	var body struct {
+		Rollout *int `json:"rollout_percent" binding:"required,min=0,max=100"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		utils.RespondWithValidationError(c, err)
+		return
+	}
+
+	flag, err := flags.Set(c.Param("name"), *body.Rollout, c.GetString("user_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	recordAudit(c, "admin.feature_flag_update", "feature_flag:"+c.Param("name"), map[string]string{"rollout_percent": strconv.Itoa(*body.Rollout)})
+	c.JSON(http.StatusOK, flag)
+}
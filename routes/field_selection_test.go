@@ -0,0 +1,90 @@
+package routes
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	jobpb "github.com/shahal0/skillsync-protos/gen/jobpb"
+	"google.golang.org/grpc"
+
+	"skillsync-api-gateway/clients"
+)
+
+// fakeJobsPage answers GetJobs with a page of n jobs carrying every field a listing has
+type fakeJobsPage struct {
+	jobpb.JobServiceClient
+	n int
+}
+
+func (f fakeJobsPage) GetJobs(context.Context, *jobpb.GetJobsRequest, ...grpc.CallOption) (*jobpb.GetJobsResponse, error) {
+	resp := &jobpb.GetJobsResponse{Total: int32(f.n)}
+	for i := 1; i <= f.n; i++ {
+		resp.Jobs = append(resp.Jobs, &jobpb.Job{
+			Id:          uint64(i),
+			Title:       fmt.Sprintf("Backend engineer %d", i),
+			Description: "Build and run the services behind our hiring platform. You'll own APIs end to end, review code and mentor engineers.",
+			Category:    "Engineering",
+			RequiredSkills: []*jobpb.JobSkill{
+				{JobId: fmt.Sprint(i), Skill: "Go", Proficiency: "Expert"},
+				{JobId: fmt.Sprint(i), Skill: "PostgreSQL", Proficiency: "Intermediate"},
+			},
+			SalaryMin:          60000,
+			SalaryMax:          90000,
+			Location:           "Bengaluru",
+			ExperienceRequired: 3,
+			Status:             "OPEN",
+			CompanyDetails:     &jobpb.CompanyDetails{},
+			Deadline:           "2026-12-31T00:00:00Z",
+			UpdatedAt:          "2026-10-01T09:30:00Z",
+			CreatedAt:          "2026-09-15T09:30:00Z",
+		})
+	}
+	return resp, nil
+}
+
+// BenchmarkJobFieldSelection compares a 100-job page of GET /jobs/ in full with
+// the same page cut down by ?fields= to what a job card shows. resp-bytes is the
+// body's size.
+func BenchmarkJobFieldSelection(b *testing.B) {
+	gin.SetMode(gin.TestMode)
+	previous := clients.JobServiceClient
+	clients.JobServiceClient = fakeJobsPage{n: 100}
+	b.Cleanup(func() {
+		clients.JobServiceClient = previous
+		invalidateJobCaches()
+	})
+	invalidateJobCaches()
+	r := gin.New()
+	r.GET("/jobs/", GetJobs)
+	serve := func(b *testing.B, query string) int {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/jobs/"+query, nil))
+		if w.Code != http.StatusOK {
+			b.Fatalf("status = %d (%s), want 200", w.Code, w.Body)
+		}
+		return w.Body.Len()
+	}
+	full := serve(b, "")
+
+	for _, bb := range []struct {
+		name  string
+		query string
+	}{
+		{"all fields", ""},
+		{"job card fields", "?fields=id,title,location,salary_min,salary_max,company_name"},
+	} {
+		b.Run(bb.name, func(b *testing.B) {
+			var size int
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				size = serve(b, bb.query)
+			}
+			b.ReportMetric(float64(size), "resp-bytes")
+			b.ReportMetric(float64(size)/float64(full), "of-full")
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package routes
+
+import (
+	"context"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	authpb "github.com/shahal0/skillsync-protos/gen/authpb"
+
+	"skillsync-api-gateway/graph"
+	"skillsync-api-gateway/middlewares"
+)
+
+const (
+	graphQLRateLimit  = 120
+	graphQLRateWindow = time.Minute
+)
+
+// graphQLResolver looks employers up through the same cache as the REST enrichment
+var graphQLResolver = &graph.Resolver{
+	EmployerProfiles: func(_ context.Context, ids []string) map[string]*authpb.EmployerPublicProfileResponse {
+		return fetchEmployerProfiles(ids)
+	},
+}
+
+func SetupGraphQLRoutes(r *gin.Engine) {
+	handler := graph.Handler(graphQLResolver)
+	serve := func(c *gin.Context) {
+		ctx := graphQLResolver.WithRequest(c.Request.Context(), graph.Viewer{
+			ID:   c.GetString("user_id"),
+			Role: c.GetString("user_role"),
+		})
+		handler.ServeHTTP(c.Writer, c.Request.WithContext(ctx))
+	}
+
+	gql := r.Group("/graphql")
+	gql.Use(middlewares.JWTMiddleware(), middlewares.RateLimitPerUser(graphQLRateLimit, graphQLRateWindow))
+	{
+		gql.GET("", serve)
+		gql.POST("", serve)
+	}
+}
@@ -0,0 +1,250 @@
+package routes
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	authpb "github.com/shahal0/skillsync-protos/gen/authpb"
+	jobpb "github.com/shahal0/skillsync-protos/gen/jobpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"skillsync-api-gateway/clients"
+	"skillsync-api-gateway/middlewares"
+)
+
+const (
+	maxGRPCWebMessageSize = 4 << 20
+	grpcWebCallTimeout    = 30 * time.Second
+
+	grpcWebTrailerFlag  = 0x80
+	grpcWebCompressFlag = 0x01
+)
+
+// grpcWebPublicMethods can be called without a token, mirroring the public REST routes
+var grpcWebPublicMethods = map[string]bool{
+	"CandidateSignup":         true,
+	"CandidateLogin":          true,
+	"CandidateVerifyEmail":    true,
+	"CandidateResendOtp":      true,
+	"CandidateForgotPassword": true,
+	"CandidateResetPassword":  true,
+	"EmployerSignup":          true,
+	"EmployerLogin":           true,
+	"EmployerVerifyEmail":     true,
+	"EmployerResendOtp":       true,
+	"EmployerForgotPassword":  true,
+	"EmployerResetPassword":   true,
+	"GetJobs":                 true,
+	"GetJobById":              true,
+}
+
+// grpcWebInternalMethods are only ever called by the gateway itself, because they
+// handle secrets the gateway generates or hashes, or rely on gateway-side rate
+// limits and token revocation, and are never exposed to browsers
+var grpcWebInternalMethods = map[string]bool{
+	"GetApiKeyByHash":               true,
+	"CreateApiKey":                  true,
+	"ListApiKeys":                   true,
+	"RevokeApiKey":                  true,
+	"CreateWebhook":                 true,
+	"ListWebhooks":                  true,
+	"CandidateRequestEmailChange":   true,
+	"CandidateConfirmEmailChange":   true,
+	"EmployerRequestEmailChange":    true,
+	"EmployerConfirmEmailChange":    true,
+	"CandidateVerifyTwoFactorLogin": true,
+	"EmployerVerifyTwoFactorLogin":  true,
+	"CandidateRevokeSession":        true,
+	"EmployerRevokeSession":         true,
+}
+
+// grpcWebCaptchaMethods need a CAPTCHA on their REST routes, which a protobuf body
+// can't carry, so they are refused here while CAPTCHA_PROVIDER is set
+var grpcWebCaptchaMethods = map[string]bool{
+	"CandidateSignup":         true,
+	"CandidateResendOtp":      true,
+	"CandidateForgotPassword": true,
+	"EmployerSignup":          true,
+	"EmployerResendOtp":       true,
+	"EmployerForgotPassword":  true,
+}
+
+// grpcWebBackend maps a fully qualified service name to its backend connection
+func grpcWebBackend(service string) *grpc.ClientConn {
+	switch service {
+	case authpb.AuthService_ServiceDesc.ServiceName:
+		return clients.AuthConn
+	case jobpb.JobService_ServiceDesc.ServiceName:
+		return clients.JobConn
+	}
+	return nil
+}
+
+// grpcWebMaintenanceName maps a service to its maintenance flag name
+func grpcWebMaintenanceName(service string) string {
+	switch service {
+	case authpb.AuthService_ServiceDesc.ServiceName:
+		return "auth"
+	case jobpb.JobService_ServiceDesc.ServiceName:
+		return "job"
+	}
+	return ""
+}
+
+// rawCodec passes already-encoded protobuf bytes straight through to the backend
+type rawCodec struct{}
+
+func (rawCodec) Marshal(v interface{}) ([]byte, error) {
+	b, ok := v.(*[]byte)
+	if !ok {
+		return nil, fmt.Errorf("rawCodec: unexpected type %T", v)
+	}
+	return *b, nil
+}
+
+func (rawCodec) Unmarshal(data []byte, v interface{}) error {
+	b, ok := v.(*[]byte)
+	if !ok {
+		return fmt.Errorf("rawCodec: unexpected type %T", v)
+	}
+	*b = append((*b)[:0], data...)
+	return nil
+}
+
+func (rawCodec) Name() string { return "proto" }
+
+func SetupGRPCWebRoutes(r *gin.Engine) {
+	grpcWeb := r.Group("/grpc")
+	grpcWeb.Use(middlewares.NoCompression(), grpcWebAuth())
+	{
+		grpcWeb.POST("/:service/:method", GRPCWebProxy)
+	}
+}
+
+// grpcWebAuth runs the JWT middleware before translation, except for public methods
+// called without a token
+func grpcWebAuth() gin.HandlerFunc {
+	jwtMiddleware := middlewares.JWTMiddleware()
+	return func(c *gin.Context) {
+		if grpcWebPublicMethods[c.Param("method")] && c.GetHeader("Authorization") == "" {
+			c.Next()
+			return
+		}
+		jwtMiddleware(c)
+	}
+}
+
+// GRPCWebProxy translates a unary gRPC-Web call into a native gRPC call on the backend
+func GRPCWebProxy(c *gin.Context) {
+	contentType := c.GetHeader("Content-Type")
+	if !strings.HasPrefix(contentType, "application/grpc-web") {
+		c.JSON(http.StatusUnsupportedMediaType, gin.H{"error": "Content-Type must be application/grpc-web"})
+		return
+	}
+	textMode := strings.HasPrefix(contentType, "application/grpc-web-text")
+
+	service, method := c.Param("service"), c.Param("method")
+	if flag, ok := middlewares.InMaintenance(grpcWebMaintenanceName(service)); ok {
+		middlewares.AbortForMaintenance(c, flag)
+		return
+	}
+	conn := grpcWebBackend(service)
+	if conn == nil || grpcWebInternalMethods[method] {
+		writeGRPCWebError(c, textMode, status.Error(codes.Unimplemented, "unknown service or method "+service+"/"+method))
+		return
+	}
+	if grpcWebCaptchaMethods[method] && middlewares.CaptchaEnabled() {
+		writeGRPCWebError(c, textMode, status.Error(codes.PermissionDenied, method+" requires a CAPTCHA; use the REST route"))
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, maxGRPCWebMessageSize))
+	if err != nil {
+		writeGRPCWebError(c, textMode, status.Error(codes.ResourceExhausted, "request too large"))
+		return
+	}
+	if textMode {
+		if body, err = base64.StdEncoding.DecodeString(string(body)); err != nil {
+			writeGRPCWebError(c, textMode, status.Error(codes.InvalidArgument, "invalid base64 body"))
+			return
+		}
+	}
+	if len(body) < 5 {
+		writeGRPCWebError(c, textMode, status.Error(codes.InvalidArgument, "missing gRPC-Web frame"))
+		return
+	}
+	if body[0]&grpcWebCompressFlag != 0 {
+		writeGRPCWebError(c, textMode, status.Error(codes.Unimplemented, "compressed messages are not supported"))
+		return
+	}
+	length := binary.BigEndian.Uint32(body[1:5])
+	if int(length) != len(body)-5 {
+		writeGRPCWebError(c, textMode, status.Error(codes.InvalidArgument, "malformed gRPC-Web frame"))
+		return
+	}
+	request := body[5:]
+
+	// Only identity derived from the JWT is forwarded, never client-supplied metadata
+	md := metadata.MD{}
+	if userID := c.GetString("user_id"); userID != "" {
+		md.Set("user-id", userID)
+	}
+	if role := c.GetString("user_role"); role != "" {
+		md.Set("role", role)
+	}
+	ctx, cancel := context.WithTimeout(metadata.NewOutgoingContext(c.Request.Context(), md), grpcWebCallTimeout)
+	defer cancel()
+
+	var response []byte
+	err = conn.Invoke(ctx, "/"+service+"/"+method, &request, &response, grpc.ForceCodec(rawCodec{}))
+	if err != nil {
+		writeGRPCWebError(c, textMode, err)
+		return
+	}
+
+	var out bytes.Buffer
+	writeGRPCWebFrame(&out, 0, response)
+	writeGRPCWebFrame(&out, grpcWebTrailerFlag, []byte("grpc-status: 0\r\ngrpc-message: \r\n"))
+	writeGRPCWebBody(c, textMode, out.Bytes())
+}
+
+func writeGRPCWebFrame(out *bytes.Buffer, flag byte, payload []byte) {
+	var header [5]byte
+	header[0] = flag
+	binary.BigEndian.PutUint32(header[1:], uint32(len(payload)))
+	out.Write(header[:])
+	out.Write(payload)
+}
+
+// writeGRPCWebError sends a trailers-only response carrying the gRPC status
+func writeGRPCWebError(c *gin.Context, textMode bool, err error) {
+	st := status.Convert(err)
+	code := fmt.Sprintf("%d", st.Code())
+	message := url.PathEscape(st.Message())
+	c.Header("grpc-status", code)
+	c.Header("grpc-message", message)
+
+	var out bytes.Buffer
+	writeGRPCWebFrame(&out, grpcWebTrailerFlag, []byte("grpc-status: "+code+"\r\ngrpc-message: "+message+"\r\n"))
+	writeGRPCWebBody(c, textMode, out.Bytes())
+}
+
+func writeGRPCWebBody(c *gin.Context, textMode bool, payload []byte) {
+	if textMode {
+		c.Data(http.StatusOK, "application/grpc-web-text+proto", []byte(base64.StdEncoding.EncodeToString(payload)))
+		return
+	}
+	c.Data(http.StatusOK, "application/grpc-web+proto", payload)
+}
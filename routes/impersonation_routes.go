@@ -0,0 +1,89 @@
+package routes
+
+import (
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"skillsync-api-gateway/middlewares"
+	"skillsync-api-gateway/utils"
+)
+
+// StartImpersonation mints a token support can use to act as a candidate or
+// employer while reproducing their issue. It lasts middlewares.ImpersonationTTL;
+// requests made with it record the admin too, and the routes that could lock the
+// user out of their account refuse it.
+func StartImpersonation(c *gin.Context) {
+	var body struct {
+		UserID string `json:"user_id" binding:"required,max=64"`
+		Role   string `json:"role" binding:"required,oneof=candidate employer"`
+		Reason string `json:"reason" binding:"required,max=500"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		utils.RespondWithValidationError(c, err)
+		return
+	}
+	userID := strings.TrimSpace(body.UserID)
+
+	// Only existing users can be impersonated
+	var err error
+	if body.Role == "candidate" {
+		_, err = getCandidatePublicProfile(c.Request.Context(), userID)
+	} else {
+		_, err = getEmployerPublicProfile(c.Request.Context(), userID)
+	}
+	if status.Code(err) == codes.NotFound {
+		c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+		return
+	}
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to look up user: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+
+	adminID := c.GetString("user_id")
+	token, expiresAt, err := middlewares.MintImpersonationToken(adminID, userID, body.Role)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create impersonation token"})
+		return
+	}
+	log.Printf("Admin %s started impersonating %s %s", adminID, body.Role, userID)
+	recordAudit(c, "admin.impersonate_start", body.Role+":"+userID, map[string]string{
+		"reason":     body.Reason,
+		"expires_at": expiresAt.UTC().Format(time.RFC3339),
+	})
+	c.JSON(http.StatusCreated, gin.H{
+		"access_token": token,
+		"token_type":   "Bearer",
+		"expires_at":   expiresAt.UTC().Format(time.RFC3339),
+		"user_id":      userID,
+		"role":         body.Role,
+	})
+}
+
+// StopImpersonation revokes an impersonation token before it expires
+func StopImpersonation(c *gin.Context) {
+	var body struct {
+		Token string `json:"token" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		utils.RespondWithValidationError(c, err)
+		return
+	}
+	impersonation, err := middlewares.ParseImpersonationToken(body.Token)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid impersonation token"})
+		return
+	}
+	middlewares.BlacklistToken(body.Token, impersonation.ExpiresAt)
+	log.Printf("Admin %s ended the impersonation of %s started by %s", c.GetString("user_id"), impersonation.UserID, impersonation.ImpersonatorID)
+	recordAudit(c, "admin.impersonate_stop", "user:"+impersonation.UserID, map[string]string{
+		"impersonator_id": impersonation.ImpersonatorID,
+	})
+	c.Status(http.StatusNoContent)
+}
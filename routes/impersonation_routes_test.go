@@ -0,0 +1,51 @@
+package routes
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"skillsync-api-gateway/middlewares"
+)
+
+// TestImpersonationLockoutRoutes checks support acting as a user can't take over
+// or lock them out of their account on any of the routes that could
+func TestImpersonationLockoutRoutes(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	SetupRoutes(r)
+
+	tests := []struct {
+		method string
+		path   string
+	}{
+		{http.MethodPatch, "/change-password"},
+		{http.MethodDelete, "/account"},
+		{http.MethodPost, "/change-email"},
+		{http.MethodPost, "/2fa/setup"},
+		{http.MethodPost, "/2fa/enable"},
+		{http.MethodPost, "/2fa/disable"},
+		{http.MethodDelete, "/sessions/s1"},
+	}
+	for _, role := range []string{"candidate", "employer"} {
+		token, _, err := middlewares.MintImpersonationToken("admin-1", role[:1]+"1", role)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, tt := range tests {
+			t.Run(role+" "+tt.method+" "+tt.path, func(t *testing.T) {
+				req := httptest.NewRequest(tt.method, "/auth/"+role+tt.path, strings.NewReader(`{}`))
+				req.Header.Set("Authorization", "Bearer "+token)
+				req.Header.Set("Content-Type", "application/json")
+				w := httptest.NewRecorder()
+				r.ServeHTTP(w, req)
+				if w.Code != http.StatusForbidden || !strings.Contains(w.Body.String(), `"error_code":"impersonation_forbidden"`) {
+					t.Errorf("status = %d (%s), want 403 impersonation_forbidden", w.Code, w.Body)
+				}
+			})
+		}
+	}
+}
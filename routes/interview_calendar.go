@@ -0,0 +1,297 @@
+package routes
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	authpb "github.com/shahal0/skillsync-protos/gen/authpb"
+	jobpb "github.com/shahal0/skillsync-protos/gen/jobpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"skillsync-api-gateway/clients"
+	"skillsync-api-gateway/utils"
+	"skillsync-api-gateway/utils/ical"
+	"skillsync-api-gateway/utils/notifier"
+)
+
+const (
+	// defaultInterviewDuration is used for interviews scheduled without a duration
+	defaultInterviewDuration = time.Hour
+	// calendarFeedMaxAge is how long calendar apps may cache the feed
+	calendarFeedMaxAge = 5 * time.Minute
+)
+
+var errInvalidFeedToken = errors.New("invalid calendar feed token")
+
+// calendarFeedToken is who a feed URL was issued to. Generation is bumped by the
+// auth service each time the user rotates or revokes their feed token, so older
+// URLs stop working.
+type calendarFeedToken struct {
+	UserID     string
+	Role       string
+	Generation int64
+}
+
+// signCalendarFeedToken encodes the token with an HMAC keyed with CALENDAR_FEED_SECRET.
+// Calendar apps can't send headers, so the token travels in the feed URL.
+func signCalendarFeedToken(token calendarFeedToken) string {
+	payload := token.UserID + "\n" + token.Role + "\n" + strconv.FormatInt(token.Generation, 10)
+	mac := hmac.New(sha256.New, []byte(cfg.CalendarFeedSecret))
+	mac.Write([]byte(payload))
+	return base64.RawURLEncoding.EncodeToString([]byte(payload)) + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// parseCalendarFeedToken checks a token's signature and decodes it. Whether its
+// generation is still current is up to the caller.
+func parseCalendarFeedToken(raw string) (calendarFeedToken, error) {
+	encodedPayload, encodedMAC, ok := strings.Cut(raw, ".")
+	if !ok {
+		return calendarFeedToken{}, errInvalidFeedToken
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil {
+		return calendarFeedToken{}, errInvalidFeedToken
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(encodedMAC)
+	if err != nil {
+		return calendarFeedToken{}, errInvalidFeedToken
+	}
+	mac := hmac.New(sha256.New, []byte(cfg.CalendarFeedSecret))
+	mac.Write(payload)
+	if !hmac.Equal(signature, mac.Sum(nil)) {
+		return calendarFeedToken{}, errInvalidFeedToken
+	}
+	fields := strings.Split(string(payload), "\n")
+	if len(fields) != 3 || fields[0] == "" {
+		return calendarFeedToken{}, errInvalidFeedToken
+	}
+	generation, err := strconv.ParseInt(fields[2], 10, 64)
+	if err != nil || generation <= 0 {
+		return calendarFeedToken{}, errInvalidFeedToken
+	}
+	return calendarFeedToken{UserID: fields[0], Role: fields[1], Generation: generation}, nil
+}
+
+// calendarUserContext forwards the feed owner's identity to the backends
+func calendarUserContext(ctx context.Context, userID, role string) context.Context {
+	return metadata.NewOutgoingContext(ctx, metadata.New(map[string]string{
+		"user-id": userID,
+		"role":    role,
+	}))
+}
+
+// respondCalendarFeedDisabled answers feed routes while CALENDAR_FEED_SECRET is unset
+func respondCalendarFeedDisabled(c *gin.Context) {
+	c.JSON(http.StatusNotImplemented, gin.H{"error": "Calendar feeds are not enabled", "error_code": "calendar_feed_disabled"})
+}
+
+// interviewEvent turns an interview into a calendar event. The UID only depends on
+// the interview's ID and the sequence on when it last changed, so a rescheduled
+// interview replaces its event instead of adding a second one.
+func interviewEvent(interview *jobpb.Interview) (ical.Event, bool) {
+	start, err := time.Parse(time.RFC3339, interview.GetScheduledAt())
+	if err != nil {
+		return ical.Event{}, false
+	}
+	duration := time.Duration(interview.GetDurationMinutes()) * time.Minute
+	if duration <= 0 {
+		duration = defaultInterviewDuration
+	}
+	stamp := parseFeedTime(interview.GetUpdatedAt())
+	if stamp.IsZero() {
+		stamp = parseFeedTime(interview.GetCreatedAt())
+	}
+	if stamp.IsZero() {
+		// Without a change time the event can't be versioned; now at least orders it
+		// after anything sent before
+		stamp = time.Now()
+	}
+
+	host := "skillsync"
+	if parsed, err := url.Parse(publicBaseURL()); err == nil && parsed.Hostname() != "" {
+		host = parsed.Hostname()
+	}
+	event := ical.Event{
+		UID:       "interview-" + strconv.FormatUint(interview.GetId(), 10) + "@" + host,
+		Sequence:  stamp.Unix(),
+		Stamp:     stamp,
+		Start:     start,
+		Duration:  duration,
+		Summary:   "Interview",
+		Cancelled: strings.EqualFold(interview.GetStatus(), "CANCELLED"),
+	}
+	if title := strings.TrimSpace(interview.GetJobTitle()); title != "" {
+		event.Summary = "Interview: " + title
+	}
+	if interview.GetMode() == "online" {
+		event.Description = "Online interview: " + interview.GetMeetingLink()
+		event.Location = interview.GetMeetingLink()
+		event.URL = interview.GetMeetingLink()
+	} else {
+		event.Description = "Onsite interview at " + interview.GetLocation()
+		event.Location = interview.GetLocation()
+	}
+	return event, true
+}
+
+// interviewInvite is the .ics attached to an interview's notification email
+func interviewInvite(interview *jobpb.Interview) []notifier.Attachment {
+	event, ok := interviewEvent(interview)
+	if !ok {
+		log.Printf("Interview %d has an unreadable time %q, sending its notification without an invite",
+			interview.GetId(), interview.GetScheduledAt())
+		return nil
+	}
+	return []notifier.Attachment{{
+		Filename:    "interview-" + strconv.FormatUint(interview.GetId(), 10) + ".ics",
+		ContentType: "text/calendar; charset=utf-8; method=PUBLISH",
+		Content:     ical.Calendar{Events: []ical.Event{event}}.Bytes(),
+	}}
+}
+
+// CreateInterviewFeedToken issues the caller a new calendar feed URL. Any URL
+// issued before stops working.
+func CreateInterviewFeedToken(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in context"})
+		return
+	}
+	if cfg.CalendarFeedSecret == "" {
+		respondCalendarFeedDisabled(c)
+		return
+	}
+	var body struct {
+		// Timezone is the IANA zone feed times are written in, UTC when empty
+		Timezone string `json:"timezone" binding:"max=64"`
+	}
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&body); err != nil {
+			utils.RespondWithValidationError(c, err)
+			return
+		}
+	}
+	if _, err := calendarLocation(body.Timezone); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error(), "error_code": "invalid_timezone"})
+		return
+	}
+
+	role := c.GetString("user_role")
+	resp, err := clients.AuthServiceClient.RotateCalendarFeedToken(calendarUserContext(c.Request.Context(), userID.(string), role), &authpb.RotateCalendarFeedTokenRequest{
+		UserId: userID.(string),
+		Role:   role,
+	})
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to create feed token: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+	token := signCalendarFeedToken(calendarFeedToken{UserID: userID.(string), Role: role, Generation: resp.GetGeneration()})
+	query := url.Values{"token": {token}}
+	if body.Timezone != "" {
+		query.Set("tz", body.Timezone)
+	}
+	recordAudit(c, "calendar_feed.rotate", "user:"+userID.(string), nil)
+	c.JSON(http.StatusCreated, gin.H{
+		"feed_url": publicBaseURL() + "/me/interviews.ics?" + query.Encode(),
+		"token":    token,
+	})
+}
+
+// RevokeInterviewFeedToken stops every calendar feed URL of the caller
+func RevokeInterviewFeedToken(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in context"})
+		return
+	}
+	role := c.GetString("user_role")
+	_, err := clients.AuthServiceClient.RevokeCalendarFeedToken(calendarUserContext(c.Request.Context(), userID.(string), role), &authpb.RevokeCalendarFeedTokenRequest{
+		UserId: userID.(string),
+		Role:   role,
+	})
+	if err != nil && status.Code(err) != codes.NotFound {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to revoke feed token: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+	recordAudit(c, "calendar_feed.revoke", "user:"+userID.(string), nil)
+	c.Status(http.StatusNoContent)
+}
+
+// calendarLocation loads the zone a feed is written in; empty is UTC
+func calendarLocation(name string) (*time.Location, error) {
+	if name == "" {
+		return nil, nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil || name == "Local" {
+		return nil, errors.New("timezone must be an IANA timezone such as Asia/Kolkata")
+	}
+	return loc, nil
+}
+
+// GetInterviewCalendarFeed serves the upcoming interviews of the token's owner as
+// an iCalendar feed calendar apps can subscribe to. The token is checked against
+// the owner's current feed generation on every request, so revoking or rotating it
+// takes effect at once.
+func GetInterviewCalendarFeed(c *gin.Context) {
+	if cfg.CalendarFeedSecret == "" {
+		respondCalendarFeedDisabled(c)
+		return
+	}
+	token, err := parseCalendarFeedToken(c.Query("token"))
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or revoked feed token", "error_code": "invalid_feed_token"})
+		return
+	}
+	loc, err := calendarLocation(c.Query("tz"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error(), "error_code": "invalid_timezone"})
+		return
+	}
+
+	ctx := calendarUserContext(c.Request.Context(), token.UserID, token.Role)
+	current, err := clients.AuthServiceClient.GetCalendarFeedToken(ctx, &authpb.GetCalendarFeedTokenRequest{
+		UserId: token.UserID,
+		Role:   token.Role,
+	})
+	if status.Code(err) == codes.NotFound || (err == nil && current.GetGeneration() != token.Generation) {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or revoked feed token", "error_code": "invalid_feed_token"})
+		return
+	}
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to check feed token: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+
+	resp, err := clients.JobServiceClient.ListUpcomingInterviews(ctx, &jobpb.ListUpcomingInterviewsRequest{
+		UserId: token.UserID,
+		Role:   token.Role,
+		From:   time.Now().UTC().Format(time.RFC3339),
+	})
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to get interviews: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+	calendar := ical.Calendar{Name: "SkillSync interviews", Location: loc}
+	for _, interview := range resp.GetInterviews() {
+		if event, ok := interviewEvent(interview); ok {
+			calendar.Events = append(calendar.Events, event)
+		}
+	}
+
+	c.Header("Cache-Control", "private, max-age="+strconv.Itoa(int(calendarFeedMaxAge.Seconds())))
+	c.Header("Content-Disposition", `inline; filename="interviews.ics"`)
+	c.Data(http.StatusOK, "text/calendar; charset=utf-8", calendar.Bytes())
+}
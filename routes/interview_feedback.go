@@ -0,0 +1,142 @@
+package routes
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	jobpb "github.com/shahal0/skillsync-protos/gen/jobpb"
+
+	"skillsync-api-gateway/clients"
+	"skillsync-api-gateway/utils"
+	"skillsync-api-gateway/utils/flags"
+)
+
+// interviewFeedbackFlag soft-launches feedback collection; while it is off the
+// feedback routes don't exist and interviews carry no feedback_submitted
+const interviewFeedbackFlag = "interview_feedback"
+
+// employerOnlyInterviewFields are the hiring team's verdict on an interview.
+// Candidates must never see them, whatever the backend returns.
+var employerOnlyInterviewFields = []string{"feedback", "feedback_submitted"}
+
+// interviewFeedbackRequest scores an interview from 1 to 5 in each area
+type interviewFeedbackRequest struct {
+	Skills        int32  `json:"skills" binding:"required,min=1,max=5"`
+	Communication int32  `json:"communication" binding:"required,min=1,max=5"`
+	Overall       int32  `json:"overall" binding:"required,min=1,max=5"`
+	Comments      string `json:"comments" binding:"max=5000"`
+}
+
+// requireEmployer refuses anyone but an employer. Feedback routes check this
+// themselves as well as through RequireRole, so a routing mistake can't show
+// feedback to a candidate.
+func requireEmployer(c *gin.Context) bool {
+	if c.GetString("user_role") != "employer" {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Only employers can access interview feedback"})
+		return false
+	}
+	return true
+}
+
+// SubmitInterviewFeedback records the employer's feedback on an interview.
+// Submitting again replaces the earlier feedback, so the response is 201 the first
+// time and 200 after that.
+func SubmitInterviewFeedback(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in context"})
+		return
+	}
+	if !requireEmployer(c) {
+		return
+	}
+	interviewID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || interviewID == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid interview ID"})
+		return
+	}
+	var body interviewFeedbackRequest
+	if err := c.ShouldBindJSON(&body); err != nil {
+		utils.RespondWithValidationError(c, err)
+		return
+	}
+	// Team members give feedback on the company's behalf but it is attributed to them
+	authorID := c.GetString("member_id")
+	if authorID == "" {
+		authorID = userID.(string)
+	}
+	resp, err := clients.JobServiceClient.SubmitInterviewFeedback(jobOwnerContext(c, userID.(string)), &jobpb.SubmitInterviewFeedbackRequest{
+		InterviewId:        interviewID,
+		EmployerId:         userID.(string),
+		AuthorId:           authorID,
+		SkillsScore:        body.Skills,
+		CommunicationScore: body.Communication,
+		OverallScore:       body.Overall,
+		Comments:           strings.TrimSpace(body.Comments),
+		Upsert:             true,
+	})
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to submit interview feedback: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+	status := http.StatusOK
+	if resp.GetCreated() {
+		status = http.StatusCreated
+	}
+	recordAudit(c, "interview.feedback", "interview:"+strconv.FormatUint(interviewID, 10), nil)
+	c.JSON(status, resp.GetFeedback())
+}
+
+// GetInterviewFeedback returns the employer's feedback on an interview
+func GetInterviewFeedback(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in context"})
+		return
+	}
+	if !requireEmployer(c) {
+		return
+	}
+	interviewID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || interviewID == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid interview ID"})
+		return
+	}
+	resp, err := clients.JobServiceClient.GetInterviewFeedback(jobOwnerContext(c, userID.(string)), &jobpb.GetInterviewFeedbackRequest{
+		InterviewId: interviewID,
+		EmployerId:  userID.(string),
+	})
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to get interview feedback: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+	c.JSON(http.StatusOK, resp.GetFeedback())
+}
+
+// interviewResponse converts a scheduling response to JSON for the caller.
+// Candidates get it without any feedback fields; employers get feedback_submitted
+// on every interview, false included, once the feature is on for them.
+func interviewResponse(c *gin.Context, resp interface{}) (map[string]interface{}, error) {
+	body, err := toMap(resp)
+	if err != nil {
+		return nil, err
+	}
+	if c.GetString("user_role") != "employer" || !flags.Enabled(c, interviewFeedbackFlag) {
+		redactFields(body, employerOnlyInterviewFields)
+		return body, nil
+	}
+	interviews := []interface{}{body["interview"]}
+	if list, ok := body["interviews"].([]interface{}); ok {
+		interviews = append(interviews, list...)
+	}
+	for _, entry := range interviews {
+		if interview, ok := entry.(map[string]interface{}); ok {
+			if _, set := interview["feedback_submitted"]; !set {
+				interview["feedback_submitted"] = false
+			}
+		}
+	}
+	return body, nil
+}
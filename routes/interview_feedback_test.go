@@ -0,0 +1,133 @@
+package routes
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	jobpb "github.com/shahal0/skillsync-protos/gen/jobpb"
+	"google.golang.org/grpc"
+
+	"skillsync-api-gateway/clients"
+	"skillsync-api-gateway/utils/flags"
+)
+
+func TestInterviewResponse(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	defer flags.Load(nil)
+
+	interview := func() map[string]interface{} {
+		return map[string]interface{}{"interview": map[string]interface{}{"id": 1}, "interviews": []interface{}{
+			map[string]interface{}{"id": 2, "feedback_submitted": true, "feedback": map[string]interface{}{"overall": 4}},
+			map[string]interface{}{"id": 3},
+		}}
+	}
+	redacted := map[string]interface{}{"interview": map[string]interface{}{"id": float64(1)}, "interviews": []interface{}{
+		map[string]interface{}{"id": float64(2)},
+		map[string]interface{}{"id": float64(3)},
+	}}
+	tests := []struct {
+		name    string
+		role    string
+		percent int
+		want    map[string]interface{}
+	}{
+		{"candidate", "candidate", 100, redacted},
+		{"employer before the launch", "employer", 0, redacted},
+		{"employer", "employer", 100, map[string]interface{}{"interview": map[string]interface{}{"id": float64(1), "feedback_submitted": false}, "interviews": []interface{}{
+			map[string]interface{}{"id": float64(2), "feedback_submitted": true, "feedback": map[string]interface{}{"overall": float64(4)}},
+			map[string]interface{}{"id": float64(3), "feedback_submitted": false},
+		}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flags.Load(map[string]int{interviewFeedbackFlag: tt.percent})
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+			c.Request = httptest.NewRequest(http.MethodGet, "/interviews", nil)
+			c.Set("user_id", "u1")
+			c.Set("user_role", tt.role)
+
+			got, err := interviewResponse(c, interview())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("interviewResponse() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+type fakeFeedbackJobs struct {
+	jobpb.JobServiceClient
+	created bool
+	req     *jobpb.SubmitInterviewFeedbackRequest
+}
+
+func (f *fakeFeedbackJobs) SubmitInterviewFeedback(_ context.Context, req *jobpb.SubmitInterviewFeedbackRequest, _ ...grpc.CallOption) (*jobpb.SubmitInterviewFeedbackResponse, error) {
+	f.req = req
+	return &jobpb.SubmitInterviewFeedbackResponse{Created: f.created, Feedback: &jobpb.InterviewFeedback{InterviewId: req.GetInterviewId()}}, nil
+}
+
+func TestSubmitInterviewFeedback(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	previous := clients.JobServiceClient
+	defer func() { clients.JobServiceClient = previous }()
+
+	valid := `{"skills":4,"communication":5,"overall":4,"comments":"  Clear answers "}`
+	tests := []struct {
+		name       string
+		role       string
+		memberID   string
+		body       string
+		created    bool
+		wantStatus int
+		wantAuthor string
+	}{
+		{"first submission", "employer", "", valid, true, http.StatusCreated, "e1"},
+		{"replaced", "employer", "", valid, false, http.StatusOK, "e1"},
+		{"team member", "employer", "m1", valid, true, http.StatusCreated, "m1"},
+		{"candidate", "candidate", "", valid, true, http.StatusForbidden, ""},
+		{"score out of range", "employer", "", `{"skills":6,"communication":5,"overall":4}`, true, http.StatusBadRequest, ""},
+		{"score missing", "employer", "", `{"skills":4,"overall":4}`, true, http.StatusBadRequest, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeFeedbackJobs{created: tt.created}
+			clients.JobServiceClient = fake
+			r := gin.New()
+			r.POST("/interviews/:id/feedback", func(c *gin.Context) {
+				c.Set("user_id", "e1")
+				c.Set("user_role", tt.role)
+				if tt.memberID != "" {
+					c.Set("member_id", tt.memberID)
+				}
+				SubmitInterviewFeedback(c)
+			})
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/interviews/7/feedback", strings.NewReader(tt.body)))
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d (%s)", w.Code, tt.wantStatus, w.Body)
+			}
+			if tt.wantAuthor == "" {
+				if fake.req != nil {
+					t.Errorf("job service called for a refused submission")
+				}
+				return
+			}
+			if fake.req.GetAuthorId() != tt.wantAuthor || fake.req.GetEmployerId() != "e1" || fake.req.GetComments() != "Clear answers" || !fake.req.GetUpsert() {
+				t.Errorf("request = %v", fake.req)
+			}
+			var feedback map[string]interface{}
+			if err := json.Unmarshal(w.Body.Bytes(), &feedback); err != nil || feedback["interview_id"] != float64(7) {
+				t.Errorf("body = %s", w.Body)
+			}
+		})
+	}
+}
@@ -0,0 +1,206 @@
+package routes
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	jobpb "github.com/shahal0/skillsync-protos/gen/jobpb"
+	"google.golang.org/grpc/metadata"
+
+	"skillsync-api-gateway/clients"
+	"skillsync-api-gateway/utils"
+)
+
+// interviewRequest is the body for scheduling or rescheduling an interview
+type interviewRequest struct {
+	ScheduledAt     string `json:"scheduled_at" binding:"required"`
+	Mode            string `json:"mode" binding:"required,oneof=online onsite"`
+	Location        string `json:"location"`
+	MeetingLink     string `json:"meeting_link"`
+	DurationMinutes int32  `json:"duration_minutes"`
+}
+
+// parseInterviewTime requires an RFC 3339 timestamp with an explicit timezone
+// offset and a time in the future
+func parseInterviewTime(value string) (time.Time, error) {
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("scheduled_at must be an RFC 3339 timestamp with a timezone offset, e.g. 2025-07-01T15:00:00+05:30")
+	}
+	if !t.After(time.Now()) {
+		return time.Time{}, fmt.Errorf("scheduled_at must be in the future")
+	}
+	return t, nil
+}
+
+// validateInterviewVenue makes sure online interviews have a link and onsite ones a location
+func validateInterviewVenue(req *interviewRequest) error {
+	if req.Mode == "online" && strings.TrimSpace(req.MeetingLink) == "" {
+		return fmt.Errorf("meeting_link is required for online interviews")
+	}
+	if req.Mode == "onsite" && strings.TrimSpace(req.Location) == "" {
+		return fmt.Errorf("location is required for onsite interviews")
+	}
+	return nil
+}
+
+// interviewContext forwards the caller's identity to the job service
+func interviewContext(c *gin.Context) context.Context {
+	return metadata.NewOutgoingContext(
+		c.Request.Context(),
+		metadata.New(map[string]string{
+			"user-id": c.GetString("user_id"),
+			"role":    c.GetString("user_role"),
+		}),
+	)
+}
+
+func ScheduleInterview(c *gin.Context) {
+	applicationID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || applicationID == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid application ID"})
+		return
+	}
+
+	var body interviewRequest
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	scheduledAt, err := parseInterviewTime(body.ScheduledAt)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := validateInterviewVenue(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := clients.JobServiceClient.ScheduleInterview(interviewContext(c), &jobpb.ScheduleInterviewRequest{
+		ApplicationId:   applicationID,
+		EmployerId:      c.GetString("user_id"),
+		ScheduledAt:     scheduledAt.Format(time.RFC3339),
+		Mode:            body.Mode,
+		Location:        body.Location,
+		MeetingLink:     body.MeetingLink,
+		DurationMinutes: body.DurationMinutes,
+	})
+	if err != nil {
+		// Ownership violations map to 403 and double-bookings to 409
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to schedule interview: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+
+	interview := resp.GetInterview()
+	notifyUserWithAttachments(c.Request.Context(), interview.GetCandidateId(), "interview_scheduled", "Interview scheduled",
+		"An interview has been scheduled for "+interview.GetScheduledAt(), strconv.FormatUint(interview.GetId(), 10),
+		interviewInvite(interview)...)
+
+	respondInterview(c, http.StatusCreated, resp)
+}
+
+func GetApplicationInterviews(c *gin.Context) {
+	applicationID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || applicationID == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid application ID"})
+		return
+	}
+
+	// The job service only returns interviews to the application's candidate or employer
+	resp, err := clients.JobServiceClient.GetInterviews(interviewContext(c), &jobpb.GetInterviewsRequest{
+		ApplicationId: applicationID,
+	})
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to get interviews: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+	respondInterview(c, http.StatusOK, resp)
+}
+
+func UpdateInterview(c *gin.Context) {
+	interviewID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || interviewID == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid interview ID"})
+		return
+	}
+
+	// Cancelling doesn't need the scheduling fields, so they are validated per action
+	var body struct {
+		Action          string `json:"action" binding:"required,oneof=reschedule cancel"`
+		Reason          string `json:"reason"`
+		ScheduledAt     string `json:"scheduled_at"`
+		Mode            string `json:"mode" binding:"omitempty,oneof=online onsite"`
+		Location        string `json:"location"`
+		MeetingLink     string `json:"meeting_link"`
+		DurationMinutes int32  `json:"duration_minutes"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	req := &jobpb.UpdateInterviewRequest{
+		InterviewId: interviewID,
+		Action:      body.Action,
+		Reason:      body.Reason,
+	}
+	if body.Action == "reschedule" {
+		scheduledAt, err := parseInterviewTime(body.ScheduledAt)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		if body.Mode != "" {
+			venue := interviewRequest{Mode: body.Mode, Location: body.Location, MeetingLink: body.MeetingLink}
+			if err := validateInterviewVenue(&venue); err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+				return
+			}
+		}
+		req.ScheduledAt = scheduledAt.Format(time.RFC3339)
+		req.Mode = body.Mode
+		req.Location = body.Location
+		req.MeetingLink = body.MeetingLink
+		req.DurationMinutes = body.DurationMinutes
+	}
+
+	resp, err := clients.JobServiceClient.UpdateInterview(interviewContext(c), req)
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to update interview: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+
+	// Tell whoever didn't make the change
+	interview := resp.GetInterview()
+	otherParty := interview.GetCandidateId()
+	if c.GetString("user_id") == interview.GetCandidateId() {
+		otherParty = interview.GetEmployerId()
+	}
+	if body.Action == "cancel" {
+		notifyUser(c.Request.Context(), otherParty, "interview_cancelled", "Interview cancelled",
+			"An interview scheduled for "+interview.GetScheduledAt()+" has been cancelled", strconv.FormatUint(interviewID, 10))
+	} else {
+		// The invite keeps the original's UID, so calendars move the event
+		notifyUserWithAttachments(c.Request.Context(), otherParty, "interview_rescheduled", "Interview rescheduled",
+			"An interview has been moved to "+interview.GetScheduledAt(), strconv.FormatUint(interviewID, 10),
+			interviewInvite(interview)...)
+	}
+
+	respondInterview(c, http.StatusOK, resp)
+}
+
+// respondInterview sends a scheduling response with feedback fields shown only to employers
+func respondInterview(c *gin.Context, code int, resp interface{}) {
+	body, err := interviewResponse(c, resp)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to encode interview"})
+		return
+	}
+	c.JSON(code, body)
+}
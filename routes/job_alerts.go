@@ -0,0 +1,162 @@
+package routes
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	jobpb "github.com/shahal0/skillsync-protos/gen/jobpb"
+	"google.golang.org/grpc/metadata"
+
+	"skillsync-api-gateway/clients"
+	"skillsync-api-gateway/utils"
+)
+
+const (
+	maxJobAlertsPerCandidate = 10
+	maxJobAlertSkills        = 20
+)
+
+// validAlertFrequencies are how often a candidate can be told about new matches
+var validAlertFrequencies = map[string]bool{"instant": true, "daily": true, "weekly": true}
+
+// candidateContext forwards the authenticated candidate to backend services
+func candidateContext(c *gin.Context, candidateID string) context.Context {
+	return metadata.NewOutgoingContext(
+		c.Request.Context(),
+		metadata.New(map[string]string{
+			"user-id": candidateID,
+			"role":    "candidate",
+		}),
+	)
+}
+
+func CreateJobAlert(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in context"})
+		return
+	}
+	candidateID := userID.(string)
+
+	var body struct {
+		jobFilters
+		Skills    []string `json:"skills"`
+		Frequency string   `json:"frequency"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	// Same parser as the live job search so the two can't diverge
+	filters, err := parseJobFilters(body.jobFilters)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	skills := make([]string, 0, len(body.Skills))
+	for _, skill := range body.Skills {
+		if skill = strings.TrimSpace(skill); skill != "" {
+			skills = append(skills, skill)
+		}
+	}
+	if len(skills) > maxJobAlertSkills {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "At most 20 skills can be used in an alert"})
+		return
+	}
+	if filters.Category == "" && filters.Keyword == "" && filters.Location == "" && len(skills) == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "An alert needs at least one of keyword, category, location or skills"})
+		return
+	}
+	frequency := strings.ToLower(strings.TrimSpace(body.Frequency))
+	if frequency == "" {
+		frequency = "daily"
+	}
+	if !validAlertFrequencies[frequency] {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "frequency must be one of instant, daily, weekly"})
+		return
+	}
+
+	ctx := candidateContext(c, candidateID)
+
+	// Enforce the per-candidate cap before creating another alert
+	existing, err := clients.JobServiceClient.ListJobAlerts(ctx, &jobpb.ListJobAlertsRequest{CandidateId: candidateID})
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to check existing alerts: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+	if len(existing.GetAlerts()) >= maxJobAlertsPerCandidate {
+		c.JSON(http.StatusConflict, gin.H{
+			"error":      "You can have at most 10 job alerts; delete one before creating another",
+			"error_code": "alert_limit_reached",
+		})
+		return
+	}
+
+	resp, err := clients.JobServiceClient.CreateJobAlert(ctx, &jobpb.CreateJobAlertRequest{
+		CandidateId:  candidateID,
+		Keyword:      filters.Keyword,
+		IncludeTerms: filters.IncludeTerms,
+		ExcludeTerms: filters.ExcludeTerms,
+		Category:     filters.Category,
+		Location:     filters.Location,
+		Skills:       skills,
+		Frequency:    frequency,
+	})
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to create job alert: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+	c.JSON(http.StatusCreated, resp)
+}
+
+func GetJobAlerts(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	resp, err := clients.JobServiceClient.ListJobAlerts(candidateContext(c, userID.(string)), &jobpb.ListJobAlertsRequest{
+		CandidateId: userID.(string),
+	})
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to get job alerts: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+
+	alerts := make([]gin.H, 0, len(resp.GetAlerts()))
+	for _, alert := range resp.GetAlerts() {
+		alerts = append(alerts, gin.H{
+			"id":                alert.GetId(),
+			"keyword":           alert.GetKeyword(),
+			"category":          alert.GetCategory(),
+			"location":          alert.GetLocation(),
+			"skills":            alert.GetSkills(),
+			"frequency":         alert.GetFrequency(),
+			"created_at":        alert.GetCreatedAt(),
+			"last_triggered_at": alert.GetLastTriggeredAt(),
+		})
+	}
+	c.JSON(http.StatusOK, gin.H{"alerts": alerts, "limit": maxJobAlertsPerCandidate})
+}
+
+func DeleteJobAlert(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	resp, err := clients.JobServiceClient.DeleteJobAlert(candidateContext(c, userID.(string)), &jobpb.DeleteJobAlertRequest{
+		AlertId:     c.Param("id"),
+		CandidateId: userID.(string),
+	})
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to delete job alert: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,134 @@
+package routes
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	jobpb "github.com/shahal0/skillsync-protos/gen/jobpb"
+	"google.golang.org/grpc/metadata"
+
+	"skillsync-api-gateway/clients"
+	"skillsync-api-gateway/utils"
+	"skillsync-api-gateway/utils/fanout"
+)
+
+const (
+	maxBulkJobs        = 100
+	bulkJobConcurrency = 5
+	// bulkJobTimeout covers the whole batch, which takes far longer than a single post
+	bulkJobTimeout = 60 * time.Second
+)
+
+// bulkJobResult reports the outcome of one row of a bulk post
+type bulkJobResult struct {
+	Index     int    `json:"index"`
+	Success   bool   `json:"success"`
+	JobID     uint64 `json:"job_id,omitempty"`
+	ErrorCode string `json:"error_code,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+// validateBulkJob checks the fields every posting needs, returning an error code and message
+func validateBulkJob(job *jobpb.PostJobRequest) (string, string) {
+	if job == nil {
+		return "invalid_job", "job must be an object"
+	}
+	if strings.TrimSpace(job.GetTitle()) == "" {
+		return "missing_title", "title is required"
+	}
+	if strings.TrimSpace(job.GetDescription()) == "" {
+		return "missing_description", "description is required"
+	}
+	if strings.TrimSpace(job.GetCategory()) == "" {
+		return "missing_category", "category is required"
+	}
+	if job.GetSalaryMin() < 0 || job.GetSalaryMax() < 0 {
+		return "invalid_salary", "salary cannot be negative"
+	}
+	if job.GetSalaryMax() > 0 && job.GetSalaryMin() > job.GetSalaryMax() {
+		return "invalid_salary_range", "salary_min cannot exceed salary_max"
+	}
+	if deadline := strings.TrimSpace(job.GetDeadline()); deadline != "" {
+		if err := utils.CheckJobDeadline(deadline); err != nil {
+			return "invalid_deadline", err.Error()
+		}
+	}
+	return "", ""
+}
+
+func BulkPostJobs(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in context"})
+		return
+	}
+	employerID := userID.(string)
+
+	var jobs []*jobpb.PostJobRequest
+	if err := c.ShouldBindJSON(&jobs); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Expected a JSON array of jobs: " + err.Error()})
+		return
+	}
+	if len(jobs) == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "At least one job is required"})
+		return
+	}
+	if len(jobs) > maxBulkJobs {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "At most 100 jobs can be posted at once"})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), bulkJobTimeout)
+	defer cancel()
+	ctx = metadata.NewOutgoingContext(ctx, metadata.New(map[string]string{
+		"user-id": employerID,
+		"role":    "employer",
+	}))
+
+	results := make([]bulkJobResult, len(jobs))
+	var valid []int
+	for i, job := range jobs {
+		results[i].Index = i
+		if code, msg := validateBulkJob(job); code != "" {
+			results[i].ErrorCode = code
+			results[i].Error = msg
+			continue
+		}
+		// The employer always comes from the token, never the row
+		job.EmployerId = employerID
+		valid = append(valid, i)
+	}
+	if len(valid) > 0 && !withinActiveJobLimit(c, employerID, len(valid)) {
+		return
+	}
+
+	post := func(ctx context.Context, i int) (*jobpb.PostJobResponse, error) {
+		return clients.JobServiceClient.PostJob(ctx, jobs[i])
+	}
+	for n, outcome := range fanout.RunWith(ctx, valid, bulkJobConcurrency, post, fanout.Options{Limiter: fanout.Backend("job")}) {
+		i := valid[n]
+		if outcome.Err != nil {
+			results[i].ErrorCode = "backend_error"
+			results[i].Error = utils.GRPCErrorMessage(outcome.Err)
+			continue
+		}
+		results[i].Success = true
+		results[i].JobID = outcome.Value.GetJobId()
+	}
+
+	succeeded := 0
+	for _, result := range results {
+		if result.Success {
+			succeeded++
+		}
+	}
+	c.JSON(http.StatusMultiStatus, gin.H{
+		"total":     len(jobs),
+		"succeeded": succeeded,
+		"failed":    len(jobs) - succeeded,
+		"results":   results,
+	})
+}
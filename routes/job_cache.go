@@ -0,0 +1,126 @@
+package routes
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	jobpb "github.com/shahal0/skillsync-protos/gen/jobpb"
+
+	"skillsync-api-gateway/clients"
+	"skillsync-api-gateway/utils"
+	"skillsync-api-gateway/utils/cache"
+)
+
+// jobListingCache holds enriched public job responses, keyed by jobListingCacheKey
+// or jobDetailCacheKey, so enrichment isn't recomputed on cache hits
+var jobListingCache = cache.NewTTLCache[map[string]interface{}](30 * time.Second)
+
+// jobListingCacheKey normalizes the GetJobs filters so equivalent queries share an entry
+func jobListingCacheKey(req *jobpb.GetJobsRequest) string {
+	normalize := func(s string) string { return strings.ToLower(strings.TrimSpace(s)) }
+	return "jobs|" + normalize(req.GetCategory()) + "|" + normalize(req.GetKeyword()) + "|" + normalize(req.GetLocation())
+}
+
+// invalidateJobCaches drops every cached listing and job, for changes that affect
+// which jobs are public. Other instances catch up when their entries expire.
+func invalidateJobCaches() {
+	jobListingCache.Clear()
+}
+
+func jobDetailCacheKey(jobID uint64) string {
+	return "job|" + strconv.FormatUint(jobID, 10)
+}
+
+// cachedJobListing returns the enriched GetJobs response for req, from the cache when possible.
+// Cached pages are already enriched with company details. Concurrent misses for the
+// same query share one backend call.
+func cachedJobListing(ctx context.Context, req *jobpb.GetJobsRequest) (map[string]interface{}, error) {
+	fetch := func(ctx context.Context) (map[string]interface{}, error) {
+		resp, err := clients.JobServiceClient.GetJobs(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		body, err := toMap(resp)
+		if err != nil {
+			return nil, err
+		}
+		jobs, complete := enrichJobs(resp.GetJobs())
+		body["jobs"] = jobs
+		if !complete {
+			utils.MarkPartial(body, "employer_profile")
+		}
+		return body, nil
+	}
+	// A forced canary choice must reach the chosen backend, and its answer isn't shared
+	if canaryForced(ctx) {
+		return fetch(ctx)
+	}
+
+	cacheKey := jobListingCacheKey(req)
+	if cached, ok := jobListingCache.Get(cacheKey); ok {
+		return cached, nil
+	}
+	return coalesce(ctx, "get_jobs", cacheKey, func(ctx context.Context) (map[string]interface{}, error) {
+		body, err := fetch(ctx)
+		if err != nil {
+			return nil, err
+		}
+		// Partial responses aren't cached so a brief outage isn't served for the whole TTL
+		if _, partial := body["partial"]; !partial {
+			jobListingCache.Set(cacheKey, body)
+		}
+		return body, nil
+	})
+}
+
+// cachedJobDetail returns the enriched GetJobById response, from the cache when
+// possible, sharing one backend call between concurrent misses for the same job
+func cachedJobDetail(ctx context.Context, jobID uint64) (map[string]interface{}, error) {
+	fetch := func(ctx context.Context) (map[string]interface{}, error) {
+		resp, err := clients.JobServiceClient.GetJobById(ctx, &jobpb.GetJobByIdRequest{JobId: jobID})
+		if err != nil {
+			return nil, err
+		}
+		body, err := toMap(resp)
+		if err != nil {
+			return nil, err
+		}
+		if job := resp.GetJob(); job != nil {
+			enriched, complete := enrichJobs([]*jobpb.Job{job})
+			if len(enriched) == 1 {
+				body["job"] = enriched[0]
+			}
+			if !complete {
+				utils.MarkPartial(body, "employer_profile")
+			}
+		}
+		return body, nil
+	}
+	if canaryForced(ctx) {
+		return fetch(ctx)
+	}
+
+	cacheKey := jobDetailCacheKey(jobID)
+	if cached, ok := jobListingCache.Get(cacheKey); ok {
+		return cached, nil
+	}
+	return coalesce(ctx, "get_job_by_id", cacheKey, func(ctx context.Context) (map[string]interface{}, error) {
+		body, err := fetch(ctx)
+		if err != nil {
+			return nil, err
+		}
+		// Partial responses aren't cached so a brief outage isn't served for the whole TTL
+		if _, partial := body["partial"]; !partial {
+			jobListingCache.Set(cacheKey, body)
+		}
+		return body, nil
+	})
+}
+
+// canaryForced reports whether the request chose its backend with X-Canary
+func canaryForced(ctx context.Context) bool {
+	route, ok := clients.CanaryRouteFrom(ctx)
+	return ok && (route.Force == "always" || route.Force == "never")
+}
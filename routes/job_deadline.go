@@ -0,0 +1,57 @@
+package routes
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"skillsync-api-gateway/utils"
+)
+
+// jobClosedReason says why a job no longer takes applications, "closed" or
+// "deadline_passed", or "" while it is open. The job is read through the detail
+// cache; if it can't be read the apply goes ahead and the job service decides.
+func jobClosedReason(ctx context.Context, jobID uint64) string {
+	body, err := cachedJobDetail(ctx, jobID)
+	if err != nil {
+		log.Printf("Job %d: skipping the closed check before applying: %v", jobID, err)
+		return ""
+	}
+	job, _ := body["job"].(map[string]interface{})
+	if job == nil {
+		return ""
+	}
+	if status, _ := job["status"].(string); strings.EqualFold(status, "CLOSED") {
+		return "closed"
+	}
+	if deadline, _ := job["deadline"].(string); utils.DeadlinePassed(deadline, time.Now()) {
+		return "deadline_passed"
+	}
+	return ""
+}
+
+// withoutExpiredJobs returns a listing without the jobs whose deadline has passed.
+// The listing may be shared with the cache, so it is copied rather than changed.
+func withoutExpiredJobs(body map[string]interface{}) map[string]interface{} {
+	jobs, ok := body["jobs"].([]map[string]interface{})
+	if !ok {
+		return body
+	}
+	now := time.Now()
+	open := make([]map[string]interface{}, 0, len(jobs))
+	for _, job := range jobs {
+		if deadline, _ := job["deadline"].(string); !utils.DeadlinePassed(deadline, now) {
+			open = append(open, job)
+		}
+	}
+	if len(open) == len(jobs) {
+		return body
+	}
+	filtered := make(map[string]interface{}, len(body))
+	for key, value := range body {
+		filtered[key] = value
+	}
+	filtered["jobs"] = open
+	return filtered
+}
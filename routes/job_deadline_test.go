@@ -0,0 +1,98 @@
+package routes
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	jobpb "github.com/shahal0/skillsync-protos/gen/jobpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"skillsync-api-gateway/clients"
+)
+
+type unavailableJobs struct {
+	jobpb.JobServiceClient
+}
+
+func (unavailableJobs) GetJobById(context.Context, *jobpb.GetJobByIdRequest, ...grpc.CallOption) (*jobpb.GetJobByIdResponse, error) {
+	return nil, status.Error(codes.Unavailable, "job service down")
+}
+
+func TestJobClosedReason(t *testing.T) {
+	previous := clients.JobServiceClient
+	defer func() { clients.JobServiceClient = previous }()
+	clients.JobServiceClient = unavailableJobs{}
+
+	past := time.Now().Add(-time.Hour).Format(time.RFC3339)
+	justPassed := time.Now().Add(-time.Minute).Format(time.RFC3339)
+	tests := []struct {
+		name string
+		job  map[string]interface{}
+		want string
+	}{
+		{"open", map[string]interface{}{"status": "OPEN", "deadline": time.Now().Add(time.Hour).Format(time.RFC3339)}, ""},
+		{"no deadline", map[string]interface{}{"status": "OPEN"}, ""},
+		{"closed", map[string]interface{}{"status": "Closed", "deadline": past}, "closed"},
+		{"deadline passed", map[string]interface{}{"status": "OPEN", "deadline": past}, "deadline_passed"},
+		{"within the clock skew", map[string]interface{}{"status": "OPEN", "deadline": justPassed}, ""},
+		{"unreadable deadline", map[string]interface{}{"status": "OPEN", "deadline": "soon"}, ""},
+		{"no job in the response", nil, ""},
+		{"job unavailable", nil, ""},
+	}
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jobID := uint64(900000 + i)
+			if tt.name != "job unavailable" {
+				body := map[string]interface{}{}
+				if tt.job != nil {
+					body["job"] = tt.job
+				}
+				jobListingCache.Set(jobDetailCacheKey(jobID), body)
+				defer jobListingCache.Delete(jobDetailCacheKey(jobID))
+			}
+			if got := jobClosedReason(context.Background(), jobID); got != tt.want {
+				t.Errorf("jobClosedReason() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithoutExpiredJobs(t *testing.T) {
+	open := map[string]interface{}{"id": 1, "deadline": time.Now().Add(time.Hour).Format(time.RFC3339)}
+	noDeadline := map[string]interface{}{"id": 2}
+	expired := map[string]interface{}{"id": 3, "deadline": "2020-01-31"}
+
+	tests := []struct {
+		name     string
+		jobs     interface{}
+		wantJobs interface{}
+		wantSame bool
+	}{
+		{"all open", []map[string]interface{}{open, noDeadline}, []map[string]interface{}{open, noDeadline}, true},
+		{"expired dropped", []map[string]interface{}{expired, open, noDeadline}, []map[string]interface{}{open, noDeadline}, false},
+		{"all expired", []map[string]interface{}{expired}, []map[string]interface{}{}, false},
+		{"not enriched", []interface{}{expired}, []interface{}{expired}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body := map[string]interface{}{"jobs": tt.jobs, "total": 3}
+			got := withoutExpiredJobs(body)
+			if !reflect.DeepEqual(got["jobs"], tt.wantJobs) {
+				t.Errorf("jobs = %v, want %v", got["jobs"], tt.wantJobs)
+			}
+			if got["total"] != 3 {
+				t.Errorf("total = %v, want the other keys kept", got["total"])
+			}
+			if same := reflect.ValueOf(got).Pointer() == reflect.ValueOf(body).Pointer(); same != tt.wantSame {
+				t.Errorf("returned the same map = %v, want %v", same, tt.wantSame)
+			}
+			if !reflect.DeepEqual(body["jobs"], tt.jobs) {
+				t.Errorf("the cached listing was changed")
+			}
+		})
+	}
+}
@@ -0,0 +1,253 @@
+package routes
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"skillsync-api-gateway/middlewares"
+	"skillsync-api-gateway/utils"
+)
+
+const (
+	defaultFeedSize = 50
+	maxFeedSize     = 100
+	feedCacheMaxAge = 5 * time.Minute
+)
+
+// feedJob is the subset of an enriched job map that feeds render
+type feedJob struct {
+	ID          string
+	Title       string
+	Description string
+	Category    string
+	Location    string
+	Company     string
+	Published   time.Time
+}
+
+// publicBaseURL is used for absolute links in feeds (PUBLIC_BASE_URL)
+func publicBaseURL() string {
+	return strings.TrimRight(cfg.PublicBaseURL, "/")
+}
+
+func (j feedJob) link() string {
+	return publicBaseURL() + "/jobs/get?id=" + j.ID
+}
+
+// parseFeedTime accepts the timestamp layouts the job service has been seen to return
+func parseFeedTime(value interface{}) time.Time {
+	s, _ := value.(string)
+	for _, layout := range []string{time.RFC3339Nano, time.RFC3339, "2006-01-02 15:04:05", "2006-01-02"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t.UTC()
+		}
+	}
+	return time.Time{}
+}
+
+// loadFeedJobs reads the GetJobs filters from the query and returns the newest open jobs
+func loadFeedJobs(c *gin.Context) ([]feedJob, bool) {
+	req, err := parseJobFilters(jobFilters{
+		Category: c.Query("category"),
+		Keyword:  c.Query("keyword"),
+		Location: c.Query("location"),
+	})
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return nil, false
+	}
+	size := defaultFeedSize
+	if n, err := strconv.Atoi(c.Query("limit")); err == nil && n > 0 {
+		size = n
+	}
+	if size > maxFeedSize {
+		size = maxFeedSize
+	}
+
+	body, err := cachedJobListing(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to get jobs: " + utils.GRPCErrorMessage(err)})
+		return nil, false
+	}
+	// Feed formats have no room for the partial flag, so only the header says so
+	utils.WarnPartial(c, body)
+	jobs, _ := body["jobs"].([]map[string]interface{})
+
+	now := time.Now()
+	feed := make([]feedJob, 0, len(jobs))
+	for _, job := range jobs {
+		if jobStatus, _ := job["status"].(string); jobStatus != "" && !strings.EqualFold(jobStatus, "OPEN") {
+			continue
+		}
+		if deadline, _ := job["deadline"].(string); utils.DeadlinePassed(deadline, now) {
+			continue
+		}
+		entry := feedJob{Published: parseFeedTime(job["created_at"])}
+		entry.ID = fmt.Sprint(job["id"])
+		if id, ok := job["id"].(float64); ok {
+			entry.ID = strconv.FormatUint(uint64(id), 10)
+		}
+		entry.Title, _ = job["title"].(string)
+		entry.Description, _ = job["description"].(string)
+		entry.Category, _ = job["category"].(string)
+		entry.Location, _ = job["location"].(string)
+		entry.Company, _ = job["company_name"].(string)
+		feed = append(feed, entry)
+	}
+	sort.SliceStable(feed, func(i, k int) bool { return feed[i].Published.After(feed[k].Published) })
+	if len(feed) > size {
+		feed = feed[:size]
+	}
+	return feed, true
+}
+
+// writeFeedCacheHeaders sets caching headers and answers conditional requests.
+// It returns false when a 304 was sent.
+func writeFeedCacheHeaders(c *gin.Context, jobs []feedJob) bool {
+	c.Header("Cache-Control", middlewares.PublicCacheControl(feedCacheMaxAge))
+	if len(jobs) == 0 || jobs[0].Published.IsZero() {
+		return true
+	}
+	lastModified := jobs[0].Published.Truncate(time.Second)
+	c.Header("Last-Modified", lastModified.Format(http.TimeFormat))
+	if since, err := http.ParseTime(c.GetHeader("If-Modified-Since")); err == nil && !lastModified.After(since) {
+		c.Status(http.StatusNotModified)
+		return false
+	}
+	return true
+}
+
+func feedTitle(c *gin.Context) string {
+	title := "SkillSync jobs"
+	var filters []string
+	for _, key := range []string{"keyword", "category", "location"} {
+		if value := strings.TrimSpace(c.Query(key)); value != "" {
+			filters = append(filters, value)
+		}
+	}
+	if len(filters) > 0 {
+		title += ": " + strings.Join(filters, ", ")
+	}
+	return title
+}
+
+type rssDocument struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	LastBuildDate string    `xml:"lastBuildDate,omitempty"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	Title       string  `xml:"title"`
+	Link        string  `xml:"link"`
+	Description string  `xml:"description"`
+	Category    string  `xml:"category,omitempty"`
+	GUID        rssGUID `xml:"guid"`
+	PubDate     string  `xml:"pubDate,omitempty"`
+}
+
+type rssGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+// GetJobsRSSFeed renders the newest open jobs as RSS 2.0. encoding/xml escapes every
+// text node, so markup in job titles or descriptions can't break the document.
+func GetJobsRSSFeed(c *gin.Context) {
+	jobs, ok := loadFeedJobs(c)
+	if !ok || !writeFeedCacheHeaders(c, jobs) {
+		return
+	}
+
+	channel := rssChannel{
+		Title:       feedTitle(c),
+		Link:        publicBaseURL() + "/jobs",
+		Description: "Newest open jobs on SkillSync",
+		Items:       make([]rssItem, 0, len(jobs)),
+	}
+	if len(jobs) > 0 && !jobs[0].Published.IsZero() {
+		channel.LastBuildDate = jobs[0].Published.Format(time.RFC1123Z)
+	}
+	for _, job := range jobs {
+		title := job.Title
+		if job.Company != "" {
+			title += " at " + job.Company
+		}
+		item := rssItem{
+			Title:       title,
+			Link:        job.link(),
+			Description: job.Description,
+			Category:    job.Category,
+			GUID:        rssGUID{IsPermaLink: true, Value: job.link()},
+		}
+		if !job.Published.IsZero() {
+			item.PubDate = job.Published.Format(time.RFC1123Z)
+		}
+		channel.Items = append(channel.Items, item)
+	}
+
+	out, err := xml.Marshal(rssDocument{Version: "2.0", Channel: channel})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.Data(http.StatusOK, "application/rss+xml; charset=utf-8", append([]byte(xml.Header), out...))
+}
+
+// GetJobsJSONFeed renders the newest open jobs as a JSON Feed 1.1 document
+func GetJobsJSONFeed(c *gin.Context) {
+	jobs, ok := loadFeedJobs(c)
+	if !ok || !writeFeedCacheHeaders(c, jobs) {
+		return
+	}
+
+	items := make([]gin.H, 0, len(jobs))
+	for _, job := range jobs {
+		item := gin.H{
+			"id":           job.link(),
+			"url":          job.link(),
+			"title":        job.Title,
+			"content_text": job.Description,
+		}
+		if job.Company != "" {
+			item["authors"] = []gin.H{{"name": job.Company}}
+		}
+		var tags []string
+		for _, tag := range []string{job.Category, job.Location} {
+			if tag != "" {
+				tags = append(tags, tag)
+			}
+		}
+		if len(tags) > 0 {
+			item["tags"] = tags
+		}
+		if !job.Published.IsZero() {
+			item["date_published"] = job.Published.Format(time.RFC3339)
+		}
+		items = append(items, item)
+	}
+
+	c.Header("Content-Type", "application/feed+json; charset=utf-8")
+	c.JSON(http.StatusOK, gin.H{
+		"version":       "https://jsonfeed.org/version/1.1",
+		"title":         feedTitle(c),
+		"home_page_url": publicBaseURL() + "/jobs",
+		"feed_url":      publicBaseURL() + c.Request.URL.RequestURI(),
+		"items":         items,
+	})
+}
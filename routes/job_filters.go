@@ -0,0 +1,98 @@
+package routes
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	jobpb "github.com/shahal0/skillsync-protos/gen/jobpb"
+)
+
+const (
+	maxJobFilterLength = 100
+	maxKeywordTerms    = 10
+)
+
+// jobFilters are the search criteria shared by the public job listing and saved job alerts,
+// so a saved alert always matches what the same live search would return
+type jobFilters struct {
+	Category string `json:"category"`
+	Keyword  string `json:"keyword"`
+	Location string `json:"location"`
+}
+
+// parseJobFilters trims and validates the filters and builds the GetJobs request. The
+// keyword is passed on as typed and also split into include and exclude terms.
+func parseJobFilters(filters jobFilters) (*jobpb.GetJobsRequest, error) {
+	req := &jobpb.GetJobsRequest{
+		Category: strings.TrimSpace(filters.Category),
+		Keyword:  strings.TrimSpace(filters.Keyword),
+		Location: strings.TrimSpace(filters.Location),
+	}
+	for name, value := range map[string]string{
+		"category": req.Category,
+		"keyword":  req.Keyword,
+		"location": req.Location,
+	} {
+		if len(value) > maxJobFilterLength {
+			return nil, fmt.Errorf("%s must be at most %d characters", name, maxJobFilterLength)
+		}
+	}
+	include, exclude := parseKeywordTerms(req.Keyword)
+	if len(include)+len(exclude) > maxKeywordTerms {
+		return nil, fmt.Errorf("keyword must have at most %d terms", maxKeywordTerms)
+	}
+	req.IncludeTerms, req.ExcludeTerms = include, exclude
+	return req, nil
+}
+
+// parseKeywordTerms splits a keyword search into terms a job must match and terms it
+// must not. Words are separate terms, "quoted phrases" are one term and a leading -
+// excludes a word or phrase, so `"machine learning" remote -intern` includes
+// "machine learning" and "remote" and excludes "intern". Repeated terms are dropped,
+// ignoring case. With unbalanced quotes the whole keyword is one term, as typed.
+func parseKeywordTerms(keyword string) (include, exclude []string) {
+	keyword = strings.TrimSpace(keyword)
+	if keyword == "" {
+		return nil, nil
+	}
+	if strings.Count(keyword, `"`)%2 != 0 {
+		return []string{keyword}, nil
+	}
+	seen := make(map[string]bool)
+	rest := keyword
+	for {
+		rest = strings.TrimLeftFunc(rest, unicode.IsSpace)
+		if rest == "" {
+			break
+		}
+		negated := false
+		if len(rest) > 1 && rest[0] == '-' && !unicode.IsSpace(rune(rest[1])) {
+			negated = true
+			rest = rest[1:]
+		}
+		var term string
+		if rest[0] == '"' {
+			// Balanced quotes mean every opening quote has a closing one
+			end := strings.IndexByte(rest[1:], '"') + 1
+			term = strings.Join(strings.Fields(rest[1:end]), " ")
+			rest = rest[end+1:]
+		} else {
+			end := strings.IndexFunc(rest, func(r rune) bool { return unicode.IsSpace(r) || r == '"' })
+			if end < 0 {
+				end = len(rest)
+			}
+			term, rest = rest[:end], rest[end:]
+		}
+		if term == "" || term == "-" || seen[strings.ToLower(term)] {
+			continue
+		}
+		seen[strings.ToLower(term)] = true
+		if negated {
+			exclude = append(exclude, term)
+		} else {
+			include = append(include, term)
+		}
+	}
+	return include, exclude
+}
@@ -0,0 +1,68 @@
+package routes
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseKeywordTerms(t *testing.T) {
+	tests := []struct {
+		name        string
+		keyword     string
+		wantInclude []string
+		wantExclude []string
+	}{
+		{"empty", "   ", nil, nil},
+		{"words", "golang  remote", []string{"golang", "remote"}, nil},
+		{"phrase and exclusion", `"machine learning" remote -intern`, []string{"machine learning", "remote"}, []string{"intern"}},
+		{"excluded phrase", `go -"part time"`, []string{"go"}, []string{"part time"}},
+		{"phrase spaces collapse", `"  senior   engineer "`, []string{"senior engineer"}, nil},
+		{"hyphenated word", "full-stack", []string{"full-stack"}, nil},
+		{"lone dash", "go - rust", []string{"go", "rust"}, nil},
+		{"empty phrase", `go ""`, []string{"go"}, nil},
+		{"repeats ignore case", "Go go -GO", []string{"Go"}, nil},
+		{"quote ends a word", `remote"senior dev"`, []string{"remote", "senior dev"}, nil},
+		{"unbalanced quotes", `"machine learning -intern`, []string{`"machine learning -intern`}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			include, exclude := parseKeywordTerms(tt.keyword)
+			if !reflect.DeepEqual(include, tt.wantInclude) || !reflect.DeepEqual(exclude, tt.wantExclude) {
+				t.Errorf("parseKeywordTerms(%q) = %q, %q, want %q, %q", tt.keyword, include, exclude, tt.wantInclude, tt.wantExclude)
+			}
+		})
+	}
+}
+
+func TestParseJobFilters(t *testing.T) {
+	tests := []struct {
+		name    string
+		filters jobFilters
+		wantErr string
+	}{
+		{"trimmed", jobFilters{Category: " engineering ", Keyword: " go -php ", Location: " Berlin"}, ""},
+		{"long location", jobFilters{Location: strings.Repeat("x", maxJobFilterLength+1)}, "location must be at most 100 characters"},
+		{"too many terms", jobFilters{Keyword: "a b c d e f g h i -j -k"}, "keyword must have at most 10 terms"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := parseJobFilters(tt.filters)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Errorf("parseJobFilters() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseJobFilters() error = %v", err)
+			}
+			if req.Category != "engineering" || req.Keyword != "go -php" || req.Location != "Berlin" {
+				t.Errorf("parseJobFilters() = %v, want trimmed filters", req)
+			}
+			if !reflect.DeepEqual(req.IncludeTerms, []string{"go"}) || !reflect.DeepEqual(req.ExcludeTerms, []string{"php"}) {
+				t.Errorf("terms = %q, %q", req.IncludeTerms, req.ExcludeTerms)
+			}
+		})
+	}
+}
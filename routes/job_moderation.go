@@ -0,0 +1,140 @@
+package routes
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	jobpb "github.com/shahal0/skillsync-protos/gen/jobpb"
+
+	"skillsync-api-gateway/clients"
+	"skillsync-api-gateway/utils"
+)
+
+const (
+	// jobReportLimit is how many postings a candidate can report per jobReportWindow
+	jobReportLimit  = 10
+	jobReportWindow = time.Hour
+)
+
+// moderationQueues are the job lists admins work through
+var moderationQueues = map[string]bool{"pending_review": true, "reported": true}
+
+// ReportJob lets a candidate flag a posting as spam, a scam or otherwise unfit;
+// reported postings are reviewed under GET /admin/jobs?status=reported
+func ReportJob(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in context"})
+		return
+	}
+	jobID, err := strconv.ParseUint(c.Param("job_id"), 10, 64)
+	if err != nil || jobID == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid job ID"})
+		return
+	}
+	var body struct {
+		Reason  string `json:"reason" binding:"required,oneof=spam scam misleading discriminatory inappropriate other"`
+		Details string `json:"details" binding:"max=1000"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		utils.RespondWithValidationError(c, err)
+		return
+	}
+	resp, err := clients.JobServiceClient.ReportJob(candidateContext(c, userID.(string)), &jobpb.ReportJobRequest{
+		JobId:      jobID,
+		ReporterId: userID.(string),
+		Reason:     body.Reason,
+		Details:    strings.TrimSpace(body.Details),
+	})
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to report job: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+	c.JSON(http.StatusCreated, gin.H{"report_id": resp.GetReportId()})
+}
+
+// ListModerationJobs lists the jobs waiting for review or reported by candidates,
+// oldest first
+func ListModerationJobs(c *gin.Context) {
+	queue := c.Query("status")
+	if !moderationQueues[queue] {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "status must be one of pending_review, reported"})
+		return
+	}
+	req := jobpb.ListJobsForModerationRequest{Status: queue, Page: 1, Limit: 20}
+	if page, err := strconv.Atoi(c.DefaultQuery("page", "1")); err == nil && page > 0 {
+		req.Page = int32(page)
+	}
+	if limit, err := strconv.Atoi(c.DefaultQuery("limit", "20")); err == nil && limit > 0 && limit <= 100 {
+		req.Limit = int32(limit)
+	}
+	resp, err := clients.JobServiceClient.ListJobsForModeration(adminContext(c), &req)
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to list jobs: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// moderationNotices are what the employer is told after each action; %s is the job title
+var moderationNotices = map[string]struct{ title, message string }{
+	"approve":  {"Job approved", "Your job %q is now live."},
+	"reject":   {"Job rejected", "Your job %q was not approved: %s"},
+	"takedown": {"Job taken down", "Your job %q was taken down: %s"},
+}
+
+// ModerateJob returns the handler for an admin decision on a job: approve or
+// reject a posting under review, or take down a published one. Rejections and
+// takedowns need a reason, which is passed on to the employer.
+func ModerateJob(action string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		jobID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+		if err != nil || jobID == 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid job ID"})
+			return
+		}
+		var body struct {
+			Reason string `json:"reason" binding:"max=1000"`
+		}
+		// The body is optional when approving
+		if c.Request.ContentLength != 0 {
+			if err := c.ShouldBindJSON(&body); err != nil {
+				utils.RespondWithValidationError(c, err)
+				return
+			}
+		}
+		reason := strings.TrimSpace(body.Reason)
+		if action != "approve" && reason == "" {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "A reason is required to " + action + " a job"})
+			return
+		}
+
+		resp, err := clients.JobServiceClient.ModerateJob(adminContext(c), &jobpb.ModerateJobRequest{
+			JobId:   jobID,
+			Action:  action,
+			Reason:  reason,
+			AdminId: c.GetString("user_id"),
+		})
+		if err != nil {
+			c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to " + action + " job: " + utils.GRPCErrorMessage(err)})
+			return
+		}
+		// The job joins or leaves the public listings now, not when the cache expires
+		invalidateJobCaches()
+
+		job := resp.GetJob()
+		notice := moderationNotices[action]
+		message := fmt.Sprintf(notice.message, job.GetTitle(), reason)
+		if action == "approve" {
+			message = fmt.Sprintf(notice.message, job.GetTitle())
+		}
+		notifyUser(c.Request.Context(), job.GetEmployerId(), "job_moderation", notice.title, message, strconv.FormatUint(jobID, 10))
+
+		recordAudit(c, "admin.job_"+action, "job:"+strconv.FormatUint(jobID, 10), map[string]string{"reason": reason})
+		c.JSON(http.StatusOK, resp)
+	}
+}
@@ -0,0 +1,45 @@
+package routes
+
+import (
+	"strings"
+
+	jobpb "github.com/shahal0/skillsync-protos/gen/jobpb"
+
+	"skillsync-api-gateway/utils"
+)
+
+// jobDefinition is what describes a job, whether it is posted or saved as a template
+type jobDefinition struct {
+	Title              string            `json:"title" binding:"required,min=3,max=200"`
+	Description        string            `json:"description" binding:"required,max=10000"`
+	Category           string            `json:"category" binding:"required,job_category"`
+	Location           string            `json:"location" binding:"max=100"`
+	SalaryMin          int64             `json:"salary_min" binding:"min=0"`
+	SalaryMax          int64             `json:"salary_max" binding:"omitempty,min=0,gtefield=SalaryMin"`
+	ExperienceRequired int32             `json:"experience_required" binding:"min=0,max=50"`
+	RequiredSkills     []*jobpb.JobSkill `json:"required_skills" binding:"max=50"`
+}
+
+// postJobRequest is the validated body for posting a job. Binding it instead of the
+// proto lets the gateway reject bad postings with field-level errors before the backend sees them.
+type postJobRequest struct {
+	jobDefinition
+	Deadline string `json:"deadline" binding:"omitempty,job_deadline"`
+}
+
+// toProto converts a validated request, taking the employer from the token rather than the body
+func (r *postJobRequest) toProto(employerID string) *jobpb.PostJobRequest {
+	category, _ := utils.NormalizeJobCategory(r.Category)
+	return &jobpb.PostJobRequest{
+		EmployerId:         employerID,
+		Title:              strings.TrimSpace(r.Title),
+		Description:        strings.TrimSpace(r.Description),
+		Category:           category,
+		Location:           strings.TrimSpace(r.Location),
+		SalaryMin:          r.SalaryMin,
+		SalaryMax:          r.SalaryMax,
+		ExperienceRequired: r.ExperienceRequired,
+		RequiredSkills:     r.RequiredSkills,
+		Deadline:           strings.TrimSpace(r.Deadline),
+	}
+}
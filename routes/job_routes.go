@@ -4,6 +4,8 @@ import (
 	"context"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	jobpb "github.com/shahal0/skillsync-protos/gen/jobpb"
@@ -11,27 +13,78 @@ import (
 
 	"skillsync-api-gateway/clients"
 	"skillsync-api-gateway/middlewares"
+	"skillsync-api-gateway/utils"
+)
+
+// idempotencyWindow is how long a retried Idempotency-Key replays the first response
+const idempotencyWindow = 24 * time.Hour
+
+// Fields selectable with ?fields= on job and application reads; company_name and
+// company_logo are added by enrichJobs
+var (
+	jobFieldSelector         = utils.NewFieldSelector(&jobpb.Job{}, "company_name", "company_logo")
+	applicationFieldSelector = utils.NewFieldSelector(&jobpb.Application{})
 )
 
 func SetupJobRoutes(r *gin.Engine) {
+	idempotent := middlewares.Idempotency(middlewares.DefaultIdempotencyStore, idempotencyWindow)
+	startJobViewFlush()
 	
 	publicJobs := r.Group("/jobs")
+	publicJobs.Use(middlewares.Maintenance("job"), middlewares.OptionalAPIKey(), middlewares.Canary(), middlewares.PublicCache(publicCacheMaxAge))
 	{
 		publicJobs.GET("/", GetJobs)       
 		publicJobs.GET("/get", GetJobById) 
+		publicJobs.GET("/feed.rss", GetJobsRSSFeed)
+		publicJobs.GET("/feed.json", GetJobsJSONFeed)
+	}
+
+	// Partner integrations post jobs with an API key; GET /jobs/ takes one through OptionalAPIKey
+	keyedJobs := r.Group("/jobs")
+	keyedJobs.Use(middlewares.Maintenance("job"), middlewares.JWTOrAPIKeyMiddleware(), middlewares.ReadOnlyForViewers())
+	{
+		keyedJobs.POST("/post", idempotent, PostJob)
 	}
 
 	protectedJobs := r.Group("/jobs")
-	protectedJobs.Use(middlewares.JWTMiddleware())
+	protectedJobs.Use(middlewares.Maintenance("job"), middlewares.JWTMiddleware(), middlewares.ReadOnlyForViewers())
 	{
-		protectedJobs.POST("/post", PostJob)
-		protectedJobs.POST("/apply", ApplyToJob)
+		protectedJobs.POST("/bulk", middlewares.RequireRole("employer"), BulkPostJobs)
+		protectedJobs.POST("/templates", middlewares.RequireRole("employer"), CreateJobTemplate)
+		protectedJobs.GET("/templates", middlewares.RequireRole("employer"), GetJobTemplates)
+		protectedJobs.DELETE("/templates/:id", middlewares.RequireRole("employer"), DeleteJobTemplate)
+		protectedJobs.POST("/alerts", middlewares.RequireRole("candidate"), CreateJobAlert)
+		protectedJobs.GET("/alerts", middlewares.RequireRole("candidate"), GetJobAlerts)
+		protectedJobs.DELETE("/alerts/:id", middlewares.RequireRole("candidate"), DeleteJobAlert)
+		protectedJobs.POST("/apply", idempotent, ApplyToJob)
 		protectedJobs.POST("/addskills", AddJobSkills)                
 		protectedJobs.PUT("/status", UpdateJobStatus)                  
+		protectedJobs.PUT("/application/:id/status", middlewares.RequireRole("employer"), UpdateApplicationStatus)
+		protectedJobs.PUT("/application/:id/seen", middlewares.RequireRole("employer"), MarkApplicationSeen)
+		protectedJobs.POST("/application/:id/notes", middlewares.RequireRole("employer"), AddApplicationNote)
+		protectedJobs.GET("/application/:id/notes", middlewares.RequireRole("employer"), GetApplicationNotes)
+		protectedJobs.DELETE("/application/notes/:note_id", middlewares.RequireRole("employer"), DeleteApplicationNote)
+		protectedJobs.PUT("/application/:id/rating", middlewares.RequireRole("employer"), RateApplication)
+		protectedJobs.GET("/application/:id/insights", middlewares.RequireRole("candidate"), GetApplicationInsights)
+		protectedJobs.GET("/applications/inbox", middlewares.RequireRole("employer"), GetApplicationsInbox)
 		protectedJobs.GET("/applications", GetCandidateApplications)  
 		protectedJobs.GET("/application", GetApplication)              
 		protectedJobs.GET("/filter-applications", FilterApplications)
 		protectedJobs.GET("/applications-by-job", GetApplicationsByJob) 
+		protectedJobs.GET("/employer/stats", middlewares.RequireRole("employer"), GetEmployerStats)
+		protectedJobs.POST("/application/:id/interview", middlewares.RequireRole("employer"), ScheduleInterview)
+		protectedJobs.GET("/application/:id/interviews", GetApplicationInterviews)
+		protectedJobs.PUT("/interview/:id", UpdateInterview)
+		protectedJobs.POST("/interview/:id/feedback", middlewares.RequireRole("employer"), middlewares.FeatureGate(interviewFeedbackFlag), SubmitInterviewFeedback)
+		protectedJobs.GET("/interview/:id/feedback", middlewares.RequireRole("employer"), middlewares.FeatureGate(interviewFeedbackFlag), GetInterviewFeedback)
+		protectedJobs.PUT("/:job_id/skills", middlewares.RequireRole("employer"), ReplaceJobSkills)
+		protectedJobs.DELETE("/:job_id/skills/:skill", middlewares.RequireRole("employer"), RemoveJobSkill)
+		protectedJobs.GET("/:job_id/analytics", middlewares.RequireRole("employer"), GetJobAnalytics)
+		protectedJobs.POST("/:job_id/screening-rules", middlewares.RequireRole("employer"), SaveScreeningRules)
+		protectedJobs.GET("/:job_id/screening-rules", middlewares.RequireRole("employer"), GetScreeningRules)
+		protectedJobs.DELETE("/:job_id/screening-rules", middlewares.RequireRole("employer"), DeleteScreeningRules)
+		protectedJobs.POST("/:job_id/screening-rules/test", middlewares.RequireRole("employer"), TestScreeningRules)
+		protectedJobs.POST("/:job_id/report", middlewares.RequireRole("candidate"), middlewares.RateLimitPerUser(jobReportLimit, jobReportWindow), ReportJob)
 	}
 }
 
@@ -41,20 +94,27 @@ func PostJob(c *gin.Context) {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in context"})
 		return
 	}
-	var req jobpb.PostJobRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	var body postJobRequest
+	if c.Query("from_template") != "" {
+		if !bindJobFromTemplate(c, userID.(string), &body) {
+			return
+		}
+	} else if err := c.ShouldBindJSON(&body); err != nil {
+		utils.RespondWithValidationError(c, err)
 		return
 	}
-	req.EmployerId = userID.(string)
+	if !withinActiveJobLimit(c, userID.(string), 1) {
+		return
+	}
+	req := body.toProto(userID.(string))
 	ctx := metadata.NewOutgoingContext(
-		context.Background(),
+		c.Request.Context(),
 		metadata.New(map[string]string{
 			"user-id": userID.(string),
 			"role":    "employer",
 		}),
 	)
-	resp, err := clients.JobServiceClient.PostJob(ctx, &req)
+	resp, err := clients.JobServiceClient.PostJob(ctx, req)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
@@ -63,25 +123,32 @@ func PostJob(c *gin.Context) {
 }
 
 func GetJobs(c *gin.Context) {
-	var req jobpb.GetJobsRequest
-	
 	// Handle query parameters directly
-	if c.Query("category") != "" {
-		req.Category = c.Query("category")
-	}
-	if c.Query("keyword") != "" {
-		req.Keyword = c.Query("keyword")
+	req, err := parseJobFilters(jobFilters{
+		Category: c.Query("category"),
+		Keyword:  c.Query("keyword"),
+		Location: c.Query("location"),
+	})
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
 	}
-	if c.Query("location") != "" {
-		req.Location = c.Query("location")
+	includeExpired, err := strconv.ParseBool(c.DefaultQuery("include_expired", "false"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "include_expired must be true or false"})
+		return
 	}
-	
-	resp, err := clients.JobServiceClient.GetJobs(context.Background(), &req)
+
+	body, err := cachedJobListing(c.Request.Context(), req)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to get jobs: " + utils.GRPCErrorMessage(err)})
 		return
 	}
-	c.JSON(http.StatusOK, resp)
+	if !includeExpired {
+		body = withoutExpiredJobs(body)
+	}
+	utils.WarnPartial(c, body)
+	utils.RespondWithFields(c, http.StatusOK, body, "jobs", jobFieldSelector)
 }
 
 func ApplyToJob(c *gin.Context) {
@@ -91,13 +158,21 @@ func ApplyToJob(c *gin.Context) {
 		return
 	}
 	var req jobpb.ApplyToJobRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	if err := utils.BindProto(c, &req); err != nil {
+		utils.RespondWithValidationError(c, err)
 		return
 	}
 	req.CandidateId = userID.(string)
+	if reason := jobClosedReason(c.Request.Context(), req.JobId); reason != "" {
+		c.JSON(http.StatusGone, gin.H{
+			"error":      "This job is no longer accepting applications",
+			"error_code": "job_closed",
+			"reason":     reason,
+		})
+		return
+	}
 	ctx := metadata.NewOutgoingContext(
-		context.Background(),
+		c.Request.Context(),
 		metadata.New(map[string]string{
 			"user-id": userID.(string),
 			"role":    "candidate",
@@ -108,6 +183,13 @@ func ApplyToJob(c *gin.Context) {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to apply to job: " + err.Error()})
 		return
 	}
+	// The employer is resolved from the job by the webhook worker, off the request path
+	publishApplicationEvent("application.created", "", req.JobId, gin.H{
+		"application_id": resp.GetApplicationId(),
+		"job_id":         req.JobId,
+		"candidate_id":   req.CandidateId,
+	})
+	screenApplication(c.Request.Context(), req.JobId, resp.GetApplicationId(), req.CandidateId)
 	c.JSON(http.StatusCreated, resp)
 }
 
@@ -118,12 +200,20 @@ func AddJobSkills(c *gin.Context) {
 		return
 	}
 	var req jobpb.AddJobSkillsRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	if err := utils.BindProto(c, &req); err != nil {
+		utils.RespondWithValidationError(c, err)
+		return
+	}
+	// Skills are stored under their canonical names, once each
+	submitted := len(req.Skills)
+	var unrecognized []string
+	req.Skills, unrecognized = normalizeJobSkills(req.Skills)
+	if submitted > 0 && len(req.Skills) == 0 {
+		respondUnrecognizedSkills(c, unrecognized)
 		return
 	}
 	ctx := metadata.NewOutgoingContext(
-		context.Background(),
+		c.Request.Context(),
 		metadata.New(map[string]string{
 			"user-id": userID.(string),
 			"role":    "employer",
@@ -134,7 +224,7 @@ func AddJobSkills(c *gin.Context) {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to add skills to job: " + err.Error()})
 		return
 	}
-	c.JSON(http.StatusOK, resp)
+	c.JSON(http.StatusOK, withUnrecognizedSkills(resp, unrecognized))
 }
 
 func UpdateJobStatus(c *gin.Context) {
@@ -152,12 +242,24 @@ func UpdateJobStatus(c *gin.Context) {
 	var req jobpb.UpdateJobStatusRequest
 	
 	// Handle query parameters directly
-	req.JobId = c.Query("job_id")
-	req.Status = c.Query("status")
+	req.JobId = strings.TrimSpace(c.Query("job_id"))
+	if req.JobId == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "job_id is required"})
+		return
+	}
+	status, err := utils.JobStatuses().Normalize(c.Query("status"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":           err.Error(),
+			"accepted_values": utils.JobStatuses().Allowed(),
+		})
+		return
+	}
+	req.Status = status
 	
 	req.EmployerId = userID.(string)
 	ctx := metadata.NewOutgoingContext(
-		context.Background(),
+		c.Request.Context(),
 		metadata.New(map[string]string{
 			"user-id": userID.(string),
 			"role":    userRole.(string),
@@ -168,12 +270,11 @@ func UpdateJobStatus(c *gin.Context) {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
+	recordAudit(c, "job.status_change", "job:"+req.JobId, map[string]string{"status": req.Status})
 	c.JSON(http.StatusOK, resp)
 }
 
 func GetJobById(c *gin.Context) {
-	var req jobpb.GetJobByIdRequest
-	
 	// Handle query parameters directly
 	jobIDStr := c.Query("id")
 	jobID, err := strconv.ParseUint(jobIDStr, 10, 64)
@@ -181,13 +282,15 @@ func GetJobById(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid job ID"})
 		return
 	}
-	req.JobId = jobID
-	resp, err := clients.JobServiceClient.GetJobById(context.Background(), &req)
+
+	body, err := cachedJobDetail(c.Request.Context(), jobID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to get job: " + utils.GRPCErrorMessage(err)})
 		return
 	}
-	c.JSON(http.StatusOK, resp)
+	recordJobView(c, jobID, body)
+	utils.WarnPartial(c, body)
+	utils.RespondWithFields(c, http.StatusOK, body, "job", jobFieldSelector)
 }
 
 func GetCandidateApplications(c *gin.Context) {
@@ -209,11 +312,19 @@ func GetCandidateApplications(c *gin.Context) {
 	
 	// Handle query parameters directly
 	if c.Query("status") != "" {
-		req.Status = c.Query("status")
+		status, err := utils.ApplicationStatuses().Normalize(c.Query("status"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":           err.Error(),
+				"accepted_values": utils.ApplicationStatuses().Allowed(),
+			})
+			return
+		}
+		req.Status = status
 	}
 	req.CandidateId = userID.(string)
 	ctx := metadata.NewOutgoingContext(
-		context.Background(),
+		c.Request.Context(),
 		metadata.New(map[string]string{
 			"user-id": userID.(string),
 			"role":    userRole.(string),
@@ -224,7 +335,16 @@ func GetCandidateApplications(c *gin.Context) {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get applications: " + err.Error()})
 		return
 	}
-	c.JSON(http.StatusOK, resp)
+	if userRole.(string) == "candidate" {
+		body, err := redactForCandidate(resp)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to encode applications"})
+			return
+		}
+		utils.RespondWithFields(c, http.StatusOK, body, "applications", applicationFieldSelector)
+		return
+	}
+	utils.RespondWithFields(c, http.StatusOK, resp, "applications", applicationFieldSelector)
 }
 
 func GetApplicationsByJob(c *gin.Context) {
@@ -250,22 +370,43 @@ func GetApplicationsByJob(c *gin.Context) {
 	req.JobId = jobID
 	
 	if c.Query("status") != "" {
-		req.Status = c.Query("status")
+		status, err := utils.ApplicationStatuses().Normalize(c.Query("status"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":           err.Error(),
+				"accepted_values": utils.ApplicationStatuses().Allowed(),
+			})
+			return
+		}
+		req.Status = status
 	}
 	// EmployerId field doesn't exist in GetApplicationsRequest
 	ctx := metadata.NewOutgoingContext(
-		context.Background(),
+		c.Request.Context(),
 		metadata.New(map[string]string{
 			"user-id": userID.(string),
 			"role":    userRole.(string),
 		}),
 	)
+	if streamRequested(c) {
+		streamList(c, ctx, "applications",
+			func(ctx context.Context, page int32) ([]*jobpb.ApplicationResponse, int64, error) {
+				pageReq := &jobpb.GetApplicationsRequest{JobId: req.JobId, Status: req.Status, Page: page, Limit: streamPageSize}
+				resp, err := clients.JobServiceClient.GetApplications(ctx, pageReq)
+				return resp.GetApplications(), int64(resp.GetTotal()), err
+			},
+			func(application *jobpb.ApplicationResponse) interface{} { return application },
+			func(err error) {
+				c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to fetch applications: " + utils.GRPCErrorMessage(err)})
+			})
+		return
+	}
 	resp, err := clients.JobServiceClient.GetApplications(ctx, &req)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch applications: " + err.Error()})
 		return
 	}
-	c.JSON(http.StatusOK, resp)
+	utils.RespondWithFields(c, http.StatusOK, resp, "applications", applicationFieldSelector)
 }
 
 func GetApplication(c *gin.Context) {
@@ -291,7 +432,7 @@ func GetApplication(c *gin.Context) {
 	}
 	req.ApplicationId = applicationID
 	ctx := metadata.NewOutgoingContext(
-		context.Background(),
+		c.Request.Context(),
 		metadata.New(map[string]string{
 			"user-id": userID.(string),
 			"role":    userRole.(string),
@@ -311,6 +452,20 @@ func GetApplication(c *gin.Context) {
 		c.JSON(http.StatusNotFound, gin.H{"error": "Application not found"})
 		return
 	}
+	// Notes and ratings are the employer's own; candidates only see their application
+	if userRole.(string) == "candidate" {
+		body, err := redactForCandidate(resp)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to encode application"})
+			return
+		}
+		c.JSON(http.StatusOK, body)
+		return
+	}
+	if userRole.(string) == "employer" {
+		respondApplicationWithCandidate(c, resp)
+		return
+	}
 
 	
 	c.JSON(http.StatusOK, resp)
@@ -332,14 +487,14 @@ func FilterApplications(c *gin.Context) {
 	}
 
 	var req jobpb.FilterApplicationsRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	if err := utils.BindProto(c, &req); err != nil {
+		utils.RespondWithValidationError(c, err)
 		return
 	}
 	req.EmployerId = userID.(string)
 
 	ctx := metadata.NewOutgoingContext(
-		context.Background(),
+		c.Request.Context(),
 		metadata.New(map[string]string{
 			"user-id": userID.(string),
 			"role":    userRole.(string),
@@ -0,0 +1,108 @@
+package routes
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	jobpb "github.com/shahal0/skillsync-protos/gen/jobpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"skillsync-api-gateway/clients"
+	"skillsync-api-gateway/utils"
+)
+
+// dedupeJobSkills drops blank entries and repeated skill names (case-insensitive),
+// keeping the first occurrence
+func dedupeJobSkills(skills []*jobpb.JobSkill) []*jobpb.JobSkill {
+	seen := make(map[string]bool, len(skills))
+	unique := make([]*jobpb.JobSkill, 0, len(skills))
+	for _, skill := range skills {
+		name := strings.TrimSpace(skill.GetSkill())
+		key := strings.ToLower(name)
+		if name == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		skill.Skill = name
+		unique = append(unique, skill)
+	}
+	return unique
+}
+
+// jobOwnerContext forwards the employer so the job service can enforce job ownership
+func jobOwnerContext(c *gin.Context, employerID string) context.Context {
+	return metadata.NewOutgoingContext(
+		c.Request.Context(),
+		metadata.New(map[string]string{
+			"user-id": employerID,
+			"role":    "employer",
+		}),
+	)
+}
+
+func RemoveJobSkill(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in context"})
+		return
+	}
+	jobID := c.Param("job_id")
+	skill := strings.TrimSpace(c.Param("skill"))
+	if jobID == "" || skill == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Job ID and skill are required"})
+		return
+	}
+
+	resp, err := clients.JobServiceClient.RemoveJobSkill(jobOwnerContext(c, userID.(string)), &jobpb.RemoveJobSkillRequest{
+		JobId:      jobID,
+		Skill:      skill,
+		EmployerId: userID.(string),
+	})
+	if err != nil {
+		// Removing a skill the job doesn't have is a no-op
+		if status.Code(err) == codes.NotFound {
+			c.JSON(http.StatusOK, gin.H{"message": "Skill was not on the job", "removed": false})
+			return
+		}
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to remove skill from job: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": resp.GetMessage(), "removed": true})
+}
+
+func ReplaceJobSkills(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	var body struct {
+		Skills []*jobpb.JobSkill `json:"skills"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	submitted := len(body.Skills)
+	skillList, unrecognized := normalizeJobSkills(body.Skills)
+	if submitted > 0 && len(skillList) == 0 {
+		respondUnrecognizedSkills(c, unrecognized)
+		return
+	}
+
+	resp, err := clients.JobServiceClient.ReplaceJobSkills(jobOwnerContext(c, userID.(string)), &jobpb.ReplaceJobSkillsRequest{
+		JobId:      c.Param("job_id"),
+		Skills:     skillList,
+		EmployerId: userID.(string),
+	})
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to replace job skills: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+	c.JSON(http.StatusOK, withUnrecognizedSkills(resp, unrecognized))
+}
@@ -0,0 +1,197 @@
+package routes
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	jobpb "github.com/shahal0/skillsync-protos/gen/jobpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"skillsync-api-gateway/clients"
+	"skillsync-api-gateway/utils"
+)
+
+// maxJobTemplateBodySize bounds the body read when posting from a template
+const maxJobTemplateBodySize = 1 << 20
+
+// jobTemplateRequest saves a job definition under a name. Deadlines are left out,
+// since a template is reused long after it is saved.
+type jobTemplateRequest struct {
+	Name string `json:"name" binding:"required,max=100"`
+	jobDefinition
+}
+
+// jobTemplateDefinition is the job a template describes
+func jobTemplateDefinition(template *jobpb.JobTemplate) jobDefinition {
+	return jobDefinition{
+		Title:              template.GetTitle(),
+		Description:        template.GetDescription(),
+		Category:           template.GetCategory(),
+		Location:           template.GetLocation(),
+		SalaryMin:          template.GetSalaryMin(),
+		SalaryMax:          template.GetSalaryMax(),
+		ExperienceRequired: template.GetExperienceRequired(),
+		RequiredSkills:     template.GetRequiredSkills(),
+	}
+}
+
+func jobTemplateSummary(template *jobpb.JobTemplate) gin.H {
+	return gin.H{
+		"id":         template.GetId(),
+		"name":       template.GetName(),
+		"title":      template.GetTitle(),
+		"category":   template.GetCategory(),
+		"location":   template.GetLocation(),
+		"created_by": template.GetCreatedBy(),
+		"created_at": template.GetCreatedAt(),
+	}
+}
+
+// CreateJobTemplate saves a job definition as one of the employer's templates.
+// Team members share the account's templates.
+func CreateJobTemplate(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in context"})
+		return
+	}
+	var body jobTemplateRequest
+	if err := c.ShouldBindJSON(&body); err != nil {
+		utils.RespondWithValidationError(c, err)
+		return
+	}
+	name := strings.TrimSpace(body.Name)
+	if name == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "name must not be blank"})
+		return
+	}
+	// The author is the member saving it, the template the company's
+	createdBy := c.GetString("member_id")
+	if createdBy == "" {
+		createdBy = userID.(string)
+	}
+	job := postJobRequest{jobDefinition: body.jobDefinition}
+	definition := job.toProto(userID.(string))
+	resp, err := clients.JobServiceClient.CreateJobTemplate(jobOwnerContext(c, userID.(string)), &jobpb.CreateJobTemplateRequest{
+		EmployerId: userID.(string),
+		CreatedBy:  createdBy,
+		Template: &jobpb.JobTemplate{
+			Name:               name,
+			Title:              definition.GetTitle(),
+			Description:        definition.GetDescription(),
+			Category:           definition.GetCategory(),
+			Location:           definition.GetLocation(),
+			SalaryMin:          definition.GetSalaryMin(),
+			SalaryMax:          definition.GetSalaryMax(),
+			ExperienceRequired: definition.GetExperienceRequired(),
+			RequiredSkills:     definition.GetRequiredSkills(),
+		},
+	})
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to save job template: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+	c.JSON(http.StatusCreated, resp.GetTemplate())
+}
+
+// GetJobTemplates lists summaries of the employer's templates
+func GetJobTemplates(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in context"})
+		return
+	}
+	resp, err := clients.JobServiceClient.ListJobTemplates(jobOwnerContext(c, userID.(string)), &jobpb.ListJobTemplatesRequest{
+		EmployerId: userID.(string),
+	})
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to list job templates: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+	templates := make([]gin.H, 0, len(resp.GetTemplates()))
+	for _, template := range resp.GetTemplates() {
+		templates = append(templates, jobTemplateSummary(template))
+	}
+	c.JSON(http.StatusOK, gin.H{"templates": templates})
+}
+
+// DeleteJobTemplate removes one of the employer's templates
+func DeleteJobTemplate(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in context"})
+		return
+	}
+	templateID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || templateID == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid template ID"})
+		return
+	}
+	_, err = clients.JobServiceClient.DeleteJobTemplate(jobOwnerContext(c, userID.(string)), &jobpb.DeleteJobTemplateRequest{
+		TemplateId: templateID,
+		EmployerId: userID.(string),
+	})
+	if status.Code(err) == codes.NotFound {
+		respondTemplateNotFound(c)
+		return
+	}
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to delete job template: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// bindJobFromTemplate fills body from the employer's template from_template, then
+// lets the request body override any field, and validates the result like any
+// other posting. It answers the request and reports false when that fails.
+func bindJobFromTemplate(c *gin.Context, employerID string, body *postJobRequest) bool {
+	templateID, err := strconv.ParseUint(c.Query("from_template"), 10, 64)
+	if err != nil || templateID == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid template ID"})
+		return false
+	}
+	resp, err := clients.JobServiceClient.GetJobTemplate(jobOwnerContext(c, employerID), &jobpb.GetJobTemplateRequest{
+		TemplateId: templateID,
+		EmployerId: employerID,
+	})
+	if status.Code(err) == codes.NotFound || (err == nil && resp.GetTemplate() == nil) {
+		respondTemplateNotFound(c)
+		return false
+	}
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to get job template: " + utils.GRPCErrorMessage(err)})
+		return false
+	}
+	body.jobDefinition = jobTemplateDefinition(resp.GetTemplate())
+
+	// Posting a template as it is needs no body
+	overrides, err := io.ReadAll(io.LimitReader(c.Request.Body, maxJobTemplateBodySize))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Failed to read request body"})
+		return false
+	}
+	if len(bytes.TrimSpace(overrides)) == 0 {
+		overrides = []byte("{}")
+	}
+	c.Request.Body = io.NopCloser(bytes.NewReader(overrides))
+	if err := c.ShouldBindJSON(body); err != nil {
+		utils.RespondWithValidationError(c, err)
+		return false
+	}
+	return true
+}
+
+// respondTemplateNotFound answers for a template that doesn't exist, was deleted or
+// belongs to another employer
+func respondTemplateNotFound(c *gin.Context) {
+	c.JSON(http.StatusNotFound, gin.H{
+		"error":      "Job template not found",
+		"error_code": "template_not_found",
+	})
+}
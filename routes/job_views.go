@@ -0,0 +1,218 @@
+package routes
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	jobpb "github.com/shahal0/skillsync-protos/gen/jobpb"
+
+	"skillsync-api-gateway/clients"
+	"skillsync-api-gateway/middlewares"
+	"skillsync-api-gateway/utils"
+	"skillsync-api-gateway/utils/hll"
+)
+
+const jobViewFlushTimeout = 10 * time.Second
+
+// botUserAgentParts mark obvious crawlers, whose views aren't counted
+var botUserAgentParts = []string{"bot", "crawler", "spider", "slurp", "curl", "wget", "python-requests", "headless"}
+
+// pendingJobViews are one job's views not yet written to the job service
+type pendingJobViews struct {
+	views   int64
+	viewers hll.Sketch
+}
+
+var (
+	jobViewsMutex sync.Mutex
+	jobViews      = map[uint64]*pendingJobViews{}
+	jobViewsCount int
+
+	jobViewFlushStart sync.Once
+	jobViewFlushStop  = make(chan struct{})
+	jobViewFlushEnd   sync.Once
+	jobViewFlushLoop  sync.WaitGroup
+	// jobViewFlushNow asks the loop for an early flush once enough views are waiting
+	jobViewFlushNow = make(chan struct{}, 1)
+)
+
+// startJobViewFlush flushes buffered views every JOB_VIEW_FLUSH_INTERVAL, or sooner
+// once JOB_VIEW_FLUSH_THRESHOLD are waiting, until stopJobViewFlush
+func startJobViewFlush() {
+	jobViewFlushStart.Do(func() {
+		jobViewFlushLoop.Add(1)
+		go func() {
+			defer jobViewFlushLoop.Done()
+			ticker := time.NewTicker(cfg.JobViews.FlushInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ticker.C:
+				case <-jobViewFlushNow:
+				case <-jobViewFlushStop:
+					return
+				}
+				ctx, cancel := context.WithTimeout(context.Background(), jobViewFlushTimeout)
+				if err := flushJobViews(ctx); err != nil {
+					log.Printf("Job view flush failed, keeping the views for the next one: %v", err)
+				}
+				cancel()
+			}
+		}()
+	})
+}
+
+// stopJobViewFlush ends the flush loop and writes what is left, so views aren't
+// lost on shutdown
+func stopJobViewFlush(ctx context.Context) error {
+	jobViewFlushEnd.Do(func() { close(jobViewFlushStop) })
+	jobViewFlushLoop.Wait()
+	return flushJobViews(ctx)
+}
+
+// recordJobView counts a view of a job, unless it comes from a bot or from the
+// employer who posted it. Anonymous viewers are told apart by IP and User-Agent.
+func recordJobView(c *gin.Context, jobID uint64, body map[string]interface{}) {
+	userAgent := strings.ToLower(c.GetHeader("User-Agent"))
+	if userAgent == "" {
+		return
+	}
+	for _, part := range botUserAgentParts {
+		if strings.Contains(userAgent, part) {
+			return
+		}
+	}
+	viewer := c.GetString("user_id")
+	if viewer == "" {
+		viewer = bearerUserID(c)
+	}
+	if job, ok := body["job"].(map[string]interface{}); ok && viewer != "" && fmt.Sprint(job["employer_id"]) == viewer {
+		return
+	}
+	viewerKey := "user:" + viewer
+	if viewer == "" {
+		viewerKey = "anon:" + c.ClientIP() + "|" + userAgent
+	}
+
+	jobViewsMutex.Lock()
+	pending, ok := jobViews[jobID]
+	if !ok {
+		pending = &pendingJobViews{}
+		jobViews[jobID] = pending
+	}
+	pending.views++
+	pending.viewers.Add(viewerKey)
+	jobViewsCount++
+	full := jobViewsCount >= cfg.JobViews.FlushThreshold
+	jobViewsMutex.Unlock()
+
+	if full {
+		select {
+		case jobViewFlushNow <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// flushJobViews writes the buffered views to the job service in one call. If that
+// fails they are put back to be retried with the next flush.
+func flushJobViews(ctx context.Context) error {
+	jobViewsMutex.Lock()
+	batch := jobViews
+	jobViews = map[uint64]*pendingJobViews{}
+	jobViewsCount = 0
+	jobViewsMutex.Unlock()
+	if len(batch) == 0 {
+		return nil
+	}
+
+	counts := make([]*jobpb.JobViewCount, 0, len(batch))
+	for jobID, pending := range batch {
+		counts = append(counts, &jobpb.JobViewCount{
+			JobId:        jobID,
+			Views:        pending.views,
+			ViewerSketch: pending.viewers.Bytes(),
+		})
+	}
+	if _, err := clients.JobServiceClient.RecordJobViews(ctx, &jobpb.RecordJobViewsRequest{Counts: counts}); err != nil {
+		jobViewsMutex.Lock()
+		for jobID, pending := range batch {
+			if current, ok := jobViews[jobID]; ok {
+				current.views += pending.views
+				current.viewers.Merge(&pending.viewers)
+			} else {
+				jobViews[jobID] = pending
+			}
+			jobViewsCount += int(pending.views)
+		}
+		jobViewsMutex.Unlock()
+		return err
+	}
+	return nil
+}
+
+// bearerUserID is the user a valid bearer token on a public route belongs to, or ""
+func bearerUserID(c *gin.Context) string {
+	token, ok := strings.CutPrefix(c.GetHeader("Authorization"), "Bearer ")
+	if !ok {
+		return ""
+	}
+	claims := jwt.MapClaims{}
+	if _, err := middlewares.ParseToken(token, claims); err != nil {
+		return ""
+	}
+	userID, _ := claims["user_id"].(string)
+	return userID
+}
+
+// GetJobAnalytics reports a job's views, approximate distinct viewers and
+// applications to the employer who posted it. Views this instance hasn't flushed
+// yet are included.
+func GetJobAnalytics(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in context"})
+		return
+	}
+	jobID, err := strconv.ParseUint(c.Param("job_id"), 10, 64)
+	if err != nil || jobID == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid job ID"})
+		return
+	}
+	resp, err := clients.JobServiceClient.GetJobAnalytics(jobOwnerContext(c, userID.(string)), &jobpb.GetJobAnalyticsRequest{
+		JobId:      jobID,
+		EmployerId: userID.(string),
+	})
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to get job analytics: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+	viewers, err := hll.FromBytes(resp.GetViewerSketch())
+	if err != nil {
+		log.Printf("Job %d analytics: ignoring stored viewer sketch: %v", jobID, err)
+		viewers = &hll.Sketch{}
+	}
+	views := resp.GetViews()
+
+	jobViewsMutex.Lock()
+	if pending, ok := jobViews[jobID]; ok {
+		views += pending.views
+		viewers.Merge(&pending.viewers)
+	}
+	jobViewsMutex.Unlock()
+
+	c.JSON(http.StatusOK, gin.H{
+		"job_id":         jobID,
+		"views":          views,
+		"unique_viewers": viewers.Estimate(),
+		"applications":   resp.GetApplications(),
+	})
+}
@@ -0,0 +1,117 @@
+package routes
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"skillsync-api-gateway/clients"
+)
+
+// streamPageSize is how many items a streamed list fetches from its backend at a time
+const streamPageSize = 100
+
+// streamPageTimeout bounds each page of a stream whose route policy sets no timeout
+const streamPageTimeout = 10 * time.Second
+
+// streamRequested reports whether the client asked for a list as NDJSON with ?stream=true
+func streamRequested(c *gin.Context) bool {
+	return c.Query("stream") == "true"
+}
+
+// streamList writes a list as newline-delimited JSON, one item per line. Pages are
+// fetched one after another and each is flushed before the next is requested, so
+// only one page is held in memory however long the list. fetch returns a page's
+// items and the total across pages, 0 if the backend doesn't say; encode turns an
+// item into its line, or nil to leave it out. An error on the first page is
+// answered by respondError with a status; a later one ends the stream with an
+// {"error": ...} line.
+//
+// A stream can outlast the route's policy timeout, so the timeout applies to each
+// page instead. fetch gets a context derived from ctx, without its deadline, that
+// is cancelled when the client goes.
+func streamList[T any](c *gin.Context, ctx context.Context, name string, fetch func(ctx context.Context, page int32) ([]T, int64, error), encode func(T) interface{}, respondError func(error)) {
+	streamCtx, cancelStream := context.WithCancel(context.WithoutCancel(ctx))
+	defer cancelStream()
+	requestCtx := c.Request.Context()
+	stop := context.AfterFunc(requestCtx, func() {
+		// The policy deadline passing isn't the client leaving
+		if errors.Is(requestCtx.Err(), context.Canceled) {
+			cancelStream()
+		}
+	})
+	defer stop()
+	timeout := streamPageTimeout
+	if policy, ok := clients.PolicyFrom(ctx); ok && policy.Timeout > 0 {
+		timeout = policy.Timeout
+	}
+	fetchPage := func(page int32) ([]T, int64, error) {
+		pageCtx, cancel := context.WithTimeout(streamCtx, timeout)
+		defer cancel()
+		return fetch(pageCtx, page)
+	}
+
+	items, total, err := fetchPage(1)
+	if err != nil {
+		respondError(err)
+		return
+	}
+
+	c.Header("Content-Type", "application/x-ndjson")
+	c.Header("X-Content-Type-Options", "nosniff")
+	c.Status(http.StatusOK)
+	out := bufio.NewWriter(c.Writer)
+	var fetched int64
+	for page := int32(1); ; page++ {
+		fetched += int64(len(items))
+		for _, item := range items {
+			line := encode(item)
+			if line == nil {
+				continue
+			}
+			data, err := json.Marshal(line)
+			if err != nil {
+				continue
+			}
+			out.Write(data)
+			out.WriteByte('\n')
+		}
+		if err := out.Flush(); err != nil {
+			return
+		}
+		c.Writer.Flush()
+
+		if streamDone(len(items), fetched, total) {
+			return
+		}
+		items, total, err = fetchPage(page + 1)
+		if err != nil {
+			if streamCtx.Err() != nil {
+				return
+			}
+			log.Printf("Streaming %s for %s failed after %d pages: %v", name, c.GetString("user_id"), page, err)
+			data, _ := json.Marshal(gin.H{"error": "Stream incomplete: the backend stopped responding"})
+			out.Write(data)
+			out.WriteByte('\n')
+			out.Flush()
+			return
+		}
+	}
+}
+
+// streamDone reports whether a stream has every item after a page of pageLen.
+// With a total, a short page only means the backend caps its page size, so the
+// stream goes on until it has them all or a page comes back empty. Without one,
+// a short page is the last.
+func streamDone(pageLen int, fetched, total int64) bool {
+	if total > 0 {
+		return pageLen == 0 || fetched >= total
+	}
+	return pageLen < streamPageSize
+}
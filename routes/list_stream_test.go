@@ -0,0 +1,197 @@
+package routes
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"skillsync-api-gateway/clients"
+	"skillsync-api-gateway/config"
+)
+
+func TestStreamDone(t *testing.T) {
+	tests := []struct {
+		name    string
+		pageLen int
+		fetched int64
+		total   int64
+		want    bool
+	}{
+		{"full page, more to come", streamPageSize, 100, 250, false},
+		{"short page below the total", 50, 50, 250, false},
+		{"reached the total", 50, 250, 250, true},
+		{"past the total", streamPageSize, 300, 250, true},
+		{"empty page before the total", 0, 120, 250, true},
+		{"unknown total, full page", streamPageSize, 100, 0, false},
+		{"unknown total, short page", 99, 199, 0, true},
+		{"unknown total, empty page", 0, 0, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := streamDone(tt.pageLen, tt.fetched, tt.total); got != tt.want {
+				t.Errorf("streamDone(%d, %d, %d) = %t, want %t", tt.pageLen, tt.fetched, tt.total, got, tt.want)
+			}
+		})
+	}
+}
+
+// runStream streams pages, each a count of items, and returns the lines written
+func runStream(t *testing.T, ctx context.Context, pages []int, total int64, fetchErr map[int32]error) ([]string, int) {
+	t.Helper()
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/?stream=true", nil).WithContext(ctx)
+
+	next := 0
+	streamList(c, ctx, "items",
+		func(ctx context.Context, page int32) ([]int, int64, error) {
+			if err := ctx.Err(); err != nil {
+				t.Errorf("page %d fetched with a done context: %v", page, err)
+			}
+			if err := fetchErr[page]; err != nil {
+				return nil, 0, err
+			}
+			if int(page) > len(pages) {
+				return nil, total, nil
+			}
+			items := make([]int, pages[page-1])
+			for i := range items {
+				items[i] = next
+				next++
+			}
+			return items, total, nil
+		},
+		func(item int) interface{} { return item },
+		func(err error) { c.JSON(http.StatusBadGateway, gin.H{"error": err.Error()}) })
+
+	body := strings.TrimSuffix(w.Body.String(), "\n")
+	if body == "" {
+		return nil, w.Code
+	}
+	return strings.Split(body, "\n"), w.Code
+}
+
+func TestStreamList(t *testing.T) {
+	tests := []struct {
+		name      string
+		pages     []int
+		total     int64
+		fetchErr  map[int32]error
+		wantLines int
+		wantCode  int
+		wantError bool
+	}{
+		{name: "pages up to the total", pages: []int{100, 100, 50}, total: 250, wantLines: 250, wantCode: http.StatusOK},
+		{name: "backend capping its page size", pages: []int{50, 50, 50, 50, 50}, total: 250, wantLines: 250, wantCode: http.StatusOK},
+		{name: "unknown total ends on a short page", pages: []int{100, 30, 100}, wantLines: 130, wantCode: http.StatusOK},
+		{name: "total larger than the list", pages: []int{100, 20}, total: 500, wantLines: 120, wantCode: http.StatusOK},
+		{name: "empty list", pages: []int{0}, wantCode: http.StatusOK},
+		{name: "first page fails", fetchErr: map[int32]error{1: errors.New("down")}, wantLines: 1, wantCode: http.StatusBadGateway},
+		{name: "later page fails", pages: []int{100, 100}, total: 300, fetchErr: map[int32]error{3: errors.New("down")}, wantLines: 201, wantCode: http.StatusOK, wantError: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lines, code := runStream(t, context.Background(), tt.pages, tt.total, tt.fetchErr)
+			if code != tt.wantCode {
+				t.Errorf("status = %d, want %d", code, tt.wantCode)
+			}
+			if len(lines) != tt.wantLines {
+				t.Fatalf("got %d lines, want %d", len(lines), tt.wantLines)
+			}
+			if tt.wantLines == 0 || tt.wantCode != http.StatusOK {
+				return
+			}
+			last := map[string]interface{}{}
+			json.Unmarshal([]byte(lines[len(lines)-1]), &last)
+			if _, isError := last["error"]; isError != tt.wantError {
+				t.Errorf("last line %s, want error line %t", lines[len(lines)-1], tt.wantError)
+			}
+		})
+	}
+}
+
+func TestStreamListOutlivesThePolicyDeadline(t *testing.T) {
+	ctx := clients.WithPolicy(context.Background(), config.UpstreamPolicy{Timeout: time.Second})
+	ctx, cancel := context.WithDeadline(ctx, time.Now().Add(-time.Second))
+	defer cancel()
+	// runStream fails the test if a page gets a done context
+	lines, code := runStream(t, ctx, []int{100, 100, 10}, 210, nil)
+	if code != http.StatusOK || len(lines) != 210 {
+		t.Errorf("status %d with %d lines, want 200 with 210", code, len(lines))
+	}
+}
+
+// countingWriter is a response that keeps only how much was written, so the
+// stream's own memory is all that's measured
+type countingWriter struct {
+	header http.Header
+	lines  int
+	bytes  int
+}
+
+func (w *countingWriter) Header() http.Header { return w.header }
+func (w *countingWriter) WriteHeader(int)     {}
+func (w *countingWriter) Flush()              {}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	w.bytes += len(p)
+	w.lines += strings.Count(string(p), "\n")
+	return len(p), nil
+}
+
+func TestStreamListMemoryBounded(t *testing.T) {
+	const items = 10000
+	type job struct {
+		ID          int    `json:"id"`
+		Title       string `json:"title"`
+		Description string `json:"description"`
+	}
+	description := strings.Repeat("Builds and runs the services behind the job board. ", 20)
+
+	gin.SetMode(gin.TestMode)
+	w := &countingWriter{header: http.Header{}}
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/?stream=true", nil)
+
+	var before, during runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&before)
+	var peak uint64
+	streamList(c, context.Background(), "jobs",
+		func(ctx context.Context, page int32) ([]job, int64, error) {
+			// What earlier pages left live is measured before each new one is built
+			runtime.GC()
+			runtime.ReadMemStats(&during)
+			if during.HeapAlloc > peak {
+				peak = during.HeapAlloc
+			}
+			first := int(page-1) * streamPageSize
+			jobs := make([]job, 0, streamPageSize)
+			for i := first; i < first+streamPageSize && i < items; i++ {
+				jobs = append(jobs, job{ID: i, Title: "Backend engineer", Description: description})
+			}
+			return jobs, items, nil
+		},
+		func(j job) interface{} { return j },
+		func(err error) { t.Fatal(err) })
+
+	if w.lines != items {
+		t.Fatalf("streamed %d lines, want %d", w.lines, items)
+	}
+	// The whole list is ~10MB; a stream holding one page at a time keeps well under 1MB
+	var growth uint64
+	if peak > before.HeapAlloc {
+		growth = peak - before.HeapAlloc
+	}
+	if growth > 1<<20 {
+		t.Errorf("live heap grew by %d bytes streaming %d bytes, want under 1MB", growth, w.bytes)
+	}
+}
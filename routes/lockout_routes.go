@@ -0,0 +1,52 @@
+package routes
+
+import (
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"skillsync-api-gateway/middlewares"
+)
+
+// ClearLockout lifts a login lockout for an email and/or IP and forgets their failures
+func ClearLockout(c *gin.Context) {
+	email := strings.TrimSpace(c.Query("email"))
+	ip := strings.TrimSpace(c.Query("ip"))
+	if email == "" && ip == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "email or ip query parameter is required"})
+		return
+	}
+
+	var keys []string
+	if email != "" {
+		keys = append(keys, middlewares.LoginEmailKey(email))
+	}
+	if ip != "" {
+		keys = append(keys, middlewares.LoginIPKey(ip))
+	}
+	for _, key := range keys {
+		if err := middlewares.DefaultLoginAttemptStore.Reset(c.Request.Context(), key); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to clear lockout: " + err.Error()})
+			return
+		}
+	}
+	log.Printf("Admin %s cleared login lockout (email set: %t, ip: %q)", c.GetString("user_id"), email != "", ip)
+	recordAudit(c, "admin.lockout_clear", "lockout", map[string]string{"email_set": strconv.FormatBool(email != ""), "ip": ip})
+	c.JSON(http.StatusOK, gin.H{"message": "Lockout cleared"})
+}
+
+// ReloadJWTKeys rereads the JWT secrets so a rotation takes effect without a
+// restart; the keys are unchanged if the new list is invalid
+func ReloadJWTKeys(c *gin.Context) {
+	count, err := middlewares.ReloadJWTKeys()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Failed to reload JWT keys: " + err.Error()})
+		return
+	}
+	log.Printf("Admin %s reloaded JWT keys, %d in use", c.GetString("user_id"), count)
+	recordAudit(c, "admin.jwt_keys_reload", "jwt_keys", map[string]string{"keys": strconv.Itoa(count)})
+	c.JSON(http.StatusOK, gin.H{"keys": count})
+}
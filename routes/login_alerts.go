@@ -0,0 +1,86 @@
+package routes
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	authpb "github.com/shahal0/skillsync-protos/gen/authpb"
+	"google.golang.org/grpc/metadata"
+
+	"skillsync-api-gateway/clients"
+)
+
+// newDeviceLookupTimeout bounds the session lookup behind a new-device alert
+const newDeviceLookupTimeout = 5 * time.Second
+
+func listSessionsRPCFor(role string) listSessionsRPC {
+	if role == "employer" {
+		return clients.AuthServiceClient.EmployerListSessions
+	}
+	return clients.AuthServiceClient.CandidateListSessions
+}
+
+// alertOnNewDevice notifies the user when they signed in from an IP and user agent
+// that none of their other sessions has. The sessions the auth service keeps are
+// the record of known devices, so the check runs in the background once the login
+// has created its own. The alert names the device, never what was submitted.
+func alertOnNewDevice(c *gin.Context, result loginResult) {
+	if result.ID == "" || (result.Role != "candidate" && result.Role != "employer") {
+		return
+	}
+	ip, userAgent := c.ClientIP(), c.Request.UserAgent()
+	sessionID := tokenSessionID(result.Token)
+	ctx := context.WithoutCancel(metadata.NewOutgoingContext(
+		c.Request.Context(),
+		metadata.New(map[string]string{
+			"user-id": result.ID,
+			"role":    result.Role,
+		}),
+	))
+	rpc := listSessionsRPCFor(result.Role)
+
+	go func() {
+		ctx, cancel := context.WithTimeout(ctx, newDeviceLookupTimeout)
+		defer cancel()
+		resp, err := rpc(ctx, &authpb.ListSessionsRequest{})
+		if err != nil {
+			log.Printf("New device check for %s %s failed: %v", result.Role, result.ID, err)
+			return
+		}
+		// Without the token's session ID the new session can't be told apart, so a
+		// second match is needed
+		seen := 0
+		for _, session := range resp.GetSessions() {
+			if sessionID != "" && session.GetId() == sessionID {
+				continue
+			}
+			if session.GetIp() == ip && session.GetUserAgent() == userAgent {
+				seen++
+			}
+		}
+		if seen > 0 && (sessionID != "" || seen > 1) {
+			return
+		}
+		device := userAgent
+		if device == "" {
+			device = "an unknown device"
+		}
+		notifyUser(ctx, result.ID, "new_device_login", "New sign-in to your account",
+			fmt.Sprintf("Your account was signed in from %s (IP %s) at %s. If this wasn't you, change your password and end that session.",
+				device, ip, time.Now().UTC().Format(time.RFC1123)), sessionID)
+	}()
+}
+
+// tokenSessionID reads the sid claim of a token fresh from the auth service
+func tokenSessionID(token string) string {
+	claims := jwt.MapClaims{}
+	if _, _, err := jwt.NewParser().ParseUnverified(token, claims); err != nil {
+		return ""
+	}
+	sessionID, _ := claims["sid"].(string)
+	return sessionID
+}
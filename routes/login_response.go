@@ -0,0 +1,72 @@
+package routes
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+
+	"skillsync-api-gateway/middlewares"
+)
+
+// loginResult is what a successful login, however it happened, hands the client
+type loginResult struct {
+	ID string
+	// LegacyID is the id as the old response typed it, when that wasn't a string
+	LegacyID      interface{}
+	Role          string
+	Token         string
+	RefreshToken  string
+	Message       string
+	EmailVerified bool
+}
+
+// respondLogin answers a successful login with the token and what the client would
+// otherwise have to decode from it: its type, seconds until it expires and the
+// user it is for. With LEGACY_RESPONSES the old id, message and token fields are
+// kept alongside. The user is alerted when the login came from a new device.
+func respondLogin(c *gin.Context, result loginResult) {
+	if result.Token == "" {
+		c.JSON(http.StatusBadGateway, gin.H{"error": "Login succeeded but no token was issued"})
+		return
+	}
+	body := gin.H{
+		"access_token": result.Token,
+		"token_type":   "Bearer",
+		"expires_in":   tokenExpiresIn(result.Token),
+		"user": gin.H{
+			"id":             result.ID,
+			"role":           result.Role,
+			"email_verified": result.EmailVerified,
+		},
+	}
+	if result.RefreshToken != "" {
+		body["refresh_token"] = result.RefreshToken
+	}
+	if cfg.LegacyResponses {
+		body["id"] = result.ID
+		if result.LegacyID != nil {
+			body["id"] = result.LegacyID
+		}
+		body["message"] = result.Message
+		body["token"] = result.Token
+	}
+	alertOnNewDevice(c, result)
+	middlewares.LoginIssued(c)
+	c.JSON(http.StatusOK, body)
+}
+
+// tokenExpiresIn is the seconds left before token's exp claim, or 0 when it has none.
+// The token comes straight from the auth service, so its signature isn't checked here.
+func tokenExpiresIn(token string) int {
+	claims := jwt.MapClaims{}
+	if _, _, err := jwt.NewParser().ParseUnverified(token, claims); err != nil {
+		return 0
+	}
+	exp, err := claims.GetExpirationTime()
+	if err != nil || exp == nil {
+		return 0
+	}
+	return max(int(time.Until(exp.Time).Seconds()), 0)
+}
@@ -0,0 +1,69 @@
+package routes
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"skillsync-api-gateway/clients"
+	"skillsync-api-gateway/middlewares"
+)
+
+func GetMaintenanceFlags(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{"flags": middlewares.MaintenanceFlags()})
+}
+
+func UpdateMaintenanceFlag(c *gin.Context) {
+	var body struct {
+		Maintenance       *bool  `json:"maintenance" binding:"required"`
+		Message           string `json:"message" binding:"max=500"`
+		RetryAfterSeconds int    `json:"retry_after_seconds" binding:"min=0,max=86400"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	flag, err := middlewares.SetMaintenance(c.Param("service"), *body.Maintenance, body.Message, body.RetryAfterSeconds, c.GetString("user_id"))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+	recordAudit(c, "admin.maintenance_update", "service:"+c.Param("service"), map[string]string{"maintenance": strconv.FormatBool(*body.Maintenance)})
+	c.JSON(http.StatusOK, flag)
+}
+
+func SetupHealthRoutes(r *gin.Engine) {
+	r.GET("/readyz", Readyz)
+}
+
+// Readyz reports whether the gateway can serve traffic. Backends in maintenance are
+// listed but don't make the gateway unready, since it still answers for them with 503s.
+func Readyz(c *gin.Context) {
+	if clients.AuthServiceClient == nil || clients.JobServiceClient == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"status": "not_ready", "error": "gRPC clients not initialized"})
+		return
+	}
+
+	status := "ready"
+	services := gin.H{}
+	for _, flag := range middlewares.MaintenanceFlags() {
+		if flag.Enabled {
+			status = "degraded"
+		}
+		services[flag.Service] = flag
+	}
+	connections := gin.H{}
+	if clients.AuthConn != nil {
+		connections["auth"] = clients.AuthConn.GetState().String()
+	}
+	if clients.JobConn != nil {
+		connections["job"] = clients.JobConn.GetState().String()
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"status":      status,
+		"services":    services,
+		"connections": connections,
+	})
+}
@@ -0,0 +1,21 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"skillsync-api-gateway/middlewares"
+)
+
+func SetupMeRoutes(r *gin.Engine) {
+	// Calendar apps can't send headers, so the feed is authenticated by its token
+	r.GET("/me/interviews.ics", middlewares.Maintenance("job"), GetInterviewCalendarFeed)
+
+	me := r.Group("/me")
+	me.Use(middlewares.JWTMiddleware())
+	{
+		me.GET("/dashboard", middlewares.RequireRole("candidate"), GetCandidateDashboard)
+		me.GET("/usage", GetMyUsage)
+		me.POST("/interviews/feed-token", CreateInterviewFeedToken)
+		me.DELETE("/interviews/feed-token", RevokeInterviewFeedToken)
+	}
+}
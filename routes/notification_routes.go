@@ -0,0 +1,266 @@
+package routes
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	notificationpb "github.com/shahal0/skillsync-protos/gen/notificationpb"
+
+	"skillsync-api-gateway/clients"
+	"skillsync-api-gateway/middlewares"
+	"skillsync-api-gateway/utils"
+)
+
+const (
+	defaultNotificationLimit = 20
+	maxNotificationLimit     = 100
+
+	// Grouping needs the whole recent history, so it reads at most this many notifications
+	notificationGroupScanLimit = 500
+	notificationGroupPageSize  = 100
+	defaultNotificationWindow  = time.Hour
+	maxNotificationWindow      = 24 * time.Hour
+
+	// maxMarkReadIDs bounds the IDs one mark-as-read request may name
+	maxMarkReadIDs = 100
+)
+
+func SetupNotificationRoutes(r *gin.Engine) {
+	notifications := r.Group("/chat-notification/notifications")
+	notifications.Use(middlewares.Maintenance("notification"), middlewares.JWTMiddleware())
+	{
+		notifications.GET("/", GetNotifications)
+		notifications.PUT("/:id/read", MarkNotificationAsRead)
+	}
+}
+
+// GetNotifications lists the caller's notifications, newest first. With group=true,
+// notifications of the same type and source within window of each other are
+// collapsed into one entry; the grouping happens here, after the fetch, and
+// page, limit and total then count groups.
+func GetNotifications(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in context"})
+		return
+	}
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil || page < 1 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "page must be a positive integer"})
+		return
+	}
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", strconv.Itoa(defaultNotificationLimit)))
+	if err != nil || limit < 1 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "limit must be a positive integer"})
+		return
+	}
+	if limit > maxNotificationLimit {
+		limit = maxNotificationLimit
+	}
+	group := c.Query("group") == "true"
+	window := defaultNotificationWindow
+	if value := c.Query("window"); value != "" {
+		window, err = time.ParseDuration(value)
+		if err != nil || window < time.Minute || window > maxNotificationWindow {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "window must be a duration between 1m and 24h"})
+			return
+		}
+	}
+
+	notificationClient := clients.GetNotificationClient()
+	if notificationClient == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Notification service unavailable"})
+		return
+	}
+	ctx := chatContext(c, userID.(string))
+
+	// A stream lists every notification, so page and limit don't apply
+	if streamRequested(c) {
+		if group {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "group can't be combined with stream"})
+			return
+		}
+		streamList(c, ctx, "notifications",
+			func(ctx context.Context, page int32) ([]*notificationpb.Notification, int64, error) {
+				resp, err := notificationClient.GetNotifications(ctx, &notificationpb.GetNotificationsRequest{
+					UserId: userID.(string),
+					Page:   page,
+					Limit:  streamPageSize,
+				})
+				return resp.GetNotifications(), int64(resp.GetTotal()), err
+			},
+			func(notification *notificationpb.Notification) interface{} { return notification },
+			func(err error) { respondChatError(c, "Failed to get notifications", err) })
+		return
+	}
+
+	if !group {
+		resp, err := notificationClient.GetNotifications(ctx, &notificationpb.GetNotificationsRequest{
+			UserId: userID.(string),
+			Page:   int32(page),
+			Limit:  int32(limit),
+		})
+		if err != nil {
+			respondChatError(c, "Failed to get notifications", err)
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{
+			"notifications": resp.GetNotifications(),
+			"total":         resp.GetTotal(),
+			"page":          page,
+			"limit":         limit,
+			"grouped":       false,
+		})
+		return
+	}
+
+	var fetched []*notificationpb.Notification
+	truncated := false
+	for n := int32(1); ; n++ {
+		resp, err := notificationClient.GetNotifications(ctx, &notificationpb.GetNotificationsRequest{
+			UserId: userID.(string),
+			Page:   n,
+			Limit:  notificationGroupPageSize,
+		})
+		if err != nil {
+			respondChatError(c, "Failed to get notifications", err)
+			return
+		}
+		fetched = append(fetched, resp.GetNotifications()...)
+		if len(resp.GetNotifications()) < notificationGroupPageSize || len(fetched) >= int(resp.GetTotal()) {
+			break
+		}
+		if len(fetched) >= notificationGroupScanLimit {
+			truncated = true
+			break
+		}
+	}
+
+	groups := groupNotifications(fetched, window)
+	start := (page - 1) * limit
+	if start > len(groups) {
+		start = len(groups)
+	}
+	end := start + limit
+	if end > len(groups) {
+		end = len(groups)
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"notifications": groups[start:end],
+		"total":         len(groups),
+		"page":          page,
+		"limit":         limit,
+		"grouped":       true,
+		"group_window":  window.String(),
+		"truncated":     truncated,
+	})
+}
+
+// notificationGroup is one entry of a grouped listing
+type notificationGroup struct {
+	Type     string    `json:"type"`
+	SourceID string    `json:"source_id"`
+	Count    int       `json:"count"`
+	Unread   int       `json:"unread"`
+	Title    string    `json:"title"`
+	Message  string    `json:"message"`
+	LatestAt time.Time `json:"latest_at"`
+	// IDs are every member, so PUT /:id/read can mark the whole group read
+	IDs []string `json:"ids"`
+}
+
+// groupNotifications collapses notifications of the same type and source, each
+// within window of the newest one in its group. The latest member's title and
+// message represent the group. Groups are returned newest first.
+func groupNotifications(notifications []*notificationpb.Notification, window time.Duration) []*notificationGroup {
+	sorted := append([]*notificationpb.Notification(nil), notifications...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].GetCreatedAt().AsTime().After(sorted[j].GetCreatedAt().AsTime())
+	})
+
+	groups := []*notificationGroup{}
+	open := map[string]*notificationGroup{}
+	for _, notification := range sorted {
+		key := notification.GetType() + "\x00" + notification.GetSourceId()
+		created := notification.GetCreatedAt().AsTime()
+		current, ok := open[key]
+		if !ok || current.LatestAt.Sub(created) > window {
+			current = &notificationGroup{
+				Type:     notification.GetType(),
+				SourceID: notification.GetSourceId(),
+				Title:    notification.GetTitle(),
+				Message:  notification.GetMessage(),
+				LatestAt: created,
+			}
+			open[key] = current
+			groups = append(groups, current)
+		}
+		current.Count++
+		if !notification.GetIsRead() {
+			current.Unread++
+		}
+		current.IDs = append(current.IDs, notification.GetId())
+	}
+	return groups
+}
+
+// MarkNotificationAsRead marks one notification read, or several given as a
+// comma-separated list such as the ids of a group. Each ID is marked on its own;
+// the response lists the ones that failed.
+func MarkNotificationAsRead(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in context"})
+		return
+	}
+	var ids []string
+	seen := map[string]bool{}
+	for _, id := range strings.Split(c.Param("id"), ",") {
+		if id = strings.TrimSpace(id); id != "" && !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "At least one notification ID is required"})
+		return
+	}
+	if len(ids) > maxMarkReadIDs {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "At most " + strconv.Itoa(maxMarkReadIDs) + " notification IDs can be marked at once"})
+		return
+	}
+
+	notificationClient := clients.GetNotificationClient()
+	if notificationClient == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Notification service unavailable"})
+		return
+	}
+	ctx := chatContext(c, userID.(string))
+
+	marked := []string{}
+	failed := []gin.H{}
+	var lastErr error
+	for _, id := range ids {
+		_, err := notificationClient.MarkNotificationAsRead(ctx, &notificationpb.MarkNotificationAsReadRequest{
+			NotificationId: id,
+			UserId:         userID.(string),
+		})
+		if err != nil {
+			lastErr = err
+			failed = append(failed, gin.H{"id": id, "error": utils.GRPCErrorMessage(err)})
+			continue
+		}
+		marked = append(marked, id)
+	}
+	// A single ID keeps the backend's status; a batch reports per-ID results
+	if len(ids) == 1 && lastErr != nil {
+		respondChatError(c, "Failed to mark notification as read", lastErr)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"marked": marked, "failed": failed})
+}
@@ -0,0 +1,99 @@
+package routes
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sync"
+	"time"
+
+	notificationpb "github.com/shahal0/skillsync-protos/gen/notificationpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"skillsync-api-gateway/clients"
+	"skillsync-api-gateway/utils/notifier"
+)
+
+var (
+	notificationOutboxOnce sync.Once
+	notificationOutboxInst *notifier.Notifier
+)
+
+// notificationOutbox lazily starts the workers that send gateway-initiated notifications
+func notificationOutbox() *notifier.Notifier {
+	notificationOutboxOnce.Do(func() {
+		notificationOutboxInst = notifier.New(nil, sendNotification, notifier.Options{})
+	})
+	return notificationOutboxInst
+}
+
+// sendNotification is the outbox's sender. Requests the service rejects as
+// invalid are not retried.
+func sendNotification(ctx context.Context, event *notifier.Event) error {
+	client := clients.GetNotificationClient()
+	if client == nil {
+		return errors.New("notification client not initialized")
+	}
+	req := &notificationpb.SendNotificationRequest{
+		UserId:   event.UserID,
+		Type:     event.Type,
+		Title:    event.Title,
+		Message:  event.Message,
+		SourceId: event.SourceID,
+	}
+	if !event.ExpiresAt.IsZero() {
+		req.ExpiresAt = event.ExpiresAt.UTC().Format(time.RFC3339)
+	}
+	for _, attachment := range event.Attachments {
+		req.Attachments = append(req.Attachments, &notificationpb.Attachment{
+			Filename:    attachment.Filename,
+			ContentType: attachment.ContentType,
+			Content:     attachment.Content,
+		})
+	}
+	_, err := client.SendNotification(ctx, req)
+	switch status.Code(err) {
+	case codes.OK:
+		queuePush(ctx, event.UserID, event.Type, event.SourceID)
+	case codes.InvalidArgument, codes.NotFound, codes.PermissionDenied, codes.FailedPrecondition:
+		return notifier.Permanent(err)
+	}
+	return err
+}
+
+// notifyUser queues a gateway-initiated notification and returns at once. It is
+// sent with retries in the background and never fails the request that triggered it.
+func notifyUser(ctx context.Context, userID, notificationType, title, message, sourceID string) {
+	notifyUserWithAttachments(ctx, userID, notificationType, title, message, sourceID)
+}
+
+// notifyUserWithAttachments is notifyUser with files for the notification service
+// to attach when it emails the notification
+func notifyUserWithAttachments(ctx context.Context, userID, notificationType, title, message, sourceID string, attachments ...notifier.Attachment) {
+	if userID == "" {
+		return
+	}
+	err := notificationOutbox().Enqueue(ctx, &notifier.Event{
+		UserID:      userID,
+		Type:        notificationType,
+		Title:       title,
+		Message:     message,
+		SourceID:    sourceID,
+		Attachments: attachments,
+	})
+	if err != nil {
+		log.Printf("Failed to queue %s notification for %s: %v", notificationType, userID, err)
+	}
+}
+
+// drainNotifications sends what is still queued, giving up at ctx's deadline
+func drainNotifications(ctx context.Context) error {
+	// Starting the workers now just to stop them would be pointless
+	started := true
+	notificationOutboxOnce.Do(func() { started = false })
+	if !started {
+		return nil
+	}
+	return notificationOutboxInst.Shutdown(ctx)
+}
@@ -0,0 +1,264 @@
+package routes
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"log"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	authpb "github.com/shahal0/skillsync-protos/gen/authpb"
+	"google.golang.org/grpc"
+
+	"skillsync-api-gateway/clients"
+	"skillsync-api-gateway/utils"
+	"skillsync-api-gateway/utils/cache"
+)
+
+const (
+	oauthStateTTL            = 10 * time.Minute
+	maxOAuthErrorDescription = 200
+)
+
+// oauthState remembers a login the gateway started, so a callback is only accepted
+// for a state it issued, once, for the same role and provider
+type oauthState struct {
+	role        string
+	provider    string
+	redirectURI string
+}
+
+var oauthStates = cache.NewTTLCache[*oauthState](oauthStateTTL)
+
+type oauthLoginRPC func(ctx context.Context, in *authpb.OAuthLoginRequest, opts ...grpc.CallOption) (*authpb.OAuthLoginResponse, error)
+
+type oauthCallbackRPC func(ctx context.Context, in *authpb.OAuthCallbackRequest, opts ...grpc.CallOption) (*authpb.OAuthCallbackResponse, error)
+
+// oauthRPCs picks the role's generalized social login RPCs
+func oauthRPCs(role string) (oauthLoginRPC, oauthCallbackRPC) {
+	if role == "employer" {
+		return clients.AuthServiceClient.EmployerOAuthLogin, clients.AuthServiceClient.EmployerOAuthCallback
+	}
+	return clients.AuthServiceClient.CandidateOAuthLogin, clients.AuthServiceClient.CandidateOAuthCallback
+}
+
+// oauthProvider resolves the provider from the route (or the fixed one for the
+// /google aliases) and checks it is enabled in OAUTH_PROVIDERS
+func oauthProvider(c *gin.Context, fixed string) (string, bool) {
+	provider := fixed
+	if provider == "" {
+		provider = c.Param("provider")
+	}
+	for _, enabled := range cfg.OAuth.Providers {
+		if provider == enabled {
+			return provider, true
+		}
+	}
+	c.JSON(http.StatusNotFound, gin.H{
+		"error":      "Unknown login provider: " + provider,
+		"error_code": "unknown_provider",
+	})
+	return "", false
+}
+
+// defaultOAuthRedirect is where the provider sends role back to unless the client asks otherwise
+func defaultOAuthRedirect(role, provider string) string {
+	redirect := cfg.OAuth.CandidateRedirectURI
+	if role == "employer" {
+		redirect = cfg.OAuth.EmployerRedirectURI
+	}
+	return strings.ReplaceAll(redirect, "{provider}", provider)
+}
+
+// redirectOrigin is a URL's scheme and host, lowercased, or "" if it isn't absolute
+func redirectOrigin(raw string) string {
+	parsed, err := url.Parse(raw)
+	if err != nil || parsed.Scheme == "" || parsed.Host == "" || parsed.User != nil {
+		return ""
+	}
+	return strings.ToLower(parsed.Scheme + "://" + parsed.Host)
+}
+
+// redirectAllowed reports whether a client's redirect_uri is the role's default or
+// on OAUTH_ALLOWED_REDIRECTS, so the login can't hand the code to another site
+func redirectAllowed(redirectURI, fallback string) bool {
+	origin := redirectOrigin(redirectURI)
+	if origin == "" {
+		return false
+	}
+	if redirectURI == fallback {
+		return true
+	}
+	for _, allowed := range cfg.OAuth.AllowedRedirects {
+		if redirectURI == allowed {
+			return true
+		}
+		// An entry without a path allows its whole origin
+		if parsed, err := url.Parse(allowed); err == nil && strings.Trim(parsed.Path, "/") == "" &&
+			parsed.RawQuery == "" && redirectOrigin(allowed) == origin {
+			return true
+		}
+	}
+	return false
+}
+
+// oauthRedirectURI picks the redirect URI for a login: the client's redirect_uri
+// if it is allowed, otherwise the role's default. It answers 400 naming the allowed
+// origins and reports false for a redirect_uri that isn't.
+func oauthRedirectURI(c *gin.Context, role, provider string) (string, bool) {
+	fallback := defaultOAuthRedirect(role, provider)
+	redirectURI := c.Query("redirect_uri")
+	if redirectURI == "" {
+		return fallback, true
+	}
+	if redirectAllowed(redirectURI, fallback) {
+		return redirectURI, true
+	}
+
+	origins := []string{redirectOrigin(fallback)}
+	for _, allowed := range cfg.OAuth.AllowedRedirects {
+		if origin := redirectOrigin(allowed); !slices.Contains(origins, origin) {
+			origins = append(origins, origin)
+		}
+	}
+	c.JSON(http.StatusBadRequest, gin.H{
+		"error":           "redirect_uri is not allowed, it must be on " + strings.Join(origins, ", "),
+		"error_code":      "invalid_redirect_uri",
+		"allowed_origins": origins,
+	})
+	return "", false
+}
+
+// oauthLogin redirects to the provider's consent page. provider is empty for the
+// /oauth/:provider routes and fixed for the legacy /google aliases.
+func oauthLogin(role, provider string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		provider, ok := oauthProvider(c, provider)
+		if !ok {
+			return
+		}
+
+		// Must also match a redirect URI registered with the provider
+		redirectURI, ok := oauthRedirectURI(c, role, provider)
+		if !ok {
+			return
+		}
+
+		b := make([]byte, 16)
+		if _, err := rand.Read(b); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to start " + provider + " login"})
+			return
+		}
+		state := hex.EncodeToString(b)
+		oauthStates.Set(state, &oauthState{role: role, provider: provider, redirectURI: redirectURI})
+
+		login, _ := oauthRPCs(role)
+		resp, err := login(c.Request.Context(), &authpb.OAuthLoginRequest{
+			Provider:    provider,
+			RedirectUrl: redirectURI,
+			State:       state,
+		})
+		if err != nil {
+			c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": utils.GRPCErrorMessage(err)})
+			return
+		}
+		if resp.GetAuthUrl() == "" {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate " + provider + " authorization URL"})
+			return
+		}
+		c.Redirect(http.StatusTemporaryRedirect, resp.GetAuthUrl())
+	}
+}
+
+// oauthCallback exchanges the provider's code for a SkillSync token. Errors reported
+// by the provider (e.g. the user cancelled) go to the frontend error page.
+func oauthCallback(role, provider string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		provider, ok := oauthProvider(c, provider)
+		if !ok {
+			return
+		}
+
+		if providerError := c.Query("error"); providerError != "" {
+			oauthStates.Take(c.Query("state"))
+			redirectToOAuthError(c, role, provider, providerError, c.Query("error_description"))
+			return
+		}
+
+		code := c.Query("code")
+		if code == "" {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Missing authorization code"})
+			return
+		}
+		state, ok := oauthStates.Take(c.Query("state"))
+		if !ok || state.role != role || state.provider != provider {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":      "Login session is invalid or has expired, please try again",
+				"error_code": "invalid_state",
+			})
+			return
+		}
+
+		_, callback := oauthRPCs(role)
+		resp, err := callback(loginContext(c), &authpb.OAuthCallbackRequest{
+			Provider:    provider,
+			Code:        code,
+			RedirectUrl: state.redirectURI,
+			State:       c.Query("state"),
+		})
+		if err != nil {
+			c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": utils.GRPCErrorMessage(err)})
+			return
+		}
+
+		// Accounts with 2FA get the cookie only after POST /auth/<role>/login/2fa
+		if resp.GetTwoFactorRequired() {
+			respondTwoFactorChallenge(c, role, resp.GetChallengeToken(), true)
+			return
+		}
+		if resp.GetToken() == "" {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to authenticate with " + provider})
+			return
+		}
+
+		utils.SetAuthCookie(c, resp.GetToken())
+		respondLogin(c, loginResult{
+			ID:           resp.GetId(),
+			Role:         role,
+			Token:        resp.GetToken(),
+			RefreshToken: resp.GetRefreshToken(),
+			Message:      resp.GetMessage(),
+			// The provider has confirmed the address
+			EmailVerified: true,
+		})
+	}
+}
+
+// redirectToOAuthError sends the browser to OAUTH_ERROR_REDIRECT_URL with the
+// provider's error passed along as query parameters
+func redirectToOAuthError(c *gin.Context, role, provider, providerError, description string) {
+	log.Printf("OAuth %s login for %s failed at the provider: %s", provider, role, providerError)
+
+	target, err := url.Parse(cfg.OAuth.ErrorRedirectURL)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": providerError, "error_description": description})
+		return
+	}
+	if len(description) > maxOAuthErrorDescription {
+		description = description[:maxOAuthErrorDescription]
+	}
+	query := target.Query()
+	query.Set("error", providerError)
+	if description != "" {
+		query.Set("error_description", description)
+	}
+	query.Set("provider", provider)
+	query.Set("role", role)
+	target.RawQuery = query.Encode()
+	c.Redirect(http.StatusFound, target.String())
+}
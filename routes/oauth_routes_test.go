@@ -0,0 +1,92 @@
+package routes
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestRedirectAllowed(t *testing.T) {
+	previous := cfg.OAuth.AllowedRedirects
+	defer func() { cfg.OAuth.AllowedRedirects = previous }()
+	cfg.OAuth.AllowedRedirects = []string{"https://app.skillsync.dev/", "https://admin.skillsync.dev/oauth/done"}
+	fallback := "http://localhost:8060/candidate/auth/google/callback"
+
+	tests := []struct {
+		name        string
+		redirectURI string
+		want        bool
+	}{
+		{"default", fallback, true},
+		{"anywhere on an allowed origin", "https://app.skillsync.dev/auth/google/callback?next=/jobs", true},
+		{"origin case", "HTTPS://App.SkillSync.dev/cb", true},
+		{"exact entry", "https://admin.skillsync.dev/oauth/done", true},
+		{"elsewhere on an exact entry's origin", "https://admin.skillsync.dev/other", false},
+		{"other scheme", "http://app.skillsync.dev/cb", false},
+		{"other port", "https://app.skillsync.dev:8443/cb", false},
+		{"lookalike host", "https://app.skillsync.dev.evil.example/cb", false},
+		{"credentials", "https://user@app.skillsync.dev/cb", false},
+		{"relative", "/auth/callback", false},
+		{"scheme relative", "//app.skillsync.dev/cb", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := redirectAllowed(tt.redirectURI, fallback); got != tt.want {
+				t.Errorf("redirectAllowed(%q) = %v, want %v", tt.redirectURI, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOAuthRedirectURI(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	previous := cfg.OAuth
+	defer func() { cfg.OAuth = previous }()
+	cfg.OAuth.EmployerRedirectURI = "https://skillsync.dev/employer/auth/{provider}/callback"
+	cfg.OAuth.AllowedRedirects = []string{"https://app.skillsync.dev", "https://skillsync.dev/other"}
+
+	tests := []struct {
+		name        string
+		redirectURI string
+		want        string
+		wantOK      bool
+	}{
+		{"default", "", "https://skillsync.dev/employer/auth/github/callback", true},
+		{"allowed", "https://app.skillsync.dev/cb", "https://app.skillsync.dev/cb", true},
+		{"refused", "https://evil.example/cb", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodGet, "/?redirect_uri="+url.QueryEscape(tt.redirectURI), nil)
+
+			got, ok := oauthRedirectURI(c, "employer", "github")
+			if got != tt.want || ok != tt.wantOK {
+				t.Fatalf("oauthRedirectURI() = %q, %v, want %q, %v", got, ok, tt.want, tt.wantOK)
+			}
+			if ok {
+				return
+			}
+			if w.Code != http.StatusBadRequest {
+				t.Fatalf("status = %d, want 400", w.Code)
+			}
+			var body struct {
+				ErrorCode      string   `json:"error_code"`
+				AllowedOrigins []string `json:"allowed_origins"`
+			}
+			if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+				t.Fatal(err)
+			}
+			want := []string{"https://skillsync.dev", "https://app.skillsync.dev"}
+			if body.ErrorCode != "invalid_redirect_uri" || !reflect.DeepEqual(body.AllowedOrigins, want) {
+				t.Errorf("body = %s, want invalid_redirect_uri naming %v", w.Body, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package routes
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	authpb "github.com/shahal0/skillsync-protos/gen/authpb"
+	"google.golang.org/grpc"
+
+	"skillsync-api-gateway/middlewares"
+	"skillsync-api-gateway/utils"
+)
+
+// otpResendThrottle spaces out resent OTPs per role and email (OTP_RESEND_COOLDOWN,
+// OTP_RESEND_MAX_PER_HOUR)
+var otpResendThrottle = middlewares.NewSendThrottle(cfg.OTPResend.Cooldown, cfg.OTPResend.MaxPerHour)
+
+type resendOtpRPC func(ctx context.Context, in *authpb.ResendOtpRequest, opts ...grpc.CallOption) (*authpb.ResendOtpResponse, error)
+
+// resendOtp forwards a resend unless the email is cooling down from the last one
+// or has had its hourly share, in which case it answers 429 with how long to wait.
+// A successful resend says when the next one is allowed, for a countdown.
+func resendOtp(c *gin.Context, role string, rpc resendOtpRPC) {
+	var req authpb.ResendOtpRequest
+	if err := utils.BindProto(c, &req); err != nil {
+		utils.RespondWithValidationError(c, err)
+		return
+	}
+	email := strings.ToLower(strings.TrimSpace(req.Email))
+	if email == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "email is required"})
+		return
+	}
+	key := role + ":" + email
+	allowed, wait := otpResendThrottle.Allow(key)
+	retryAfter := int(math.Ceil(wait.Seconds()))
+	if !allowed {
+		c.Header("Retry-After", strconv.Itoa(retryAfter))
+		c.JSON(http.StatusTooManyRequests, gin.H{
+			"error":               "A code was sent recently, please wait before asking for another",
+			"error_code":          "otp_resend_cooldown",
+			"retry_after_seconds": retryAfter,
+		})
+		return
+	}
+
+	resp, err := rpc(c.Request.Context(), &req)
+	if err != nil {
+		otpResendThrottle.Release(key)
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": utils.GRPCErrorMessage(err)})
+		return
+	}
+	body, err := toMap(resp)
+	if err != nil {
+		body = map[string]interface{}{}
+	}
+	body["retry_after_seconds"] = retryAfter
+	body["next_resend_at"] = time.Now().Add(wait).UTC().Format(time.RFC3339)
+	c.JSON(http.StatusOK, body)
+}
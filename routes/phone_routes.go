@@ -0,0 +1,188 @@
+package routes
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	authpb "github.com/shahal0/skillsync-protos/gen/authpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"skillsync-api-gateway/clients"
+	"skillsync-api-gateway/utils"
+	"skillsync-api-gateway/utils/phone"
+	"skillsync-api-gateway/utils/profilecache"
+)
+
+// One SMS per cooldown, and a few OTP attempts per window, for each user
+const (
+	phoneResendCooldown = time.Minute
+	phoneVerifyLimit    = 5
+	phoneVerifyWindow   = 15 * time.Minute
+)
+
+type addPhoneRPC func(ctx context.Context, in *authpb.AddPhoneRequest, opts ...grpc.CallOption) (*authpb.AddPhoneResponse, error)
+
+type verifyPhoneRPC func(ctx context.Context, in *authpb.VerifyPhoneRequest, opts ...grpc.CallOption) (*authpb.VerifyPhoneResponse, error)
+
+type removePhoneRPC func(ctx context.Context, in *authpb.RemovePhoneRequest, opts ...grpc.CallOption) (*authpb.RemovePhoneResponse, error)
+
+func candidateAddPhone(c *gin.Context) {
+	addPhone(c, clients.AuthServiceClient.CandidateAddPhone)
+}
+
+func candidateVerifyPhone(c *gin.Context) {
+	verifyPhone(c, clients.AuthServiceClient.CandidateVerifyPhone)
+}
+
+func candidateRemovePhone(c *gin.Context) {
+	removePhone(c, clients.AuthServiceClient.CandidateRemovePhone)
+}
+
+func employerAddPhone(c *gin.Context) {
+	addPhone(c, clients.AuthServiceClient.EmployerAddPhone)
+}
+
+func employerVerifyPhone(c *gin.Context) {
+	verifyPhone(c, clients.AuthServiceClient.EmployerVerifyPhone)
+}
+
+func employerRemovePhone(c *gin.Context) {
+	removePhone(c, clients.AuthServiceClient.EmployerRemovePhone)
+}
+
+// phoneContext forwards the caller to the auth service
+func phoneContext(c *gin.Context) (context.Context, bool) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in context"})
+		return nil, false
+	}
+	return metadata.NewOutgoingContext(
+		c.Request.Context(),
+		metadata.New(map[string]string{"user-id": userID.(string)}),
+	), true
+}
+
+// addPhone normalizes the number to E.164 and has the auth service text an OTP to
+// it. The number only counts as verified once the OTP is confirmed.
+func addPhone(c *gin.Context, rpc addPhoneRPC) {
+	ctx, ok := phoneContext(c)
+	if !ok {
+		return
+	}
+	var body struct {
+		Phone string `json:"phone" binding:"required,max=32"`
+		// Country is the ISO 3166 code national numbers are dialled in, e.g. IN
+		Country string `json:"country" binding:"omitempty,len=2"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		utils.RespondWithValidationError(c, err)
+		return
+	}
+	number, err := phone.Normalize(body.Phone, body.Country)
+	if err != nil {
+		response := gin.H{"error": "phone: " + err.Error(), "error_code": "invalid_phone"}
+		if errors.Is(err, phone.ErrRegionRequired) || errors.Is(err, phone.ErrUnknownRegion) {
+			response["accepted_countries"] = phone.Regions()
+		}
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	resp, err := rpc(ctx, &authpb.AddPhoneRequest{Phone: number})
+	if err != nil {
+		if status.Code(err) == codes.AlreadyExists {
+			c.JSON(http.StatusConflict, gin.H{
+				"error":      "That phone number is already in use",
+				"error_code": "phone_taken",
+			})
+			return
+		}
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to send verification code: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"message":        resp.GetMessage(),
+		"phone":          number,
+		"phone_verified": false,
+	})
+}
+
+// verifyPhone confirms the number with the OTP texted to it
+func verifyPhone(c *gin.Context, rpc verifyPhoneRPC) {
+	ctx, ok := phoneContext(c)
+	if !ok {
+		return
+	}
+	var body struct {
+		Otp string `json:"otp" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		utils.RespondWithValidationError(c, err)
+		return
+	}
+	resp, err := rpc(ctx, &authpb.VerifyPhoneRequest{Otp: strings.TrimSpace(body.Otp)})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.InvalidArgument, codes.Unauthenticated, codes.PermissionDenied:
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":      "The code is invalid or has expired",
+				"error_code": "invalid_otp",
+			})
+		case codes.NotFound:
+			c.JSON(http.StatusNotFound, gin.H{
+				"error":      "No phone number is waiting for verification",
+				"error_code": "no_pending_phone",
+			})
+		default:
+			c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to verify phone: " + utils.GRPCErrorMessage(err)})
+		}
+		return
+	}
+	// Cached candidate profiles carry phone_verified
+	profilecache.Invalidate(c.Request.Context(), c.GetString("user_id"))
+	recordAudit(c, "phone.verify", c.GetString("user_role")+":"+c.GetString("user_id"), nil)
+	c.JSON(http.StatusOK, gin.H{
+		"message":        resp.GetMessage(),
+		"phone":          resp.GetPhone(),
+		"phone_verified": true,
+	})
+}
+
+// removePhone deletes the number, verified or not
+func removePhone(c *gin.Context, rpc removePhoneRPC) {
+	ctx, ok := phoneContext(c)
+	if !ok {
+		return
+	}
+	if _, err := rpc(ctx, &authpb.RemovePhoneRequest{}); err != nil {
+		if status.Code(err) == codes.NotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "No phone number to remove"})
+			return
+		}
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to remove phone: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+	profilecache.Invalidate(c.Request.Context(), c.GetString("user_id"))
+	recordAudit(c, "phone.remove", c.GetString("user_role")+":"+c.GetString("user_id"), nil)
+	c.Status(http.StatusNoContent)
+}
+
+// withPhoneVerified adds phone_verified to a profile response; the generated field
+// is left out of JSON when false
+func withPhoneVerified(resp interface{}, verified bool) gin.H {
+	body := gin.H{}
+	if encoded, err := json.Marshal(resp); err == nil {
+		_ = json.Unmarshal(encoded, &body)
+	}
+	body["phone_verified"] = verified
+	return body
+}
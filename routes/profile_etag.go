@@ -0,0 +1,88 @@
+package routes
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	authpb "github.com/shahal0/skillsync-protos/gen/authpb"
+
+	"skillsync-api-gateway/clients"
+	"skillsync-api-gateway/utils"
+)
+
+// profileETag is a profile's version as an entity tag. The auth service doesn't
+// version profiles, so it is a digest of the profile as served.
+func profileETag(profile interface{}) string {
+	data, err := json.Marshal(profile)
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(data)
+	return `"` + hex.EncodeToString(sum[:16]) + `"`
+}
+
+// etagMatches reports whether an If-Match header accepts etag. Compression turns
+// the tag weak on the way out, so a W/ prefix is ignored rather than never matching.
+func etagMatches(ifMatch, etag string) bool {
+	for _, candidate := range strings.Split(ifMatch, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// currentProfile reads the caller's profile as the matching GET does, for its ETag
+func currentProfile(ctx context.Context, role string) (interface{}, error) {
+	if role == "employer" {
+		return clients.AuthServiceClient.EmployerProfile(ctx, &authpb.EmployerProfileRequest{})
+	}
+	return clients.AuthServiceClient.CandidateProfile(ctx, &authpb.CandidateProfileRequest{})
+}
+
+// checkProfileVersion enforces If-Match on a profile update. Without the header the
+// update goes ahead, last write wins. Otherwise the profile is read again and, if it
+// changed since the client's copy, the request gets 412 with the current ETag and
+// profile so the client can merge; it reports false then. A write landing between
+// the read and the update can still slip through.
+func checkProfileVersion(ctx context.Context, c *gin.Context, role string) bool {
+	ifMatch := c.GetHeader("If-Match")
+	if ifMatch == "" {
+		return true
+	}
+	profile, err := currentProfile(ctx, role)
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": utils.GRPCErrorMessage(err)})
+		return false
+	}
+	etag := profileETag(profile)
+	if etagMatches(ifMatch, etag) {
+		return true
+	}
+	c.Header("ETag", etag)
+	c.JSON(http.StatusPreconditionFailed, gin.H{
+		"error":      "The profile changed since you loaded it",
+		"error_code": "precondition_failed",
+		"etag":       etag,
+		"profile":    profile,
+	})
+	return false
+}
+
+// setUpdatedProfileETag sends the ETag of the profile after an update, so a client
+// using If-Match can update again without reloading. Skipped when the update had
+// no If-Match, to keep last-write-wins updates at one backend call.
+func setUpdatedProfileETag(ctx context.Context, c *gin.Context, role string) {
+	if c.GetHeader("If-Match") == "" {
+		return
+	}
+	if profile, err := currentProfile(ctx, role); err == nil {
+		c.Header("ETag", profileETag(profile))
+	}
+}
@@ -0,0 +1,100 @@
+package routes
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	authpb "github.com/shahal0/skillsync-protos/gen/authpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"skillsync-api-gateway/clients"
+)
+
+func TestEtagMatches(t *testing.T) {
+	tests := []struct {
+		name    string
+		ifMatch string
+		want    bool
+	}{
+		{"same", `"abc"`, true},
+		{"weak", `W/"abc"`, true},
+		{"in a list", `"xyz", W/"abc"`, true},
+		{"any", "*", true},
+		{"other", `"xyz"`, false},
+		{"unquoted", "abc", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := etagMatches(tt.ifMatch, `"abc"`); got != tt.want {
+				t.Errorf("etagMatches(%q) = %v, want %v", tt.ifMatch, got, tt.want)
+			}
+		})
+	}
+}
+
+type fakeProfileAuth struct {
+	authpb.AuthServiceClient
+	name  string
+	err   error
+	calls int
+}
+
+func (f *fakeProfileAuth) CandidateProfile(context.Context, *authpb.CandidateProfileRequest, ...grpc.CallOption) (*authpb.CandidateProfileResponse, error) {
+	f.calls++
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &authpb.CandidateProfileResponse{Id: "c1", Name: f.name}, nil
+}
+
+func TestCheckProfileVersion(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	previous := clients.AuthServiceClient
+	defer func() { clients.AuthServiceClient = previous }()
+	current := profileETag(&authpb.CandidateProfileResponse{Id: "c1", Name: "Ada"})
+
+	tests := []struct {
+		name       string
+		ifMatch    string
+		auth       *fakeProfileAuth
+		want       bool
+		wantStatus int
+		wantCalls  int
+	}{
+		{"no If-Match", "", &fakeProfileAuth{name: "Ada"}, true, http.StatusOK, 0},
+		{"current", current, &fakeProfileAuth{name: "Ada"}, true, http.StatusOK, 1},
+		{"changed since", current, &fakeProfileAuth{name: "Grace"}, false, http.StatusPreconditionFailed, 1},
+		{"profile unreadable", current, &fakeProfileAuth{err: status.Error(codes.Unavailable, "down")}, false, http.StatusServiceUnavailable, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clients.AuthServiceClient = tt.auth
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodPut, "/auth/candidate/profile", nil)
+			if tt.ifMatch != "" {
+				c.Request.Header.Set("If-Match", tt.ifMatch)
+			}
+
+			if got := checkProfileVersion(context.Background(), c, "candidate"); got != tt.want {
+				t.Fatalf("checkProfileVersion() = %v, want %v", got, tt.want)
+			}
+			if w.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", w.Code, tt.wantStatus)
+			}
+			if tt.auth.calls != tt.wantCalls {
+				t.Errorf("profile read %d times, want %d", tt.auth.calls, tt.wantCalls)
+			}
+			if tt.wantStatus == http.StatusPreconditionFailed {
+				if etag := w.Header().Get("ETag"); etag == "" || etag == current {
+					t.Errorf("ETag = %q, want the changed profile's", etag)
+				}
+			}
+		})
+	}
+}
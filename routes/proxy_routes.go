@@ -0,0 +1,156 @@
+package routes
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"skillsync-api-gateway/config"
+	"skillsync-api-gateway/middlewares"
+)
+
+const (
+	// proxyDialTimeout bounds connecting to a backend, or the route's timeout if shorter
+	proxyDialTimeout     = 5 * time.Second
+	proxyIdleConnTimeout = 90 * time.Second
+	proxyMaxIdlePerHost  = 32
+	// proxyFlushInterval keeps streamed responses moving without flushing every write
+	proxyFlushInterval = 100 * time.Millisecond
+	// statusClientClosedRequest is logged for requests the client gave up on, as nginx does
+	statusClientClosedRequest = 499
+)
+
+// Identity headers the gateway sets on JWT routes; clients can't supply their own
+var proxyIdentityHeaders = []string{"X-User-ID", "X-User-Role"}
+
+// proxyResponseHeaderPrefixes are backend response headers the gateway answers
+// itself. Browsers reject duplicated CORS headers, so the backend's are dropped.
+var proxyResponseHeaderPrefixes = []string{"Access-Control-"}
+
+// SetupProxyRoutes mounts each PROXY_ROUTES prefix as a catch-all group that
+// forwards to a REST backend, so endpoints can migrate without a Go handler per path
+func SetupProxyRoutes(r *gin.Engine) {
+	for _, route := range cfg.ProxyRoutes {
+		proxy, err := newReverseProxy(route)
+		if err != nil {
+			// config.Validate already checked the target
+			log.Fatalf("Proxy route %s: %v", route.Prefix, err)
+		}
+
+		group := r.Group(route.Prefix)
+		group.Use(middlewares.NoCompression())
+		if route.RequireJWT {
+			group.Use(middlewares.JWTMiddleware())
+		}
+		handler := proxyHandler(proxy)
+		group.Any("", handler)
+		group.Any("/*path", handler)
+		log.Printf("Proxying %s/* to %s (jwt: %t, timeout: %s)", route.Prefix, route.Target, route.RequireJWT, route.Timeout)
+	}
+}
+
+// newReverseProxy forwards to route.Target, keeping the query string, stripping
+// hop-by-hop headers and streaming the response as it arrives
+func newReverseProxy(route config.ProxyRoute) (*httputil.ReverseProxy, error) {
+	target, err := url.Parse(route.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	dialTimeout := proxyDialTimeout
+	if route.Timeout > 0 && route.Timeout < dialTimeout {
+		dialTimeout = route.Timeout
+	}
+	transport := &http.Transport{
+		Proxy:                 http.ProxyFromEnvironment,
+		DialContext:           (&net.Dialer{Timeout: dialTimeout, KeepAlive: 30 * time.Second}).DialContext,
+		MaxIdleConns:          proxyMaxIdlePerHost * 4,
+		MaxIdleConnsPerHost:   proxyMaxIdlePerHost,
+		IdleConnTimeout:       proxyIdleConnTimeout,
+		TLSHandshakeTimeout:   dialTimeout,
+		ResponseHeaderTimeout: route.Timeout,
+		ExpectContinueTimeout: time.Second,
+	}
+
+	return &httputil.ReverseProxy{
+		Rewrite: func(pr *httputil.ProxyRequest) {
+			if !route.KeepPrefix {
+				pr.Out.URL.Path = strings.TrimPrefix(pr.In.URL.Path, route.Prefix)
+				pr.Out.URL.RawPath = ""
+			}
+			pr.SetURL(target)
+			pr.SetXForwarded()
+		},
+		// The gateway already answers with its own request ID and CORS headers
+		ModifyResponse: func(resp *http.Response) error {
+			resp.Header.Del(middlewares.RequestIDHeader)
+			for name := range resp.Header {
+				for _, prefix := range proxyResponseHeaderPrefixes {
+					if strings.HasPrefix(name, prefix) {
+						resp.Header.Del(name)
+					}
+				}
+			}
+			return nil
+		},
+		Transport:     transport,
+		FlushInterval: proxyFlushInterval,
+		ErrorHandler: func(w http.ResponseWriter, r *http.Request, err error) {
+			code, message := proxyErrorStatus(r, err)
+			if code == statusClientClosedRequest {
+				// Nobody is left to read an answer
+				w.WriteHeader(code)
+				return
+			}
+			log.Printf("Proxy %s %s to %s failed: %v", r.Method, r.URL.Path, route.Target, err)
+			w.Header().Set("Content-Type", "application/json; charset=utf-8")
+			w.WriteHeader(code)
+			json.NewEncoder(w).Encode(gin.H{"error": message})
+		},
+	}, nil
+}
+
+// proxyErrorStatus maps a failed proxy round trip to the status and message the
+// client gets: 504 for a timeout, 413 for a body over the route's limit, 499 when
+// the client went away, and 502 for anything else the backend did
+func proxyErrorStatus(r *http.Request, err error) (int, string) {
+	var maxBytesErr *http.MaxBytesError
+	var netErr net.Error
+	switch {
+	case errors.As(err, &maxBytesErr):
+		return http.StatusRequestEntityTooLarge, "Request body too large"
+	case errors.Is(err, context.Canceled) && r.Context().Err() != nil:
+		return statusClientClosedRequest, "Client closed the request"
+	case errors.Is(err, context.DeadlineExceeded) || (errors.As(err, &netErr) && netErr.Timeout()):
+		return http.StatusGatewayTimeout, "Upstream service timed out"
+	default:
+		return http.StatusBadGateway, "Upstream service unavailable"
+	}
+}
+
+// proxyHandler replaces any client-supplied identity headers with the caller
+// authenticated by JWTMiddleware and passes the request ID on to the backend
+func proxyHandler(proxy *httputil.ReverseProxy) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		for _, header := range proxyIdentityHeaders {
+			c.Request.Header.Del(header)
+		}
+		if id := c.GetString("request_id"); id != "" {
+			c.Request.Header.Set(middlewares.RequestIDHeader, id)
+		}
+		if userID := c.GetString("user_id"); userID != "" {
+			c.Request.Header.Set("X-User-ID", userID)
+			c.Request.Header.Set("X-User-Role", c.GetString("user_role"))
+		}
+		proxy.ServeHTTP(c.Writer, c.Request)
+	}
+}
@@ -0,0 +1,278 @@
+package routes
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"skillsync-api-gateway/config"
+)
+
+// proxyGateway serves SetupProxyRoutes for routes. It runs a real server because
+// ReverseProxy needs a ResponseWriter that can report a client going away.
+func proxyGateway(t *testing.T, routes ...config.ProxyRoute) *httptest.Server {
+	t.Helper()
+	previous := cfg
+	t.Cleanup(func() { cfg = previous })
+	cfg = config.Default()
+	cfg.ProxyRoutes = routes
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	SetupProxyRoutes(r)
+	gateway := httptest.NewServer(r)
+	t.Cleanup(gateway.Close)
+	return gateway
+}
+
+// proxyGet sends a GET through gateway and returns the response with its body read
+func proxyGet(t *testing.T, gateway *httptest.Server, target string, headers map[string]string) (*http.Response, []byte) {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodGet, gateway.URL+target, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Host = "gateway.example"
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resp, body
+}
+
+// seenRequest is what the upstream received
+type seenRequest struct {
+	Path    string      `json:"path"`
+	Query   string      `json:"query"`
+	Headers http.Header `json:"headers"`
+}
+
+func echoUpstream(t *testing.T) *httptest.Server {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+		w.Header().Set("X-Upstream", "yes")
+		json.NewEncoder(w).Encode(seenRequest{Path: r.URL.Path, Query: r.URL.RawQuery, Headers: r.Header})
+	}))
+	t.Cleanup(upstream.Close)
+	return upstream
+}
+
+func TestProxyForwardsRequests(t *testing.T) {
+	upstream := echoUpstream(t)
+	gateway := proxyGateway(t,
+		config.ProxyRoute{Prefix: "/legacy", Target: upstream.URL + "/api", Timeout: time.Second},
+		config.ProxyRoute{Prefix: "/kept", Target: upstream.URL, KeepPrefix: true, Timeout: time.Second},
+	)
+
+	tests := []struct {
+		name        string
+		target      string
+		headers     map[string]string
+		wantPath    string
+		wantQuery   string
+		wantHeaders map[string]string
+		// wantAbsent are headers the upstream mustn't see
+		wantAbsent []string
+	}{
+		{
+			name:      "query string survives",
+			target:    "/legacy/items?q=a%20b&page=2&tag=x&tag=y",
+			wantPath:  "/api/items",
+			wantQuery: "q=a%20b&page=2&tag=x&tag=y",
+		},
+		{
+			name:     "prefix kept",
+			target:   "/kept/items",
+			wantPath: "/kept/items",
+		},
+		{
+			name:        "forwarding headers replace the client's",
+			target:      "/legacy/items",
+			headers:     map[string]string{"X-Forwarded-For": "203.0.113.9", "X-Forwarded-Host": "evil.example", "Forwarded": "for=203.0.113.9"},
+			wantPath:    "/api/items",
+			wantHeaders: map[string]string{"X-Forwarded-For": "127.0.0.1", "X-Forwarded-Host": "gateway.example", "X-Forwarded-Proto": "http"},
+			wantAbsent:  []string{"Forwarded"},
+		},
+		{
+			name:       "hop-by-hop headers stripped",
+			target:     "/legacy/items",
+			headers:    map[string]string{"Connection": "X-Hop", "X-Hop": "1", "Keep-Alive": "timeout=5", "Proxy-Authorization": "Basic eDp5"},
+			wantPath:   "/api/items",
+			wantAbsent: []string{"X-Hop", "Keep-Alive", "Proxy-Authorization"},
+		},
+		{
+			name:       "identity headers can't be spoofed",
+			target:     "/legacy/items",
+			headers:    map[string]string{"X-User-ID": "admin", "X-User-Role": "admin"},
+			wantPath:   "/api/items",
+			wantAbsent: []string{"X-User-Id", "X-User-Role"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, body := proxyGet(t, gateway, tt.target, tt.headers)
+			if resp.StatusCode != http.StatusOK {
+				t.Fatalf("status = %d, body %s", resp.StatusCode, body)
+			}
+			var seen seenRequest
+			if err := json.Unmarshal(body, &seen); err != nil {
+				t.Fatal(err)
+			}
+			// The proxy may re-encode the query, so compare the values
+			gotQuery, _ := url.ParseQuery(seen.Query)
+			wantQuery, _ := url.ParseQuery(tt.wantQuery)
+			if seen.Path != tt.wantPath || !reflect.DeepEqual(gotQuery, wantQuery) {
+				t.Errorf("upstream got %s?%s, want %s?%s", seen.Path, seen.Query, tt.wantPath, tt.wantQuery)
+			}
+			for name, want := range tt.wantHeaders {
+				if got := seen.Headers.Get(name); got != want {
+					t.Errorf("upstream %s = %q, want %q", name, got, want)
+				}
+			}
+			for _, name := range tt.wantAbsent {
+				if got := seen.Headers.Get(name); got != "" {
+					t.Errorf("upstream got %s = %q", name, got)
+				}
+			}
+			if resp.Header.Get("X-Upstream") != "yes" {
+				t.Error("upstream response headers weren't passed on")
+			}
+			if got := resp.Header.Get("Access-Control-Allow-Origin"); got != "" {
+				t.Errorf("upstream CORS header passed on: %q", got)
+			}
+		})
+	}
+}
+
+func TestProxyStreamsChunkedResponses(t *testing.T) {
+	release := make(chan struct{})
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, "first")
+		w.(http.Flusher).Flush()
+		<-release
+		fmt.Fprintln(w, "second")
+	}))
+	defer upstream.Close()
+	defer close(release)
+	gateway := proxyGateway(t, config.ProxyRoute{Prefix: "/legacy", Target: upstream.URL, Timeout: time.Second})
+
+	resp, err := http.Get(gateway.URL + "/legacy/stream")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if len(resp.TransferEncoding) == 0 || resp.TransferEncoding[0] != "chunked" {
+		t.Errorf("transfer encoding = %v, want chunked", resp.TransferEncoding)
+	}
+
+	// The first chunk arrives while the upstream is still holding the second
+	lines := make(chan string)
+	go func() {
+		line, _ := bufio.NewReader(resp.Body).ReadString('\n')
+		lines <- line
+	}()
+	select {
+	case line := <-lines:
+		if line != "first\n" {
+			t.Errorf("first chunk = %q", line)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("response was buffered instead of streamed")
+	}
+}
+
+func TestProxyUpstreamFailures(t *testing.T) {
+	// A listener that was closed refuses connections
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	refused := "http://" + listener.Addr().String()
+	listener.Close()
+
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-time.After(time.Second):
+		case <-r.Context().Done():
+		}
+	}))
+	defer slow.Close()
+
+	tests := []struct {
+		name      string
+		target    string
+		wantCode  int
+		wantError string
+	}{
+		{"connection refused", refused, http.StatusBadGateway, "Upstream service unavailable"},
+		{"no response headers in time", slow.URL, http.StatusGatewayTimeout, "Upstream service timed out"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gateway := proxyGateway(t, config.ProxyRoute{Prefix: "/legacy", Target: tt.target, Timeout: 50 * time.Millisecond})
+			resp, body := proxyGet(t, gateway, "/legacy/items", nil)
+			if resp.StatusCode != tt.wantCode {
+				t.Errorf("status = %d, want %d", resp.StatusCode, tt.wantCode)
+			}
+			var envelope map[string]string
+			if err := json.Unmarshal(body, &envelope); err != nil || envelope["error"] != tt.wantError {
+				t.Errorf("body = %s, want error %q", body, tt.wantError)
+			}
+		})
+	}
+}
+
+func TestProxyErrorStatus(t *testing.T) {
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	live := httptest.NewRequest(http.MethodGet, "/", nil)
+	gone := live.WithContext(cancelled)
+
+	tests := []struct {
+		name string
+		req  *http.Request
+		err  error
+		want int
+	}{
+		{"body over the limit", live, fmt.Errorf("copying body: %w", &http.MaxBytesError{Limit: 10}), http.StatusRequestEntityTooLarge},
+		{"client went away", gone, context.Canceled, statusClientClosedRequest},
+		{"backend cancelled", live, context.Canceled, http.StatusBadGateway},
+		{"deadline", live, context.DeadlineExceeded, http.StatusGatewayTimeout},
+		{"dial timeout", live, &net.OpError{Op: "dial", Err: timeoutError{}}, http.StatusGatewayTimeout},
+		{"backend hung up", live, io.ErrUnexpectedEOF, http.StatusBadGateway},
+		{"other", live, errors.New("boom"), http.StatusBadGateway},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, _ := proxyErrorStatus(tt.req, tt.err); got != tt.want {
+				t.Errorf("status = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
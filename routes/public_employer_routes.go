@@ -0,0 +1,46 @@
+package routes
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"skillsync-api-gateway/middlewares"
+	"skillsync-api-gateway/utils"
+)
+
+// publicCacheMaxAge is how long browsers and CDNs may reuse public job and employer pages
+const publicCacheMaxAge = time.Minute
+
+func SetupEmployerRoutes(r *gin.Engine) {
+	publicEmployers := r.Group("/employers")
+	publicEmployers.Use(middlewares.Maintenance("auth"), middlewares.PublicCache(publicCacheMaxAge))
+	{
+		publicEmployers.GET("/:id/public", GetEmployerPublicProfile)
+		publicEmployers.GET("/:id/reviews", GetEmployerReviews)
+	}
+
+	reviews := r.Group("/employers")
+	reviews.Use(middlewares.Maintenance("auth"), middlewares.JWTMiddleware(), middlewares.RequireRole("candidate"))
+	{
+		reviews.POST("/:id/reviews", CreateEmployerReview)
+		reviews.DELETE("/:id/reviews/mine", DeleteMyEmployerReview)
+	}
+}
+
+func GetEmployerPublicProfile(c *gin.Context) {
+	employerID := c.Param("id")
+	if employerID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid employer ID"})
+		return
+	}
+
+	profile, err := getEmployerPublicProfile(c.Request.Context(), employerID)
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": utils.GRPCErrorMessage(err)})
+		return
+	}
+
+	c.JSON(http.StatusOK, publicEmployerProfile(profile))
+}
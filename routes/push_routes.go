@@ -0,0 +1,236 @@
+package routes
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"log"
+	"net/http"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	notificationpb "github.com/shahal0/skillsync-protos/gen/notificationpb"
+
+	"skillsync-api-gateway/clients"
+	"skillsync-api-gateway/middlewares"
+	"skillsync-api-gateway/utils"
+	"skillsync-api-gateway/utils/notifier"
+	"skillsync-api-gateway/utils/webpush"
+)
+
+// pushSender is set by Configure from the VAPID keys; nil disables web push
+var pushSender *webpush.Sender
+
+// pushMetrics are published on the pprof server at /debug/vars
+var pushMetrics = expvar.NewMap("web_push")
+
+// pushTypes are the notifications urgent enough to push to a closed tab
+var pushTypes = map[string]bool{
+	"new_message":           true,
+	"application_status":    true,
+	"interview_scheduled":   true,
+	"interview_rescheduled": true,
+	"interview_cancelled":   true,
+}
+
+var (
+	pushOutboxOnce sync.Once
+	pushOutboxInst *notifier.Notifier
+)
+
+// pushOutbox lazily starts the workers that send web pushes
+func pushOutbox() *notifier.Notifier {
+	pushOutboxOnce.Do(func() {
+		pushOutboxInst = notifier.New(nil, sendPush, notifier.Options{})
+	})
+	return pushOutboxInst
+}
+
+// drainPushes sends the pushes still queued, giving up at ctx's deadline
+func drainPushes(ctx context.Context) error {
+	started := true
+	pushOutboxOnce.Do(func() { started = false })
+	if !started {
+		return nil
+	}
+	return pushOutboxInst.Shutdown(ctx)
+}
+
+// queuePush queues a push of eventType to every browser userID subscribed, if
+// web push is on and the type is urgent enough. It never blocks the caller.
+func queuePush(ctx context.Context, userID, eventType, sourceID string) {
+	if pushSender == nil || userID == "" || !pushTypes[eventType] {
+		return
+	}
+	err := pushOutbox().Enqueue(ctx, &notifier.Event{UserID: userID, Type: eventType, SourceID: sourceID})
+	if err != nil {
+		log.Printf("Failed to queue %s push for %s: %v", eventType, userID, err)
+	}
+}
+
+// pushAllowed reports whether the user's notification preferences let eventType
+// be pushed to them
+func pushAllowed(ctx context.Context, client notificationpb.NotificationServiceClient, userID, eventType string) (bool, error) {
+	resp, err := client.GetNotificationPreferences(ctx, &notificationpb.GetNotificationPreferencesRequest{UserId: userID})
+	if err != nil {
+		return false, err
+	}
+	preferences := resp.GetPreferences()
+	if !preferences.GetPushEnabled() {
+		return false, nil
+	}
+	for _, muted := range preferences.GetMutedTypes() {
+		if muted == eventType {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// sendPush is the push outbox's sender. Each of the user's subscriptions gets the
+// event's type and ID only. A failed lookup is retried; a failed push is not, so
+// browsers that got it aren't pushed twice. Subscriptions the push service no
+// longer knows are deleted.
+func sendPush(ctx context.Context, event *notifier.Event) error {
+	client := clients.GetNotificationClient()
+	if client == nil {
+		return errors.New("notification client not initialized")
+	}
+	allowed, err := pushAllowed(ctx, client, event.UserID, event.Type)
+	if err != nil || !allowed {
+		return err
+	}
+	resp, err := client.ListPushSubscriptions(ctx, &notificationpb.ListPushSubscriptionsRequest{UserId: event.UserID})
+	if err != nil {
+		return err
+	}
+	payload := webpush.Payload{Type: event.Type, ID: event.SourceID}
+	for _, subscription := range resp.GetSubscriptions() {
+		err := pushSender.Send(ctx, webpush.Subscription{
+			Endpoint: subscription.GetEndpoint(),
+			P256dh:   subscription.GetP256Dh(),
+			Auth:     subscription.GetAuth(),
+		}, payload)
+		switch {
+		case errors.Is(err, webpush.ErrGone):
+			pushMetrics.Add("gone", 1)
+			_, err := client.DeletePushSubscription(ctx, &notificationpb.DeletePushSubscriptionRequest{
+				UserId:   event.UserID,
+				Endpoint: subscription.GetEndpoint(),
+			})
+			if err != nil {
+				log.Printf("Failed to delete expired push subscription of %s: %v", event.UserID, err)
+			}
+		case err != nil:
+			pushMetrics.Add("failed", 1)
+			log.Printf("Web push of %s to %s failed: %v", event.Type, event.UserID, err)
+		default:
+			pushMetrics.Add("sent", 1)
+		}
+	}
+	return nil
+}
+
+func SetupPushRoutes(r *gin.Engine) {
+	push := r.Group("/chat-notification/push")
+	push.Use(middlewares.Maintenance("notification"), middlewares.JWTMiddleware())
+	{
+		push.GET("/public-key", GetPushPublicKey)
+		push.POST("/subscribe", SubscribePush)
+		push.DELETE("/subscribe", UnsubscribePush)
+	}
+}
+
+// respondPushDisabled answers push routes while the gateway has no VAPID keys
+func respondPushDisabled(c *gin.Context) {
+	c.JSON(http.StatusNotImplemented, gin.H{"error": "Web push is not enabled", "error_code": "push_disabled"})
+}
+
+// GetPushPublicKey returns the VAPID key browsers subscribe with
+func GetPushPublicKey(c *gin.Context) {
+	if pushSender == nil {
+		respondPushDisabled(c)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"public_key": pushSender.PublicKey()})
+}
+
+// SubscribePush saves the caller's browser subscription, one per endpoint, so
+// urgent notifications reach it while the app is closed
+func SubscribePush(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in context"})
+		return
+	}
+	if pushSender == nil {
+		respondPushDisabled(c)
+		return
+	}
+	var body struct {
+		Endpoint string `json:"endpoint" binding:"required,url,max=2048"`
+		Keys     struct {
+			P256dh string `json:"p256dh" binding:"required,max=256"`
+			Auth   string `json:"auth" binding:"required,max=64"`
+		} `json:"keys" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		utils.RespondWithValidationError(c, err)
+		return
+	}
+	// The gateway posts to the endpoint, so only known push services are accepted
+	if !webpush.AllowedEndpoint(body.Endpoint, cfg.WebPush.AllowedHosts) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":      "endpoint must be an https URL of a supported push service",
+			"error_code": "unsupported_push_service",
+		})
+		return
+	}
+	client := clients.GetNotificationClient()
+	if client == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Notification service unavailable"})
+		return
+	}
+	_, err := client.SavePushSubscription(chatContext(c, userID.(string)), &notificationpb.SavePushSubscriptionRequest{
+		UserId:    userID.(string),
+		Endpoint:  body.Endpoint,
+		P256Dh:    body.Keys.P256dh,
+		Auth:      body.Keys.Auth,
+		UserAgent: c.Request.UserAgent(),
+	})
+	if err != nil {
+		respondChatError(c, "Failed to save push subscription", err)
+		return
+	}
+	c.JSON(http.StatusCreated, gin.H{"endpoint": body.Endpoint})
+}
+
+// UnsubscribePush deletes one of the caller's browser subscriptions
+func UnsubscribePush(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in context"})
+		return
+	}
+	var body struct {
+		Endpoint string `json:"endpoint" binding:"required,max=2048"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		utils.RespondWithValidationError(c, err)
+		return
+	}
+	client := clients.GetNotificationClient()
+	if client == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Notification service unavailable"})
+		return
+	}
+	_, err := client.DeletePushSubscription(chatContext(c, userID.(string)), &notificationpb.DeletePushSubscriptionRequest{
+		UserId:   userID.(string),
+		Endpoint: body.Endpoint,
+	})
+	if err != nil {
+		respondChatError(c, "Failed to delete push subscription", err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,69 @@
+package routes
+
+import (
+	"time"
+
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+
+	"skillsync-api-gateway/config"
+	"skillsync-api-gateway/middlewares"
+	"skillsync-api-gateway/utils/profilecache"
+)
+
+// NewRouter builds the gateway's Gin engine with its global middleware and every
+// route group. The packages must already be configured and the clients connected.
+func NewRouter(c *config.Config) *gin.Engine {
+	// The access log replaces gin's default logger
+	r := gin.New()
+	r.Use(gin.Recovery())
+	if c.MockMode {
+		// Set first so even requests aborted by later middleware carry it
+		r.Use(middlewares.MockMode())
+	}
+	r.Use(middlewares.RequestID())
+	// Times the whole request, middleware included, against its route's SLO
+	r.Use(middlewares.SLO())
+	// Bounds and retries each route's backend calls by its entry in the policy table
+	r.Use(middlewares.UpstreamPolicy())
+	r.Use(middlewares.AccessLog())
+	r.Use(middlewares.Compress())
+	r.Use(middlewares.ProblemDetails())
+	// Translates error messages before ProblemDetails converts them
+	r.Use(middlewares.Locale())
+
+	r.Use(middlewares.SecurityHeaders())
+	// Lets the sections of one request share the caller's profile
+	r.Use(profilecache.Scope())
+
+	r.Use(cors.New(cors.Config{
+		AllowOrigins:     c.CORS.AllowOrigins,
+		AllowMethods:     []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
+		AllowHeaders:     []string{"Origin", "Content-Type", "Accept", "Authorization", "X-API-Key", "X-Grpc-Web", "X-User-Agent", "Grpc-Timeout", "Idempotency-Key", "If-Match", "X-Request-ID"},
+		ExposeHeaders:    []string{"Content-Length", "ETag", "Grpc-Status", "Grpc-Message", "Idempotency-Replayed", "Signup-Replayed", "X-Request-ID", "X-Mock-Mode"},
+		AllowCredentials: true,
+		MaxAge:           12 * time.Hour,
+	}))
+
+	SetupRoutes(r)             // Auth routes
+	SetupJobRoutes(r)          // Job routes
+	SetupAdminRoutes(r)        // Admin routes
+	SetupEmployerRoutes(r)     // Public employer routes
+	SetupCandidateRoutes(r)    // Candidate sourcing routes
+	SetupMeRoutes(r)           // Current user routes
+	SetupWebhookRoutes(r)      // Employer webhook routes
+	SetupChatRoutes(r)         // Chat routes
+	SetupNotificationRoutes(r) // Notification routes
+	SetupPushRoutes(r)         // Web Push subscriptions
+	SetupUploadRoutes(r)       // Presigned direct-to-storage uploads
+	SetupSkillRoutes(r)        // Skill taxonomy and typeahead
+	SetupGraphQLRoutes(r)      // Read-only GraphQL over the same backends
+	SetupGRPCWebRoutes(r)      // gRPC-Web access to the auth and job services
+	SetupHealthRoutes(r)       // Readiness probe
+	SetupProxyRoutes(r)        // PROXY_ROUTES prefixes forwarded to REST backends
+	SetupCallbackRoutes(r)     // Signed callbacks from partners
+	SetupSitemapRoutes(r)      // robots.txt and the job sitemap for search engines
+	SetupBatchRoutes(r)        // Several calls in one round trip, through the routes above
+	SetupFallbackRoutes(r)     // JSON 404/405 handlers and route listing; must be last
+	return r
+}
@@ -0,0 +1,379 @@
+package routes
+
+import (
+	"context"
+	"expvar"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	authpb "github.com/shahal0/skillsync-protos/gen/authpb"
+	jobpb "github.com/shahal0/skillsync-protos/gen/jobpb"
+	"google.golang.org/grpc/metadata"
+
+	"skillsync-api-gateway/clients"
+	"skillsync-api-gateway/utils"
+	"skillsync-api-gateway/utils/profilecache"
+	"skillsync-api-gateway/utils/screening"
+)
+
+// screeningTimeout bounds screening a new application, so applying stays quick
+const screeningTimeout = 3 * time.Second
+
+// screeningMetrics are published on the pprof server at /debug/vars
+var screeningMetrics = expvar.NewMap("screening")
+
+// screeningStatuses are the application statuses each action moves to
+var screeningStatuses = map[string]string{
+	screening.Reject:    "REJECTED",
+	screening.Shortlist: "SHORTLISTED",
+}
+
+type screeningRuleRequest struct {
+	Field    string `json:"field" binding:"required"`
+	Operator string `json:"operator" binding:"required"`
+	// Value is a number or a string, depending on the field
+	Value   interface{} `json:"value" binding:"required"`
+	Action  string      `json:"action" binding:"required,oneof=reject shortlist"`
+	Message string      `json:"message" binding:"max=500,no_profanity"`
+}
+
+type screeningSample struct {
+	ExperienceYears int64    `json:"experience_years" binding:"min=0"`
+	Skills          []string `json:"skills" binding:"max=100,dive,max=100"`
+	Location        string   `json:"location" binding:"max=200"`
+}
+
+// screeningRules checks submitted rules against the catalog. Skill values are
+// stored under their canonical names. It answers the request and returns false
+// when a rule isn't supported.
+func screeningRules(c *gin.Context, submitted []screeningRuleRequest) ([]screening.Rule, bool) {
+	rules := make([]screening.Rule, 0, len(submitted))
+	for i, rule := range submitted {
+		value := strings.TrimSpace(fmt.Sprint(rule.Value))
+		if number, ok := rule.Value.(float64); ok {
+			value = strconv.FormatFloat(number, 'f', -1, 64)
+		}
+		if rule.Field == screening.Skill {
+			value, _ = canonicalSkill(value)
+		}
+		parsed := screening.Rule{
+			Field:    rule.Field,
+			Operator: rule.Operator,
+			Value:    value,
+			Action:   rule.Action,
+			Message:  strings.TrimSpace(rule.Message),
+		}
+		if err := screening.Validate(parsed); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":      "rules[" + strconv.Itoa(i) + "]: " + err.Error(),
+				"error_code": "invalid_screening_rule",
+				"catalog":    screening.Catalog(),
+			})
+			return nil, false
+		}
+		rules = append(rules, parsed)
+	}
+	return rules, true
+}
+
+func screeningRulesFromProto(stored []*jobpb.ScreeningRule) []screening.Rule {
+	rules := make([]screening.Rule, 0, len(stored))
+	for _, rule := range stored {
+		rules = append(rules, screening.Rule{
+			Field:    rule.GetField(),
+			Operator: rule.GetOperator(),
+			Value:    rule.GetValue(),
+			Action:   rule.GetAction(),
+			Message:  rule.GetMessage(),
+		})
+	}
+	return rules
+}
+
+func screeningRuleViews(rules []screening.Rule) []gin.H {
+	views := make([]gin.H, 0, len(rules))
+	for _, rule := range rules {
+		views = append(views, gin.H{
+			"field":    rule.Field,
+			"operator": rule.Operator,
+			"value":    rule.Value,
+			"action":   rule.Action,
+			"message":  rule.Message,
+		})
+	}
+	return views
+}
+
+// screeningApplicant gathers what rules are evaluated against from the
+// candidate's profile and the job's required skills
+func screeningApplicant(profile *authpb.CandidateProfileResponse, requiredSkills []string) screening.Applicant {
+	applicant := screening.Applicant{
+		ExperienceYears: profile.GetExperience(),
+		Skills:          make(map[string]bool, len(profile.GetSkills())),
+		Location:        profile.GetCurrentLocation(),
+	}
+	for _, skill := range profile.GetSkills() {
+		name, _ := canonicalSkill(strings.TrimSpace(skill.GetSkill()))
+		applicant.Skills[strings.ToLower(name)] = true
+	}
+	if percentage, ok := skillMatch(requiredSkills, profile.GetSkills())["match_percentage"].(int); ok {
+		applicant.SkillMatchPercent = &percentage
+	}
+	return applicant
+}
+
+// screeningJobID reads the job ID of a screening route, answering the request
+// when it isn't valid
+func screeningJobID(c *gin.Context) (uint64, bool) {
+	jobID, err := strconv.ParseUint(c.Param("job_id"), 10, 64)
+	if err != nil || jobID == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid job ID"})
+		return 0, false
+	}
+	return jobID, true
+}
+
+// SaveScreeningRules replaces the rules that screen new applications to a job
+func SaveScreeningRules(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in context"})
+		return
+	}
+	jobID, ok := screeningJobID(c)
+	if !ok {
+		return
+	}
+	var body struct {
+		Rules []screeningRuleRequest `json:"rules" binding:"required,min=1,max=10,dive"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		utils.RespondWithValidationError(c, err)
+		return
+	}
+	rules, ok := screeningRules(c, body.Rules)
+	if !ok {
+		return
+	}
+	stored := make([]*jobpb.ScreeningRule, 0, len(rules))
+	for _, rule := range rules {
+		stored = append(stored, &jobpb.ScreeningRule{
+			Field:    rule.Field,
+			Operator: rule.Operator,
+			Value:    rule.Value,
+			Action:   rule.Action,
+			Message:  rule.Message,
+		})
+	}
+	_, err := clients.JobServiceClient.SetScreeningRules(jobOwnerContext(c, userID.(string)), &jobpb.SetScreeningRulesRequest{
+		JobId:      jobID,
+		EmployerId: userID.(string),
+		Rules:      stored,
+	})
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to save screening rules: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+	recordAudit(c, "job.screening_rules_set", "job:"+strconv.FormatUint(jobID, 10), map[string]string{"rules": strconv.Itoa(len(rules))})
+	c.JSON(http.StatusOK, gin.H{"rules": screeningRuleViews(rules)})
+}
+
+// GetScreeningRules lists a job's screening rules with the supported catalog
+func GetScreeningRules(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in context"})
+		return
+	}
+	jobID, ok := screeningJobID(c)
+	if !ok {
+		return
+	}
+	resp, err := clients.JobServiceClient.GetScreeningRules(jobOwnerContext(c, userID.(string)), &jobpb.GetScreeningRulesRequest{
+		JobId:      jobID,
+		EmployerId: userID.(string),
+	})
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to get screening rules: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"rules":   screeningRuleViews(screeningRulesFromProto(resp.GetRules())),
+		"catalog": screening.Catalog(),
+	})
+}
+
+// DeleteScreeningRules stops screening new applications to a job
+func DeleteScreeningRules(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in context"})
+		return
+	}
+	jobID, ok := screeningJobID(c)
+	if !ok {
+		return
+	}
+	_, err := clients.JobServiceClient.DeleteScreeningRules(jobOwnerContext(c, userID.(string)), &jobpb.DeleteScreeningRulesRequest{
+		JobId:      jobID,
+		EmployerId: userID.(string),
+	})
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to delete screening rules: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+	recordAudit(c, "job.screening_rules_delete", "job:"+strconv.FormatUint(jobID, 10), nil)
+	c.Status(http.StatusNoContent)
+}
+
+// TestScreeningRules evaluates rules against a sample applicant without touching
+// any application. It uses the job's saved rules unless the body has its own.
+func TestScreeningRules(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in context"})
+		return
+	}
+	jobID, ok := screeningJobID(c)
+	if !ok {
+		return
+	}
+	var body struct {
+		Rules  []screeningRuleRequest `json:"rules" binding:"max=10,dive"`
+		Sample screeningSample        `json:"sample" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		utils.RespondWithValidationError(c, err)
+		return
+	}
+
+	// Loading the saved rules also checks the caller owns the job
+	resp, err := clients.JobServiceClient.GetScreeningRules(jobOwnerContext(c, userID.(string)), &jobpb.GetScreeningRulesRequest{
+		JobId:      jobID,
+		EmployerId: userID.(string),
+	})
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to get screening rules: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+	rules := screeningRulesFromProto(resp.GetRules())
+	if len(body.Rules) > 0 {
+		if rules, ok = screeningRules(c, body.Rules); !ok {
+			return
+		}
+	}
+	job, err := cachedJobDetail(c.Request.Context(), jobID)
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to get job: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+
+	skills := make([]*authpb.Skill, 0, len(body.Sample.Skills))
+	for _, skill := range body.Sample.Skills {
+		skills = append(skills, &authpb.Skill{Skill: skill})
+	}
+	applicant := screeningApplicant(&authpb.CandidateProfileResponse{
+		Experience:      body.Sample.ExperienceYears,
+		Skills:          skills,
+		CurrentLocation: body.Sample.Location,
+	}, jobRequiredSkills(job))
+
+	result := gin.H{
+		"matched":             false,
+		"rule_index":          nil,
+		"action":              nil,
+		"status":              nil,
+		"message":             nil,
+		"skill_match_percent": applicant.SkillMatchPercent,
+	}
+	if i := screening.Evaluate(rules, applicant); i >= 0 {
+		result["matched"] = true
+		result["rule_index"] = i
+		result["action"] = rules[i].Action
+		result["status"] = screeningStatuses[rules[i].Action]
+		result["message"] = rules[i].Message
+	}
+	c.JSON(http.StatusOK, result)
+}
+
+// screenApplication runs the job's screening rules on a new application and, when
+// one fires, moves the application to its status and tells the candidate. It never
+// fails the apply: on any error the application keeps its default status.
+func screenApplication(ctx context.Context, jobID, applicationID uint64, candidateID string) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), screeningTimeout)
+	defer cancel()
+	skip := func(step string, err error) {
+		screeningMetrics.Add("errors", 1)
+		log.Printf("Screening application %d: %s failed, leaving it as submitted: %v", applicationID, step, err)
+	}
+
+	job, err := cachedJobDetail(ctx, jobID)
+	if err != nil {
+		skip("loading the job", err)
+		return
+	}
+	detail, _ := job["job"].(map[string]interface{})
+	employerID, _ := detail["employer_id"].(string)
+	if employerID == "" {
+		skip("finding the employer", fmt.Errorf("job %d has no employer", jobID))
+		return
+	}
+	employerCtx := metadata.NewOutgoingContext(ctx, metadata.New(map[string]string{
+		"user-id": employerID,
+		"role":    "employer",
+	}))
+	resp, err := clients.JobServiceClient.GetScreeningRules(employerCtx, &jobpb.GetScreeningRulesRequest{
+		JobId:      jobID,
+		EmployerId: employerID,
+	})
+	if err != nil {
+		skip("loading the rules", err)
+		return
+	}
+	rules := screeningRulesFromProto(resp.GetRules())
+	if len(rules) == 0 {
+		return
+	}
+	profile, err := profilecache.Get(ctx, candidateID)
+	if err != nil {
+		skip("loading the candidate profile", err)
+		return
+	}
+
+	i := screening.Evaluate(rules, screeningApplicant(profile, jobRequiredSkills(job)))
+	if i < 0 {
+		screeningMetrics.Add("unmatched", 1)
+		return
+	}
+	rule := rules[i]
+	status := screeningStatuses[rule.Action]
+	_, err = clients.JobServiceClient.UpdateApplicationStatus(employerCtx, &jobpb.UpdateApplicationStatusRequest{
+		ApplicationId: strconv.FormatUint(applicationID, 10),
+		Status:        status,
+		EmployerId:    employerID,
+		Reason:        "Automatic screening rule " + strconv.Itoa(i+1),
+	})
+	if err != nil {
+		skip("updating the status", err)
+		return
+	}
+	screeningMetrics.Add(rule.Action, 1)
+
+	message := rule.Message
+	if message == "" {
+		message = "Your application status changed to " + status
+	}
+	notifyUser(ctx, candidateID, "application_status", "Application update", message, strconv.FormatUint(applicationID, 10))
+	publishApplicationEvent("application.status_changed", employerID, jobID, gin.H{
+		"application_id": applicationID,
+		"job_id":         jobID,
+		"candidate_id":   candidateID,
+		"status":         status,
+		"reason":         "screening",
+	})
+}
@@ -0,0 +1,111 @@
+package routes
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	authpb "github.com/shahal0/skillsync-protos/gen/authpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"skillsync-api-gateway/clients"
+	"skillsync-api-gateway/middlewares"
+	"skillsync-api-gateway/utils"
+)
+
+type listSessionsRPC func(ctx context.Context, in *authpb.ListSessionsRequest, opts ...grpc.CallOption) (*authpb.ListSessionsResponse, error)
+
+type revokeSessionRPC func(ctx context.Context, in *authpb.RevokeSessionRequest, opts ...grpc.CallOption) (*authpb.RevokeSessionResponse, error)
+
+// loginContext passes the device details the auth service records on the new session
+func loginContext(c *gin.Context) context.Context {
+	return metadata.NewOutgoingContext(
+		c.Request.Context(),
+		metadata.New(map[string]string{
+			"client-user-agent": c.Request.UserAgent(),
+			"client-ip":         c.ClientIP(),
+		}),
+	)
+}
+
+func candidateListSessions(c *gin.Context) {
+	listSessions(c, candidateContext, clients.AuthServiceClient.CandidateListSessions)
+}
+
+func candidateRevokeSession(c *gin.Context) {
+	revokeSession(c, candidateContext, clients.AuthServiceClient.CandidateRevokeSession)
+}
+
+func employerListSessions(c *gin.Context) {
+	listSessions(c, employerContext, clients.AuthServiceClient.EmployerListSessions)
+}
+
+func employerRevokeSession(c *gin.Context) {
+	revokeSession(c, employerContext, clients.AuthServiceClient.EmployerRevokeSession)
+}
+
+// listSessions returns the caller's active sessions, flagging the one making the request
+func listSessions(c *gin.Context, userContext func(*gin.Context, string) context.Context, rpc listSessionsRPC) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	resp, err := rpc(userContext(c, userID.(string)), &authpb.ListSessionsRequest{})
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to list sessions: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+
+	currentID := c.GetString("session_id")
+	sessions := make([]gin.H, 0, len(resp.GetSessions()))
+	for _, session := range resp.GetSessions() {
+		sessions = append(sessions, gin.H{
+			"id":           session.GetId(),
+			"user_agent":   session.GetUserAgent(),
+			"ip":           session.GetIp(),
+			"created_at":   session.GetCreatedAt(),
+			"last_seen_at": session.GetLastSeenAt(),
+			"current":      currentID != "" && session.GetId() == currentID,
+		})
+	}
+	c.JSON(http.StatusOK, gin.H{"sessions": sessions})
+}
+
+// revokeSession ends one session: the auth service invalidates its refresh token and
+// the gateway rejects its outstanding access tokens. Revoking the current session
+// is a logout.
+func revokeSession(c *gin.Context, userContext func(*gin.Context, string) context.Context, rpc revokeSessionRPC) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in context"})
+		return
+	}
+	sessionID := c.Param("id")
+
+	resp, err := rpc(userContext(c, userID.(string)), &authpb.RevokeSessionRequest{SessionId: sessionID})
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to revoke session: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+
+	var expiresAt time.Time
+	if resp.GetExpiresAt() != "" {
+		expiresAt, _ = time.Parse(time.RFC3339, resp.GetExpiresAt())
+	}
+	middlewares.RevokeSession(sessionID, expiresAt)
+
+	current := sessionID == c.GetString("session_id")
+	if current {
+		middlewares.RevokeCurrentToken(c)
+		utils.ClearAuthCookie(c)
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"message":    resp.GetMessage(),
+		"revoked":    true,
+		"logged_out": current,
+	})
+}
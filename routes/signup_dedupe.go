@@ -0,0 +1,78 @@
+package routes
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"expvar"
+	"strings"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+
+	"skillsync-api-gateway/utils/cache"
+)
+
+// SignupReplayedHeader marks a signup response replayed from an identical earlier submission
+const SignupReplayedHeader = "Signup-Replayed"
+
+// signupCallTimeout bounds a signup call that no longer follows its request's context
+const signupCallTimeout = 10 * time.Second
+
+var (
+	// signupGroup shares an in-flight signup between identical submissions
+	signupGroup singleflight.Group
+	// recentSignups keeps successful signups for SIGNUP_DEDUPE_WINDOW
+	recentSignups = cache.NewTTLCache[interface{}](cfg.SignupDedupeWindow)
+	// dedupedSignups counts, per role, the signups answered without calling the auth service
+	dedupedSignups = expvar.NewMap("deduped_signups")
+)
+
+// dedupeSignup calls signup once for identical submissions of the same role and
+// email that arrive while one is in flight or within SIGNUP_DEDUPE_WINDOW after it
+// succeeded, and reports whether the response came from another submission. Only
+// successes are kept, so a corrected or retried submission after an error goes
+// through. Submissions that differ in any field, like different users, never share.
+func dedupeSignup[V any](ctx context.Context, role, email string, req interface{}, signup func(context.Context) (V, error)) (V, bool, error) {
+	key := signupKey(role, email, req)
+	if resp, ok := recentSignups.Get(key); ok {
+		dedupedSignups.Add(role, 1)
+		return resp.(V), true, nil
+	}
+	leader := false
+	resp, err, _ := signupGroup.Do(key, func() (interface{}, error) {
+		leader = true
+		// A double click often aborts the first request; the signup still completes
+		// so the second one can share it
+		callCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), signupCallTimeout)
+		defer cancel()
+		resp, err := signup(callCtx)
+		if err != nil {
+			return nil, err
+		}
+		recentSignups.Set(key, resp)
+		return resp, nil
+	})
+	if !leader {
+		dedupedSignups.Add(role, 1)
+	}
+	if err != nil {
+		var zero V
+		return zero, !leader, err
+	}
+	return resp.(V), !leader, nil
+}
+
+// signupKey identifies a submission by role, normalized email and a hash of the
+// rest of the request, so the password is never kept in the clear
+func signupKey(role, email string, req interface{}) string {
+	fields := map[string]interface{}{}
+	if body, err := json.Marshal(req); err == nil {
+		_ = json.Unmarshal(body, &fields)
+	}
+	delete(fields, "email")
+	body, _ := json.Marshal(fields)
+	sum := sha256.Sum256(body)
+	return role + "|" + strings.ToLower(strings.TrimSpace(email)) + "|" + hex.EncodeToString(sum[:])
+}
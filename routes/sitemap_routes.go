@@ -0,0 +1,163 @@
+package routes
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	jobpb "github.com/shahal0/skillsync-protos/gen/jobpb"
+
+	"skillsync-api-gateway/clients"
+	"skillsync-api-gateway/middlewares"
+	"skillsync-api-gateway/utils"
+	"skillsync-api-gateway/utils/sitemap"
+)
+
+const (
+	// sitemapPageSize is how many jobs are read from the job service at a time
+	sitemapPageSize = 500
+	// sitemapTimeout bounds generating the whole sitemap
+	sitemapTimeout = 2 * time.Minute
+)
+
+// jobSitemap is the generated sitemap of open jobs, one urlset per shard
+type jobSitemap struct {
+	shards    [][]byte
+	generated time.Time
+}
+
+var (
+	currentSitemap    atomic.Pointer[jobSitemap]
+	sitemapRefreshing atomic.Bool
+)
+
+func SetupSitemapRoutes(r *gin.Engine) {
+	r.GET("/robots.txt", GetRobots)
+	r.GET("/sitemap.xml", GetSitemap)
+	// Shards are named sitemap-jobs-<n>.xml; the parameter is "<n>.xml"
+	r.GET("/sitemap-jobs-:shard", GetSitemapShard)
+}
+
+// generateJobSitemap pages through the open jobs, adding each to the sitemap as
+// it is read, so only one page of jobs is held at a time. Jobs that are closed or
+// past their deadline are left out.
+func generateJobSitemap(ctx context.Context) (*jobSitemap, error) {
+	ctx, cancel := context.WithTimeout(ctx, sitemapTimeout)
+	defer cancel()
+
+	started := time.Now()
+	builder := sitemap.NewBuilder(sitemap.MaxURLs)
+	for page := int32(1); ; page++ {
+		resp, err := clients.JobServiceClient.GetJobs(ctx, &jobpb.GetJobsRequest{Status: "OPEN", Page: page, Limit: sitemapPageSize})
+		if err != nil {
+			return nil, err
+		}
+		for _, job := range resp.GetJobs() {
+			if !strings.EqualFold(job.GetStatus(), "OPEN") || utils.DeadlinePassed(job.GetDeadline(), started) {
+				continue
+			}
+			builder.Add(sitemap.URL{
+				Loc:     publicBaseURL() + "/jobs/" + strconv.FormatUint(job.GetId(), 10),
+				LastMod: parseFeedTime(job.GetUpdatedAt()),
+			})
+		}
+		if len(resp.GetJobs()) < sitemapPageSize || int64(page)*sitemapPageSize >= int64(resp.GetTotal()) {
+			break
+		}
+	}
+	return &jobSitemap{shards: builder.Finish(), generated: started}, nil
+}
+
+// refreshSitemap regenerates the sitemap in the background unless that is already
+// under way. The stale sitemap is served until the new one is ready, and kept if
+// generating fails.
+func refreshSitemap() {
+	if !sitemapRefreshing.CompareAndSwap(false, true) {
+		return
+	}
+	go func() {
+		defer sitemapRefreshing.Store(false)
+		generated, err := generateJobSitemap(context.Background())
+		if err != nil {
+			log.Printf("Sitemap refresh failed, serving the previous one: %v", err)
+			return
+		}
+		currentSitemap.Store(generated)
+	}()
+}
+
+// loadJobSitemap returns the current sitemap. Only the first request generates it
+// while clients wait; later ones get the cached sitemap and, once it is older than
+// SITEMAP_CACHE_TTL, start a background refresh.
+func loadJobSitemap(ctx context.Context) (*jobSitemap, error) {
+	current := currentSitemap.Load()
+	if current == nil {
+		return coalesce(ctx, "sitemap", "sitemap|jobs", func(ctx context.Context) (*jobSitemap, error) {
+			generated, err := generateJobSitemap(ctx)
+			if err != nil {
+				return nil, err
+			}
+			currentSitemap.Store(generated)
+			return generated, nil
+		})
+	}
+	if time.Since(current.generated) > cfg.SitemapTTL {
+		refreshSitemap()
+	}
+	return current, nil
+}
+
+// writeSitemap sends a sitemap document, cacheable until the next regeneration
+func writeSitemap(c *gin.Context, current *jobSitemap, body []byte) {
+	c.Header("Cache-Control", middlewares.PublicCacheControl(cfg.SitemapTTL))
+	c.Header("Last-Modified", current.generated.UTC().Format(http.TimeFormat))
+	c.Data(http.StatusOK, "application/xml; charset=utf-8", body)
+}
+
+// GetSitemap serves the job sitemap, or an index of its shards when the open jobs
+// don't fit in one
+func GetSitemap(c *gin.Context) {
+	current, err := loadJobSitemap(c.Request.Context())
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to generate sitemap: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+	if len(current.shards) == 1 {
+		writeSitemap(c, current, current.shards[0])
+		return
+	}
+	index := sitemap.Index(len(current.shards), func(n int) string {
+		return publicBaseURL() + "/sitemap-jobs-" + strconv.Itoa(n) + ".xml"
+	}, current.generated)
+	writeSitemap(c, current, index)
+}
+
+// GetSitemapShard serves one shard of a sitemap too large for a single file
+func GetSitemapShard(c *gin.Context) {
+	n, err := strconv.Atoi(strings.TrimSuffix(c.Param("shard"), ".xml"))
+	if err != nil || !strings.HasSuffix(c.Param("shard"), ".xml") {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Sitemap not found"})
+		return
+	}
+	current, err := loadJobSitemap(c.Request.Context())
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to generate sitemap: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+	if n < 1 || n > len(current.shards) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Sitemap not found"})
+		return
+	}
+	writeSitemap(c, current, current.shards[n-1])
+}
+
+// GetRobots lets crawlers index the site and points them at the sitemap
+func GetRobots(c *gin.Context) {
+	c.Header("Cache-Control", middlewares.PublicCacheControl(cfg.SitemapTTL))
+	c.String(http.StatusOK, "User-agent: *\nAllow: /\nSitemap: %s/sitemap.xml\n", publicBaseURL())
+}
@@ -0,0 +1,225 @@
+package routes
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	authpb "github.com/shahal0/skillsync-protos/gen/authpb"
+	jobpb "github.com/shahal0/skillsync-protos/gen/jobpb"
+
+	"skillsync-api-gateway/clients"
+	"skillsync-api-gateway/utils"
+	"skillsync-api-gateway/utils/skills"
+)
+
+const (
+	defaultSkillSuggestions = 10
+	maxSkillSuggestions     = 25
+	skillTaxonomyTimeout    = 10 * time.Second
+	// Until the first load succeeds it is retried sooner than the regular refresh
+	skillTaxonomyRetry = 30 * time.Second
+)
+
+var (
+	// skillTaxonomy is nil until the first load from the job service succeeds
+	skillTaxonomy atomic.Pointer[skills.Taxonomy]
+
+	skillTaxonomyStart sync.Once
+	skillTaxonomyStop  = make(chan struct{})
+	skillTaxonomyEnd   sync.Once
+)
+
+func SetupSkillRoutes(r *gin.Engine) {
+	skillTaxonomyStart.Do(func() { go refreshSkillTaxonomyEvery(cfg.SkillTaxonomyRefresh) })
+
+	skillRoutes := r.Group("/skills")
+	{
+		skillRoutes.GET("/suggest", SuggestSkills)
+		skillRoutes.GET("/categories", GetSkillCategories)
+	}
+}
+
+// refreshSkillTaxonomyEvery loads the taxonomy now and then every interval until
+// stopSkillTaxonomyRefresh. A failed refresh keeps serving the previous taxonomy.
+func refreshSkillTaxonomyEvery(interval time.Duration) {
+	for {
+		wait := interval
+		ctx, cancel := context.WithTimeout(context.Background(), skillTaxonomyTimeout)
+		if err := refreshSkillTaxonomy(ctx); err != nil {
+			log.Printf("Skill taxonomy refresh failed: %v", err)
+			if skillTaxonomy.Load() == nil {
+				wait = skillTaxonomyRetry
+			}
+		}
+		cancel()
+		select {
+		case <-time.After(wait):
+		case <-skillTaxonomyStop:
+			return
+		}
+	}
+}
+
+func stopSkillTaxonomyRefresh() {
+	skillTaxonomyEnd.Do(func() { close(skillTaxonomyStop) })
+}
+
+// refreshSkillTaxonomy replaces the taxonomy with the job service's current one
+func refreshSkillTaxonomy(ctx context.Context) error {
+	resp, err := clients.JobServiceClient.ListSkillTaxonomy(ctx, &jobpb.ListSkillTaxonomyRequest{})
+	if err != nil {
+		return err
+	}
+	list := make([]skills.Skill, 0, len(resp.GetSkills()))
+	for _, skill := range resp.GetSkills() {
+		list = append(list, skills.Skill{
+			Name:     skill.GetName(),
+			Category: skill.GetCategory(),
+			Aliases:  skill.GetAliases(),
+		})
+	}
+	taxonomy := skills.New(list)
+	skillTaxonomy.Store(taxonomy)
+	log.Printf("Skill taxonomy loaded: %d skills", taxonomy.Len())
+	return nil
+}
+
+// SuggestSkills offers canonical skill names for a typeahead, matching names and
+// aliases by prefix and tolerating one typo
+func SuggestSkills(c *gin.Context) {
+	taxonomy := skillTaxonomy.Load()
+	if taxonomy == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Skill taxonomy not loaded yet"})
+		return
+	}
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", strconv.Itoa(defaultSkillSuggestions)))
+	if err != nil || limit < 1 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "limit must be a positive integer"})
+		return
+	}
+	if limit > maxSkillSuggestions {
+		limit = maxSkillSuggestions
+	}
+	c.Header("Cache-Control", "public, max-age=300")
+	c.JSON(http.StatusOK, gin.H{"suggestions": taxonomy.Suggest(c.Query("q"), limit)})
+}
+
+// GetSkillCategories lists the taxonomy's categories with their skills
+func GetSkillCategories(c *gin.Context) {
+	taxonomy := skillTaxonomy.Load()
+	if taxonomy == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Skill taxonomy not loaded yet"})
+		return
+	}
+	c.Header("Cache-Control", "public, max-age=300")
+	c.JSON(http.StatusOK, gin.H{"categories": taxonomy.Categories()})
+}
+
+// AddSkillAlias teaches the taxonomy another spelling of a skill. The job service
+// stores it and the gateway reloads the taxonomy at once.
+func AddSkillAlias(c *gin.Context) {
+	var body struct {
+		Skill string `json:"skill" binding:"required,max=100"`
+		Alias string `json:"alias" binding:"required,max=100"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		utils.RespondWithValidationError(c, err)
+		return
+	}
+	if skills.Normalize(body.Alias) == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "alias must contain letters or digits"})
+		return
+	}
+	_, err := clients.JobServiceClient.AddSkillAlias(adminContext(c), &jobpb.AddSkillAliasRequest{
+		Skill: body.Skill,
+		Alias: body.Alias,
+	})
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to add skill alias: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+	// The alias is stored either way; a failed reload is picked up by the next refresh
+	ctx, cancel := context.WithTimeout(c.Request.Context(), skillTaxonomyTimeout)
+	defer cancel()
+	if err := refreshSkillTaxonomy(ctx); err != nil {
+		log.Printf("Skill taxonomy reload after adding alias %q failed: %v", body.Alias, err)
+	}
+	recordAudit(c, "admin.skill_alias", "skill:"+body.Skill, map[string]string{"alias": body.Alias})
+	c.JSON(http.StatusCreated, gin.H{"skill": body.Skill, "alias": body.Alias})
+}
+
+// canonicalSkill maps a submitted skill to its taxonomy name. Before the taxonomy
+// has loaded every name is accepted as given.
+func canonicalSkill(name string) (string, bool) {
+	taxonomy := skillTaxonomy.Load()
+	if taxonomy == nil {
+		return name, true
+	}
+	skill, ok := taxonomy.Lookup(name)
+	return skill.Name, ok
+}
+
+// normalizeCandidateSkills renames skills to their canonical names, dropping
+// repeats and the ones the taxonomy doesn't know, which are returned instead
+func normalizeCandidateSkills(submitted []*authpb.Skill) ([]*authpb.Skill, []string) {
+	kept := make([]*authpb.Skill, 0, len(submitted))
+	unrecognized := []string{}
+	seen := map[string]bool{}
+	for _, skill := range submitted {
+		name, ok := canonicalSkill(skill.GetSkill())
+		if !ok {
+			unrecognized = append(unrecognized, skill.GetSkill())
+			continue
+		}
+		if seen[skills.Normalize(name)] {
+			continue
+		}
+		seen[skills.Normalize(name)] = true
+		skill.Skill = name
+		kept = append(kept, skill)
+	}
+	return kept, unrecognized
+}
+
+// normalizeJobSkills is normalizeCandidateSkills for job skills
+func normalizeJobSkills(submitted []*jobpb.JobSkill) ([]*jobpb.JobSkill, []string) {
+	kept := make([]*jobpb.JobSkill, 0, len(submitted))
+	unrecognized := []string{}
+	for _, skill := range dedupeJobSkills(submitted) {
+		name, ok := canonicalSkill(skill.GetSkill())
+		if !ok {
+			unrecognized = append(unrecognized, skill.GetSkill())
+			continue
+		}
+		skill.Skill = name
+		kept = append(kept, skill)
+	}
+	// Two spellings of one skill are the same skill once renamed
+	return dedupeJobSkills(kept), unrecognized
+}
+
+// respondUnrecognizedSkills rejects a submission in which no skill was recognized,
+// rather than storing an empty list in its place
+func respondUnrecognizedSkills(c *gin.Context, unrecognized []string) {
+	c.JSON(http.StatusUnprocessableEntity, gin.H{
+		"error":               "None of the skills are recognized; pick them from GET /skills/suggest",
+		"unrecognized_skills": unrecognized,
+	})
+}
+
+// withUnrecognizedSkills adds unrecognized_skills to a backend response
+func withUnrecognizedSkills(resp interface{}, unrecognized []string) gin.H {
+	body := gin.H{}
+	if encoded, err := json.Marshal(resp); err == nil {
+		_ = json.Unmarshal(encoded, &body)
+	}
+	body["unrecognized_skills"] = unrecognized
+	return body
+}
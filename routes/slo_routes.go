@@ -0,0 +1,82 @@
+package routes
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"skillsync-api-gateway/config"
+	"skillsync-api-gateway/middlewares"
+)
+
+// latencyAllowance is the fraction of requests a p99 latency target lets be slower
+const latencyAllowance = 0.01
+
+// milliseconds is d for JSON, in fractional milliseconds
+func milliseconds(d time.Duration) float64 {
+	return float64(d.Microseconds()) / 1000
+}
+
+// GetSLOSummary shows on-call which routes are burning their budget: each route's
+// requests, errors and latency over the last minutes (all of SLO_WINDOW unless
+// minutes is given) against its objective. A burn rate above 1 means the route is
+// failing or slow more often than its objective allows. Routes burning fastest
+// come first.
+func GetSLOSummary(c *gin.Context) {
+	tracker := middlewares.SLOTracker()
+	window := tracker.Window()
+	if value := c.Query("minutes"); value != "" {
+		minutes, err := strconv.Atoi(value)
+		if err != nil || minutes < 1 || time.Duration(minutes)*time.Minute > window {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "minutes must be a number from 1 to " + strconv.Itoa(int(window/time.Minute))})
+			return
+		}
+		window = time.Duration(minutes) * time.Minute
+	}
+
+	type routeSummary struct {
+		view gin.H
+		burn float64
+	}
+	var summaries []routeSummary
+	for _, stats := range tracker.Summary(window) {
+		_, path, _ := strings.Cut(stats.Route, " ")
+		objective, source := cfg.SLOs.For(config.PolicyName(path))
+		errorRate := float64(stats.Errors) / float64(stats.Requests)
+		slowRate := float64(stats.Slow) / float64(stats.Requests)
+		errorBurn := errorRate / objective.ErrorBudget
+		var latencyBurn float64
+		if objective.Latency > 0 {
+			latencyBurn = slowRate / latencyAllowance
+		}
+		summaries = append(summaries, routeSummary{
+			burn: max(errorBurn, latencyBurn),
+			view: gin.H{
+				"route":             stats.Route,
+				"objective":         source,
+				"requests":          stats.Requests,
+				"errors":            stats.Errors,
+				"error_rate":        errorRate,
+				"error_budget":      objective.ErrorBudget,
+				"error_burn_rate":   errorBurn,
+				"slow_requests":     stats.Slow,
+				"p50_ms":            milliseconds(stats.P50),
+				"p99_ms":            milliseconds(stats.P99),
+				"latency_target_ms": milliseconds(objective.Latency),
+				"latency_burn_rate": latencyBurn,
+				"violating":         errorBurn > 1 || latencyBurn > 1,
+			},
+		})
+	}
+	sort.SliceStable(summaries, func(i, j int) bool { return summaries[i].burn > summaries[j].burn })
+
+	routes := make([]gin.H, 0, len(summaries))
+	for _, summary := range summaries {
+		routes = append(routes, summary.view)
+	}
+	c.JSON(http.StatusOK, gin.H{"window_minutes": int(window / time.Minute), "routes": routes})
+}
@@ -0,0 +1,119 @@
+package routes
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	authpb "github.com/shahal0/skillsync-protos/gen/authpb"
+
+	"skillsync-api-gateway/clients"
+	"skillsync-api-gateway/middlewares"
+	"skillsync-api-gateway/utils"
+)
+
+// ownMembership reports whether memberID is the caller, who can't change or
+// remove themselves and so can't leave an account without an owner
+func ownMembership(c *gin.Context, memberID string) bool {
+	return memberID == c.GetString("member_id") || memberID == c.GetString("user_id")
+}
+
+// inviteTeamMember invites someone by email to the caller's employer account. The
+// auth service sends the invitation; it becomes a membership once accepted.
+func inviteTeamMember(c *gin.Context) {
+	var body struct {
+		Email string `json:"email" binding:"required,email,max=254"`
+		Role  string `json:"role" binding:"required,oneof=owner recruiter viewer"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		utils.RespondWithValidationError(c, err)
+		return
+	}
+	employerID := c.GetString("user_id")
+	email := strings.ToLower(strings.TrimSpace(body.Email))
+	resp, err := clients.AuthServiceClient.InviteTeamMember(employerContext(c, employerID), &authpb.InviteTeamMemberRequest{
+		EmployerId: employerID,
+		Email:      email,
+		Role:       body.Role,
+		InvitedBy:  c.GetString("member_id"),
+	})
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to invite team member: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+	recordAudit(c, "employer.team_invite", "employer:"+employerID, map[string]string{
+		"email": email,
+		"role":  body.Role,
+	})
+	c.JSON(http.StatusCreated, resp)
+}
+
+// listTeamMembers lists the members of the caller's employer account and the
+// invitations still pending
+func listTeamMembers(c *gin.Context) {
+	employerID := c.GetString("user_id")
+	resp, err := clients.AuthServiceClient.ListTeamMembers(employerContext(c, employerID), &authpb.ListTeamMembersRequest{
+		EmployerId: employerID,
+	})
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to list team members: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+	members := resp.GetMembers()
+	if members == nil {
+		members = []*authpb.TeamMember{}
+	}
+	c.JSON(http.StatusOK, gin.H{"members": members})
+}
+
+// updateTeamMemberRole changes a member's role. Their tokens carry the old role,
+// so they are refused until the member signs in again.
+func updateTeamMemberRole(c *gin.Context) {
+	memberID := c.Param("member_id")
+	if ownMembership(c, memberID) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "You can't change your own role"})
+		return
+	}
+	var body struct {
+		Role string `json:"role" binding:"required,oneof=owner recruiter viewer"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		utils.RespondWithValidationError(c, err)
+		return
+	}
+	employerID := c.GetString("user_id")
+	resp, err := clients.AuthServiceClient.UpdateTeamMemberRole(employerContext(c, employerID), &authpb.UpdateTeamMemberRoleRequest{
+		EmployerId: employerID,
+		MemberId:   memberID,
+		Role:       body.Role,
+	})
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to change team member role: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+	middlewares.RevokeTeamMember(memberID)
+	recordAudit(c, "employer.team_role_change", "member:"+memberID, map[string]string{"role": body.Role})
+	c.JSON(http.StatusOK, resp)
+}
+
+// removeTeamMember takes a member off the caller's employer account and refuses
+// their tokens from then on
+func removeTeamMember(c *gin.Context) {
+	memberID := c.Param("member_id")
+	if ownMembership(c, memberID) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "You can't remove yourself from the team"})
+		return
+	}
+	employerID := c.GetString("user_id")
+	_, err := clients.AuthServiceClient.RemoveTeamMember(employerContext(c, employerID), &authpb.RemoveTeamMemberRequest{
+		EmployerId: employerID,
+		MemberId:   memberID,
+	})
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to remove team member: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+	middlewares.RevokeTeamMember(memberID)
+	recordAudit(c, "employer.team_remove", "member:"+memberID, nil)
+	c.Status(http.StatusNoContent)
+}
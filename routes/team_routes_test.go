@@ -0,0 +1,110 @@
+package routes
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	authpb "github.com/shahal0/skillsync-protos/gen/authpb"
+	jobpb "github.com/shahal0/skillsync-protos/gen/jobpb"
+	"google.golang.org/grpc"
+
+	"skillsync-api-gateway/clients"
+	"skillsync-api-gateway/middlewares"
+	"skillsync-api-gateway/utils"
+)
+
+// fakeTeamAuth gives every employer a plan without an active job limit
+type fakeTeamAuth struct {
+	authpb.AuthServiceClient
+}
+
+func (fakeTeamAuth) GetEmployerPlan(context.Context, *authpb.GetEmployerPlanRequest, ...grpc.CallOption) (*authpb.EmployerPlanResponse, error) {
+	return &authpb.EmployerPlanResponse{Plan: "pro"}, nil
+}
+
+type fakeTeamJobs struct {
+	jobpb.JobServiceClient
+	posted []string
+}
+
+func (f *fakeTeamJobs) PostJob(_ context.Context, req *jobpb.PostJobRequest, _ ...grpc.CallOption) (*jobpb.PostJobResponse, error) {
+	f.posted = append(f.posted, req.EmployerId)
+	return &jobpb.PostJobResponse{JobId: 1, Message: "posted"}, nil
+}
+
+// memberToken signs a token for member m1 of employer e1's team with teamRole
+func memberToken(t *testing.T, teamRole string) string {
+	t.Helper()
+	key := middlewares.SigningKey()
+	unsigned := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"user_id":     "m1",
+		"role":        "employer",
+		"employer_id": "e1",
+		"team_role":   teamRole,
+		"iat":         float64(time.Now().Unix()),
+		"exp":         float64(time.Now().Add(time.Hour).Unix()),
+	})
+	if key.ID != "" {
+		unsigned.Header["kid"] = key.ID
+	}
+	token, err := unsigned.SignedString([]byte(key.Secret))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+// TestPostJobTeamRoles checks through the router that a viewer can't post a job
+// for their employer and a recruiter can
+func TestPostJobTeamRoles(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	utils.RegisterValidators()
+	jobs := &fakeTeamJobs{}
+	previousAuth, previousJobs := clients.AuthServiceClient, clients.JobServiceClient
+	clients.AuthServiceClient, clients.JobServiceClient = fakeTeamAuth{}, jobs
+	t.Cleanup(func() { clients.AuthServiceClient, clients.JobServiceClient = previousAuth, previousJobs })
+
+	r := gin.New()
+	SetupJobRoutes(r)
+	body := `{"title":"Backend engineer","description":"Builds the gateway","category":"` + utils.JobCategories()[0] + `"}`
+
+	tests := []struct {
+		teamRole   string
+		wantStatus int
+		wantBody   string
+	}{
+		{middlewares.TeamRoleViewer, http.StatusForbidden, `"error_code":"team_role_forbidden"`},
+		{middlewares.TeamRoleRecruiter, http.StatusCreated, `"job_id":1`},
+		{middlewares.TeamRoleOwner, http.StatusCreated, `"job_id":1`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.teamRole, func(t *testing.T) {
+			jobs.posted = nil
+			req := httptest.NewRequest(http.MethodPost, "/jobs/post", strings.NewReader(body))
+			req.Header.Set("Authorization", "Bearer "+memberToken(t, tt.teamRole))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus || !strings.Contains(w.Body.String(), tt.wantBody) {
+				t.Fatalf("status = %d (%s), want %d with %s", w.Code, w.Body, tt.wantStatus, tt.wantBody)
+			}
+			wantPosted := 0
+			if tt.wantStatus == http.StatusCreated {
+				wantPosted = 1
+			}
+			if len(jobs.posted) != wantPosted {
+				t.Fatalf("job service called %d times, want %d", len(jobs.posted), wantPosted)
+			}
+			if wantPosted == 1 && jobs.posted[0] != "e1" {
+				t.Errorf("job posted for %q, want the team's employer e1", jobs.posted[0])
+			}
+		})
+	}
+}
@@ -0,0 +1,240 @@
+package routes
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	authpb "github.com/shahal0/skillsync-protos/gen/authpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"skillsync-api-gateway/clients"
+	"skillsync-api-gateway/middlewares"
+	"skillsync-api-gateway/utils"
+	"skillsync-api-gateway/utils/cache"
+)
+
+const (
+	twoFactorChallengeTTL   = 5 * time.Minute
+	twoFactorLoginRateLimit = 10
+)
+
+// loginChallenge is a pending second login step. The client only ever sees the
+// gateway's own challenge token; the auth service's token stays here.
+type loginChallenge struct {
+	role      string
+	upstream  string
+	setCookie bool
+}
+
+// loginChallenges are single-use: they are taken out of the cache on the first attempt
+var loginChallenges = cache.NewTTLCache[*loginChallenge](twoFactorChallengeTTL)
+
+type setupTwoFactorRPC func(ctx context.Context, in *authpb.SetupTwoFactorRequest, opts ...grpc.CallOption) (*authpb.SetupTwoFactorResponse, error)
+
+type enableTwoFactorRPC func(ctx context.Context, in *authpb.EnableTwoFactorRequest, opts ...grpc.CallOption) (*authpb.EnableTwoFactorResponse, error)
+
+type disableTwoFactorRPC func(ctx context.Context, in *authpb.DisableTwoFactorRequest, opts ...grpc.CallOption) (*authpb.DisableTwoFactorResponse, error)
+
+type verifyTwoFactorLoginRPC func(ctx context.Context, in *authpb.VerifyTwoFactorLoginRequest, opts ...grpc.CallOption) (*authpb.VerifyTwoFactorLoginResponse, error)
+
+func candidateSetupTwoFactor(c *gin.Context) {
+	setupTwoFactor(c, candidateContext, clients.AuthServiceClient.CandidateSetupTwoFactor)
+}
+
+func candidateEnableTwoFactor(c *gin.Context) {
+	enableTwoFactor(c, candidateContext, clients.AuthServiceClient.CandidateEnableTwoFactor)
+}
+
+func candidateDisableTwoFactor(c *gin.Context) {
+	disableTwoFactor(c, candidateContext, clients.AuthServiceClient.CandidateDisableTwoFactor)
+}
+
+func candidateLoginTwoFactor(c *gin.Context) {
+	loginTwoFactor(c, "candidate", clients.AuthServiceClient.CandidateVerifyTwoFactorLogin)
+}
+
+func employerSetupTwoFactor(c *gin.Context) {
+	setupTwoFactor(c, employerContext, clients.AuthServiceClient.EmployerSetupTwoFactor)
+}
+
+func employerEnableTwoFactor(c *gin.Context) {
+	enableTwoFactor(c, employerContext, clients.AuthServiceClient.EmployerEnableTwoFactor)
+}
+
+func employerDisableTwoFactor(c *gin.Context) {
+	disableTwoFactor(c, employerContext, clients.AuthServiceClient.EmployerDisableTwoFactor)
+}
+
+func employerLoginTwoFactor(c *gin.Context) {
+	loginTwoFactor(c, "employer", clients.AuthServiceClient.EmployerVerifyTwoFactorLogin)
+}
+
+// respondTwoFactorChallenge answers a login that needs a TOTP code with a short-lived
+// gateway challenge token instead of a JWT
+func respondTwoFactorChallenge(c *gin.Context, role, upstream string, setCookie bool) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to start two-factor login"})
+		return
+	}
+	token := hex.EncodeToString(b)
+	loginChallenges.Set(token, &loginChallenge{role: role, upstream: upstream, setCookie: setCookie})
+
+	c.JSON(http.StatusOK, gin.H{
+		"2fa_required":    true,
+		"challenge_token": token,
+		"expires_in":      int(twoFactorChallengeTTL.Seconds()),
+	})
+}
+
+// setupTwoFactor returns a new TOTP secret and its otpauth:// provisioning URI for the
+// authenticator app. 2FA stays off until the first code is confirmed with enable.
+func setupTwoFactor(c *gin.Context, userContext func(*gin.Context, string) context.Context, rpc setupTwoFactorRPC) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	resp, err := rpc(userContext(c, userID.(string)), &authpb.SetupTwoFactorRequest{})
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to set up two-factor authentication: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"provisioning_uri": resp.GetProvisioningUri(),
+		"secret":           resp.GetSecret(),
+	})
+}
+
+func enableTwoFactor(c *gin.Context, userContext func(*gin.Context, string) context.Context, rpc enableTwoFactorRPC) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	var body struct {
+		Code string `json:"code" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		utils.RespondWithValidationError(c, err)
+		return
+	}
+
+	resp, err := rpc(userContext(c, userID.(string)), &authpb.EnableTwoFactorRequest{Code: strings.TrimSpace(body.Code)})
+	if err != nil {
+		respondTwoFactorError(c, "Failed to enable two-factor authentication", err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"message":        resp.GetMessage(),
+		"enabled":        true,
+		"recovery_codes": resp.GetRecoveryCodes(),
+	})
+}
+
+// disableTwoFactor needs a current code, plus the password for accounts that have one
+// (Google-login accounts don't)
+func disableTwoFactor(c *gin.Context, userContext func(*gin.Context, string) context.Context, rpc disableTwoFactorRPC) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	var body struct {
+		Password string `json:"password"`
+		Code     string `json:"code" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		utils.RespondWithValidationError(c, err)
+		return
+	}
+
+	resp, err := rpc(userContext(c, userID.(string)), &authpb.DisableTwoFactorRequest{
+		Password: body.Password,
+		Code:     strings.TrimSpace(body.Code),
+	})
+	if err != nil {
+		respondTwoFactorError(c, "Failed to disable two-factor authentication", err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"message": resp.GetMessage(),
+		"enabled": false,
+	})
+}
+
+// loginTwoFactor completes a login with the challenge token and a TOTP (or recovery) code
+func loginTwoFactor(c *gin.Context, role string, rpc verifyTwoFactorLoginRPC) {
+	var body struct {
+		ChallengeToken string `json:"challenge_token" binding:"required"`
+		Code           string `json:"code" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		utils.RespondWithValidationError(c, err)
+		return
+	}
+
+	challenge, ok := loginChallenges.Take(body.ChallengeToken)
+	if !ok || challenge.role != role {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error":      "The login challenge is invalid or has expired, please log in again",
+			"error_code": "invalid_challenge",
+		})
+		return
+	}
+
+	resp, err := rpc(loginContext(c), &authpb.VerifyTwoFactorLoginRequest{
+		ChallengeToken: challenge.upstream,
+		Code:           strings.TrimSpace(body.Code),
+	})
+	if err != nil {
+		// The challenge is spent either way, so a wrong code means starting over
+		if code := status.Code(err); code == codes.InvalidArgument || code == codes.Unauthenticated || code == codes.PermissionDenied {
+			c.JSON(http.StatusUnauthorized, gin.H{
+				"error":      "The code is incorrect, please log in again",
+				"error_code": "invalid_code",
+			})
+			return
+		}
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Two-factor login failed: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+	if challenge.setCookie {
+		utils.SetAuthCookie(c, resp.GetToken())
+	}
+	respondLogin(c, loginResult{
+		ID:            resp.GetId(),
+		Role:          challenge.role,
+		Token:         resp.GetToken(),
+		RefreshToken:  resp.GetRefreshToken(),
+		Message:       resp.GetMessage(),
+		EmailVerified: resp.GetEmailVerified(),
+	})
+}
+
+func respondTwoFactorError(c *gin.Context, prefix string, err error) {
+	switch status.Code(err) {
+	case codes.InvalidArgument, codes.Unauthenticated, codes.PermissionDenied:
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error":      "The code or password is incorrect",
+			"error_code": "invalid_code",
+		})
+	default:
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": prefix + ": " + utils.GRPCErrorMessage(err)})
+	}
+}
+
+// twoFactorLoginLimit caps code guesses per client IP on the public /login/2fa routes
+func twoFactorLoginLimit() gin.HandlerFunc {
+	return middlewares.RateLimitPerUser(twoFactorLoginRateLimit, time.Minute)
+}
@@ -0,0 +1,80 @@
+package routes
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	authpb "github.com/shahal0/skillsync-protos/gen/authpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"skillsync-api-gateway/clients"
+	"skillsync-api-gateway/middlewares"
+)
+
+// fakeLoginAuth answers logins by password: "wrong" fails, "2fa" asks for a
+// second factor and anything else issues a token
+type fakeLoginAuth struct {
+	authpb.AuthServiceClient
+}
+
+func (fakeLoginAuth) CandidateLogin(_ context.Context, req *authpb.CandidateLoginRequest, _ ...grpc.CallOption) (*authpb.CandidateLoginResponse, error) {
+	switch req.Password {
+	case "wrong":
+		return nil, status.Error(codes.Unauthenticated, "invalid credentials")
+	case "2fa":
+		return &authpb.CandidateLoginResponse{TwoFactorRequired: true, ChallengeToken: "upstream"}, nil
+	}
+	return &authpb.CandidateLoginResponse{Id: "c1", Token: "token"}, nil
+}
+
+func (fakeLoginAuth) CandidateListSessions(context.Context, *authpb.ListSessionsRequest, ...grpc.CallOption) (*authpb.ListSessionsResponse, error) {
+	return nil, status.Error(codes.Unavailable, "down")
+}
+
+// TestLoginThrottleTwoFactorChallenge checks a correct password answered with a 2FA
+// challenge doesn't forgive the email's earlier failures, and a login that issues
+// tokens does
+func TestLoginThrottleTwoFactorChallenge(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	previous := clients.AuthServiceClient
+	clients.AuthServiceClient = fakeLoginAuth{}
+	t.Cleanup(func() {
+		clients.AuthServiceClient = previous
+		middlewares.DefaultLoginAttemptStore.Reset(context.Background(), middlewares.LoginEmailKey("ada@example.com"))
+		middlewares.DefaultLoginAttemptStore.Reset(context.Background(), middlewares.LoginIPKey("192.0.2.1"))
+	})
+
+	tests := []struct {
+		name      string
+		passwords []string
+		wantLast  int
+	}{
+		{"challenge keeps the failures", []string{"wrong", "wrong", "wrong", "wrong", "2fa", "wrong", "2fa"}, http.StatusTooManyRequests},
+		{"tokens clear the failures", []string{"wrong", "wrong", "wrong", "wrong", "right", "wrong", "right"}, http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			middlewares.DefaultLoginAttemptStore.Reset(context.Background(), middlewares.LoginEmailKey("ada@example.com"))
+			middlewares.DefaultLoginAttemptStore.Reset(context.Background(), middlewares.LoginIPKey("192.0.2.1"))
+			r := gin.New()
+			SetupRoutes(r)
+
+			var w *httptest.ResponseRecorder
+			for _, password := range tt.passwords {
+				w = httptest.NewRecorder()
+				req := httptest.NewRequest(http.MethodPost, "/auth/candidate/login", strings.NewReader(`{"email":"ada@example.com","password":"`+password+`"}`))
+				req.Header.Set("Content-Type", "application/json")
+				r.ServeHTTP(w, req)
+			}
+			if w.Code != tt.wantLast {
+				t.Errorf("last login status = %d (%s), want %d", w.Code, w.Body, tt.wantLast)
+			}
+		})
+	}
+}
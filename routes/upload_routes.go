@@ -0,0 +1,186 @@
+package routes
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"skillsync-api-gateway/config"
+	"skillsync-api-gateway/middlewares"
+	"skillsync-api-gateway/utils"
+	"skillsync-api-gateway/utils/storage"
+)
+
+const (
+	presignRequestLimit  = 60
+	presignLimitWindow   = time.Hour
+	uploadPurposeResume  = "resume"
+	uploadPurposeChat    = "chat_attachment"
+	uploadKeyRandomBytes = 16
+)
+
+// uploadPolicy is what may be uploaded for one purpose
+type uploadPolicy struct {
+	maxSize      int64
+	contentTypes []string
+}
+
+// uploadPolicies are the accepted presign purposes
+var uploadPolicies = map[string]uploadPolicy{
+	uploadPurposeResume: {
+		maxSize: 10 << 20,
+		contentTypes: []string{
+			"application/pdf",
+			"application/msword",
+			"application/vnd.openxmlformats-officedocument.wordprocessingml.document",
+		},
+	},
+	uploadPurposeChat: {
+		maxSize: 25 << 20,
+		contentTypes: []string{
+			"application/pdf",
+			"application/msword",
+			"application/vnd.openxmlformats-officedocument.wordprocessingml.document",
+			"image/png",
+			"image/jpeg",
+			"image/webp",
+			"image/gif",
+			"text/plain",
+		},
+	},
+}
+
+// uploadStorage is nil unless STORAGE_ENDPOINT is set; Configure rebuilds it
+var uploadStorage = newUploadStorage(cfg.Storage)
+
+func newUploadStorage(c config.StorageConfig) *storage.Client {
+	if c.Endpoint == "" {
+		return nil
+	}
+	client, err := storage.New(c.Endpoint, c.Bucket, c.Region, c.AccessKey, c.SecretKey)
+	if err != nil {
+		log.Printf("Warning: presigned uploads disabled: %v", err)
+		return nil
+	}
+	return client
+}
+
+func SetupUploadRoutes(r *gin.Engine) {
+	uploads := r.Group("/uploads")
+	uploads.Use(middlewares.JWTMiddleware())
+	{
+		uploads.POST("/presign", middlewares.RateLimitPerUser(presignRequestLimit, presignLimitWindow), PresignUpload)
+	}
+}
+
+// PresignUpload returns a URL the client PUTs the file to directly, and the
+// object_key to hand to the resume or chat endpoint afterwards. Keys are
+// namespaced by user, so nobody can attach someone else's upload.
+func PresignUpload(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in context"})
+		return
+	}
+	if uploadStorage == nil {
+		respondUploadsDisabled(c)
+		return
+	}
+	var body struct {
+		Purpose     string `json:"purpose" binding:"required"`
+		ContentType string `json:"content_type" binding:"required"`
+		Size        int64  `json:"size" binding:"required,min=1"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		utils.RespondWithValidationError(c, err)
+		return
+	}
+	policy, ok := uploadPolicies[body.Purpose]
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "purpose must be resume or chat_attachment"})
+		return
+	}
+	contentType := strings.ToLower(strings.TrimSpace(body.ContentType))
+	if !policy.allows(contentType) {
+		c.JSON(http.StatusUnsupportedMediaType, gin.H{"error": "Content type not allowed", "allowed": policy.contentTypes})
+		return
+	}
+	if body.Size > policy.maxSize {
+		c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": "File too large", "max_size": policy.maxSize})
+		return
+	}
+
+	random := make([]byte, uploadKeyRandomBytes)
+	if _, err := rand.Read(random); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create upload key"})
+		return
+	}
+	key := uploadKeyPrefix(userID.(string), body.Purpose) + hex.EncodeToString(random)
+	ttl := cfg.Storage.URLTTL
+	c.JSON(http.StatusOK, gin.H{
+		"url":    uploadStorage.PresignPut(key, contentType, body.Size, ttl),
+		"method": http.MethodPut,
+		// The signature covers these, so the PUT must send them unchanged
+		"headers": gin.H{
+			"Content-Type":   contentType,
+			"Content-Length": body.Size,
+		},
+		"object_key": key,
+		"expires_at": time.Now().Add(ttl).UTC(),
+	})
+}
+
+func (p uploadPolicy) allows(contentType string) bool {
+	for _, allowed := range p.contentTypes {
+		if allowed == contentType {
+			return true
+		}
+	}
+	return false
+}
+
+// uploadKeyPrefix namespaces a user's uploads for one purpose
+func uploadKeyPrefix(userID, purpose string) string {
+	return "uploads/" + unsafeFilename.ReplaceAllString(userID, "_") + "/" + purpose + "/"
+}
+
+// verifyUpload checks that key is the user's upload for purpose and that it
+// landed in the bucket within the purpose's limits. On failure it writes the
+// response and returns false.
+func verifyUpload(c *gin.Context, userID, purpose, key string) (*storage.Object, bool) {
+	if uploadStorage == nil {
+		respondUploadsDisabled(c)
+		return nil, false
+	}
+	prefix := uploadKeyPrefix(userID, purpose)
+	if !strings.HasPrefix(key, prefix) || len(key) == len(prefix) || strings.Contains(key[len(prefix):], "/") {
+		c.JSON(http.StatusForbidden, gin.H{"error": "object_key does not belong to this upload"})
+		return nil, false
+	}
+	object, err := uploadStorage.Stat(c.Request.Context(), key)
+	if errors.Is(err, storage.ErrNotFound) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Upload not found; PUT the file to the presigned URL first"})
+		return nil, false
+	}
+	if err != nil {
+		log.Printf("Upload check for %s failed: %v", key, err)
+		c.JSON(http.StatusBadGateway, gin.H{"error": "Failed to verify upload"})
+		return nil, false
+	}
+	policy := uploadPolicies[purpose]
+	if object.Size > policy.maxSize || !policy.allows(strings.ToLower(object.ContentType)) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Uploaded file does not match the presigned type or size"})
+		return nil, false
+	}
+	return object, true
+}
+
+func respondUploadsDisabled(c *gin.Context) {
+	c.JSON(http.StatusNotImplemented, gin.H{"error": "Presigned uploads are not configured; upload the file directly"})
+}
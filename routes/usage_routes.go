@@ -0,0 +1,59 @@
+package routes
+
+import (
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"skillsync-api-gateway/middlewares"
+)
+
+// usageReport is a principal's usage in the current window and the earlier
+// windows the store still has
+func usageReport(c *gin.Context, role, userID string) gin.H {
+	meter := middlewares.UsageMeter()
+	principal := middlewares.UsagePrincipal(role, userID)
+	report := gin.H{
+		"user_id": userID,
+		"role":    role,
+		"current": meter.Current(principal),
+		"history": nil,
+	}
+	history, err := meter.History(c.Request.Context(), principal)
+	if err != nil {
+		log.Printf("Usage history unavailable for %s: %v", principal, err)
+		return report
+	}
+	report["history"] = history
+	return report
+}
+
+// GetMyUsage shows the caller their requests against the quota. Team members see
+// their company account's usage, which they share.
+func GetMyUsage(c *gin.Context) {
+	c.JSON(http.StatusOK, usageReport(c, c.GetString("user_role"), c.GetString("user_id")))
+}
+
+// GetUserUsage shows support a user's usage, for each role unless role is given
+func GetUserUsage(c *gin.Context) {
+	userID := strings.TrimSpace(c.Query("user_id"))
+	if userID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "user_id is required"})
+		return
+	}
+	roles := []string{"candidate", "employer"}
+	if role := c.Query("role"); role != "" {
+		if role != "candidate" && role != "employer" {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "role must be candidate or employer"})
+			return
+		}
+		roles = []string{role}
+	}
+	usage := make([]gin.H, 0, len(roles))
+	for _, role := range roles {
+		usage = append(usage, usageReport(c, role, userID))
+	}
+	c.JSON(http.StatusOK, gin.H{"usage": usage})
+}
@@ -0,0 +1,220 @@
+package routes
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	jobpb "github.com/shahal0/skillsync-protos/gen/jobpb"
+	"google.golang.org/grpc/metadata"
+
+	"skillsync-api-gateway/clients"
+	"skillsync-api-gateway/middlewares"
+	"skillsync-api-gateway/utils"
+	"skillsync-api-gateway/utils/webhook"
+)
+
+const (
+	webhookTestTimeout     = 5 * time.Second
+	maxWebhooksPerEmployer = 5
+)
+
+var (
+	webhookDispatcherOnce sync.Once
+	webhookDispatcherInst *webhook.Dispatcher
+)
+
+// webhookDispatcher lazily starts the delivery workers shared by all handlers
+func webhookDispatcher() *webhook.Dispatcher {
+	webhookDispatcherOnce.Do(func() {
+		webhookDispatcherInst = webhook.NewDispatcher(resolveWebhookEndpoints, webhook.Options{})
+	})
+	return webhookDispatcherInst
+}
+
+// resolveWebhookEndpoints finds the employer's registered URLs, looking the employer up from the job if needed
+func resolveWebhookEndpoints(ctx context.Context, event *webhook.Event) ([]webhook.Endpoint, error) {
+	if event.EmployerID == "" && event.JobID != 0 {
+		job, err := clients.JobServiceClient.GetJobById(ctx, &jobpb.GetJobByIdRequest{JobId: event.JobID})
+		if err != nil {
+			return nil, fmt.Errorf("looking up job %d: %w", event.JobID, err)
+		}
+		event.EmployerID = job.GetJob().GetEmployerId()
+	}
+	if event.EmployerID == "" {
+		return nil, fmt.Errorf("event has no employer")
+	}
+
+	ctx = metadata.NewOutgoingContext(ctx, metadata.New(map[string]string{
+		"user-id": event.EmployerID,
+		"role":    "employer",
+	}))
+	resp, err := clients.JobServiceClient.ListWebhooks(ctx, &jobpb.ListWebhooksRequest{EmployerId: event.EmployerID})
+	if err != nil {
+		return nil, err
+	}
+	endpoints := make([]webhook.Endpoint, 0, len(resp.GetWebhooks()))
+	for _, hook := range resp.GetWebhooks() {
+		endpoints = append(endpoints, webhook.Endpoint{ID: hook.GetId(), URL: hook.GetUrl(), Secret: hook.GetSecret()})
+	}
+	return endpoints, nil
+}
+
+// publishApplicationEvent hands an application event to the webhook workers without waiting
+func publishApplicationEvent(eventType, employerID string, jobID uint64, data gin.H) {
+	event := webhook.NewEvent(eventType, data)
+	event.EmployerID = employerID
+	event.JobID = jobID
+	webhookDispatcher().Publish(event)
+}
+
+func SetupWebhookRoutes(r *gin.Engine) {
+	webhooks := r.Group("/webhooks")
+	webhooks.Use(middlewares.Maintenance("job"), middlewares.JWTMiddleware(), middlewares.RequireRole("employer"), middlewares.ReadOnlyForViewers())
+	{
+		webhooks.POST("", CreateWebhook)
+		webhooks.GET("", GetWebhooks)
+		webhooks.DELETE("/:id", DeleteWebhook)
+		webhooks.POST("/:id/test", TestWebhook)
+	}
+}
+
+func CreateWebhook(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in context"})
+		return
+	}
+	employerID := userID.(string)
+
+	var body struct {
+		URL string `json:"url" binding:"required,url,max=2048"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		utils.RespondWithValidationError(c, err)
+		return
+	}
+	if parsed, err := url.Parse(body.URL); err != nil || (parsed.Scheme != "https" && parsed.Scheme != "http") {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "url must be an http or https URL"})
+		return
+	}
+
+	ctx := employerContext(c, employerID)
+	existing, err := clients.JobServiceClient.ListWebhooks(ctx, &jobpb.ListWebhooksRequest{EmployerId: employerID})
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to check existing webhooks: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+	if len(existing.GetWebhooks()) >= maxWebhooksPerEmployer {
+		c.JSON(http.StatusConflict, gin.H{"error": "You can register at most 5 webhooks; delete one before adding another"})
+		return
+	}
+
+	// The secret is generated here and only ever shown in this response
+	secret := webhook.NewSecret()
+	resp, err := clients.JobServiceClient.CreateWebhook(ctx, &jobpb.CreateWebhookRequest{
+		EmployerId: employerID,
+		Url:        body.URL,
+		Secret:     secret,
+	})
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to create webhook: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+	hook := resp.GetWebhook()
+	c.JSON(http.StatusCreated, gin.H{
+		"id":         hook.GetId(),
+		"url":        hook.GetUrl(),
+		"secret":     secret,
+		"created_at": hook.GetCreatedAt(),
+	})
+}
+
+func GetWebhooks(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	resp, err := clients.JobServiceClient.ListWebhooks(employerContext(c, userID.(string)), &jobpb.ListWebhooksRequest{
+		EmployerId: userID.(string),
+	})
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to get webhooks: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+	// Secrets are never listed
+	webhooks := make([]gin.H, 0, len(resp.GetWebhooks()))
+	for _, hook := range resp.GetWebhooks() {
+		webhooks = append(webhooks, gin.H{
+			"id":         hook.GetId(),
+			"url":        hook.GetUrl(),
+			"created_at": hook.GetCreatedAt(),
+		})
+	}
+	c.JSON(http.StatusOK, gin.H{"webhooks": webhooks})
+}
+
+func DeleteWebhook(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in context"})
+		return
+	}
+
+	resp, err := clients.JobServiceClient.DeleteWebhook(employerContext(c, userID.(string)), &jobpb.DeleteWebhookRequest{
+		WebhookId:  c.Param("id"),
+		EmployerId: userID.(string),
+	})
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to delete webhook: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// TestWebhook sends a single ping synchronously so the employer sees the receiver's answer
+func TestWebhook(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in context"})
+		return
+	}
+	employerID := userID.(string)
+
+	resp, err := clients.JobServiceClient.ListWebhooks(employerContext(c, employerID), &jobpb.ListWebhooksRequest{EmployerId: employerID})
+	if err != nil {
+		c.JSON(utils.HTTPStatusFromGRPC(err), gin.H{"error": "Failed to get webhooks: " + utils.GRPCErrorMessage(err)})
+		return
+	}
+	var endpoint *webhook.Endpoint
+	for _, hook := range resp.GetWebhooks() {
+		if hook.GetId() == c.Param("id") {
+			endpoint = &webhook.Endpoint{ID: hook.GetId(), URL: hook.GetUrl(), Secret: hook.GetSecret()}
+			break
+		}
+	}
+	if endpoint == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Webhook not found"})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), webhookTestTimeout)
+	defer cancel()
+	event := webhook.NewEvent("ping", gin.H{"webhook_id": endpoint.ID})
+	statusCode, err := webhookDispatcher().Send(ctx, *endpoint, event)
+	result := gin.H{
+		"delivered":   err == nil,
+		"event_id":    event.ID,
+		"status_code": statusCode,
+	}
+	if err != nil {
+		result["error"] = err.Error()
+	}
+	c.JSON(http.StatusOK, result)
+}
@@ -0,0 +1,176 @@
+package testsupport
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	authpb "github.com/shahal0/skillsync-protos/gen/authpb"
+	jobpb "github.com/shahal0/skillsync-protos/gen/jobpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	getJobsMethod        = "/jobservice.JobService/GetJobs"
+	candidateLoginMethod = "/authpb.AuthService/CandidateLogin"
+)
+
+func programJobs(g *Gateway, n int) {
+	jobs := make([]*jobpb.Job, n)
+	for i := range jobs {
+		jobs[i] = &jobpb.Job{Id: uint64(i + 1), Title: "Go developer", Category: "engineering", Status: "Open", EmployerId: "e1"}
+	}
+	Handle(g.Backend, getJobsMethod, func(context.Context, *jobpb.GetJobsRequest) (*jobpb.GetJobsResponse, error) {
+		return &jobpb.GetJobsResponse{Jobs: jobs, Total: int32(len(jobs))}, nil
+	})
+}
+
+func programLogin(g *Gateway) {
+	Handle(g.Backend, candidateLoginMethod, func(_ context.Context, req *authpb.CandidateLoginRequest) (*authpb.CandidateLoginResponse, error) {
+		if req.GetPassword() != "correct-horse" {
+			return nil, status.Error(codes.Unauthenticated, "wrong password")
+		}
+		return &authpb.CandidateLoginResponse{Id: "c1", Token: "upstream-token", EmailVerified: true}, nil
+	})
+	// The new-device check lists the candidate's sessions after each login; two
+	// from httptest's client address make it a known device, so no alert is sent
+	known := &authpb.Session{Ip: "192.0.2.1"}
+	Respond(g.Backend, "/authpb.AuthService/CandidateListSessions", &authpb.ListSessionsResponse{Sessions: []*authpb.Session{known, known}})
+}
+
+func TestGateway(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		body       interface{}
+		setup      func(g *Gateway)
+		wantStatus int
+		wantBody   string
+		wantCalls  map[string]int
+	}{
+		{
+			name:       "jobs",
+			method:     http.MethodGet,
+			path:       "/jobs/",
+			setup:      func(g *Gateway) { programJobs(g, 3) },
+			wantStatus: http.StatusOK,
+			wantBody:   `"Go developer"`,
+			wantCalls:  map[string]int{getJobsMethod: 1},
+		},
+		{
+			name:   "jobs backend down",
+			method: http.MethodGet,
+			path:   "/jobs/",
+			setup: func(g *Gateway) {
+				g.Backend.SetError(getJobsMethod, status.Error(codes.Unavailable, "job service down"))
+			},
+			wantStatus: http.StatusServiceUnavailable,
+		},
+		{
+			name:       "login",
+			method:     http.MethodPost,
+			path:       "/auth/candidate/login",
+			body:       map[string]string{"email": "c1@example.com", "password": "correct-horse"},
+			setup:      programLogin,
+			wantStatus: http.StatusOK,
+			wantCalls:  map[string]int{candidateLoginMethod: 1},
+		},
+		{
+			name:       "login wrong password",
+			method:     http.MethodPost,
+			path:       "/auth/candidate/login",
+			body:       map[string]string{"email": "c1@example.com", "password": "battery-staple"},
+			setup:      programLogin,
+			wantStatus: http.StatusUnauthorized,
+			wantBody:   "Invalid email or password",
+		},
+		{
+			name:       "unprogrammed method",
+			method:     http.MethodPost,
+			path:       "/auth/candidate/login",
+			body:       map[string]string{"email": "c1@example.com", "password": "correct-horse"},
+			wantStatus: http.StatusNotImplemented,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewGateway(t)
+			if tt.setup != nil {
+				tt.setup(g)
+			}
+			w := g.Request(t, tt.method, tt.path, tt.body, "")
+			if w.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d (%s)", w.Code, tt.wantStatus, w.Body)
+			}
+			if !strings.Contains(w.Body.String(), tt.wantBody) {
+				t.Errorf("body = %s, want it to contain %s", w.Body, tt.wantBody)
+			}
+			for method, want := range tt.wantCalls {
+				if got := g.Backend.Calls(method); got != want {
+					t.Errorf("Calls(%s) = %d, want %d", method, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestUserToken(t *testing.T) {
+	g := NewGateway(t)
+	tests := []struct {
+		name       string
+		token      string
+		wantStatus int
+	}{
+		{"candidate", g.UserToken(t, "c1", "candidate"), http.StatusOK},
+		{"expired", g.Token(t, map[string]interface{}{"user_id": "c1", "role": "candidate", "exp": time.Now().Add(-time.Minute).Unix()}), http.StatusUnauthorized},
+		{"none", "", http.StatusUnauthorized},
+	}
+	Handle(g.Backend, "/authpb.AuthService/CandidateProfile", func(context.Context, *authpb.CandidateProfileRequest) (*authpb.CandidateProfileResponse, error) {
+		return &authpb.CandidateProfileResponse{Id: "c1", Name: "Candidate"}, nil
+	})
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if w := g.Request(t, http.MethodGet, "/auth/candidate/profile", nil, tt.token); w.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d (%s)", w.Code, tt.wantStatus, w.Body)
+			}
+		})
+	}
+}
+
+// benchmarkRequest serves b.N requests and reports their p99 latency alongside
+// the allocations, so regressions in the middleware chain show up
+func benchmarkRequest(b *testing.B, g *Gateway, method, path string, body interface{}, wantStatus int) {
+	b.Helper()
+	latencies := make([]time.Duration, 0, b.N)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		start := time.Now()
+		w := g.Request(b, method, path, body, "")
+		latencies = append(latencies, time.Since(start))
+		if w.Code != wantStatus {
+			b.Fatalf("status = %d, want %d (%s)", w.Code, wantStatus, w.Body)
+		}
+	}
+	b.StopTimer()
+	sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+	b.ReportMetric(float64(latencies[len(latencies)*99/100].Microseconds()), "p99-µs")
+}
+
+func BenchmarkGetJobs(b *testing.B) {
+	g := NewGateway(b)
+	programJobs(g, 20)
+	benchmarkRequest(b, g, http.MethodGet, "/jobs/", nil, http.StatusOK)
+}
+
+func BenchmarkCandidateLogin(b *testing.B) {
+	g := NewGateway(b)
+	programLogin(g)
+	body := map[string]string{"email": "c1@example.com", "password": "correct-horse"}
+	benchmarkRequest(b, g, http.MethodPost, "/auth/candidate/login", body, http.StatusOK)
+}
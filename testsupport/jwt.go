@@ -0,0 +1,38 @@
+package testsupport
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"skillsync-api-gateway/middlewares"
+)
+
+// tokenTTL is how long minted tokens are valid unless claims set "exp"
+const tokenTTL = time.Hour
+
+// Token mints an HS256 token accepted by the gateway's JWT middleware. claims are
+// used as given, so tokens can carry any role, session ID or expiry.
+func (g *Gateway) Token(tb testing.TB, claims jwt.MapClaims) string {
+	tb.Helper()
+	if _, ok := claims["exp"]; !ok {
+		claims["exp"] = time.Now().Add(tokenTTL).Unix()
+	}
+	key := middlewares.SigningKey()
+	unsigned := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	if key.ID != "" {
+		unsigned.Header["kid"] = key.ID
+	}
+	token, err := unsigned.SignedString([]byte(key.Secret))
+	if err != nil {
+		tb.Fatalf("testsupport: signing token: %v", err)
+	}
+	return token
+}
+
+// UserToken mints a token for userID with role (candidate, employer or admin)
+func (g *Gateway) UserToken(tb testing.TB, userID, role string) string {
+	tb.Helper()
+	return g.Token(tb, jwt.MapClaims{"user_id": userID, "role": role})
+}
@@ -0,0 +1,264 @@
+package mock
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	authpb "github.com/shahal0/skillsync-protos/gen/authpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"skillsync-api-gateway/testsupport"
+)
+
+func employerID(id int64) string {
+	return strconv.FormatInt(id, 10)
+}
+
+func (s *store) registerAuth(backend *testsupport.FakeBackend) {
+	testsupport.Handle(backend, authpb.AuthService_CandidateSignup_FullMethodName, s.candidateSignup)
+	testsupport.Handle(backend, authpb.AuthService_CandidateLogin_FullMethodName, s.candidateLogin)
+	testsupport.Handle(backend, authpb.AuthService_CandidateProfile_FullMethodName, s.candidateProfile)
+	testsupport.Handle(backend, authpb.AuthService_CandidateProfileUpdate_FullMethodName, s.candidateProfileUpdate)
+	testsupport.Handle(backend, authpb.AuthService_CandidateSkillsUpdate_FullMethodName, s.candidateSkillsUpdate)
+	testsupport.Handle(backend, authpb.AuthService_GetCandidateSkills_FullMethodName, s.getCandidateSkills)
+	testsupport.Handle(backend, authpb.AuthService_EmployerSignup_FullMethodName, s.employerSignup)
+	testsupport.Handle(backend, authpb.AuthService_EmployerLogin_FullMethodName, s.employerLogin)
+	testsupport.Handle(backend, authpb.AuthService_EmployerProfile_FullMethodName, s.employerProfile)
+	testsupport.Handle(backend, authpb.AuthService_EmployerProfileById_FullMethodName, s.employerProfileByID)
+	testsupport.Handle(backend, authpb.AuthService_EmployerProfileUpdate_FullMethodName, s.employerProfileUpdate)
+	testsupport.Handle(backend, authpb.AuthService_GetEmployerPublicProfile_FullMethodName, s.employerPublicProfile)
+}
+
+// emailTaken reports whether any account uses email. The caller holds the mutex.
+func (s *store) emailTaken(email string) bool {
+	for _, account := range s.candidates {
+		if strings.EqualFold(account.profile.GetEmail(), email) {
+			return true
+		}
+	}
+	for _, account := range s.employers {
+		if strings.EqualFold(account.profile.GetEmail(), email) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *store) candidateSignup(_ context.Context, req *authpb.CandidateSignupRequest) (*authpb.CandidateSignupResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.emailTaken(req.GetEmail()) {
+		return nil, status.Error(codes.AlreadyExists, "email already registered")
+	}
+	id := "c" + strconv.FormatUint(s.nextID(), 10)
+	s.candidates[id] = &candidate{
+		profile:  &authpb.CandidateProfileResponse{Id: id, Email: req.GetEmail(), Name: req.GetName(), IsVerified: true},
+		password: req.GetPassword(),
+	}
+	return &authpb.CandidateSignupResponse{Id: id, Message: "Signed up; mock accounts need no email verification"}, nil
+}
+
+func (s *store) candidateLogin(_ context.Context, req *authpb.CandidateLoginRequest) (*authpb.CandidateLoginResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	for id, account := range s.candidates {
+		if strings.EqualFold(account.profile.GetEmail(), req.GetEmail()) && account.password == req.GetPassword() {
+			token, err := signToken(id, "candidate", account.profile.GetEmail())
+			if err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+			return &authpb.CandidateLoginResponse{Id: id, Token: token, Message: "Login successful", EmailVerified: true}, nil
+		}
+	}
+	return nil, status.Error(codes.Unauthenticated, "invalid email or password")
+}
+
+// callerCandidate is the candidate making the call. The caller holds the mutex.
+func (s *store) callerCandidate(ctx context.Context) (*candidate, error) {
+	userID, _ := caller(ctx)
+	account, ok := s.candidates[userID]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "candidate not found")
+	}
+	return account, nil
+}
+
+func (s *store) candidateProfile(ctx context.Context, _ *authpb.CandidateProfileRequest) (*authpb.CandidateProfileResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	account, err := s.callerCandidate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return clone(account.profile), nil
+}
+
+func (s *store) candidateProfileUpdate(ctx context.Context, req *authpb.CandidateProfileUpdateRequest) (*authpb.CandidateProfileResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	account, err := s.callerCandidate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	profile := account.profile
+	for _, field := range []struct {
+		value string
+		into  *string
+	}{
+		{req.GetName(), &profile.Name},
+		{req.GetCurrentLocation(), &profile.CurrentLocation},
+		{req.GetPreferredLocation(), &profile.PreferredLocation},
+		{req.GetLinkedin(), &profile.Linkedin},
+		{req.GetGithub(), &profile.Github},
+		{req.GetProfilePicture(), &profile.ProfilePicture},
+	} {
+		if field.value != "" {
+			*field.into = field.value
+		}
+	}
+	if req.GetPhone() != 0 {
+		profile.Phone = req.GetPhone()
+	}
+	if req.GetExperience() != 0 {
+		profile.Experience = req.GetExperience()
+	}
+	return clone(profile), nil
+}
+
+func (s *store) candidateSkillsUpdate(ctx context.Context, req *authpb.SkillsUpdateRequest) (*authpb.GenericResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	account, err := s.callerCandidate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	account.profile.Skills = req.GetSkills()
+	return &authpb.GenericResponse{Message: "Skills updated", Success: true}, nil
+}
+
+func (s *store) getCandidateSkills(_ context.Context, req *authpb.GetCandidateSkillsRequest) (*authpb.GetCandidateSkillsResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	account, ok := s.candidates[req.GetCandidateId()]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "candidate not found")
+	}
+	names := make([]string, 0, len(account.profile.GetSkills()))
+	for _, skill := range account.profile.GetSkills() {
+		names = append(names, skill.GetSkill())
+	}
+	return &authpb.GetCandidateSkillsResponse{Skills: names}, nil
+}
+
+func (s *store) employerSignup(_ context.Context, req *authpb.EmployerSignupRequest) (*authpb.EmployerSignupResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.emailTaken(req.GetEmail()) {
+		return nil, status.Error(codes.AlreadyExists, "email already registered")
+	}
+	id := int64(s.nextID())
+	s.employers[employerID(id)] = &employer{
+		profile: &authpb.EmployerProfileResponse{
+			Id:          id,
+			Email:       req.GetEmail(),
+			CompanyName: req.GetCompanyName(),
+			Phone:       req.GetPhone(),
+			Industry:    req.GetIndustry(),
+			Location:    req.GetLocation(),
+			Website:     req.GetWebsite(),
+			IsVerified:  true,
+		},
+		password: req.GetPassword(),
+	}
+	return &authpb.EmployerSignupResponse{Id: id, Message: "Signed up; mock accounts need no email verification"}, nil
+}
+
+func (s *store) employerLogin(_ context.Context, req *authpb.EmployerLoginRequest) (*authpb.EmployerLoginResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	for id, account := range s.employers {
+		if strings.EqualFold(account.profile.GetEmail(), req.GetEmail()) && account.password == req.GetPassword() {
+			token, err := signToken(id, "employer", account.profile.GetEmail())
+			if err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+			return &authpb.EmployerLoginResponse{Id: account.profile.GetId(), Token: token, Message: "Login successful", EmailVerified: true}, nil
+		}
+	}
+	return nil, status.Error(codes.Unauthenticated, "invalid email or password")
+}
+
+func (s *store) employerByID(id string) (*employer, error) {
+	account, ok := s.employers[id]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "employer not found")
+	}
+	return account, nil
+}
+
+func (s *store) employerProfile(ctx context.Context, _ *authpb.EmployerProfileRequest) (*authpb.EmployerProfileResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	userID, _ := caller(ctx)
+	account, err := s.employerByID(userID)
+	if err != nil {
+		return nil, err
+	}
+	return clone(account.profile), nil
+}
+
+func (s *store) employerProfileByID(_ context.Context, req *authpb.EmployerProfileByIdRequest) (*authpb.EmployerProfileResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	account, err := s.employerByID(req.GetEmployerId())
+	if err != nil {
+		return nil, err
+	}
+	return clone(account.profile), nil
+}
+
+func (s *store) employerProfileUpdate(ctx context.Context, req *authpb.EmployerProfileUpdateRequest) (*authpb.EmployerProfileResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	userID, _ := caller(ctx)
+	account, err := s.employerByID(userID)
+	if err != nil {
+		return nil, err
+	}
+	profile := account.profile
+	for _, field := range []struct {
+		value string
+		into  *string
+	}{
+		{req.GetCompanyName(), &profile.CompanyName},
+		{req.GetIndustry(), &profile.Industry},
+		{req.GetLocation(), &profile.Location},
+		{req.GetWebsite(), &profile.Website},
+	} {
+		if field.value != "" {
+			*field.into = field.value
+		}
+	}
+	if req.GetPhone() != 0 {
+		profile.Phone = req.GetPhone()
+	}
+	return clone(profile), nil
+}
+
+func (s *store) employerPublicProfile(_ context.Context, req *authpb.EmployerPublicProfileRequest) (*authpb.EmployerPublicProfileResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	account, err := s.employerByID(req.GetEmployerId())
+	if err != nil {
+		return nil, err
+	}
+	return &authpb.EmployerPublicProfileResponse{
+		EmployerId:  req.GetEmployerId(),
+		CompanyName: account.profile.GetCompanyName(),
+		Website:     account.profile.GetWebsite(),
+		Industry:    account.profile.GetIndustry(),
+		Location:    account.profile.GetLocation(),
+	}, nil
+}
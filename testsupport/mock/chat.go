@@ -0,0 +1,197 @@
+package mock
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"time"
+
+	chatpb "github.com/shahal0/skillsync-protos/gen/chatpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"skillsync-api-gateway/testsupport"
+)
+
+func (s *store) registerChat(backend *testsupport.FakeBackend) {
+	testsupport.Handle(backend, chatpb.ChatService_StartConversation_FullMethodName, s.startConversation)
+	testsupport.Handle(backend, chatpb.ChatService_GetConversation_FullMethodName, s.getConversation)
+	testsupport.Handle(backend, chatpb.ChatService_ListConversations_FullMethodName, s.listConversations)
+	testsupport.Handle(backend, chatpb.ChatService_SendMessage_FullMethodName, s.sendMessage)
+	testsupport.Handle(backend, chatpb.ChatService_ListMessages_FullMethodName, s.listMessages)
+	testsupport.Handle(backend, chatpb.ChatService_MarkMessagesAsRead_FullMethodName, s.markMessagesAsRead)
+	testsupport.Handle(backend, chatpb.ChatService_GetUnreadCount_FullMethodName, s.chatUnreadCount)
+}
+
+func participant(conversation *chatpb.Conversation, userID string) bool {
+	return conversation.GetEmployerId() == userID || conversation.GetCandidateId() == userID
+}
+
+// unread counts the messages to userID in a conversation they haven't read. The
+// caller holds the mutex.
+func (s *store) unread(conversationID, userID string) int32 {
+	var count int32
+	for _, message := range s.messages[conversationID] {
+		if message.GetReceiverId() == userID && message.GetStatus() != chatpb.MessageStatus_READ {
+			count++
+		}
+	}
+	return count
+}
+
+// conversationFor is a participant's copy of a conversation, with their unread
+// count. The caller holds the mutex.
+func (s *store) conversationFor(conversationID, userID string) (*chatpb.Conversation, error) {
+	conversation, ok := s.conversations[conversationID]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "conversation not found")
+	}
+	if !participant(conversation, userID) {
+		return nil, status.Error(codes.PermissionDenied, "not a participant in this conversation")
+	}
+	view := clone(conversation)
+	view.UnreadCount = s.unread(conversationID, userID)
+	return view, nil
+}
+
+func (s *store) startConversation(ctx context.Context, req *chatpb.StartConversationRequest) (*chatpb.StartConversationResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	userID, _ := caller(ctx)
+	for id, conversation := range s.conversations {
+		if conversation.GetJobId() == req.GetJobId() && conversation.GetCandidateId() == req.GetCandidateId() {
+			view, err := s.conversationFor(id, userID)
+			if err != nil {
+				return nil, err
+			}
+			return &chatpb.StartConversationResponse{Conversation: view}, nil
+		}
+	}
+	now := timestamppb.New(s.now())
+	conversation := &chatpb.Conversation{
+		Id:            "conv" + strconv.FormatUint(s.nextID(), 10),
+		JobId:         req.GetJobId(),
+		ApplicationId: req.GetApplicationId(),
+		EmployerId:    req.GetEmployerId(),
+		CandidateId:   req.GetCandidateId(),
+		JobTitle:      req.GetJobTitle(),
+		Status:        "ACTIVE",
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+	if !participant(conversation, userID) {
+		return nil, status.Error(codes.PermissionDenied, "not a participant in this conversation")
+	}
+	s.conversations[conversation.GetId()] = conversation
+	return &chatpb.StartConversationResponse{Conversation: clone(conversation), Created: true}, nil
+}
+
+func (s *store) getConversation(ctx context.Context, req *chatpb.GetConversationRequest) (*chatpb.GetConversationResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	userID, _ := caller(ctx)
+	view, err := s.conversationFor(req.GetConversationId(), userID)
+	if err != nil {
+		return nil, err
+	}
+	return &chatpb.GetConversationResponse{Conversation: view}, nil
+}
+
+func (s *store) listConversations(_ context.Context, req *chatpb.ListConversationsRequest) (*chatpb.ListConversationsResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	var conversations []*chatpb.Conversation
+	for id, conversation := range s.conversations {
+		if participant(conversation, req.GetUserId()) {
+			view, _ := s.conversationFor(id, req.GetUserId())
+			conversations = append(conversations, view)
+		}
+	}
+	sort.Slice(conversations, func(i, j int) bool {
+		return conversations[i].GetUpdatedAt().AsTime().After(conversations[j].GetUpdatedAt().AsTime())
+	})
+	return &chatpb.ListConversationsResponse{
+		Conversations: page(conversations, req.GetPage(), req.GetLimit()),
+		Total:         int32(len(conversations)),
+	}, nil
+}
+
+func (s *store) sendMessage(ctx context.Context, req *chatpb.SendMessageRequest) (*chatpb.SendMessageResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	conversation, ok := s.conversations[req.GetConversationId()]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "conversation not found")
+	}
+	if !participant(conversation, req.GetSenderId()) {
+		return nil, status.Error(codes.PermissionDenied, "not a participant in this conversation")
+	}
+	message := &chatpb.Message{
+		Id:             conversation.GetId() + "-m" + strconv.FormatUint(s.nextID(), 10),
+		ConversationId: conversation.GetId(),
+		SenderId:       req.GetSenderId(),
+		SenderRole:     chatpb.SenderRole_CANDIDATE,
+		ReceiverId:     conversation.GetEmployerId(),
+		Content:        req.GetContent(),
+		Attachments:    req.GetAttachments(),
+		SentTime:       s.now().UTC().Format(time.RFC3339),
+		Status:         chatpb.MessageStatus_SENT,
+	}
+	if _, role := caller(ctx); role == "employer" || req.GetSenderId() == conversation.GetEmployerId() {
+		message.SenderRole = chatpb.SenderRole_EMPLOYER
+		message.ReceiverId = conversation.GetCandidateId()
+	}
+	s.messages[conversation.GetId()] = append(s.messages[conversation.GetId()], message)
+	conversation.LastMessage = message
+	conversation.UpdatedAt = timestamppb.New(s.now())
+	return &chatpb.SendMessageResponse{Message: clone(message)}, nil
+}
+
+func (s *store) listMessages(_ context.Context, req *chatpb.ListMessagesRequest) (*chatpb.ListMessagesResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if _, err := s.conversationFor(req.GetConversationId(), req.GetUserId()); err != nil {
+		return nil, err
+	}
+	stored := s.messages[req.GetConversationId()]
+	messages := make([]*chatpb.Message, 0, len(stored))
+	for _, message := range stored {
+		messages = append(messages, clone(message))
+	}
+	return &chatpb.ListMessagesResponse{
+		Messages: page(messages, req.GetPage(), req.GetLimit()),
+		Total:    int32(len(messages)),
+	}, nil
+}
+
+func (s *store) markMessagesAsRead(_ context.Context, req *chatpb.MarkMessagesAsReadRequest) (*chatpb.MarkMessagesAsReadResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	ids := make(map[string]bool, len(req.GetMessageIds()))
+	for _, id := range req.GetMessageIds() {
+		ids[id] = true
+	}
+	var count int64
+	for _, messages := range s.messages {
+		for _, message := range messages {
+			if ids[message.GetId()] && message.GetReceiverId() == req.GetUserId() && message.GetStatus() != chatpb.MessageStatus_READ {
+				message.Status = chatpb.MessageStatus_READ
+				count++
+			}
+		}
+	}
+	return &chatpb.MarkMessagesAsReadResponse{Count: count}, nil
+}
+
+func (s *store) chatUnreadCount(_ context.Context, req *chatpb.GetUnreadCountRequest) (*chatpb.GetUnreadCountResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	var count int64
+	for id, conversation := range s.conversations {
+		if participant(conversation, req.GetUserId()) {
+			count += int64(s.unread(id, req.GetUserId()))
+		}
+	}
+	return &chatpb.GetUnreadCountResponse{Count: count}, nil
+}
@@ -0,0 +1,290 @@
+package mock
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	jobpb "github.com/shahal0/skillsync-protos/gen/jobpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"skillsync-api-gateway/testsupport"
+)
+
+func (s *store) registerJobs(backend *testsupport.FakeBackend) {
+	testsupport.Handle(backend, jobpb.JobService_GetJobs_FullMethodName, s.getJobs)
+	testsupport.Handle(backend, jobpb.JobService_GetJobById_FullMethodName, s.getJobByID)
+	testsupport.Handle(backend, jobpb.JobService_ListEmployerJobs_FullMethodName, s.listEmployerJobs)
+	testsupport.Handle(backend, jobpb.JobService_PostJob_FullMethodName, s.postJob)
+	testsupport.Handle(backend, jobpb.JobService_UpdateJobStatus_FullMethodName, s.updateJobStatus)
+	testsupport.Handle(backend, jobpb.JobService_AddJobSkills_FullMethodName, s.addJobSkills)
+	testsupport.Handle(backend, jobpb.JobService_ApplyToJob_FullMethodName, s.applyToJob)
+	testsupport.Handle(backend, jobpb.JobService_GetApplications_FullMethodName, s.getApplications)
+	testsupport.Handle(backend, jobpb.JobService_GetApplication_FullMethodName, s.getApplication)
+	testsupport.Handle(backend, jobpb.JobService_UpdateApplicationStatus_FullMethodName, s.updateApplicationStatus)
+}
+
+// sortedJobs returns the jobs matching keep, newest first. The caller holds the mutex.
+func (s *store) sortedJobs(keep func(*jobpb.Job) bool) []*jobpb.Job {
+	var jobs []*jobpb.Job
+	for _, job := range s.jobs {
+		if keep(job) {
+			jobs = append(jobs, clone(job))
+		}
+	}
+	sort.Slice(jobs, func(i, j int) bool { return jobs[i].GetId() > jobs[j].GetId() })
+	return jobs
+}
+
+// matchesKeyword applies the listing's keyword terms to a job's title and
+// description, or the raw keyword when the gateway sent no terms
+func matchesKeyword(job *jobpb.Job, req *jobpb.GetJobsRequest) bool {
+	text := strings.ToLower(job.GetTitle() + " " + job.GetDescription())
+	include := req.GetIncludeTerms()
+	if len(include) == 0 && req.GetKeyword() != "" {
+		include = []string{req.GetKeyword()}
+	}
+	for _, term := range include {
+		if !strings.Contains(text, strings.ToLower(term)) {
+			return false
+		}
+	}
+	for _, term := range req.GetExcludeTerms() {
+		if strings.Contains(text, strings.ToLower(term)) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *store) getJobs(_ context.Context, req *jobpb.GetJobsRequest) (*jobpb.GetJobsResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	wantStatus := req.GetStatus()
+	if wantStatus == "" {
+		wantStatus = "OPEN"
+	}
+	jobs := s.sortedJobs(func(job *jobpb.Job) bool {
+		return strings.EqualFold(job.GetStatus(), wantStatus) &&
+			(req.GetCategory() == "" || strings.EqualFold(job.GetCategory(), req.GetCategory())) &&
+			(req.GetLocation() == "" || strings.Contains(strings.ToLower(job.GetLocation()), strings.ToLower(req.GetLocation()))) &&
+			job.GetExperienceRequired() >= req.GetExperienceRequired() &&
+			matchesKeyword(job, req)
+	})
+	return &jobpb.GetJobsResponse{Jobs: page(jobs, req.GetPage(), req.GetLimit()), Total: int32(len(jobs))}, nil
+}
+
+func (s *store) getJobByID(_ context.Context, req *jobpb.GetJobByIdRequest) (*jobpb.GetJobByIdResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	job, ok := s.jobs[req.GetJobId()]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "job not found")
+	}
+	return &jobpb.GetJobByIdResponse{Job: clone(job)}, nil
+}
+
+func (s *store) listEmployerJobs(_ context.Context, req *jobpb.ListEmployerJobsRequest) (*jobpb.ListEmployerJobsResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	jobs := s.sortedJobs(func(job *jobpb.Job) bool { return job.GetEmployerId() == req.GetEmployerId() })
+	return &jobpb.ListEmployerJobsResponse{Jobs: jobs}, nil
+}
+
+func (s *store) postJob(ctx context.Context, req *jobpb.PostJobRequest) (*jobpb.PostJobResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	employerID := req.GetEmployerId()
+	if employerID == "" {
+		employerID, _ = caller(ctx)
+	}
+	if _, ok := s.employers[employerID]; !ok {
+		return nil, status.Error(codes.PermissionDenied, "only employers can post jobs")
+	}
+	now := s.now().UTC()
+	created := now.Format(time.RFC3339)
+	job := &jobpb.Job{
+		Id:                 s.nextID(),
+		EmployerId:         employerID,
+		Title:              req.GetTitle(),
+		Description:        req.GetDescription(),
+		Category:           req.GetCategory(),
+		RequiredSkills:     req.GetRequiredSkills(),
+		SalaryMin:          req.GetSalaryMin(),
+		SalaryMax:          req.GetSalaryMax(),
+		Location:           req.GetLocation(),
+		ExperienceRequired: req.GetExperienceRequired(),
+		Status:             "OPEN",
+		Deadline:           req.GetDeadline(),
+		CreatedAt:          created,
+		UpdatedAt:          created,
+	}
+	if job.Deadline == "" {
+		job.Deadline = now.AddDate(0, 1, 0).Format("2006-01-02")
+	}
+	s.jobs[job.GetId()] = job
+	return &jobpb.PostJobResponse{JobId: job.GetId(), Message: "Job posted"}, nil
+}
+
+// ownedJob is the caller's job. The caller holds the mutex.
+func (s *store) ownedJob(ctx context.Context, jobID uint64) (*jobpb.Job, error) {
+	job, ok := s.jobs[jobID]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "job not found")
+	}
+	if userID, role := caller(ctx); role != "admin" && job.GetEmployerId() != userID {
+		return nil, status.Error(codes.PermissionDenied, "not your job")
+	}
+	return job, nil
+}
+
+func (s *store) updateJobStatus(ctx context.Context, req *jobpb.UpdateJobStatusRequest) (*jobpb.UpdateJobStatusResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	jobID, err := strconv.ParseUint(req.GetJobId(), 10, 64)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid job ID")
+	}
+	job, err := s.ownedJob(ctx, jobID)
+	if err != nil {
+		return nil, err
+	}
+	job.Status = strings.ToUpper(req.GetStatus())
+	job.UpdatedAt = s.now().UTC().Format(time.RFC3339)
+	return &jobpb.UpdateJobStatusResponse{Message: "Job status updated"}, nil
+}
+
+func (s *store) addJobSkills(ctx context.Context, req *jobpb.AddJobSkillsRequest) (*jobpb.AddJobSkillsResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	job, err := s.ownedJob(ctx, req.GetJobId())
+	if err != nil {
+		return nil, err
+	}
+	for _, skill := range job.GetRequiredSkills() {
+		if strings.EqualFold(skill.GetSkill(), req.GetSkill()) {
+			return nil, status.Error(codes.AlreadyExists, "job already requires this skill")
+		}
+	}
+	job.RequiredSkills = append(job.RequiredSkills, &jobpb.JobSkill{
+		JobId:       strconv.FormatUint(job.GetId(), 10),
+		Skill:       req.GetSkill(),
+		Proficiency: req.GetProficiency(),
+	})
+	return &jobpb.AddJobSkillsResponse{Message: "Skill added"}, nil
+}
+
+func (s *store) applyToJob(_ context.Context, req *jobpb.ApplyToJobRequest) (*jobpb.ApplyToJobResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	job, ok := s.jobs[req.GetJobId()]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "job not found")
+	}
+	if job.GetStatus() != "OPEN" {
+		return nil, status.Error(codes.FailedPrecondition, "job is not open")
+	}
+	account, ok := s.candidates[req.GetCandidateId()]
+	if !ok {
+		return nil, status.Error(codes.PermissionDenied, "only candidates can apply")
+	}
+	for _, application := range s.applications {
+		if application.GetJobId() == job.GetId() && application.GetCandidateId() == req.GetCandidateId() {
+			return nil, status.Error(codes.AlreadyExists, "already applied to this job")
+		}
+	}
+	now := s.now()
+	application := &jobpb.ApplicationResponse{
+		Id:          s.nextID(),
+		JobId:       job.GetId(),
+		CandidateId: req.GetCandidateId(),
+		Status:      "APPLIED",
+		ResumeUrl:   req.GetResumeUrl(),
+		AppliedAt:   now.UTC().Format(time.RFC3339),
+	}
+	s.applications[application.GetId()] = application
+	// The real job service tells the employer itself; the gateway doesn't
+	s.addNotification(job.GetEmployerId(), "application_created", "New application",
+		account.profile.GetName()+" applied to "+job.GetTitle(), strconv.FormatUint(application.GetId(), 10), now)
+	return &jobpb.ApplyToJobResponse{ApplicationId: application.GetId(), Message: "Application submitted"}, nil
+}
+
+// visibleApplication reports whether the caller may see application: candidates
+// their own, employers those to their jobs. The caller holds the mutex.
+func (s *store) visibleApplication(ctx context.Context, application *jobpb.ApplicationResponse) bool {
+	userID, role := caller(ctx)
+	switch role {
+	case "admin":
+		return true
+	case "employer":
+		return s.jobs[application.GetJobId()].GetEmployerId() == userID
+	default:
+		return application.GetCandidateId() == userID
+	}
+}
+
+// applicationView is a copy of application with its job, as the job service
+// returns it. The caller holds the mutex.
+func (s *store) applicationView(application *jobpb.ApplicationResponse) *jobpb.ApplicationResponse {
+	view := clone(application)
+	if job, ok := s.jobs[application.GetJobId()]; ok {
+		view.Job = clone(job)
+	}
+	return view
+}
+
+func (s *store) getApplications(ctx context.Context, req *jobpb.GetApplicationsRequest) (*jobpb.GetApplicationsResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	var applications []*jobpb.ApplicationResponse
+	for _, application := range s.applications {
+		if (req.GetJobId() == 0 || application.GetJobId() == req.GetJobId()) &&
+			(req.GetCandidateId() == "" || application.GetCandidateId() == req.GetCandidateId()) &&
+			(req.GetStatus() == "" || strings.EqualFold(application.GetStatus(), req.GetStatus())) &&
+			s.visibleApplication(ctx, application) {
+			applications = append(applications, clone(application))
+		}
+	}
+	sort.Slice(applications, func(i, j int) bool { return applications[i].GetId() > applications[j].GetId() })
+	applications = page(applications, req.GetPage(), req.GetLimit())
+	for i, application := range applications {
+		applications[i] = s.applicationView(application)
+	}
+	return &jobpb.GetApplicationsResponse{
+		Applications: applications,
+		Total:        int32(len(applications)),
+	}, nil
+}
+
+func (s *store) getApplication(ctx context.Context, req *jobpb.GetApplicationRequest) (*jobpb.GetApplicationResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	application, ok := s.applications[req.GetApplicationId()]
+	if !ok || !s.visibleApplication(ctx, application) {
+		return nil, status.Error(codes.NotFound, "application not found")
+	}
+	return &jobpb.GetApplicationResponse{Application: s.applicationView(application)}, nil
+}
+
+// updateApplicationStatus changes the status. The candidate's notification is
+// sent by the gateway, so it arrives through the notification fake.
+func (s *store) updateApplicationStatus(ctx context.Context, req *jobpb.UpdateApplicationStatusRequest) (*jobpb.UpdateApplicationStatusResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	applicationID, err := strconv.ParseUint(req.GetApplicationId(), 10, 64)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid application ID")
+	}
+	application, ok := s.applications[applicationID]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "application not found")
+	}
+	if _, err := s.ownedJob(ctx, application.GetJobId()); err != nil {
+		return nil, err
+	}
+	application.Status = strings.ToUpper(req.GetStatus())
+	return &jobpb.UpdateApplicationStatusResponse{Message: "Application status updated", Application: s.applicationView(application)}, nil
+}
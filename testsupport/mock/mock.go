@@ -0,0 +1,137 @@
+// Package mock serves every backend of the gateway in process with deterministic
+// sample data, so the frontend can be developed without the auth, job, chat and
+// notification services (MOCK_MODE). State lives in memory: mutations change it,
+// so flows like applying, changing the status and reading the notification work,
+// and a restart resets it. RPCs the mock doesn't implement answer Unimplemented.
+package mock
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	authpb "github.com/shahal0/skillsync-protos/gen/authpb"
+	chatpb "github.com/shahal0/skillsync-protos/gen/chatpb"
+	jobpb "github.com/shahal0/skillsync-protos/gen/jobpb"
+	notificationpb "github.com/shahal0/skillsync-protos/gen/notificationpb"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+
+	"skillsync-api-gateway/middlewares"
+	"skillsync-api-gateway/testsupport"
+)
+
+// Password is the password of every seeded account
+const Password = "mock-password"
+
+// tokenTTL is how long tokens from a mock login are valid
+const tokenTTL = 24 * time.Hour
+
+// ErrReleaseMode is returned by Start when gin runs in release mode
+var ErrReleaseMode = errors.New("mock mode can't be enabled when GIN_MODE is release")
+
+type candidate struct {
+	profile  *authpb.CandidateProfileResponse
+	password string
+}
+
+type employer struct {
+	profile  *authpb.EmployerProfileResponse
+	password string
+}
+
+// store is the backends' shared state. Every handler holds the mutex for its
+// whole call, which is plenty for one developer clicking through the app.
+type store struct {
+	mutex sync.Mutex
+
+	candidates    map[string]*candidate
+	employers     map[string]*employer
+	jobs          map[uint64]*jobpb.Job
+	applications  map[uint64]*jobpb.ApplicationResponse
+	conversations map[string]*chatpb.Conversation
+	messages      map[string][]*chatpb.Message
+	notifications map[string][]*notificationpb.Notification
+
+	// lastID numbers everything created after seeding
+	lastID uint64
+	now    func() time.Time
+}
+
+// Start runs a fake backend seeded with the sample data, for clients.Connect. It
+// refuses to start in gin's release mode.
+func Start() (*testsupport.FakeBackend, error) {
+	if gin.Mode() == gin.ReleaseMode {
+		return nil, ErrReleaseMode
+	}
+	backend, err := testsupport.NewFakeBackend()
+	if err != nil {
+		return nil, err
+	}
+	s := seed(time.Now)
+	s.registerAuth(backend)
+	s.registerJobs(backend)
+	s.registerChat(backend)
+	s.registerNotifications(backend)
+	return backend, nil
+}
+
+// nextID returns a new ID, above every seeded one
+func (s *store) nextID() uint64 {
+	s.lastID++
+	return s.lastID
+}
+
+// caller is the user the gateway forwards in the call's metadata
+func caller(ctx context.Context) (userID, role string) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get("user-id"); len(values) > 0 {
+		userID = values[0]
+	}
+	if values := md.Get("role"); len(values) > 0 {
+		role = values[0]
+	}
+	return userID, role
+}
+
+// signToken mints a token the gateway's JWT middleware accepts, like the auth
+// service does on login
+func signToken(userID, role, email string) (string, error) {
+	now := time.Now()
+	key := middlewares.SigningKey()
+	unsigned := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"user_id": userID,
+		"role":    role,
+		"email":   email,
+		"iat":     now.Unix(),
+		"exp":     now.Add(tokenTTL).Unix(),
+	})
+	if key.ID != "" {
+		unsigned.Header["kid"] = key.ID
+	}
+	return unsigned.SignedString([]byte(key.Secret))
+}
+
+// page returns one page of items; page counts from 1 and a limit of 0 returns all
+func page[T any](items []T, number, limit int32) []T {
+	if limit <= 0 {
+		return items
+	}
+	if number < 1 {
+		number = 1
+	}
+	start := int(number-1) * int(limit)
+	if start >= len(items) {
+		return []T{}
+	}
+	return items[start:min(start+int(limit), len(items))]
+}
+
+// clone copies a stored message, so it isn't read while encoded after the
+// handler returned and another call changes it
+func clone[M proto.Message](message M) M {
+	return proto.Clone(message).(M)
+}
@@ -0,0 +1,86 @@
+package mock
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"time"
+
+	notificationpb "github.com/shahal0/skillsync-protos/gen/notificationpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"skillsync-api-gateway/testsupport"
+)
+
+func (s *store) registerNotifications(backend *testsupport.FakeBackend) {
+	testsupport.Handle(backend, notificationpb.NotificationService_SendNotification_FullMethodName, s.sendNotification)
+	testsupport.Handle(backend, notificationpb.NotificationService_GetNotifications_FullMethodName, s.getNotifications)
+	testsupport.Handle(backend, notificationpb.NotificationService_MarkNotificationAsRead_FullMethodName, s.markNotificationAsRead)
+	testsupport.Handle(backend, notificationpb.NotificationService_GetUnreadCount_FullMethodName, s.notificationUnreadCount)
+}
+
+// addNotification stores a notification for userID. The caller holds the mutex.
+func (s *store) addNotification(userID, notificationType, title, message, sourceID string, at time.Time) {
+	s.notifications[userID] = append(s.notifications[userID], &notificationpb.Notification{
+		Id:        strconv.FormatUint(s.nextID(), 10),
+		UserId:    userID,
+		Type:      notificationType,
+		Title:     title,
+		Message:   message,
+		SourceId:  sourceID,
+		CreatedAt: timestamppb.New(at),
+	})
+}
+
+func (s *store) sendNotification(_ context.Context, req *notificationpb.SendNotificationRequest) (*notificationpb.SendNotificationResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if req.GetUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	s.addNotification(req.GetUserId(), req.GetType(), req.GetTitle(), req.GetMessage(), req.GetSourceId(), s.now())
+	return &notificationpb.SendNotificationResponse{Success: true}, nil
+}
+
+func (s *store) getNotifications(_ context.Context, req *notificationpb.GetNotificationsRequest) (*notificationpb.GetNotificationsResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	stored := s.notifications[req.GetUserId()]
+	notifications := make([]*notificationpb.Notification, 0, len(stored))
+	for _, notification := range stored {
+		notifications = append(notifications, clone(notification))
+	}
+	sort.SliceStable(notifications, func(i, j int) bool {
+		return notifications[i].GetCreatedAt().AsTime().After(notifications[j].GetCreatedAt().AsTime())
+	})
+	return &notificationpb.GetNotificationsResponse{
+		Notifications: page(notifications, req.GetPage(), req.GetLimit()),
+		Total:         int32(len(notifications)),
+	}, nil
+}
+
+func (s *store) markNotificationAsRead(_ context.Context, req *notificationpb.MarkNotificationAsReadRequest) (*notificationpb.MarkNotificationAsReadResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	for _, notification := range s.notifications[req.GetUserId()] {
+		if notification.GetId() == req.GetNotificationId() {
+			notification.IsRead = true
+			return &notificationpb.MarkNotificationAsReadResponse{Success: true}, nil
+		}
+	}
+	return nil, status.Error(codes.NotFound, "notification not found")
+}
+
+func (s *store) notificationUnreadCount(_ context.Context, req *notificationpb.GetUnreadCountRequest) (*notificationpb.GetUnreadCountResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	var count int64
+	for _, notification := range s.notifications[req.GetUserId()] {
+		if !notification.GetIsRead() {
+			count++
+		}
+	}
+	return &notificationpb.GetUnreadCountResponse{Count: count}, nil
+}
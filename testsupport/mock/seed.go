@@ -0,0 +1,156 @@
+package mock
+
+import (
+	"strconv"
+	"time"
+
+	authpb "github.com/shahal0/skillsync-protos/gen/authpb"
+	chatpb "github.com/shahal0/skillsync-protos/gen/chatpb"
+	jobpb "github.com/shahal0/skillsync-protos/gen/jobpb"
+	notificationpb "github.com/shahal0/skillsync-protos/gen/notificationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// seededAt is when the sample data was "created", so it reads the same on every run
+var seededAt = time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+
+// firstFreeID is above every seeded ID
+const firstFreeID = 1000
+
+func skills(names ...string) []*authpb.Skill {
+	list := make([]*authpb.Skill, 0, len(names))
+	for _, name := range names {
+		list = append(list, &authpb.Skill{Skill: name, Level: "intermediate"})
+	}
+	return list
+}
+
+func jobSkills(names ...string) []*jobpb.JobSkill {
+	list := make([]*jobpb.JobSkill, 0, len(names))
+	for _, name := range names {
+		list = append(list, &jobpb.JobSkill{Skill: name, Proficiency: "intermediate"})
+	}
+	return list
+}
+
+// seed builds the sample data. Job deadlines are set from now so seeded jobs stay
+// open; everything else is fixed.
+func seed(now func() time.Time) *store {
+	s := &store{
+		candidates:    make(map[string]*candidate),
+		employers:     make(map[string]*employer),
+		jobs:          make(map[uint64]*jobpb.Job),
+		applications:  make(map[uint64]*jobpb.ApplicationResponse),
+		conversations: make(map[string]*chatpb.Conversation),
+		messages:      make(map[string][]*chatpb.Message),
+		notifications: make(map[string][]*notificationpb.Notification),
+		lastID:        firstFreeID,
+		now:           now,
+	}
+	created := seededAt.Format(time.RFC3339)
+	deadline := now().AddDate(0, 1, 0).UTC().Format("2006-01-02")
+
+	for _, profile := range []*authpb.CandidateProfileResponse{
+		{Id: "c1", Email: "asha@candidate.mock", Name: "Asha Menon", Experience: 4, Skills: skills("Go", "PostgreSQL", "Docker"),
+			CurrentLocation: "Kochi", PreferredLocation: "Bengaluru", Github: "https://github.com/asha-mock", IsVerified: true},
+		{Id: "c2", Email: "rahul@candidate.mock", Name: "Rahul Nair", Experience: 1, Skills: skills("JavaScript", "React"),
+			CurrentLocation: "Chennai", PreferredLocation: "Remote", IsVerified: true},
+		{Id: "c3", Email: "priya@candidate.mock", Name: "Priya Iyer", Experience: 7, Skills: skills("Python", "Machine Learning", "SQL"),
+			CurrentLocation: "Bengaluru", PreferredLocation: "Bengaluru", IsVerified: true},
+	} {
+		s.candidates[profile.GetId()] = &candidate{profile: profile, password: Password}
+	}
+	for _, profile := range []*authpb.EmployerProfileResponse{
+		{Id: 101, Email: "hiring@acme.mock", CompanyName: "Acme Cloud", Industry: "Software", Location: "Bengaluru",
+			Website: "https://acme.example.com", IsVerified: true},
+		{Id: 102, Email: "jobs@globex.mock", CompanyName: "Globex Analytics", Industry: "Data", Location: "Remote",
+			Website: "https://globex.example.com", IsVerified: true},
+	} {
+		s.employers[employerID(profile.GetId())] = &employer{profile: profile, password: Password}
+	}
+
+	for _, job := range []*jobpb.Job{
+		{Id: 1, EmployerId: "101", Title: "Backend Engineer (Go)", Category: "Engineering", Location: "Bengaluru",
+			Description: "Build the APIs behind our cloud console.", RequiredSkills: jobSkills("Go", "PostgreSQL", "Docker"),
+			SalaryMin: 1800000, SalaryMax: 2800000, ExperienceRequired: 3},
+		{Id: 2, EmployerId: "101", Title: "Frontend Developer", Category: "Engineering", Location: "Remote",
+			Description: "Own the React app our customers use every day.", RequiredSkills: jobSkills("JavaScript", "React", "CSS"),
+			SalaryMin: 1000000, SalaryMax: 1800000, ExperienceRequired: 1},
+		{Id: 3, EmployerId: "101", Title: "DevOps Intern", Category: "Engineering", Location: "Bengaluru",
+			Description: "Six months on our platform team.", RequiredSkills: jobSkills("Docker", "Linux"),
+			SalaryMin: 300000, SalaryMax: 400000},
+		{Id: 4, EmployerId: "102", Title: "Data Scientist", Category: "Data", Location: "Remote",
+			Description: "Model churn and usage for enterprise customers.", RequiredSkills: jobSkills("Python", "Machine Learning", "SQL"),
+			SalaryMin: 2000000, SalaryMax: 3200000, ExperienceRequired: 5},
+		{Id: 5, EmployerId: "102", Title: "Analytics Engineer", Category: "Data", Location: "Chennai",
+			Description: "Turn raw events into dashboards people trust.", RequiredSkills: jobSkills("SQL", "Python"),
+			SalaryMin: 1200000, SalaryMax: 2000000, ExperienceRequired: 2},
+	} {
+		job.Status = "OPEN"
+		job.Deadline = deadline
+		job.CreatedAt, job.UpdatedAt = created, created
+		s.jobs[job.GetId()] = job
+	}
+
+	for _, application := range []*jobpb.ApplicationResponse{
+		{Id: 1, JobId: 1, CandidateId: "c1", Status: "SHORTLISTED"},
+		{Id: 2, JobId: 2, CandidateId: "c2", Status: "APPLIED"},
+		{Id: 3, JobId: 4, CandidateId: "c3", Status: "APPLIED"},
+		{Id: 4, JobId: 5, CandidateId: "c1", Status: "REJECTED"},
+	} {
+		application.ResumeUrl = "https://files.example.com/resumes/" + application.GetCandidateId() + ".pdf"
+		application.AppliedAt = created
+		s.applications[application.GetId()] = application
+	}
+
+	s.addConversation(&chatpb.Conversation{Id: "conv1", JobId: "1", ApplicationId: "1", EmployerId: "101", CandidateId: "c1",
+		JobTitle: "Backend Engineer (Go)", Status: "ACTIVE"},
+		seededMessage("101", chatpb.SenderRole_EMPLOYER, "Hi Asha, thanks for applying! Are you free for a call on Thursday?", 0),
+		seededMessage("c1", chatpb.SenderRole_CANDIDATE, "Thursday works, any time after 2pm.", 1))
+	s.addConversation(&chatpb.Conversation{Id: "conv2", JobId: "4", ApplicationId: "3", EmployerId: "102", CandidateId: "c3",
+		JobTitle: "Data Scientist", Status: "ACTIVE"},
+		seededMessage("102", chatpb.SenderRole_EMPLOYER, "Could you share a project you're proud of?", 0))
+
+	s.seedNotification("c1", "application_status", "Application update", "Your application status changed to SHORTLISTED", "1")
+	s.seedNotification("c1", "new_message", "New message", "Acme Cloud sent you a message", "conv1")
+	s.seedNotification("101", "application_created", "New application", "Asha Menon applied to Backend Engineer (Go)", "1")
+	return s
+}
+
+func seededMessage(senderID string, role chatpb.SenderRole, content string, minutes int) *chatpb.Message {
+	return &chatpb.Message{
+		SenderId:   senderID,
+		SenderRole: role,
+		Content:    content,
+		SentTime:   seededAt.Add(time.Duration(minutes) * time.Minute).Format(time.RFC3339),
+		Status:     chatpb.MessageStatus_READ,
+	}
+}
+
+func (s *store) addConversation(conversation *chatpb.Conversation, messages ...*chatpb.Message) {
+	conversation.CreatedAt = timestamppb.New(seededAt)
+	conversation.UpdatedAt = timestamppb.New(seededAt)
+	for i, message := range messages {
+		message.Id = conversation.GetId() + "-m" + strconv.Itoa(i+1)
+		message.ConversationId = conversation.GetId()
+		message.ReceiverId = conversation.GetCandidateId()
+		if message.GetSenderId() == conversation.GetCandidateId() {
+			message.ReceiverId = conversation.GetEmployerId()
+		}
+		conversation.LastMessage = message
+	}
+	s.conversations[conversation.GetId()] = conversation
+	s.messages[conversation.GetId()] = messages
+}
+
+func (s *store) seedNotification(userID, notificationType, title, message, sourceID string) {
+	s.notifications[userID] = append(s.notifications[userID], &notificationpb.Notification{
+		Id:        userID + "-n" + strconv.Itoa(len(s.notifications[userID])+1),
+		UserId:    userID,
+		Type:      notificationType,
+		Title:     title,
+		Message:   message,
+		SourceId:  sourceID,
+		CreatedAt: timestamppb.New(seededAt),
+	})
+}
@@ -0,0 +1,3659 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: auth.proto
+
+package authpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	AuthService_VerifyToken_FullMethodName                         = "/authpb.AuthService/VerifyToken"
+	AuthService_CandidateSignup_FullMethodName                     = "/authpb.AuthService/CandidateSignup"
+	AuthService_CandidateLogin_FullMethodName                      = "/authpb.AuthService/CandidateLogin"
+	AuthService_CandidateVerifyEmail_FullMethodName                = "/authpb.AuthService/CandidateVerifyEmail"
+	AuthService_CandidateResendOtp_FullMethodName                  = "/authpb.AuthService/CandidateResendOtp"
+	AuthService_CandidateForgotPassword_FullMethodName             = "/authpb.AuthService/CandidateForgotPassword"
+	AuthService_CandidateResetPassword_FullMethodName              = "/authpb.AuthService/CandidateResetPassword"
+	AuthService_CandidateChangePassword_FullMethodName             = "/authpb.AuthService/CandidateChangePassword"
+	AuthService_CandidateProfile_FullMethodName                    = "/authpb.AuthService/CandidateProfile"
+	AuthService_CandidateProfileUpdate_FullMethodName              = "/authpb.AuthService/CandidateProfileUpdate"
+	AuthService_CandidateSkillsUpdate_FullMethodName               = "/authpb.AuthService/CandidateSkillsUpdate"
+	AuthService_CandidateEducationUpdate_FullMethodName            = "/authpb.AuthService/CandidateEducationUpdate"
+	AuthService_CandidateUploadResume_FullMethodName               = "/authpb.AuthService/CandidateUploadResume"
+	AuthService_CandidateGoogleLogin_FullMethodName                = "/authpb.AuthService/CandidateGoogleLogin"
+	AuthService_CandidateGoogleCallback_FullMethodName             = "/authpb.AuthService/CandidateGoogleCallback"
+	AuthService_GetCandidateSkills_FullMethodName                  = "/authpb.AuthService/GetCandidateSkills"
+	AuthService_EmployerSignup_FullMethodName                      = "/authpb.AuthService/EmployerSignup"
+	AuthService_EmployerLogin_FullMethodName                       = "/authpb.AuthService/EmployerLogin"
+	AuthService_EmployerVerifyEmail_FullMethodName                 = "/authpb.AuthService/EmployerVerifyEmail"
+	AuthService_EmployerResendOtp_FullMethodName                   = "/authpb.AuthService/EmployerResendOtp"
+	AuthService_EmployerForgotPassword_FullMethodName              = "/authpb.AuthService/EmployerForgotPassword"
+	AuthService_EmployerResetPassword_FullMethodName               = "/authpb.AuthService/EmployerResetPassword"
+	AuthService_EmployerChangePassword_FullMethodName              = "/authpb.AuthService/EmployerChangePassword"
+	AuthService_EmployerProfile_FullMethodName                     = "/authpb.AuthService/EmployerProfile"
+	AuthService_EmployerProfileById_FullMethodName                 = "/authpb.AuthService/EmployerProfileById"
+	AuthService_EmployerProfileUpdate_FullMethodName               = "/authpb.AuthService/EmployerProfileUpdate"
+	AuthService_EmployerGoogleLogin_FullMethodName                 = "/authpb.AuthService/EmployerGoogleLogin"
+	AuthService_EmployerGoogleCallback_FullMethodName              = "/authpb.AuthService/EmployerGoogleCallback"
+	AuthService_CandidateDeleteAccount_FullMethodName              = "/authpb.AuthService/CandidateDeleteAccount"
+	AuthService_EmployerDeleteAccount_FullMethodName               = "/authpb.AuthService/EmployerDeleteAccount"
+	AuthService_CandidateSendUnlockLink_FullMethodName             = "/authpb.AuthService/CandidateSendUnlockLink"
+	AuthService_EmployerSendUnlockLink_FullMethodName              = "/authpb.AuthService/EmployerSendUnlockLink"
+	AuthService_CandidateVerifyUnlockToken_FullMethodName          = "/authpb.AuthService/CandidateVerifyUnlockToken"
+	AuthService_EmployerVerifyUnlockToken_FullMethodName           = "/authpb.AuthService/EmployerVerifyUnlockToken"
+	AuthService_CandidateRequestEmailChange_FullMethodName         = "/authpb.AuthService/CandidateRequestEmailChange"
+	AuthService_EmployerRequestEmailChange_FullMethodName          = "/authpb.AuthService/EmployerRequestEmailChange"
+	AuthService_CandidateConfirmEmailChange_FullMethodName         = "/authpb.AuthService/CandidateConfirmEmailChange"
+	AuthService_EmployerConfirmEmailChange_FullMethodName          = "/authpb.AuthService/EmployerConfirmEmailChange"
+	AuthService_CandidateOAuthLogin_FullMethodName                 = "/authpb.AuthService/CandidateOAuthLogin"
+	AuthService_EmployerOAuthLogin_FullMethodName                  = "/authpb.AuthService/EmployerOAuthLogin"
+	AuthService_CandidateOAuthCallback_FullMethodName              = "/authpb.AuthService/CandidateOAuthCallback"
+	AuthService_EmployerOAuthCallback_FullMethodName               = "/authpb.AuthService/EmployerOAuthCallback"
+	AuthService_CandidateAddPhone_FullMethodName                   = "/authpb.AuthService/CandidateAddPhone"
+	AuthService_EmployerAddPhone_FullMethodName                    = "/authpb.AuthService/EmployerAddPhone"
+	AuthService_CandidateVerifyPhone_FullMethodName                = "/authpb.AuthService/CandidateVerifyPhone"
+	AuthService_EmployerVerifyPhone_FullMethodName                 = "/authpb.AuthService/EmployerVerifyPhone"
+	AuthService_CandidateRemovePhone_FullMethodName                = "/authpb.AuthService/CandidateRemovePhone"
+	AuthService_EmployerRemovePhone_FullMethodName                 = "/authpb.AuthService/EmployerRemovePhone"
+	AuthService_CandidateListSessions_FullMethodName               = "/authpb.AuthService/CandidateListSessions"
+	AuthService_EmployerListSessions_FullMethodName                = "/authpb.AuthService/EmployerListSessions"
+	AuthService_CandidateRevokeSession_FullMethodName              = "/authpb.AuthService/CandidateRevokeSession"
+	AuthService_EmployerRevokeSession_FullMethodName               = "/authpb.AuthService/EmployerRevokeSession"
+	AuthService_CandidateSetupTwoFactor_FullMethodName             = "/authpb.AuthService/CandidateSetupTwoFactor"
+	AuthService_EmployerSetupTwoFactor_FullMethodName              = "/authpb.AuthService/EmployerSetupTwoFactor"
+	AuthService_CandidateEnableTwoFactor_FullMethodName            = "/authpb.AuthService/CandidateEnableTwoFactor"
+	AuthService_EmployerEnableTwoFactor_FullMethodName             = "/authpb.AuthService/EmployerEnableTwoFactor"
+	AuthService_CandidateDisableTwoFactor_FullMethodName           = "/authpb.AuthService/CandidateDisableTwoFactor"
+	AuthService_EmployerDisableTwoFactor_FullMethodName            = "/authpb.AuthService/EmployerDisableTwoFactor"
+	AuthService_CandidateVerifyTwoFactorLogin_FullMethodName       = "/authpb.AuthService/CandidateVerifyTwoFactorLogin"
+	AuthService_EmployerVerifyTwoFactorLogin_FullMethodName        = "/authpb.AuthService/EmployerVerifyTwoFactorLogin"
+	AuthService_CreateApiKey_FullMethodName                        = "/authpb.AuthService/CreateApiKey"
+	AuthService_ListApiKeys_FullMethodName                         = "/authpb.AuthService/ListApiKeys"
+	AuthService_RevokeApiKey_FullMethodName                        = "/authpb.AuthService/RevokeApiKey"
+	AuthService_GetApiKeyByHash_FullMethodName                     = "/authpb.AuthService/GetApiKeyByHash"
+	AuthService_GetEmployerPlan_FullMethodName                     = "/authpb.AuthService/GetEmployerPlan"
+	AuthService_EmployerUploadLogo_FullMethodName                  = "/authpb.AuthService/EmployerUploadLogo"
+	AuthService_EmployerUploadVerificationDocuments_FullMethodName = "/authpb.AuthService/EmployerUploadVerificationDocuments"
+	AuthService_EmployerVerificationStatus_FullMethodName          = "/authpb.AuthService/EmployerVerificationStatus"
+	AuthService_ListPendingEmployerVerifications_FullMethodName    = "/authpb.AuthService/ListPendingEmployerVerifications"
+	AuthService_ReviewEmployerVerification_FullMethodName          = "/authpb.AuthService/ReviewEmployerVerification"
+	AuthService_GetEmployerPublicProfile_FullMethodName            = "/authpb.AuthService/GetEmployerPublicProfile"
+	AuthService_GetCandidatePublicProfile_FullMethodName           = "/authpb.AuthService/GetCandidatePublicProfile"
+	AuthService_GetCandidateVisibility_FullMethodName              = "/authpb.AuthService/GetCandidateVisibility"
+	AuthService_UpdateCandidateVisibility_FullMethodName           = "/authpb.AuthService/UpdateCandidateVisibility"
+	AuthService_SearchCandidates_FullMethodName                    = "/authpb.AuthService/SearchCandidates"
+	AuthService_SaveCandidate_FullMethodName                       = "/authpb.AuthService/SaveCandidate"
+	AuthService_UnsaveCandidate_FullMethodName                     = "/authpb.AuthService/UnsaveCandidate"
+	AuthService_ListSavedCandidates_FullMethodName                 = "/authpb.AuthService/ListSavedCandidates"
+	AuthService_CreateEmployerReview_FullMethodName                = "/authpb.AuthService/CreateEmployerReview"
+	AuthService_ListEmployerReviews_FullMethodName                 = "/authpb.AuthService/ListEmployerReviews"
+	AuthService_DeleteEmployerReview_FullMethodName                = "/authpb.AuthService/DeleteEmployerReview"
+	AuthService_RemoveEmployerReview_FullMethodName                = "/authpb.AuthService/RemoveEmployerReview"
+	AuthService_EndorseCandidateSkills_FullMethodName              = "/authpb.AuthService/EndorseCandidateSkills"
+	AuthService_ListCandidateEndorsements_FullMethodName           = "/authpb.AuthService/ListCandidateEndorsements"
+	AuthService_RetractEndorsement_FullMethodName                  = "/authpb.AuthService/RetractEndorsement"
+	AuthService_InviteTeamMember_FullMethodName                    = "/authpb.AuthService/InviteTeamMember"
+	AuthService_ListTeamMembers_FullMethodName                     = "/authpb.AuthService/ListTeamMembers"
+	AuthService_UpdateTeamMemberRole_FullMethodName                = "/authpb.AuthService/UpdateTeamMemberRole"
+	AuthService_RemoveTeamMember_FullMethodName                    = "/authpb.AuthService/RemoveTeamMember"
+	AuthService_GetCalendarFeedToken_FullMethodName                = "/authpb.AuthService/GetCalendarFeedToken"
+	AuthService_RotateCalendarFeedToken_FullMethodName             = "/authpb.AuthService/RotateCalendarFeedToken"
+	AuthService_RevokeCalendarFeedToken_FullMethodName             = "/authpb.AuthService/RevokeCalendarFeedToken"
+	AuthService_ListUserIds_FullMethodName                         = "/authpb.AuthService/ListUserIds"
+)
+
+// AuthServiceClient is the client API for AuthService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AuthServiceClient interface {
+	// Token verification
+	VerifyToken(ctx context.Context, in *VerifyTokenRequest, opts ...grpc.CallOption) (*VerifyTokenResponse, error)
+	// Candidate endpoints
+	CandidateSignup(ctx context.Context, in *CandidateSignupRequest, opts ...grpc.CallOption) (*CandidateSignupResponse, error)
+	CandidateLogin(ctx context.Context, in *CandidateLoginRequest, opts ...grpc.CallOption) (*CandidateLoginResponse, error)
+	CandidateVerifyEmail(ctx context.Context, in *VerifyEmailRequest, opts ...grpc.CallOption) (*VerifyEmailResponse, error)
+	CandidateResendOtp(ctx context.Context, in *ResendOtpRequest, opts ...grpc.CallOption) (*ResendOtpResponse, error)
+	CandidateForgotPassword(ctx context.Context, in *ForgotPasswordRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	CandidateResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	CandidateChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	CandidateProfile(ctx context.Context, in *CandidateProfileRequest, opts ...grpc.CallOption) (*CandidateProfileResponse, error)
+	CandidateProfileUpdate(ctx context.Context, in *CandidateProfileUpdateRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	CandidateSkillsUpdate(ctx context.Context, in *SkillsUpdateRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	CandidateEducationUpdate(ctx context.Context, in *EducationUpdateRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	CandidateUploadResume(ctx context.Context, in *UploadResumeRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	CandidateGoogleLogin(ctx context.Context, in *GoogleLoginRequest, opts ...grpc.CallOption) (*AuthResponse, error)
+	CandidateGoogleCallback(ctx context.Context, in *GoogleCallbackRequest, opts ...grpc.CallOption) (*AuthResponse, error)
+	GetCandidateSkills(ctx context.Context, in *GetCandidateSkillsRequest, opts ...grpc.CallOption) (*GetCandidateSkillsResponse, error)
+	// Employer endpoints
+	EmployerSignup(ctx context.Context, in *EmployerSignupRequest, opts ...grpc.CallOption) (*EmployerSignupResponse, error)
+	EmployerLogin(ctx context.Context, in *EmployerLoginRequest, opts ...grpc.CallOption) (*EmployerLoginResponse, error)
+	EmployerVerifyEmail(ctx context.Context, in *VerifyEmailRequest, opts ...grpc.CallOption) (*VerifyEmailResponse, error)
+	EmployerResendOtp(ctx context.Context, in *ResendOtpRequest, opts ...grpc.CallOption) (*ResendOtpResponse, error)
+	EmployerForgotPassword(ctx context.Context, in *ForgotPasswordRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	EmployerResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	EmployerChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	EmployerProfile(ctx context.Context, in *EmployerProfileRequest, opts ...grpc.CallOption) (*EmployerProfileResponse, error)
+	EmployerProfileById(ctx context.Context, in *EmployerProfileByIdRequest, opts ...grpc.CallOption) (*EmployerProfileResponse, error)
+	EmployerProfileUpdate(ctx context.Context, in *EmployerProfileUpdateRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	EmployerGoogleLogin(ctx context.Context, in *GoogleLoginRequest, opts ...grpc.CallOption) (*AuthResponse, error)
+	EmployerGoogleCallback(ctx context.Context, in *GoogleCallbackRequest, opts ...grpc.CallOption) (*AuthResponse, error)
+	// Account deletion
+	CandidateDeleteAccount(ctx context.Context, in *DeleteAccountRequest, opts ...grpc.CallOption) (*DeleteAccountResponse, error)
+	EmployerDeleteAccount(ctx context.Context, in *DeleteAccountRequest, opts ...grpc.CallOption) (*DeleteAccountResponse, error)
+	// Account unlock
+	CandidateSendUnlockLink(ctx context.Context, in *SendUnlockLinkRequest, opts ...grpc.CallOption) (*SendUnlockLinkResponse, error)
+	EmployerSendUnlockLink(ctx context.Context, in *SendUnlockLinkRequest, opts ...grpc.CallOption) (*SendUnlockLinkResponse, error)
+	CandidateVerifyUnlockToken(ctx context.Context, in *VerifyUnlockTokenRequest, opts ...grpc.CallOption) (*VerifyUnlockTokenResponse, error)
+	EmployerVerifyUnlockToken(ctx context.Context, in *VerifyUnlockTokenRequest, opts ...grpc.CallOption) (*VerifyUnlockTokenResponse, error)
+	// Email change
+	CandidateRequestEmailChange(ctx context.Context, in *RequestEmailChangeRequest, opts ...grpc.CallOption) (*RequestEmailChangeResponse, error)
+	EmployerRequestEmailChange(ctx context.Context, in *RequestEmailChangeRequest, opts ...grpc.CallOption) (*RequestEmailChangeResponse, error)
+	CandidateConfirmEmailChange(ctx context.Context, in *ConfirmEmailChangeRequest, opts ...grpc.CallOption) (*ConfirmEmailChangeResponse, error)
+	EmployerConfirmEmailChange(ctx context.Context, in *ConfirmEmailChangeRequest, opts ...grpc.CallOption) (*ConfirmEmailChangeResponse, error)
+	// OAuth
+	CandidateOAuthLogin(ctx context.Context, in *OAuthLoginRequest, opts ...grpc.CallOption) (*OAuthLoginResponse, error)
+	EmployerOAuthLogin(ctx context.Context, in *OAuthLoginRequest, opts ...grpc.CallOption) (*OAuthLoginResponse, error)
+	CandidateOAuthCallback(ctx context.Context, in *OAuthCallbackRequest, opts ...grpc.CallOption) (*OAuthCallbackResponse, error)
+	EmployerOAuthCallback(ctx context.Context, in *OAuthCallbackRequest, opts ...grpc.CallOption) (*OAuthCallbackResponse, error)
+	// Phone numbers
+	CandidateAddPhone(ctx context.Context, in *AddPhoneRequest, opts ...grpc.CallOption) (*AddPhoneResponse, error)
+	EmployerAddPhone(ctx context.Context, in *AddPhoneRequest, opts ...grpc.CallOption) (*AddPhoneResponse, error)
+	CandidateVerifyPhone(ctx context.Context, in *VerifyPhoneRequest, opts ...grpc.CallOption) (*VerifyPhoneResponse, error)
+	EmployerVerifyPhone(ctx context.Context, in *VerifyPhoneRequest, opts ...grpc.CallOption) (*VerifyPhoneResponse, error)
+	CandidateRemovePhone(ctx context.Context, in *RemovePhoneRequest, opts ...grpc.CallOption) (*RemovePhoneResponse, error)
+	EmployerRemovePhone(ctx context.Context, in *RemovePhoneRequest, opts ...grpc.CallOption) (*RemovePhoneResponse, error)
+	// Sessions
+	CandidateListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error)
+	EmployerListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error)
+	CandidateRevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*RevokeSessionResponse, error)
+	EmployerRevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*RevokeSessionResponse, error)
+	// Two-factor authentication
+	CandidateSetupTwoFactor(ctx context.Context, in *SetupTwoFactorRequest, opts ...grpc.CallOption) (*SetupTwoFactorResponse, error)
+	EmployerSetupTwoFactor(ctx context.Context, in *SetupTwoFactorRequest, opts ...grpc.CallOption) (*SetupTwoFactorResponse, error)
+	CandidateEnableTwoFactor(ctx context.Context, in *EnableTwoFactorRequest, opts ...grpc.CallOption) (*EnableTwoFactorResponse, error)
+	EmployerEnableTwoFactor(ctx context.Context, in *EnableTwoFactorRequest, opts ...grpc.CallOption) (*EnableTwoFactorResponse, error)
+	CandidateDisableTwoFactor(ctx context.Context, in *DisableTwoFactorRequest, opts ...grpc.CallOption) (*DisableTwoFactorResponse, error)
+	EmployerDisableTwoFactor(ctx context.Context, in *DisableTwoFactorRequest, opts ...grpc.CallOption) (*DisableTwoFactorResponse, error)
+	CandidateVerifyTwoFactorLogin(ctx context.Context, in *VerifyTwoFactorLoginRequest, opts ...grpc.CallOption) (*VerifyTwoFactorLoginResponse, error)
+	EmployerVerifyTwoFactorLogin(ctx context.Context, in *VerifyTwoFactorLoginRequest, opts ...grpc.CallOption) (*VerifyTwoFactorLoginResponse, error)
+	// API keys
+	CreateApiKey(ctx context.Context, in *CreateApiKeyRequest, opts ...grpc.CallOption) (*CreateApiKeyResponse, error)
+	ListApiKeys(ctx context.Context, in *ListApiKeysRequest, opts ...grpc.CallOption) (*ListApiKeysResponse, error)
+	RevokeApiKey(ctx context.Context, in *RevokeApiKeyRequest, opts ...grpc.CallOption) (*RevokeApiKeyResponse, error)
+	GetApiKeyByHash(ctx context.Context, in *GetApiKeyByHashRequest, opts ...grpc.CallOption) (*GetApiKeyByHashResponse, error)
+	// Employer plans
+	GetEmployerPlan(ctx context.Context, in *GetEmployerPlanRequest, opts ...grpc.CallOption) (*EmployerPlanResponse, error)
+	// Employer logo and verification
+	EmployerUploadLogo(ctx context.Context, in *UploadLogoRequest, opts ...grpc.CallOption) (*UploadLogoResponse, error)
+	EmployerUploadVerificationDocuments(ctx context.Context, in *UploadVerificationDocumentsRequest, opts ...grpc.CallOption) (*VerificationStatusResponse, error)
+	EmployerVerificationStatus(ctx context.Context, in *VerificationStatusRequest, opts ...grpc.CallOption) (*VerificationStatusResponse, error)
+	ListPendingEmployerVerifications(ctx context.Context, in *ListPendingVerificationsRequest, opts ...grpc.CallOption) (*ListPendingVerificationsResponse, error)
+	ReviewEmployerVerification(ctx context.Context, in *ReviewVerificationRequest, opts ...grpc.CallOption) (*VerificationStatusResponse, error)
+	// Public profiles
+	GetEmployerPublicProfile(ctx context.Context, in *EmployerPublicProfileRequest, opts ...grpc.CallOption) (*EmployerPublicProfileResponse, error)
+	GetCandidatePublicProfile(ctx context.Context, in *CandidatePublicProfileRequest, opts ...grpc.CallOption) (*CandidatePublicProfile, error)
+	// Candidate visibility
+	GetCandidateVisibility(ctx context.Context, in *GetCandidateVisibilityRequest, opts ...grpc.CallOption) (*GetCandidateVisibilityResponse, error)
+	UpdateCandidateVisibility(ctx context.Context, in *UpdateCandidateVisibilityRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Candidate search and saved candidates
+	SearchCandidates(ctx context.Context, in *SearchCandidatesRequest, opts ...grpc.CallOption) (*SearchCandidatesResponse, error)
+	SaveCandidate(ctx context.Context, in *SaveCandidateRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	UnsaveCandidate(ctx context.Context, in *UnsaveCandidateRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	ListSavedCandidates(ctx context.Context, in *ListSavedCandidatesRequest, opts ...grpc.CallOption) (*ListSavedCandidatesResponse, error)
+	// Employer reviews
+	CreateEmployerReview(ctx context.Context, in *CreateEmployerReviewRequest, opts ...grpc.CallOption) (*CreateEmployerReviewResponse, error)
+	ListEmployerReviews(ctx context.Context, in *ListEmployerReviewsRequest, opts ...grpc.CallOption) (*ListEmployerReviewsResponse, error)
+	DeleteEmployerReview(ctx context.Context, in *DeleteEmployerReviewRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	RemoveEmployerReview(ctx context.Context, in *RemoveEmployerReviewRequest, opts ...grpc.CallOption) (*RemoveEmployerReviewResponse, error)
+	// Skill endorsements
+	EndorseCandidateSkills(ctx context.Context, in *EndorseCandidateSkillsRequest, opts ...grpc.CallOption) (*EndorseCandidateSkillsResponse, error)
+	ListCandidateEndorsements(ctx context.Context, in *ListCandidateEndorsementsRequest, opts ...grpc.CallOption) (*ListCandidateEndorsementsResponse, error)
+	RetractEndorsement(ctx context.Context, in *RetractEndorsementRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Employer teams
+	InviteTeamMember(ctx context.Context, in *InviteTeamMemberRequest, opts ...grpc.CallOption) (*InviteTeamMemberResponse, error)
+	ListTeamMembers(ctx context.Context, in *ListTeamMembersRequest, opts ...grpc.CallOption) (*ListTeamMembersResponse, error)
+	UpdateTeamMemberRole(ctx context.Context, in *UpdateTeamMemberRoleRequest, opts ...grpc.CallOption) (*UpdateTeamMemberRoleResponse, error)
+	RemoveTeamMember(ctx context.Context, in *RemoveTeamMemberRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Calendar feed tokens
+	GetCalendarFeedToken(ctx context.Context, in *GetCalendarFeedTokenRequest, opts ...grpc.CallOption) (*CalendarFeedTokenResponse, error)
+	RotateCalendarFeedToken(ctx context.Context, in *RotateCalendarFeedTokenRequest, opts ...grpc.CallOption) (*CalendarFeedTokenResponse, error)
+	RevokeCalendarFeedToken(ctx context.Context, in *RevokeCalendarFeedTokenRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	// User listing
+	ListUserIds(ctx context.Context, in *ListUserIdsRequest, opts ...grpc.CallOption) (*ListUserIdsResponse, error)
+}
+
+type authServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAuthServiceClient(cc grpc.ClientConnInterface) AuthServiceClient {
+	return &authServiceClient{cc}
+}
+
+func (c *authServiceClient) VerifyToken(ctx context.Context, in *VerifyTokenRequest, opts ...grpc.CallOption) (*VerifyTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyTokenResponse)
+	err := c.cc.Invoke(ctx, AuthService_VerifyToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) CandidateSignup(ctx context.Context, in *CandidateSignupRequest, opts ...grpc.CallOption) (*CandidateSignupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CandidateSignupResponse)
+	err := c.cc.Invoke(ctx, AuthService_CandidateSignup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) CandidateLogin(ctx context.Context, in *CandidateLoginRequest, opts ...grpc.CallOption) (*CandidateLoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CandidateLoginResponse)
+	err := c.cc.Invoke(ctx, AuthService_CandidateLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) CandidateVerifyEmail(ctx context.Context, in *VerifyEmailRequest, opts ...grpc.CallOption) (*VerifyEmailResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyEmailResponse)
+	err := c.cc.Invoke(ctx, AuthService_CandidateVerifyEmail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) CandidateResendOtp(ctx context.Context, in *ResendOtpRequest, opts ...grpc.CallOption) (*ResendOtpResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResendOtpResponse)
+	err := c.cc.Invoke(ctx, AuthService_CandidateResendOtp_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) CandidateForgotPassword(ctx context.Context, in *ForgotPasswordRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, AuthService_CandidateForgotPassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) CandidateResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, AuthService_CandidateResetPassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) CandidateChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, AuthService_CandidateChangePassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) CandidateProfile(ctx context.Context, in *CandidateProfileRequest, opts ...grpc.CallOption) (*CandidateProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CandidateProfileResponse)
+	err := c.cc.Invoke(ctx, AuthService_CandidateProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) CandidateProfileUpdate(ctx context.Context, in *CandidateProfileUpdateRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, AuthService_CandidateProfileUpdate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) CandidateSkillsUpdate(ctx context.Context, in *SkillsUpdateRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, AuthService_CandidateSkillsUpdate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) CandidateEducationUpdate(ctx context.Context, in *EducationUpdateRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, AuthService_CandidateEducationUpdate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) CandidateUploadResume(ctx context.Context, in *UploadResumeRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, AuthService_CandidateUploadResume_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) CandidateGoogleLogin(ctx context.Context, in *GoogleLoginRequest, opts ...grpc.CallOption) (*AuthResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuthResponse)
+	err := c.cc.Invoke(ctx, AuthService_CandidateGoogleLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) CandidateGoogleCallback(ctx context.Context, in *GoogleCallbackRequest, opts ...grpc.CallOption) (*AuthResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuthResponse)
+	err := c.cc.Invoke(ctx, AuthService_CandidateGoogleCallback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) GetCandidateSkills(ctx context.Context, in *GetCandidateSkillsRequest, opts ...grpc.CallOption) (*GetCandidateSkillsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCandidateSkillsResponse)
+	err := c.cc.Invoke(ctx, AuthService_GetCandidateSkills_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) EmployerSignup(ctx context.Context, in *EmployerSignupRequest, opts ...grpc.CallOption) (*EmployerSignupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmployerSignupResponse)
+	err := c.cc.Invoke(ctx, AuthService_EmployerSignup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) EmployerLogin(ctx context.Context, in *EmployerLoginRequest, opts ...grpc.CallOption) (*EmployerLoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmployerLoginResponse)
+	err := c.cc.Invoke(ctx, AuthService_EmployerLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) EmployerVerifyEmail(ctx context.Context, in *VerifyEmailRequest, opts ...grpc.CallOption) (*VerifyEmailResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyEmailResponse)
+	err := c.cc.Invoke(ctx, AuthService_EmployerVerifyEmail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) EmployerResendOtp(ctx context.Context, in *ResendOtpRequest, opts ...grpc.CallOption) (*ResendOtpResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResendOtpResponse)
+	err := c.cc.Invoke(ctx, AuthService_EmployerResendOtp_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) EmployerForgotPassword(ctx context.Context, in *ForgotPasswordRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, AuthService_EmployerForgotPassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) EmployerResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, AuthService_EmployerResetPassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) EmployerChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, AuthService_EmployerChangePassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) EmployerProfile(ctx context.Context, in *EmployerProfileRequest, opts ...grpc.CallOption) (*EmployerProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmployerProfileResponse)
+	err := c.cc.Invoke(ctx, AuthService_EmployerProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) EmployerProfileById(ctx context.Context, in *EmployerProfileByIdRequest, opts ...grpc.CallOption) (*EmployerProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmployerProfileResponse)
+	err := c.cc.Invoke(ctx, AuthService_EmployerProfileById_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) EmployerProfileUpdate(ctx context.Context, in *EmployerProfileUpdateRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, AuthService_EmployerProfileUpdate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) EmployerGoogleLogin(ctx context.Context, in *GoogleLoginRequest, opts ...grpc.CallOption) (*AuthResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuthResponse)
+	err := c.cc.Invoke(ctx, AuthService_EmployerGoogleLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) EmployerGoogleCallback(ctx context.Context, in *GoogleCallbackRequest, opts ...grpc.CallOption) (*AuthResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuthResponse)
+	err := c.cc.Invoke(ctx, AuthService_EmployerGoogleCallback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) CandidateDeleteAccount(ctx context.Context, in *DeleteAccountRequest, opts ...grpc.CallOption) (*DeleteAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAccountResponse)
+	err := c.cc.Invoke(ctx, AuthService_CandidateDeleteAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) EmployerDeleteAccount(ctx context.Context, in *DeleteAccountRequest, opts ...grpc.CallOption) (*DeleteAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAccountResponse)
+	err := c.cc.Invoke(ctx, AuthService_EmployerDeleteAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) CandidateSendUnlockLink(ctx context.Context, in *SendUnlockLinkRequest, opts ...grpc.CallOption) (*SendUnlockLinkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendUnlockLinkResponse)
+	err := c.cc.Invoke(ctx, AuthService_CandidateSendUnlockLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) EmployerSendUnlockLink(ctx context.Context, in *SendUnlockLinkRequest, opts ...grpc.CallOption) (*SendUnlockLinkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendUnlockLinkResponse)
+	err := c.cc.Invoke(ctx, AuthService_EmployerSendUnlockLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) CandidateVerifyUnlockToken(ctx context.Context, in *VerifyUnlockTokenRequest, opts ...grpc.CallOption) (*VerifyUnlockTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyUnlockTokenResponse)
+	err := c.cc.Invoke(ctx, AuthService_CandidateVerifyUnlockToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) EmployerVerifyUnlockToken(ctx context.Context, in *VerifyUnlockTokenRequest, opts ...grpc.CallOption) (*VerifyUnlockTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyUnlockTokenResponse)
+	err := c.cc.Invoke(ctx, AuthService_EmployerVerifyUnlockToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) CandidateRequestEmailChange(ctx context.Context, in *RequestEmailChangeRequest, opts ...grpc.CallOption) (*RequestEmailChangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestEmailChangeResponse)
+	err := c.cc.Invoke(ctx, AuthService_CandidateRequestEmailChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) EmployerRequestEmailChange(ctx context.Context, in *RequestEmailChangeRequest, opts ...grpc.CallOption) (*RequestEmailChangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestEmailChangeResponse)
+	err := c.cc.Invoke(ctx, AuthService_EmployerRequestEmailChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) CandidateConfirmEmailChange(ctx context.Context, in *ConfirmEmailChangeRequest, opts ...grpc.CallOption) (*ConfirmEmailChangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfirmEmailChangeResponse)
+	err := c.cc.Invoke(ctx, AuthService_CandidateConfirmEmailChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) EmployerConfirmEmailChange(ctx context.Context, in *ConfirmEmailChangeRequest, opts ...grpc.CallOption) (*ConfirmEmailChangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfirmEmailChangeResponse)
+	err := c.cc.Invoke(ctx, AuthService_EmployerConfirmEmailChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) CandidateOAuthLogin(ctx context.Context, in *OAuthLoginRequest, opts ...grpc.CallOption) (*OAuthLoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OAuthLoginResponse)
+	err := c.cc.Invoke(ctx, AuthService_CandidateOAuthLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) EmployerOAuthLogin(ctx context.Context, in *OAuthLoginRequest, opts ...grpc.CallOption) (*OAuthLoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OAuthLoginResponse)
+	err := c.cc.Invoke(ctx, AuthService_EmployerOAuthLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) CandidateOAuthCallback(ctx context.Context, in *OAuthCallbackRequest, opts ...grpc.CallOption) (*OAuthCallbackResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OAuthCallbackResponse)
+	err := c.cc.Invoke(ctx, AuthService_CandidateOAuthCallback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) EmployerOAuthCallback(ctx context.Context, in *OAuthCallbackRequest, opts ...grpc.CallOption) (*OAuthCallbackResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OAuthCallbackResponse)
+	err := c.cc.Invoke(ctx, AuthService_EmployerOAuthCallback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) CandidateAddPhone(ctx context.Context, in *AddPhoneRequest, opts ...grpc.CallOption) (*AddPhoneResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddPhoneResponse)
+	err := c.cc.Invoke(ctx, AuthService_CandidateAddPhone_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) EmployerAddPhone(ctx context.Context, in *AddPhoneRequest, opts ...grpc.CallOption) (*AddPhoneResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddPhoneResponse)
+	err := c.cc.Invoke(ctx, AuthService_EmployerAddPhone_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) CandidateVerifyPhone(ctx context.Context, in *VerifyPhoneRequest, opts ...grpc.CallOption) (*VerifyPhoneResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyPhoneResponse)
+	err := c.cc.Invoke(ctx, AuthService_CandidateVerifyPhone_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) EmployerVerifyPhone(ctx context.Context, in *VerifyPhoneRequest, opts ...grpc.CallOption) (*VerifyPhoneResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyPhoneResponse)
+	err := c.cc.Invoke(ctx, AuthService_EmployerVerifyPhone_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) CandidateRemovePhone(ctx context.Context, in *RemovePhoneRequest, opts ...grpc.CallOption) (*RemovePhoneResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemovePhoneResponse)
+	err := c.cc.Invoke(ctx, AuthService_CandidateRemovePhone_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) EmployerRemovePhone(ctx context.Context, in *RemovePhoneRequest, opts ...grpc.CallOption) (*RemovePhoneResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemovePhoneResponse)
+	err := c.cc.Invoke(ctx, AuthService_EmployerRemovePhone_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) CandidateListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSessionsResponse)
+	err := c.cc.Invoke(ctx, AuthService_CandidateListSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) EmployerListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSessionsResponse)
+	err := c.cc.Invoke(ctx, AuthService_EmployerListSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) CandidateRevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*RevokeSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeSessionResponse)
+	err := c.cc.Invoke(ctx, AuthService_CandidateRevokeSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) EmployerRevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*RevokeSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeSessionResponse)
+	err := c.cc.Invoke(ctx, AuthService_EmployerRevokeSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) CandidateSetupTwoFactor(ctx context.Context, in *SetupTwoFactorRequest, opts ...grpc.CallOption) (*SetupTwoFactorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetupTwoFactorResponse)
+	err := c.cc.Invoke(ctx, AuthService_CandidateSetupTwoFactor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) EmployerSetupTwoFactor(ctx context.Context, in *SetupTwoFactorRequest, opts ...grpc.CallOption) (*SetupTwoFactorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetupTwoFactorResponse)
+	err := c.cc.Invoke(ctx, AuthService_EmployerSetupTwoFactor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) CandidateEnableTwoFactor(ctx context.Context, in *EnableTwoFactorRequest, opts ...grpc.CallOption) (*EnableTwoFactorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EnableTwoFactorResponse)
+	err := c.cc.Invoke(ctx, AuthService_CandidateEnableTwoFactor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) EmployerEnableTwoFactor(ctx context.Context, in *EnableTwoFactorRequest, opts ...grpc.CallOption) (*EnableTwoFactorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EnableTwoFactorResponse)
+	err := c.cc.Invoke(ctx, AuthService_EmployerEnableTwoFactor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) CandidateDisableTwoFactor(ctx context.Context, in *DisableTwoFactorRequest, opts ...grpc.CallOption) (*DisableTwoFactorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DisableTwoFactorResponse)
+	err := c.cc.Invoke(ctx, AuthService_CandidateDisableTwoFactor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) EmployerDisableTwoFactor(ctx context.Context, in *DisableTwoFactorRequest, opts ...grpc.CallOption) (*DisableTwoFactorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DisableTwoFactorResponse)
+	err := c.cc.Invoke(ctx, AuthService_EmployerDisableTwoFactor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) CandidateVerifyTwoFactorLogin(ctx context.Context, in *VerifyTwoFactorLoginRequest, opts ...grpc.CallOption) (*VerifyTwoFactorLoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyTwoFactorLoginResponse)
+	err := c.cc.Invoke(ctx, AuthService_CandidateVerifyTwoFactorLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) EmployerVerifyTwoFactorLogin(ctx context.Context, in *VerifyTwoFactorLoginRequest, opts ...grpc.CallOption) (*VerifyTwoFactorLoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyTwoFactorLoginResponse)
+	err := c.cc.Invoke(ctx, AuthService_EmployerVerifyTwoFactorLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) CreateApiKey(ctx context.Context, in *CreateApiKeyRequest, opts ...grpc.CallOption) (*CreateApiKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateApiKeyResponse)
+	err := c.cc.Invoke(ctx, AuthService_CreateApiKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ListApiKeys(ctx context.Context, in *ListApiKeysRequest, opts ...grpc.CallOption) (*ListApiKeysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListApiKeysResponse)
+	err := c.cc.Invoke(ctx, AuthService_ListApiKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RevokeApiKey(ctx context.Context, in *RevokeApiKeyRequest, opts ...grpc.CallOption) (*RevokeApiKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeApiKeyResponse)
+	err := c.cc.Invoke(ctx, AuthService_RevokeApiKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) GetApiKeyByHash(ctx context.Context, in *GetApiKeyByHashRequest, opts ...grpc.CallOption) (*GetApiKeyByHashResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetApiKeyByHashResponse)
+	err := c.cc.Invoke(ctx, AuthService_GetApiKeyByHash_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) GetEmployerPlan(ctx context.Context, in *GetEmployerPlanRequest, opts ...grpc.CallOption) (*EmployerPlanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmployerPlanResponse)
+	err := c.cc.Invoke(ctx, AuthService_GetEmployerPlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) EmployerUploadLogo(ctx context.Context, in *UploadLogoRequest, opts ...grpc.CallOption) (*UploadLogoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UploadLogoResponse)
+	err := c.cc.Invoke(ctx, AuthService_EmployerUploadLogo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) EmployerUploadVerificationDocuments(ctx context.Context, in *UploadVerificationDocumentsRequest, opts ...grpc.CallOption) (*VerificationStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerificationStatusResponse)
+	err := c.cc.Invoke(ctx, AuthService_EmployerUploadVerificationDocuments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) EmployerVerificationStatus(ctx context.Context, in *VerificationStatusRequest, opts ...grpc.CallOption) (*VerificationStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerificationStatusResponse)
+	err := c.cc.Invoke(ctx, AuthService_EmployerVerificationStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ListPendingEmployerVerifications(ctx context.Context, in *ListPendingVerificationsRequest, opts ...grpc.CallOption) (*ListPendingVerificationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPendingVerificationsResponse)
+	err := c.cc.Invoke(ctx, AuthService_ListPendingEmployerVerifications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ReviewEmployerVerification(ctx context.Context, in *ReviewVerificationRequest, opts ...grpc.CallOption) (*VerificationStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerificationStatusResponse)
+	err := c.cc.Invoke(ctx, AuthService_ReviewEmployerVerification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) GetEmployerPublicProfile(ctx context.Context, in *EmployerPublicProfileRequest, opts ...grpc.CallOption) (*EmployerPublicProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmployerPublicProfileResponse)
+	err := c.cc.Invoke(ctx, AuthService_GetEmployerPublicProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) GetCandidatePublicProfile(ctx context.Context, in *CandidatePublicProfileRequest, opts ...grpc.CallOption) (*CandidatePublicProfile, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CandidatePublicProfile)
+	err := c.cc.Invoke(ctx, AuthService_GetCandidatePublicProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) GetCandidateVisibility(ctx context.Context, in *GetCandidateVisibilityRequest, opts ...grpc.CallOption) (*GetCandidateVisibilityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCandidateVisibilityResponse)
+	err := c.cc.Invoke(ctx, AuthService_GetCandidateVisibility_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) UpdateCandidateVisibility(ctx context.Context, in *UpdateCandidateVisibilityRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, AuthService_UpdateCandidateVisibility_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) SearchCandidates(ctx context.Context, in *SearchCandidatesRequest, opts ...grpc.CallOption) (*SearchCandidatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchCandidatesResponse)
+	err := c.cc.Invoke(ctx, AuthService_SearchCandidates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) SaveCandidate(ctx context.Context, in *SaveCandidateRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, AuthService_SaveCandidate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) UnsaveCandidate(ctx context.Context, in *UnsaveCandidateRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, AuthService_UnsaveCandidate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ListSavedCandidates(ctx context.Context, in *ListSavedCandidatesRequest, opts ...grpc.CallOption) (*ListSavedCandidatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSavedCandidatesResponse)
+	err := c.cc.Invoke(ctx, AuthService_ListSavedCandidates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) CreateEmployerReview(ctx context.Context, in *CreateEmployerReviewRequest, opts ...grpc.CallOption) (*CreateEmployerReviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateEmployerReviewResponse)
+	err := c.cc.Invoke(ctx, AuthService_CreateEmployerReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ListEmployerReviews(ctx context.Context, in *ListEmployerReviewsRequest, opts ...grpc.CallOption) (*ListEmployerReviewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEmployerReviewsResponse)
+	err := c.cc.Invoke(ctx, AuthService_ListEmployerReviews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) DeleteEmployerReview(ctx context.Context, in *DeleteEmployerReviewRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, AuthService_DeleteEmployerReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RemoveEmployerReview(ctx context.Context, in *RemoveEmployerReviewRequest, opts ...grpc.CallOption) (*RemoveEmployerReviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveEmployerReviewResponse)
+	err := c.cc.Invoke(ctx, AuthService_RemoveEmployerReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) EndorseCandidateSkills(ctx context.Context, in *EndorseCandidateSkillsRequest, opts ...grpc.CallOption) (*EndorseCandidateSkillsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EndorseCandidateSkillsResponse)
+	err := c.cc.Invoke(ctx, AuthService_EndorseCandidateSkills_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ListCandidateEndorsements(ctx context.Context, in *ListCandidateEndorsementsRequest, opts ...grpc.CallOption) (*ListCandidateEndorsementsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCandidateEndorsementsResponse)
+	err := c.cc.Invoke(ctx, AuthService_ListCandidateEndorsements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RetractEndorsement(ctx context.Context, in *RetractEndorsementRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, AuthService_RetractEndorsement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) InviteTeamMember(ctx context.Context, in *InviteTeamMemberRequest, opts ...grpc.CallOption) (*InviteTeamMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InviteTeamMemberResponse)
+	err := c.cc.Invoke(ctx, AuthService_InviteTeamMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ListTeamMembers(ctx context.Context, in *ListTeamMembersRequest, opts ...grpc.CallOption) (*ListTeamMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTeamMembersResponse)
+	err := c.cc.Invoke(ctx, AuthService_ListTeamMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) UpdateTeamMemberRole(ctx context.Context, in *UpdateTeamMemberRoleRequest, opts ...grpc.CallOption) (*UpdateTeamMemberRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateTeamMemberRoleResponse)
+	err := c.cc.Invoke(ctx, AuthService_UpdateTeamMemberRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RemoveTeamMember(ctx context.Context, in *RemoveTeamMemberRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, AuthService_RemoveTeamMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) GetCalendarFeedToken(ctx context.Context, in *GetCalendarFeedTokenRequest, opts ...grpc.CallOption) (*CalendarFeedTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CalendarFeedTokenResponse)
+	err := c.cc.Invoke(ctx, AuthService_GetCalendarFeedToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RotateCalendarFeedToken(ctx context.Context, in *RotateCalendarFeedTokenRequest, opts ...grpc.CallOption) (*CalendarFeedTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CalendarFeedTokenResponse)
+	err := c.cc.Invoke(ctx, AuthService_RotateCalendarFeedToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RevokeCalendarFeedToken(ctx context.Context, in *RevokeCalendarFeedTokenRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, AuthService_RevokeCalendarFeedToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ListUserIds(ctx context.Context, in *ListUserIdsRequest, opts ...grpc.CallOption) (*ListUserIdsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUserIdsResponse)
+	err := c.cc.Invoke(ctx, AuthService_ListUserIds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AuthServiceServer is the server API for AuthService service.
+// All implementations must embed UnimplementedAuthServiceServer
+// for forward compatibility.
+type AuthServiceServer interface {
+	// Token verification
+	VerifyToken(context.Context, *VerifyTokenRequest) (*VerifyTokenResponse, error)
+	// Candidate endpoints
+	CandidateSignup(context.Context, *CandidateSignupRequest) (*CandidateSignupResponse, error)
+	CandidateLogin(context.Context, *CandidateLoginRequest) (*CandidateLoginResponse, error)
+	CandidateVerifyEmail(context.Context, *VerifyEmailRequest) (*VerifyEmailResponse, error)
+	CandidateResendOtp(context.Context, *ResendOtpRequest) (*ResendOtpResponse, error)
+	CandidateForgotPassword(context.Context, *ForgotPasswordRequest) (*GenericResponse, error)
+	CandidateResetPassword(context.Context, *ResetPasswordRequest) (*GenericResponse, error)
+	CandidateChangePassword(context.Context, *ChangePasswordRequest) (*GenericResponse, error)
+	CandidateProfile(context.Context, *CandidateProfileRequest) (*CandidateProfileResponse, error)
+	CandidateProfileUpdate(context.Context, *CandidateProfileUpdateRequest) (*GenericResponse, error)
+	CandidateSkillsUpdate(context.Context, *SkillsUpdateRequest) (*GenericResponse, error)
+	CandidateEducationUpdate(context.Context, *EducationUpdateRequest) (*GenericResponse, error)
+	CandidateUploadResume(context.Context, *UploadResumeRequest) (*GenericResponse, error)
+	CandidateGoogleLogin(context.Context, *GoogleLoginRequest) (*AuthResponse, error)
+	CandidateGoogleCallback(context.Context, *GoogleCallbackRequest) (*AuthResponse, error)
+	GetCandidateSkills(context.Context, *GetCandidateSkillsRequest) (*GetCandidateSkillsResponse, error)
+	// Employer endpoints
+	EmployerSignup(context.Context, *EmployerSignupRequest) (*EmployerSignupResponse, error)
+	EmployerLogin(context.Context, *EmployerLoginRequest) (*EmployerLoginResponse, error)
+	EmployerVerifyEmail(context.Context, *VerifyEmailRequest) (*VerifyEmailResponse, error)
+	EmployerResendOtp(context.Context, *ResendOtpRequest) (*ResendOtpResponse, error)
+	EmployerForgotPassword(context.Context, *ForgotPasswordRequest) (*GenericResponse, error)
+	EmployerResetPassword(context.Context, *ResetPasswordRequest) (*GenericResponse, error)
+	EmployerChangePassword(context.Context, *ChangePasswordRequest) (*GenericResponse, error)
+	EmployerProfile(context.Context, *EmployerProfileRequest) (*EmployerProfileResponse, error)
+	EmployerProfileById(context.Context, *EmployerProfileByIdRequest) (*EmployerProfileResponse, error)
+	EmployerProfileUpdate(context.Context, *EmployerProfileUpdateRequest) (*GenericResponse, error)
+	EmployerGoogleLogin(context.Context, *GoogleLoginRequest) (*AuthResponse, error)
+	EmployerGoogleCallback(context.Context, *GoogleCallbackRequest) (*AuthResponse, error)
+	// Account deletion
+	CandidateDeleteAccount(context.Context, *DeleteAccountRequest) (*DeleteAccountResponse, error)
+	EmployerDeleteAccount(context.Context, *DeleteAccountRequest) (*DeleteAccountResponse, error)
+	// Account unlock
+	CandidateSendUnlockLink(context.Context, *SendUnlockLinkRequest) (*SendUnlockLinkResponse, error)
+	EmployerSendUnlockLink(context.Context, *SendUnlockLinkRequest) (*SendUnlockLinkResponse, error)
+	CandidateVerifyUnlockToken(context.Context, *VerifyUnlockTokenRequest) (*VerifyUnlockTokenResponse, error)
+	EmployerVerifyUnlockToken(context.Context, *VerifyUnlockTokenRequest) (*VerifyUnlockTokenResponse, error)
+	// Email change
+	CandidateRequestEmailChange(context.Context, *RequestEmailChangeRequest) (*RequestEmailChangeResponse, error)
+	EmployerRequestEmailChange(context.Context, *RequestEmailChangeRequest) (*RequestEmailChangeResponse, error)
+	CandidateConfirmEmailChange(context.Context, *ConfirmEmailChangeRequest) (*ConfirmEmailChangeResponse, error)
+	EmployerConfirmEmailChange(context.Context, *ConfirmEmailChangeRequest) (*ConfirmEmailChangeResponse, error)
+	// OAuth
+	CandidateOAuthLogin(context.Context, *OAuthLoginRequest) (*OAuthLoginResponse, error)
+	EmployerOAuthLogin(context.Context, *OAuthLoginRequest) (*OAuthLoginResponse, error)
+	CandidateOAuthCallback(context.Context, *OAuthCallbackRequest) (*OAuthCallbackResponse, error)
+	EmployerOAuthCallback(context.Context, *OAuthCallbackRequest) (*OAuthCallbackResponse, error)
+	// Phone numbers
+	CandidateAddPhone(context.Context, *AddPhoneRequest) (*AddPhoneResponse, error)
+	EmployerAddPhone(context.Context, *AddPhoneRequest) (*AddPhoneResponse, error)
+	CandidateVerifyPhone(context.Context, *VerifyPhoneRequest) (*VerifyPhoneResponse, error)
+	EmployerVerifyPhone(context.Context, *VerifyPhoneRequest) (*VerifyPhoneResponse, error)
+	CandidateRemovePhone(context.Context, *RemovePhoneRequest) (*RemovePhoneResponse, error)
+	EmployerRemovePhone(context.Context, *RemovePhoneRequest) (*RemovePhoneResponse, error)
+	// Sessions
+	CandidateListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error)
+	EmployerListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error)
+	CandidateRevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionResponse, error)
+	EmployerRevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionResponse, error)
+	// Two-factor authentication
+	CandidateSetupTwoFactor(context.Context, *SetupTwoFactorRequest) (*SetupTwoFactorResponse, error)
+	EmployerSetupTwoFactor(context.Context, *SetupTwoFactorRequest) (*SetupTwoFactorResponse, error)
+	CandidateEnableTwoFactor(context.Context, *EnableTwoFactorRequest) (*EnableTwoFactorResponse, error)
+	EmployerEnableTwoFactor(context.Context, *EnableTwoFactorRequest) (*EnableTwoFactorResponse, error)
+	CandidateDisableTwoFactor(context.Context, *DisableTwoFactorRequest) (*DisableTwoFactorResponse, error)
+	EmployerDisableTwoFactor(context.Context, *DisableTwoFactorRequest) (*DisableTwoFactorResponse, error)
+	CandidateVerifyTwoFactorLogin(context.Context, *VerifyTwoFactorLoginRequest) (*VerifyTwoFactorLoginResponse, error)
+	EmployerVerifyTwoFactorLogin(context.Context, *VerifyTwoFactorLoginRequest) (*VerifyTwoFactorLoginResponse, error)
+	// API keys
+	CreateApiKey(context.Context, *CreateApiKeyRequest) (*CreateApiKeyResponse, error)
+	ListApiKeys(context.Context, *ListApiKeysRequest) (*ListApiKeysResponse, error)
+	RevokeApiKey(context.Context, *RevokeApiKeyRequest) (*RevokeApiKeyResponse, error)
+	GetApiKeyByHash(context.Context, *GetApiKeyByHashRequest) (*GetApiKeyByHashResponse, error)
+	// Employer plans
+	GetEmployerPlan(context.Context, *GetEmployerPlanRequest) (*EmployerPlanResponse, error)
+	// Employer logo and verification
+	EmployerUploadLogo(context.Context, *UploadLogoRequest) (*UploadLogoResponse, error)
+	EmployerUploadVerificationDocuments(context.Context, *UploadVerificationDocumentsRequest) (*VerificationStatusResponse, error)
+	EmployerVerificationStatus(context.Context, *VerificationStatusRequest) (*VerificationStatusResponse, error)
+	ListPendingEmployerVerifications(context.Context, *ListPendingVerificationsRequest) (*ListPendingVerificationsResponse, error)
+	ReviewEmployerVerification(context.Context, *ReviewVerificationRequest) (*VerificationStatusResponse, error)
+	// Public profiles
+	GetEmployerPublicProfile(context.Context, *EmployerPublicProfileRequest) (*EmployerPublicProfileResponse, error)
+	GetCandidatePublicProfile(context.Context, *CandidatePublicProfileRequest) (*CandidatePublicProfile, error)
+	// Candidate visibility
+	GetCandidateVisibility(context.Context, *GetCandidateVisibilityRequest) (*GetCandidateVisibilityResponse, error)
+	UpdateCandidateVisibility(context.Context, *UpdateCandidateVisibilityRequest) (*GenericResponse, error)
+	// Candidate search and saved candidates
+	SearchCandidates(context.Context, *SearchCandidatesRequest) (*SearchCandidatesResponse, error)
+	SaveCandidate(context.Context, *SaveCandidateRequest) (*GenericResponse, error)
+	UnsaveCandidate(context.Context, *UnsaveCandidateRequest) (*GenericResponse, error)
+	ListSavedCandidates(context.Context, *ListSavedCandidatesRequest) (*ListSavedCandidatesResponse, error)
+	// Employer reviews
+	CreateEmployerReview(context.Context, *CreateEmployerReviewRequest) (*CreateEmployerReviewResponse, error)
+	ListEmployerReviews(context.Context, *ListEmployerReviewsRequest) (*ListEmployerReviewsResponse, error)
+	DeleteEmployerReview(context.Context, *DeleteEmployerReviewRequest) (*GenericResponse, error)
+	RemoveEmployerReview(context.Context, *RemoveEmployerReviewRequest) (*RemoveEmployerReviewResponse, error)
+	// Skill endorsements
+	EndorseCandidateSkills(context.Context, *EndorseCandidateSkillsRequest) (*EndorseCandidateSkillsResponse, error)
+	ListCandidateEndorsements(context.Context, *ListCandidateEndorsementsRequest) (*ListCandidateEndorsementsResponse, error)
+	RetractEndorsement(context.Context, *RetractEndorsementRequest) (*GenericResponse, error)
+	// Employer teams
+	InviteTeamMember(context.Context, *InviteTeamMemberRequest) (*InviteTeamMemberResponse, error)
+	ListTeamMembers(context.Context, *ListTeamMembersRequest) (*ListTeamMembersResponse, error)
+	UpdateTeamMemberRole(context.Context, *UpdateTeamMemberRoleRequest) (*UpdateTeamMemberRoleResponse, error)
+	RemoveTeamMember(context.Context, *RemoveTeamMemberRequest) (*GenericResponse, error)
+	// Calendar feed tokens
+	GetCalendarFeedToken(context.Context, *GetCalendarFeedTokenRequest) (*CalendarFeedTokenResponse, error)
+	RotateCalendarFeedToken(context.Context, *RotateCalendarFeedTokenRequest) (*CalendarFeedTokenResponse, error)
+	RevokeCalendarFeedToken(context.Context, *RevokeCalendarFeedTokenRequest) (*GenericResponse, error)
+	// User listing
+	ListUserIds(context.Context, *ListUserIdsRequest) (*ListUserIdsResponse, error)
+	mustEmbedUnimplementedAuthServiceServer()
+}
+
+// UnimplementedAuthServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAuthServiceServer struct{}
+
+func (UnimplementedAuthServiceServer) VerifyToken(context.Context, *VerifyTokenRequest) (*VerifyTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyToken not implemented")
+}
+func (UnimplementedAuthServiceServer) CandidateSignup(context.Context, *CandidateSignupRequest) (*CandidateSignupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CandidateSignup not implemented")
+}
+func (UnimplementedAuthServiceServer) CandidateLogin(context.Context, *CandidateLoginRequest) (*CandidateLoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CandidateLogin not implemented")
+}
+func (UnimplementedAuthServiceServer) CandidateVerifyEmail(context.Context, *VerifyEmailRequest) (*VerifyEmailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CandidateVerifyEmail not implemented")
+}
+func (UnimplementedAuthServiceServer) CandidateResendOtp(context.Context, *ResendOtpRequest) (*ResendOtpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CandidateResendOtp not implemented")
+}
+func (UnimplementedAuthServiceServer) CandidateForgotPassword(context.Context, *ForgotPasswordRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CandidateForgotPassword not implemented")
+}
+func (UnimplementedAuthServiceServer) CandidateResetPassword(context.Context, *ResetPasswordRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CandidateResetPassword not implemented")
+}
+func (UnimplementedAuthServiceServer) CandidateChangePassword(context.Context, *ChangePasswordRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CandidateChangePassword not implemented")
+}
+func (UnimplementedAuthServiceServer) CandidateProfile(context.Context, *CandidateProfileRequest) (*CandidateProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CandidateProfile not implemented")
+}
+func (UnimplementedAuthServiceServer) CandidateProfileUpdate(context.Context, *CandidateProfileUpdateRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CandidateProfileUpdate not implemented")
+}
+func (UnimplementedAuthServiceServer) CandidateSkillsUpdate(context.Context, *SkillsUpdateRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CandidateSkillsUpdate not implemented")
+}
+func (UnimplementedAuthServiceServer) CandidateEducationUpdate(context.Context, *EducationUpdateRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CandidateEducationUpdate not implemented")
+}
+func (UnimplementedAuthServiceServer) CandidateUploadResume(context.Context, *UploadResumeRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CandidateUploadResume not implemented")
+}
+func (UnimplementedAuthServiceServer) CandidateGoogleLogin(context.Context, *GoogleLoginRequest) (*AuthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CandidateGoogleLogin not implemented")
+}
+func (UnimplementedAuthServiceServer) CandidateGoogleCallback(context.Context, *GoogleCallbackRequest) (*AuthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CandidateGoogleCallback not implemented")
+}
+func (UnimplementedAuthServiceServer) GetCandidateSkills(context.Context, *GetCandidateSkillsRequest) (*GetCandidateSkillsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCandidateSkills not implemented")
+}
+func (UnimplementedAuthServiceServer) EmployerSignup(context.Context, *EmployerSignupRequest) (*EmployerSignupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmployerSignup not implemented")
+}
+func (UnimplementedAuthServiceServer) EmployerLogin(context.Context, *EmployerLoginRequest) (*EmployerLoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmployerLogin not implemented")
+}
+func (UnimplementedAuthServiceServer) EmployerVerifyEmail(context.Context, *VerifyEmailRequest) (*VerifyEmailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmployerVerifyEmail not implemented")
+}
+func (UnimplementedAuthServiceServer) EmployerResendOtp(context.Context, *ResendOtpRequest) (*ResendOtpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmployerResendOtp not implemented")
+}
+func (UnimplementedAuthServiceServer) EmployerForgotPassword(context.Context, *ForgotPasswordRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmployerForgotPassword not implemented")
+}
+func (UnimplementedAuthServiceServer) EmployerResetPassword(context.Context, *ResetPasswordRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmployerResetPassword not implemented")
+}
+func (UnimplementedAuthServiceServer) EmployerChangePassword(context.Context, *ChangePasswordRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmployerChangePassword not implemented")
+}
+func (UnimplementedAuthServiceServer) EmployerProfile(context.Context, *EmployerProfileRequest) (*EmployerProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmployerProfile not implemented")
+}
+func (UnimplementedAuthServiceServer) EmployerProfileById(context.Context, *EmployerProfileByIdRequest) (*EmployerProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmployerProfileById not implemented")
+}
+func (UnimplementedAuthServiceServer) EmployerProfileUpdate(context.Context, *EmployerProfileUpdateRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmployerProfileUpdate not implemented")
+}
+func (UnimplementedAuthServiceServer) EmployerGoogleLogin(context.Context, *GoogleLoginRequest) (*AuthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmployerGoogleLogin not implemented")
+}
+func (UnimplementedAuthServiceServer) EmployerGoogleCallback(context.Context, *GoogleCallbackRequest) (*AuthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmployerGoogleCallback not implemented")
+}
+func (UnimplementedAuthServiceServer) CandidateDeleteAccount(context.Context, *DeleteAccountRequest) (*DeleteAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CandidateDeleteAccount not implemented")
+}
+func (UnimplementedAuthServiceServer) EmployerDeleteAccount(context.Context, *DeleteAccountRequest) (*DeleteAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmployerDeleteAccount not implemented")
+}
+func (UnimplementedAuthServiceServer) CandidateSendUnlockLink(context.Context, *SendUnlockLinkRequest) (*SendUnlockLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CandidateSendUnlockLink not implemented")
+}
+func (UnimplementedAuthServiceServer) EmployerSendUnlockLink(context.Context, *SendUnlockLinkRequest) (*SendUnlockLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmployerSendUnlockLink not implemented")
+}
+func (UnimplementedAuthServiceServer) CandidateVerifyUnlockToken(context.Context, *VerifyUnlockTokenRequest) (*VerifyUnlockTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CandidateVerifyUnlockToken not implemented")
+}
+func (UnimplementedAuthServiceServer) EmployerVerifyUnlockToken(context.Context, *VerifyUnlockTokenRequest) (*VerifyUnlockTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmployerVerifyUnlockToken not implemented")
+}
+func (UnimplementedAuthServiceServer) CandidateRequestEmailChange(context.Context, *RequestEmailChangeRequest) (*RequestEmailChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CandidateRequestEmailChange not implemented")
+}
+func (UnimplementedAuthServiceServer) EmployerRequestEmailChange(context.Context, *RequestEmailChangeRequest) (*RequestEmailChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmployerRequestEmailChange not implemented")
+}
+func (UnimplementedAuthServiceServer) CandidateConfirmEmailChange(context.Context, *ConfirmEmailChangeRequest) (*ConfirmEmailChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CandidateConfirmEmailChange not implemented")
+}
+func (UnimplementedAuthServiceServer) EmployerConfirmEmailChange(context.Context, *ConfirmEmailChangeRequest) (*ConfirmEmailChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmployerConfirmEmailChange not implemented")
+}
+func (UnimplementedAuthServiceServer) CandidateOAuthLogin(context.Context, *OAuthLoginRequest) (*OAuthLoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CandidateOAuthLogin not implemented")
+}
+func (UnimplementedAuthServiceServer) EmployerOAuthLogin(context.Context, *OAuthLoginRequest) (*OAuthLoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmployerOAuthLogin not implemented")
+}
+func (UnimplementedAuthServiceServer) CandidateOAuthCallback(context.Context, *OAuthCallbackRequest) (*OAuthCallbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CandidateOAuthCallback not implemented")
+}
+func (UnimplementedAuthServiceServer) EmployerOAuthCallback(context.Context, *OAuthCallbackRequest) (*OAuthCallbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmployerOAuthCallback not implemented")
+}
+func (UnimplementedAuthServiceServer) CandidateAddPhone(context.Context, *AddPhoneRequest) (*AddPhoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CandidateAddPhone not implemented")
+}
+func (UnimplementedAuthServiceServer) EmployerAddPhone(context.Context, *AddPhoneRequest) (*AddPhoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmployerAddPhone not implemented")
+}
+func (UnimplementedAuthServiceServer) CandidateVerifyPhone(context.Context, *VerifyPhoneRequest) (*VerifyPhoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CandidateVerifyPhone not implemented")
+}
+func (UnimplementedAuthServiceServer) EmployerVerifyPhone(context.Context, *VerifyPhoneRequest) (*VerifyPhoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmployerVerifyPhone not implemented")
+}
+func (UnimplementedAuthServiceServer) CandidateRemovePhone(context.Context, *RemovePhoneRequest) (*RemovePhoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CandidateRemovePhone not implemented")
+}
+func (UnimplementedAuthServiceServer) EmployerRemovePhone(context.Context, *RemovePhoneRequest) (*RemovePhoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmployerRemovePhone not implemented")
+}
+func (UnimplementedAuthServiceServer) CandidateListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CandidateListSessions not implemented")
+}
+func (UnimplementedAuthServiceServer) EmployerListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmployerListSessions not implemented")
+}
+func (UnimplementedAuthServiceServer) CandidateRevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CandidateRevokeSession not implemented")
+}
+func (UnimplementedAuthServiceServer) EmployerRevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmployerRevokeSession not implemented")
+}
+func (UnimplementedAuthServiceServer) CandidateSetupTwoFactor(context.Context, *SetupTwoFactorRequest) (*SetupTwoFactorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CandidateSetupTwoFactor not implemented")
+}
+func (UnimplementedAuthServiceServer) EmployerSetupTwoFactor(context.Context, *SetupTwoFactorRequest) (*SetupTwoFactorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmployerSetupTwoFactor not implemented")
+}
+func (UnimplementedAuthServiceServer) CandidateEnableTwoFactor(context.Context, *EnableTwoFactorRequest) (*EnableTwoFactorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CandidateEnableTwoFactor not implemented")
+}
+func (UnimplementedAuthServiceServer) EmployerEnableTwoFactor(context.Context, *EnableTwoFactorRequest) (*EnableTwoFactorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmployerEnableTwoFactor not implemented")
+}
+func (UnimplementedAuthServiceServer) CandidateDisableTwoFactor(context.Context, *DisableTwoFactorRequest) (*DisableTwoFactorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CandidateDisableTwoFactor not implemented")
+}
+func (UnimplementedAuthServiceServer) EmployerDisableTwoFactor(context.Context, *DisableTwoFactorRequest) (*DisableTwoFactorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmployerDisableTwoFactor not implemented")
+}
+func (UnimplementedAuthServiceServer) CandidateVerifyTwoFactorLogin(context.Context, *VerifyTwoFactorLoginRequest) (*VerifyTwoFactorLoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CandidateVerifyTwoFactorLogin not implemented")
+}
+func (UnimplementedAuthServiceServer) EmployerVerifyTwoFactorLogin(context.Context, *VerifyTwoFactorLoginRequest) (*VerifyTwoFactorLoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmployerVerifyTwoFactorLogin not implemented")
+}
+func (UnimplementedAuthServiceServer) CreateApiKey(context.Context, *CreateApiKeyRequest) (*CreateApiKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateApiKey not implemented")
+}
+func (UnimplementedAuthServiceServer) ListApiKeys(context.Context, *ListApiKeysRequest) (*ListApiKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListApiKeys not implemented")
+}
+func (UnimplementedAuthServiceServer) RevokeApiKey(context.Context, *RevokeApiKeyRequest) (*RevokeApiKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeApiKey not implemented")
+}
+func (UnimplementedAuthServiceServer) GetApiKeyByHash(context.Context, *GetApiKeyByHashRequest) (*GetApiKeyByHashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetApiKeyByHash not implemented")
+}
+func (UnimplementedAuthServiceServer) GetEmployerPlan(context.Context, *GetEmployerPlanRequest) (*EmployerPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEmployerPlan not implemented")
+}
+func (UnimplementedAuthServiceServer) EmployerUploadLogo(context.Context, *UploadLogoRequest) (*UploadLogoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmployerUploadLogo not implemented")
+}
+func (UnimplementedAuthServiceServer) EmployerUploadVerificationDocuments(context.Context, *UploadVerificationDocumentsRequest) (*VerificationStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmployerUploadVerificationDocuments not implemented")
+}
+func (UnimplementedAuthServiceServer) EmployerVerificationStatus(context.Context, *VerificationStatusRequest) (*VerificationStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmployerVerificationStatus not implemented")
+}
+func (UnimplementedAuthServiceServer) ListPendingEmployerVerifications(context.Context, *ListPendingVerificationsRequest) (*ListPendingVerificationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPendingEmployerVerifications not implemented")
+}
+func (UnimplementedAuthServiceServer) ReviewEmployerVerification(context.Context, *ReviewVerificationRequest) (*VerificationStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewEmployerVerification not implemented")
+}
+func (UnimplementedAuthServiceServer) GetEmployerPublicProfile(context.Context, *EmployerPublicProfileRequest) (*EmployerPublicProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEmployerPublicProfile not implemented")
+}
+func (UnimplementedAuthServiceServer) GetCandidatePublicProfile(context.Context, *CandidatePublicProfileRequest) (*CandidatePublicProfile, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCandidatePublicProfile not implemented")
+}
+func (UnimplementedAuthServiceServer) GetCandidateVisibility(context.Context, *GetCandidateVisibilityRequest) (*GetCandidateVisibilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCandidateVisibility not implemented")
+}
+func (UnimplementedAuthServiceServer) UpdateCandidateVisibility(context.Context, *UpdateCandidateVisibilityRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCandidateVisibility not implemented")
+}
+func (UnimplementedAuthServiceServer) SearchCandidates(context.Context, *SearchCandidatesRequest) (*SearchCandidatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchCandidates not implemented")
+}
+func (UnimplementedAuthServiceServer) SaveCandidate(context.Context, *SaveCandidateRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveCandidate not implemented")
+}
+func (UnimplementedAuthServiceServer) UnsaveCandidate(context.Context, *UnsaveCandidateRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsaveCandidate not implemented")
+}
+func (UnimplementedAuthServiceServer) ListSavedCandidates(context.Context, *ListSavedCandidatesRequest) (*ListSavedCandidatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSavedCandidates not implemented")
+}
+func (UnimplementedAuthServiceServer) CreateEmployerReview(context.Context, *CreateEmployerReviewRequest) (*CreateEmployerReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEmployerReview not implemented")
+}
+func (UnimplementedAuthServiceServer) ListEmployerReviews(context.Context, *ListEmployerReviewsRequest) (*ListEmployerReviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEmployerReviews not implemented")
+}
+func (UnimplementedAuthServiceServer) DeleteEmployerReview(context.Context, *DeleteEmployerReviewRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEmployerReview not implemented")
+}
+func (UnimplementedAuthServiceServer) RemoveEmployerReview(context.Context, *RemoveEmployerReviewRequest) (*RemoveEmployerReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveEmployerReview not implemented")
+}
+func (UnimplementedAuthServiceServer) EndorseCandidateSkills(context.Context, *EndorseCandidateSkillsRequest) (*EndorseCandidateSkillsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EndorseCandidateSkills not implemented")
+}
+func (UnimplementedAuthServiceServer) ListCandidateEndorsements(context.Context, *ListCandidateEndorsementsRequest) (*ListCandidateEndorsementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCandidateEndorsements not implemented")
+}
+func (UnimplementedAuthServiceServer) RetractEndorsement(context.Context, *RetractEndorsementRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetractEndorsement not implemented")
+}
+func (UnimplementedAuthServiceServer) InviteTeamMember(context.Context, *InviteTeamMemberRequest) (*InviteTeamMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InviteTeamMember not implemented")
+}
+func (UnimplementedAuthServiceServer) ListTeamMembers(context.Context, *ListTeamMembersRequest) (*ListTeamMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTeamMembers not implemented")
+}
+func (UnimplementedAuthServiceServer) UpdateTeamMemberRole(context.Context, *UpdateTeamMemberRoleRequest) (*UpdateTeamMemberRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTeamMemberRole not implemented")
+}
+func (UnimplementedAuthServiceServer) RemoveTeamMember(context.Context, *RemoveTeamMemberRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveTeamMember not implemented")
+}
+func (UnimplementedAuthServiceServer) GetCalendarFeedToken(context.Context, *GetCalendarFeedTokenRequest) (*CalendarFeedTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCalendarFeedToken not implemented")
+}
+func (UnimplementedAuthServiceServer) RotateCalendarFeedToken(context.Context, *RotateCalendarFeedTokenRequest) (*CalendarFeedTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateCalendarFeedToken not implemented")
+}
+func (UnimplementedAuthServiceServer) RevokeCalendarFeedToken(context.Context, *RevokeCalendarFeedTokenRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeCalendarFeedToken not implemented")
+}
+func (UnimplementedAuthServiceServer) ListUserIds(context.Context, *ListUserIdsRequest) (*ListUserIdsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserIds not implemented")
+}
+func (UnimplementedAuthServiceServer) mustEmbedUnimplementedAuthServiceServer() {}
+func (UnimplementedAuthServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeAuthServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AuthServiceServer will
+// result in compilation errors.
+type UnsafeAuthServiceServer interface {
+	mustEmbedUnimplementedAuthServiceServer()
+}
+
+func RegisterAuthServiceServer(s grpc.ServiceRegistrar, srv AuthServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAuthServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AuthService_ServiceDesc, srv)
+}
+
+func _AuthService_VerifyToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).VerifyToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_VerifyToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).VerifyToken(ctx, req.(*VerifyTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_CandidateSignup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CandidateSignupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).CandidateSignup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_CandidateSignup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).CandidateSignup(ctx, req.(*CandidateSignupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_CandidateLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CandidateLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).CandidateLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_CandidateLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).CandidateLogin(ctx, req.(*CandidateLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_CandidateVerifyEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).CandidateVerifyEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_CandidateVerifyEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).CandidateVerifyEmail(ctx, req.(*VerifyEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_CandidateResendOtp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResendOtpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).CandidateResendOtp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_CandidateResendOtp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).CandidateResendOtp(ctx, req.(*ResendOtpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_CandidateForgotPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForgotPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).CandidateForgotPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_CandidateForgotPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).CandidateForgotPassword(ctx, req.(*ForgotPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_CandidateResetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).CandidateResetPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_CandidateResetPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).CandidateResetPassword(ctx, req.(*ResetPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_CandidateChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).CandidateChangePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_CandidateChangePassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).CandidateChangePassword(ctx, req.(*ChangePasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_CandidateProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CandidateProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).CandidateProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_CandidateProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).CandidateProfile(ctx, req.(*CandidateProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_CandidateProfileUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CandidateProfileUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).CandidateProfileUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_CandidateProfileUpdate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).CandidateProfileUpdate(ctx, req.(*CandidateProfileUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_CandidateSkillsUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SkillsUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).CandidateSkillsUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_CandidateSkillsUpdate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).CandidateSkillsUpdate(ctx, req.(*SkillsUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_CandidateEducationUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EducationUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).CandidateEducationUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_CandidateEducationUpdate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).CandidateEducationUpdate(ctx, req.(*EducationUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_CandidateUploadResume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadResumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).CandidateUploadResume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_CandidateUploadResume_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).CandidateUploadResume(ctx, req.(*UploadResumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_CandidateGoogleLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GoogleLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).CandidateGoogleLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_CandidateGoogleLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).CandidateGoogleLogin(ctx, req.(*GoogleLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_CandidateGoogleCallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GoogleCallbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).CandidateGoogleCallback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_CandidateGoogleCallback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).CandidateGoogleCallback(ctx, req.(*GoogleCallbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_GetCandidateSkills_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCandidateSkillsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).GetCandidateSkills(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_GetCandidateSkills_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).GetCandidateSkills(ctx, req.(*GetCandidateSkillsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_EmployerSignup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmployerSignupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).EmployerSignup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_EmployerSignup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).EmployerSignup(ctx, req.(*EmployerSignupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_EmployerLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmployerLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).EmployerLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_EmployerLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).EmployerLogin(ctx, req.(*EmployerLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_EmployerVerifyEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).EmployerVerifyEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_EmployerVerifyEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).EmployerVerifyEmail(ctx, req.(*VerifyEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_EmployerResendOtp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResendOtpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).EmployerResendOtp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_EmployerResendOtp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).EmployerResendOtp(ctx, req.(*ResendOtpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_EmployerForgotPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForgotPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).EmployerForgotPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_EmployerForgotPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).EmployerForgotPassword(ctx, req.(*ForgotPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_EmployerResetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).EmployerResetPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_EmployerResetPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).EmployerResetPassword(ctx, req.(*ResetPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_EmployerChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).EmployerChangePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_EmployerChangePassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).EmployerChangePassword(ctx, req.(*ChangePasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_EmployerProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmployerProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).EmployerProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_EmployerProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).EmployerProfile(ctx, req.(*EmployerProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_EmployerProfileById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmployerProfileByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).EmployerProfileById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_EmployerProfileById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).EmployerProfileById(ctx, req.(*EmployerProfileByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_EmployerProfileUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmployerProfileUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).EmployerProfileUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_EmployerProfileUpdate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).EmployerProfileUpdate(ctx, req.(*EmployerProfileUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_EmployerGoogleLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GoogleLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).EmployerGoogleLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_EmployerGoogleLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).EmployerGoogleLogin(ctx, req.(*GoogleLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_EmployerGoogleCallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GoogleCallbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).EmployerGoogleCallback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_EmployerGoogleCallback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).EmployerGoogleCallback(ctx, req.(*GoogleCallbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_CandidateDeleteAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).CandidateDeleteAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_CandidateDeleteAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).CandidateDeleteAccount(ctx, req.(*DeleteAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_EmployerDeleteAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).EmployerDeleteAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_EmployerDeleteAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).EmployerDeleteAccount(ctx, req.(*DeleteAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_CandidateSendUnlockLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendUnlockLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).CandidateSendUnlockLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_CandidateSendUnlockLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).CandidateSendUnlockLink(ctx, req.(*SendUnlockLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_EmployerSendUnlockLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendUnlockLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).EmployerSendUnlockLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_EmployerSendUnlockLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).EmployerSendUnlockLink(ctx, req.(*SendUnlockLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_CandidateVerifyUnlockToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyUnlockTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).CandidateVerifyUnlockToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_CandidateVerifyUnlockToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).CandidateVerifyUnlockToken(ctx, req.(*VerifyUnlockTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_EmployerVerifyUnlockToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyUnlockTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).EmployerVerifyUnlockToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_EmployerVerifyUnlockToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).EmployerVerifyUnlockToken(ctx, req.(*VerifyUnlockTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_CandidateRequestEmailChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestEmailChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).CandidateRequestEmailChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_CandidateRequestEmailChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).CandidateRequestEmailChange(ctx, req.(*RequestEmailChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_EmployerRequestEmailChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestEmailChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).EmployerRequestEmailChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_EmployerRequestEmailChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).EmployerRequestEmailChange(ctx, req.(*RequestEmailChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_CandidateConfirmEmailChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmEmailChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).CandidateConfirmEmailChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_CandidateConfirmEmailChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).CandidateConfirmEmailChange(ctx, req.(*ConfirmEmailChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_EmployerConfirmEmailChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmEmailChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).EmployerConfirmEmailChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_EmployerConfirmEmailChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).EmployerConfirmEmailChange(ctx, req.(*ConfirmEmailChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_CandidateOAuthLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OAuthLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).CandidateOAuthLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_CandidateOAuthLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).CandidateOAuthLogin(ctx, req.(*OAuthLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_EmployerOAuthLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OAuthLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).EmployerOAuthLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_EmployerOAuthLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).EmployerOAuthLogin(ctx, req.(*OAuthLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_CandidateOAuthCallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OAuthCallbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).CandidateOAuthCallback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_CandidateOAuthCallback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).CandidateOAuthCallback(ctx, req.(*OAuthCallbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_EmployerOAuthCallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OAuthCallbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).EmployerOAuthCallback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_EmployerOAuthCallback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).EmployerOAuthCallback(ctx, req.(*OAuthCallbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_CandidateAddPhone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPhoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).CandidateAddPhone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_CandidateAddPhone_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).CandidateAddPhone(ctx, req.(*AddPhoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_EmployerAddPhone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPhoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).EmployerAddPhone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_EmployerAddPhone_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).EmployerAddPhone(ctx, req.(*AddPhoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_CandidateVerifyPhone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyPhoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).CandidateVerifyPhone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_CandidateVerifyPhone_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).CandidateVerifyPhone(ctx, req.(*VerifyPhoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_EmployerVerifyPhone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyPhoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).EmployerVerifyPhone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_EmployerVerifyPhone_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).EmployerVerifyPhone(ctx, req.(*VerifyPhoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_CandidateRemovePhone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemovePhoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).CandidateRemovePhone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_CandidateRemovePhone_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).CandidateRemovePhone(ctx, req.(*RemovePhoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_EmployerRemovePhone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemovePhoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).EmployerRemovePhone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_EmployerRemovePhone_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).EmployerRemovePhone(ctx, req.(*RemovePhoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_CandidateListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).CandidateListSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_CandidateListSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).CandidateListSessions(ctx, req.(*ListSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_EmployerListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).EmployerListSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_EmployerListSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).EmployerListSessions(ctx, req.(*ListSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_CandidateRevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).CandidateRevokeSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_CandidateRevokeSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).CandidateRevokeSession(ctx, req.(*RevokeSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_EmployerRevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).EmployerRevokeSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_EmployerRevokeSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).EmployerRevokeSession(ctx, req.(*RevokeSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_CandidateSetupTwoFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetupTwoFactorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).CandidateSetupTwoFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_CandidateSetupTwoFactor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).CandidateSetupTwoFactor(ctx, req.(*SetupTwoFactorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_EmployerSetupTwoFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetupTwoFactorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).EmployerSetupTwoFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_EmployerSetupTwoFactor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).EmployerSetupTwoFactor(ctx, req.(*SetupTwoFactorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_CandidateEnableTwoFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnableTwoFactorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).CandidateEnableTwoFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_CandidateEnableTwoFactor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).CandidateEnableTwoFactor(ctx, req.(*EnableTwoFactorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_EmployerEnableTwoFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnableTwoFactorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).EmployerEnableTwoFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_EmployerEnableTwoFactor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).EmployerEnableTwoFactor(ctx, req.(*EnableTwoFactorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_CandidateDisableTwoFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableTwoFactorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).CandidateDisableTwoFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_CandidateDisableTwoFactor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).CandidateDisableTwoFactor(ctx, req.(*DisableTwoFactorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_EmployerDisableTwoFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableTwoFactorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).EmployerDisableTwoFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_EmployerDisableTwoFactor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).EmployerDisableTwoFactor(ctx, req.(*DisableTwoFactorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_CandidateVerifyTwoFactorLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyTwoFactorLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).CandidateVerifyTwoFactorLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_CandidateVerifyTwoFactorLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).CandidateVerifyTwoFactorLogin(ctx, req.(*VerifyTwoFactorLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_EmployerVerifyTwoFactorLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyTwoFactorLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).EmployerVerifyTwoFactorLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_EmployerVerifyTwoFactorLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).EmployerVerifyTwoFactorLogin(ctx, req.(*VerifyTwoFactorLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_CreateApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateApiKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).CreateApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_CreateApiKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).CreateApiKey(ctx, req.(*CreateApiKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ListApiKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApiKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ListApiKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ListApiKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ListApiKeys(ctx, req.(*ListApiKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RevokeApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeApiKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RevokeApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RevokeApiKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RevokeApiKey(ctx, req.(*RevokeApiKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_GetApiKeyByHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApiKeyByHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).GetApiKeyByHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_GetApiKeyByHash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).GetApiKeyByHash(ctx, req.(*GetApiKeyByHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_GetEmployerPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEmployerPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).GetEmployerPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_GetEmployerPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).GetEmployerPlan(ctx, req.(*GetEmployerPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_EmployerUploadLogo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadLogoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).EmployerUploadLogo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_EmployerUploadLogo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).EmployerUploadLogo(ctx, req.(*UploadLogoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_EmployerUploadVerificationDocuments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadVerificationDocumentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).EmployerUploadVerificationDocuments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_EmployerUploadVerificationDocuments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).EmployerUploadVerificationDocuments(ctx, req.(*UploadVerificationDocumentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_EmployerVerificationStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerificationStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).EmployerVerificationStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_EmployerVerificationStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).EmployerVerificationStatus(ctx, req.(*VerificationStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ListPendingEmployerVerifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPendingVerificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ListPendingEmployerVerifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ListPendingEmployerVerifications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ListPendingEmployerVerifications(ctx, req.(*ListPendingVerificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ReviewEmployerVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewVerificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ReviewEmployerVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ReviewEmployerVerification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ReviewEmployerVerification(ctx, req.(*ReviewVerificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_GetEmployerPublicProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmployerPublicProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).GetEmployerPublicProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_GetEmployerPublicProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).GetEmployerPublicProfile(ctx, req.(*EmployerPublicProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_GetCandidatePublicProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CandidatePublicProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).GetCandidatePublicProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_GetCandidatePublicProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).GetCandidatePublicProfile(ctx, req.(*CandidatePublicProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_GetCandidateVisibility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCandidateVisibilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).GetCandidateVisibility(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_GetCandidateVisibility_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).GetCandidateVisibility(ctx, req.(*GetCandidateVisibilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_UpdateCandidateVisibility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCandidateVisibilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).UpdateCandidateVisibility(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_UpdateCandidateVisibility_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).UpdateCandidateVisibility(ctx, req.(*UpdateCandidateVisibilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_SearchCandidates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchCandidatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).SearchCandidates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_SearchCandidates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).SearchCandidates(ctx, req.(*SearchCandidatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_SaveCandidate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveCandidateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).SaveCandidate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_SaveCandidate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).SaveCandidate(ctx, req.(*SaveCandidateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_UnsaveCandidate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsaveCandidateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).UnsaveCandidate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_UnsaveCandidate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).UnsaveCandidate(ctx, req.(*UnsaveCandidateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ListSavedCandidates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSavedCandidatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ListSavedCandidates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ListSavedCandidates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ListSavedCandidates(ctx, req.(*ListSavedCandidatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_CreateEmployerReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEmployerReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).CreateEmployerReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_CreateEmployerReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).CreateEmployerReview(ctx, req.(*CreateEmployerReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ListEmployerReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEmployerReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ListEmployerReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ListEmployerReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ListEmployerReviews(ctx, req.(*ListEmployerReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_DeleteEmployerReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEmployerReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).DeleteEmployerReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_DeleteEmployerReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).DeleteEmployerReview(ctx, req.(*DeleteEmployerReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RemoveEmployerReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveEmployerReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RemoveEmployerReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RemoveEmployerReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RemoveEmployerReview(ctx, req.(*RemoveEmployerReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_EndorseCandidateSkills_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EndorseCandidateSkillsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).EndorseCandidateSkills(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_EndorseCandidateSkills_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).EndorseCandidateSkills(ctx, req.(*EndorseCandidateSkillsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ListCandidateEndorsements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCandidateEndorsementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ListCandidateEndorsements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ListCandidateEndorsements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ListCandidateEndorsements(ctx, req.(*ListCandidateEndorsementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RetractEndorsement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetractEndorsementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RetractEndorsement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RetractEndorsement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RetractEndorsement(ctx, req.(*RetractEndorsementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_InviteTeamMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteTeamMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).InviteTeamMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_InviteTeamMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).InviteTeamMember(ctx, req.(*InviteTeamMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ListTeamMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTeamMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ListTeamMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ListTeamMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ListTeamMembers(ctx, req.(*ListTeamMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_UpdateTeamMemberRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTeamMemberRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).UpdateTeamMemberRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_UpdateTeamMemberRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).UpdateTeamMemberRole(ctx, req.(*UpdateTeamMemberRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RemoveTeamMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveTeamMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RemoveTeamMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RemoveTeamMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RemoveTeamMember(ctx, req.(*RemoveTeamMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_GetCalendarFeedToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCalendarFeedTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).GetCalendarFeedToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_GetCalendarFeedToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).GetCalendarFeedToken(ctx, req.(*GetCalendarFeedTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RotateCalendarFeedToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateCalendarFeedTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RotateCalendarFeedToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RotateCalendarFeedToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RotateCalendarFeedToken(ctx, req.(*RotateCalendarFeedTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RevokeCalendarFeedToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeCalendarFeedTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RevokeCalendarFeedToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RevokeCalendarFeedToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RevokeCalendarFeedToken(ctx, req.(*RevokeCalendarFeedTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ListUserIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserIdsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ListUserIds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ListUserIds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ListUserIds(ctx, req.(*ListUserIdsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AuthService_ServiceDesc is the grpc.ServiceDesc for AuthService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AuthService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "authpb.AuthService",
+	HandlerType: (*AuthServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "VerifyToken",
+			Handler:    _AuthService_VerifyToken_Handler,
+		},
+		{
+			MethodName: "CandidateSignup",
+			Handler:    _AuthService_CandidateSignup_Handler,
+		},
+		{
+			MethodName: "CandidateLogin",
+			Handler:    _AuthService_CandidateLogin_Handler,
+		},
+		{
+			MethodName: "CandidateVerifyEmail",
+			Handler:    _AuthService_CandidateVerifyEmail_Handler,
+		},
+		{
+			MethodName: "CandidateResendOtp",
+			Handler:    _AuthService_CandidateResendOtp_Handler,
+		},
+		{
+			MethodName: "CandidateForgotPassword",
+			Handler:    _AuthService_CandidateForgotPassword_Handler,
+		},
+		{
+			MethodName: "CandidateResetPassword",
+			Handler:    _AuthService_CandidateResetPassword_Handler,
+		},
+		{
+			MethodName: "CandidateChangePassword",
+			Handler:    _AuthService_CandidateChangePassword_Handler,
+		},
+		{
+			MethodName: "CandidateProfile",
+			Handler:    _AuthService_CandidateProfile_Handler,
+		},
+		{
+			MethodName: "CandidateProfileUpdate",
+			Handler:    _AuthService_CandidateProfileUpdate_Handler,
+		},
+		{
+			MethodName: "CandidateSkillsUpdate",
+			Handler:    _AuthService_CandidateSkillsUpdate_Handler,
+		},
+		{
+			MethodName: "CandidateEducationUpdate",
+			Handler:    _AuthService_CandidateEducationUpdate_Handler,
+		},
+		{
+			MethodName: "CandidateUploadResume",
+			Handler:    _AuthService_CandidateUploadResume_Handler,
+		},
+		{
+			MethodName: "CandidateGoogleLogin",
+			Handler:    _AuthService_CandidateGoogleLogin_Handler,
+		},
+		{
+			MethodName: "CandidateGoogleCallback",
+			Handler:    _AuthService_CandidateGoogleCallback_Handler,
+		},
+		{
+			MethodName: "GetCandidateSkills",
+			Handler:    _AuthService_GetCandidateSkills_Handler,
+		},
+		{
+			MethodName: "EmployerSignup",
+			Handler:    _AuthService_EmployerSignup_Handler,
+		},
+		{
+			MethodName: "EmployerLogin",
+			Handler:    _AuthService_EmployerLogin_Handler,
+		},
+		{
+			MethodName: "EmployerVerifyEmail",
+			Handler:    _AuthService_EmployerVerifyEmail_Handler,
+		},
+		{
+			MethodName: "EmployerResendOtp",
+			Handler:    _AuthService_EmployerResendOtp_Handler,
+		},
+		{
+			MethodName: "EmployerForgotPassword",
+			Handler:    _AuthService_EmployerForgotPassword_Handler,
+		},
+		{
+			MethodName: "EmployerResetPassword",
+			Handler:    _AuthService_EmployerResetPassword_Handler,
+		},
+		{
+			MethodName: "EmployerChangePassword",
+			Handler:    _AuthService_EmployerChangePassword_Handler,
+		},
+		{
+			MethodName: "EmployerProfile",
+			Handler:    _AuthService_EmployerProfile_Handler,
+		},
+		{
+			MethodName: "EmployerProfileById",
+			Handler:    _AuthService_EmployerProfileById_Handler,
+		},
+		{
+			MethodName: "EmployerProfileUpdate",
+			Handler:    _AuthService_EmployerProfileUpdate_Handler,
+		},
+		{
+			MethodName: "EmployerGoogleLogin",
+			Handler:    _AuthService_EmployerGoogleLogin_Handler,
+		},
+		{
+			MethodName: "EmployerGoogleCallback",
+			Handler:    _AuthService_EmployerGoogleCallback_Handler,
+		},
+		{
+			MethodName: "CandidateDeleteAccount",
+			Handler:    _AuthService_CandidateDeleteAccount_Handler,
+		},
+		{
+			MethodName: "EmployerDeleteAccount",
+			Handler:    _AuthService_EmployerDeleteAccount_Handler,
+		},
+		{
+			MethodName: "CandidateSendUnlockLink",
+			Handler:    _AuthService_CandidateSendUnlockLink_Handler,
+		},
+		{
+			MethodName: "EmployerSendUnlockLink",
+			Handler:    _AuthService_EmployerSendUnlockLink_Handler,
+		},
+		{
+			MethodName: "CandidateVerifyUnlockToken",
+			Handler:    _AuthService_CandidateVerifyUnlockToken_Handler,
+		},
+		{
+			MethodName: "EmployerVerifyUnlockToken",
+			Handler:    _AuthService_EmployerVerifyUnlockToken_Handler,
+		},
+		{
+			MethodName: "CandidateRequestEmailChange",
+			Handler:    _AuthService_CandidateRequestEmailChange_Handler,
+		},
+		{
+			MethodName: "EmployerRequestEmailChange",
+			Handler:    _AuthService_EmployerRequestEmailChange_Handler,
+		},
+		{
+			MethodName: "CandidateConfirmEmailChange",
+			Handler:    _AuthService_CandidateConfirmEmailChange_Handler,
+		},
+		{
+			MethodName: "EmployerConfirmEmailChange",
+			Handler:    _AuthService_EmployerConfirmEmailChange_Handler,
+		},
+		{
+			MethodName: "CandidateOAuthLogin",
+			Handler:    _AuthService_CandidateOAuthLogin_Handler,
+		},
+		{
+			MethodName: "EmployerOAuthLogin",
+			Handler:    _AuthService_EmployerOAuthLogin_Handler,
+		},
+		{
+			MethodName: "CandidateOAuthCallback",
+			Handler:    _AuthService_CandidateOAuthCallback_Handler,
+		},
+		{
+			MethodName: "EmployerOAuthCallback",
+			Handler:    _AuthService_EmployerOAuthCallback_Handler,
+		},
+		{
+			MethodName: "CandidateAddPhone",
+			Handler:    _AuthService_CandidateAddPhone_Handler,
+		},
+		{
+			MethodName: "EmployerAddPhone",
+			Handler:    _AuthService_EmployerAddPhone_Handler,
+		},
+		{
+			MethodName: "CandidateVerifyPhone",
+			Handler:    _AuthService_CandidateVerifyPhone_Handler,
+		},
+		{
+			MethodName: "EmployerVerifyPhone",
+			Handler:    _AuthService_EmployerVerifyPhone_Handler,
+		},
+		{
+			MethodName: "CandidateRemovePhone",
+			Handler:    _AuthService_CandidateRemovePhone_Handler,
+		},
+		{
+			MethodName: "EmployerRemovePhone",
+			Handler:    _AuthService_EmployerRemovePhone_Handler,
+		},
+		{
+			MethodName: "CandidateListSessions",
+			Handler:    _AuthService_CandidateListSessions_Handler,
+		},
+		{
+			MethodName: "EmployerListSessions",
+			Handler:    _AuthService_EmployerListSessions_Handler,
+		},
+		{
+			MethodName: "CandidateRevokeSession",
+			Handler:    _AuthService_CandidateRevokeSession_Handler,
+		},
+		{
+			MethodName: "EmployerRevokeSession",
+			Handler:    _AuthService_EmployerRevokeSession_Handler,
+		},
+		{
+			MethodName: "CandidateSetupTwoFactor",
+			Handler:    _AuthService_CandidateSetupTwoFactor_Handler,
+		},
+		{
+			MethodName: "EmployerSetupTwoFactor",
+			Handler:    _AuthService_EmployerSetupTwoFactor_Handler,
+		},
+		{
+			MethodName: "CandidateEnableTwoFactor",
+			Handler:    _AuthService_CandidateEnableTwoFactor_Handler,
+		},
+		{
+			MethodName: "EmployerEnableTwoFactor",
+			Handler:    _AuthService_EmployerEnableTwoFactor_Handler,
+		},
+		{
+			MethodName: "CandidateDisableTwoFactor",
+			Handler:    _AuthService_CandidateDisableTwoFactor_Handler,
+		},
+		{
+			MethodName: "EmployerDisableTwoFactor",
+			Handler:    _AuthService_EmployerDisableTwoFactor_Handler,
+		},
+		{
+			MethodName: "CandidateVerifyTwoFactorLogin",
+			Handler:    _AuthService_CandidateVerifyTwoFactorLogin_Handler,
+		},
+		{
+			MethodName: "EmployerVerifyTwoFactorLogin",
+			Handler:    _AuthService_EmployerVerifyTwoFactorLogin_Handler,
+		},
+		{
+			MethodName: "CreateApiKey",
+			Handler:    _AuthService_CreateApiKey_Handler,
+		},
+		{
+			MethodName: "ListApiKeys",
+			Handler:    _AuthService_ListApiKeys_Handler,
+		},
+		{
+			MethodName: "RevokeApiKey",
+			Handler:    _AuthService_RevokeApiKey_Handler,
+		},
+		{
+			MethodName: "GetApiKeyByHash",
+			Handler:    _AuthService_GetApiKeyByHash_Handler,
+		},
+		{
+			MethodName: "GetEmployerPlan",
+			Handler:    _AuthService_GetEmployerPlan_Handler,
+		},
+		{
+			MethodName: "EmployerUploadLogo",
+			Handler:    _AuthService_EmployerUploadLogo_Handler,
+		},
+		{
+			MethodName: "EmployerUploadVerificationDocuments",
+			Handler:    _AuthService_EmployerUploadVerificationDocuments_Handler,
+		},
+		{
+			MethodName: "EmployerVerificationStatus",
+			Handler:    _AuthService_EmployerVerificationStatus_Handler,
+		},
+		{
+			MethodName: "ListPendingEmployerVerifications",
+			Handler:    _AuthService_ListPendingEmployerVerifications_Handler,
+		},
+		{
+			MethodName: "ReviewEmployerVerification",
+			Handler:    _AuthService_ReviewEmployerVerification_Handler,
+		},
+		{
+			MethodName: "GetEmployerPublicProfile",
+			Handler:    _AuthService_GetEmployerPublicProfile_Handler,
+		},
+		{
+			MethodName: "GetCandidatePublicProfile",
+			Handler:    _AuthService_GetCandidatePublicProfile_Handler,
+		},
+		{
+			MethodName: "GetCandidateVisibility",
+			Handler:    _AuthService_GetCandidateVisibility_Handler,
+		},
+		{
+			MethodName: "UpdateCandidateVisibility",
+			Handler:    _AuthService_UpdateCandidateVisibility_Handler,
+		},
+		{
+			MethodName: "SearchCandidates",
+			Handler:    _AuthService_SearchCandidates_Handler,
+		},
+		{
+			MethodName: "SaveCandidate",
+			Handler:    _AuthService_SaveCandidate_Handler,
+		},
+		{
+			MethodName: "UnsaveCandidate",
+			Handler:    _AuthService_UnsaveCandidate_Handler,
+		},
+		{
+			MethodName: "ListSavedCandidates",
+			Handler:    _AuthService_ListSavedCandidates_Handler,
+		},
+		{
+			MethodName: "CreateEmployerReview",
+			Handler:    _AuthService_CreateEmployerReview_Handler,
+		},
+		{
+			MethodName: "ListEmployerReviews",
+			Handler:    _AuthService_ListEmployerReviews_Handler,
+		},
+		{
+			MethodName: "DeleteEmployerReview",
+			Handler:    _AuthService_DeleteEmployerReview_Handler,
+		},
+		{
+			MethodName: "RemoveEmployerReview",
+			Handler:    _AuthService_RemoveEmployerReview_Handler,
+		},
+		{
+			MethodName: "EndorseCandidateSkills",
+			Handler:    _AuthService_EndorseCandidateSkills_Handler,
+		},
+		{
+			MethodName: "ListCandidateEndorsements",
+			Handler:    _AuthService_ListCandidateEndorsements_Handler,
+		},
+		{
+			MethodName: "RetractEndorsement",
+			Handler:    _AuthService_RetractEndorsement_Handler,
+		},
+		{
+			MethodName: "InviteTeamMember",
+			Handler:    _AuthService_InviteTeamMember_Handler,
+		},
+		{
+			MethodName: "ListTeamMembers",
+			Handler:    _AuthService_ListTeamMembers_Handler,
+		},
+		{
+			MethodName: "UpdateTeamMemberRole",
+			Handler:    _AuthService_UpdateTeamMemberRole_Handler,
+		},
+		{
+			MethodName: "RemoveTeamMember",
+			Handler:    _AuthService_RemoveTeamMember_Handler,
+		},
+		{
+			MethodName: "GetCalendarFeedToken",
+			Handler:    _AuthService_GetCalendarFeedToken_Handler,
+		},
+		{
+			MethodName: "RotateCalendarFeedToken",
+			Handler:    _AuthService_RotateCalendarFeedToken_Handler,
+		},
+		{
+			MethodName: "RevokeCalendarFeedToken",
+			Handler:    _AuthService_RevokeCalendarFeedToken_Handler,
+		},
+		{
+			MethodName: "ListUserIds",
+			Handler:    _AuthService_ListUserIds_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "auth.proto",
+}
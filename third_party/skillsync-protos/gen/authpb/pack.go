@@ -0,0 +1,2 @@
+// gen/authpb/doc.go
+package authpb
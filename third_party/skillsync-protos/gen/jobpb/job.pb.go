@@ -0,0 +1,7972 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: skillsync-protos/Job/job.proto
+
+package jobpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// EmployerDetail represents a single detail about an employer
+type EmployerDetail struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`     // e.g., 'company_name', 'email', 'industry', etc.
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"` // The actual value of the detail
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmployerDetail) Reset() {
+	*x = EmployerDetail{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmployerDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmployerDetail) ProtoMessage() {}
+
+func (x *EmployerDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmployerDetail.ProtoReflect.Descriptor instead.
+func (*EmployerDetail) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EmployerDetail) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *EmployerDetail) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// CompanyDetails represents a collection of employer details
+type CompanyDetails struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Details       []*EmployerDetail      `protobuf:"bytes,1,rep,name=details,proto3" json:"details,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompanyDetails) Reset() {
+	*x = CompanyDetails{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompanyDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompanyDetails) ProtoMessage() {}
+
+func (x *CompanyDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompanyDetails.ProtoReflect.Descriptor instead.
+func (*CompanyDetails) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CompanyDetails) GetDetails() []*EmployerDetail {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+// EmployerProfile contains basic employer information
+type EmployerProfile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CompanyName   string                 `protobuf:"bytes,1,opt,name=company_name,json=companyName,proto3" json:"company_name,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Industry      string                 `protobuf:"bytes,3,opt,name=industry,proto3" json:"industry,omitempty"`
+	Website       string                 `protobuf:"bytes,4,opt,name=website,proto3" json:"website,omitempty"`
+	Location      string                 `protobuf:"bytes,5,opt,name=location,proto3" json:"location,omitempty"`
+	IsVerified    bool                   `protobuf:"varint,6,opt,name=is_verified,json=isVerified,proto3" json:"is_verified,omitempty"`
+	IsTrusted     bool                   `protobuf:"varint,7,opt,name=is_trusted,json=isTrusted,proto3" json:"is_trusted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmployerProfile) Reset() {
+	*x = EmployerProfile{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmployerProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmployerProfile) ProtoMessage() {}
+
+func (x *EmployerProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmployerProfile.ProtoReflect.Descriptor instead.
+func (*EmployerProfile) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EmployerProfile) GetCompanyName() string {
+	if x != nil {
+		return x.CompanyName
+	}
+	return ""
+}
+
+func (x *EmployerProfile) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *EmployerProfile) GetIndustry() string {
+	if x != nil {
+		return x.Industry
+	}
+	return ""
+}
+
+func (x *EmployerProfile) GetWebsite() string {
+	if x != nil {
+		return x.Website
+	}
+	return ""
+}
+
+func (x *EmployerProfile) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *EmployerProfile) GetIsVerified() bool {
+	if x != nil {
+		return x.IsVerified
+	}
+	return false
+}
+
+func (x *EmployerProfile) GetIsTrusted() bool {
+	if x != nil {
+		return x.IsTrusted
+	}
+	return false
+}
+
+// Job message - comprehensive definition matching your model
+type Job struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // Changed from string to uint64 to match Go uint type
+	EmployerId         string                 `protobuf:"bytes,2,opt,name=employer_id,json=employerId,proto3" json:"employer_id,omitempty"`
+	Title              string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description        string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Category           string                 `protobuf:"bytes,5,opt,name=category,proto3" json:"category,omitempty"`
+	RequiredSkills     []*JobSkill            `protobuf:"bytes,6,rep,name=required_skills,json=requiredSkills,proto3" json:"required_skills,omitempty"` // Skills are included in the job response
+	SalaryMin          int64                  `protobuf:"varint,7,opt,name=salary_min,json=salaryMin,proto3" json:"salary_min,omitempty"`
+	SalaryMax          int64                  `protobuf:"varint,8,opt,name=salary_max,json=salaryMax,proto3" json:"salary_max,omitempty"`
+	Location           string                 `protobuf:"bytes,9,opt,name=location,proto3" json:"location,omitempty"`
+	ExperienceRequired int32                  `protobuf:"varint,10,opt,name=experience_required,json=experienceRequired,proto3" json:"experience_required,omitempty"`
+	Status             string                 `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"`                                          // Possible values: OPEN, CLOSED, DRAFT
+	EmployerProfile    *EmployerProfile       `protobuf:"bytes,12,opt,name=employer_profile,json=employerProfile,proto3" json:"employer_profile,omitempty"` // Standard employer details
+	CompanyDetails     *CompanyDetails        `protobuf:"bytes,13,opt,name=company_details,json=companyDetails,proto3" json:"company_details,omitempty"`    // Company details as an array of key-value pairs
+	Deadline           string                 `protobuf:"bytes,14,opt,name=deadline,proto3" json:"deadline,omitempty"`                                      // RFC 3339; applications close after it
+	UpdatedAt          string                 `protobuf:"bytes,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CreatedAt          string                 `protobuf:"bytes,16,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Job) Reset() {
+	*x = Job{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Job) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Job) ProtoMessage() {}
+
+func (x *Job) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Job.ProtoReflect.Descriptor instead.
+func (*Job) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Job) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Job) GetEmployerId() string {
+	if x != nil {
+		return x.EmployerId
+	}
+	return ""
+}
+
+func (x *Job) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Job) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Job) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *Job) GetRequiredSkills() []*JobSkill {
+	if x != nil {
+		return x.RequiredSkills
+	}
+	return nil
+}
+
+func (x *Job) GetSalaryMin() int64 {
+	if x != nil {
+		return x.SalaryMin
+	}
+	return 0
+}
+
+func (x *Job) GetSalaryMax() int64 {
+	if x != nil {
+		return x.SalaryMax
+	}
+	return 0
+}
+
+func (x *Job) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *Job) GetExperienceRequired() int32 {
+	if x != nil {
+		return x.ExperienceRequired
+	}
+	return 0
+}
+
+func (x *Job) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Job) GetEmployerProfile() *EmployerProfile {
+	if x != nil {
+		return x.EmployerProfile
+	}
+	return nil
+}
+
+func (x *Job) GetCompanyDetails() *CompanyDetails {
+	if x != nil {
+		return x.CompanyDetails
+	}
+	return nil
+}
+
+func (x *Job) GetDeadline() string {
+	if x != nil {
+		return x.Deadline
+	}
+	return ""
+}
+
+func (x *Job) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *Job) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// JobSkill message - matching your model
+type JobSkill struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"` // Using string to match Go implementation
+	Skill         string                 `protobuf:"bytes,2,opt,name=skill,proto3" json:"skill,omitempty"`
+	Proficiency   string                 `protobuf:"bytes,3,opt,name=proficiency,proto3" json:"proficiency,omitempty"` // e.g., Beginner, Intermediate, Expert
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JobSkill) Reset() {
+	*x = JobSkill{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobSkill) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobSkill) ProtoMessage() {}
+
+func (x *JobSkill) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobSkill.ProtoReflect.Descriptor instead.
+func (*JobSkill) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *JobSkill) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *JobSkill) GetSkill() string {
+	if x != nil {
+		return x.Skill
+	}
+	return ""
+}
+
+func (x *JobSkill) GetProficiency() string {
+	if x != nil {
+		return x.Proficiency
+	}
+	return ""
+}
+
+// JobSkills represents a collection of skills for a job
+type JobSkills struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Skills        []*JobSkill            `protobuf:"bytes,1,rep,name=skills,proto3" json:"skills,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JobSkills) Reset() {
+	*x = JobSkills{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobSkills) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobSkills) ProtoMessage() {}
+
+func (x *JobSkills) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobSkills.ProtoReflect.Descriptor instead.
+func (*JobSkills) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *JobSkills) GetSkills() []*JobSkill {
+	if x != nil {
+		return x.Skills
+	}
+	return nil
+}
+
+// Application message - matching your model
+type Application struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                    // Changed from string to uint64
+	JobId         uint64                 `protobuf:"varint,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"` // Changed from string to uint64
+	CandidateId   string                 `protobuf:"bytes,3,opt,name=candidate_id,json=candidateId,proto3" json:"candidate_id,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`                        // Applied, Viewed, Shortlisted, Rejected
+	ResumeUrl     string                 `protobuf:"bytes,5,opt,name=resume_url,json=resumeUrl,proto3" json:"resume_url,omitempty"` // Optional field for resume URL
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Application) Reset() {
+	*x = Application{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Application) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Application) ProtoMessage() {}
+
+func (x *Application) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Application.ProtoReflect.Descriptor instead.
+func (*Application) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Application) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Application) GetJobId() uint64 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+func (x *Application) GetCandidateId() string {
+	if x != nil {
+		return x.CandidateId
+	}
+	return ""
+}
+
+func (x *Application) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Application) GetResumeUrl() string {
+	if x != nil {
+		return x.ResumeUrl
+	}
+	return ""
+}
+
+// ApplicationResponse message - matching your Go model with Job object
+type ApplicationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Job           *Job                   `protobuf:"bytes,2,opt,name=job,proto3" json:"job,omitempty"` // Contains the full Job object instead of just job_id
+	CandidateId   string                 `protobuf:"bytes,3,opt,name=candidate_id,json=candidateId,proto3" json:"candidate_id,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`                        // Applied, Viewed, Shortlisted, Rejected
+	ResumeUrl     string                 `protobuf:"bytes,5,opt,name=resume_url,json=resumeUrl,proto3" json:"resume_url,omitempty"` // Optional field for resume URL
+	AppliedAt     string                 `protobuf:"bytes,6,opt,name=applied_at,json=appliedAt,proto3" json:"applied_at,omitempty"` // Timestamp when the application was submitted
+	JobId         uint64                 `protobuf:"varint,7,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`            // Same as job.id, set even when job isn't loaded
+	Seen          bool                   `protobuf:"varint,8,opt,name=seen,proto3" json:"seen,omitempty"`                           // Whether the employer has opened it
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplicationResponse) Reset() {
+	*x = ApplicationResponse{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplicationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplicationResponse) ProtoMessage() {}
+
+func (x *ApplicationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplicationResponse.ProtoReflect.Descriptor instead.
+func (*ApplicationResponse) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ApplicationResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ApplicationResponse) GetJob() *Job {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+func (x *ApplicationResponse) GetCandidateId() string {
+	if x != nil {
+		return x.CandidateId
+	}
+	return ""
+}
+
+func (x *ApplicationResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ApplicationResponse) GetResumeUrl() string {
+	if x != nil {
+		return x.ResumeUrl
+	}
+	return ""
+}
+
+func (x *ApplicationResponse) GetAppliedAt() string {
+	if x != nil {
+		return x.AppliedAt
+	}
+	return ""
+}
+
+func (x *ApplicationResponse) GetJobId() uint64 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+func (x *ApplicationResponse) GetSeen() bool {
+	if x != nil {
+		return x.Seen
+	}
+	return false
+}
+
+// PostJob request/response
+type PostJobRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Title              string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description        string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Category           string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	RequiredSkills     []*JobSkill            `protobuf:"bytes,4,rep,name=required_skills,json=requiredSkills,proto3" json:"required_skills,omitempty"`
+	SalaryMin          int64                  `protobuf:"varint,5,opt,name=salary_min,json=salaryMin,proto3" json:"salary_min,omitempty"`
+	SalaryMax          int64                  `protobuf:"varint,6,opt,name=salary_max,json=salaryMax,proto3" json:"salary_max,omitempty"`
+	Location           string                 `protobuf:"bytes,7,opt,name=location,proto3" json:"location,omitempty"`
+	ExperienceRequired int32                  `protobuf:"varint,8,opt,name=experience_required,json=experienceRequired,proto3" json:"experience_required,omitempty"`
+	EmployerId         string                 `protobuf:"bytes,9,opt,name=employer_id,json=employerId,proto3" json:"employer_id,omitempty"` // Will be extracted from token in implementation
+	Deadline           string                 `protobuf:"bytes,10,opt,name=deadline,proto3" json:"deadline,omitempty"`                      // Optional, RFC 3339
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *PostJobRequest) Reset() {
+	*x = PostJobRequest{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostJobRequest) ProtoMessage() {}
+
+func (x *PostJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostJobRequest.ProtoReflect.Descriptor instead.
+func (*PostJobRequest) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PostJobRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *PostJobRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *PostJobRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *PostJobRequest) GetRequiredSkills() []*JobSkill {
+	if x != nil {
+		return x.RequiredSkills
+	}
+	return nil
+}
+
+func (x *PostJobRequest) GetSalaryMin() int64 {
+	if x != nil {
+		return x.SalaryMin
+	}
+	return 0
+}
+
+func (x *PostJobRequest) GetSalaryMax() int64 {
+	if x != nil {
+		return x.SalaryMax
+	}
+	return 0
+}
+
+func (x *PostJobRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *PostJobRequest) GetExperienceRequired() int32 {
+	if x != nil {
+		return x.ExperienceRequired
+	}
+	return 0
+}
+
+func (x *PostJobRequest) GetEmployerId() string {
+	if x != nil {
+		return x.EmployerId
+	}
+	return ""
+}
+
+func (x *PostJobRequest) GetDeadline() string {
+	if x != nil {
+		return x.Deadline
+	}
+	return ""
+}
+
+type PostJobResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         uint64                 `protobuf:"varint,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"` // Changed from string to uint64
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostJobResponse) Reset() {
+	*x = PostJobResponse{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostJobResponse) ProtoMessage() {}
+
+func (x *PostJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostJobResponse.ProtoReflect.Descriptor instead.
+func (*PostJobResponse) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PostJobResponse) GetJobId() uint64 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+func (x *PostJobResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// GetJobs request/response with filters
+type GetJobsRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Category           string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`                                                // Optional category filter
+	Keyword            string                 `protobuf:"bytes,2,opt,name=keyword,proto3" json:"keyword,omitempty"`                                                  // Optional keyword search
+	Location           string                 `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`                                                // Optional location filter
+	ExperienceRequired int32                  `protobuf:"varint,4,opt,name=experience_required,json=experienceRequired,proto3" json:"experience_required,omitempty"` // Optional experience required filter (in years)
+	IncludeTerms       []string               `protobuf:"bytes,5,rep,name=include_terms,json=includeTerms,proto3" json:"include_terms,omitempty"`                    // Terms a job must match, split from keyword
+	ExcludeTerms       []string               `protobuf:"bytes,6,rep,name=exclude_terms,json=excludeTerms,proto3" json:"exclude_terms,omitempty"`                    // Terms a job must not match
+	Page               int32                  `protobuf:"varint,7,opt,name=page,proto3" json:"page,omitempty"`
+	Limit              int32                  `protobuf:"varint,8,opt,name=limit,proto3" json:"limit,omitempty"`
+	Status             string                 `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"` // Optional status filter
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetJobsRequest) Reset() {
+	*x = GetJobsRequest{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJobsRequest) ProtoMessage() {}
+
+func (x *GetJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJobsRequest.ProtoReflect.Descriptor instead.
+func (*GetJobsRequest) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetJobsRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *GetJobsRequest) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *GetJobsRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *GetJobsRequest) GetExperienceRequired() int32 {
+	if x != nil {
+		return x.ExperienceRequired
+	}
+	return 0
+}
+
+func (x *GetJobsRequest) GetIncludeTerms() []string {
+	if x != nil {
+		return x.IncludeTerms
+	}
+	return nil
+}
+
+func (x *GetJobsRequest) GetExcludeTerms() []string {
+	if x != nil {
+		return x.ExcludeTerms
+	}
+	return nil
+}
+
+func (x *GetJobsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetJobsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetJobsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type GetJobsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jobs          []*Job                 `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJobsResponse) Reset() {
+	*x = GetJobsResponse{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJobsResponse) ProtoMessage() {}
+
+func (x *GetJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJobsResponse.ProtoReflect.Descriptor instead.
+func (*GetJobsResponse) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetJobsResponse) GetJobs() []*Job {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+func (x *GetJobsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// GetJobById request/response
+type GetJobByIdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         uint64                 `protobuf:"varint,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"` // Changed from string to uint64
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJobByIdRequest) Reset() {
+	*x = GetJobByIdRequest{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJobByIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJobByIdRequest) ProtoMessage() {}
+
+func (x *GetJobByIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJobByIdRequest.ProtoReflect.Descriptor instead.
+func (*GetJobByIdRequest) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetJobByIdRequest) GetJobId() uint64 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+type GetJobByIdResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Job           *Job                   `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJobByIdResponse) Reset() {
+	*x = GetJobByIdResponse{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJobByIdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJobByIdResponse) ProtoMessage() {}
+
+func (x *GetJobByIdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJobByIdResponse.ProtoReflect.Descriptor instead.
+func (*GetJobByIdResponse) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetJobByIdResponse) GetJob() *Job {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+// ApplyToJob request/response
+type ApplyToJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         uint64                 `protobuf:"varint,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`                  // Changed from string to uint64
+	CandidateId   string                 `protobuf:"bytes,2,opt,name=candidate_id,json=candidateId,proto3" json:"candidate_id,omitempty"` // Will be extracted from token in implementation
+	ResumeUrl     string                 `protobuf:"bytes,3,opt,name=resume_url,json=resumeUrl,proto3" json:"resume_url,omitempty"`       // Optional - can be retrieved from candidate profile
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyToJobRequest) Reset() {
+	*x = ApplyToJobRequest{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyToJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyToJobRequest) ProtoMessage() {}
+
+func (x *ApplyToJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyToJobRequest.ProtoReflect.Descriptor instead.
+func (*ApplyToJobRequest) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ApplyToJobRequest) GetJobId() uint64 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+func (x *ApplyToJobRequest) GetCandidateId() string {
+	if x != nil {
+		return x.CandidateId
+	}
+	return ""
+}
+
+func (x *ApplyToJobRequest) GetResumeUrl() string {
+	if x != nil {
+		return x.ResumeUrl
+	}
+	return ""
+}
+
+type ApplyToJobResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId uint64                 `protobuf:"varint,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"` // Changed from string to uint64
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyToJobResponse) Reset() {
+	*x = ApplyToJobResponse{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyToJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyToJobResponse) ProtoMessage() {}
+
+func (x *ApplyToJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyToJobResponse.ProtoReflect.Descriptor instead.
+func (*ApplyToJobResponse) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ApplyToJobResponse) GetApplicationId() uint64 {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return 0
+}
+
+func (x *ApplyToJobResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// GetApplications request/response
+type GetApplicationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         uint64                 `protobuf:"varint,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`                  // For employer to view applications for a job
+	CandidateId   string                 `protobuf:"bytes,2,opt,name=candidate_id,json=candidateId,proto3" json:"candidate_id,omitempty"` // For candidate to view their applications
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`                              // Filter by status
+	Page          int32                  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetApplicationsRequest) Reset() {
+	*x = GetApplicationsRequest{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetApplicationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetApplicationsRequest) ProtoMessage() {}
+
+func (x *GetApplicationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetApplicationsRequest.ProtoReflect.Descriptor instead.
+func (*GetApplicationsRequest) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetApplicationsRequest) GetJobId() uint64 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+func (x *GetApplicationsRequest) GetCandidateId() string {
+	if x != nil {
+		return x.CandidateId
+	}
+	return ""
+}
+
+func (x *GetApplicationsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetApplicationsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetApplicationsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetApplicationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Applications  []*ApplicationResponse `protobuf:"bytes,1,rep,name=applications,proto3" json:"applications,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetApplicationsResponse) Reset() {
+	*x = GetApplicationsResponse{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetApplicationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetApplicationsResponse) ProtoMessage() {}
+
+func (x *GetApplicationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetApplicationsResponse.ProtoReflect.Descriptor instead.
+func (*GetApplicationsResponse) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetApplicationsResponse) GetApplications() []*ApplicationResponse {
+	if x != nil {
+		return x.Applications
+	}
+	return nil
+}
+
+func (x *GetApplicationsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// GetApplication request/response
+type GetApplicationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId uint64                 `protobuf:"varint,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetApplicationRequest) Reset() {
+	*x = GetApplicationRequest{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetApplicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetApplicationRequest) ProtoMessage() {}
+
+func (x *GetApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetApplicationRequest.ProtoReflect.Descriptor instead.
+func (*GetApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetApplicationRequest) GetApplicationId() uint64 {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return 0
+}
+
+type GetApplicationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Application   *ApplicationResponse   `protobuf:"bytes,1,opt,name=application,proto3" json:"application,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetApplicationResponse) Reset() {
+	*x = GetApplicationResponse{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetApplicationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetApplicationResponse) ProtoMessage() {}
+
+func (x *GetApplicationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetApplicationResponse.ProtoReflect.Descriptor instead.
+func (*GetApplicationResponse) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetApplicationResponse) GetApplication() *ApplicationResponse {
+	if x != nil {
+		return x.Application
+	}
+	return nil
+}
+
+// UpdateApplicationStatus request/response
+type UpdateApplicationStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`                           // New status: Viewed, Shortlisted, Rejected
+	EmployerId    string                 `protobuf:"bytes,3,opt,name=employer_id,json=employerId,proto3" json:"employer_id,omitempty"` // Will be extracted from token in implementation
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`                           // Optional, shown to the candidate
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateApplicationStatusRequest) Reset() {
+	*x = UpdateApplicationStatusRequest{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateApplicationStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateApplicationStatusRequest) ProtoMessage() {}
+
+func (x *UpdateApplicationStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateApplicationStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateApplicationStatusRequest) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UpdateApplicationStatusRequest) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *UpdateApplicationStatusRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UpdateApplicationStatusRequest) GetEmployerId() string {
+	if x != nil {
+		return x.EmployerId
+	}
+	return ""
+}
+
+func (x *UpdateApplicationStatusRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type UpdateApplicationStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Application   *ApplicationResponse   `protobuf:"bytes,2,opt,name=application,proto3" json:"application,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateApplicationStatusResponse) Reset() {
+	*x = UpdateApplicationStatusResponse{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateApplicationStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateApplicationStatusResponse) ProtoMessage() {}
+
+func (x *UpdateApplicationStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateApplicationStatusResponse.ProtoReflect.Descriptor instead.
+func (*UpdateApplicationStatusResponse) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *UpdateApplicationStatusResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UpdateApplicationStatusResponse) GetApplication() *ApplicationResponse {
+	if x != nil {
+		return x.Application
+	}
+	return nil
+}
+
+// AddJobSkills request/response
+type AddJobSkillsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         uint64                 `protobuf:"varint,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"` // Changed to uint64 to match Job ID type
+	Skill         string                 `protobuf:"bytes,2,opt,name=skill,proto3" json:"skill,omitempty"`
+	Proficiency   string                 `protobuf:"bytes,3,opt,name=proficiency,proto3" json:"proficiency,omitempty"`
+	Skills        []*JobSkill            `protobuf:"bytes,4,rep,name=skills,proto3" json:"skills,omitempty"` // Several skills at once, alongside skill
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddJobSkillsRequest) Reset() {
+	*x = AddJobSkillsRequest{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddJobSkillsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddJobSkillsRequest) ProtoMessage() {}
+
+func (x *AddJobSkillsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddJobSkillsRequest.ProtoReflect.Descriptor instead.
+func (*AddJobSkillsRequest) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *AddJobSkillsRequest) GetJobId() uint64 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+func (x *AddJobSkillsRequest) GetSkill() string {
+	if x != nil {
+		return x.Skill
+	}
+	return ""
+}
+
+func (x *AddJobSkillsRequest) GetProficiency() string {
+	if x != nil {
+		return x.Proficiency
+	}
+	return ""
+}
+
+func (x *AddJobSkillsRequest) GetSkills() []*JobSkill {
+	if x != nil {
+		return x.Skills
+	}
+	return nil
+}
+
+type AddJobSkillsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddJobSkillsResponse) Reset() {
+	*x = AddJobSkillsResponse{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddJobSkillsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddJobSkillsResponse) ProtoMessage() {}
+
+func (x *AddJobSkillsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddJobSkillsResponse.ProtoReflect.Descriptor instead.
+func (*AddJobSkillsResponse) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *AddJobSkillsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// UpdateJobStatus request/response
+type UpdateJobStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`                           // OPEN, IN_PROGRESS, COMPLETED, CANCELLED
+	EmployerId    string                 `protobuf:"bytes,3,opt,name=employer_id,json=employerId,proto3" json:"employer_id,omitempty"` // Will be extracted from token
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateJobStatusRequest) Reset() {
+	*x = UpdateJobStatusRequest{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateJobStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateJobStatusRequest) ProtoMessage() {}
+
+func (x *UpdateJobStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateJobStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateJobStatusRequest) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *UpdateJobStatusRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *UpdateJobStatusRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UpdateJobStatusRequest) GetEmployerId() string {
+	if x != nil {
+		return x.EmployerId
+	}
+	return ""
+}
+
+type UpdateJobStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateJobStatusResponse) Reset() {
+	*x = UpdateJobStatusResponse{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateJobStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateJobStatusResponse) ProtoMessage() {}
+
+func (x *UpdateJobStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateJobStatusResponse.ProtoReflect.Descriptor instead.
+func (*UpdateJobStatusResponse) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *UpdateJobStatusResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// FilterApplications request/response
+type FilterApplicationsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	JobId           uint64                 `protobuf:"varint,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`                              // Job ID to filter applications for
+	EmployerId      string                 `protobuf:"bytes,2,opt,name=employer_id,json=employerId,proto3" json:"employer_id,omitempty"`                // Will be extracted from token
+	MinExperience   int32                  `protobuf:"varint,3,opt,name=min_experience,json=minExperience,proto3" json:"min_experience,omitempty"`      // Minimum years of experience
+	RequiredSkills  []string               `protobuf:"bytes,4,rep,name=required_skills,json=requiredSkills,proto3" json:"required_skills,omitempty"`    // Must-have skills
+	PreferredSkills []string               `protobuf:"bytes,5,rep,name=preferred_skills,json=preferredSkills,proto3" json:"preferred_skills,omitempty"` // Nice-to-have skills
+	Limit           int32                  `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`                                           // Maximum number of results to return
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *FilterApplicationsRequest) Reset() {
+	*x = FilterApplicationsRequest{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FilterApplicationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilterApplicationsRequest) ProtoMessage() {}
+
+func (x *FilterApplicationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilterApplicationsRequest.ProtoReflect.Descriptor instead.
+func (*FilterApplicationsRequest) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *FilterApplicationsRequest) GetJobId() uint64 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+func (x *FilterApplicationsRequest) GetEmployerId() string {
+	if x != nil {
+		return x.EmployerId
+	}
+	return ""
+}
+
+func (x *FilterApplicationsRequest) GetMinExperience() int32 {
+	if x != nil {
+		return x.MinExperience
+	}
+	return 0
+}
+
+func (x *FilterApplicationsRequest) GetRequiredSkills() []string {
+	if x != nil {
+		return x.RequiredSkills
+	}
+	return nil
+}
+
+func (x *FilterApplicationsRequest) GetPreferredSkills() []string {
+	if x != nil {
+		return x.PreferredSkills
+	}
+	return nil
+}
+
+func (x *FilterApplicationsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type RankedApplication struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Application    *ApplicationResponse   `protobuf:"bytes,1,opt,name=application,proto3" json:"application,omitempty"`                               // The application with job details
+	RelevanceScore float64                `protobuf:"fixed64,2,opt,name=relevance_score,json=relevanceScore,proto3" json:"relevance_score,omitempty"` // Score from 0-100 indicating relevance
+	MatchingSkills []string               `protobuf:"bytes,3,rep,name=matching_skills,json=matchingSkills,proto3" json:"matching_skills,omitempty"`   // Skills that matched job requirements
+	MissingSkills  []string               `protobuf:"bytes,4,rep,name=missing_skills,json=missingSkills,proto3" json:"missing_skills,omitempty"`      // Skills that were required but missing
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RankedApplication) Reset() {
+	*x = RankedApplication{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RankedApplication) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RankedApplication) ProtoMessage() {}
+
+func (x *RankedApplication) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RankedApplication.ProtoReflect.Descriptor instead.
+func (*RankedApplication) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RankedApplication) GetApplication() *ApplicationResponse {
+	if x != nil {
+		return x.Application
+	}
+	return nil
+}
+
+func (x *RankedApplication) GetRelevanceScore() float64 {
+	if x != nil {
+		return x.RelevanceScore
+	}
+	return 0
+}
+
+func (x *RankedApplication) GetMatchingSkills() []string {
+	if x != nil {
+		return x.MatchingSkills
+	}
+	return nil
+}
+
+func (x *RankedApplication) GetMissingSkills() []string {
+	if x != nil {
+		return x.MissingSkills
+	}
+	return nil
+}
+
+type FilterApplicationsResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	RankedApplications []*RankedApplication   `protobuf:"bytes,1,rep,name=ranked_applications,json=rankedApplications,proto3" json:"ranked_applications,omitempty"` // Applications sorted by relevance
+	TotalApplications  int32                  `protobuf:"varint,2,opt,name=total_applications,json=totalApplications,proto3" json:"total_applications,omitempty"`   // Total number of applications for this job
+	Message            string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`                                                 // Success or error message
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *FilterApplicationsResponse) Reset() {
+	*x = FilterApplicationsResponse{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FilterApplicationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilterApplicationsResponse) ProtoMessage() {}
+
+func (x *FilterApplicationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilterApplicationsResponse.ProtoReflect.Descriptor instead.
+func (*FilterApplicationsResponse) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *FilterApplicationsResponse) GetRankedApplications() []*RankedApplication {
+	if x != nil {
+		return x.RankedApplications
+	}
+	return nil
+}
+
+func (x *FilterApplicationsResponse) GetTotalApplications() int32 {
+	if x != nil {
+		return x.TotalApplications
+	}
+	return 0
+}
+
+func (x *FilterApplicationsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// ListEmployerJobs request/response
+type ListEmployerJobsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EmployerId    string                 `protobuf:"bytes,1,opt,name=employer_id,json=employerId,proto3" json:"employer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEmployerJobsRequest) Reset() {
+	*x = ListEmployerJobsRequest{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEmployerJobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEmployerJobsRequest) ProtoMessage() {}
+
+func (x *ListEmployerJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEmployerJobsRequest.ProtoReflect.Descriptor instead.
+func (*ListEmployerJobsRequest) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListEmployerJobsRequest) GetEmployerId() string {
+	if x != nil {
+		return x.EmployerId
+	}
+	return ""
+}
+
+type ListEmployerJobsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jobs          []*Job                 `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEmployerJobsResponse) Reset() {
+	*x = ListEmployerJobsResponse{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEmployerJobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEmployerJobsResponse) ProtoMessage() {}
+
+func (x *ListEmployerJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEmployerJobsResponse.ProtoReflect.Descriptor instead.
+func (*ListEmployerJobsResponse) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListEmployerJobsResponse) GetJobs() []*Job {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+// Employer dashboard stats request/response
+type EmployerJobStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EmployerId    string                 `protobuf:"bytes,1,opt,name=employer_id,json=employerId,proto3" json:"employer_id,omitempty"`
+	Period        string                 `protobuf:"bytes,2,opt,name=period,proto3" json:"period,omitempty"` // 7d, 30d or all
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmployerJobStatsRequest) Reset() {
+	*x = EmployerJobStatsRequest{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmployerJobStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmployerJobStatsRequest) ProtoMessage() {}
+
+func (x *EmployerJobStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmployerJobStatsRequest.ProtoReflect.Descriptor instead.
+func (*EmployerJobStatsRequest) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *EmployerJobStatsRequest) GetEmployerId() string {
+	if x != nil {
+		return x.EmployerId
+	}
+	return ""
+}
+
+func (x *EmployerJobStatsRequest) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+type EmployerJobStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TotalJobs     int64                  `protobuf:"varint,1,opt,name=total_jobs,json=totalJobs,proto3" json:"total_jobs,omitempty"`
+	OpenJobs      int64                  `protobuf:"varint,2,opt,name=open_jobs,json=openJobs,proto3" json:"open_jobs,omitempty"`
+	ClosedJobs    int64                  `protobuf:"varint,3,opt,name=closed_jobs,json=closedJobs,proto3" json:"closed_jobs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmployerJobStatsResponse) Reset() {
+	*x = EmployerJobStatsResponse{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmployerJobStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmployerJobStatsResponse) ProtoMessage() {}
+
+func (x *EmployerJobStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmployerJobStatsResponse.ProtoReflect.Descriptor instead.
+func (*EmployerJobStatsResponse) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *EmployerJobStatsResponse) GetTotalJobs() int64 {
+	if x != nil {
+		return x.TotalJobs
+	}
+	return 0
+}
+
+func (x *EmployerJobStatsResponse) GetOpenJobs() int64 {
+	if x != nil {
+		return x.OpenJobs
+	}
+	return 0
+}
+
+func (x *EmployerJobStatsResponse) GetClosedJobs() int64 {
+	if x != nil {
+		return x.ClosedJobs
+	}
+	return 0
+}
+
+type EmployerApplicationStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EmployerId    string                 `protobuf:"bytes,1,opt,name=employer_id,json=employerId,proto3" json:"employer_id,omitempty"`
+	Period        string                 `protobuf:"bytes,2,opt,name=period,proto3" json:"period,omitempty"` // 7d, 30d or all
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmployerApplicationStatsRequest) Reset() {
+	*x = EmployerApplicationStatsRequest{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmployerApplicationStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmployerApplicationStatsRequest) ProtoMessage() {}
+
+func (x *EmployerApplicationStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmployerApplicationStatsRequest.ProtoReflect.Descriptor instead.
+func (*EmployerApplicationStatsRequest) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *EmployerApplicationStatsRequest) GetEmployerId() string {
+	if x != nil {
+		return x.EmployerId
+	}
+	return ""
+}
+
+func (x *EmployerApplicationStatsRequest) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+type EmployerApplicationStatsResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	TotalApplications int64                  `protobuf:"varint,1,opt,name=total_applications,json=totalApplications,proto3" json:"total_applications,omitempty"`
+	PerJob            map[string]int64       `protobuf:"bytes,2,rep,name=per_job,json=perJob,proto3" json:"per_job,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // Keyed by job ID
+	ByStatus          map[string]int64       `protobuf:"bytes,3,rep,name=by_status,json=byStatus,proto3" json:"by_status,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *EmployerApplicationStatsResponse) Reset() {
+	*x = EmployerApplicationStatsResponse{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmployerApplicationStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmployerApplicationStatsResponse) ProtoMessage() {}
+
+func (x *EmployerApplicationStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmployerApplicationStatsResponse.ProtoReflect.Descriptor instead.
+func (*EmployerApplicationStatsResponse) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *EmployerApplicationStatsResponse) GetTotalApplications() int64 {
+	if x != nil {
+		return x.TotalApplications
+	}
+	return 0
+}
+
+func (x *EmployerApplicationStatsResponse) GetPerJob() map[string]int64 {
+	if x != nil {
+		return x.PerJob
+	}
+	return nil
+}
+
+func (x *EmployerApplicationStatsResponse) GetByStatus() map[string]int64 {
+	if x != nil {
+		return x.ByStatus
+	}
+	return nil
+}
+
+// RecommendedJobsCount request/response
+type RecommendedJobsCountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CandidateId   string                 `protobuf:"bytes,1,opt,name=candidate_id,json=candidateId,proto3" json:"candidate_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecommendedJobsCountRequest) Reset() {
+	*x = RecommendedJobsCountRequest{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecommendedJobsCountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecommendedJobsCountRequest) ProtoMessage() {}
+
+func (x *RecommendedJobsCountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecommendedJobsCountRequest.ProtoReflect.Descriptor instead.
+func (*RecommendedJobsCountRequest) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *RecommendedJobsCountRequest) GetCandidateId() string {
+	if x != nil {
+		return x.CandidateId
+	}
+	return ""
+}
+
+type RecommendedJobsCountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         int64                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecommendedJobsCountResponse) Reset() {
+	*x = RecommendedJobsCountResponse{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecommendedJobsCountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecommendedJobsCountResponse) ProtoMessage() {}
+
+func (x *RecommendedJobsCountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecommendedJobsCountResponse.ProtoReflect.Descriptor instead.
+func (*RecommendedJobsCountResponse) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *RecommendedJobsCountResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// RemoveJobSkill request/response
+type RemoveJobSkillRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Skill         string                 `protobuf:"bytes,2,opt,name=skill,proto3" json:"skill,omitempty"`
+	EmployerId    string                 `protobuf:"bytes,3,opt,name=employer_id,json=employerId,proto3" json:"employer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveJobSkillRequest) Reset() {
+	*x = RemoveJobSkillRequest{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveJobSkillRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveJobSkillRequest) ProtoMessage() {}
+
+func (x *RemoveJobSkillRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveJobSkillRequest.ProtoReflect.Descriptor instead.
+func (*RemoveJobSkillRequest) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *RemoveJobSkillRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *RemoveJobSkillRequest) GetSkill() string {
+	if x != nil {
+		return x.Skill
+	}
+	return ""
+}
+
+func (x *RemoveJobSkillRequest) GetEmployerId() string {
+	if x != nil {
+		return x.EmployerId
+	}
+	return ""
+}
+
+type RemoveJobSkillResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Skills        []*JobSkill            `protobuf:"bytes,2,rep,name=skills,proto3" json:"skills,omitempty"` // The job's skills after the removal
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveJobSkillResponse) Reset() {
+	*x = RemoveJobSkillResponse{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveJobSkillResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveJobSkillResponse) ProtoMessage() {}
+
+func (x *RemoveJobSkillResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveJobSkillResponse.ProtoReflect.Descriptor instead.
+func (*RemoveJobSkillResponse) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *RemoveJobSkillResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RemoveJobSkillResponse) GetSkills() []*JobSkill {
+	if x != nil {
+		return x.Skills
+	}
+	return nil
+}
+
+// ReplaceJobSkills request/response
+type ReplaceJobSkillsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Skills        []*JobSkill            `protobuf:"bytes,2,rep,name=skills,proto3" json:"skills,omitempty"`
+	EmployerId    string                 `protobuf:"bytes,3,opt,name=employer_id,json=employerId,proto3" json:"employer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplaceJobSkillsRequest) Reset() {
+	*x = ReplaceJobSkillsRequest{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplaceJobSkillsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplaceJobSkillsRequest) ProtoMessage() {}
+
+func (x *ReplaceJobSkillsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplaceJobSkillsRequest.ProtoReflect.Descriptor instead.
+func (*ReplaceJobSkillsRequest) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ReplaceJobSkillsRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *ReplaceJobSkillsRequest) GetSkills() []*JobSkill {
+	if x != nil {
+		return x.Skills
+	}
+	return nil
+}
+
+func (x *ReplaceJobSkillsRequest) GetEmployerId() string {
+	if x != nil {
+		return x.EmployerId
+	}
+	return ""
+}
+
+type ReplaceJobSkillsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Skills        []*JobSkill            `protobuf:"bytes,2,rep,name=skills,proto3" json:"skills,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplaceJobSkillsResponse) Reset() {
+	*x = ReplaceJobSkillsResponse{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplaceJobSkillsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplaceJobSkillsResponse) ProtoMessage() {}
+
+func (x *ReplaceJobSkillsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplaceJobSkillsResponse.ProtoReflect.Descriptor instead.
+func (*ReplaceJobSkillsResponse) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ReplaceJobSkillsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ReplaceJobSkillsResponse) GetSkills() []*JobSkill {
+	if x != nil {
+		return x.Skills
+	}
+	return nil
+}
+
+// Interview message
+type Interview struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ApplicationId   uint64                 `protobuf:"varint,2,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	EmployerId      string                 `protobuf:"bytes,3,opt,name=employer_id,json=employerId,proto3" json:"employer_id,omitempty"`
+	CandidateId     string                 `protobuf:"bytes,4,opt,name=candidate_id,json=candidateId,proto3" json:"candidate_id,omitempty"`
+	ScheduledAt     string                 `protobuf:"bytes,5,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"` // RFC 3339
+	JobTitle        string                 `protobuf:"bytes,6,opt,name=job_title,json=jobTitle,proto3" json:"job_title,omitempty"`
+	DurationMinutes int32                  `protobuf:"varint,7,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"`
+	Mode            string                 `protobuf:"bytes,8,opt,name=mode,proto3" json:"mode,omitempty"` // online or onsite
+	Location        string                 `protobuf:"bytes,9,opt,name=location,proto3" json:"location,omitempty"`
+	MeetingLink     string                 `protobuf:"bytes,10,opt,name=meeting_link,json=meetingLink,proto3" json:"meeting_link,omitempty"`
+	Status          string                 `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"` // SCHEDULED, RESCHEDULED, CANCELLED
+	CreatedAt       string                 `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       string                 `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Interview) Reset() {
+	*x = Interview{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Interview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Interview) ProtoMessage() {}
+
+func (x *Interview) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Interview.ProtoReflect.Descriptor instead.
+func (*Interview) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *Interview) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Interview) GetApplicationId() uint64 {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return 0
+}
+
+func (x *Interview) GetEmployerId() string {
+	if x != nil {
+		return x.EmployerId
+	}
+	return ""
+}
+
+func (x *Interview) GetCandidateId() string {
+	if x != nil {
+		return x.CandidateId
+	}
+	return ""
+}
+
+func (x *Interview) GetScheduledAt() string {
+	if x != nil {
+		return x.ScheduledAt
+	}
+	return ""
+}
+
+func (x *Interview) GetJobTitle() string {
+	if x != nil {
+		return x.JobTitle
+	}
+	return ""
+}
+
+func (x *Interview) GetDurationMinutes() int32 {
+	if x != nil {
+		return x.DurationMinutes
+	}
+	return 0
+}
+
+func (x *Interview) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *Interview) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *Interview) GetMeetingLink() string {
+	if x != nil {
+		return x.MeetingLink
+	}
+	return ""
+}
+
+func (x *Interview) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Interview) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Interview) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+// ScheduleInterview request/response
+type ScheduleInterviewRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId   uint64                 `protobuf:"varint,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	EmployerId      string                 `protobuf:"bytes,2,opt,name=employer_id,json=employerId,proto3" json:"employer_id,omitempty"`
+	ScheduledAt     string                 `protobuf:"bytes,3,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"` // RFC 3339
+	Mode            string                 `protobuf:"bytes,4,opt,name=mode,proto3" json:"mode,omitempty"`
+	Location        string                 `protobuf:"bytes,5,opt,name=location,proto3" json:"location,omitempty"`
+	MeetingLink     string                 `protobuf:"bytes,6,opt,name=meeting_link,json=meetingLink,proto3" json:"meeting_link,omitempty"`
+	DurationMinutes int32                  `protobuf:"varint,7,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ScheduleInterviewRequest) Reset() {
+	*x = ScheduleInterviewRequest{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleInterviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleInterviewRequest) ProtoMessage() {}
+
+func (x *ScheduleInterviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleInterviewRequest.ProtoReflect.Descriptor instead.
+func (*ScheduleInterviewRequest) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ScheduleInterviewRequest) GetApplicationId() uint64 {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return 0
+}
+
+func (x *ScheduleInterviewRequest) GetEmployerId() string {
+	if x != nil {
+		return x.EmployerId
+	}
+	return ""
+}
+
+func (x *ScheduleInterviewRequest) GetScheduledAt() string {
+	if x != nil {
+		return x.ScheduledAt
+	}
+	return ""
+}
+
+func (x *ScheduleInterviewRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *ScheduleInterviewRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *ScheduleInterviewRequest) GetMeetingLink() string {
+	if x != nil {
+		return x.MeetingLink
+	}
+	return ""
+}
+
+func (x *ScheduleInterviewRequest) GetDurationMinutes() int32 {
+	if x != nil {
+		return x.DurationMinutes
+	}
+	return 0
+}
+
+type InterviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Interview     *Interview             `protobuf:"bytes,1,opt,name=interview,proto3" json:"interview,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InterviewResponse) Reset() {
+	*x = InterviewResponse{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InterviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InterviewResponse) ProtoMessage() {}
+
+func (x *InterviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InterviewResponse.ProtoReflect.Descriptor instead.
+func (*InterviewResponse) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *InterviewResponse) GetInterview() *Interview {
+	if x != nil {
+		return x.Interview
+	}
+	return nil
+}
+
+// GetInterviews request/response
+type GetInterviewsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId uint64                 `protobuf:"varint,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInterviewsRequest) Reset() {
+	*x = GetInterviewsRequest{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInterviewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInterviewsRequest) ProtoMessage() {}
+
+func (x *GetInterviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInterviewsRequest.ProtoReflect.Descriptor instead.
+func (*GetInterviewsRequest) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetInterviewsRequest) GetApplicationId() uint64 {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return 0
+}
+
+type GetInterviewsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Interviews    []*Interview           `protobuf:"bytes,1,rep,name=interviews,proto3" json:"interviews,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInterviewsResponse) Reset() {
+	*x = GetInterviewsResponse{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInterviewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInterviewsResponse) ProtoMessage() {}
+
+func (x *GetInterviewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInterviewsResponse.ProtoReflect.Descriptor instead.
+func (*GetInterviewsResponse) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetInterviewsResponse) GetInterviews() []*Interview {
+	if x != nil {
+		return x.Interviews
+	}
+	return nil
+}
+
+// UpdateInterview request
+type UpdateInterviewRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	InterviewId     uint64                 `protobuf:"varint,1,opt,name=interview_id,json=interviewId,proto3" json:"interview_id,omitempty"`
+	Action          string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"` // reschedule or cancel
+	Reason          string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	ScheduledAt     string                 `protobuf:"bytes,4,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"` // Set when rescheduling
+	Mode            string                 `protobuf:"bytes,5,opt,name=mode,proto3" json:"mode,omitempty"`
+	Location        string                 `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"`
+	MeetingLink     string                 `protobuf:"bytes,7,opt,name=meeting_link,json=meetingLink,proto3" json:"meeting_link,omitempty"`
+	DurationMinutes int32                  `protobuf:"varint,8,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateInterviewRequest) Reset() {
+	*x = UpdateInterviewRequest{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateInterviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateInterviewRequest) ProtoMessage() {}
+
+func (x *UpdateInterviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateInterviewRequest.ProtoReflect.Descriptor instead.
+func (*UpdateInterviewRequest) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *UpdateInterviewRequest) GetInterviewId() uint64 {
+	if x != nil {
+		return x.InterviewId
+	}
+	return 0
+}
+
+func (x *UpdateInterviewRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *UpdateInterviewRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *UpdateInterviewRequest) GetScheduledAt() string {
+	if x != nil {
+		return x.ScheduledAt
+	}
+	return ""
+}
+
+func (x *UpdateInterviewRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *UpdateInterviewRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *UpdateInterviewRequest) GetMeetingLink() string {
+	if x != nil {
+		return x.MeetingLink
+	}
+	return ""
+}
+
+func (x *UpdateInterviewRequest) GetDurationMinutes() int32 {
+	if x != nil {
+		return x.DurationMinutes
+	}
+	return 0
+}
+
+// ListUpcomingInterviews request/response
+type ListUpcomingInterviewsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	From          string                 `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"` // RFC 3339
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUpcomingInterviewsRequest) Reset() {
+	*x = ListUpcomingInterviewsRequest{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUpcomingInterviewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUpcomingInterviewsRequest) ProtoMessage() {}
+
+func (x *ListUpcomingInterviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUpcomingInterviewsRequest.ProtoReflect.Descriptor instead.
+func (*ListUpcomingInterviewsRequest) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListUpcomingInterviewsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListUpcomingInterviewsRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *ListUpcomingInterviewsRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+type ListUpcomingInterviewsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Interviews    []*Interview           `protobuf:"bytes,1,rep,name=interviews,proto3" json:"interviews,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUpcomingInterviewsResponse) Reset() {
+	*x = ListUpcomingInterviewsResponse{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUpcomingInterviewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUpcomingInterviewsResponse) ProtoMessage() {}
+
+func (x *ListUpcomingInterviewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUpcomingInterviewsResponse.ProtoReflect.Descriptor instead.
+func (*ListUpcomingInterviewsResponse) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListUpcomingInterviewsResponse) GetInterviews() []*Interview {
+	if x != nil {
+		return x.Interviews
+	}
+	return nil
+}
+
+// InterviewFeedback message
+type InterviewFeedback struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	InterviewId        uint64                 `protobuf:"varint,2,opt,name=interview_id,json=interviewId,proto3" json:"interview_id,omitempty"`
+	AuthorId           string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	SkillsScore        int32                  `protobuf:"varint,4,opt,name=skills_score,json=skillsScore,proto3" json:"skills_score,omitempty"` // 1 to 5
+	CommunicationScore int32                  `protobuf:"varint,5,opt,name=communication_score,json=communicationScore,proto3" json:"communication_score,omitempty"`
+	OverallScore       int32                  `protobuf:"varint,6,opt,name=overall_score,json=overallScore,proto3" json:"overall_score,omitempty"`
+	Comments           string                 `protobuf:"bytes,7,opt,name=comments,proto3" json:"comments,omitempty"`
+	CreatedAt          string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt          string                 `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *InterviewFeedback) Reset() {
+	*x = InterviewFeedback{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InterviewFeedback) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InterviewFeedback) ProtoMessage() {}
+
+func (x *InterviewFeedback) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InterviewFeedback.ProtoReflect.Descriptor instead.
+func (*InterviewFeedback) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *InterviewFeedback) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *InterviewFeedback) GetInterviewId() uint64 {
+	if x != nil {
+		return x.InterviewId
+	}
+	return 0
+}
+
+func (x *InterviewFeedback) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *InterviewFeedback) GetSkillsScore() int32 {
+	if x != nil {
+		return x.SkillsScore
+	}
+	return 0
+}
+
+func (x *InterviewFeedback) GetCommunicationScore() int32 {
+	if x != nil {
+		return x.CommunicationScore
+	}
+	return 0
+}
+
+func (x *InterviewFeedback) GetOverallScore() int32 {
+	if x != nil {
+		return x.OverallScore
+	}
+	return 0
+}
+
+func (x *InterviewFeedback) GetComments() string {
+	if x != nil {
+		return x.Comments
+	}
+	return ""
+}
+
+func (x *InterviewFeedback) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *InterviewFeedback) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+// SubmitInterviewFeedback request/response
+type SubmitInterviewFeedbackRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	InterviewId        uint64                 `protobuf:"varint,1,opt,name=interview_id,json=interviewId,proto3" json:"interview_id,omitempty"`
+	EmployerId         string                 `protobuf:"bytes,2,opt,name=employer_id,json=employerId,proto3" json:"employer_id,omitempty"`
+	AuthorId           string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	SkillsScore        int32                  `protobuf:"varint,4,opt,name=skills_score,json=skillsScore,proto3" json:"skills_score,omitempty"`
+	CommunicationScore int32                  `protobuf:"varint,5,opt,name=communication_score,json=communicationScore,proto3" json:"communication_score,omitempty"`
+	OverallScore       int32                  `protobuf:"varint,6,opt,name=overall_score,json=overallScore,proto3" json:"overall_score,omitempty"`
+	Comments           string                 `protobuf:"bytes,7,opt,name=comments,proto3" json:"comments,omitempty"`
+	Upsert             bool                   `protobuf:"varint,8,opt,name=upsert,proto3" json:"upsert,omitempty"` // Replace the author's earlier feedback instead of failing
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *SubmitInterviewFeedbackRequest) Reset() {
+	*x = SubmitInterviewFeedbackRequest{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitInterviewFeedbackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitInterviewFeedbackRequest) ProtoMessage() {}
+
+func (x *SubmitInterviewFeedbackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitInterviewFeedbackRequest.ProtoReflect.Descriptor instead.
+func (*SubmitInterviewFeedbackRequest) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *SubmitInterviewFeedbackRequest) GetInterviewId() uint64 {
+	if x != nil {
+		return x.InterviewId
+	}
+	return 0
+}
+
+func (x *SubmitInterviewFeedbackRequest) GetEmployerId() string {
+	if x != nil {
+		return x.EmployerId
+	}
+	return ""
+}
+
+func (x *SubmitInterviewFeedbackRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *SubmitInterviewFeedbackRequest) GetSkillsScore() int32 {
+	if x != nil {
+		return x.SkillsScore
+	}
+	return 0
+}
+
+func (x *SubmitInterviewFeedbackRequest) GetCommunicationScore() int32 {
+	if x != nil {
+		return x.CommunicationScore
+	}
+	return 0
+}
+
+func (x *SubmitInterviewFeedbackRequest) GetOverallScore() int32 {
+	if x != nil {
+		return x.OverallScore
+	}
+	return 0
+}
+
+func (x *SubmitInterviewFeedbackRequest) GetComments() string {
+	if x != nil {
+		return x.Comments
+	}
+	return ""
+}
+
+func (x *SubmitInterviewFeedbackRequest) GetUpsert() bool {
+	if x != nil {
+		return x.Upsert
+	}
+	return false
+}
+
+type SubmitInterviewFeedbackResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Feedback      *InterviewFeedback     `protobuf:"bytes,1,opt,name=feedback,proto3" json:"feedback,omitempty"`
+	Created       bool                   `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"` // False when earlier feedback was replaced
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitInterviewFeedbackResponse) Reset() {
+	*x = SubmitInterviewFeedbackResponse{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitInterviewFeedbackResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitInterviewFeedbackResponse) ProtoMessage() {}
+
+func (x *SubmitInterviewFeedbackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitInterviewFeedbackResponse.ProtoReflect.Descriptor instead.
+func (*SubmitInterviewFeedbackResponse) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *SubmitInterviewFeedbackResponse) GetFeedback() *InterviewFeedback {
+	if x != nil {
+		return x.Feedback
+	}
+	return nil
+}
+
+func (x *SubmitInterviewFeedbackResponse) GetCreated() bool {
+	if x != nil {
+		return x.Created
+	}
+	return false
+}
+
+// GetInterviewFeedback request/response
+type GetInterviewFeedbackRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InterviewId   uint64                 `protobuf:"varint,1,opt,name=interview_id,json=interviewId,proto3" json:"interview_id,omitempty"`
+	EmployerId    string                 `protobuf:"bytes,2,opt,name=employer_id,json=employerId,proto3" json:"employer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInterviewFeedbackRequest) Reset() {
+	*x = GetInterviewFeedbackRequest{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInterviewFeedbackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInterviewFeedbackRequest) ProtoMessage() {}
+
+func (x *GetInterviewFeedbackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInterviewFeedbackRequest.ProtoReflect.Descriptor instead.
+func (*GetInterviewFeedbackRequest) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetInterviewFeedbackRequest) GetInterviewId() uint64 {
+	if x != nil {
+		return x.InterviewId
+	}
+	return 0
+}
+
+func (x *GetInterviewFeedbackRequest) GetEmployerId() string {
+	if x != nil {
+		return x.EmployerId
+	}
+	return ""
+}
+
+type GetInterviewFeedbackResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Feedback      []*InterviewFeedback   `protobuf:"bytes,1,rep,name=feedback,proto3" json:"feedback,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInterviewFeedbackResponse) Reset() {
+	*x = GetInterviewFeedbackResponse{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInterviewFeedbackResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInterviewFeedbackResponse) ProtoMessage() {}
+
+func (x *GetInterviewFeedbackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInterviewFeedbackResponse.ProtoReflect.Descriptor instead.
+func (*GetInterviewFeedbackResponse) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GetInterviewFeedbackResponse) GetFeedback() []*InterviewFeedback {
+	if x != nil {
+		return x.Feedback
+	}
+	return nil
+}
+
+// JobAlert message
+type JobAlert struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CandidateId     string                 `protobuf:"bytes,2,opt,name=candidate_id,json=candidateId,proto3" json:"candidate_id,omitempty"`
+	Keyword         string                 `protobuf:"bytes,3,opt,name=keyword,proto3" json:"keyword,omitempty"`
+	Category        string                 `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
+	Location        string                 `protobuf:"bytes,5,opt,name=location,proto3" json:"location,omitempty"`
+	Skills          []string               `protobuf:"bytes,6,rep,name=skills,proto3" json:"skills,omitempty"`
+	Frequency       string                 `protobuf:"bytes,7,opt,name=frequency,proto3" json:"frequency,omitempty"` // instant, daily or weekly
+	CreatedAt       string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastTriggeredAt string                 `protobuf:"bytes,9,opt,name=last_triggered_at,json=lastTriggeredAt,proto3" json:"last_triggered_at,omitempty"`
+	IncludeTerms    []string               `protobuf:"bytes,10,rep,name=include_terms,json=includeTerms,proto3" json:"include_terms,omitempty"`
+	ExcludeTerms    []string               `protobuf:"bytes,11,rep,name=exclude_terms,json=excludeTerms,proto3" json:"exclude_terms,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *JobAlert) Reset() {
+	*x = JobAlert{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobAlert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobAlert) ProtoMessage() {}
+
+func (x *JobAlert) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobAlert.ProtoReflect.Descriptor instead.
+func (*JobAlert) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *JobAlert) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *JobAlert) GetCandidateId() string {
+	if x != nil {
+		return x.CandidateId
+	}
+	return ""
+}
+
+func (x *JobAlert) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *JobAlert) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *JobAlert) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *JobAlert) GetSkills() []string {
+	if x != nil {
+		return x.Skills
+	}
+	return nil
+}
+
+func (x *JobAlert) GetFrequency() string {
+	if x != nil {
+		return x.Frequency
+	}
+	return ""
+}
+
+func (x *JobAlert) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *JobAlert) GetLastTriggeredAt() string {
+	if x != nil {
+		return x.LastTriggeredAt
+	}
+	return ""
+}
+
+func (x *JobAlert) GetIncludeTerms() []string {
+	if x != nil {
+		return x.IncludeTerms
+	}
+	return nil
+}
+
+func (x *JobAlert) GetExcludeTerms() []string {
+	if x != nil {
+		return x.ExcludeTerms
+	}
+	return nil
+}
+
+// CreateJobAlert request/response
+type CreateJobAlertRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CandidateId   string                 `protobuf:"bytes,1,opt,name=candidate_id,json=candidateId,proto3" json:"candidate_id,omitempty"`
+	Keyword       string                 `protobuf:"bytes,2,opt,name=keyword,proto3" json:"keyword,omitempty"`
+	Category      string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	Location      string                 `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`
+	Skills        []string               `protobuf:"bytes,5,rep,name=skills,proto3" json:"skills,omitempty"`
+	Frequency     string                 `protobuf:"bytes,6,opt,name=frequency,proto3" json:"frequency,omitempty"`
+	IncludeTerms  []string               `protobuf:"bytes,7,rep,name=include_terms,json=includeTerms,proto3" json:"include_terms,omitempty"`
+	ExcludeTerms  []string               `protobuf:"bytes,8,rep,name=exclude_terms,json=excludeTerms,proto3" json:"exclude_terms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateJobAlertRequest) Reset() {
+	*x = CreateJobAlertRequest{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateJobAlertRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateJobAlertRequest) ProtoMessage() {}
+
+func (x *CreateJobAlertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateJobAlertRequest.ProtoReflect.Descriptor instead.
+func (*CreateJobAlertRequest) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *CreateJobAlertRequest) GetCandidateId() string {
+	if x != nil {
+		return x.CandidateId
+	}
+	return ""
+}
+
+func (x *CreateJobAlertRequest) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *CreateJobAlertRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *CreateJobAlertRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *CreateJobAlertRequest) GetSkills() []string {
+	if x != nil {
+		return x.Skills
+	}
+	return nil
+}
+
+func (x *CreateJobAlertRequest) GetFrequency() string {
+	if x != nil {
+		return x.Frequency
+	}
+	return ""
+}
+
+func (x *CreateJobAlertRequest) GetIncludeTerms() []string {
+	if x != nil {
+		return x.IncludeTerms
+	}
+	return nil
+}
+
+func (x *CreateJobAlertRequest) GetExcludeTerms() []string {
+	if x != nil {
+		return x.ExcludeTerms
+	}
+	return nil
+}
+
+type CreateJobAlertResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Alert         *JobAlert              `protobuf:"bytes,1,opt,name=alert,proto3" json:"alert,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateJobAlertResponse) Reset() {
+	*x = CreateJobAlertResponse{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateJobAlertResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateJobAlertResponse) ProtoMessage() {}
+
+func (x *CreateJobAlertResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateJobAlertResponse.ProtoReflect.Descriptor instead.
+func (*CreateJobAlertResponse) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *CreateJobAlertResponse) GetAlert() *JobAlert {
+	if x != nil {
+		return x.Alert
+	}
+	return nil
+}
+
+// ListJobAlerts request/response
+type ListJobAlertsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CandidateId   string                 `protobuf:"bytes,1,opt,name=candidate_id,json=candidateId,proto3" json:"candidate_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJobAlertsRequest) Reset() {
+	*x = ListJobAlertsRequest{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJobAlertsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobAlertsRequest) ProtoMessage() {}
+
+func (x *ListJobAlertsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobAlertsRequest.ProtoReflect.Descriptor instead.
+func (*ListJobAlertsRequest) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListJobAlertsRequest) GetCandidateId() string {
+	if x != nil {
+		return x.CandidateId
+	}
+	return ""
+}
+
+type ListJobAlertsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Alerts        []*JobAlert            `protobuf:"bytes,1,rep,name=alerts,proto3" json:"alerts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJobAlertsResponse) Reset() {
+	*x = ListJobAlertsResponse{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJobAlertsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobAlertsResponse) ProtoMessage() {}
+
+func (x *ListJobAlertsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobAlertsResponse.ProtoReflect.Descriptor instead.
+func (*ListJobAlertsResponse) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ListJobAlertsResponse) GetAlerts() []*JobAlert {
+	if x != nil {
+		return x.Alerts
+	}
+	return nil
+}
+
+// DeleteJobAlert request/response
+type DeleteJobAlertRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AlertId       string                 `protobuf:"bytes,1,opt,name=alert_id,json=alertId,proto3" json:"alert_id,omitempty"`
+	CandidateId   string                 `protobuf:"bytes,2,opt,name=candidate_id,json=candidateId,proto3" json:"candidate_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteJobAlertRequest) Reset() {
+	*x = DeleteJobAlertRequest{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteJobAlertRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteJobAlertRequest) ProtoMessage() {}
+
+func (x *DeleteJobAlertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteJobAlertRequest.ProtoReflect.Descriptor instead.
+func (*DeleteJobAlertRequest) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *DeleteJobAlertRequest) GetAlertId() string {
+	if x != nil {
+		return x.AlertId
+	}
+	return ""
+}
+
+func (x *DeleteJobAlertRequest) GetCandidateId() string {
+	if x != nil {
+		return x.CandidateId
+	}
+	return ""
+}
+
+type DeleteJobAlertResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteJobAlertResponse) Reset() {
+	*x = DeleteJobAlertResponse{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteJobAlertResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteJobAlertResponse) ProtoMessage() {}
+
+func (x *DeleteJobAlertResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteJobAlertResponse.ProtoReflect.Descriptor instead.
+func (*DeleteJobAlertResponse) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *DeleteJobAlertResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Webhook message
+type Webhook struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EmployerId    string                 `protobuf:"bytes,2,opt,name=employer_id,json=employerId,proto3" json:"employer_id,omitempty"`
+	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	Secret        string                 `protobuf:"bytes,4,opt,name=secret,proto3" json:"secret,omitempty"` // Signs deliveries; only returned to the gateway
+	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Webhook) Reset() {
+	*x = Webhook{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Webhook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Webhook) ProtoMessage() {}
+
+func (x *Webhook) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Webhook.ProtoReflect.Descriptor instead.
+func (*Webhook) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *Webhook) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Webhook) GetEmployerId() string {
+	if x != nil {
+		return x.EmployerId
+	}
+	return ""
+}
+
+func (x *Webhook) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Webhook) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *Webhook) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// CreateWebhook request/response
+type CreateWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EmployerId    string                 `protobuf:"bytes,1,opt,name=employer_id,json=employerId,proto3" json:"employer_id,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Secret        string                 `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWebhookRequest) Reset() {
+	*x = CreateWebhookRequest{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookRequest) ProtoMessage() {}
+
+func (x *CreateWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookRequest.ProtoReflect.Descriptor instead.
+func (*CreateWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *CreateWebhookRequest) GetEmployerId() string {
+	if x != nil {
+		return x.EmployerId
+	}
+	return ""
+}
+
+func (x *CreateWebhookRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CreateWebhookRequest) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+type CreateWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Webhook       *Webhook               `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWebhookResponse) Reset() {
+	*x = CreateWebhookResponse{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookResponse) ProtoMessage() {}
+
+func (x *CreateWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookResponse.ProtoReflect.Descriptor instead.
+func (*CreateWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *CreateWebhookResponse) GetWebhook() *Webhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+// ListWebhooks request/response
+type ListWebhooksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EmployerId    string                 `protobuf:"bytes,1,opt,name=employer_id,json=employerId,proto3" json:"employer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhooksRequest) Reset() {
+	*x = ListWebhooksRequest{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhooksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksRequest) ProtoMessage() {}
+
+func (x *ListWebhooksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhooksRequest) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ListWebhooksRequest) GetEmployerId() string {
+	if x != nil {
+		return x.EmployerId
+	}
+	return ""
+}
+
+type ListWebhooksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Webhooks      []*Webhook             `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhooksResponse) Reset() {
+	*x = ListWebhooksResponse{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhooksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksResponse) ProtoMessage() {}
+
+func (x *ListWebhooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhooksResponse) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ListWebhooksResponse) GetWebhooks() []*Webhook {
+	if x != nil {
+		return x.Webhooks
+	}
+	return nil
+}
+
+// DeleteWebhook request/response
+type DeleteWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WebhookId     string                 `protobuf:"bytes,1,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	EmployerId    string                 `protobuf:"bytes,2,opt,name=employer_id,json=employerId,proto3" json:"employer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookRequest) Reset() {
+	*x = DeleteWebhookRequest{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookRequest) ProtoMessage() {}
+
+func (x *DeleteWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *DeleteWebhookRequest) GetWebhookId() string {
+	if x != nil {
+		return x.WebhookId
+	}
+	return ""
+}
+
+func (x *DeleteWebhookRequest) GetEmployerId() string {
+	if x != nil {
+		return x.EmployerId
+	}
+	return ""
+}
+
+type DeleteWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookResponse) Reset() {
+	*x = DeleteWebhookResponse{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookResponse) ProtoMessage() {}
+
+func (x *DeleteWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *DeleteWebhookResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Job moderation requests/responses
+type ReportJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         uint64                 `protobuf:"varint,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	ReporterId    string                 `protobuf:"bytes,2,opt,name=reporter_id,json=reporterId,proto3" json:"reporter_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"` // spam, scam, misleading, discriminatory, inappropriate, other
+	Details       string                 `protobuf:"bytes,4,opt,name=details,proto3" json:"details,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportJobRequest) Reset() {
+	*x = ReportJobRequest{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportJobRequest) ProtoMessage() {}
+
+func (x *ReportJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportJobRequest.ProtoReflect.Descriptor instead.
+func (*ReportJobRequest) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ReportJobRequest) GetJobId() uint64 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+func (x *ReportJobRequest) GetReporterId() string {
+	if x != nil {
+		return x.ReporterId
+	}
+	return ""
+}
+
+func (x *ReportJobRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ReportJobRequest) GetDetails() string {
+	if x != nil {
+		return x.Details
+	}
+	return ""
+}
+
+type ReportJobResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReportId      string                 `protobuf:"bytes,1,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportJobResponse) Reset() {
+	*x = ReportJobResponse{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportJobResponse) ProtoMessage() {}
+
+func (x *ReportJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportJobResponse.ProtoReflect.Descriptor instead.
+func (*ReportJobResponse) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ReportJobResponse) GetReportId() string {
+	if x != nil {
+		return x.ReportId
+	}
+	return ""
+}
+
+type ListJobsForModerationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"` // pending_review or reported
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJobsForModerationRequest) Reset() {
+	*x = ListJobsForModerationRequest{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJobsForModerationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobsForModerationRequest) ProtoMessage() {}
+
+func (x *ListJobsForModerationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobsForModerationRequest.ProtoReflect.Descriptor instead.
+func (*ListJobsForModerationRequest) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ListJobsForModerationRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListJobsForModerationRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListJobsForModerationRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListJobsForModerationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jobs          []*Job                 `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJobsForModerationResponse) Reset() {
+	*x = ListJobsForModerationResponse{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJobsForModerationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobsForModerationResponse) ProtoMessage() {}
+
+func (x *ListJobsForModerationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobsForModerationResponse.ProtoReflect.Descriptor instead.
+func (*ListJobsForModerationResponse) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ListJobsForModerationResponse) GetJobs() []*Job {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+func (x *ListJobsForModerationResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type ModerateJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         uint64                 `protobuf:"varint,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Action        string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"` // approve, reject or takedown
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	AdminId       string                 `protobuf:"bytes,4,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModerateJobRequest) Reset() {
+	*x = ModerateJobRequest{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerateJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerateJobRequest) ProtoMessage() {}
+
+func (x *ModerateJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerateJobRequest.ProtoReflect.Descriptor instead.
+func (*ModerateJobRequest) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ModerateJobRequest) GetJobId() uint64 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+func (x *ModerateJobRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ModerateJobRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ModerateJobRequest) GetAdminId() string {
+	if x != nil {
+		return x.AdminId
+	}
+	return ""
+}
+
+type ModerateJobResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Job           *Job                   `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModerateJobResponse) Reset() {
+	*x = ModerateJobResponse{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerateJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerateJobResponse) ProtoMessage() {}
+
+func (x *ModerateJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerateJobResponse.ProtoReflect.Descriptor instead.
+func (*ModerateJobResponse) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ModerateJobResponse) GetJob() *Job {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+// MarkJobPremium request/response
+type MarkJobPremiumRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         uint64                 `protobuf:"varint,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	EmployerId    string                 `protobuf:"bytes,2,opt,name=employer_id,json=employerId,proto3" json:"employer_id,omitempty"`
+	PaymentId     string                 `protobuf:"bytes,3,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	Amount        int64                  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"` // In the currency's minor unit
+	Currency      string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkJobPremiumRequest) Reset() {
+	*x = MarkJobPremiumRequest{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkJobPremiumRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkJobPremiumRequest) ProtoMessage() {}
+
+func (x *MarkJobPremiumRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkJobPremiumRequest.ProtoReflect.Descriptor instead.
+func (*MarkJobPremiumRequest) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *MarkJobPremiumRequest) GetJobId() uint64 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+func (x *MarkJobPremiumRequest) GetEmployerId() string {
+	if x != nil {
+		return x.EmployerId
+	}
+	return ""
+}
+
+func (x *MarkJobPremiumRequest) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *MarkJobPremiumRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *MarkJobPremiumRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type MarkJobPremiumResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkJobPremiumResponse) Reset() {
+	*x = MarkJobPremiumResponse{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkJobPremiumResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkJobPremiumResponse) ProtoMessage() {}
+
+func (x *MarkJobPremiumResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkJobPremiumResponse.ProtoReflect.Descriptor instead.
+func (*MarkJobPremiumResponse) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *MarkJobPremiumResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Job view requests/responses
+type JobViewCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         uint64                 `protobuf:"varint,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Views         int64                  `protobuf:"varint,2,opt,name=views,proto3" json:"views,omitempty"`
+	ViewerSketch  []byte                 `protobuf:"bytes,3,opt,name=viewer_sketch,json=viewerSketch,proto3" json:"viewer_sketch,omitempty"` // HyperLogLog of distinct viewers, merged into the stored one
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JobViewCount) Reset() {
+	*x = JobViewCount{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobViewCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobViewCount) ProtoMessage() {}
+
+func (x *JobViewCount) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobViewCount.ProtoReflect.Descriptor instead.
+func (*JobViewCount) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *JobViewCount) GetJobId() uint64 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+func (x *JobViewCount) GetViews() int64 {
+	if x != nil {
+		return x.Views
+	}
+	return 0
+}
+
+func (x *JobViewCount) GetViewerSketch() []byte {
+	if x != nil {
+		return x.ViewerSketch
+	}
+	return nil
+}
+
+type RecordJobViewsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Counts        []*JobViewCount        `protobuf:"bytes,1,rep,name=counts,proto3" json:"counts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordJobViewsRequest) Reset() {
+	*x = RecordJobViewsRequest{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordJobViewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordJobViewsRequest) ProtoMessage() {}
+
+func (x *RecordJobViewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordJobViewsRequest.ProtoReflect.Descriptor instead.
+func (*RecordJobViewsRequest) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *RecordJobViewsRequest) GetCounts() []*JobViewCount {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+type RecordJobViewsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordJobViewsResponse) Reset() {
+	*x = RecordJobViewsResponse{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordJobViewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordJobViewsResponse) ProtoMessage() {}
+
+func (x *RecordJobViewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordJobViewsResponse.ProtoReflect.Descriptor instead.
+func (*RecordJobViewsResponse) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *RecordJobViewsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetJobAnalyticsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         uint64                 `protobuf:"varint,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	EmployerId    string                 `protobuf:"bytes,2,opt,name=employer_id,json=employerId,proto3" json:"employer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJobAnalyticsRequest) Reset() {
+	*x = GetJobAnalyticsRequest{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJobAnalyticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJobAnalyticsRequest) ProtoMessage() {}
+
+func (x *GetJobAnalyticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJobAnalyticsRequest.ProtoReflect.Descriptor instead.
+func (*GetJobAnalyticsRequest) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *GetJobAnalyticsRequest) GetJobId() uint64 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+func (x *GetJobAnalyticsRequest) GetEmployerId() string {
+	if x != nil {
+		return x.EmployerId
+	}
+	return ""
+}
+
+type GetJobAnalyticsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Views         int64                  `protobuf:"varint,1,opt,name=views,proto3" json:"views,omitempty"`
+	ViewerSketch  []byte                 `protobuf:"bytes,2,opt,name=viewer_sketch,json=viewerSketch,proto3" json:"viewer_sketch,omitempty"`
+	Applications  int64                  `protobuf:"varint,3,opt,name=applications,proto3" json:"applications,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJobAnalyticsResponse) Reset() {
+	*x = GetJobAnalyticsResponse{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJobAnalyticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJobAnalyticsResponse) ProtoMessage() {}
+
+func (x *GetJobAnalyticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJobAnalyticsResponse.ProtoReflect.Descriptor instead.
+func (*GetJobAnalyticsResponse) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *GetJobAnalyticsResponse) GetViews() int64 {
+	if x != nil {
+		return x.Views
+	}
+	return 0
+}
+
+func (x *GetJobAnalyticsResponse) GetViewerSketch() []byte {
+	if x != nil {
+		return x.ViewerSketch
+	}
+	return nil
+}
+
+func (x *GetJobAnalyticsResponse) GetApplications() int64 {
+	if x != nil {
+		return x.Applications
+	}
+	return 0
+}
+
+// Skill taxonomy requests/responses
+type TaxonomySkill struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Category      string                 `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	Aliases       []string               `protobuf:"bytes,3,rep,name=aliases,proto3" json:"aliases,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaxonomySkill) Reset() {
+	*x = TaxonomySkill{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaxonomySkill) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaxonomySkill) ProtoMessage() {}
+
+func (x *TaxonomySkill) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaxonomySkill.ProtoReflect.Descriptor instead.
+func (*TaxonomySkill) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *TaxonomySkill) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TaxonomySkill) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *TaxonomySkill) GetAliases() []string {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+type ListSkillTaxonomyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSkillTaxonomyRequest) Reset() {
+	*x = ListSkillTaxonomyRequest{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSkillTaxonomyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSkillTaxonomyRequest) ProtoMessage() {}
+
+func (x *ListSkillTaxonomyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSkillTaxonomyRequest.ProtoReflect.Descriptor instead.
+func (*ListSkillTaxonomyRequest) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{82}
+}
+
+type ListSkillTaxonomyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Skills        []*TaxonomySkill       `protobuf:"bytes,1,rep,name=skills,proto3" json:"skills,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSkillTaxonomyResponse) Reset() {
+	*x = ListSkillTaxonomyResponse{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSkillTaxonomyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSkillTaxonomyResponse) ProtoMessage() {}
+
+func (x *ListSkillTaxonomyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSkillTaxonomyResponse.ProtoReflect.Descriptor instead.
+func (*ListSkillTaxonomyResponse) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ListSkillTaxonomyResponse) GetSkills() []*TaxonomySkill {
+	if x != nil {
+		return x.Skills
+	}
+	return nil
+}
+
+type AddSkillAliasRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Skill         string                 `protobuf:"bytes,1,opt,name=skill,proto3" json:"skill,omitempty"`
+	Alias         string                 `protobuf:"bytes,2,opt,name=alias,proto3" json:"alias,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddSkillAliasRequest) Reset() {
+	*x = AddSkillAliasRequest{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddSkillAliasRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddSkillAliasRequest) ProtoMessage() {}
+
+func (x *AddSkillAliasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddSkillAliasRequest.ProtoReflect.Descriptor instead.
+func (*AddSkillAliasRequest) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *AddSkillAliasRequest) GetSkill() string {
+	if x != nil {
+		return x.Skill
+	}
+	return ""
+}
+
+func (x *AddSkillAliasRequest) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+type AddSkillAliasResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Skill         *TaxonomySkill         `protobuf:"bytes,1,opt,name=skill,proto3" json:"skill,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddSkillAliasResponse) Reset() {
+	*x = AddSkillAliasResponse{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddSkillAliasResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddSkillAliasResponse) ProtoMessage() {}
+
+func (x *AddSkillAliasResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddSkillAliasResponse.ProtoReflect.Descriptor instead.
+func (*AddSkillAliasResponse) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *AddSkillAliasResponse) GetSkill() *TaxonomySkill {
+	if x != nil {
+		return x.Skill
+	}
+	return nil
+}
+
+// Application notes requests/responses
+type ApplicationNote struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ApplicationId uint64                 `protobuf:"varint,2,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	AuthorId      string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Body          string                 `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplicationNote) Reset() {
+	*x = ApplicationNote{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplicationNote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplicationNote) ProtoMessage() {}
+
+func (x *ApplicationNote) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplicationNote.ProtoReflect.Descriptor instead.
+func (*ApplicationNote) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *ApplicationNote) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ApplicationNote) GetApplicationId() uint64 {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return 0
+}
+
+func (x *ApplicationNote) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *ApplicationNote) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *ApplicationNote) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type AddApplicationNoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId uint64                 `protobuf:"varint,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	EmployerId    string                 `protobuf:"bytes,2,opt,name=employer_id,json=employerId,proto3" json:"employer_id,omitempty"`
+	AuthorId      string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Body          string                 `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddApplicationNoteRequest) Reset() {
+	*x = AddApplicationNoteRequest{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddApplicationNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddApplicationNoteRequest) ProtoMessage() {}
+
+func (x *AddApplicationNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddApplicationNoteRequest.ProtoReflect.Descriptor instead.
+func (*AddApplicationNoteRequest) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *AddApplicationNoteRequest) GetApplicationId() uint64 {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return 0
+}
+
+func (x *AddApplicationNoteRequest) GetEmployerId() string {
+	if x != nil {
+		return x.EmployerId
+	}
+	return ""
+}
+
+func (x *AddApplicationNoteRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *AddApplicationNoteRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+type AddApplicationNoteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Note          *ApplicationNote       `protobuf:"bytes,1,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddApplicationNoteResponse) Reset() {
+	*x = AddApplicationNoteResponse{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddApplicationNoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddApplicationNoteResponse) ProtoMessage() {}
+
+func (x *AddApplicationNoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddApplicationNoteResponse.ProtoReflect.Descriptor instead.
+func (*AddApplicationNoteResponse) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *AddApplicationNoteResponse) GetNote() *ApplicationNote {
+	if x != nil {
+		return x.Note
+	}
+	return nil
+}
+
+type ListApplicationNotesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId uint64                 `protobuf:"varint,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	EmployerId    string                 `protobuf:"bytes,2,opt,name=employer_id,json=employerId,proto3" json:"employer_id,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApplicationNotesRequest) Reset() {
+	*x = ListApplicationNotesRequest{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApplicationNotesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApplicationNotesRequest) ProtoMessage() {}
+
+func (x *ListApplicationNotesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApplicationNotesRequest.ProtoReflect.Descriptor instead.
+func (*ListApplicationNotesRequest) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *ListApplicationNotesRequest) GetApplicationId() uint64 {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return 0
+}
+
+func (x *ListApplicationNotesRequest) GetEmployerId() string {
+	if x != nil {
+		return x.EmployerId
+	}
+	return ""
+}
+
+func (x *ListApplicationNotesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListApplicationNotesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListApplicationNotesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notes         []*ApplicationNote     `protobuf:"bytes,1,rep,name=notes,proto3" json:"notes,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApplicationNotesResponse) Reset() {
+	*x = ListApplicationNotesResponse{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApplicationNotesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApplicationNotesResponse) ProtoMessage() {}
+
+func (x *ListApplicationNotesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApplicationNotesResponse.ProtoReflect.Descriptor instead.
+func (*ListApplicationNotesResponse) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *ListApplicationNotesResponse) GetNotes() []*ApplicationNote {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+func (x *ListApplicationNotesResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type DeleteApplicationNoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NoteId        uint64                 `protobuf:"varint,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	EmployerId    string                 `protobuf:"bytes,2,opt,name=employer_id,json=employerId,proto3" json:"employer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteApplicationNoteRequest) Reset() {
+	*x = DeleteApplicationNoteRequest{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteApplicationNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteApplicationNoteRequest) ProtoMessage() {}
+
+func (x *DeleteApplicationNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteApplicationNoteRequest.ProtoReflect.Descriptor instead.
+func (*DeleteApplicationNoteRequest) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *DeleteApplicationNoteRequest) GetNoteId() uint64 {
+	if x != nil {
+		return x.NoteId
+	}
+	return 0
+}
+
+func (x *DeleteApplicationNoteRequest) GetEmployerId() string {
+	if x != nil {
+		return x.EmployerId
+	}
+	return ""
+}
+
+type DeleteApplicationNoteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteApplicationNoteResponse) Reset() {
+	*x = DeleteApplicationNoteResponse{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteApplicationNoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteApplicationNoteResponse) ProtoMessage() {}
+
+func (x *DeleteApplicationNoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteApplicationNoteResponse.ProtoReflect.Descriptor instead.
+func (*DeleteApplicationNoteResponse) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *DeleteApplicationNoteResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type RateApplicationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId uint64                 `protobuf:"varint,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	EmployerId    string                 `protobuf:"bytes,2,opt,name=employer_id,json=employerId,proto3" json:"employer_id,omitempty"`
+	Rating        int32                  `protobuf:"varint,3,opt,name=rating,proto3" json:"rating,omitempty"` // 1 to 5
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RateApplicationRequest) Reset() {
+	*x = RateApplicationRequest{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RateApplicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateApplicationRequest) ProtoMessage() {}
+
+func (x *RateApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateApplicationRequest.ProtoReflect.Descriptor instead.
+func (*RateApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *RateApplicationRequest) GetApplicationId() uint64 {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return 0
+}
+
+func (x *RateApplicationRequest) GetEmployerId() string {
+	if x != nil {
+		return x.EmployerId
+	}
+	return ""
+}
+
+func (x *RateApplicationRequest) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+type RateApplicationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId uint64                 `protobuf:"varint,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	Rating        int32                  `protobuf:"varint,2,opt,name=rating,proto3" json:"rating,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RateApplicationResponse) Reset() {
+	*x = RateApplicationResponse{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RateApplicationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateApplicationResponse) ProtoMessage() {}
+
+func (x *RateApplicationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateApplicationResponse.ProtoReflect.Descriptor instead.
+func (*RateApplicationResponse) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *RateApplicationResponse) GetApplicationId() uint64 {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return 0
+}
+
+func (x *RateApplicationResponse) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+// Saved jobs requests/responses
+type SavedJob struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Job           *Job                   `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	SavedAt       string                 `protobuf:"bytes,2,opt,name=saved_at,json=savedAt,proto3" json:"saved_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SavedJob) Reset() {
+	*x = SavedJob{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SavedJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavedJob) ProtoMessage() {}
+
+func (x *SavedJob) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavedJob.ProtoReflect.Descriptor instead.
+func (*SavedJob) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *SavedJob) GetJob() *Job {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+func (x *SavedJob) GetSavedAt() string {
+	if x != nil {
+		return x.SavedAt
+	}
+	return ""
+}
+
+type ListSavedJobsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CandidateId   string                 `protobuf:"bytes,1,opt,name=candidate_id,json=candidateId,proto3" json:"candidate_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSavedJobsRequest) Reset() {
+	*x = ListSavedJobsRequest{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSavedJobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSavedJobsRequest) ProtoMessage() {}
+
+func (x *ListSavedJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSavedJobsRequest.ProtoReflect.Descriptor instead.
+func (*ListSavedJobsRequest) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *ListSavedJobsRequest) GetCandidateId() string {
+	if x != nil {
+		return x.CandidateId
+	}
+	return ""
+}
+
+type ListSavedJobsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SavedJobs     []*SavedJob            `protobuf:"bytes,1,rep,name=saved_jobs,json=savedJobs,proto3" json:"saved_jobs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSavedJobsResponse) Reset() {
+	*x = ListSavedJobsResponse{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSavedJobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSavedJobsResponse) ProtoMessage() {}
+
+func (x *ListSavedJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSavedJobsResponse.ProtoReflect.Descriptor instead.
+func (*ListSavedJobsResponse) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *ListSavedJobsResponse) GetSavedJobs() []*SavedJob {
+	if x != nil {
+		return x.SavedJobs
+	}
+	return nil
+}
+
+// Job templates requests/responses
+type JobTemplate struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name               string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Title              string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description        string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Category           string                 `protobuf:"bytes,5,opt,name=category,proto3" json:"category,omitempty"`
+	Location           string                 `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"`
+	SalaryMin          int64                  `protobuf:"varint,7,opt,name=salary_min,json=salaryMin,proto3" json:"salary_min,omitempty"`
+	SalaryMax          int64                  `protobuf:"varint,8,opt,name=salary_max,json=salaryMax,proto3" json:"salary_max,omitempty"`
+	ExperienceRequired int32                  `protobuf:"varint,9,opt,name=experience_required,json=experienceRequired,proto3" json:"experience_required,omitempty"`
+	RequiredSkills     []*JobSkill            `protobuf:"bytes,10,rep,name=required_skills,json=requiredSkills,proto3" json:"required_skills,omitempty"`
+	CreatedBy          string                 `protobuf:"bytes,11,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt          string                 `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *JobTemplate) Reset() {
+	*x = JobTemplate{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobTemplate) ProtoMessage() {}
+
+func (x *JobTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobTemplate.ProtoReflect.Descriptor instead.
+func (*JobTemplate) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *JobTemplate) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *JobTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *JobTemplate) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *JobTemplate) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *JobTemplate) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *JobTemplate) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *JobTemplate) GetSalaryMin() int64 {
+	if x != nil {
+		return x.SalaryMin
+	}
+	return 0
+}
+
+func (x *JobTemplate) GetSalaryMax() int64 {
+	if x != nil {
+		return x.SalaryMax
+	}
+	return 0
+}
+
+func (x *JobTemplate) GetExperienceRequired() int32 {
+	if x != nil {
+		return x.ExperienceRequired
+	}
+	return 0
+}
+
+func (x *JobTemplate) GetRequiredSkills() []*JobSkill {
+	if x != nil {
+		return x.RequiredSkills
+	}
+	return nil
+}
+
+func (x *JobTemplate) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *JobTemplate) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type CreateJobTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EmployerId    string                 `protobuf:"bytes,1,opt,name=employer_id,json=employerId,proto3" json:"employer_id,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,2,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	Template      *JobTemplate           `protobuf:"bytes,3,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateJobTemplateRequest) Reset() {
+	*x = CreateJobTemplateRequest{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateJobTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateJobTemplateRequest) ProtoMessage() {}
+
+func (x *CreateJobTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateJobTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateJobTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *CreateJobTemplateRequest) GetEmployerId() string {
+	if x != nil {
+		return x.EmployerId
+	}
+	return ""
+}
+
+func (x *CreateJobTemplateRequest) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *CreateJobTemplateRequest) GetTemplate() *JobTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type JobTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *JobTemplate           `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JobTemplateResponse) Reset() {
+	*x = JobTemplateResponse{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobTemplateResponse) ProtoMessage() {}
+
+func (x *JobTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobTemplateResponse.ProtoReflect.Descriptor instead.
+func (*JobTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *JobTemplateResponse) GetTemplate() *JobTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type ListJobTemplatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EmployerId    string                 `protobuf:"bytes,1,opt,name=employer_id,json=employerId,proto3" json:"employer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJobTemplatesRequest) Reset() {
+	*x = ListJobTemplatesRequest{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJobTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobTemplatesRequest) ProtoMessage() {}
+
+func (x *ListJobTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ListJobTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *ListJobTemplatesRequest) GetEmployerId() string {
+	if x != nil {
+		return x.EmployerId
+	}
+	return ""
+}
+
+type ListJobTemplatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Templates     []*JobTemplate         `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJobTemplatesResponse) Reset() {
+	*x = ListJobTemplatesResponse{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJobTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobTemplatesResponse) ProtoMessage() {}
+
+func (x *ListJobTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*ListJobTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *ListJobTemplatesResponse) GetTemplates() []*JobTemplate {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+type GetJobTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId    uint64                 `protobuf:"varint,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	EmployerId    string                 `protobuf:"bytes,2,opt,name=employer_id,json=employerId,proto3" json:"employer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJobTemplateRequest) Reset() {
+	*x = GetJobTemplateRequest{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJobTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJobTemplateRequest) ProtoMessage() {}
+
+func (x *GetJobTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJobTemplateRequest.ProtoReflect.Descriptor instead.
+func (*GetJobTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *GetJobTemplateRequest) GetTemplateId() uint64 {
+	if x != nil {
+		return x.TemplateId
+	}
+	return 0
+}
+
+func (x *GetJobTemplateRequest) GetEmployerId() string {
+	if x != nil {
+		return x.EmployerId
+	}
+	return ""
+}
+
+type DeleteJobTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId    uint64                 `protobuf:"varint,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	EmployerId    string                 `protobuf:"bytes,2,opt,name=employer_id,json=employerId,proto3" json:"employer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteJobTemplateRequest) Reset() {
+	*x = DeleteJobTemplateRequest{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteJobTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteJobTemplateRequest) ProtoMessage() {}
+
+func (x *DeleteJobTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteJobTemplateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteJobTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *DeleteJobTemplateRequest) GetTemplateId() uint64 {
+	if x != nil {
+		return x.TemplateId
+	}
+	return 0
+}
+
+func (x *DeleteJobTemplateRequest) GetEmployerId() string {
+	if x != nil {
+		return x.EmployerId
+	}
+	return ""
+}
+
+type DeleteJobTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteJobTemplateResponse) Reset() {
+	*x = DeleteJobTemplateResponse{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteJobTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteJobTemplateResponse) ProtoMessage() {}
+
+func (x *DeleteJobTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteJobTemplateResponse.ProtoReflect.Descriptor instead.
+func (*DeleteJobTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *DeleteJobTemplateResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Application inbox requests/responses
+type MarkApplicationSeenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId uint64                 `protobuf:"varint,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	EmployerId    string                 `protobuf:"bytes,2,opt,name=employer_id,json=employerId,proto3" json:"employer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkApplicationSeenRequest) Reset() {
+	*x = MarkApplicationSeenRequest{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkApplicationSeenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkApplicationSeenRequest) ProtoMessage() {}
+
+func (x *MarkApplicationSeenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkApplicationSeenRequest.ProtoReflect.Descriptor instead.
+func (*MarkApplicationSeenRequest) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *MarkApplicationSeenRequest) GetApplicationId() uint64 {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return 0
+}
+
+func (x *MarkApplicationSeenRequest) GetEmployerId() string {
+	if x != nil {
+		return x.EmployerId
+	}
+	return ""
+}
+
+type MarkApplicationSeenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkApplicationSeenResponse) Reset() {
+	*x = MarkApplicationSeenResponse{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkApplicationSeenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkApplicationSeenResponse) ProtoMessage() {}
+
+func (x *MarkApplicationSeenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkApplicationSeenResponse.ProtoReflect.Descriptor instead.
+func (*MarkApplicationSeenResponse) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *MarkApplicationSeenResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type JobApplicantCountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         uint64                 `protobuf:"varint,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JobApplicantCountRequest) Reset() {
+	*x = JobApplicantCountRequest{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobApplicantCountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobApplicantCountRequest) ProtoMessage() {}
+
+func (x *JobApplicantCountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobApplicantCountRequest.ProtoReflect.Descriptor instead.
+func (*JobApplicantCountRequest) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *JobApplicantCountRequest) GetJobId() uint64 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+type JobApplicantCountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         int64                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JobApplicantCountResponse) Reset() {
+	*x = JobApplicantCountResponse{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobApplicantCountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobApplicantCountResponse) ProtoMessage() {}
+
+func (x *JobApplicantCountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobApplicantCountResponse.ProtoReflect.Descriptor instead.
+func (*JobApplicantCountResponse) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *JobApplicantCountResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// Screening rules requests/responses
+type ScreeningRule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"` // experience, skill, location or skill_match
+	Operator      string                 `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Action        string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`   // reject or shortlist
+	Message       string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"` // Shown to a rejected candidate
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScreeningRule) Reset() {
+	*x = ScreeningRule{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScreeningRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScreeningRule) ProtoMessage() {}
+
+func (x *ScreeningRule) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScreeningRule.ProtoReflect.Descriptor instead.
+func (*ScreeningRule) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *ScreeningRule) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *ScreeningRule) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *ScreeningRule) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *ScreeningRule) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ScreeningRule) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type SetScreeningRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         uint64                 `protobuf:"varint,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	EmployerId    string                 `protobuf:"bytes,2,opt,name=employer_id,json=employerId,proto3" json:"employer_id,omitempty"`
+	Rules         []*ScreeningRule       `protobuf:"bytes,3,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetScreeningRulesRequest) Reset() {
+	*x = SetScreeningRulesRequest{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetScreeningRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetScreeningRulesRequest) ProtoMessage() {}
+
+func (x *SetScreeningRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetScreeningRulesRequest.ProtoReflect.Descriptor instead.
+func (*SetScreeningRulesRequest) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *SetScreeningRulesRequest) GetJobId() uint64 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+func (x *SetScreeningRulesRequest) GetEmployerId() string {
+	if x != nil {
+		return x.EmployerId
+	}
+	return ""
+}
+
+func (x *SetScreeningRulesRequest) GetRules() []*ScreeningRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type ScreeningRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         uint64                 `protobuf:"varint,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Rules         []*ScreeningRule       `protobuf:"bytes,2,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScreeningRulesResponse) Reset() {
+	*x = ScreeningRulesResponse{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScreeningRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScreeningRulesResponse) ProtoMessage() {}
+
+func (x *ScreeningRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScreeningRulesResponse.ProtoReflect.Descriptor instead.
+func (*ScreeningRulesResponse) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *ScreeningRulesResponse) GetJobId() uint64 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+func (x *ScreeningRulesResponse) GetRules() []*ScreeningRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type GetScreeningRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         uint64                 `protobuf:"varint,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	EmployerId    string                 `protobuf:"bytes,2,opt,name=employer_id,json=employerId,proto3" json:"employer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetScreeningRulesRequest) Reset() {
+	*x = GetScreeningRulesRequest{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetScreeningRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScreeningRulesRequest) ProtoMessage() {}
+
+func (x *GetScreeningRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScreeningRulesRequest.ProtoReflect.Descriptor instead.
+func (*GetScreeningRulesRequest) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *GetScreeningRulesRequest) GetJobId() uint64 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+func (x *GetScreeningRulesRequest) GetEmployerId() string {
+	if x != nil {
+		return x.EmployerId
+	}
+	return ""
+}
+
+type DeleteScreeningRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         uint64                 `protobuf:"varint,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	EmployerId    string                 `protobuf:"bytes,2,opt,name=employer_id,json=employerId,proto3" json:"employer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteScreeningRulesRequest) Reset() {
+	*x = DeleteScreeningRulesRequest{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteScreeningRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteScreeningRulesRequest) ProtoMessage() {}
+
+func (x *DeleteScreeningRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteScreeningRulesRequest.ProtoReflect.Descriptor instead.
+func (*DeleteScreeningRulesRequest) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *DeleteScreeningRulesRequest) GetJobId() uint64 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+func (x *DeleteScreeningRulesRequest) GetEmployerId() string {
+	if x != nil {
+		return x.EmployerId
+	}
+	return ""
+}
+
+type DeleteScreeningRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteScreeningRulesResponse) Reset() {
+	*x = DeleteScreeningRulesResponse{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteScreeningRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteScreeningRulesResponse) ProtoMessage() {}
+
+func (x *DeleteScreeningRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteScreeningRulesResponse.ProtoReflect.Descriptor instead.
+func (*DeleteScreeningRulesResponse) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *DeleteScreeningRulesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetEmployerProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EmployerId    string                 `protobuf:"bytes,1,opt,name=employer_id,json=employerId,proto3" json:"employer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEmployerProfileRequest) Reset() {
+	*x = GetEmployerProfileRequest{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEmployerProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEmployerProfileRequest) ProtoMessage() {}
+
+func (x *GetEmployerProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEmployerProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetEmployerProfileRequest) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *GetEmployerProfileRequest) GetEmployerId() string {
+	if x != nil {
+		return x.EmployerId
+	}
+	return ""
+}
+
+type EmployerProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *EmployerProfile       `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmployerProfileResponse) Reset() {
+	*x = EmployerProfileResponse{}
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmployerProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmployerProfileResponse) ProtoMessage() {}
+
+func (x *EmployerProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_skillsync_protos_Job_job_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmployerProfileResponse.ProtoReflect.Descriptor instead.
+func (*EmployerProfileResponse) Descriptor() ([]byte, []int) {
+	return file_skillsync_protos_Job_job_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *EmployerProfileResponse) GetProfile() *EmployerProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+func (x *EmployerProfileResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+var File_skillsync_protos_Job_job_proto protoreflect.FileDescriptor
+
+const file_skillsync_protos_Job_job_proto_rawDesc = "" +
+	"\n" +
+	"\x1eskillsync-protos/Job/job.proto\x12\n" +
+	"jobservice\"8\n" +
+	"\x0eEmployerDetail\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\"F\n" +
+	"\x0eCompanyDetails\x124\n" +
+	"\adetails\x18\x01 \x03(\v2\x1a.jobservice.EmployerDetailR\adetails\"\xdc\x01\n" +
+	"\x0fEmployerProfile\x12!\n" +
+	"\fcompany_name\x18\x01 \x01(\tR\vcompanyName\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1a\n" +
+	"\bindustry\x18\x03 \x01(\tR\bindustry\x12\x18\n" +
+	"\awebsite\x18\x04 \x01(\tR\awebsite\x12\x1a\n" +
+	"\blocation\x18\x05 \x01(\tR\blocation\x12\x1f\n" +
+	"\vis_verified\x18\x06 \x01(\bR\n" +
+	"isVerified\x12\x1d\n" +
+	"\n" +
+	"is_trusted\x18\a \x01(\bR\tisTrusted\"\xd3\x04\n" +
+	"\x03Job\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1f\n" +
+	"\vemployer_id\x18\x02 \x01(\tR\n" +
+	"employerId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bcategory\x18\x05 \x01(\tR\bcategory\x12=\n" +
+	"\x0frequired_skills\x18\x06 \x03(\v2\x14.jobservice.JobSkillR\x0erequiredSkills\x12\x1d\n" +
+	"\n" +
+	"salary_min\x18\a \x01(\x03R\tsalaryMin\x12\x1d\n" +
+	"\n" +
+	"salary_max\x18\b \x01(\x03R\tsalaryMax\x12\x1a\n" +
+	"\blocation\x18\t \x01(\tR\blocation\x12/\n" +
+	"\x13experience_required\x18\n" +
+	" \x01(\x05R\x12experienceRequired\x12\x16\n" +
+	"\x06status\x18\v \x01(\tR\x06status\x12F\n" +
+	"\x10employer_profile\x18\f \x01(\v2\x1b.jobservice.EmployerProfileR\x0femployerProfile\x12C\n" +
+	"\x0fcompany_details\x18\r \x01(\v2\x1a.jobservice.CompanyDetailsR\x0ecompanyDetails\x12\x1a\n" +
+	"\bdeadline\x18\x0e \x01(\tR\bdeadline\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x0f \x01(\tR\tupdatedAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x10 \x01(\tR\tcreatedAt\"Y\n" +
+	"\bJobSkill\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12\x14\n" +
+	"\x05skill\x18\x02 \x01(\tR\x05skill\x12 \n" +
+	"\vproficiency\x18\x03 \x01(\tR\vproficiency\"9\n" +
+	"\tJobSkills\x12,\n" +
+	"\x06skills\x18\x01 \x03(\v2\x14.jobservice.JobSkillR\x06skills\"\x8e\x01\n" +
+	"\vApplication\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x15\n" +
+	"\x06job_id\x18\x02 \x01(\x04R\x05jobId\x12!\n" +
+	"\fcandidate_id\x18\x03 \x01(\tR\vcandidateId\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"resume_url\x18\x05 \x01(\tR\tresumeUrl\"\xec\x01\n" +
+	"\x13ApplicationResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12!\n" +
+	"\x03job\x18\x02 \x01(\v2\x0f.jobservice.JobR\x03job\x12!\n" +
+	"\fcandidate_id\x18\x03 \x01(\tR\vcandidateId\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"resume_url\x18\x05 \x01(\tR\tresumeUrl\x12\x1d\n" +
+	"\n" +
+	"applied_at\x18\x06 \x01(\tR\tappliedAt\x12\x15\n" +
+	"\x06job_id\x18\a \x01(\x04R\x05jobId\x12\x12\n" +
+	"\x04seen\x18\b \x01(\bR\x04seen\"\xeb\x02\n" +
+	"\x0ePostJobRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bcategory\x18\x03 \x01(\tR\bcategory\x12=\n" +
+	"\x0frequired_skills\x18\x04 \x03(\v2\x14.jobservice.JobSkillR\x0erequiredSkills\x12\x1d\n" +
+	"\n" +
+	"salary_min\x18\x05 \x01(\x03R\tsalaryMin\x12\x1d\n" +
+	"\n" +
+	"salary_max\x18\x06 \x01(\x03R\tsalaryMax\x12\x1a\n" +
+	"\blocation\x18\a \x01(\tR\blocation\x12/\n" +
+	"\x13experience_required\x18\b \x01(\x05R\x12experienceRequired\x12\x1f\n" +
+	"\vemployer_id\x18\t \x01(\tR\n" +
+	"employerId\x12\x1a\n" +
+	"\bdeadline\x18\n" +
+	" \x01(\tR\bdeadline\"B\n" +
+	"\x0fPostJobResponse\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\x04R\x05jobId\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x9f\x02\n" +
+	"\x0eGetJobsRequest\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\x12\x18\n" +
+	"\akeyword\x18\x02 \x01(\tR\akeyword\x12\x1a\n" +
+	"\blocation\x18\x03 \x01(\tR\blocation\x12/\n" +
+	"\x13experience_required\x18\x04 \x01(\x05R\x12experienceRequired\x12#\n" +
+	"\rinclude_terms\x18\x05 \x03(\tR\fincludeTerms\x12#\n" +
+	"\rexclude_terms\x18\x06 \x03(\tR\fexcludeTerms\x12\x12\n" +
+	"\x04page\x18\a \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\b \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06status\x18\t \x01(\tR\x06status\"L\n" +
+	"\x0fGetJobsResponse\x12#\n" +
+	"\x04jobs\x18\x01 \x03(\v2\x0f.jobservice.JobR\x04jobs\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"*\n" +
+	"\x11GetJobByIdRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\x04R\x05jobId\"7\n" +
+	"\x12GetJobByIdResponse\x12!\n" +
+	"\x03job\x18\x01 \x01(\v2\x0f.jobservice.JobR\x03job\"l\n" +
+	"\x11ApplyToJobRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\x04R\x05jobId\x12!\n" +
+	"\fcandidate_id\x18\x02 \x01(\tR\vcandidateId\x12\x1d\n" +
+	"\n" +
+	"resume_url\x18\x03 \x01(\tR\tresumeUrl\"U\n" +
+	"\x12ApplyToJobResponse\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\x04R\rapplicationId\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x94\x01\n" +
+	"\x16GetApplicationsRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\x04R\x05jobId\x12!\n" +
+	"\fcandidate_id\x18\x02 \x01(\tR\vcandidateId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x12\n" +
+	"\x04page\x18\x04 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\"t\n" +
+	"\x17GetApplicationsResponse\x12C\n" +
+	"\fapplications\x18\x01 \x03(\v2\x1f.jobservice.ApplicationResponseR\fapplications\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\">\n" +
+	"\x15GetApplicationRequest\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\x04R\rapplicationId\"[\n" +
+	"\x16GetApplicationResponse\x12A\n" +
+	"\vapplication\x18\x01 \x01(\v2\x1f.jobservice.ApplicationResponseR\vapplication\"\x98\x01\n" +
+	"\x1eUpdateApplicationStatusRequest\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1f\n" +
+	"\vemployer_id\x18\x03 \x01(\tR\n" +
+	"employerId\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"~\n" +
+	"\x1fUpdateApplicationStatusResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12A\n" +
+	"\vapplication\x18\x02 \x01(\v2\x1f.jobservice.ApplicationResponseR\vapplication\"\x92\x01\n" +
+	"\x13AddJobSkillsRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\x04R\x05jobId\x12\x14\n" +
+	"\x05skill\x18\x02 \x01(\tR\x05skill\x12 \n" +
+	"\vproficiency\x18\x03 \x01(\tR\vproficiency\x12,\n" +
+	"\x06skills\x18\x04 \x03(\v2\x14.jobservice.JobSkillR\x06skills\"0\n" +
+	"\x14AddJobSkillsResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"h\n" +
+	"\x16UpdateJobStatusRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1f\n" +
+	"\vemployer_id\x18\x03 \x01(\tR\n" +
+	"employerId\"3\n" +
+	"\x17UpdateJobStatusResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\xe4\x01\n" +
+	"\x19FilterApplicationsRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\x04R\x05jobId\x12\x1f\n" +
+	"\vemployer_id\x18\x02 \x01(\tR\n" +
+	"employerId\x12%\n" +
+	"\x0emin_experience\x18\x03 \x01(\x05R\rminExperience\x12'\n" +
+	"\x0frequired_skills\x18\x04 \x03(\tR\x0erequiredSkills\x12)\n" +
+	"\x10preferred_skills\x18\x05 \x03(\tR\x0fpreferredSkills\x12\x14\n" +
+	"\x05limit\x18\x06 \x01(\x05R\x05limit\"\xcf\x01\n" +
+	"\x11RankedApplication\x12A\n" +
+	"\vapplication\x18\x01 \x01(\v2\x1f.jobservice.ApplicationResponseR\vapplication\x12'\n" +
+	"\x0frelevance_score\x18\x02 \x01(\x01R\x0erelevanceScore\x12'\n" +
+	"\x0fmatching_skills\x18\x03 \x03(\tR\x0ematchingSkills\x12%\n" +
+	"\x0emissing_skills\x18\x04 \x03(\tR\rmissingSkills\"\xb5\x01\n" +
+	"\x1aFilterApplicationsResponse\x12N\n" +
+	"\x13ranked_applications\x18\x01 \x03(\v2\x1d.jobservice.RankedApplicationR\x12rankedApplications\x12-\n" +
+	"\x12total_applications\x18\x02 \x01(\x05R\x11totalApplications\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\":\n" +
+	"\x17ListEmployerJobsRequest\x12\x1f\n" +
+	"\vemployer_id\x18\x01 \x01(\tR\n" +
+	"employerId\"?\n" +
+	"\x18ListEmployerJobsResponse\x12#\n" +
+	"\x04jobs\x18\x01 \x03(\v2\x0f.jobservice.JobR\x04jobs\"R\n" +
+	"\x17EmployerJobStatsRequest\x12\x1f\n" +
+	"\vemployer_id\x18\x01 \x01(\tR\n" +
+	"employerId\x12\x16\n" +
+	"\x06period\x18\x02 \x01(\tR\x06period\"w\n" +
+	"\x18EmployerJobStatsResponse\x12\x1d\n" +
+	"\n" +
+	"total_jobs\x18\x01 \x01(\x03R\ttotalJobs\x12\x1b\n" +
+	"\topen_jobs\x18\x02 \x01(\x03R\bopenJobs\x12\x1f\n" +
+	"\vclosed_jobs\x18\x03 \x01(\x03R\n" +
+	"closedJobs\"Z\n" +
+	"\x1fEmployerApplicationStatsRequest\x12\x1f\n" +
+	"\vemployer_id\x18\x01 \x01(\tR\n" +
+	"employerId\x12\x16\n" +
+	"\x06period\x18\x02 \x01(\tR\x06period\"\xf5\x02\n" +
+	" EmployerApplicationStatsResponse\x12-\n" +
+	"\x12total_applications\x18\x01 \x01(\x03R\x11totalApplications\x12Q\n" +
+	"\aper_job\x18\x02 \x03(\v28.jobservice.EmployerApplicationStatsResponse.PerJobEntryR\x06perJob\x12W\n" +
+	"\tby_status\x18\x03 \x03(\v2:.jobservice.EmployerApplicationStatsResponse.ByStatusEntryR\bbyStatus\x1a9\n" +
+	"\vPerJobEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\x1a;\n" +
+	"\rByStatusEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"@\n" +
+	"\x1bRecommendedJobsCountRequest\x12!\n" +
+	"\fcandidate_id\x18\x01 \x01(\tR\vcandidateId\"4\n" +
+	"\x1cRecommendedJobsCountResponse\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x03R\x05count\"e\n" +
+	"\x15RemoveJobSkillRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12\x14\n" +
+	"\x05skill\x18\x02 \x01(\tR\x05skill\x12\x1f\n" +
+	"\vemployer_id\x18\x03 \x01(\tR\n" +
+	"employerId\"`\n" +
+	"\x16RemoveJobSkillResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12,\n" +
+	"\x06skills\x18\x02 \x03(\v2\x14.jobservice.JobSkillR\x06skills\"\x7f\n" +
+	"\x17ReplaceJobSkillsRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12,\n" +
+	"\x06skills\x18\x02 \x03(\v2\x14.jobservice.JobSkillR\x06skills\x12\x1f\n" +
+	"\vemployer_id\x18\x03 \x01(\tR\n" +
+	"employerId\"b\n" +
+	"\x18ReplaceJobSkillsResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12,\n" +
+	"\x06skills\x18\x02 \x03(\v2\x14.jobservice.JobSkillR\x06skills\"\x9a\x03\n" +
+	"\tInterview\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12%\n" +
+	"\x0eapplication_id\x18\x02 \x01(\x04R\rapplicationId\x12\x1f\n" +
+	"\vemployer_id\x18\x03 \x01(\tR\n" +
+	"employerId\x12!\n" +
+	"\fcandidate_id\x18\x04 \x01(\tR\vcandidateId\x12!\n" +
+	"\fscheduled_at\x18\x05 \x01(\tR\vscheduledAt\x12\x1b\n" +
+	"\tjob_title\x18\x06 \x01(\tR\bjobTitle\x12)\n" +
+	"\x10duration_minutes\x18\a \x01(\x05R\x0fdurationMinutes\x12\x12\n" +
+	"\x04mode\x18\b \x01(\tR\x04mode\x12\x1a\n" +
+	"\blocation\x18\t \x01(\tR\blocation\x12!\n" +
+	"\fmeeting_link\x18\n" +
+	" \x01(\tR\vmeetingLink\x12\x16\n" +
+	"\x06status\x18\v \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\f \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\r \x01(\tR\tupdatedAt\"\x83\x02\n" +
+	"\x18ScheduleInterviewRequest\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\x04R\rapplicationId\x12\x1f\n" +
+	"\vemployer_id\x18\x02 \x01(\tR\n" +
+	"employerId\x12!\n" +
+	"\fscheduled_at\x18\x03 \x01(\tR\vscheduledAt\x12\x12\n" +
+	"\x04mode\x18\x04 \x01(\tR\x04mode\x12\x1a\n" +
+	"\blocation\x18\x05 \x01(\tR\blocation\x12!\n" +
+	"\fmeeting_link\x18\x06 \x01(\tR\vmeetingLink\x12)\n" +
+	"\x10duration_minutes\x18\a \x01(\x05R\x0fdurationMinutes\"H\n" +
+	"\x11InterviewResponse\x123\n" +
+	"\tinterview\x18\x01 \x01(\v2\x15.jobservice.InterviewR\tinterview\"=\n" +
+	"\x14GetInterviewsRequest\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\x04R\rapplicationId\"N\n" +
+	"\x15GetInterviewsResponse\x125\n" +
+	"\n" +
+	"interviews\x18\x01 \x03(\v2\x15.jobservice.InterviewR\n" +
+	"interviews\"\x8c\x02\n" +
+	"\x16UpdateInterviewRequest\x12!\n" +
+	"\finterview_id\x18\x01 \x01(\x04R\vinterviewId\x12\x16\n" +
+	"\x06action\x18\x02 \x01(\tR\x06action\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12!\n" +
+	"\fscheduled_at\x18\x04 \x01(\tR\vscheduledAt\x12\x12\n" +
+	"\x04mode\x18\x05 \x01(\tR\x04mode\x12\x1a\n" +
+	"\blocation\x18\x06 \x01(\tR\blocation\x12!\n" +
+	"\fmeeting_link\x18\a \x01(\tR\vmeetingLink\x12)\n" +
+	"\x10duration_minutes\x18\b \x01(\x05R\x0fdurationMinutes\"`\n" +
+	"\x1dListUpcomingInterviewsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\x12\x12\n" +
+	"\x04from\x18\x03 \x01(\tR\x04from\"W\n" +
+	"\x1eListUpcomingInterviewsResponse\x125\n" +
+	"\n" +
+	"interviews\x18\x01 \x03(\v2\x15.jobservice.InterviewR\n" +
+	"interviews\"\xb6\x02\n" +
+	"\x11InterviewFeedback\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12!\n" +
+	"\finterview_id\x18\x02 \x01(\x04R\vinterviewId\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\x12!\n" +
+	"\fskills_score\x18\x04 \x01(\x05R\vskillsScore\x12/\n" +
+	"\x13communication_score\x18\x05 \x01(\x05R\x12communicationScore\x12#\n" +
+	"\roverall_score\x18\x06 \x01(\x05R\foverallScore\x12\x1a\n" +
+	"\bcomments\x18\a \x01(\tR\bcomments\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\tR\tupdatedAt\"\xae\x02\n" +
+	"\x1eSubmitInterviewFeedbackRequest\x12!\n" +
+	"\finterview_id\x18\x01 \x01(\x04R\vinterviewId\x12\x1f\n" +
+	"\vemployer_id\x18\x02 \x01(\tR\n" +
+	"employerId\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\x12!\n" +
+	"\fskills_score\x18\x04 \x01(\x05R\vskillsScore\x12/\n" +
+	"\x13communication_score\x18\x05 \x01(\x05R\x12communicationScore\x12#\n" +
+	"\roverall_score\x18\x06 \x01(\x05R\foverallScore\x12\x1a\n" +
+	"\bcomments\x18\a \x01(\tR\bcomments\x12\x16\n" +
+	"\x06upsert\x18\b \x01(\bR\x06upsert\"v\n" +
+	"\x1fSubmitInterviewFeedbackResponse\x129\n" +
+	"\bfeedback\x18\x01 \x01(\v2\x1d.jobservice.InterviewFeedbackR\bfeedback\x12\x18\n" +
+	"\acreated\x18\x02 \x01(\bR\acreated\"a\n" +
+	"\x1bGetInterviewFeedbackRequest\x12!\n" +
+	"\finterview_id\x18\x01 \x01(\x04R\vinterviewId\x12\x1f\n" +
+	"\vemployer_id\x18\x02 \x01(\tR\n" +
+	"employerId\"Y\n" +
+	"\x1cGetInterviewFeedbackResponse\x129\n" +
+	"\bfeedback\x18\x01 \x03(\v2\x1d.jobservice.InterviewFeedbackR\bfeedback\"\xda\x02\n" +
+	"\bJobAlert\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
+	"\fcandidate_id\x18\x02 \x01(\tR\vcandidateId\x12\x18\n" +
+	"\akeyword\x18\x03 \x01(\tR\akeyword\x12\x1a\n" +
+	"\bcategory\x18\x04 \x01(\tR\bcategory\x12\x1a\n" +
+	"\blocation\x18\x05 \x01(\tR\blocation\x12\x16\n" +
+	"\x06skills\x18\x06 \x03(\tR\x06skills\x12\x1c\n" +
+	"\tfrequency\x18\a \x01(\tR\tfrequency\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\tR\tcreatedAt\x12*\n" +
+	"\x11last_triggered_at\x18\t \x01(\tR\x0flastTriggeredAt\x12#\n" +
+	"\rinclude_terms\x18\n" +
+	" \x03(\tR\fincludeTerms\x12#\n" +
+	"\rexclude_terms\x18\v \x03(\tR\fexcludeTerms\"\x8c\x02\n" +
+	"\x15CreateJobAlertRequest\x12!\n" +
+	"\fcandidate_id\x18\x01 \x01(\tR\vcandidateId\x12\x18\n" +
+	"\akeyword\x18\x02 \x01(\tR\akeyword\x12\x1a\n" +
+	"\bcategory\x18\x03 \x01(\tR\bcategory\x12\x1a\n" +
+	"\blocation\x18\x04 \x01(\tR\blocation\x12\x16\n" +
+	"\x06skills\x18\x05 \x03(\tR\x06skills\x12\x1c\n" +
+	"\tfrequency\x18\x06 \x01(\tR\tfrequency\x12#\n" +
+	"\rinclude_terms\x18\a \x03(\tR\fincludeTerms\x12#\n" +
+	"\rexclude_terms\x18\b \x03(\tR\fexcludeTerms\"D\n" +
+	"\x16CreateJobAlertResponse\x12*\n" +
+	"\x05alert\x18\x01 \x01(\v2\x14.jobservice.JobAlertR\x05alert\"9\n" +
+	"\x14ListJobAlertsRequest\x12!\n" +
+	"\fcandidate_id\x18\x01 \x01(\tR\vcandidateId\"E\n" +
+	"\x15ListJobAlertsResponse\x12,\n" +
+	"\x06alerts\x18\x01 \x03(\v2\x14.jobservice.JobAlertR\x06alerts\"U\n" +
+	"\x15DeleteJobAlertRequest\x12\x19\n" +
+	"\balert_id\x18\x01 \x01(\tR\aalertId\x12!\n" +
+	"\fcandidate_id\x18\x02 \x01(\tR\vcandidateId\"2\n" +
+	"\x16DeleteJobAlertResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\x83\x01\n" +
+	"\aWebhook\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vemployer_id\x18\x02 \x01(\tR\n" +
+	"employerId\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12\x16\n" +
+	"\x06secret\x18\x04 \x01(\tR\x06secret\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\"a\n" +
+	"\x14CreateWebhookRequest\x12\x1f\n" +
+	"\vemployer_id\x18\x01 \x01(\tR\n" +
+	"employerId\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x16\n" +
+	"\x06secret\x18\x03 \x01(\tR\x06secret\"F\n" +
+	"\x15CreateWebhookResponse\x12-\n" +
+	"\awebhook\x18\x01 \x01(\v2\x13.jobservice.WebhookR\awebhook\"6\n" +
+	"\x13ListWebhooksRequest\x12\x1f\n" +
+	"\vemployer_id\x18\x01 \x01(\tR\n" +
+	"employerId\"G\n" +
+	"\x14ListWebhooksResponse\x12/\n" +
+	"\bwebhooks\x18\x01 \x03(\v2\x13.jobservice.WebhookR\bwebhooks\"V\n" +
+	"\x14DeleteWebhookRequest\x12\x1d\n" +
+	"\n" +
+	"webhook_id\x18\x01 \x01(\tR\twebhookId\x12\x1f\n" +
+	"\vemployer_id\x18\x02 \x01(\tR\n" +
+	"employerId\"1\n" +
+	"\x15DeleteWebhookResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"|\n" +
+	"\x10ReportJobRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\x04R\x05jobId\x12\x1f\n" +
+	"\vreporter_id\x18\x02 \x01(\tR\n" +
+	"reporterId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x18\n" +
+	"\adetails\x18\x04 \x01(\tR\adetails\"0\n" +
+	"\x11ReportJobResponse\x12\x1b\n" +
+	"\treport_id\x18\x01 \x01(\tR\breportId\"`\n" +
+	"\x1cListJobsForModerationRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"Z\n" +
+	"\x1dListJobsForModerationResponse\x12#\n" +
+	"\x04jobs\x18\x01 \x03(\v2\x0f.jobservice.JobR\x04jobs\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"v\n" +
+	"\x12ModerateJobRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\x04R\x05jobId\x12\x16\n" +
+	"\x06action\x18\x02 \x01(\tR\x06action\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x19\n" +
+	"\badmin_id\x18\x04 \x01(\tR\aadminId\"8\n" +
+	"\x13ModerateJobResponse\x12!\n" +
+	"\x03job\x18\x01 \x01(\v2\x0f.jobservice.JobR\x03job\"\xa2\x01\n" +
+	"\x15MarkJobPremiumRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\x04R\x05jobId\x12\x1f\n" +
+	"\vemployer_id\x18\x02 \x01(\tR\n" +
+	"employerId\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\x03 \x01(\tR\tpaymentId\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\"2\n" +
+	"\x16MarkJobPremiumResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"`\n" +
+	"\fJobViewCount\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\x04R\x05jobId\x12\x14\n" +
+	"\x05views\x18\x02 \x01(\x03R\x05views\x12#\n" +
+	"\rviewer_sketch\x18\x03 \x01(\fR\fviewerSketch\"I\n" +
+	"\x15RecordJobViewsRequest\x120\n" +
+	"\x06counts\x18\x01 \x03(\v2\x18.jobservice.JobViewCountR\x06counts\"2\n" +
+	"\x16RecordJobViewsResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"P\n" +
+	"\x16GetJobAnalyticsRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\x04R\x05jobId\x12\x1f\n" +
+	"\vemployer_id\x18\x02 \x01(\tR\n" +
+	"employerId\"x\n" +
+	"\x17GetJobAnalyticsResponse\x12\x14\n" +
+	"\x05views\x18\x01 \x01(\x03R\x05views\x12#\n" +
+	"\rviewer_sketch\x18\x02 \x01(\fR\fviewerSketch\x12\"\n" +
+	"\fapplications\x18\x03 \x01(\x03R\fapplications\"Y\n" +
+	"\rTaxonomySkill\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
+	"\bcategory\x18\x02 \x01(\tR\bcategory\x12\x18\n" +
+	"\aaliases\x18\x03 \x03(\tR\aaliases\"\x1a\n" +
+	"\x18ListSkillTaxonomyRequest\"N\n" +
+	"\x19ListSkillTaxonomyResponse\x121\n" +
+	"\x06skills\x18\x01 \x03(\v2\x19.jobservice.TaxonomySkillR\x06skills\"B\n" +
+	"\x14AddSkillAliasRequest\x12\x14\n" +
+	"\x05skill\x18\x01 \x01(\tR\x05skill\x12\x14\n" +
+	"\x05alias\x18\x02 \x01(\tR\x05alias\"H\n" +
+	"\x15AddSkillAliasResponse\x12/\n" +
+	"\x05skill\x18\x01 \x01(\v2\x19.jobservice.TaxonomySkillR\x05skill\"\x98\x01\n" +
+	"\x0fApplicationNote\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12%\n" +
+	"\x0eapplication_id\x18\x02 \x01(\x04R\rapplicationId\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\x12\x12\n" +
+	"\x04body\x18\x04 \x01(\tR\x04body\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\"\x94\x01\n" +
+	"\x19AddApplicationNoteRequest\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\x04R\rapplicationId\x12\x1f\n" +
+	"\vemployer_id\x18\x02 \x01(\tR\n" +
+	"employerId\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\x12\x12\n" +
+	"\x04body\x18\x04 \x01(\tR\x04body\"M\n" +
+	"\x1aAddApplicationNoteResponse\x12/\n" +
+	"\x04note\x18\x01 \x01(\v2\x1b.jobservice.ApplicationNoteR\x04note\"\x8f\x01\n" +
+	"\x1bListApplicationNotesRequest\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\x04R\rapplicationId\x12\x1f\n" +
+	"\vemployer_id\x18\x02 \x01(\tR\n" +
+	"employerId\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"g\n" +
+	"\x1cListApplicationNotesResponse\x121\n" +
+	"\x05notes\x18\x01 \x03(\v2\x1b.jobservice.ApplicationNoteR\x05notes\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"X\n" +
+	"\x1cDeleteApplicationNoteRequest\x12\x17\n" +
+	"\anote_id\x18\x01 \x01(\x04R\x06noteId\x12\x1f\n" +
+	"\vemployer_id\x18\x02 \x01(\tR\n" +
+	"employerId\"9\n" +
+	"\x1dDeleteApplicationNoteResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"x\n" +
+	"\x16RateApplicationRequest\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\x04R\rapplicationId\x12\x1f\n" +
+	"\vemployer_id\x18\x02 \x01(\tR\n" +
+	"employerId\x12\x16\n" +
+	"\x06rating\x18\x03 \x01(\x05R\x06rating\"X\n" +
+	"\x17RateApplicationResponse\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\x04R\rapplicationId\x12\x16\n" +
+	"\x06rating\x18\x02 \x01(\x05R\x06rating\"H\n" +
+	"\bSavedJob\x12!\n" +
+	"\x03job\x18\x01 \x01(\v2\x0f.jobservice.JobR\x03job\x12\x19\n" +
+	"\bsaved_at\x18\x02 \x01(\tR\asavedAt\"9\n" +
+	"\x14ListSavedJobsRequest\x12!\n" +
+	"\fcandidate_id\x18\x01 \x01(\tR\vcandidateId\"L\n" +
+	"\x15ListSavedJobsResponse\x123\n" +
+	"\n" +
+	"saved_jobs\x18\x01 \x03(\v2\x14.jobservice.SavedJobR\tsavedJobs\"\x8d\x03\n" +
+	"\vJobTemplate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bcategory\x18\x05 \x01(\tR\bcategory\x12\x1a\n" +
+	"\blocation\x18\x06 \x01(\tR\blocation\x12\x1d\n" +
+	"\n" +
+	"salary_min\x18\a \x01(\x03R\tsalaryMin\x12\x1d\n" +
+	"\n" +
+	"salary_max\x18\b \x01(\x03R\tsalaryMax\x12/\n" +
+	"\x13experience_required\x18\t \x01(\x05R\x12experienceRequired\x12=\n" +
+	"\x0frequired_skills\x18\n" +
+	" \x03(\v2\x14.jobservice.JobSkillR\x0erequiredSkills\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\v \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\f \x01(\tR\tcreatedAt\"\x8f\x01\n" +
+	"\x18CreateJobTemplateRequest\x12\x1f\n" +
+	"\vemployer_id\x18\x01 \x01(\tR\n" +
+	"employerId\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x02 \x01(\tR\tcreatedBy\x123\n" +
+	"\btemplate\x18\x03 \x01(\v2\x17.jobservice.JobTemplateR\btemplate\"J\n" +
+	"\x13JobTemplateResponse\x123\n" +
+	"\btemplate\x18\x01 \x01(\v2\x17.jobservice.JobTemplateR\btemplate\":\n" +
+	"\x17ListJobTemplatesRequest\x12\x1f\n" +
+	"\vemployer_id\x18\x01 \x01(\tR\n" +
+	"employerId\"Q\n" +
+	"\x18ListJobTemplatesResponse\x125\n" +
+	"\ttemplates\x18\x01 \x03(\v2\x17.jobservice.JobTemplateR\ttemplates\"Y\n" +
+	"\x15GetJobTemplateRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\x04R\n" +
+	"templateId\x12\x1f\n" +
+	"\vemployer_id\x18\x02 \x01(\tR\n" +
+	"employerId\"\\\n" +
+	"\x18DeleteJobTemplateRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\x04R\n" +
+	"templateId\x12\x1f\n" +
+	"\vemployer_id\x18\x02 \x01(\tR\n" +
+	"employerId\"5\n" +
+	"\x19DeleteJobTemplateResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"d\n" +
+	"\x1aMarkApplicationSeenRequest\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\x04R\rapplicationId\x12\x1f\n" +
+	"\vemployer_id\x18\x02 \x01(\tR\n" +
+	"employerId\"7\n" +
+	"\x1bMarkApplicationSeenResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"1\n" +
+	"\x18JobApplicantCountRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\x04R\x05jobId\"1\n" +
+	"\x19JobApplicantCountResponse\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x03R\x05count\"\x89\x01\n" +
+	"\rScreeningRule\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x1a\n" +
+	"\boperator\x18\x02 \x01(\tR\boperator\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\x12\x16\n" +
+	"\x06action\x18\x04 \x01(\tR\x06action\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\"\x83\x01\n" +
+	"\x18SetScreeningRulesRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\x04R\x05jobId\x12\x1f\n" +
+	"\vemployer_id\x18\x02 \x01(\tR\n" +
+	"employerId\x12/\n" +
+	"\x05rules\x18\x03 \x03(\v2\x19.jobservice.ScreeningRuleR\x05rules\"`\n" +
+	"\x16ScreeningRulesResponse\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\x04R\x05jobId\x12/\n" +
+	"\x05rules\x18\x02 \x03(\v2\x19.jobservice.ScreeningRuleR\x05rules\"R\n" +
+	"\x18GetScreeningRulesRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\x04R\x05jobId\x12\x1f\n" +
+	"\vemployer_id\x18\x02 \x01(\tR\n" +
+	"employerId\"U\n" +
+	"\x1bDeleteScreeningRulesRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\x04R\x05jobId\x12\x1f\n" +
+	"\vemployer_id\x18\x02 \x01(\tR\n" +
+	"employerId\"8\n" +
+	"\x1cDeleteScreeningRulesResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"<\n" +
+	"\x19GetEmployerProfileRequest\x12\x1f\n" +
+	"\vemployer_id\x18\x01 \x01(\tR\n" +
+	"employerId\"f\n" +
+	"\x17EmployerProfileResponse\x125\n" +
+	"\aprofile\x18\x01 \x01(\v2\x1b.jobservice.EmployerProfileR\aprofile\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error2s\n" +
+	"\x0fEmployerService\x12`\n" +
+	"\x12GetEmployerProfile\x12%.jobservice.GetEmployerProfileRequest\x1a#.jobservice.EmployerProfileResponse2\xd0$\n" +
+	"\n" +
+	"JobService\x12B\n" +
+	"\aPostJob\x12\x1a.jobservice.PostJobRequest\x1a\x1b.jobservice.PostJobResponse\x12B\n" +
+	"\aGetJobs\x12\x1a.jobservice.GetJobsRequest\x1a\x1b.jobservice.GetJobsResponse\x12K\n" +
+	"\n" +
+	"GetJobById\x12\x1d.jobservice.GetJobByIdRequest\x1a\x1e.jobservice.GetJobByIdResponse\x12Z\n" +
+	"\x0fUpdateJobStatus\x12\".jobservice.UpdateJobStatusRequest\x1a#.jobservice.UpdateJobStatusResponse\x12K\n" +
+	"\n" +
+	"ApplyToJob\x12\x1d.jobservice.ApplyToJobRequest\x1a\x1e.jobservice.ApplyToJobResponse\x12Z\n" +
+	"\x0fGetApplications\x12\".jobservice.GetApplicationsRequest\x1a#.jobservice.GetApplicationsResponse\x12W\n" +
+	"\x0eGetApplication\x12!.jobservice.GetApplicationRequest\x1a\".jobservice.GetApplicationResponse\x12r\n" +
+	"\x17UpdateApplicationStatus\x12*.jobservice.UpdateApplicationStatusRequest\x1a+.jobservice.UpdateApplicationStatusResponse\x12c\n" +
+	"\x12FilterApplications\x12%.jobservice.FilterApplicationsRequest\x1a&.jobservice.FilterApplicationsResponse\x12Q\n" +
+	"\fAddJobSkills\x12\x1f.jobservice.AddJobSkillsRequest\x1a .jobservice.AddJobSkillsResponse\x12W\n" +
+	"\x0eRemoveJobSkill\x12!.jobservice.RemoveJobSkillRequest\x1a\".jobservice.RemoveJobSkillResponse\x12]\n" +
+	"\x10ReplaceJobSkills\x12#.jobservice.ReplaceJobSkillsRequest\x1a$.jobservice.ReplaceJobSkillsResponse\x12]\n" +
+	"\x10ListEmployerJobs\x12#.jobservice.ListEmployerJobsRequest\x1a$.jobservice.ListEmployerJobsResponse\x12`\n" +
+	"\x13GetEmployerJobStats\x12#.jobservice.EmployerJobStatsRequest\x1a$.jobservice.EmployerJobStatsResponse\x12x\n" +
+	"\x1bGetEmployerApplicationStats\x12+.jobservice.EmployerApplicationStatsRequest\x1a,.jobservice.EmployerApplicationStatsResponse\x12l\n" +
+	"\x17GetRecommendedJobsCount\x12'.jobservice.RecommendedJobsCountRequest\x1a(.jobservice.RecommendedJobsCountResponse\x12T\n" +
+	"\rListSavedJobs\x12 .jobservice.ListSavedJobsRequest\x1a!.jobservice.ListSavedJobsResponse\x12X\n" +
+	"\x11ScheduleInterview\x12$.jobservice.ScheduleInterviewRequest\x1a\x1d.jobservice.InterviewResponse\x12T\n" +
+	"\rGetInterviews\x12 .jobservice.GetInterviewsRequest\x1a!.jobservice.GetInterviewsResponse\x12T\n" +
+	"\x0fUpdateInterview\x12\".jobservice.UpdateInterviewRequest\x1a\x1d.jobservice.InterviewResponse\x12o\n" +
+	"\x16ListUpcomingInterviews\x12).jobservice.ListUpcomingInterviewsRequest\x1a*.jobservice.ListUpcomingInterviewsResponse\x12r\n" +
+	"\x17SubmitInterviewFeedback\x12*.jobservice.SubmitInterviewFeedbackRequest\x1a+.jobservice.SubmitInterviewFeedbackResponse\x12i\n" +
+	"\x14GetInterviewFeedback\x12'.jobservice.GetInterviewFeedbackRequest\x1a(.jobservice.GetInterviewFeedbackResponse\x12W\n" +
+	"\x0eCreateJobAlert\x12!.jobservice.CreateJobAlertRequest\x1a\".jobservice.CreateJobAlertResponse\x12T\n" +
+	"\rListJobAlerts\x12 .jobservice.ListJobAlertsRequest\x1a!.jobservice.ListJobAlertsResponse\x12W\n" +
+	"\x0eDeleteJobAlert\x12!.jobservice.DeleteJobAlertRequest\x1a\".jobservice.DeleteJobAlertResponse\x12T\n" +
+	"\rCreateWebhook\x12 .jobservice.CreateWebhookRequest\x1a!.jobservice.CreateWebhookResponse\x12Q\n" +
+	"\fListWebhooks\x12\x1f.jobservice.ListWebhooksRequest\x1a .jobservice.ListWebhooksResponse\x12T\n" +
+	"\rDeleteWebhook\x12 .jobservice.DeleteWebhookRequest\x1a!.jobservice.DeleteWebhookResponse\x12H\n" +
+	"\tReportJob\x12\x1c.jobservice.ReportJobRequest\x1a\x1d.jobservice.ReportJobResponse\x12l\n" +
+	"\x15ListJobsForModeration\x12(.jobservice.ListJobsForModerationRequest\x1a).jobservice.ListJobsForModerationResponse\x12N\n" +
+	"\vModerateJob\x12\x1e.jobservice.ModerateJobRequest\x1a\x1f.jobservice.ModerateJobResponse\x12W\n" +
+	"\x0eMarkJobPremium\x12!.jobservice.MarkJobPremiumRequest\x1a\".jobservice.MarkJobPremiumResponse\x12W\n" +
+	"\x0eRecordJobViews\x12!.jobservice.RecordJobViewsRequest\x1a\".jobservice.RecordJobViewsResponse\x12Z\n" +
+	"\x0fGetJobAnalytics\x12\".jobservice.GetJobAnalyticsRequest\x1a#.jobservice.GetJobAnalyticsResponse\x12`\n" +
+	"\x11ListSkillTaxonomy\x12$.jobservice.ListSkillTaxonomyRequest\x1a%.jobservice.ListSkillTaxonomyResponse\x12T\n" +
+	"\rAddSkillAlias\x12 .jobservice.AddSkillAliasRequest\x1a!.jobservice.AddSkillAliasResponse\x12c\n" +
+	"\x12AddApplicationNote\x12%.jobservice.AddApplicationNoteRequest\x1a&.jobservice.AddApplicationNoteResponse\x12i\n" +
+	"\x14ListApplicationNotes\x12'.jobservice.ListApplicationNotesRequest\x1a(.jobservice.ListApplicationNotesResponse\x12l\n" +
+	"\x15DeleteApplicationNote\x12(.jobservice.DeleteApplicationNoteRequest\x1a).jobservice.DeleteApplicationNoteResponse\x12Z\n" +
+	"\x0fRateApplication\x12\".jobservice.RateApplicationRequest\x1a#.jobservice.RateApplicationResponse\x12f\n" +
+	"\x13MarkApplicationSeen\x12&.jobservice.MarkApplicationSeenRequest\x1a'.jobservice.MarkApplicationSeenResponse\x12c\n" +
+	"\x14GetJobApplicantCount\x12$.jobservice.JobApplicantCountRequest\x1a%.jobservice.JobApplicantCountResponse\x12Z\n" +
+	"\x11CreateJobTemplate\x12$.jobservice.CreateJobTemplateRequest\x1a\x1f.jobservice.JobTemplateResponse\x12]\n" +
+	"\x10ListJobTemplates\x12#.jobservice.ListJobTemplatesRequest\x1a$.jobservice.ListJobTemplatesResponse\x12T\n" +
+	"\x0eGetJobTemplate\x12!.jobservice.GetJobTemplateRequest\x1a\x1f.jobservice.JobTemplateResponse\x12`\n" +
+	"\x11DeleteJobTemplate\x12$.jobservice.DeleteJobTemplateRequest\x1a%.jobservice.DeleteJobTemplateResponse\x12]\n" +
+	"\x11SetScreeningRules\x12$.jobservice.SetScreeningRulesRequest\x1a\".jobservice.ScreeningRulesResponse\x12]\n" +
+	"\x11GetScreeningRules\x12$.jobservice.GetScreeningRulesRequest\x1a\".jobservice.ScreeningRulesResponse\x12i\n" +
+	"\x14DeleteScreeningRules\x12'.jobservice.DeleteScreeningRulesRequest\x1a(.jobservice.DeleteScreeningRulesResponseB\x1cZ\x1askillsync-protos/gen/jobpbb\x06proto3"
+
+var (
+	file_skillsync_protos_Job_job_proto_rawDescOnce sync.Once
+	file_skillsync_protos_Job_job_proto_rawDescData []byte
+)
+
+func file_skillsync_protos_Job_job_proto_rawDescGZIP() []byte {
+	file_skillsync_protos_Job_job_proto_rawDescOnce.Do(func() {
+		file_skillsync_protos_Job_job_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_skillsync_protos_Job_job_proto_rawDesc), len(file_skillsync_protos_Job_job_proto_rawDesc)))
+	})
+	return file_skillsync_protos_Job_job_proto_rawDescData
+}
+
+var file_skillsync_protos_Job_job_proto_msgTypes = make([]protoimpl.MessageInfo, 120)
+var file_skillsync_protos_Job_job_proto_goTypes = []any{
+	(*EmployerDetail)(nil),                   // 0: jobservice.EmployerDetail
+	(*CompanyDetails)(nil),                   // 1: jobservice.CompanyDetails
+	(*EmployerProfile)(nil),                  // 2: jobservice.EmployerProfile
+	(*Job)(nil),                              // 3: jobservice.Job
+	(*JobSkill)(nil),                         // 4: jobservice.JobSkill
+	(*JobSkills)(nil),                        // 5: jobservice.JobSkills
+	(*Application)(nil),                      // 6: jobservice.Application
+	(*ApplicationResponse)(nil),              // 7: jobservice.ApplicationResponse
+	(*PostJobRequest)(nil),                   // 8: jobservice.PostJobRequest
+	(*PostJobResponse)(nil),                  // 9: jobservice.PostJobResponse
+	(*GetJobsRequest)(nil),                   // 10: jobservice.GetJobsRequest
+	(*GetJobsResponse)(nil),                  // 11: jobservice.GetJobsResponse
+	(*GetJobByIdRequest)(nil),                // 12: jobservice.GetJobByIdRequest
+	(*GetJobByIdResponse)(nil),               // 13: jobservice.GetJobByIdResponse
+	(*ApplyToJobRequest)(nil),                // 14: jobservice.ApplyToJobRequest
+	(*ApplyToJobResponse)(nil),               // 15: jobservice.ApplyToJobResponse
+	(*GetApplicationsRequest)(nil),           // 16: jobservice.GetApplicationsRequest
+	(*GetApplicationsResponse)(nil),          // 17: jobservice.GetApplicationsResponse
+	(*GetApplicationRequest)(nil),            // 18: jobservice.GetApplicationRequest
+	(*GetApplicationResponse)(nil),           // 19: jobservice.GetApplicationResponse
+	(*UpdateApplicationStatusRequest)(nil),   // 20: jobservice.UpdateApplicationStatusRequest
+	(*UpdateApplicationStatusResponse)(nil),  // 21: jobservice.UpdateApplicationStatusResponse
+	(*AddJobSkillsRequest)(nil),              // 22: jobservice.AddJobSkillsRequest
+	(*AddJobSkillsResponse)(nil),             // 23: jobservice.AddJobSkillsResponse
+	(*UpdateJobStatusRequest)(nil),           // 24: jobservice.UpdateJobStatusRequest
+	(*UpdateJobStatusResponse)(nil),          // 25: jobservice.UpdateJobStatusResponse
+	(*FilterApplicationsRequest)(nil),        // 26: jobservice.FilterApplicationsRequest
+	(*RankedApplication)(nil),                // 27: jobservice.RankedApplication
+	(*FilterApplicationsResponse)(nil),       // 28: jobservice.FilterApplicationsResponse
+	(*ListEmployerJobsRequest)(nil),          // 29: jobservice.ListEmployerJobsRequest
+	(*ListEmployerJobsResponse)(nil),         // 30: jobservice.ListEmployerJobsResponse
+	(*EmployerJobStatsRequest)(nil),          // 31: jobservice.EmployerJobStatsRequest
+	(*EmployerJobStatsResponse)(nil),         // 32: jobservice.EmployerJobStatsResponse
+	(*EmployerApplicationStatsRequest)(nil),  // 33: jobservice.EmployerApplicationStatsRequest
+	(*EmployerApplicationStatsResponse)(nil), // 34: jobservice.EmployerApplicationStatsResponse
+	(*RecommendedJobsCountRequest)(nil),      // 35: jobservice.RecommendedJobsCountRequest
+	(*RecommendedJobsCountResponse)(nil),     // 36: jobservice.RecommendedJobsCountResponse
+	(*RemoveJobSkillRequest)(nil),            // 37: jobservice.RemoveJobSkillRequest
+	(*RemoveJobSkillResponse)(nil),           // 38: jobservice.RemoveJobSkillResponse
+	(*ReplaceJobSkillsRequest)(nil),          // 39: jobservice.ReplaceJobSkillsRequest
+	(*ReplaceJobSkillsResponse)(nil),         // 40: jobservice.ReplaceJobSkillsResponse
+	(*Interview)(nil),                        // 41: jobservice.Interview
+	(*ScheduleInterviewRequest)(nil),         // 42: jobservice.ScheduleInterviewRequest
+	(*InterviewResponse)(nil),                // 43: jobservice.InterviewResponse
+	(*GetInterviewsRequest)(nil),             // 44: jobservice.GetInterviewsRequest
+	(*GetInterviewsResponse)(nil),            // 45: jobservice.GetInterviewsResponse
+	(*UpdateInterviewRequest)(nil),           // 46: jobservice.UpdateInterviewRequest
+	(*ListUpcomingInterviewsRequest)(nil),    // 47: jobservice.ListUpcomingInterviewsRequest
+	(*ListUpcomingInterviewsResponse)(nil),   // 48: jobservice.ListUpcomingInterviewsResponse
+	(*InterviewFeedback)(nil),                // 49: jobservice.InterviewFeedback
+	(*SubmitInterviewFeedbackRequest)(nil),   // 50: jobservice.SubmitInterviewFeedbackRequest
+	(*SubmitInterviewFeedbackResponse)(nil),  // 51: jobservice.SubmitInterviewFeedbackResponse
+	(*GetInterviewFeedbackRequest)(nil),      // 52: jobservice.GetInterviewFeedbackRequest
+	(*GetInterviewFeedbackResponse)(nil),     // 53: jobservice.GetInterviewFeedbackResponse
+	(*JobAlert)(nil),                         // 54: jobservice.JobAlert
+	(*CreateJobAlertRequest)(nil),            // 55: jobservice.CreateJobAlertRequest
+	(*CreateJobAlertResponse)(nil),           // 56: jobservice.CreateJobAlertResponse
+	(*ListJobAlertsRequest)(nil),             // 57: jobservice.ListJobAlertsRequest
+	(*ListJobAlertsResponse)(nil),            // 58: jobservice.ListJobAlertsResponse
+	(*DeleteJobAlertRequest)(nil),            // 59: jobservice.DeleteJobAlertRequest
+	(*DeleteJobAlertResponse)(nil),           // 60: jobservice.DeleteJobAlertResponse
+	(*Webhook)(nil),                          // 61: jobservice.Webhook
+	(*CreateWebhookRequest)(nil),             // 62: jobservice.CreateWebhookRequest
+	(*CreateWebhookResponse)(nil),            // 63: jobservice.CreateWebhookResponse
+	(*ListWebhooksRequest)(nil),              // 64: jobservice.ListWebhooksRequest
+	(*ListWebhooksResponse)(nil),             // 65: jobservice.ListWebhooksResponse
+	(*DeleteWebhookRequest)(nil),             // 66: jobservice.DeleteWebhookRequest
+	(*DeleteWebhookResponse)(nil),            // 67: jobservice.DeleteWebhookResponse
+	(*ReportJobRequest)(nil),                 // 68: jobservice.ReportJobRequest
+	(*ReportJobResponse)(nil),                // 69: jobservice.ReportJobResponse
+	(*ListJobsForModerationRequest)(nil),     // 70: jobservice.ListJobsForModerationRequest
+	(*ListJobsForModerationResponse)(nil),    // 71: jobservice.ListJobsForModerationResponse
+	(*ModerateJobRequest)(nil),               // 72: jobservice.ModerateJobRequest
+	(*ModerateJobResponse)(nil),              // 73: jobservice.ModerateJobResponse
+	(*MarkJobPremiumRequest)(nil),            // 74: jobservice.MarkJobPremiumRequest
+	(*MarkJobPremiumResponse)(nil),           // 75: jobservice.MarkJobPremiumResponse
+	(*JobViewCount)(nil),                     // 76: jobservice.JobViewCount
+	(*RecordJobViewsRequest)(nil),            // 77: jobservice.RecordJobViewsRequest
+	(*RecordJobViewsResponse)(nil),           // 78: jobservice.RecordJobViewsResponse
+	(*GetJobAnalyticsRequest)(nil),           // 79: jobservice.GetJobAnalyticsRequest
+	(*GetJobAnalyticsResponse)(nil),          // 80: jobservice.GetJobAnalyticsResponse
+	(*TaxonomySkill)(nil),                    // 81: jobservice.TaxonomySkill
+	(*ListSkillTaxonomyRequest)(nil),         // 82: jobservice.ListSkillTaxonomyRequest
+	(*ListSkillTaxonomyResponse)(nil),        // 83: jobservice.ListSkillTaxonomyResponse
+	(*AddSkillAliasRequest)(nil),             // 84: jobservice.AddSkillAliasRequest
+	(*AddSkillAliasResponse)(nil),            // 85: jobservice.AddSkillAliasResponse
+	(*ApplicationNote)(nil),                  // 86: jobservice.ApplicationNote
+	(*AddApplicationNoteRequest)(nil),        // 87: jobservice.AddApplicationNoteRequest
+	(*AddApplicationNoteResponse)(nil),       // 88: jobservice.AddApplicationNoteResponse
+	(*ListApplicationNotesRequest)(nil),      // 89: jobservice.ListApplicationNotesRequest
+	(*ListApplicationNotesResponse)(nil),     // 90: jobservice.ListApplicationNotesResponse
+	(*DeleteApplicationNoteRequest)(nil),     // 91: jobservice.DeleteApplicationNoteRequest
+	(*DeleteApplicationNoteResponse)(nil),    // 92: jobservice.DeleteApplicationNoteResponse
+	(*RateApplicationRequest)(nil),           // 93: jobservice.RateApplicationRequest
+	(*RateApplicationResponse)(nil),          // 94: jobservice.RateApplicationResponse
+	(*SavedJob)(nil),                         // 95: jobservice.SavedJob
+	(*ListSavedJobsRequest)(nil),             // 96: jobservice.ListSavedJobsRequest
+	(*ListSavedJobsResponse)(nil),            // 97: jobservice.ListSavedJobsResponse
+	(*JobTemplate)(nil),                      // 98: jobservice.JobTemplate
+	(*CreateJobTemplateRequest)(nil),         // 99: jobservice.CreateJobTemplateRequest
+	(*JobTemplateResponse)(nil),              // 100: jobservice.JobTemplateResponse
+	(*ListJobTemplatesRequest)(nil),          // 101: jobservice.ListJobTemplatesRequest
+	(*ListJobTemplatesResponse)(nil),         // 102: jobservice.ListJobTemplatesResponse
+	(*GetJobTemplateRequest)(nil),            // 103: jobservice.GetJobTemplateRequest
+	(*DeleteJobTemplateRequest)(nil),         // 104: jobservice.DeleteJobTemplateRequest
+	(*DeleteJobTemplateResponse)(nil),        // 105: jobservice.DeleteJobTemplateResponse
+	(*MarkApplicationSeenRequest)(nil),       // 106: jobservice.MarkApplicationSeenRequest
+	(*MarkApplicationSeenResponse)(nil),      // 107: jobservice.MarkApplicationSeenResponse
+	(*JobApplicantCountRequest)(nil),         // 108: jobservice.JobApplicantCountRequest
+	(*JobApplicantCountResponse)(nil),        // 109: jobservice.JobApplicantCountResponse
+	(*ScreeningRule)(nil),                    // 110: jobservice.ScreeningRule
+	(*SetScreeningRulesRequest)(nil),         // 111: jobservice.SetScreeningRulesRequest
+	(*ScreeningRulesResponse)(nil),           // 112: jobservice.ScreeningRulesResponse
+	(*GetScreeningRulesRequest)(nil),         // 113: jobservice.GetScreeningRulesRequest
+	(*DeleteScreeningRulesRequest)(nil),      // 114: jobservice.DeleteScreeningRulesRequest
+	(*DeleteScreeningRulesResponse)(nil),     // 115: jobservice.DeleteScreeningRulesResponse
+	(*GetEmployerProfileRequest)(nil),        // 116: jobservice.GetEmployerProfileRequest
+	(*EmployerProfileResponse)(nil),          // 117: jobservice.EmployerProfileResponse
+	nil,                                      // 118: jobservice.EmployerApplicationStatsResponse.PerJobEntry
+	nil,                                      // 119: jobservice.EmployerApplicationStatsResponse.ByStatusEntry
+}
+var file_skillsync_protos_Job_job_proto_depIdxs = []int32{
+	0,   // 0: jobservice.CompanyDetails.details:type_name -> jobservice.EmployerDetail
+	4,   // 1: jobservice.Job.required_skills:type_name -> jobservice.JobSkill
+	2,   // 2: jobservice.Job.employer_profile:type_name -> jobservice.EmployerProfile
+	1,   // 3: jobservice.Job.company_details:type_name -> jobservice.CompanyDetails
+	4,   // 4: jobservice.JobSkills.skills:type_name -> jobservice.JobSkill
+	3,   // 5: jobservice.ApplicationResponse.job:type_name -> jobservice.Job
+	4,   // 6: jobservice.PostJobRequest.required_skills:type_name -> jobservice.JobSkill
+	3,   // 7: jobservice.GetJobsResponse.jobs:type_name -> jobservice.Job
+	3,   // 8: jobservice.GetJobByIdResponse.job:type_name -> jobservice.Job
+	7,   // 9: jobservice.GetApplicationsResponse.applications:type_name -> jobservice.ApplicationResponse
+	7,   // 10: jobservice.GetApplicationResponse.application:type_name -> jobservice.ApplicationResponse
+	7,   // 11: jobservice.UpdateApplicationStatusResponse.application:type_name -> jobservice.ApplicationResponse
+	4,   // 12: jobservice.AddJobSkillsRequest.skills:type_name -> jobservice.JobSkill
+	7,   // 13: jobservice.RankedApplication.application:type_name -> jobservice.ApplicationResponse
+	27,  // 14: jobservice.FilterApplicationsResponse.ranked_applications:type_name -> jobservice.RankedApplication
+	3,   // 15: jobservice.ListEmployerJobsResponse.jobs:type_name -> jobservice.Job
+	118, // 16: jobservice.EmployerApplicationStatsResponse.per_job:type_name -> jobservice.EmployerApplicationStatsResponse.PerJobEntry
+	119, // 17: jobservice.EmployerApplicationStatsResponse.by_status:type_name -> jobservice.EmployerApplicationStatsResponse.ByStatusEntry
+	4,   // 18: jobservice.RemoveJobSkillResponse.skills:type_name -> jobservice.JobSkill
+	4,   // 19: jobservice.ReplaceJobSkillsRequest.skills:type_name -> jobservice.JobSkill
+	4,   // 20: jobservice.ReplaceJobSkillsResponse.skills:type_name -> jobservice.JobSkill
+	41,  // 21: jobservice.InterviewResponse.interview:type_name -> jobservice.Interview
+	41,  // 22: jobservice.GetInterviewsResponse.interviews:type_name -> jobservice.Interview
+	41,  // 23: jobservice.ListUpcomingInterviewsResponse.interviews:type_name -> jobservice.Interview
+	49,  // 24: jobservice.SubmitInterviewFeedbackResponse.feedback:type_name -> jobservice.InterviewFeedback
+	49,  // 25: jobservice.GetInterviewFeedbackResponse.feedback:type_name -> jobservice.InterviewFeedback
+	54,  // 26: jobservice.CreateJobAlertResponse.alert:type_name -> jobservice.JobAlert
+	54,  // 27: jobservice.ListJobAlertsResponse.alerts:type_name -> jobservice.JobAlert
+	61,  // 28: jobservice.CreateWebhookResponse.webhook:type_name -> jobservice.Webhook
+	61,  // 29: jobservice.ListWebhooksResponse.webhooks:type_name -> jobservice.Webhook
+	3,   // 30: jobservice.ListJobsForModerationResponse.jobs:type_name -> jobservice.Job
+	3,   // 31: jobservice.ModerateJobResponse.job:type_name -> jobservice.Job
+	76,  // 32: jobservice.RecordJobViewsRequest.counts:type_name -> jobservice.JobViewCount
+	81,  // 33: jobservice.ListSkillTaxonomyResponse.skills:type_name -> jobservice.TaxonomySkill
+	81,  // 34: jobservice.AddSkillAliasResponse.skill:type_name -> jobservice.TaxonomySkill
+	86,  // 35: jobservice.AddApplicationNoteResponse.note:type_name -> jobservice.ApplicationNote
+	86,  // 36: jobservice.ListApplicationNotesResponse.notes:type_name -> jobservice.ApplicationNote
+	3,   // 37: jobservice.SavedJob.job:type_name -> jobservice.Job
+	95,  // 38: jobservice.ListSavedJobsResponse.saved_jobs:type_name -> jobservice.SavedJob
+	4,   // 39: jobservice.JobTemplate.required_skills:type_name -> jobservice.JobSkill
+	98,  // 40: jobservice.CreateJobTemplateRequest.template:type_name -> jobservice.JobTemplate
+	98,  // 41: jobservice.JobTemplateResponse.template:type_name -> jobservice.JobTemplate
+	98,  // 42: jobservice.ListJobTemplatesResponse.templates:type_name -> jobservice.JobTemplate
+	110, // 43: jobservice.SetScreeningRulesRequest.rules:type_name -> jobservice.ScreeningRule
+	110, // 44: jobservice.ScreeningRulesResponse.rules:type_name -> jobservice.ScreeningRule
+	2,   // 45: jobservice.EmployerProfileResponse.profile:type_name -> jobservice.EmployerProfile
+	116, // 46: jobservice.EmployerService.GetEmployerProfile:input_type -> jobservice.GetEmployerProfileRequest
+	8,   // 47: jobservice.JobService.PostJob:input_type -> jobservice.PostJobRequest
+	10,  // 48: jobservice.JobService.GetJobs:input_type -> jobservice.GetJobsRequest
+	12,  // 49: jobservice.JobService.GetJobById:input_type -> jobservice.GetJobByIdRequest
+	24,  // 50: jobservice.JobService.UpdateJobStatus:input_type -> jobservice.UpdateJobStatusRequest
+	14,  // 51: jobservice.JobService.ApplyToJob:input_type -> jobservice.ApplyToJobRequest
+	16,  // 52: jobservice.JobService.GetApplications:input_type -> jobservice.GetApplicationsRequest
+	18,  // 53: jobservice.JobService.GetApplication:input_type -> jobservice.GetApplicationRequest
+	20,  // 54: jobservice.JobService.UpdateApplicationStatus:input_type -> jobservice.UpdateApplicationStatusRequest
+	26,  // 55: jobservice.JobService.FilterApplications:input_type -> jobservice.FilterApplicationsRequest
+	22,  // 56: jobservice.JobService.AddJobSkills:input_type -> jobservice.AddJobSkillsRequest
+	37,  // 57: jobservice.JobService.RemoveJobSkill:input_type -> jobservice.RemoveJobSkillRequest
+	39,  // 58: jobservice.JobService.ReplaceJobSkills:input_type -> jobservice.ReplaceJobSkillsRequest
+	29,  // 59: jobservice.JobService.ListEmployerJobs:input_type -> jobservice.ListEmployerJobsRequest
+	31,  // 60: jobservice.JobService.GetEmployerJobStats:input_type -> jobservice.EmployerJobStatsRequest
+	33,  // 61: jobservice.JobService.GetEmployerApplicationStats:input_type -> jobservice.EmployerApplicationStatsRequest
+	35,  // 62: jobservice.JobService.GetRecommendedJobsCount:input_type -> jobservice.RecommendedJobsCountRequest
+	96,  // 63: jobservice.JobService.ListSavedJobs:input_type -> jobservice.ListSavedJobsRequest
+	42,  // 64: jobservice.JobService.ScheduleInterview:input_type -> jobservice.ScheduleInterviewRequest
+	44,  // 65: jobservice.JobService.GetInterviews:input_type -> jobservice.GetInterviewsRequest
+	46,  // 66: jobservice.JobService.UpdateInterview:input_type -> jobservice.UpdateInterviewRequest
+	47,  // 67: jobservice.JobService.ListUpcomingInterviews:input_type -> jobservice.ListUpcomingInterviewsRequest
+	50,  // 68: jobservice.JobService.SubmitInterviewFeedback:input_type -> jobservice.SubmitInterviewFeedbackRequest
+	52,  // 69: jobservice.JobService.GetInterviewFeedback:input_type -> jobservice.GetInterviewFeedbackRequest
+	55,  // 70: jobservice.JobService.CreateJobAlert:input_type -> jobservice.CreateJobAlertRequest
+	57,  // 71: jobservice.JobService.ListJobAlerts:input_type -> jobservice.ListJobAlertsRequest
+	59,  // 72: jobservice.JobService.DeleteJobAlert:input_type -> jobservice.DeleteJobAlertRequest
+	62,  // 73: jobservice.JobService.CreateWebhook:input_type -> jobservice.CreateWebhookRequest
+	64,  // 74: jobservice.JobService.ListWebhooks:input_type -> jobservice.ListWebhooksRequest
+	66,  // 75: jobservice.JobService.DeleteWebhook:input_type -> jobservice.DeleteWebhookRequest
+	68,  // 76: jobservice.JobService.ReportJob:input_type -> jobservice.ReportJobRequest
+	70,  // 77: jobservice.JobService.ListJobsForModeration:input_type -> jobservice.ListJobsForModerationRequest
+	72,  // 78: jobservice.JobService.ModerateJob:input_type -> jobservice.ModerateJobRequest
+	74,  // 79: jobservice.JobService.MarkJobPremium:input_type -> jobservice.MarkJobPremiumRequest
+	77,  // 80: jobservice.JobService.RecordJobViews:input_type -> jobservice.RecordJobViewsRequest
+	79,  // 81: jobservice.JobService.GetJobAnalytics:input_type -> jobservice.GetJobAnalyticsRequest
+	82,  // 82: jobservice.JobService.ListSkillTaxonomy:input_type -> jobservice.ListSkillTaxonomyRequest
+	84,  // 83: jobservice.JobService.AddSkillAlias:input_type -> jobservice.AddSkillAliasRequest
+	87,  // 84: jobservice.JobService.AddApplicationNote:input_type -> jobservice.AddApplicationNoteRequest
+	89,  // 85: jobservice.JobService.ListApplicationNotes:input_type -> jobservice.ListApplicationNotesRequest
+	91,  // 86: jobservice.JobService.DeleteApplicationNote:input_type -> jobservice.DeleteApplicationNoteRequest
+	93,  // 87: jobservice.JobService.RateApplication:input_type -> jobservice.RateApplicationRequest
+	106, // 88: jobservice.JobService.MarkApplicationSeen:input_type -> jobservice.MarkApplicationSeenRequest
+	108, // 89: jobservice.JobService.GetJobApplicantCount:input_type -> jobservice.JobApplicantCountRequest
+	99,  // 90: jobservice.JobService.CreateJobTemplate:input_type -> jobservice.CreateJobTemplateRequest
+	101, // 91: jobservice.JobService.ListJobTemplates:input_type -> jobservice.ListJobTemplatesRequest
+	103, // 92: jobservice.JobService.GetJobTemplate:input_type -> jobservice.GetJobTemplateRequest
+	104, // 93: jobservice.JobService.DeleteJobTemplate:input_type -> jobservice.DeleteJobTemplateRequest
+	111, // 94: jobservice.JobService.SetScreeningRules:input_type -> jobservice.SetScreeningRulesRequest
+	113, // 95: jobservice.JobService.GetScreeningRules:input_type -> jobservice.GetScreeningRulesRequest
+	114, // 96: jobservice.JobService.DeleteScreeningRules:input_type -> jobservice.DeleteScreeningRulesRequest
+	117, // 97: jobservice.EmployerService.GetEmployerProfile:output_type -> jobservice.EmployerProfileResponse
+	9,   // 98: jobservice.JobService.PostJob:output_type -> jobservice.PostJobResponse
+	11,  // 99: jobservice.JobService.GetJobs:output_type -> jobservice.GetJobsResponse
+	13,  // 100: jobservice.JobService.GetJobById:output_type -> jobservice.GetJobByIdResponse
+	25,  // 101: jobservice.JobService.UpdateJobStatus:output_type -> jobservice.UpdateJobStatusResponse
+	15,  // 102: jobservice.JobService.ApplyToJob:output_type -> jobservice.ApplyToJobResponse
+	17,  // 103: jobservice.JobService.GetApplications:output_type -> jobservice.GetApplicationsResponse
+	19,  // 104: jobservice.JobService.GetApplication:output_type -> jobservice.GetApplicationResponse
+	21,  // 105: jobservice.JobService.UpdateApplicationStatus:output_type -> jobservice.UpdateApplicationStatusResponse
+	28,  // 106: jobservice.JobService.FilterApplications:output_type -> jobservice.FilterApplicationsResponse
+	23,  // 107: jobservice.JobService.AddJobSkills:output_type -> jobservice.AddJobSkillsResponse
+	38,  // 108: jobservice.JobService.RemoveJobSkill:output_type -> jobservice.RemoveJobSkillResponse
+	40,  // 109: jobservice.JobService.ReplaceJobSkills:output_type -> jobservice.ReplaceJobSkillsResponse
+	30,  // 110: jobservice.JobService.ListEmployerJobs:output_type -> jobservice.ListEmployerJobsResponse
+	32,  // 111: jobservice.JobService.GetEmployerJobStats:output_type -> jobservice.EmployerJobStatsResponse
+	34,  // 112: jobservice.JobService.GetEmployerApplicationStats:output_type -> jobservice.EmployerApplicationStatsResponse
+	36,  // 113: jobservice.JobService.GetRecommendedJobsCount:output_type -> jobservice.RecommendedJobsCountResponse
+	97,  // 114: jobservice.JobService.ListSavedJobs:output_type -> jobservice.ListSavedJobsResponse
+	43,  // 115: jobservice.JobService.ScheduleInterview:output_type -> jobservice.InterviewResponse
+	45,  // 116: jobservice.JobService.GetInterviews:output_type -> jobservice.GetInterviewsResponse
+	43,  // 117: jobservice.JobService.UpdateInterview:output_type -> jobservice.InterviewResponse
+	48,  // 118: jobservice.JobService.ListUpcomingInterviews:output_type -> jobservice.ListUpcomingInterviewsResponse
+	51,  // 119: jobservice.JobService.SubmitInterviewFeedback:output_type -> jobservice.SubmitInterviewFeedbackResponse
+	53,  // 120: jobservice.JobService.GetInterviewFeedback:output_type -> jobservice.GetInterviewFeedbackResponse
+	56,  // 121: jobservice.JobService.CreateJobAlert:output_type -> jobservice.CreateJobAlertResponse
+	58,  // 122: jobservice.JobService.ListJobAlerts:output_type -> jobservice.ListJobAlertsResponse
+	60,  // 123: jobservice.JobService.DeleteJobAlert:output_type -> jobservice.DeleteJobAlertResponse
+	63,  // 124: jobservice.JobService.CreateWebhook:output_type -> jobservice.CreateWebhookResponse
+	65,  // 125: jobservice.JobService.ListWebhooks:output_type -> jobservice.ListWebhooksResponse
+	67,  // 126: jobservice.JobService.DeleteWebhook:output_type -> jobservice.DeleteWebhookResponse
+	69,  // 127: jobservice.JobService.ReportJob:output_type -> jobservice.ReportJobResponse
+	71,  // 128: jobservice.JobService.ListJobsForModeration:output_type -> jobservice.ListJobsForModerationResponse
+	73,  // 129: jobservice.JobService.ModerateJob:output_type -> jobservice.ModerateJobResponse
+	75,  // 130: jobservice.JobService.MarkJobPremium:output_type -> jobservice.MarkJobPremiumResponse
+	78,  // 131: jobservice.JobService.RecordJobViews:output_type -> jobservice.RecordJobViewsResponse
+	80,  // 132: jobservice.JobService.GetJobAnalytics:output_type -> jobservice.GetJobAnalyticsResponse
+	83,  // 133: jobservice.JobService.ListSkillTaxonomy:output_type -> jobservice.ListSkillTaxonomyResponse
+	85,  // 134: jobservice.JobService.AddSkillAlias:output_type -> jobservice.AddSkillAliasResponse
+	88,  // 135: jobservice.JobService.AddApplicationNote:output_type -> jobservice.AddApplicationNoteResponse
+	90,  // 136: jobservice.JobService.ListApplicationNotes:output_type -> jobservice.ListApplicationNotesResponse
+	92,  // 137: jobservice.JobService.DeleteApplicationNote:output_type -> jobservice.DeleteApplicationNoteResponse
+	94,  // 138: jobservice.JobService.RateApplication:output_type -> jobservice.RateApplicationResponse
+	107, // 139: jobservice.JobService.MarkApplicationSeen:output_type -> jobservice.MarkApplicationSeenResponse
+	109, // 140: jobservice.JobService.GetJobApplicantCount:output_type -> jobservice.JobApplicantCountResponse
+	100, // 141: jobservice.JobService.CreateJobTemplate:output_type -> jobservice.JobTemplateResponse
+	102, // 142: jobservice.JobService.ListJobTemplates:output_type -> jobservice.ListJobTemplatesResponse
+	100, // 143: jobservice.JobService.GetJobTemplate:output_type -> jobservice.JobTemplateResponse
+	105, // 144: jobservice.JobService.DeleteJobTemplate:output_type -> jobservice.DeleteJobTemplateResponse
+	112, // 145: jobservice.JobService.SetScreeningRules:output_type -> jobservice.ScreeningRulesResponse
+	112, // 146: jobservice.JobService.GetScreeningRules:output_type -> jobservice.ScreeningRulesResponse
+	115, // 147: jobservice.JobService.DeleteScreeningRules:output_type -> jobservice.DeleteScreeningRulesResponse
+	97,  // [97:148] is the sub-list for method output_type
+	46,  // [46:97] is the sub-list for method input_type
+	46,  // [46:46] is the sub-list for extension type_name
+	46,  // [46:46] is the sub-list for extension extendee
+	0,   // [0:46] is the sub-list for field type_name
+}
+
+func init() { file_skillsync_protos_Job_job_proto_init() }
+func file_skillsync_protos_Job_job_proto_init() {
+	if File_skillsync_protos_Job_job_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_skillsync_protos_Job_job_proto_rawDesc), len(file_skillsync_protos_Job_job_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   120,
+			NumExtensions: 0,
+			NumServices:   2,
+		},
+		GoTypes:           file_skillsync_protos_Job_job_proto_goTypes,
+		DependencyIndexes: file_skillsync_protos_Job_job_proto_depIdxs,
+		MessageInfos:      file_skillsync_protos_Job_job_proto_msgTypes,
+	}.Build()
+	File_skillsync_protos_Job_job_proto = out.File
+	file_skillsync_protos_Job_job_proto_goTypes = nil
+	file_skillsync_protos_Job_job_proto_depIdxs = nil
+}
@@ -0,0 +1,2121 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: skillsync-protos/Job/job.proto
+
+package jobpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	EmployerService_GetEmployerProfile_FullMethodName = "/jobservice.EmployerService/GetEmployerProfile"
+)
+
+// EmployerServiceClient is the client API for EmployerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Service definition
+// EmployerService defines the RPC methods for employer operations
+type EmployerServiceClient interface {
+	GetEmployerProfile(ctx context.Context, in *GetEmployerProfileRequest, opts ...grpc.CallOption) (*EmployerProfileResponse, error)
+}
+
+type employerServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEmployerServiceClient(cc grpc.ClientConnInterface) EmployerServiceClient {
+	return &employerServiceClient{cc}
+}
+
+func (c *employerServiceClient) GetEmployerProfile(ctx context.Context, in *GetEmployerProfileRequest, opts ...grpc.CallOption) (*EmployerProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmployerProfileResponse)
+	err := c.cc.Invoke(ctx, EmployerService_GetEmployerProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EmployerServiceServer is the server API for EmployerService service.
+// All implementations must embed UnimplementedEmployerServiceServer
+// for forward compatibility.
+//
+// Service definition
+// EmployerService defines the RPC methods for employer operations
+type EmployerServiceServer interface {
+	GetEmployerProfile(context.Context, *GetEmployerProfileRequest) (*EmployerProfileResponse, error)
+	mustEmbedUnimplementedEmployerServiceServer()
+}
+
+// UnimplementedEmployerServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedEmployerServiceServer struct{}
+
+func (UnimplementedEmployerServiceServer) GetEmployerProfile(context.Context, *GetEmployerProfileRequest) (*EmployerProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEmployerProfile not implemented")
+}
+func (UnimplementedEmployerServiceServer) mustEmbedUnimplementedEmployerServiceServer() {}
+func (UnimplementedEmployerServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeEmployerServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EmployerServiceServer will
+// result in compilation errors.
+type UnsafeEmployerServiceServer interface {
+	mustEmbedUnimplementedEmployerServiceServer()
+}
+
+func RegisterEmployerServiceServer(s grpc.ServiceRegistrar, srv EmployerServiceServer) {
+	// If the following call pancis, it indicates UnimplementedEmployerServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&EmployerService_ServiceDesc, srv)
+}
+
+func _EmployerService_GetEmployerProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEmployerProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployerServiceServer).GetEmployerProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployerService_GetEmployerProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployerServiceServer).GetEmployerProfile(ctx, req.(*GetEmployerProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// EmployerService_ServiceDesc is the grpc.ServiceDesc for EmployerService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var EmployerService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "jobservice.EmployerService",
+	HandlerType: (*EmployerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetEmployerProfile",
+			Handler:    _EmployerService_GetEmployerProfile_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "skillsync-protos/Job/job.proto",
+}
+
+const (
+	JobService_PostJob_FullMethodName                     = "/jobservice.JobService/PostJob"
+	JobService_GetJobs_FullMethodName                     = "/jobservice.JobService/GetJobs"
+	JobService_GetJobById_FullMethodName                  = "/jobservice.JobService/GetJobById"
+	JobService_UpdateJobStatus_FullMethodName             = "/jobservice.JobService/UpdateJobStatus"
+	JobService_ApplyToJob_FullMethodName                  = "/jobservice.JobService/ApplyToJob"
+	JobService_GetApplications_FullMethodName             = "/jobservice.JobService/GetApplications"
+	JobService_GetApplication_FullMethodName              = "/jobservice.JobService/GetApplication"
+	JobService_UpdateApplicationStatus_FullMethodName     = "/jobservice.JobService/UpdateApplicationStatus"
+	JobService_FilterApplications_FullMethodName          = "/jobservice.JobService/FilterApplications"
+	JobService_AddJobSkills_FullMethodName                = "/jobservice.JobService/AddJobSkills"
+	JobService_RemoveJobSkill_FullMethodName              = "/jobservice.JobService/RemoveJobSkill"
+	JobService_ReplaceJobSkills_FullMethodName            = "/jobservice.JobService/ReplaceJobSkills"
+	JobService_ListEmployerJobs_FullMethodName            = "/jobservice.JobService/ListEmployerJobs"
+	JobService_GetEmployerJobStats_FullMethodName         = "/jobservice.JobService/GetEmployerJobStats"
+	JobService_GetEmployerApplicationStats_FullMethodName = "/jobservice.JobService/GetEmployerApplicationStats"
+	JobService_GetRecommendedJobsCount_FullMethodName     = "/jobservice.JobService/GetRecommendedJobsCount"
+	JobService_ListSavedJobs_FullMethodName               = "/jobservice.JobService/ListSavedJobs"
+	JobService_ScheduleInterview_FullMethodName           = "/jobservice.JobService/ScheduleInterview"
+	JobService_GetInterviews_FullMethodName               = "/jobservice.JobService/GetInterviews"
+	JobService_UpdateInterview_FullMethodName             = "/jobservice.JobService/UpdateInterview"
+	JobService_ListUpcomingInterviews_FullMethodName      = "/jobservice.JobService/ListUpcomingInterviews"
+	JobService_SubmitInterviewFeedback_FullMethodName     = "/jobservice.JobService/SubmitInterviewFeedback"
+	JobService_GetInterviewFeedback_FullMethodName        = "/jobservice.JobService/GetInterviewFeedback"
+	JobService_CreateJobAlert_FullMethodName              = "/jobservice.JobService/CreateJobAlert"
+	JobService_ListJobAlerts_FullMethodName               = "/jobservice.JobService/ListJobAlerts"
+	JobService_DeleteJobAlert_FullMethodName              = "/jobservice.JobService/DeleteJobAlert"
+	JobService_CreateWebhook_FullMethodName               = "/jobservice.JobService/CreateWebhook"
+	JobService_ListWebhooks_FullMethodName                = "/jobservice.JobService/ListWebhooks"
+	JobService_DeleteWebhook_FullMethodName               = "/jobservice.JobService/DeleteWebhook"
+	JobService_ReportJob_FullMethodName                   = "/jobservice.JobService/ReportJob"
+	JobService_ListJobsForModeration_FullMethodName       = "/jobservice.JobService/ListJobsForModeration"
+	JobService_ModerateJob_FullMethodName                 = "/jobservice.JobService/ModerateJob"
+	JobService_MarkJobPremium_FullMethodName              = "/jobservice.JobService/MarkJobPremium"
+	JobService_RecordJobViews_FullMethodName              = "/jobservice.JobService/RecordJobViews"
+	JobService_GetJobAnalytics_FullMethodName             = "/jobservice.JobService/GetJobAnalytics"
+	JobService_ListSkillTaxonomy_FullMethodName           = "/jobservice.JobService/ListSkillTaxonomy"
+	JobService_AddSkillAlias_FullMethodName               = "/jobservice.JobService/AddSkillAlias"
+	JobService_AddApplicationNote_FullMethodName          = "/jobservice.JobService/AddApplicationNote"
+	JobService_ListApplicationNotes_FullMethodName        = "/jobservice.JobService/ListApplicationNotes"
+	JobService_DeleteApplicationNote_FullMethodName       = "/jobservice.JobService/DeleteApplicationNote"
+	JobService_RateApplication_FullMethodName             = "/jobservice.JobService/RateApplication"
+	JobService_MarkApplicationSeen_FullMethodName         = "/jobservice.JobService/MarkApplicationSeen"
+	JobService_GetJobApplicantCount_FullMethodName        = "/jobservice.JobService/GetJobApplicantCount"
+	JobService_CreateJobTemplate_FullMethodName           = "/jobservice.JobService/CreateJobTemplate"
+	JobService_ListJobTemplates_FullMethodName            = "/jobservice.JobService/ListJobTemplates"
+	JobService_GetJobTemplate_FullMethodName              = "/jobservice.JobService/GetJobTemplate"
+	JobService_DeleteJobTemplate_FullMethodName           = "/jobservice.JobService/DeleteJobTemplate"
+	JobService_SetScreeningRules_FullMethodName           = "/jobservice.JobService/SetScreeningRules"
+	JobService_GetScreeningRules_FullMethodName           = "/jobservice.JobService/GetScreeningRules"
+	JobService_DeleteScreeningRules_FullMethodName        = "/jobservice.JobService/DeleteScreeningRules"
+)
+
+// JobServiceClient is the client API for JobService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type JobServiceClient interface {
+	// Core job operations
+	PostJob(ctx context.Context, in *PostJobRequest, opts ...grpc.CallOption) (*PostJobResponse, error)
+	GetJobs(ctx context.Context, in *GetJobsRequest, opts ...grpc.CallOption) (*GetJobsResponse, error)
+	GetJobById(ctx context.Context, in *GetJobByIdRequest, opts ...grpc.CallOption) (*GetJobByIdResponse, error)
+	UpdateJobStatus(ctx context.Context, in *UpdateJobStatusRequest, opts ...grpc.CallOption) (*UpdateJobStatusResponse, error)
+	// Application operations
+	ApplyToJob(ctx context.Context, in *ApplyToJobRequest, opts ...grpc.CallOption) (*ApplyToJobResponse, error)
+	GetApplications(ctx context.Context, in *GetApplicationsRequest, opts ...grpc.CallOption) (*GetApplicationsResponse, error)
+	GetApplication(ctx context.Context, in *GetApplicationRequest, opts ...grpc.CallOption) (*GetApplicationResponse, error)
+	UpdateApplicationStatus(ctx context.Context, in *UpdateApplicationStatusRequest, opts ...grpc.CallOption) (*UpdateApplicationStatusResponse, error)
+	FilterApplications(ctx context.Context, in *FilterApplicationsRequest, opts ...grpc.CallOption) (*FilterApplicationsResponse, error)
+	// Skills operations
+	AddJobSkills(ctx context.Context, in *AddJobSkillsRequest, opts ...grpc.CallOption) (*AddJobSkillsResponse, error)
+	RemoveJobSkill(ctx context.Context, in *RemoveJobSkillRequest, opts ...grpc.CallOption) (*RemoveJobSkillResponse, error)
+	ReplaceJobSkills(ctx context.Context, in *ReplaceJobSkillsRequest, opts ...grpc.CallOption) (*ReplaceJobSkillsResponse, error)
+	// Employer operations
+	ListEmployerJobs(ctx context.Context, in *ListEmployerJobsRequest, opts ...grpc.CallOption) (*ListEmployerJobsResponse, error)
+	GetEmployerJobStats(ctx context.Context, in *EmployerJobStatsRequest, opts ...grpc.CallOption) (*EmployerJobStatsResponse, error)
+	GetEmployerApplicationStats(ctx context.Context, in *EmployerApplicationStatsRequest, opts ...grpc.CallOption) (*EmployerApplicationStatsResponse, error)
+	// Candidate operations
+	GetRecommendedJobsCount(ctx context.Context, in *RecommendedJobsCountRequest, opts ...grpc.CallOption) (*RecommendedJobsCountResponse, error)
+	ListSavedJobs(ctx context.Context, in *ListSavedJobsRequest, opts ...grpc.CallOption) (*ListSavedJobsResponse, error)
+	// Interview operations
+	ScheduleInterview(ctx context.Context, in *ScheduleInterviewRequest, opts ...grpc.CallOption) (*InterviewResponse, error)
+	GetInterviews(ctx context.Context, in *GetInterviewsRequest, opts ...grpc.CallOption) (*GetInterviewsResponse, error)
+	UpdateInterview(ctx context.Context, in *UpdateInterviewRequest, opts ...grpc.CallOption) (*InterviewResponse, error)
+	ListUpcomingInterviews(ctx context.Context, in *ListUpcomingInterviewsRequest, opts ...grpc.CallOption) (*ListUpcomingInterviewsResponse, error)
+	SubmitInterviewFeedback(ctx context.Context, in *SubmitInterviewFeedbackRequest, opts ...grpc.CallOption) (*SubmitInterviewFeedbackResponse, error)
+	GetInterviewFeedback(ctx context.Context, in *GetInterviewFeedbackRequest, opts ...grpc.CallOption) (*GetInterviewFeedbackResponse, error)
+	// Job alert operations
+	CreateJobAlert(ctx context.Context, in *CreateJobAlertRequest, opts ...grpc.CallOption) (*CreateJobAlertResponse, error)
+	ListJobAlerts(ctx context.Context, in *ListJobAlertsRequest, opts ...grpc.CallOption) (*ListJobAlertsResponse, error)
+	DeleteJobAlert(ctx context.Context, in *DeleteJobAlertRequest, opts ...grpc.CallOption) (*DeleteJobAlertResponse, error)
+	// Webhook operations
+	CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*CreateWebhookResponse, error)
+	ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error)
+	DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookResponse, error)
+	// Moderation operations
+	ReportJob(ctx context.Context, in *ReportJobRequest, opts ...grpc.CallOption) (*ReportJobResponse, error)
+	ListJobsForModeration(ctx context.Context, in *ListJobsForModerationRequest, opts ...grpc.CallOption) (*ListJobsForModerationResponse, error)
+	ModerateJob(ctx context.Context, in *ModerateJobRequest, opts ...grpc.CallOption) (*ModerateJobResponse, error)
+	// Payment operations
+	MarkJobPremium(ctx context.Context, in *MarkJobPremiumRequest, opts ...grpc.CallOption) (*MarkJobPremiumResponse, error)
+	// Job view operations
+	RecordJobViews(ctx context.Context, in *RecordJobViewsRequest, opts ...grpc.CallOption) (*RecordJobViewsResponse, error)
+	GetJobAnalytics(ctx context.Context, in *GetJobAnalyticsRequest, opts ...grpc.CallOption) (*GetJobAnalyticsResponse, error)
+	// Skill taxonomy operations
+	ListSkillTaxonomy(ctx context.Context, in *ListSkillTaxonomyRequest, opts ...grpc.CallOption) (*ListSkillTaxonomyResponse, error)
+	AddSkillAlias(ctx context.Context, in *AddSkillAliasRequest, opts ...grpc.CallOption) (*AddSkillAliasResponse, error)
+	// Application review operations
+	AddApplicationNote(ctx context.Context, in *AddApplicationNoteRequest, opts ...grpc.CallOption) (*AddApplicationNoteResponse, error)
+	ListApplicationNotes(ctx context.Context, in *ListApplicationNotesRequest, opts ...grpc.CallOption) (*ListApplicationNotesResponse, error)
+	DeleteApplicationNote(ctx context.Context, in *DeleteApplicationNoteRequest, opts ...grpc.CallOption) (*DeleteApplicationNoteResponse, error)
+	RateApplication(ctx context.Context, in *RateApplicationRequest, opts ...grpc.CallOption) (*RateApplicationResponse, error)
+	MarkApplicationSeen(ctx context.Context, in *MarkApplicationSeenRequest, opts ...grpc.CallOption) (*MarkApplicationSeenResponse, error)
+	GetJobApplicantCount(ctx context.Context, in *JobApplicantCountRequest, opts ...grpc.CallOption) (*JobApplicantCountResponse, error)
+	// Job template operations
+	CreateJobTemplate(ctx context.Context, in *CreateJobTemplateRequest, opts ...grpc.CallOption) (*JobTemplateResponse, error)
+	ListJobTemplates(ctx context.Context, in *ListJobTemplatesRequest, opts ...grpc.CallOption) (*ListJobTemplatesResponse, error)
+	GetJobTemplate(ctx context.Context, in *GetJobTemplateRequest, opts ...grpc.CallOption) (*JobTemplateResponse, error)
+	DeleteJobTemplate(ctx context.Context, in *DeleteJobTemplateRequest, opts ...grpc.CallOption) (*DeleteJobTemplateResponse, error)
+	// Screening rule operations
+	SetScreeningRules(ctx context.Context, in *SetScreeningRulesRequest, opts ...grpc.CallOption) (*ScreeningRulesResponse, error)
+	GetScreeningRules(ctx context.Context, in *GetScreeningRulesRequest, opts ...grpc.CallOption) (*ScreeningRulesResponse, error)
+	DeleteScreeningRules(ctx context.Context, in *DeleteScreeningRulesRequest, opts ...grpc.CallOption) (*DeleteScreeningRulesResponse, error)
+}
+
+type jobServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewJobServiceClient(cc grpc.ClientConnInterface) JobServiceClient {
+	return &jobServiceClient{cc}
+}
+
+func (c *jobServiceClient) PostJob(ctx context.Context, in *PostJobRequest, opts ...grpc.CallOption) (*PostJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PostJobResponse)
+	err := c.cc.Invoke(ctx, JobService_PostJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) GetJobs(ctx context.Context, in *GetJobsRequest, opts ...grpc.CallOption) (*GetJobsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetJobsResponse)
+	err := c.cc.Invoke(ctx, JobService_GetJobs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) GetJobById(ctx context.Context, in *GetJobByIdRequest, opts ...grpc.CallOption) (*GetJobByIdResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetJobByIdResponse)
+	err := c.cc.Invoke(ctx, JobService_GetJobById_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) UpdateJobStatus(ctx context.Context, in *UpdateJobStatusRequest, opts ...grpc.CallOption) (*UpdateJobStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateJobStatusResponse)
+	err := c.cc.Invoke(ctx, JobService_UpdateJobStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) ApplyToJob(ctx context.Context, in *ApplyToJobRequest, opts ...grpc.CallOption) (*ApplyToJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplyToJobResponse)
+	err := c.cc.Invoke(ctx, JobService_ApplyToJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) GetApplications(ctx context.Context, in *GetApplicationsRequest, opts ...grpc.CallOption) (*GetApplicationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetApplicationsResponse)
+	err := c.cc.Invoke(ctx, JobService_GetApplications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) GetApplication(ctx context.Context, in *GetApplicationRequest, opts ...grpc.CallOption) (*GetApplicationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetApplicationResponse)
+	err := c.cc.Invoke(ctx, JobService_GetApplication_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) UpdateApplicationStatus(ctx context.Context, in *UpdateApplicationStatusRequest, opts ...grpc.CallOption) (*UpdateApplicationStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateApplicationStatusResponse)
+	err := c.cc.Invoke(ctx, JobService_UpdateApplicationStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) FilterApplications(ctx context.Context, in *FilterApplicationsRequest, opts ...grpc.CallOption) (*FilterApplicationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FilterApplicationsResponse)
+	err := c.cc.Invoke(ctx, JobService_FilterApplications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) AddJobSkills(ctx context.Context, in *AddJobSkillsRequest, opts ...grpc.CallOption) (*AddJobSkillsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddJobSkillsResponse)
+	err := c.cc.Invoke(ctx, JobService_AddJobSkills_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) RemoveJobSkill(ctx context.Context, in *RemoveJobSkillRequest, opts ...grpc.CallOption) (*RemoveJobSkillResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveJobSkillResponse)
+	err := c.cc.Invoke(ctx, JobService_RemoveJobSkill_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) ReplaceJobSkills(ctx context.Context, in *ReplaceJobSkillsRequest, opts ...grpc.CallOption) (*ReplaceJobSkillsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReplaceJobSkillsResponse)
+	err := c.cc.Invoke(ctx, JobService_ReplaceJobSkills_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) ListEmployerJobs(ctx context.Context, in *ListEmployerJobsRequest, opts ...grpc.CallOption) (*ListEmployerJobsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEmployerJobsResponse)
+	err := c.cc.Invoke(ctx, JobService_ListEmployerJobs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) GetEmployerJobStats(ctx context.Context, in *EmployerJobStatsRequest, opts ...grpc.CallOption) (*EmployerJobStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmployerJobStatsResponse)
+	err := c.cc.Invoke(ctx, JobService_GetEmployerJobStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) GetEmployerApplicationStats(ctx context.Context, in *EmployerApplicationStatsRequest, opts ...grpc.CallOption) (*EmployerApplicationStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmployerApplicationStatsResponse)
+	err := c.cc.Invoke(ctx, JobService_GetEmployerApplicationStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) GetRecommendedJobsCount(ctx context.Context, in *RecommendedJobsCountRequest, opts ...grpc.CallOption) (*RecommendedJobsCountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecommendedJobsCountResponse)
+	err := c.cc.Invoke(ctx, JobService_GetRecommendedJobsCount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) ListSavedJobs(ctx context.Context, in *ListSavedJobsRequest, opts ...grpc.CallOption) (*ListSavedJobsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSavedJobsResponse)
+	err := c.cc.Invoke(ctx, JobService_ListSavedJobs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) ScheduleInterview(ctx context.Context, in *ScheduleInterviewRequest, opts ...grpc.CallOption) (*InterviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InterviewResponse)
+	err := c.cc.Invoke(ctx, JobService_ScheduleInterview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) GetInterviews(ctx context.Context, in *GetInterviewsRequest, opts ...grpc.CallOption) (*GetInterviewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetInterviewsResponse)
+	err := c.cc.Invoke(ctx, JobService_GetInterviews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) UpdateInterview(ctx context.Context, in *UpdateInterviewRequest, opts ...grpc.CallOption) (*InterviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InterviewResponse)
+	err := c.cc.Invoke(ctx, JobService_UpdateInterview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) ListUpcomingInterviews(ctx context.Context, in *ListUpcomingInterviewsRequest, opts ...grpc.CallOption) (*ListUpcomingInterviewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUpcomingInterviewsResponse)
+	err := c.cc.Invoke(ctx, JobService_ListUpcomingInterviews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) SubmitInterviewFeedback(ctx context.Context, in *SubmitInterviewFeedbackRequest, opts ...grpc.CallOption) (*SubmitInterviewFeedbackResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitInterviewFeedbackResponse)
+	err := c.cc.Invoke(ctx, JobService_SubmitInterviewFeedback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) GetInterviewFeedback(ctx context.Context, in *GetInterviewFeedbackRequest, opts ...grpc.CallOption) (*GetInterviewFeedbackResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetInterviewFeedbackResponse)
+	err := c.cc.Invoke(ctx, JobService_GetInterviewFeedback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) CreateJobAlert(ctx context.Context, in *CreateJobAlertRequest, opts ...grpc.CallOption) (*CreateJobAlertResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateJobAlertResponse)
+	err := c.cc.Invoke(ctx, JobService_CreateJobAlert_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) ListJobAlerts(ctx context.Context, in *ListJobAlertsRequest, opts ...grpc.CallOption) (*ListJobAlertsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListJobAlertsResponse)
+	err := c.cc.Invoke(ctx, JobService_ListJobAlerts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) DeleteJobAlert(ctx context.Context, in *DeleteJobAlertRequest, opts ...grpc.CallOption) (*DeleteJobAlertResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteJobAlertResponse)
+	err := c.cc.Invoke(ctx, JobService_DeleteJobAlert_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*CreateWebhookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateWebhookResponse)
+	err := c.cc.Invoke(ctx, JobService_CreateWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWebhooksResponse)
+	err := c.cc.Invoke(ctx, JobService_ListWebhooks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteWebhookResponse)
+	err := c.cc.Invoke(ctx, JobService_DeleteWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) ReportJob(ctx context.Context, in *ReportJobRequest, opts ...grpc.CallOption) (*ReportJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReportJobResponse)
+	err := c.cc.Invoke(ctx, JobService_ReportJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) ListJobsForModeration(ctx context.Context, in *ListJobsForModerationRequest, opts ...grpc.CallOption) (*ListJobsForModerationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListJobsForModerationResponse)
+	err := c.cc.Invoke(ctx, JobService_ListJobsForModeration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) ModerateJob(ctx context.Context, in *ModerateJobRequest, opts ...grpc.CallOption) (*ModerateJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ModerateJobResponse)
+	err := c.cc.Invoke(ctx, JobService_ModerateJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) MarkJobPremium(ctx context.Context, in *MarkJobPremiumRequest, opts ...grpc.CallOption) (*MarkJobPremiumResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarkJobPremiumResponse)
+	err := c.cc.Invoke(ctx, JobService_MarkJobPremium_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) RecordJobViews(ctx context.Context, in *RecordJobViewsRequest, opts ...grpc.CallOption) (*RecordJobViewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecordJobViewsResponse)
+	err := c.cc.Invoke(ctx, JobService_RecordJobViews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) GetJobAnalytics(ctx context.Context, in *GetJobAnalyticsRequest, opts ...grpc.CallOption) (*GetJobAnalyticsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetJobAnalyticsResponse)
+	err := c.cc.Invoke(ctx, JobService_GetJobAnalytics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) ListSkillTaxonomy(ctx context.Context, in *ListSkillTaxonomyRequest, opts ...grpc.CallOption) (*ListSkillTaxonomyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSkillTaxonomyResponse)
+	err := c.cc.Invoke(ctx, JobService_ListSkillTaxonomy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) AddSkillAlias(ctx context.Context, in *AddSkillAliasRequest, opts ...grpc.CallOption) (*AddSkillAliasResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddSkillAliasResponse)
+	err := c.cc.Invoke(ctx, JobService_AddSkillAlias_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) AddApplicationNote(ctx context.Context, in *AddApplicationNoteRequest, opts ...grpc.CallOption) (*AddApplicationNoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddApplicationNoteResponse)
+	err := c.cc.Invoke(ctx, JobService_AddApplicationNote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) ListApplicationNotes(ctx context.Context, in *ListApplicationNotesRequest, opts ...grpc.CallOption) (*ListApplicationNotesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListApplicationNotesResponse)
+	err := c.cc.Invoke(ctx, JobService_ListApplicationNotes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) DeleteApplicationNote(ctx context.Context, in *DeleteApplicationNoteRequest, opts ...grpc.CallOption) (*DeleteApplicationNoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteApplicationNoteResponse)
+	err := c.cc.Invoke(ctx, JobService_DeleteApplicationNote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) RateApplication(ctx context.Context, in *RateApplicationRequest, opts ...grpc.CallOption) (*RateApplicationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RateApplicationResponse)
+	err := c.cc.Invoke(ctx, JobService_RateApplication_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) MarkApplicationSeen(ctx context.Context, in *MarkApplicationSeenRequest, opts ...grpc.CallOption) (*MarkApplicationSeenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarkApplicationSeenResponse)
+	err := c.cc.Invoke(ctx, JobService_MarkApplicationSeen_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) GetJobApplicantCount(ctx context.Context, in *JobApplicantCountRequest, opts ...grpc.CallOption) (*JobApplicantCountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JobApplicantCountResponse)
+	err := c.cc.Invoke(ctx, JobService_GetJobApplicantCount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) CreateJobTemplate(ctx context.Context, in *CreateJobTemplateRequest, opts ...grpc.CallOption) (*JobTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JobTemplateResponse)
+	err := c.cc.Invoke(ctx, JobService_CreateJobTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) ListJobTemplates(ctx context.Context, in *ListJobTemplatesRequest, opts ...grpc.CallOption) (*ListJobTemplatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListJobTemplatesResponse)
+	err := c.cc.Invoke(ctx, JobService_ListJobTemplates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) GetJobTemplate(ctx context.Context, in *GetJobTemplateRequest, opts ...grpc.CallOption) (*JobTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JobTemplateResponse)
+	err := c.cc.Invoke(ctx, JobService_GetJobTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) DeleteJobTemplate(ctx context.Context, in *DeleteJobTemplateRequest, opts ...grpc.CallOption) (*DeleteJobTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteJobTemplateResponse)
+	err := c.cc.Invoke(ctx, JobService_DeleteJobTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) SetScreeningRules(ctx context.Context, in *SetScreeningRulesRequest, opts ...grpc.CallOption) (*ScreeningRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScreeningRulesResponse)
+	err := c.cc.Invoke(ctx, JobService_SetScreeningRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) GetScreeningRules(ctx context.Context, in *GetScreeningRulesRequest, opts ...grpc.CallOption) (*ScreeningRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScreeningRulesResponse)
+	err := c.cc.Invoke(ctx, JobService_GetScreeningRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) DeleteScreeningRules(ctx context.Context, in *DeleteScreeningRulesRequest, opts ...grpc.CallOption) (*DeleteScreeningRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteScreeningRulesResponse)
+	err := c.cc.Invoke(ctx, JobService_DeleteScreeningRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// JobServiceServer is the server API for JobService service.
+// All implementations must embed UnimplementedJobServiceServer
+// for forward compatibility.
+type JobServiceServer interface {
+	// Core job operations
+	PostJob(context.Context, *PostJobRequest) (*PostJobResponse, error)
+	GetJobs(context.Context, *GetJobsRequest) (*GetJobsResponse, error)
+	GetJobById(context.Context, *GetJobByIdRequest) (*GetJobByIdResponse, error)
+	UpdateJobStatus(context.Context, *UpdateJobStatusRequest) (*UpdateJobStatusResponse, error)
+	// Application operations
+	ApplyToJob(context.Context, *ApplyToJobRequest) (*ApplyToJobResponse, error)
+	GetApplications(context.Context, *GetApplicationsRequest) (*GetApplicationsResponse, error)
+	GetApplication(context.Context, *GetApplicationRequest) (*GetApplicationResponse, error)
+	UpdateApplicationStatus(context.Context, *UpdateApplicationStatusRequest) (*UpdateApplicationStatusResponse, error)
+	FilterApplications(context.Context, *FilterApplicationsRequest) (*FilterApplicationsResponse, error)
+	// Skills operations
+	AddJobSkills(context.Context, *AddJobSkillsRequest) (*AddJobSkillsResponse, error)
+	RemoveJobSkill(context.Context, *RemoveJobSkillRequest) (*RemoveJobSkillResponse, error)
+	ReplaceJobSkills(context.Context, *ReplaceJobSkillsRequest) (*ReplaceJobSkillsResponse, error)
+	// Employer operations
+	ListEmployerJobs(context.Context, *ListEmployerJobsRequest) (*ListEmployerJobsResponse, error)
+	GetEmployerJobStats(context.Context, *EmployerJobStatsRequest) (*EmployerJobStatsResponse, error)
+	GetEmployerApplicationStats(context.Context, *EmployerApplicationStatsRequest) (*EmployerApplicationStatsResponse, error)
+	// Candidate operations
+	GetRecommendedJobsCount(context.Context, *RecommendedJobsCountRequest) (*RecommendedJobsCountResponse, error)
+	ListSavedJobs(context.Context, *ListSavedJobsRequest) (*ListSavedJobsResponse, error)
+	// Interview operations
+	ScheduleInterview(context.Context, *ScheduleInterviewRequest) (*InterviewResponse, error)
+	GetInterviews(context.Context, *GetInterviewsRequest) (*GetInterviewsResponse, error)
+	UpdateInterview(context.Context, *UpdateInterviewRequest) (*InterviewResponse, error)
+	ListUpcomingInterviews(context.Context, *ListUpcomingInterviewsRequest) (*ListUpcomingInterviewsResponse, error)
+	SubmitInterviewFeedback(context.Context, *SubmitInterviewFeedbackRequest) (*SubmitInterviewFeedbackResponse, error)
+	GetInterviewFeedback(context.Context, *GetInterviewFeedbackRequest) (*GetInterviewFeedbackResponse, error)
+	// Job alert operations
+	CreateJobAlert(context.Context, *CreateJobAlertRequest) (*CreateJobAlertResponse, error)
+	ListJobAlerts(context.Context, *ListJobAlertsRequest) (*ListJobAlertsResponse, error)
+	DeleteJobAlert(context.Context, *DeleteJobAlertRequest) (*DeleteJobAlertResponse, error)
+	// Webhook operations
+	CreateWebhook(context.Context, *CreateWebhookRequest) (*CreateWebhookResponse, error)
+	ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksResponse, error)
+	DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteWebhookResponse, error)
+	// Moderation operations
+	ReportJob(context.Context, *ReportJobRequest) (*ReportJobResponse, error)
+	ListJobsForModeration(context.Context, *ListJobsForModerationRequest) (*ListJobsForModerationResponse, error)
+	ModerateJob(context.Context, *ModerateJobRequest) (*ModerateJobResponse, error)
+	// Payment operations
+	MarkJobPremium(context.Context, *MarkJobPremiumRequest) (*MarkJobPremiumResponse, error)
+	// Job view operations
+	RecordJobViews(context.Context, *RecordJobViewsRequest) (*RecordJobViewsResponse, error)
+	GetJobAnalytics(context.Context, *GetJobAnalyticsRequest) (*GetJobAnalyticsResponse, error)
+	// Skill taxonomy operations
+	ListSkillTaxonomy(context.Context, *ListSkillTaxonomyRequest) (*ListSkillTaxonomyResponse, error)
+	AddSkillAlias(context.Context, *AddSkillAliasRequest) (*AddSkillAliasResponse, error)
+	// Application review operations
+	AddApplicationNote(context.Context, *AddApplicationNoteRequest) (*AddApplicationNoteResponse, error)
+	ListApplicationNotes(context.Context, *ListApplicationNotesRequest) (*ListApplicationNotesResponse, error)
+	DeleteApplicationNote(context.Context, *DeleteApplicationNoteRequest) (*DeleteApplicationNoteResponse, error)
+	RateApplication(context.Context, *RateApplicationRequest) (*RateApplicationResponse, error)
+	MarkApplicationSeen(context.Context, *MarkApplicationSeenRequest) (*MarkApplicationSeenResponse, error)
+	GetJobApplicantCount(context.Context, *JobApplicantCountRequest) (*JobApplicantCountResponse, error)
+	// Job template operations
+	CreateJobTemplate(context.Context, *CreateJobTemplateRequest) (*JobTemplateResponse, error)
+	ListJobTemplates(context.Context, *ListJobTemplatesRequest) (*ListJobTemplatesResponse, error)
+	GetJobTemplate(context.Context, *GetJobTemplateRequest) (*JobTemplateResponse, error)
+	DeleteJobTemplate(context.Context, *DeleteJobTemplateRequest) (*DeleteJobTemplateResponse, error)
+	// Screening rule operations
+	SetScreeningRules(context.Context, *SetScreeningRulesRequest) (*ScreeningRulesResponse, error)
+	GetScreeningRules(context.Context, *GetScreeningRulesRequest) (*ScreeningRulesResponse, error)
+	DeleteScreeningRules(context.Context, *DeleteScreeningRulesRequest) (*DeleteScreeningRulesResponse, error)
+	mustEmbedUnimplementedJobServiceServer()
+}
+
+// UnimplementedJobServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedJobServiceServer struct{}
+
+func (UnimplementedJobServiceServer) PostJob(context.Context, *PostJobRequest) (*PostJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostJob not implemented")
+}
+func (UnimplementedJobServiceServer) GetJobs(context.Context, *GetJobsRequest) (*GetJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJobs not implemented")
+}
+func (UnimplementedJobServiceServer) GetJobById(context.Context, *GetJobByIdRequest) (*GetJobByIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJobById not implemented")
+}
+func (UnimplementedJobServiceServer) UpdateJobStatus(context.Context, *UpdateJobStatusRequest) (*UpdateJobStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateJobStatus not implemented")
+}
+func (UnimplementedJobServiceServer) ApplyToJob(context.Context, *ApplyToJobRequest) (*ApplyToJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyToJob not implemented")
+}
+func (UnimplementedJobServiceServer) GetApplications(context.Context, *GetApplicationsRequest) (*GetApplicationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetApplications not implemented")
+}
+func (UnimplementedJobServiceServer) GetApplication(context.Context, *GetApplicationRequest) (*GetApplicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetApplication not implemented")
+}
+func (UnimplementedJobServiceServer) UpdateApplicationStatus(context.Context, *UpdateApplicationStatusRequest) (*UpdateApplicationStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateApplicationStatus not implemented")
+}
+func (UnimplementedJobServiceServer) FilterApplications(context.Context, *FilterApplicationsRequest) (*FilterApplicationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FilterApplications not implemented")
+}
+func (UnimplementedJobServiceServer) AddJobSkills(context.Context, *AddJobSkillsRequest) (*AddJobSkillsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddJobSkills not implemented")
+}
+func (UnimplementedJobServiceServer) RemoveJobSkill(context.Context, *RemoveJobSkillRequest) (*RemoveJobSkillResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveJobSkill not implemented")
+}
+func (UnimplementedJobServiceServer) ReplaceJobSkills(context.Context, *ReplaceJobSkillsRequest) (*ReplaceJobSkillsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplaceJobSkills not implemented")
+}
+func (UnimplementedJobServiceServer) ListEmployerJobs(context.Context, *ListEmployerJobsRequest) (*ListEmployerJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEmployerJobs not implemented")
+}
+func (UnimplementedJobServiceServer) GetEmployerJobStats(context.Context, *EmployerJobStatsRequest) (*EmployerJobStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEmployerJobStats not implemented")
+}
+func (UnimplementedJobServiceServer) GetEmployerApplicationStats(context.Context, *EmployerApplicationStatsRequest) (*EmployerApplicationStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEmployerApplicationStats not implemented")
+}
+func (UnimplementedJobServiceServer) GetRecommendedJobsCount(context.Context, *RecommendedJobsCountRequest) (*RecommendedJobsCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecommendedJobsCount not implemented")
+}
+func (UnimplementedJobServiceServer) ListSavedJobs(context.Context, *ListSavedJobsRequest) (*ListSavedJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSavedJobs not implemented")
+}
+func (UnimplementedJobServiceServer) ScheduleInterview(context.Context, *ScheduleInterviewRequest) (*InterviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleInterview not implemented")
+}
+func (UnimplementedJobServiceServer) GetInterviews(context.Context, *GetInterviewsRequest) (*GetInterviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInterviews not implemented")
+}
+func (UnimplementedJobServiceServer) UpdateInterview(context.Context, *UpdateInterviewRequest) (*InterviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateInterview not implemented")
+}
+func (UnimplementedJobServiceServer) ListUpcomingInterviews(context.Context, *ListUpcomingInterviewsRequest) (*ListUpcomingInterviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUpcomingInterviews not implemented")
+}
+func (UnimplementedJobServiceServer) SubmitInterviewFeedback(context.Context, *SubmitInterviewFeedbackRequest) (*SubmitInterviewFeedbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitInterviewFeedback not implemented")
+}
+func (UnimplementedJobServiceServer) GetInterviewFeedback(context.Context, *GetInterviewFeedbackRequest) (*GetInterviewFeedbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInterviewFeedback not implemented")
+}
+func (UnimplementedJobServiceServer) CreateJobAlert(context.Context, *CreateJobAlertRequest) (*CreateJobAlertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateJobAlert not implemented")
+}
+func (UnimplementedJobServiceServer) ListJobAlerts(context.Context, *ListJobAlertsRequest) (*ListJobAlertsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJobAlerts not implemented")
+}
+func (UnimplementedJobServiceServer) DeleteJobAlert(context.Context, *DeleteJobAlertRequest) (*DeleteJobAlertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteJobAlert not implemented")
+}
+func (UnimplementedJobServiceServer) CreateWebhook(context.Context, *CreateWebhookRequest) (*CreateWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebhook not implemented")
+}
+func (UnimplementedJobServiceServer) ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhooks not implemented")
+}
+func (UnimplementedJobServiceServer) DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhook not implemented")
+}
+func (UnimplementedJobServiceServer) ReportJob(context.Context, *ReportJobRequest) (*ReportJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportJob not implemented")
+}
+func (UnimplementedJobServiceServer) ListJobsForModeration(context.Context, *ListJobsForModerationRequest) (*ListJobsForModerationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJobsForModeration not implemented")
+}
+func (UnimplementedJobServiceServer) ModerateJob(context.Context, *ModerateJobRequest) (*ModerateJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModerateJob not implemented")
+}
+func (UnimplementedJobServiceServer) MarkJobPremium(context.Context, *MarkJobPremiumRequest) (*MarkJobPremiumResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkJobPremium not implemented")
+}
+func (UnimplementedJobServiceServer) RecordJobViews(context.Context, *RecordJobViewsRequest) (*RecordJobViewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordJobViews not implemented")
+}
+func (UnimplementedJobServiceServer) GetJobAnalytics(context.Context, *GetJobAnalyticsRequest) (*GetJobAnalyticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJobAnalytics not implemented")
+}
+func (UnimplementedJobServiceServer) ListSkillTaxonomy(context.Context, *ListSkillTaxonomyRequest) (*ListSkillTaxonomyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSkillTaxonomy not implemented")
+}
+func (UnimplementedJobServiceServer) AddSkillAlias(context.Context, *AddSkillAliasRequest) (*AddSkillAliasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddSkillAlias not implemented")
+}
+func (UnimplementedJobServiceServer) AddApplicationNote(context.Context, *AddApplicationNoteRequest) (*AddApplicationNoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddApplicationNote not implemented")
+}
+func (UnimplementedJobServiceServer) ListApplicationNotes(context.Context, *ListApplicationNotesRequest) (*ListApplicationNotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListApplicationNotes not implemented")
+}
+func (UnimplementedJobServiceServer) DeleteApplicationNote(context.Context, *DeleteApplicationNoteRequest) (*DeleteApplicationNoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteApplicationNote not implemented")
+}
+func (UnimplementedJobServiceServer) RateApplication(context.Context, *RateApplicationRequest) (*RateApplicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateApplication not implemented")
+}
+func (UnimplementedJobServiceServer) MarkApplicationSeen(context.Context, *MarkApplicationSeenRequest) (*MarkApplicationSeenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkApplicationSeen not implemented")
+}
+func (UnimplementedJobServiceServer) GetJobApplicantCount(context.Context, *JobApplicantCountRequest) (*JobApplicantCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJobApplicantCount not implemented")
+}
+func (UnimplementedJobServiceServer) CreateJobTemplate(context.Context, *CreateJobTemplateRequest) (*JobTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateJobTemplate not implemented")
+}
+func (UnimplementedJobServiceServer) ListJobTemplates(context.Context, *ListJobTemplatesRequest) (*ListJobTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJobTemplates not implemented")
+}
+func (UnimplementedJobServiceServer) GetJobTemplate(context.Context, *GetJobTemplateRequest) (*JobTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJobTemplate not implemented")
+}
+func (UnimplementedJobServiceServer) DeleteJobTemplate(context.Context, *DeleteJobTemplateRequest) (*DeleteJobTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteJobTemplate not implemented")
+}
+func (UnimplementedJobServiceServer) SetScreeningRules(context.Context, *SetScreeningRulesRequest) (*ScreeningRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetScreeningRules not implemented")
+}
+func (UnimplementedJobServiceServer) GetScreeningRules(context.Context, *GetScreeningRulesRequest) (*ScreeningRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScreeningRules not implemented")
+}
+func (UnimplementedJobServiceServer) DeleteScreeningRules(context.Context, *DeleteScreeningRulesRequest) (*DeleteScreeningRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteScreeningRules not implemented")
+}
+func (UnimplementedJobServiceServer) mustEmbedUnimplementedJobServiceServer() {}
+func (UnimplementedJobServiceServer) testEmbeddedByValue()                    {}
+
+// UnsafeJobServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to JobServiceServer will
+// result in compilation errors.
+type UnsafeJobServiceServer interface {
+	mustEmbedUnimplementedJobServiceServer()
+}
+
+func RegisterJobServiceServer(s grpc.ServiceRegistrar, srv JobServiceServer) {
+	// If the following call pancis, it indicates UnimplementedJobServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&JobService_ServiceDesc, srv)
+}
+
+func _JobService_PostJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).PostJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_PostJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).PostJob(ctx, req.(*PostJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_GetJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).GetJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_GetJobs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).GetJobs(ctx, req.(*GetJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_GetJobById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJobByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).GetJobById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_GetJobById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).GetJobById(ctx, req.(*GetJobByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_UpdateJobStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateJobStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).UpdateJobStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_UpdateJobStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).UpdateJobStatus(ctx, req.(*UpdateJobStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_ApplyToJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyToJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).ApplyToJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_ApplyToJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).ApplyToJob(ctx, req.(*ApplyToJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_GetApplications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApplicationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).GetApplications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_GetApplications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).GetApplications(ctx, req.(*GetApplicationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_GetApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).GetApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_GetApplication_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).GetApplication(ctx, req.(*GetApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_UpdateApplicationStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateApplicationStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).UpdateApplicationStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_UpdateApplicationStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).UpdateApplicationStatus(ctx, req.(*UpdateApplicationStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_FilterApplications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FilterApplicationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).FilterApplications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_FilterApplications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).FilterApplications(ctx, req.(*FilterApplicationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_AddJobSkills_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddJobSkillsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).AddJobSkills(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_AddJobSkills_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).AddJobSkills(ctx, req.(*AddJobSkillsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_RemoveJobSkill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveJobSkillRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).RemoveJobSkill(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_RemoveJobSkill_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).RemoveJobSkill(ctx, req.(*RemoveJobSkillRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_ReplaceJobSkills_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplaceJobSkillsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).ReplaceJobSkills(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_ReplaceJobSkills_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).ReplaceJobSkills(ctx, req.(*ReplaceJobSkillsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_ListEmployerJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEmployerJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).ListEmployerJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_ListEmployerJobs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).ListEmployerJobs(ctx, req.(*ListEmployerJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_GetEmployerJobStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmployerJobStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).GetEmployerJobStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_GetEmployerJobStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).GetEmployerJobStats(ctx, req.(*EmployerJobStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_GetEmployerApplicationStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmployerApplicationStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).GetEmployerApplicationStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_GetEmployerApplicationStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).GetEmployerApplicationStats(ctx, req.(*EmployerApplicationStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_GetRecommendedJobsCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecommendedJobsCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).GetRecommendedJobsCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_GetRecommendedJobsCount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).GetRecommendedJobsCount(ctx, req.(*RecommendedJobsCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_ListSavedJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSavedJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).ListSavedJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_ListSavedJobs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).ListSavedJobs(ctx, req.(*ListSavedJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_ScheduleInterview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleInterviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).ScheduleInterview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_ScheduleInterview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).ScheduleInterview(ctx, req.(*ScheduleInterviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_GetInterviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInterviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).GetInterviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_GetInterviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).GetInterviews(ctx, req.(*GetInterviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_UpdateInterview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateInterviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).UpdateInterview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_UpdateInterview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).UpdateInterview(ctx, req.(*UpdateInterviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_ListUpcomingInterviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUpcomingInterviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).ListUpcomingInterviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_ListUpcomingInterviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).ListUpcomingInterviews(ctx, req.(*ListUpcomingInterviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_SubmitInterviewFeedback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitInterviewFeedbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).SubmitInterviewFeedback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_SubmitInterviewFeedback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).SubmitInterviewFeedback(ctx, req.(*SubmitInterviewFeedbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_GetInterviewFeedback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInterviewFeedbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).GetInterviewFeedback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_GetInterviewFeedback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).GetInterviewFeedback(ctx, req.(*GetInterviewFeedbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_CreateJobAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateJobAlertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).CreateJobAlert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_CreateJobAlert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).CreateJobAlert(ctx, req.(*CreateJobAlertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_ListJobAlerts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJobAlertsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).ListJobAlerts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_ListJobAlerts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).ListJobAlerts(ctx, req.(*ListJobAlertsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_DeleteJobAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteJobAlertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).DeleteJobAlert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_DeleteJobAlert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).DeleteJobAlert(ctx, req.(*DeleteJobAlertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_CreateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).CreateWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_CreateWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).CreateWebhook(ctx, req.(*CreateWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_ListWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).ListWebhooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_ListWebhooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).ListWebhooks(ctx, req.(*ListWebhooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_DeleteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).DeleteWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_DeleteWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).DeleteWebhook(ctx, req.(*DeleteWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_ReportJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).ReportJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_ReportJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).ReportJob(ctx, req.(*ReportJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_ListJobsForModeration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJobsForModerationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).ListJobsForModeration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_ListJobsForModeration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).ListJobsForModeration(ctx, req.(*ListJobsForModerationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_ModerateJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerateJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).ModerateJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_ModerateJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).ModerateJob(ctx, req.(*ModerateJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_MarkJobPremium_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkJobPremiumRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).MarkJobPremium(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_MarkJobPremium_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).MarkJobPremium(ctx, req.(*MarkJobPremiumRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_RecordJobViews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordJobViewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).RecordJobViews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_RecordJobViews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).RecordJobViews(ctx, req.(*RecordJobViewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_GetJobAnalytics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJobAnalyticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).GetJobAnalytics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_GetJobAnalytics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).GetJobAnalytics(ctx, req.(*GetJobAnalyticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_ListSkillTaxonomy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSkillTaxonomyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).ListSkillTaxonomy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_ListSkillTaxonomy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).ListSkillTaxonomy(ctx, req.(*ListSkillTaxonomyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_AddSkillAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddSkillAliasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).AddSkillAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_AddSkillAlias_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).AddSkillAlias(ctx, req.(*AddSkillAliasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_AddApplicationNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddApplicationNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).AddApplicationNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_AddApplicationNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).AddApplicationNote(ctx, req.(*AddApplicationNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_ListApplicationNotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApplicationNotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).ListApplicationNotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_ListApplicationNotes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).ListApplicationNotes(ctx, req.(*ListApplicationNotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_DeleteApplicationNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteApplicationNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).DeleteApplicationNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_DeleteApplicationNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).DeleteApplicationNote(ctx, req.(*DeleteApplicationNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_RateApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RateApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).RateApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_RateApplication_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).RateApplication(ctx, req.(*RateApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_MarkApplicationSeen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkApplicationSeenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).MarkApplicationSeen(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_MarkApplicationSeen_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).MarkApplicationSeen(ctx, req.(*MarkApplicationSeenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_GetJobApplicantCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobApplicantCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).GetJobApplicantCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_GetJobApplicantCount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).GetJobApplicantCount(ctx, req.(*JobApplicantCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_CreateJobTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateJobTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).CreateJobTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_CreateJobTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).CreateJobTemplate(ctx, req.(*CreateJobTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_ListJobTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJobTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).ListJobTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_ListJobTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).ListJobTemplates(ctx, req.(*ListJobTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_GetJobTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJobTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).GetJobTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_GetJobTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).GetJobTemplate(ctx, req.(*GetJobTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_DeleteJobTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteJobTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).DeleteJobTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_DeleteJobTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).DeleteJobTemplate(ctx, req.(*DeleteJobTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_SetScreeningRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetScreeningRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).SetScreeningRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_SetScreeningRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).SetScreeningRules(ctx, req.(*SetScreeningRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_GetScreeningRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScreeningRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).GetScreeningRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_GetScreeningRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).GetScreeningRules(ctx, req.(*GetScreeningRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_DeleteScreeningRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteScreeningRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).DeleteScreeningRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_DeleteScreeningRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).DeleteScreeningRules(ctx, req.(*DeleteScreeningRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// JobService_ServiceDesc is the grpc.ServiceDesc for JobService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var JobService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "jobservice.JobService",
+	HandlerType: (*JobServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PostJob",
+			Handler:    _JobService_PostJob_Handler,
+		},
+		{
+			MethodName: "GetJobs",
+			Handler:    _JobService_GetJobs_Handler,
+		},
+		{
+			MethodName: "GetJobById",
+			Handler:    _JobService_GetJobById_Handler,
+		},
+		{
+			MethodName: "UpdateJobStatus",
+			Handler:    _JobService_UpdateJobStatus_Handler,
+		},
+		{
+			MethodName: "ApplyToJob",
+			Handler:    _JobService_ApplyToJob_Handler,
+		},
+		{
+			MethodName: "GetApplications",
+			Handler:    _JobService_GetApplications_Handler,
+		},
+		{
+			MethodName: "GetApplication",
+			Handler:    _JobService_GetApplication_Handler,
+		},
+		{
+			MethodName: "UpdateApplicationStatus",
+			Handler:    _JobService_UpdateApplicationStatus_Handler,
+		},
+		{
+			MethodName: "FilterApplications",
+			Handler:    _JobService_FilterApplications_Handler,
+		},
+		{
+			MethodName: "AddJobSkills",
+			Handler:    _JobService_AddJobSkills_Handler,
+		},
+		{
+			MethodName: "RemoveJobSkill",
+			Handler:    _JobService_RemoveJobSkill_Handler,
+		},
+		{
+			MethodName: "ReplaceJobSkills",
+			Handler:    _JobService_ReplaceJobSkills_Handler,
+		},
+		{
+			MethodName: "ListEmployerJobs",
+			Handler:    _JobService_ListEmployerJobs_Handler,
+		},
+		{
+			MethodName: "GetEmployerJobStats",
+			Handler:    _JobService_GetEmployerJobStats_Handler,
+		},
+		{
+			MethodName: "GetEmployerApplicationStats",
+			Handler:    _JobService_GetEmployerApplicationStats_Handler,
+		},
+		{
+			MethodName: "GetRecommendedJobsCount",
+			Handler:    _JobService_GetRecommendedJobsCount_Handler,
+		},
+		{
+			MethodName: "ListSavedJobs",
+			Handler:    _JobService_ListSavedJobs_Handler,
+		},
+		{
+			MethodName: "ScheduleInterview",
+			Handler:    _JobService_ScheduleInterview_Handler,
+		},
+		{
+			MethodName: "GetInterviews",
+			Handler:    _JobService_GetInterviews_Handler,
+		},
+		{
+			MethodName: "UpdateInterview",
+			Handler:    _JobService_UpdateInterview_Handler,
+		},
+		{
+			MethodName: "ListUpcomingInterviews",
+			Handler:    _JobService_ListUpcomingInterviews_Handler,
+		},
+		{
+			MethodName: "SubmitInterviewFeedback",
+			Handler:    _JobService_SubmitInterviewFeedback_Handler,
+		},
+		{
+			MethodName: "GetInterviewFeedback",
+			Handler:    _JobService_GetInterviewFeedback_Handler,
+		},
+		{
+			MethodName: "CreateJobAlert",
+			Handler:    _JobService_CreateJobAlert_Handler,
+		},
+		{
+			MethodName: "ListJobAlerts",
+			Handler:    _JobService_ListJobAlerts_Handler,
+		},
+		{
+			MethodName: "DeleteJobAlert",
+			Handler:    _JobService_DeleteJobAlert_Handler,
+		},
+		{
+			MethodName: "CreateWebhook",
+			Handler:    _JobService_CreateWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhooks",
+			Handler:    _JobService_ListWebhooks_Handler,
+		},
+		{
+			MethodName: "DeleteWebhook",
+			Handler:    _JobService_DeleteWebhook_Handler,
+		},
+		{
+			MethodName: "ReportJob",
+			Handler:    _JobService_ReportJob_Handler,
+		},
+		{
+			MethodName: "ListJobsForModeration",
+			Handler:    _JobService_ListJobsForModeration_Handler,
+		},
+		{
+			MethodName: "ModerateJob",
+			Handler:    _JobService_ModerateJob_Handler,
+		},
+		{
+			MethodName: "MarkJobPremium",
+			Handler:    _JobService_MarkJobPremium_Handler,
+		},
+		{
+			MethodName: "RecordJobViews",
+			Handler:    _JobService_RecordJobViews_Handler,
+		},
+		{
+			MethodName: "GetJobAnalytics",
+			Handler:    _JobService_GetJobAnalytics_Handler,
+		},
+		{
+			MethodName: "ListSkillTaxonomy",
+			Handler:    _JobService_ListSkillTaxonomy_Handler,
+		},
+		{
+			MethodName: "AddSkillAlias",
+			Handler:    _JobService_AddSkillAlias_Handler,
+		},
+		{
+			MethodName: "AddApplicationNote",
+			Handler:    _JobService_AddApplicationNote_Handler,
+		},
+		{
+			MethodName: "ListApplicationNotes",
+			Handler:    _JobService_ListApplicationNotes_Handler,
+		},
+		{
+			MethodName: "DeleteApplicationNote",
+			Handler:    _JobService_DeleteApplicationNote_Handler,
+		},
+		{
+			MethodName: "RateApplication",
+			Handler:    _JobService_RateApplication_Handler,
+		},
+		{
+			MethodName: "MarkApplicationSeen",
+			Handler:    _JobService_MarkApplicationSeen_Handler,
+		},
+		{
+			MethodName: "GetJobApplicantCount",
+			Handler:    _JobService_GetJobApplicantCount_Handler,
+		},
+		{
+			MethodName: "CreateJobTemplate",
+			Handler:    _JobService_CreateJobTemplate_Handler,
+		},
+		{
+			MethodName: "ListJobTemplates",
+			Handler:    _JobService_ListJobTemplates_Handler,
+		},
+		{
+			MethodName: "GetJobTemplate",
+			Handler:    _JobService_GetJobTemplate_Handler,
+		},
+		{
+			MethodName: "DeleteJobTemplate",
+			Handler:    _JobService_DeleteJobTemplate_Handler,
+		},
+		{
+			MethodName: "SetScreeningRules",
+			Handler:    _JobService_SetScreeningRules_Handler,
+		},
+		{
+			MethodName: "GetScreeningRules",
+			Handler:    _JobService_GetScreeningRules_Handler,
+		},
+		{
+			MethodName: "DeleteScreeningRules",
+			Handler:    _JobService_DeleteScreeningRules_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "skillsync-protos/Job/job.proto",
+}
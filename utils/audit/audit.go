@@ -0,0 +1,262 @@
+// Package audit records who changed what. Events go to every configured Sink, and
+// a Store answers the admin queries. Recording never fails the caller's request:
+// sink errors are logged and dropped.
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Event is one sensitive operation
+type Event struct {
+	Time    time.Time `json:"time"`
+	ActorID string    `json:"actor_id"`
+	// ImpersonatorID is the admin who acted as the actor, if any
+	ImpersonatorID string            `json:"impersonator_id,omitempty"`
+	Role           string            `json:"role"`
+	Action         string            `json:"action"`
+	Target         string            `json:"target"`
+	IP             string            `json:"ip"`
+	RequestID      string            `json:"request_id"`
+	Details        map[string]string `json:"details,omitempty"`
+}
+
+// secretDetail matches detail keys that may hold credentials; they are never recorded
+var secretDetail = []string{"password", "token", "secret", "otp"}
+
+// sanitize drops details that look like credentials
+func sanitize(event Event) Event {
+	if len(event.Details) == 0 {
+		return event
+	}
+	details := make(map[string]string, len(event.Details))
+	for name, value := range event.Details {
+		lower := strings.ToLower(name)
+		secret := false
+		for _, word := range secretDetail {
+			if strings.Contains(lower, word) {
+				secret = true
+				break
+			}
+		}
+		if !secret {
+			details[name] = value
+		}
+	}
+	event.Details = details
+	return event
+}
+
+// Sink receives every recorded event, e.g. stdout, a file or another service
+type Sink interface {
+	Write(event Event) error
+}
+
+// Query filters stored events. Empty fields match everything; Page starts at 1.
+type Query struct {
+	Actor  string
+	Action string
+	From   time.Time
+	To     time.Time
+	Page   int
+	Limit  int
+}
+
+func (q Query) matches(event Event) bool {
+	return (q.Actor == "" || event.ActorID == q.Actor) &&
+		(q.Action == "" || event.Action == q.Action) &&
+		(q.From.IsZero() || !event.Time.Before(q.From)) &&
+		(q.To.IsZero() || event.Time.Before(q.To))
+}
+
+// page returns one page of events, newest first, and the total that matched
+func (q Query) page(events []Event) ([]Event, int) {
+	sort.SliceStable(events, func(i, j int) bool { return events[i].Time.After(events[j].Time) })
+	total := len(events)
+	start := (q.Page - 1) * q.Limit
+	if start >= total {
+		return []Event{}, total
+	}
+	end := start + q.Limit
+	if end > total {
+		end = total
+	}
+	return events[start:end], total
+}
+
+// Store is a Sink that can also be queried
+type Store interface {
+	Sink
+	Query(q Query) ([]Event, int, error)
+}
+
+// WriterSink writes events as JSON lines, e.g. to stdout
+type WriterSink struct {
+	mutex   sync.Mutex
+	encoder *json.Encoder
+}
+
+// NewWriterSink writes events to w
+func NewWriterSink(w io.Writer) *WriterSink {
+	return &WriterSink{encoder: json.NewEncoder(w)}
+}
+
+func (s *WriterSink) Write(event Event) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.encoder.Encode(event)
+}
+
+// MemoryStore keeps the most recent events in a ring buffer
+type MemoryStore struct {
+	mutex  sync.RWMutex
+	events []Event
+	next   int
+	full   bool
+}
+
+// NewMemoryStore keeps up to capacity events
+func NewMemoryStore(capacity int) *MemoryStore {
+	return &MemoryStore{events: make([]Event, capacity)}
+}
+
+func (s *MemoryStore) Write(event Event) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if len(s.events) == 0 {
+		return nil
+	}
+	s.events[s.next] = event
+	s.next = (s.next + 1) % len(s.events)
+	if s.next == 0 {
+		s.full = true
+	}
+	return nil
+}
+
+func (s *MemoryStore) Query(q Query) ([]Event, int, error) {
+	s.mutex.RLock()
+	stored := s.events[:s.next]
+	if s.full {
+		stored = s.events
+	}
+	var matched []Event
+	for _, event := range stored {
+		if q.matches(event) {
+			matched = append(matched, event)
+		}
+	}
+	s.mutex.RUnlock()
+
+	events, total := q.page(matched)
+	return events, total, nil
+}
+
+// FileStore appends events to a JSON lines file and queries by scanning it
+type FileStore struct {
+	mutex sync.Mutex
+	path  string
+	file  *os.File
+}
+
+// OpenFileStore opens (or creates) the file at path for appending
+func OpenFileStore(path string) (*FileStore, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	return &FileStore{path: path, file: file}, nil
+}
+
+func (s *FileStore) Write(event Event) error {
+	line, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	_, err = s.file.Write(append(line, '\n'))
+	return err
+}
+
+func (s *FileStore) Query(q Query) ([]Event, int, error) {
+	file, err := os.Open(s.path)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer file.Close()
+
+	var matched []Event
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var event Event
+		// A torn last line from a crash shouldn't hide the rest of the log
+		if err := json.Unmarshal(scanner.Bytes(), &event); err != nil {
+			continue
+		}
+		if q.matches(event) {
+			matched = append(matched, event)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, 0, err
+	}
+	events, total := q.page(matched)
+	return events, total, nil
+}
+
+// Logger fans events out to its sinks and answers queries from its store
+type Logger struct {
+	sinks []Sink
+	store Store
+}
+
+// NewLogger records to store and every extra sink. store may be nil to disable queries.
+func NewLogger(store Store, sinks ...Sink) *Logger {
+	logger := &Logger{store: store, sinks: sinks}
+	if store != nil {
+		logger.sinks = append(logger.sinks, store)
+	}
+	return logger
+}
+
+// ErrNoStore is returned by Query when the logger has nothing to query
+var ErrNoStore = errors.New("audit store not configured")
+
+// Record writes event to every sink, stamping the time if unset. Failures are
+// logged and never returned, so a broken sink can't fail a user's request.
+func (l *Logger) Record(event Event) {
+	if event.Time.IsZero() {
+		event.Time = time.Now().UTC()
+	}
+	event = sanitize(event)
+	for _, sink := range l.sinks {
+		if err := sink.Write(event); err != nil {
+			log.Printf("Failed to write audit event %s by %s: %v", event.Action, event.ActorID, err)
+		}
+	}
+}
+
+// Query returns one page of matching events, newest first, and the total count
+func (l *Logger) Query(q Query) ([]Event, int, error) {
+	if l.store == nil {
+		return nil, 0, ErrNoStore
+	}
+	if q.Page < 1 {
+		q.Page = 1
+	}
+	if q.Limit < 1 {
+		q.Limit = 50
+	}
+	return l.store.Query(q)
+}
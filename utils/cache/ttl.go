@@ -0,0 +1,90 @@
+package cache
+
+import (
+	"sync"
+	"time"
+)
+
+type entry[V any] struct {
+	value     V
+	expiresAt time.Time
+}
+
+// TTLCache is a small in-memory cache whose entries expire after a fixed TTL
+type TTLCache[V any] struct {
+	mutex sync.RWMutex
+	ttl   time.Duration
+	items map[string]entry[V]
+}
+
+// NewTTLCache creates a cache that keeps entries for ttl
+func NewTTLCache[V any](ttl time.Duration) *TTLCache[V] {
+	return &TTLCache[V]{
+		ttl:   ttl,
+		items: make(map[string]entry[V]),
+	}
+}
+
+// Get returns the cached value for key if it hasn't expired
+func (c *TTLCache[V]) Get(key string) (V, bool) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	item, ok := c.items[key]
+	if !ok || time.Now().After(item.expiresAt) {
+		var zero V
+		return zero, false
+	}
+	return item.value, true
+}
+
+// Set stores value under key using the cache's TTL
+func (c *TTLCache[V]) Set(key string, value V) {
+	c.SetWithTTL(key, value, c.ttl)
+}
+
+// SetWithTTL stores value under key with a custom TTL
+func (c *TTLCache[V]) SetWithTTL(key string, value V, ttl time.Duration) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	now := time.Now()
+	// Opportunistically drop expired entries so the map doesn't grow unbounded
+	if len(c.items) > 0 && len(c.items)%256 == 0 {
+		for k, item := range c.items {
+			if now.After(item.expiresAt) {
+				delete(c.items, k)
+			}
+		}
+	}
+	c.items[key] = entry[V]{value: value, expiresAt: now.Add(ttl)}
+}
+
+// Delete removes key from the cache
+func (c *TTLCache[V]) Delete(key string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	delete(c.items, key)
+}
+
+// Clear removes every entry from the cache
+func (c *TTLCache[V]) Clear() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.items = make(map[string]entry[V])
+}
+
+// Take removes key and returns its value if it hadn't expired, so a value can be
+// used at most once even under concurrent requests
+func (c *TTLCache[V]) Take(key string) (V, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	item, ok := c.items[key]
+	delete(c.items, key)
+	if !ok || time.Now().After(item.expiresAt) {
+		var zero V
+		return zero, false
+	}
+	return item.value, true
+}
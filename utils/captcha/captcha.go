@@ -0,0 +1,91 @@
+package captcha
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Supported providers
+const (
+	Turnstile = "turnstile"
+	ReCAPTCHA = "recaptcha"
+)
+
+var verifyURLs = map[string]string{
+	Turnstile: "https://challenges.cloudflare.com/turnstile/v0/siteverify",
+	ReCAPTCHA: "https://www.google.com/recaptcha/api/siteverify",
+}
+
+// ErrFailed means the provider answered and rejected the token
+var ErrFailed = errors.New("captcha verification failed")
+
+// ErrUnavailable means the provider couldn't be reached or gave an unusable answer
+var ErrUnavailable = errors.New("captcha provider unavailable")
+
+// Verifier checks a CAPTCHA token a client solved. Implementations return ErrFailed
+// or ErrUnavailable (possibly wrapped) so callers can choose to fail open.
+type Verifier interface {
+	Verify(ctx context.Context, token, remoteIP string) error
+}
+
+// HTTPVerifier calls a provider's siteverify API. Turnstile and reCAPTCHA share the
+// same request and response shape.
+type HTTPVerifier struct {
+	URL    string
+	Secret string
+	Client *http.Client
+}
+
+// New returns the verifier for provider, or nil when provider is empty
+func New(provider, secret string, timeout time.Duration) (Verifier, error) {
+	if provider == "" {
+		return nil, nil
+	}
+	verifyURL, ok := verifyURLs[provider]
+	if !ok {
+		return nil, fmt.Errorf("unknown captcha provider %q", provider)
+	}
+	return &HTTPVerifier{URL: verifyURL, Secret: secret, Client: &http.Client{Timeout: timeout}}, nil
+}
+
+func (v *HTTPVerifier) Verify(ctx context.Context, token, remoteIP string) error {
+	if strings.TrimSpace(token) == "" {
+		return ErrFailed
+	}
+	form := url.Values{"secret": {v.Secret}, "response": {token}}
+	if remoteIP != "" {
+		form.Set("remoteip", remoteIP)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, v.URL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrUnavailable, err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := v.Client.Do(req)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrUnavailable, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%w: status %d", ErrUnavailable, resp.StatusCode)
+	}
+
+	var result struct {
+		Success    bool     `json:"success"`
+		ErrorCodes []string `json:"error-codes"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return fmt.Errorf("%w: %v", ErrUnavailable, err)
+	}
+	if !result.Success {
+		return fmt.Errorf("%w: %s", ErrFailed, strings.Join(result.ErrorCodes, ","))
+	}
+	return nil
+}
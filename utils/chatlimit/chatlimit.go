@@ -0,0 +1,187 @@
+// Package chatlimit caps how fast users send chat messages. The REST and
+// WebSocket transports share one Limiter, so switching between them doesn't
+// reset a sender's budget. A sender who keeps hitting the limits is muted for a
+// while.
+package chatlimit
+
+import (
+	"expvar"
+	"sync"
+	"time"
+)
+
+// Reasons a message is refused
+const (
+	SenderLimit       = "chat_rate_limited"
+	ConversationLimit = "conversation_rate_limited"
+	BulkLimit         = "bulk_send_rate_limited"
+	Muted             = "chat_flood_muted"
+)
+
+// window is the period the message budgets are counted over
+const window = time.Minute
+
+var (
+	// metrics counts refused messages by reason
+	metrics = expvar.NewMap("chat_throttle")
+	// userMetrics counts refused messages per sender; only throttled senders appear
+	userMetrics = expvar.NewMap("chat_throttle_users")
+)
+
+// Options are the budgets, per minute, and the flood detection settings
+type Options struct {
+	// PerSender caps a sender's messages across all conversations
+	PerSender int
+	// PerRecipient caps a sender's messages to one person, across every
+	// conversation with them
+	PerRecipient int
+	// BulkPerSender caps messages sent through bulk and broadcast endpoints
+	BulkPerSender int
+	// FloodThreshold refusals within FloodWindow mute the sender for MuteFor
+	FloodThreshold int
+	FloodWindow    time.Duration
+	MuteFor        time.Duration
+}
+
+// Decision is the outcome of asking to send
+type Decision struct {
+	Allowed bool
+	// Reason is why the message was refused, one of the constants above
+	Reason string
+	// RetryAfter is how long until the sender may try again
+	RetryAfter time.Duration
+}
+
+type counter struct {
+	start time.Time
+	count int
+}
+
+type budget struct {
+	key    string
+	limit  int
+	reason string
+}
+
+// Limiter counts messages per sender in fixed one minute windows
+type Limiter struct {
+	mutex      sync.Mutex
+	options    Options
+	counters   map[string]*counter
+	strikes    map[string]*counter
+	mutedUntil map[string]time.Time
+	swept      time.Time
+}
+
+// New returns a Limiter enforcing options
+func New(options Options) *Limiter {
+	return &Limiter{
+		options:    options,
+		counters:   make(map[string]*counter),
+		strikes:    make(map[string]*counter),
+		mutedUntil: make(map[string]time.Time),
+	}
+}
+
+// Allow counts one message from senderID to recipientID, or refuses it. A nil
+// Limiter allows everything.
+func (l *Limiter) Allow(senderID, recipientID string) Decision {
+	if l == nil {
+		return Decision{Allowed: true}
+	}
+	return l.take(senderID, 1, []budget{
+		{key: "sender:" + senderID, limit: l.options.PerSender, reason: SenderLimit},
+		{key: "recipient:" + senderID + "\x00" + recipientID, limit: l.options.PerRecipient, reason: ConversationLimit},
+	})
+}
+
+// AllowBulk counts messages sent at once by a bulk or broadcast endpoint, which
+// have their own, higher budget. A muted sender can't send them either.
+func (l *Limiter) AllowBulk(senderID string, messages int) Decision {
+	if l == nil {
+		return Decision{Allowed: true}
+	}
+	return l.take(senderID, messages, []budget{
+		{key: "bulk:" + senderID, limit: l.options.BulkPerSender, reason: BulkLimit},
+	})
+}
+
+func (l *Limiter) take(senderID string, messages int, budgets []budget) Decision {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	now := time.Now()
+	l.sweep(now)
+	if until, ok := l.mutedUntil[senderID]; ok && now.Before(until) {
+		return l.refused(senderID, Muted, until.Sub(now))
+	}
+	// Every budget is checked before any is used, so a refused message costs nothing
+	for _, b := range budgets {
+		current := l.current(l.counters, b.key, now)
+		if current.count+messages > b.limit {
+			return l.strike(senderID, b.reason, window-now.Sub(current.start), now)
+		}
+	}
+	for _, b := range budgets {
+		l.counters[b.key].count += messages
+	}
+	return Decision{Allowed: true}
+}
+
+// current returns key's counter for the window now falls in
+func (l *Limiter) current(counters map[string]*counter, key string, now time.Time) *counter {
+	c, ok := counters[key]
+	if !ok || now.Sub(c.start) >= window {
+		c = &counter{start: now}
+		counters[key] = c
+	}
+	return c
+}
+
+// strike refuses a message over a budget and mutes the sender once they have
+// been refused FloodThreshold times within FloodWindow
+func (l *Limiter) strike(senderID, reason string, retryAfter time.Duration, now time.Time) Decision {
+	strikes, ok := l.strikes[senderID]
+	if !ok || now.Sub(strikes.start) >= l.options.FloodWindow {
+		strikes = &counter{start: now}
+		l.strikes[senderID] = strikes
+	}
+	strikes.count++
+	if strikes.count >= l.options.FloodThreshold {
+		delete(l.strikes, senderID)
+		l.mutedUntil[senderID] = now.Add(l.options.MuteFor)
+		metrics.Add("mutes", 1)
+		return l.refused(senderID, Muted, l.options.MuteFor)
+	}
+	return l.refused(senderID, reason, retryAfter)
+}
+
+func (l *Limiter) refused(senderID, reason string, retryAfter time.Duration) Decision {
+	metrics.Add(reason, 1)
+	userMetrics.Add(senderID, 1)
+	return Decision{Allowed: false, Reason: reason, RetryAfter: retryAfter}
+}
+
+// sweep drops counters, strikes and mutes that ended, at most once a window, so
+// idle senders don't accumulate
+func (l *Limiter) sweep(now time.Time) {
+	if now.Sub(l.swept) < window {
+		return
+	}
+	l.swept = now
+	for key, c := range l.counters {
+		if now.Sub(c.start) >= window {
+			delete(l.counters, key)
+		}
+	}
+	for key, c := range l.strikes {
+		if now.Sub(c.start) >= l.options.FloodWindow {
+			delete(l.strikes, key)
+		}
+	}
+	for key, until := range l.mutedUntil {
+		if !now.Before(until) {
+			delete(l.mutedUntil, key)
+		}
+	}
+}
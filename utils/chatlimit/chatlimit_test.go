@@ -0,0 +1,86 @@
+package chatlimit
+
+import (
+	"testing"
+	"time"
+)
+
+type send struct {
+	sender    string
+	recipient string
+	// bulk is the number of messages in a bulk send; 0 sends one message with Allow
+	bulk int
+	want string
+}
+
+func TestLimiter(t *testing.T) {
+	options := Options{PerSender: 3, PerRecipient: 2, BulkPerSender: 5, FloodThreshold: 3, FloodWindow: time.Minute, MuteFor: 10 * time.Minute}
+	tests := []struct {
+		name  string
+		sends []send
+	}{
+		{"within budget", []send{{"u1", "a", 0, ""}, {"u1", "b", 0, ""}}},
+		{"per recipient", []send{{"u1", "a", 0, ""}, {"u1", "a", 0, ""}, {"u1", "a", 0, ConversationLimit}, {"u1", "b", 0, ""}}},
+		{"per sender", []send{{"u1", "a", 0, ""}, {"u1", "b", 0, ""}, {"u1", "c", 0, ""}, {"u1", "d", 0, SenderLimit}}},
+		{"senders counted apart", []send{{"u1", "a", 0, ""}, {"u1", "a", 0, ""}, {"u2", "a", 0, ""}, {"u2", "a", 0, ""}}},
+		{"refused messages cost nothing", []send{{"u1", "a", 0, ""}, {"u1", "a", 0, ""}, {"u1", "a", 0, ConversationLimit}, {"u1", "b", 0, ""}, {"u1", "c", 0, SenderLimit}}},
+		{"bulk has its own budget", []send{{"u1", "a", 0, ""}, {"u1", "", 5, ""}, {"u1", "b", 0, ""}, {"u1", "", 1, BulkLimit}}},
+		{"bulk over budget at once", []send{{"u1", "", 6, BulkLimit}, {"u1", "", 5, ""}}},
+		{
+			"flooding mutes",
+			[]send{{"u1", "a", 0, ""}, {"u1", "a", 0, ""}, {"u1", "a", 0, ConversationLimit}, {"u1", "a", 0, ConversationLimit}, {"u1", "a", 0, Muted}, {"u1", "b", 0, Muted}, {"u1", "", 1, Muted}, {"u2", "a", 0, ""}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limiter := New(options)
+			for i, s := range tt.sends {
+				var decision Decision
+				if s.bulk > 0 {
+					decision = limiter.AllowBulk(s.sender, s.bulk)
+				} else {
+					decision = limiter.Allow(s.sender, s.recipient)
+				}
+				if decision.Allowed != (s.want == "") || decision.Reason != s.want {
+					t.Fatalf("send %d = %+v, want reason %q", i, decision, s.want)
+				}
+				if !decision.Allowed && decision.RetryAfter <= 0 {
+					t.Errorf("send %d RetryAfter = %v, want a wait", i, decision.RetryAfter)
+				}
+				if decision.Reason == Muted && decision.RetryAfter > options.MuteFor {
+					t.Errorf("send %d RetryAfter = %v, longer than the mute", i, decision.RetryAfter)
+				}
+			}
+		})
+	}
+}
+
+func TestLimiterWindows(t *testing.T) {
+	limiter := New(Options{PerSender: 1, PerRecipient: 1, FloodThreshold: 2, FloodWindow: time.Minute, MuteFor: time.Minute})
+	limiter.Allow("u1", "a")
+	if d := limiter.Allow("u1", "a"); d.Allowed {
+		t.Fatal("second message allowed within the window")
+	}
+	// Move the counters back a window
+	for _, c := range limiter.counters {
+		c.start = c.start.Add(-window)
+	}
+	if d := limiter.Allow("u1", "a"); !d.Allowed {
+		t.Errorf("message in a new window refused: %+v", d)
+	}
+
+	limiter.mutedUntil["u2"] = time.Now().Add(-time.Second)
+	if d := limiter.Allow("u2", "a"); !d.Allowed {
+		t.Errorf("sender refused after their mute ended: %+v", d)
+	}
+}
+
+func TestNilLimiter(t *testing.T) {
+	var limiter *Limiter
+	if d := limiter.Allow("u1", "a"); !d.Allowed {
+		t.Errorf("Allow() = %+v on a nil Limiter", d)
+	}
+	if d := limiter.AllowBulk("u1", 1000); !d.Allowed {
+		t.Errorf("AllowBulk() = %+v on a nil Limiter", d)
+	}
+}
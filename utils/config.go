@@ -0,0 +1,33 @@
+package utils
+
+import (
+	"net/http"
+
+	"skillsync-api-gateway/config"
+	"skillsync-api-gateway/utils/password"
+)
+
+// cfg is the configuration utils read; it defaults to config.Default until Configure runs
+var cfg = config.Default()
+
+// Configure sets the configuration used by utils and rebuilds the allowlists derived from it
+func Configure(c *config.Config) {
+	cfg = c
+	jobStatuses = NewStatusValidator("job status", c.JobStatuses)
+	applicationStatuses = NewStatusValidator("application status", c.ApplicationStatuses)
+	passwordPolicy = newPasswordPolicy(c.Password)
+}
+
+func newPasswordPolicy(c config.PasswordPolicyConfig) password.Policy {
+	policy := password.Policy{
+		MinLength:     c.MinLength,
+		RequireUpper:  c.RequireUpper,
+		RequireLower:  c.RequireLower,
+		RequireDigit:  c.RequireDigit,
+		RequireSymbol: c.RequireSymbol,
+	}
+	if c.BreachCheck {
+		policy.Breaches = password.NewHIBPChecker(&http.Client{Timeout: breachCheckTimeout})
+	}
+	return policy
+}
@@ -0,0 +1,28 @@
+package utils
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// AuthCookieName holds the session JWT for browser clients
+	AuthCookieName = "auth_token"
+	// AuthCookieMaxAge matches the 24 hour token lifetime
+	AuthCookieMaxAge = 3600 * 24
+)
+
+// SetAuthCookie stores the session token as an HttpOnly cookie using the configured attributes
+func SetAuthCookie(c *gin.Context, token string) {
+	setCookie(c, AuthCookieName, token, AuthCookieMaxAge)
+}
+
+// ClearAuthCookie expires the session cookie with the same attributes it was set with
+func ClearAuthCookie(c *gin.Context) {
+	setCookie(c, AuthCookieName, "", -1)
+}
+
+func setCookie(c *gin.Context, name, value string, maxAge int) {
+	// Attributes come from COOKIE_SECURE, COOKIE_SAMESITE and COOKIE_DOMAIN
+	c.SetSameSite(cfg.Cookie.SameSite)
+	c.SetCookie(name, value, maxAge, "/", cfg.Cookie.Domain, cfg.Cookie.Secure, true)
+}
@@ -0,0 +1,59 @@
+package utils
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"skillsync-api-gateway/config"
+)
+
+func TestAuthCookie(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	tests := []struct {
+		name       string
+		cookie     config.CookieConfig
+		clear      bool
+		wantMaxAge int
+	}{
+		{"default", config.Default().Cookie, false, AuthCookieMaxAge},
+		{"lax on a domain", config.CookieConfig{Secure: true, SameSite: http.SameSiteLaxMode, Domain: "example.com"}, false, AuthCookieMaxAge},
+		{"none", config.CookieConfig{Secure: true, SameSite: http.SameSiteNoneMode}, false, AuthCookieMaxAge},
+		{"insecure for local development", config.CookieConfig{SameSite: http.SameSiteStrictMode}, false, AuthCookieMaxAge},
+		{"clear", config.CookieConfig{Secure: true, SameSite: http.SameSiteLaxMode, Domain: "example.com"}, true, -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			previous := cfg
+			c := config.Default()
+			c.Cookie = tt.cookie
+			cfg = c
+			defer func() { cfg = previous }()
+
+			w := httptest.NewRecorder()
+			ctx, _ := gin.CreateTestContext(w)
+			if tt.clear {
+				ClearAuthCookie(ctx)
+			} else {
+				SetAuthCookie(ctx, "token")
+			}
+			cookies := w.Result().Cookies()
+			if len(cookies) != 1 {
+				t.Fatalf("got %d cookies, want 1", len(cookies))
+			}
+			got := cookies[0]
+			if got.Name != AuthCookieName || got.Path != "/" || !got.HttpOnly {
+				t.Errorf("cookie = %+v, want an HttpOnly %s on /", got, AuthCookieName)
+			}
+			if got.MaxAge != tt.wantMaxAge {
+				t.Errorf("MaxAge = %d, want %d", got.MaxAge, tt.wantMaxAge)
+			}
+			if got.Secure != tt.cookie.Secure || got.SameSite != tt.cookie.SameSite || got.Domain != tt.cookie.Domain {
+				t.Errorf("Secure, SameSite, Domain = %v, %v, %q, want %v, %v, %q",
+					got.Secure, got.SameSite, got.Domain, tt.cookie.Secure, tt.cookie.SameSite, tt.cookie.Domain)
+			}
+		})
+	}
+}
@@ -0,0 +1,166 @@
+// Package fanout runs a handler's calls for many items with bounded concurrency.
+// Each Run starts at most its concurrency in goroutines, and a Limiter shared by
+// every request caps the calls in flight to one backend, so a burst of large
+// requests queues at the gateway instead of piling onto the service.
+package fanout
+
+import (
+	"context"
+	"expvar"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// metrics are published on the pprof server at /debug/vars: per backend, how often
+// a call had to wait for its limiter
+var metrics = expvar.NewMap("fanout")
+
+// Result is the outcome of fn for one item, at the item's index
+type Result[R any] struct {
+	Value R
+	Err   error
+}
+
+// Options tune RunWith
+type Options struct {
+	// ItemTimeout bounds each call; 0 leaves only ctx's deadline
+	ItemTimeout time.Duration
+	// Limiter, when set, is held for each call on top of the run's own concurrency
+	Limiter *Limiter
+}
+
+// Run calls fn for every item with at most concurrency calls at a time and returns
+// the results in the order of items. Items not yet started when ctx is done are
+// not called; their error is ctx's.
+func Run[T, R any](ctx context.Context, items []T, concurrency int, fn func(context.Context, T) (R, error)) []Result[R] {
+	return RunWith(ctx, items, concurrency, fn, Options{})
+}
+
+// RunWith is Run with a per-item timeout and a backend limiter
+func RunWith[T, R any](ctx context.Context, items []T, concurrency int, fn func(context.Context, T) (R, error), options Options) []Result[R] {
+	results := make([]Result[R], len(items))
+	if len(items) == 0 {
+		return results
+	}
+	concurrency = min(max(concurrency, 1), len(items))
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				results[i] = call(ctx, items[i], fn, options)
+			}
+		}()
+	}
+	for i := range items {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+	return results
+}
+
+// call runs fn for one item, turning a panic into the item's error so one bad item
+// can't take the gateway down from a goroutine the router doesn't recover
+func call[T, R any](ctx context.Context, item T, fn func(context.Context, T) (R, error), options Options) (result Result[R]) {
+	if err := ctx.Err(); err != nil {
+		result.Err = err
+		return result
+	}
+	if err := options.Limiter.Acquire(ctx); err != nil {
+		result.Err = err
+		return result
+	}
+	defer options.Limiter.Release()
+
+	if options.ItemTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, options.ItemTimeout)
+		defer cancel()
+	}
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			result = Result[R]{Err: fmt.Errorf("fanout: panic: %v", recovered)}
+		}
+	}()
+	result.Value, result.Err = fn(ctx, item)
+	return result
+}
+
+// Limiter caps the calls in flight across every Run sharing it. A nil Limiter
+// doesn't limit.
+type Limiter struct {
+	name  string
+	slots chan struct{}
+}
+
+// NewLimiter allows size calls in flight at once
+func NewLimiter(name string, size int) *Limiter {
+	return &Limiter{name: name, slots: make(chan struct{}, max(size, 1))}
+}
+
+// Acquire waits for a slot or for ctx to be done
+func (l *Limiter) Acquire(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+	select {
+	case l.slots <- struct{}{}:
+		return nil
+	default:
+	}
+	metrics.Add(l.name+"_waits", 1)
+	select {
+	case l.slots <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Release frees a slot taken by Acquire
+func (l *Limiter) Release() {
+	if l == nil {
+		return
+	}
+	<-l.slots
+}
+
+// InFlight is how many slots are taken
+func (l *Limiter) InFlight() int {
+	if l == nil {
+		return 0
+	}
+	return len(l.slots)
+}
+
+var (
+	backendsMutex sync.Mutex
+	backendSize   = 64
+	backends      = map[string]*Limiter{}
+)
+
+// Configure sizes the backend limiters (BACKEND_MAX_IN_FLIGHT). Limiters already
+// handed out keep their size; later calls to Backend get new ones.
+func Configure(maxInFlight int) {
+	backendsMutex.Lock()
+	defer backendsMutex.Unlock()
+	backendSize = maxInFlight
+	backends = map[string]*Limiter{}
+}
+
+// Backend is the limiter shared by every fan-out to the named backend service
+func Backend(name string) *Limiter {
+	backendsMutex.Lock()
+	defer backendsMutex.Unlock()
+	limiter, ok := backends[name]
+	if !ok {
+		limiter = NewLimiter(name, backendSize)
+		backends[name] = limiter
+	}
+	return limiter
+}
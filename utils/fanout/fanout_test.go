@@ -0,0 +1,253 @@
+package fanout
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// gauge tracks how many calls are in flight and the most there ever were
+type gauge struct {
+	current atomic.Int64
+	peak    atomic.Int64
+}
+
+func (g *gauge) enter() {
+	n := g.current.Add(1)
+	for {
+		peak := g.peak.Load()
+		if n <= peak || g.peak.CompareAndSwap(peak, n) {
+			return
+		}
+	}
+}
+
+func (g *gauge) leave() {
+	g.current.Add(-1)
+}
+
+func TestRunConcurrencyLimit(t *testing.T) {
+	tests := []struct {
+		name        string
+		items       int
+		concurrency int
+		runs        int
+		limiter     int
+		wantPeak    int64
+	}{
+		{"one run", 100, 8, 1, 0, 8},
+		{"more workers than items", 3, 8, 1, 0, 3},
+		{"zero concurrency is one", 10, 0, 1, 0, 1},
+		{"limiter shared by runs", 50, 8, 10, 5, 5},
+		{"limiter larger than the runs", 20, 2, 3, 64, 6},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var limiter *Limiter
+			if tt.limiter > 0 {
+				limiter = NewLimiter("test", tt.limiter)
+			}
+			var g gauge
+			fn := func(_ context.Context, item int) (int, error) {
+				g.enter()
+				defer g.leave()
+				time.Sleep(time.Millisecond)
+				return item, nil
+			}
+			items := make([]int, tt.items)
+			var wg sync.WaitGroup
+			for r := 0; r < tt.runs; r++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					for _, result := range RunWith(context.Background(), items, tt.concurrency, fn, Options{Limiter: limiter}) {
+						if result.Err != nil {
+							t.Errorf("unexpected error %v", result.Err)
+						}
+					}
+				}()
+			}
+			wg.Wait()
+			if peak := g.peak.Load(); peak != tt.wantPeak {
+				t.Errorf("peak concurrency = %d, want %d", peak, tt.wantPeak)
+			}
+			if limiter.InFlight() != 0 {
+				t.Errorf("limiter has %d slots still taken", limiter.InFlight())
+			}
+		})
+	}
+}
+
+func TestRunCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var called atomic.Int64
+	results := Run(ctx, []int{0, 1, 2, 3, 4, 5}, 1, func(ctx context.Context, item int) (int, error) {
+		called.Add(1)
+		if item == 1 {
+			cancel()
+			return 0, ctx.Err()
+		}
+		return item, nil
+	})
+	if n := called.Load(); n != 2 {
+		t.Errorf("fn called %d times, want 2: items after the cancel must not start", n)
+	}
+	for i, result := range results {
+		if wantErr := i >= 1; (result.Err != nil) != wantErr {
+			t.Errorf("results[%d] = %+v, want an error %v", i, result, wantErr)
+		}
+		if i >= 2 && !errors.Is(result.Err, context.Canceled) {
+			t.Errorf("results[%d].Err = %v, want context.Canceled", i, result.Err)
+		}
+	}
+}
+
+func TestRunWaitingOnLimiterCancelled(t *testing.T) {
+	limiter := NewLimiter("test", 1)
+	limiter.Acquire(context.Background())
+	defer limiter.Release()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	results := RunWith(ctx, []int{1, 2}, 2, func(context.Context, int) (int, error) {
+		t.Error("fn called without a limiter slot")
+		return 0, nil
+	}, Options{Limiter: limiter})
+	for i, result := range results {
+		if !errors.Is(result.Err, context.DeadlineExceeded) {
+			t.Errorf("results[%d].Err = %v, want context.DeadlineExceeded", i, result.Err)
+		}
+	}
+}
+
+func TestRunItemTimeout(t *testing.T) {
+	results := RunWith(context.Background(), []time.Duration{0, time.Second}, 2, func(ctx context.Context, d time.Duration) (string, error) {
+		select {
+		case <-time.After(d):
+			return "done", nil
+		case <-ctx.Done():
+			return "", ctx.Err()
+		}
+	}, Options{ItemTimeout: 20 * time.Millisecond})
+	if results[0].Err != nil || results[0].Value != "done" {
+		t.Errorf("results[0] = %+v, want done", results[0])
+	}
+	if !errors.Is(results[1].Err, context.DeadlineExceeded) {
+		t.Errorf("results[1].Err = %v, want context.DeadlineExceeded", results[1].Err)
+	}
+}
+
+func TestRunKeepsInputOrder(t *testing.T) {
+	tests := []struct {
+		name        string
+		items       int
+		concurrency int
+	}{
+		{"empty", 0, 4},
+		{"serial", 20, 1},
+		{"concurrent", 200, 16},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			items := make([]int, tt.items)
+			for i := range items {
+				items[i] = i
+			}
+			// Later items finish first
+			results := Run(context.Background(), items, tt.concurrency, func(_ context.Context, item int) (string, error) {
+				time.Sleep(time.Duration(tt.items-item) * 10 * time.Microsecond)
+				return fmt.Sprint("item ", item), nil
+			})
+			if len(results) != tt.items {
+				t.Fatalf("%d results, want %d", len(results), tt.items)
+			}
+			for i, result := range results {
+				if want := fmt.Sprint("item ", i); result.Value != want || result.Err != nil {
+					t.Errorf("results[%d] = %+v, want %q", i, result, want)
+				}
+			}
+		})
+	}
+}
+
+func TestRunRecoversPanics(t *testing.T) {
+	limiter := NewLimiter("test", 2)
+	results := RunWith(context.Background(), []string{"a", "boom", "c"}, 2, func(_ context.Context, item string) (string, error) {
+		if item == "boom" {
+			panic("bad item")
+		}
+		return strings.ToUpper(item), nil
+	}, Options{Limiter: limiter})
+	if results[0].Value != "A" || results[2].Value != "C" {
+		t.Errorf("results = %+v, want the other items served", results)
+	}
+	if results[1].Err == nil || !strings.Contains(results[1].Err.Error(), "fanout: panic: bad item") {
+		t.Errorf("results[1].Err = %v, want the recovered panic", results[1].Err)
+	}
+	if limiter.InFlight() != 0 {
+		t.Errorf("limiter slot leaked by the panic: %d in flight", limiter.InFlight())
+	}
+}
+
+// slowBackend answers in latency, and more slowly the more calls it is serving
+// past capacity, like a service with a fixed pool of database connections
+type slowBackend struct {
+	latency  time.Duration
+	capacity int64
+	gauge
+}
+
+func (b *slowBackend) call(ctx context.Context, item int) (int, error) {
+	b.enter()
+	defer b.leave()
+	delay := b.latency
+	if overload := b.current.Load() - b.capacity; overload > 0 {
+		delay += time.Duration(overload) * b.latency / time.Duration(b.capacity)
+	}
+	select {
+	case <-time.After(delay):
+		return item, nil
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	}
+}
+
+// BenchmarkFanout compares a bounded fan-out of 500 calls with starting a
+// goroutine per call, against a backend that slows down past 16 calls at once
+func BenchmarkFanout(b *testing.B) {
+	items := make([]int, 500)
+	run := map[string]func(ctx context.Context, backend *slowBackend) []Result[int]{
+		"bounded": func(ctx context.Context, backend *slowBackend) []Result[int] {
+			return RunWith(ctx, items, 16, backend.call, Options{})
+		},
+		"unbounded": func(ctx context.Context, backend *slowBackend) []Result[int] {
+			results := make([]Result[int], len(items))
+			var wg sync.WaitGroup
+			for i := range items {
+				wg.Add(1)
+				go func(i int) {
+					defer wg.Done()
+					results[i].Value, results[i].Err = backend.call(ctx, items[i])
+				}(i)
+			}
+			wg.Wait()
+			return results
+		},
+	}
+	for _, name := range []string{"bounded", "unbounded"} {
+		b.Run(name, func(b *testing.B) {
+			backend := &slowBackend{latency: time.Millisecond, capacity: 16}
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				run[name](context.Background(), backend)
+			}
+			b.ReportMetric(float64(backend.peak.Load()), "peak-in-flight")
+		})
+	}
+}
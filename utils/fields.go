@@ -0,0 +1,180 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// maxFieldDepth bounds how deep selectable paths go into nested messages
+const maxFieldDepth = 3
+
+// FieldSelector validates and applies ?fields= projections for one response shape.
+// The selectable paths are the JSON names of a prototype's fields, nested ones
+// dotted (e.g. "required_skills.name"), plus any computed fields the handler adds.
+type FieldSelector struct {
+	allowed map[string]bool
+	names   []string
+}
+
+// NewFieldSelector lists the fields of prototype (a struct or pointer to one, such
+// as a generated message) and computed, the fields added after marshaling
+func NewFieldSelector(prototype interface{}, computed ...string) *FieldSelector {
+	s := &FieldSelector{allowed: make(map[string]bool)}
+	s.collect(reflect.TypeOf(prototype), "", 0)
+	for _, name := range computed {
+		s.allowed[name] = true
+	}
+	for name := range s.allowed {
+		s.names = append(s.names, name)
+	}
+	sort.Strings(s.names)
+	return s
+}
+
+func (s *FieldSelector) collect(t reflect.Type, prefix string, depth int) {
+	for t.Kind() == reflect.Pointer || t.Kind() == reflect.Slice {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct || depth >= maxFieldDepth {
+		return
+	}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name := strings.SplitN(field.Tag.Get("json"), ",", 2)[0]
+		if !field.IsExported() || name == "" || name == "-" {
+			continue
+		}
+		s.allowed[prefix+name] = true
+		s.collect(field.Type, prefix+name+".", depth+1)
+	}
+}
+
+// Fields returns every selectable path, sorted
+func (s *FieldSelector) Fields() []string {
+	return s.names
+}
+
+// Parse splits a ?fields= value and rejects unknown names
+func (s *FieldSelector) Parse(query string) ([]string, error) {
+	var fields, unknown []string
+	for _, field := range strings.Split(query, ",") {
+		if field = strings.TrimSpace(field); field == "" {
+			continue
+		}
+		if !s.allowed[field] {
+			unknown = append(unknown, field)
+		}
+		fields = append(fields, field)
+	}
+	if len(unknown) > 0 {
+		return nil, fmt.Errorf("unknown fields: %s", strings.Join(unknown, ", "))
+	}
+	return fields, nil
+}
+
+// fieldTree is a set of selected paths, with children for dotted ones
+type fieldTree map[string]fieldTree
+
+func newFieldTree(fields []string) fieldTree {
+	tree := fieldTree{}
+	for _, field := range fields {
+		parts := strings.Split(field, ".")
+		node := tree
+		for i, part := range parts {
+			child, ok := node[part]
+			if ok && len(child) == 0 {
+				// The whole field is already selected
+				break
+			}
+			if i == len(parts)-1 {
+				node[part] = fieldTree{}
+				break
+			}
+			if !ok {
+				child = fieldTree{}
+				node[part] = child
+			}
+			node = child
+		}
+	}
+	return tree
+}
+
+// Project keeps only fields from decoded JSON (maps and slices from
+// encoding/json). Lists are projected element by element, so a path names a field
+// of each item; missing fields are skipped.
+func Project(value interface{}, fields []string) interface{} {
+	return newFieldTree(fields).project(value)
+}
+
+func (tree fieldTree) project(value interface{}) interface{} {
+	if len(tree) == 0 {
+		return value
+	}
+	switch v := value.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(tree))
+		for name, child := range tree {
+			if field, ok := v[name]; ok {
+				out[name] = child.project(field)
+			}
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		for i, item := range v {
+			out[i] = tree.project(item)
+		}
+		return out
+	default:
+		return value
+	}
+}
+
+// RespondWithFields writes body as JSON, projected by the request's ?fields= when
+// present. collection names the key whose value (an item or list of items) the
+// fields apply to, e.g. "jobs"; empty means the whole body. Unknown fields get a
+// 400 listing the available ones.
+func RespondWithFields(c *gin.Context, code int, body interface{}, collection string, selector *FieldSelector) {
+	query, ok := c.GetQuery("fields")
+	if !ok || strings.TrimSpace(query) == "" {
+		c.JSON(code, body)
+		return
+	}
+	fields, err := selector.Parse(query)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":            err.Error(),
+			"error_code":       "unknown_field",
+			"available_fields": selector.Fields(),
+		})
+		return
+	}
+
+	// Round trip through JSON so computed and typed values project the same way
+	data, err := json.Marshal(body)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to encode response"})
+		return
+	}
+	var decoded interface{}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to encode response"})
+		return
+	}
+
+	if object, ok := decoded.(map[string]interface{}); ok && collection != "" {
+		if items, ok := object[collection]; ok {
+			object[collection] = Project(items, fields)
+		}
+		c.JSON(code, object)
+		return
+	}
+	c.JSON(code, Project(decoded, fields))
+}
@@ -0,0 +1,134 @@
+package utils
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+type testSkill struct {
+	Name  string `json:"name"`
+	Level int    `json:"level,omitempty"`
+}
+
+type testJob struct {
+	ID       uint64       `json:"id"`
+	Title    string       `json:"title"`
+	Skills   []*testSkill `json:"required_skills"`
+	internal string
+	Ignored  string `json:"-"`
+	Untagged string
+}
+
+func TestFieldSelectorParse(t *testing.T) {
+	selector := NewFieldSelector(&testJob{}, "company_name")
+	if got, want := selector.Fields(), []string{"company_name", "id", "required_skills", "required_skills.level", "required_skills.name", "title"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("Fields() = %v, want %v", got, want)
+	}
+
+	tests := []struct {
+		name    string
+		query   string
+		want    []string
+		wantErr string
+	}{
+		{"plain", "id,title", []string{"id", "title"}, ""},
+		{"nested and computed", " required_skills.name , company_name ", []string{"required_skills.name", "company_name"}, ""},
+		{"empty entries", "id,,", []string{"id"}, ""},
+		{"unknown", "id,salary,required_skills.years", nil, "unknown fields: salary, required_skills.years"},
+		{"untagged fields aren't selectable", "Untagged", nil, "unknown fields: Untagged"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := selector.Parse(tt.query)
+			if tt.wantErr == "" && err != nil {
+				t.Fatalf("Parse(%q) error = %v", tt.query, err)
+			}
+			if tt.wantErr != "" && (err == nil || err.Error() != tt.wantErr) {
+				t.Fatalf("Parse(%q) error = %v, want %q", tt.query, err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Parse(%q) = %v, want %v", tt.query, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestProject(t *testing.T) {
+	decode := func(s string) interface{} {
+		var v interface{}
+		if err := json.Unmarshal([]byte(s), &v); err != nil {
+			t.Fatal(err)
+		}
+		return v
+	}
+	job := `{"id":1,"title":"SRE","required_skills":[{"name":"go","level":3},{"name":"k8s"}],"employer":{"id":"e1","name":"Acme"}}`
+	tests := []struct {
+		name   string
+		value  string
+		fields []string
+		want   string
+	}{
+		{"top level", job, []string{"id", "title"}, `{"id":1,"title":"SRE"}`},
+		{"nested in a list", job, []string{"required_skills.name"}, `{"required_skills":[{"name":"go"},{"name":"k8s"}]}`},
+		{"nested object", job, []string{"employer.name", "id"}, `{"employer":{"name":"Acme"},"id":1}`},
+		{"whole field wins over its path", job, []string{"employer", "employer.name"}, `{"employer":{"id":"e1","name":"Acme"}}`},
+		{"path then whole field", job, []string{"employer.name", "employer"}, `{"employer":{"id":"e1","name":"Acme"}}`},
+		{"missing skipped", job, []string{"id", "deadline"}, `{"id":1}`},
+		{"list of items", `[{"id":1,"title":"a"},{"id":2}]`, []string{"title"}, `[{"title":"a"},{}]`},
+		{"path into a scalar", job, []string{"title.length"}, `{"title":"SRE"}`},
+		{"no fields", job, nil, job},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Project(decode(tt.value), tt.fields)
+			if want := decode(tt.want); !reflect.DeepEqual(got, want) {
+				t.Errorf("Project() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestRespondWithFields(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	selector := NewFieldSelector(&testJob{}, "company_name")
+	body := gin.H{"jobs": []gin.H{{"id": 1, "title": "SRE", "company_name": "Acme"}}, "total": 1}
+
+	tests := []struct {
+		name       string
+		target     string
+		wantStatus int
+		wantBody   string
+	}{
+		{"no fields", "/jobs", http.StatusOK, `{"jobs":[{"company_name":"Acme","id":1,"title":"SRE"}],"total":1}`},
+		{"projected collection", "/jobs?fields=title,company_name", http.StatusOK, `{"jobs":[{"company_name":"Acme","title":"SRE"}],"total":1}`},
+		{"unknown", "/jobs?fields=salary", http.StatusBadRequest, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodGet, tt.target, nil)
+			RespondWithFields(c, http.StatusOK, body, "jobs", selector)
+			if w.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", w.Code, tt.wantStatus)
+			}
+			if tt.wantBody != "" && w.Body.String() != tt.wantBody {
+				t.Errorf("body = %s, want %s", w.Body, tt.wantBody)
+			}
+			if tt.wantStatus == http.StatusBadRequest {
+				var problem struct {
+					ErrorCode       string   `json:"error_code"`
+					AvailableFields []string `json:"available_fields"`
+				}
+				if err := json.Unmarshal(w.Body.Bytes(), &problem); err != nil || problem.ErrorCode != "unknown_field" || len(problem.AvailableFields) == 0 {
+					t.Errorf("body = %s, want unknown_field with the available fields", w.Body)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,148 @@
+// Package flags evaluates feature flags with percentage rollouts. A flag is on for
+// a stable subset of users: each user lands in a bucket from 0 to 99 per flag, and
+// the flag is on for buckets below its rollout.
+package flags
+
+import (
+	"fmt"
+	"hash/fnv"
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// evaluationsKey holds the request's flag results in the gin context
+const evaluationsKey = "feature_flags"
+
+var validName = regexp.MustCompile(`^[a-z0-9_.-]{1,64}$`)
+
+// Flag is one feature flag and its rollout
+type Flag struct {
+	Name string `json:"name"`
+	// Rollout is the percentage of users the flag is on for, 0 to 100
+	Rollout   int       `json:"rollout_percent"`
+	UpdatedBy string    `json:"updated_by,omitempty"`
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+}
+
+var (
+	mutex    sync.RWMutex
+	registry = map[string]*Flag{}
+)
+
+// Load replaces the flags with the configured rollouts (FEATURE_FLAGS)
+func Load(rollouts map[string]int) {
+	mutex.Lock()
+	defer mutex.Unlock()
+	registry = make(map[string]*Flag, len(rollouts))
+	for name, rollout := range rollouts {
+		registry[name] = &Flag{Name: name, Rollout: rollout, UpdatedBy: "env", UpdatedAt: time.Now()}
+	}
+}
+
+// Set creates or changes a flag at runtime and logs who did it
+func Set(name string, rollout int, updatedBy string) (Flag, error) {
+	if !validName.MatchString(name) {
+		return Flag{}, fmt.Errorf("invalid flag name %q; use lowercase letters, digits, '.', '_' or '-'", name)
+	}
+	if rollout < 0 || rollout > 100 {
+		return Flag{}, fmt.Errorf("rollout must be between 0 and 100, got %d", rollout)
+	}
+	mutex.Lock()
+	defer mutex.Unlock()
+	flag := &Flag{Name: name, Rollout: rollout, UpdatedBy: updatedBy, UpdatedAt: time.Now()}
+	registry[name] = flag
+	log.Printf("Feature flag %s set to %d%% by %s", name, rollout, updatedBy)
+	return *flag, nil
+}
+
+// List returns a snapshot of every flag, sorted by name
+func List() []Flag {
+	mutex.RLock()
+	defer mutex.RUnlock()
+	flags := make([]Flag, 0, len(registry))
+	for _, flag := range registry {
+		flags = append(flags, *flag)
+	}
+	sort.Slice(flags, func(i, j int) bool { return flags[i].Name < flags[j].Name })
+	return flags
+}
+
+// Bucket places key (a user ID) in 0-99 for flag name. It depends only on its
+// inputs, so a user keeps their bucket across requests, restarts and instances,
+// and buckets for different flags are independent.
+func Bucket(name, key string) int {
+	h := fnv.New32a()
+	h.Write([]byte(name))
+	h.Write([]byte{0})
+	h.Write([]byte(key))
+	return int(h.Sum32() % 100)
+}
+
+// Evaluate reports whether flag name is on for key. Unknown flags are off.
+func Evaluate(name, key string) bool {
+	mutex.RLock()
+	flag, ok := registry[name]
+	rollout := 0
+	if ok {
+		rollout = flag.Rollout
+	}
+	mutex.RUnlock()
+
+	switch {
+	case rollout <= 0:
+		return false
+	case rollout >= 100:
+		return true
+	default:
+		return Bucket(name, key) < rollout
+	}
+}
+
+// Enabled reports whether flag name is on for this request. Requests are keyed by
+// the authenticated user ID, or the client IP when there is none, so it should run
+// after the JWT middleware on authenticated routes. The result is remembered for
+// the rest of the request and added to the access log.
+func Enabled(c *gin.Context, name string) bool {
+	evaluations, _ := c.Get(evaluationsKey)
+	results, _ := evaluations.(map[string]bool)
+	if enabled, ok := results[name]; ok {
+		return enabled
+	}
+
+	key := c.GetString("user_id")
+	if key == "" {
+		key = c.ClientIP()
+	}
+	enabled := Evaluate(name, key)
+	if results == nil {
+		results = make(map[string]bool)
+		c.Set(evaluationsKey, results)
+	}
+	results[name] = enabled
+	return enabled
+}
+
+// Evaluated lists the flags evaluated for this request as "name:on,other:off"
+func Evaluated(c *gin.Context) string {
+	evaluations, _ := c.Get(evaluationsKey)
+	results, _ := evaluations.(map[string]bool)
+	if len(results) == 0 {
+		return ""
+	}
+	pairs := make([]string, 0, len(results))
+	for name, enabled := range results {
+		state := "off"
+		if enabled {
+			state = "on"
+		}
+		pairs = append(pairs, name+":"+state)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
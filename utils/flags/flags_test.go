@@ -0,0 +1,126 @@
+package flags
+
+import (
+	"fmt"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestBucketIsStable(t *testing.T) {
+	// Pinned values: a change here moves users in or out of every rollout
+	tests := []struct {
+		name string
+		key  string
+		want int
+	}{
+		{"new_error_envelope", "user-1", 98},
+		{"new_error_envelope", "user-2", 79},
+		{"aggregation", "user-1", 22},
+		{"aggregation", "192.0.2.1", 78},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name+"/"+tt.key, func(t *testing.T) {
+			for i := 0; i < 3; i++ {
+				if got := Bucket(tt.name, tt.key); got != tt.want {
+					t.Fatalf("Bucket(%q, %q) = %d, want %d", tt.name, tt.key, got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestBucketSpread(t *testing.T) {
+	const users = 10000
+	in := 0
+	for i := 0; i < users; i++ {
+		if Bucket("spread", fmt.Sprintf("user-%d", i)) < 25 {
+			in++
+		}
+	}
+	if in < users*22/100 || in > users*28/100 {
+		t.Errorf("%d of %d users in the bottom 25 buckets", in, users)
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	Load(map[string]int{"off": 0, "on": 100, "aggregation": 25})
+	defer Load(nil)
+	tests := []struct {
+		name string
+		flag string
+		key  string
+		want bool
+	}{
+		{"unknown flag", "missing", "user-1", false},
+		{"off", "off", "user-1", false},
+		{"on", "on", "user-1", true},
+		{"bucket below the rollout", "aggregation", "user-1", true},
+		{"bucket above the rollout", "aggregation", "192.0.2.1", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Evaluate(tt.flag, tt.key); got != tt.want {
+				t.Errorf("Evaluate(%q, %q) = %v, want %v", tt.flag, tt.key, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSet(t *testing.T) {
+	defer Load(nil)
+	tests := []struct {
+		name    string
+		flag    string
+		rollout int
+		wantErr bool
+	}{
+		{"valid", "new_error_envelope", 10, false},
+		{"dots and dashes", "jobs.v2-feed", 100, false},
+		{"upper case", "NewFeed", 10, true},
+		{"empty", "", 10, true},
+		{"negative", "feed", -1, true},
+		{"over 100", "feed", 101, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flag, err := Set(tt.flag, tt.rollout, "admin-1")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Set() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && (flag.Name != tt.flag || flag.Rollout != tt.rollout || flag.UpdatedBy != "admin-1") {
+				t.Errorf("Set() = %+v", flag)
+			}
+		})
+	}
+	if got := List(); len(got) != 2 || got[0].Name != "jobs.v2-feed" || got[1].Name != "new_error_envelope" {
+		t.Errorf("List() = %+v, want the two valid flags by name", got)
+	}
+}
+
+func TestEnabled(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	Load(map[string]int{"on": 100, "off": 0})
+	defer Load(nil)
+
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest("GET", "/", nil)
+	c.Set("user_id", "user-1")
+	if !Enabled(c, "on") || Enabled(c, "off") {
+		t.Fatal("Enabled() doesn't follow the rollouts")
+	}
+	// The first result holds for the rest of the request
+	Load(map[string]int{"on": 0})
+	if !Enabled(c, "on") {
+		t.Error("Enabled() changed within a request")
+	}
+	if got := Evaluated(c); got != "off:off,on:on" {
+		t.Errorf("Evaluated() = %q", got)
+	}
+
+	fresh, _ := gin.CreateTestContext(httptest.NewRecorder())
+	if got := Evaluated(fresh); got != "" {
+		t.Errorf("Evaluated() without evaluations = %q", got)
+	}
+}
@@ -0,0 +1,75 @@
+package utils
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// messageTooLarge matches gRPC's errors for messages over the configured limit,
+// capturing the message size and the limit
+var messageTooLarge = regexp.MustCompile(`message larger than max \((\d+) vs\. (\d+)\)`)
+
+// messageSizeLimit returns the limit a message went over, if err is a gRPC message
+// size error rather than a backend running out of something
+func messageSizeLimit(st *status.Status) (int64, bool) {
+	if st.Code() != codes.ResourceExhausted {
+		return 0, false
+	}
+	match := messageTooLarge.FindStringSubmatch(st.Message())
+	if match == nil {
+		return 0, false
+	}
+	limit, err := strconv.ParseInt(match[2], 10, 64)
+	return limit, err == nil
+}
+
+// HTTPStatusFromGRPC maps a gRPC error returned by a backend service to the closest HTTP status
+func HTTPStatusFromGRPC(err error) int {
+	st, ok := status.FromError(err)
+	if !ok {
+		return http.StatusBadGateway
+	}
+	switch st.Code() {
+	case codes.OK:
+		return http.StatusOK
+	case codes.InvalidArgument, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted, codes.FailedPrecondition:
+		return http.StatusConflict
+	case codes.ResourceExhausted:
+		if _, ok := messageSizeLimit(st); ok {
+			return http.StatusRequestEntityTooLarge
+		}
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	default:
+		return http.StatusBadGateway
+	}
+}
+
+// GRPCErrorMessage returns the backend's message without the gRPC "rpc error: code = ..." prefix
+func GRPCErrorMessage(err error) string {
+	if st, ok := status.FromError(err); ok {
+		if limit, ok := messageSizeLimit(st); ok {
+			return fmt.Sprintf("the request or response is larger than the %.1f MB allowed for backend messages", float64(limit)/(1<<20))
+		}
+		return st.Message()
+	}
+	return err.Error()
+}
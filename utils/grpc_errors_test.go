@@ -0,0 +1,37 @@
+package utils
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGRPCErrorMapping(t *testing.T) {
+	tests := []struct {
+		name        string
+		err         error
+		wantStatus  int
+		wantMessage string
+	}{
+		{"send limit", status.Error(codes.ResourceExhausted, "grpc: trying to send message larger than max (2097157 vs. 1048576)"), http.StatusRequestEntityTooLarge, "the request or response is larger than the 1.0 MB allowed for backend messages"},
+		{"receive limit", status.Error(codes.ResourceExhausted, "grpc: received message larger than max (20971520 vs. 16777216)"), http.StatusRequestEntityTooLarge, "the request or response is larger than the 16.0 MB allowed for backend messages"},
+		{"quota", status.Error(codes.ResourceExhausted, "too many requests"), http.StatusTooManyRequests, "too many requests"},
+		{"not found", status.Error(codes.NotFound, "job not found"), http.StatusNotFound, "job not found"},
+		{"deadline", status.Error(codes.DeadlineExceeded, "context deadline exceeded"), http.StatusGatewayTimeout, "context deadline exceeded"},
+		{"unavailable", status.Error(codes.Unavailable, "connection refused"), http.StatusServiceUnavailable, "connection refused"},
+		{"not a status", errors.New("boom"), http.StatusBadGateway, "boom"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := HTTPStatusFromGRPC(tt.err); got != tt.wantStatus {
+				t.Errorf("HTTPStatusFromGRPC = %d, want %d", got, tt.wantStatus)
+			}
+			if got := GRPCErrorMessage(tt.err); got != tt.wantMessage {
+				t.Errorf("GRPCErrorMessage = %q, want %q", got, tt.wantMessage)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+// Package hll estimates how many distinct keys were seen with a HyperLogLog sketch:
+// 1 KB per sketch and within a few percent, however many keys. Sketches from different
+// gateway instances or flushes merge by taking the larger of each register.
+package hll
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"math"
+	"math/bits"
+)
+
+const (
+	precision = 10
+	registers = 1 << precision
+)
+
+// ErrSize means serialized registers have the wrong length
+var ErrSize = errors.New("hll: sketch must be 1024 bytes")
+
+// Sketch is a HyperLogLog sketch; the zero value is empty and ready to use
+type Sketch struct {
+	registers [registers]uint8
+}
+
+// FromBytes restores a sketch serialized with Bytes. Empty input is an empty sketch.
+func FromBytes(b []byte) (*Sketch, error) {
+	s := &Sketch{}
+	if len(b) == 0 {
+		return s, nil
+	}
+	if len(b) != registers {
+		return nil, ErrSize
+	}
+	copy(s.registers[:], b)
+	return s, nil
+}
+
+// Bytes serializes the sketch's registers
+func (s *Sketch) Bytes() []byte {
+	return append([]byte(nil), s.registers[:]...)
+}
+
+// Add records key. The hash is stable across processes so sketches built on
+// different instances can be merged.
+func (s *Sketch) Add(key string) {
+	sum := sha256.Sum256([]byte(key))
+	hash := binary.BigEndian.Uint64(sum[:8])
+	index := hash >> (64 - precision)
+	rank := uint8(bits.LeadingZeros64(hash<<precision|1<<(precision-1)) + 1)
+	if rank > s.registers[index] {
+		s.registers[index] = rank
+	}
+}
+
+// Merge adds other's keys to s
+func (s *Sketch) Merge(other *Sketch) {
+	for i, r := range other.registers {
+		if r > s.registers[i] {
+			s.registers[i] = r
+		}
+	}
+}
+
+// Estimate is the approximate number of distinct keys added
+func (s *Sketch) Estimate() uint64 {
+	sum := 0.0
+	empty := 0
+	for _, r := range s.registers {
+		sum += 1 / float64(uint64(1)<<r)
+		if r == 0 {
+			empty++
+		}
+	}
+	m := float64(registers)
+	estimate := 0.7213 / (1 + 1.079/m) * m * m / sum
+	// Small cardinalities are counted more accurately from the empty registers
+	if estimate <= 2.5*m && empty > 0 {
+		estimate = m * math.Log(m/float64(empty))
+	}
+	return uint64(estimate + 0.5)
+}
@@ -0,0 +1,134 @@
+// Package i18n negotiates the response language and localizes the gateway's own
+// error messages. Messages are looked up by error_code in the catalogs embedded from
+// catalogs/<locale>.json; English messages stay as the handlers wrote them.
+package i18n
+
+import (
+	"context"
+	"embed"
+	"encoding/json"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+//go:embed catalogs/*.json
+var catalogFiles embed.FS
+
+// catalogs maps a locale to its error_code -> message catalog
+var catalogs = mustLoadCatalogs()
+
+func mustLoadCatalogs() map[string]map[string]string {
+	entries, err := catalogFiles.ReadDir("catalogs")
+	if err != nil {
+		panic(err)
+	}
+	loaded := make(map[string]map[string]string, len(entries))
+	for _, entry := range entries {
+		data, err := catalogFiles.ReadFile(path.Join("catalogs", entry.Name()))
+		if err != nil {
+			panic(err)
+		}
+		var messages map[string]string
+		if err := json.Unmarshal(data, &messages); err != nil {
+			panic("i18n: catalog " + entry.Name() + ": " + err.Error())
+		}
+		loaded[strings.TrimSuffix(entry.Name(), ".json")] = messages
+	}
+	return loaded
+}
+
+// HasCatalog reports whether locale has a message catalog
+func HasCatalog(locale string) bool {
+	_, ok := catalogs[locale]
+	return ok
+}
+
+// Message returns the message for code in locale, if the catalog has one
+func Message(locale, code string) (string, bool) {
+	message, ok := catalogs[locale][code]
+	return message, ok
+}
+
+// Localize replaces the "error" message of an {"error", "error_code"} envelope with
+// its translation in locale. It reports whether the message was replaced.
+func Localize(locale string, envelope map[string]interface{}) bool {
+	code, _ := envelope["error_code"].(string)
+	if _, ok := envelope["error"].(string); !ok || code == "" {
+		return false
+	}
+	message, ok := Message(locale, code)
+	if !ok {
+		return false
+	}
+	envelope["error"] = message
+	return true
+}
+
+type localeKey struct{}
+
+// WithLocale attaches the negotiated locale to ctx for the gRPC clients to forward
+func WithLocale(ctx context.Context, locale string) context.Context {
+	return context.WithValue(ctx, localeKey{}, locale)
+}
+
+// FromContext returns the locale attached to ctx, or "" if there is none
+func FromContext(ctx context.Context) string {
+	locale, _ := ctx.Value(localeKey{}).(string)
+	return locale
+}
+
+// languageRange is one entry of an Accept-Language header
+type languageRange struct {
+	tag     string
+	quality float64
+}
+
+// Negotiate picks the best of supported for an Accept-Language header, matching on
+// the primary language ("fr-CH" matches "fr"). Ranges are tried in order of their q
+// value, and ranges with q=0 are excluded. It returns "" when nothing matches.
+func Negotiate(acceptLanguage string, supported []string) string {
+	var ranges []languageRange
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" {
+			continue
+		}
+		quality := 1.0
+		for _, param := range strings.Split(params, ";") {
+			if q, ok := strings.CutPrefix(strings.TrimSpace(param), "q="); ok {
+				parsed, err := strconv.ParseFloat(q, 64)
+				if err != nil || parsed < 0 || parsed > 1 {
+					parsed = 0
+				}
+				quality = parsed
+			}
+		}
+		if quality > 0 {
+			ranges = append(ranges, languageRange{tag: tag, quality: quality})
+		}
+	}
+	// Equal q values keep the client's order
+	sort.SliceStable(ranges, func(i, j int) bool { return ranges[i].quality > ranges[j].quality })
+
+	for _, r := range ranges {
+		if locale := Match(r.tag, supported); locale != "" {
+			return locale
+		}
+	}
+	return ""
+}
+
+// Match returns the supported locale for a single language tag, or ""
+func Match(tag string, supported []string) string {
+	primary, _, _ := strings.Cut(strings.ToLower(strings.TrimSpace(tag)), "-")
+	primary, _, _ = strings.Cut(primary, "_")
+	for _, locale := range supported {
+		if primary == locale {
+			return locale
+		}
+	}
+	return ""
+}
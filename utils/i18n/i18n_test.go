@@ -0,0 +1,108 @@
+package i18n
+
+import (
+	"context"
+	"testing"
+)
+
+var supported = []string{"en", "hi", "ar", "fr"}
+
+func TestNegotiate(t *testing.T) {
+	tests := []struct {
+		header string
+		want   string
+	}{
+		{"fr", "fr"},
+		{"fr-CH, fr;q=0.9, en;q=0.8", "fr"},
+		{"de, ar;q=0.5", "ar"},
+		{"en;q=0.2, hi;q=0.7", "hi"},
+		{"ar, fr", "ar"},
+		{"fr;q=0, en", "en"},
+		{"hi;q=2, en;q=0.1", "en"},
+		{"hi;q=abc, ar;q=0.3", "ar"},
+		{"pt_BR", ""},
+		{"de, ja", ""},
+		{"", ""},
+		{"*", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.header, func(t *testing.T) {
+			if got := Negotiate(tt.header, supported); got != tt.want {
+				t.Errorf("Negotiate(%q) = %q, want %q", tt.header, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		tag  string
+		want string
+	}{
+		{"fr", "fr"},
+		{" FR-ca ", "fr"},
+		{"hi_IN", "hi"},
+		{"fra", ""},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.tag, func(t *testing.T) {
+			if got := Match(tt.tag, supported); got != tt.want {
+				t.Errorf("Match(%q) = %q, want %q", tt.tag, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLocalize(t *testing.T) {
+	french, _ := Message("fr", "captcha_failed")
+	tests := []struct {
+		name      string
+		locale    string
+		envelope  map[string]interface{}
+		want      bool
+		wantError interface{}
+	}{
+		{"translated", "fr", map[string]interface{}{"error": "CAPTCHA failed", "error_code": "captcha_failed"}, true, french},
+		{"unknown code", "fr", map[string]interface{}{"error": "Oops", "error_code": "no_such_code"}, false, "Oops"},
+		{"no code", "fr", map[string]interface{}{"error": "Oops"}, false, "Oops"},
+		{"no catalog", "en", map[string]interface{}{"error": "CAPTCHA failed", "error_code": "captcha_failed"}, false, "CAPTCHA failed"},
+		{"error isn't a message", "fr", map[string]interface{}{"error": 42, "error_code": "captcha_failed"}, false, 42},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Localize(tt.locale, tt.envelope); got != tt.want {
+				t.Errorf("Localize() = %v, want %v", got, tt.want)
+			}
+			if tt.envelope["error"] != tt.wantError {
+				t.Errorf("error = %v, want %v", tt.envelope["error"], tt.wantError)
+			}
+		})
+	}
+}
+
+func TestCatalogsCoverTheSameCodes(t *testing.T) {
+	reference := catalogs["fr"]
+	if len(reference) == 0 {
+		t.Fatal("fr catalog is empty")
+	}
+	for locale, messages := range catalogs {
+		for code := range reference {
+			if messages[code] == "" {
+				t.Errorf("%s catalog has no message for %s", locale, code)
+			}
+		}
+		if len(messages) != len(reference) {
+			t.Errorf("%s catalog has %d messages, fr has %d", locale, len(messages), len(reference))
+		}
+	}
+}
+
+func TestWithLocale(t *testing.T) {
+	if got := FromContext(context.Background()); got != "" {
+		t.Errorf("FromContext() without a locale = %q", got)
+	}
+	if got := FromContext(WithLocale(context.Background(), "ar")); got != "ar" {
+		t.Errorf("FromContext() = %q, want ar", got)
+	}
+}
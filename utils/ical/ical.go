@@ -0,0 +1,234 @@
+// Package ical writes iCalendar (RFC 5545) calendars of events, for calendar feeds
+// and invites. Events keep their UID across updates and carry an increasing
+// SEQUENCE, so calendar apps update an event instead of adding it twice.
+package ical
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// ProductID names the gateway as the calendar's producer
+const ProductID = "-//SkillSync//Interviews//EN"
+
+const (
+	utcLayout   = "20060102T150405Z"
+	localLayout = "20060102T150405"
+	// maxLineOctets is where lines are folded
+	maxLineOctets = 75
+)
+
+// Event is one calendar event
+type Event struct {
+	// UID identifies the event across updates
+	UID string
+	// Sequence must grow each time the event changes
+	Sequence int64
+	// Stamp is when the event was last changed
+	Stamp    time.Time
+	Start    time.Time
+	Duration time.Duration
+
+	Summary     string
+	Description string
+	Location    string
+	URL         string
+	Cancelled   bool
+}
+
+// Calendar is a set of events published as one calendar
+type Calendar struct {
+	// Name is shown by calendar apps subscribing to the feed
+	Name string
+	// Location is the timezone event times are written in. Nil writes them in
+	// UTC; any other location is described by a VTIMEZONE.
+	Location *time.Location
+	Events   []Event
+}
+
+// Bytes encodes the calendar, with CRLF line endings and long lines folded
+func (cal Calendar) Bytes() []byte {
+	w := &writer{}
+	w.line("BEGIN:VCALENDAR")
+	w.line("VERSION:2.0")
+	w.line("PRODID:" + ProductID)
+	w.line("CALSCALE:GREGORIAN")
+	w.line("METHOD:PUBLISH")
+	if cal.Name != "" {
+		w.line("X-WR-CALNAME:" + escape(cal.Name))
+	}
+
+	zoned := cal.Location != nil && cal.Location != time.UTC
+	if zoned {
+		w.line("X-WR-TIMEZONE:" + cal.Location.String())
+		if from, to, ok := cal.span(); ok {
+			writeTimezone(w, cal.Location, from, to)
+		}
+	}
+
+	for _, event := range cal.Events {
+		w.line("BEGIN:VEVENT")
+		w.line("UID:" + escape(event.UID))
+		w.line(fmt.Sprintf("SEQUENCE:%d", event.Sequence))
+		w.line("DTSTAMP:" + event.Stamp.UTC().Format(utcLayout))
+		end := event.Start.Add(event.Duration)
+		if zoned {
+			tzid := ";TZID=" + cal.Location.String() + ":"
+			w.line("DTSTART" + tzid + event.Start.In(cal.Location).Format(localLayout))
+			w.line("DTEND" + tzid + end.In(cal.Location).Format(localLayout))
+		} else {
+			w.line("DTSTART:" + event.Start.UTC().Format(utcLayout))
+			w.line("DTEND:" + end.UTC().Format(utcLayout))
+		}
+		w.line("SUMMARY:" + escape(event.Summary))
+		if event.Description != "" {
+			w.line("DESCRIPTION:" + escape(event.Description))
+		}
+		if event.Location != "" {
+			w.line("LOCATION:" + escape(event.Location))
+		}
+		if event.URL != "" {
+			w.line("URL:" + event.URL)
+		}
+		if event.Cancelled {
+			w.line("STATUS:CANCELLED")
+		} else {
+			w.line("STATUS:CONFIRMED")
+		}
+		w.line("END:VEVENT")
+	}
+	w.line("END:VCALENDAR")
+	return w.buf.Bytes()
+}
+
+// span returns the earliest start and latest end of the events
+func (cal Calendar) span() (from, to time.Time, ok bool) {
+	for i, event := range cal.Events {
+		end := event.Start.Add(event.Duration)
+		if i == 0 || event.Start.Before(from) {
+			from = event.Start
+		}
+		if i == 0 || end.After(to) {
+			to = end
+		}
+	}
+	return from, to, len(cal.Events) > 0
+}
+
+// transition is a change of a zone's offset at an instant
+type transition struct {
+	at         time.Time
+	fromOffset int
+	toOffset   int
+	name       string
+	dst        bool
+}
+
+// writeTimezone describes loc between from and to. The first observance holds
+// from 1970 until the zone's first transition in that span, and each transition
+// is listed with the exact local time it happens at, so no recurrence rules are
+// needed and past rule changes can't be misapplied.
+func writeTimezone(w *writer, loc *time.Location, from, to time.Time) {
+	name, offset := from.In(loc).Zone()
+	w.line("BEGIN:VTIMEZONE")
+	w.line("TZID:" + loc.String())
+	observance(w, from.In(loc).IsDST(), time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC), offset, offset, name)
+	for _, t := range transitions(loc, from, to) {
+		local := t.at.In(time.FixedZone("", t.fromOffset))
+		observance(w, t.dst, local, t.fromOffset, t.toOffset, t.name)
+	}
+	w.line("END:VTIMEZONE")
+}
+
+func observance(w *writer, dst bool, start time.Time, fromOffset, toOffset int, name string) {
+	kind := "STANDARD"
+	if dst {
+		kind = "DAYLIGHT"
+	}
+	w.line("BEGIN:" + kind)
+	w.line("DTSTART:" + start.Format(localLayout))
+	w.line("TZOFFSETFROM:" + formatOffset(fromOffset))
+	w.line("TZOFFSETTO:" + formatOffset(toOffset))
+	if name != "" {
+		w.line("TZNAME:" + escape(name))
+	}
+	w.line("END:" + kind)
+}
+
+// transitions finds loc's offset changes between from and to by stepping a day at
+// a time and narrowing each change down to the second
+func transitions(loc *time.Location, from, to time.Time) []transition {
+	var found []transition
+	_, offset := from.In(loc).Zone()
+	for day := from; day.Before(to); {
+		next := day.Add(24 * time.Hour)
+		if _, nextOffset := next.In(loc).Zone(); nextOffset != offset {
+			lo, hi := day, next
+			for hi.Sub(lo) > time.Second {
+				mid := lo.Add(hi.Sub(lo) / 2)
+				if _, midOffset := mid.In(loc).Zone(); midOffset == offset {
+					lo = mid
+				} else {
+					hi = mid
+				}
+			}
+			at := hi.Truncate(time.Second)
+			name, toOffset := at.In(loc).Zone()
+			found = append(found, transition{at: at, fromOffset: offset, toOffset: toOffset, name: name, dst: at.In(loc).IsDST()})
+			offset = toOffset
+		}
+		day = next
+	}
+	return found
+}
+
+// formatOffset writes an offset in seconds as +hhmm, or +hhmmss when it has seconds
+func formatOffset(seconds int) string {
+	sign := "+"
+	if seconds < 0 {
+		sign, seconds = "-", -seconds
+	}
+	formatted := fmt.Sprintf("%s%02d%02d", sign, seconds/3600, seconds/60%60)
+	if seconds%60 != 0 {
+		formatted += fmt.Sprintf("%02d", seconds%60)
+	}
+	return formatted
+}
+
+// escape escapes a TEXT value
+func escape(value string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+		"\r", `\n`,
+	).Replace(value)
+}
+
+type writer struct {
+	buf bytes.Buffer
+}
+
+// line writes a content line, folding it at 75 octets without splitting a
+// UTF-8 character
+func (w *writer) line(content string) {
+	limit := maxLineOctets
+	for len(content) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(content[cut]) {
+			cut--
+		}
+		w.buf.WriteString(content[:cut])
+		w.buf.WriteString("\r\n ")
+		content = content[cut:]
+		// Continuation lines start with the space, which counts toward the limit
+		limit = maxLineOctets - 1
+	}
+	w.buf.WriteString(content)
+	w.buf.WriteString("\r\n")
+}
@@ -0,0 +1,178 @@
+package ical
+
+import (
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	// The zoned calendar test needs Europe/Berlin wherever the tests run
+	_ "time/tzdata"
+)
+
+func TestCalendarBytesUTC(t *testing.T) {
+	cal := Calendar{
+		Name: "Interviews, SkillSync",
+		Events: []Event{{
+			UID:         "interview-7@skillsync",
+			Sequence:    2,
+			Stamp:       time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC),
+			Start:       time.Date(2024, 5, 2, 16, 30, 0, 0, time.FixedZone("CEST", 2*60*60)),
+			Duration:    45 * time.Minute,
+			Summary:     "Interview: Go developer",
+			Description: "Round 2; bring\nyour laptop",
+			Location:    "Video call",
+			URL:         "https://meet.example/abc",
+		}, {
+			UID:       "interview-8@skillsync",
+			Stamp:     time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC),
+			Start:     time.Date(2024, 5, 3, 10, 0, 0, 0, time.UTC),
+			Duration:  time.Hour,
+			Summary:   "Interview: SRE",
+			Cancelled: true,
+		}},
+	}
+	want := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//SkillSync//Interviews//EN",
+		"CALSCALE:GREGORIAN",
+		"METHOD:PUBLISH",
+		`X-WR-CALNAME:Interviews\, SkillSync`,
+		"BEGIN:VEVENT",
+		"UID:interview-7@skillsync",
+		"SEQUENCE:2",
+		"DTSTAMP:20240501T090000Z",
+		"DTSTART:20240502T143000Z",
+		"DTEND:20240502T151500Z",
+		"SUMMARY:Interview: Go developer",
+		`DESCRIPTION:Round 2\; bring\nyour laptop`,
+		"LOCATION:Video call",
+		"URL:https://meet.example/abc",
+		"STATUS:CONFIRMED",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:interview-8@skillsync",
+		"SEQUENCE:0",
+		"DTSTAMP:20240501T090000Z",
+		"DTSTART:20240503T100000Z",
+		"DTEND:20240503T110000Z",
+		"SUMMARY:Interview: SRE",
+		"STATUS:CANCELLED",
+		"END:VEVENT",
+		"END:VCALENDAR",
+		"",
+	}, "\r\n")
+	if got := string(cal.Bytes()); got != want {
+		t.Errorf("Bytes() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestCalendarBytesZoned(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cal := Calendar{Location: berlin, Events: []Event{
+		{UID: "a", Start: time.Date(2024, 3, 28, 10, 0, 0, 0, berlin), Duration: time.Hour, Summary: "Before"},
+		{UID: "b", Start: time.Date(2024, 4, 2, 10, 0, 0, 0, berlin), Duration: time.Hour, Summary: "After"},
+	}}
+	got := string(cal.Bytes())
+	for _, want := range []string{
+		"X-WR-TIMEZONE:Europe/Berlin\r\n",
+		"BEGIN:VTIMEZONE\r\nTZID:Europe/Berlin\r\n" +
+			"BEGIN:STANDARD\r\nDTSTART:19700101T000000\r\nTZOFFSETFROM:+0100\r\nTZOFFSETTO:+0100\r\nTZNAME:CET\r\nEND:STANDARD\r\n" +
+			"BEGIN:DAYLIGHT\r\nDTSTART:20240331T020000\r\nTZOFFSETFROM:+0100\r\nTZOFFSETTO:+0200\r\nTZNAME:CEST\r\nEND:DAYLIGHT\r\n" +
+			"END:VTIMEZONE\r\n",
+		"DTSTART;TZID=Europe/Berlin:20240328T100000\r\nDTEND;TZID=Europe/Berlin:20240328T110000\r\n",
+		"DTSTART;TZID=Europe/Berlin:20240402T100000\r\nDTEND;TZID=Europe/Berlin:20240402T110000\r\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("Bytes() is missing\n%s\nin\n%s", want, got)
+		}
+	}
+	if strings.Contains(got, "END:DAYLIGHT\r\nBEGIN:STANDARD") {
+		t.Errorf("Bytes() lists a transition outside the events' span:\n%s", got)
+	}
+}
+
+func TestEscape(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{"plain", "plain"},
+		{`a\b`, `a\\b`},
+		{"a;b,c", `a\;b\,c`},
+		{"a\r\nb\nc\rd", `a\nb\nc\nd`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			if got := escape(tt.value); got != tt.want {
+				t.Errorf("escape(%q) = %q, want %q", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatOffset(t *testing.T) {
+	tests := []struct {
+		seconds int
+		want    string
+	}{
+		{0, "+0000"},
+		{2 * 60 * 60, "+0200"},
+		{-(9*60*60 + 30*60), "-0930"},
+		{5*60*60 + 30*60, "+0530"},
+		{-(17*60 + 30), "-001730"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := formatOffset(tt.seconds); got != tt.want {
+				t.Errorf("formatOffset(%d) = %q, want %q", tt.seconds, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLineFolding(t *testing.T) {
+	tests := []struct {
+		name      string
+		content   string
+		wantLines int
+	}{
+		{"short", "SUMMARY:Interview", 1},
+		{"exactly 75", strings.Repeat("a", 75), 1},
+		{"76", strings.Repeat("a", 76), 2},
+		{"continuations hold 74", strings.Repeat("a", 75+74+1), 3},
+		{"multibyte not split", "DESCRIPTION:" + strings.Repeat("é", 80), 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &writer{}
+			w.line(tt.content)
+			folded := w.buf.String()
+			if !strings.HasSuffix(folded, "\r\n") {
+				t.Fatalf("line %q doesn't end in CRLF", folded)
+			}
+			lines := strings.Split(strings.TrimSuffix(folded, "\r\n"), "\r\n")
+			if len(lines) != tt.wantLines {
+				t.Errorf("%d lines, want %d", len(lines), tt.wantLines)
+			}
+			for i, line := range lines {
+				if len(line) > maxLineOctets {
+					t.Errorf("line %d has %d octets", i, len(line))
+				}
+				if !utf8.ValidString(line) {
+					t.Errorf("line %d splits a character: %q", i, line)
+				}
+				if i > 0 && !strings.HasPrefix(line, " ") {
+					t.Errorf("continuation line %d doesn't start with a space", i)
+				}
+			}
+			if unfolded := strings.ReplaceAll(strings.TrimSuffix(folded, "\r\n"), "\r\n ", ""); unfolded != tt.content {
+				t.Errorf("unfolded = %q, want %q", unfolded, tt.content)
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"image"
+	_ "image/jpeg" // register JPEG decoder for DecodeConfig
+	_ "image/png"  // register PNG decoder for DecodeConfig
+	"net/http"
+)
+
+// ErrUnsupportedImage is returned for anything other than PNG, JPEG or WebP
+var ErrUnsupportedImage = errors.New("unsupported image format")
+
+// ImageInfo describes an uploaded image without decoding its pixels
+type ImageInfo struct {
+	ContentType string
+	Width       int
+	Height      int
+}
+
+// InspectImage sniffs the content type and reads the dimensions from the image header
+func InspectImage(data []byte) (*ImageInfo, error) {
+	contentType := http.DetectContentType(data)
+	switch contentType {
+	case "image/png", "image/jpeg":
+		cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		return &ImageInfo{ContentType: contentType, Width: cfg.Width, Height: cfg.Height}, nil
+	case "image/webp":
+		width, height, err := webpDimensions(data)
+		if err != nil {
+			return nil, err
+		}
+		return &ImageInfo{ContentType: contentType, Width: width, Height: height}, nil
+	default:
+		return nil, ErrUnsupportedImage
+	}
+}
+
+// webpDimensions reads the canvas size from the first chunk of a RIFF/WEBP file
+func webpDimensions(data []byte) (int, int, error) {
+	if len(data) < 30 {
+		return 0, 0, errors.New("webp header too short")
+	}
+	chunk := data[12:16]
+	payload := data[20:]
+	switch string(chunk) {
+	case "VP8 ":
+		// Lossy: 3 byte frame tag, 3 byte start code, then 14 bit width/height
+		if payload[3] != 0x9d || payload[4] != 0x01 || payload[5] != 0x2a {
+			return 0, 0, errors.New("invalid VP8 start code")
+		}
+		width := int(binary.LittleEndian.Uint16(payload[6:8]) & 0x3fff)
+		height := int(binary.LittleEndian.Uint16(payload[8:10]) & 0x3fff)
+		return width, height, nil
+	case "VP8L":
+		// Lossless: signature byte, then 14 bit width-1 and height-1
+		if payload[0] != 0x2f {
+			return 0, 0, errors.New("invalid VP8L signature")
+		}
+		bits := binary.LittleEndian.Uint32(payload[1:5])
+		return int(bits&0x3fff) + 1, int((bits>>14)&0x3fff) + 1, nil
+	case "VP8X":
+		// Extended: 24 bit canvas width-1 and height-1 after 4 bytes of flags
+		width := int(payload[4]) | int(payload[5])<<8 | int(payload[6])<<16
+		height := int(payload[7]) | int(payload[8])<<8 | int(payload[9])<<16
+		return width + 1, height + 1, nil
+	default:
+		return 0, 0, errors.New("unknown webp chunk")
+	}
+}
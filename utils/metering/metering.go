@@ -0,0 +1,293 @@
+// Package metering counts requests per principal and route class against a quota.
+// Counting is in memory with atomics so the request path stays cheap; a background
+// loop flushes the counts to a Store. Quotas are enforced per gateway instance.
+package metering
+
+import (
+	"context"
+	"log"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Route classes
+const (
+	Reads  = "reads"
+	Writes = "writes"
+)
+
+// Count is the requests a principal made in one class during one quota window
+type Count struct {
+	Principal   string    `json:"principal"`
+	Class       string    `json:"class"`
+	WindowStart time.Time `json:"window_start"`
+	Requests    int64     `json:"requests"`
+}
+
+// Store keeps flushed counts. Record is given the requests made since the last
+// flush, which it adds to what it has for the same principal, class and window.
+type Store interface {
+	Record(ctx context.Context, counts []Count) error
+	// Usage returns a principal's stored counts, newest window first
+	Usage(ctx context.Context, principal string) ([]Count, error)
+}
+
+// Options configure a Meter
+type Options struct {
+	// Window is how long a quota lasts before it resets
+	Window time.Duration
+	// Quotas is the requests allowed per window for each class; 0 is unlimited
+	Quotas map[string]int64
+	// FlushInterval is how often counts are written to the store
+	FlushInterval time.Duration
+}
+
+type counterKey struct {
+	principal string
+	class     string
+	window    int64
+}
+
+type counter struct {
+	count   atomic.Int64
+	flushed atomic.Int64
+}
+
+// Meter counts requests and checks them against the quotas
+type Meter struct {
+	options  Options
+	store    Store
+	counters sync.Map // counterKey -> *counter
+
+	startOnce sync.Once
+	stopOnce  sync.Once
+	stop      chan struct{}
+	loop      sync.WaitGroup
+}
+
+// New returns a Meter that flushes to store once started
+func New(store Store, options Options) *Meter {
+	return &Meter{options: options, store: store, stop: make(chan struct{})}
+}
+
+// Decision is the outcome of counting one request
+type Decision struct {
+	Allowed bool
+	// Limit is the class's quota, 0 when unlimited
+	Limit     int64
+	Remaining int64
+	Reset     time.Time
+}
+
+// window returns the index of the window now falls in and when it ends
+func (m *Meter) window(now time.Time) (int64, time.Time) {
+	size := int64(m.options.Window)
+	index := now.UnixNano() / size
+	return index, time.Unix(0, (index+1)*size)
+}
+
+func (m *Meter) counter(key counterKey) *counter {
+	if existing, ok := m.counters.Load(key); ok {
+		return existing.(*counter)
+	}
+	created, _ := m.counters.LoadOrStore(key, &counter{})
+	return created.(*counter)
+}
+
+// Allow counts a request by principal in class. A request over the quota is not
+// counted and reported as not allowed.
+func (m *Meter) Allow(principal, class string) Decision {
+	index, reset := m.window(time.Now())
+	c := m.counter(counterKey{principal: principal, class: class, window: index})
+	limit := m.options.Quotas[class]
+	count := c.count.Add(1)
+	if limit > 0 && count > limit {
+		c.count.Add(-1)
+		return Decision{Allowed: false, Limit: limit, Remaining: 0, Reset: reset}
+	}
+	remaining := int64(0)
+	if limit > 0 {
+		remaining = limit - count
+	}
+	return Decision{Allowed: true, Limit: limit, Remaining: remaining, Reset: reset}
+}
+
+// Usage is a principal's requests in the current window per class
+type Usage struct {
+	Class     string    `json:"class"`
+	Used      int64     `json:"used"`
+	Limit     int64     `json:"limit"`
+	Remaining *int64    `json:"remaining"`
+	Reset     time.Time `json:"reset_at"`
+}
+
+// Current reports principal's usage in the current window, for every class
+func (m *Meter) Current(principal string) []Usage {
+	index, reset := m.window(time.Now())
+	usage := make([]Usage, 0, 2)
+	for _, class := range []string{Reads, Writes} {
+		var used int64
+		if existing, ok := m.counters.Load(counterKey{principal: principal, class: class, window: index}); ok {
+			used = existing.(*counter).count.Load()
+		}
+		entry := Usage{Class: class, Used: used, Limit: m.options.Quotas[class], Reset: reset}
+		if entry.Limit > 0 {
+			remaining := max(entry.Limit-used, 0)
+			entry.Remaining = &remaining
+		}
+		usage = append(usage, entry)
+	}
+	return usage
+}
+
+// History returns principal's flushed counts from the store
+func (m *Meter) History(ctx context.Context, principal string) ([]Count, error) {
+	return m.store.Usage(ctx, principal)
+}
+
+// Start flushes to the store every FlushInterval until Stop. Calling it again does nothing.
+func (m *Meter) Start() {
+	m.startOnce.Do(func() {
+		m.loop.Add(1)
+		go func() {
+			defer m.loop.Done()
+			ticker := time.NewTicker(m.options.FlushInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ticker.C:
+				case <-m.stop:
+					return
+				}
+				ctx, cancel := context.WithTimeout(context.Background(), m.options.FlushInterval)
+				if err := m.Flush(ctx); err != nil {
+					log.Printf("Usage flush failed, retrying with the next one: %v", err)
+				}
+				cancel()
+			}
+		}()
+	})
+}
+
+// Stop ends the flush loop and writes the counts not yet flushed
+func (m *Meter) Stop(ctx context.Context) error {
+	m.stopOnce.Do(func() { close(m.stop) })
+	m.loop.Wait()
+	return m.Flush(ctx)
+}
+
+// Flush writes the requests counted since the last flush to the store. Counters
+// of windows that ended are dropped once written.
+func (m *Meter) Flush(ctx context.Context) error {
+	current, _ := m.window(time.Now())
+	type pending struct {
+		key   counterKey
+		c     *counter
+		delta int64
+	}
+	var batch []pending
+	m.counters.Range(func(k, v interface{}) bool {
+		key, c := k.(counterKey), v.(*counter)
+		if delta := c.count.Load() - c.flushed.Load(); delta > 0 {
+			batch = append(batch, pending{key: key, c: c, delta: delta})
+		} else if key.window < current {
+			m.counters.Delete(key)
+		}
+		return true
+	})
+	if len(batch) == 0 {
+		return nil
+	}
+
+	counts := make([]Count, 0, len(batch))
+	for _, p := range batch {
+		counts = append(counts, Count{
+			Principal:   p.key.principal,
+			Class:       p.key.class,
+			WindowStart: time.Unix(0, p.key.window*int64(m.options.Window)),
+			Requests:    p.delta,
+		})
+	}
+	if err := m.store.Record(ctx, counts); err != nil {
+		return err
+	}
+	for _, p := range batch {
+		p.c.flushed.Add(p.delta)
+	}
+	return nil
+}
+
+// MemoryStore keeps counts in memory for the last windows it was given
+type MemoryStore struct {
+	mutex  sync.Mutex
+	keep   int
+	counts map[string]map[counterKey]int64
+}
+
+// NewMemoryStore keeps up to keep windows per principal
+func NewMemoryStore(keep int) *MemoryStore {
+	return &MemoryStore{keep: keep, counts: make(map[string]map[counterKey]int64)}
+}
+
+// Record implements Store
+func (s *MemoryStore) Record(_ context.Context, counts []Count) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	for _, count := range counts {
+		principal := s.counts[count.Principal]
+		if principal == nil {
+			principal = make(map[counterKey]int64)
+			s.counts[count.Principal] = principal
+		}
+		key := counterKey{class: count.Class, window: count.WindowStart.UnixNano()}
+		principal[key] += count.Requests
+		s.prune(principal)
+	}
+	return nil
+}
+
+// prune drops a principal's oldest windows beyond keep
+func (s *MemoryStore) prune(principal map[counterKey]int64) {
+	windows := make(map[int64]bool)
+	for key := range principal {
+		windows[key.window] = true
+	}
+	if len(windows) <= s.keep {
+		return
+	}
+	starts := make([]int64, 0, len(windows))
+	for start := range windows {
+		starts = append(starts, start)
+	}
+	sort.Slice(starts, func(i, j int) bool { return starts[i] > starts[j] })
+	oldest := starts[s.keep-1]
+	for key := range principal {
+		if key.window < oldest {
+			delete(principal, key)
+		}
+	}
+}
+
+// Usage implements Store
+func (s *MemoryStore) Usage(_ context.Context, principal string) ([]Count, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	counts := make([]Count, 0, len(s.counts[principal]))
+	for key, requests := range s.counts[principal] {
+		counts = append(counts, Count{
+			Principal:   principal,
+			Class:       key.class,
+			WindowStart: time.Unix(0, key.window),
+			Requests:    requests,
+		})
+	}
+	sort.Slice(counts, func(i, j int) bool {
+		if !counts[i].WindowStart.Equal(counts[j].WindowStart) {
+			return counts[i].WindowStart.After(counts[j].WindowStart)
+		}
+		return counts[i].Class < counts[j].Class
+	})
+	return counts, nil
+}
@@ -0,0 +1,241 @@
+package metering
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestAllow(t *testing.T) {
+	type call struct {
+		principal     string
+		class         string
+		wantAllowed   bool
+		wantRemaining int64
+	}
+	tests := []struct {
+		name   string
+		quotas map[string]int64
+		calls  []call
+	}{
+		{
+			"counts down to the quota",
+			map[string]int64{Reads: 2},
+			[]call{{"u1", Reads, true, 1}, {"u1", Reads, true, 0}, {"u1", Reads, false, 0}, {"u1", Reads, false, 0}},
+		},
+		{
+			"classes counted apart",
+			map[string]int64{Reads: 1, Writes: 1},
+			[]call{{"u1", Reads, true, 0}, {"u1", Writes, true, 0}, {"u1", Reads, false, 0}},
+		},
+		{
+			"principals counted apart",
+			map[string]int64{Writes: 1},
+			[]call{{"u1", Writes, true, 0}, {"u2", Writes, true, 0}, {"u1", Writes, false, 0}},
+		},
+		{
+			"no quota is unlimited",
+			map[string]int64{Writes: 1},
+			[]call{{"u1", Reads, true, 0}, {"u1", Reads, true, 0}, {"u1", Reads, true, 0}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := New(NewMemoryStore(2), Options{Window: time.Hour, Quotas: tt.quotas, FlushInterval: time.Minute})
+			for i, c := range tt.calls {
+				decision := m.Allow(c.principal, c.class)
+				if decision.Allowed != c.wantAllowed || decision.Remaining != c.wantRemaining {
+					t.Errorf("call %d: Allow(%s, %s) = %+v, want allowed %v with %d remaining", i, c.principal, c.class, decision, c.wantAllowed, c.wantRemaining)
+				}
+				if decision.Limit != tt.quotas[c.class] || !decision.Reset.After(time.Now()) {
+					t.Errorf("call %d: limit %d resetting %s, want %d in the future", i, decision.Limit, decision.Reset, tt.quotas[c.class])
+				}
+			}
+		})
+	}
+}
+
+func TestAllowConcurrent(t *testing.T) {
+	m := New(NewMemoryStore(2), Options{Window: time.Hour, Quotas: map[string]int64{Writes: 100}, FlushInterval: time.Minute})
+	var allowed atomic.Int64
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 10; j++ {
+				if m.Allow("u1", Writes).Allowed {
+					allowed.Add(1)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	if n := allowed.Load(); n != 100 {
+		t.Errorf("%d requests allowed, want the quota of 100", n)
+	}
+	if used := m.Current("u1")[1].Used; used != 100 {
+		t.Errorf("Current() used = %d, want 100: refused requests must not count", used)
+	}
+}
+
+func TestCurrent(t *testing.T) {
+	m := New(NewMemoryStore(2), Options{Window: time.Hour, Quotas: map[string]int64{Writes: 5}, FlushInterval: time.Minute})
+	m.Allow("u1", Reads)
+	m.Allow("u1", Writes)
+	m.Allow("u1", Writes)
+
+	usage := m.Current("u1")
+	if len(usage) != 2 || usage[0].Class != Reads || usage[1].Class != Writes {
+		t.Fatalf("Current() = %+v, want reads then writes", usage)
+	}
+	if usage[0].Used != 1 || usage[0].Remaining != nil {
+		t.Errorf("reads = %+v, want 1 used and no remaining without a quota", usage[0])
+	}
+	if usage[1].Used != 2 || usage[1].Remaining == nil || *usage[1].Remaining != 3 {
+		t.Errorf("writes = %+v, want 2 used and 3 remaining", usage[1])
+	}
+	if other := m.Current("u2"); other[1].Used != 0 || *other[1].Remaining != 5 {
+		t.Errorf("unseen principal = %+v, want nothing used", other)
+	}
+}
+
+// failingStore fails Record while fail is set
+type failingStore struct {
+	*MemoryStore
+	fail bool
+}
+
+func (s *failingStore) Record(ctx context.Context, counts []Count) error {
+	if s.fail {
+		return errors.New("store down")
+	}
+	return s.MemoryStore.Record(ctx, counts)
+}
+
+func TestFlush(t *testing.T) {
+	store := &failingStore{MemoryStore: NewMemoryStore(2)}
+	m := New(store, Options{Window: time.Hour, FlushInterval: time.Minute})
+	requests := func() int64 {
+		t.Helper()
+		counts, err := m.History(context.Background(), "u1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		var total int64
+		for _, count := range counts {
+			total += count.Requests
+		}
+		return total
+	}
+
+	m.Allow("u1", Reads)
+	m.Allow("u1", Reads)
+	if err := m.Flush(context.Background()); err != nil || requests() != 2 {
+		t.Fatalf("after the first flush: %d requests stored (%v), want 2", requests(), err)
+	}
+	if err := m.Flush(context.Background()); err != nil || requests() != 2 {
+		t.Errorf("flushing again stored %d requests, want the same 2", requests())
+	}
+
+	m.Allow("u1", Reads)
+	store.fail = true
+	if err := m.Flush(context.Background()); err == nil {
+		t.Error("Flush() succeeded with the store down")
+	}
+	store.fail = false
+	if err := m.Flush(context.Background()); err != nil || requests() != 3 {
+		t.Errorf("after a failed flush: %d requests stored (%v), want the retry to add 1", requests(), err)
+	}
+}
+
+func TestFlushDropsEndedWindows(t *testing.T) {
+	m := New(NewMemoryStore(2), Options{Window: time.Hour, FlushInterval: time.Minute})
+	current, _ := m.window(time.Now())
+	m.counter(counterKey{principal: "u1", class: Reads, window: current - 1}).count.Add(1)
+	m.Allow("u1", Reads)
+
+	// The first flush writes the ended window, the second drops it
+	for i := 0; i < 2; i++ {
+		if err := m.Flush(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	var windows []int64
+	m.counters.Range(func(k, _ interface{}) bool {
+		windows = append(windows, k.(counterKey).window)
+		return true
+	})
+	if len(windows) != 1 || windows[0] != current {
+		t.Errorf("counters kept for windows %v, want only the current one %d", windows, current)
+	}
+}
+
+func TestStartStop(t *testing.T) {
+	m := New(NewMemoryStore(2), Options{Window: time.Hour, FlushInterval: 10 * time.Millisecond})
+	m.Start()
+	m.Start()
+	m.Allow("u1", Writes)
+
+	deadline := time.Now().Add(time.Second)
+	for {
+		counts, _ := m.History(context.Background(), "u1")
+		if len(counts) == 1 && counts[0].Requests == 1 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("History() = %+v, want the request flushed by the loop", counts)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	m.Allow("u1", Writes)
+	if err := m.Stop(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Stop(context.Background()); err != nil {
+		t.Errorf("second Stop() = %v", err)
+	}
+	if counts, _ := m.History(context.Background(), "u1"); len(counts) != 1 || counts[0].Requests != 2 {
+		t.Errorf("History() after Stop = %+v, want the last request flushed", counts)
+	}
+}
+
+func TestMemoryStore(t *testing.T) {
+	store := NewMemoryStore(2)
+	hour := func(h int) time.Time { return time.Date(2024, 1, 1, h, 0, 0, 0, time.UTC) }
+	store.Record(context.Background(), []Count{
+		{Principal: "u1", Class: Reads, WindowStart: hour(1), Requests: 1},
+		{Principal: "u1", Class: Reads, WindowStart: hour(2), Requests: 2},
+		{Principal: "u1", Class: Writes, WindowStart: hour(2), Requests: 3},
+		{Principal: "u2", Class: Reads, WindowStart: hour(1), Requests: 9},
+	})
+	store.Record(context.Background(), []Count{
+		{Principal: "u1", Class: Reads, WindowStart: hour(2), Requests: 5},
+		{Principal: "u1", Class: Reads, WindowStart: hour(3), Requests: 4},
+	})
+
+	counts, err := store.Usage(context.Background(), "u1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Count{
+		{Principal: "u1", Class: Reads, WindowStart: hour(3), Requests: 4},
+		{Principal: "u1", Class: Reads, WindowStart: hour(2), Requests: 7},
+		{Principal: "u1", Class: Writes, WindowStart: hour(2), Requests: 3},
+	}
+	if len(counts) != len(want) {
+		t.Fatalf("Usage() = %+v, want %+v", counts, want)
+	}
+	for i := range want {
+		if counts[i].Class != want[i].Class || !counts[i].WindowStart.Equal(want[i].WindowStart) || counts[i].Requests != want[i].Requests {
+			t.Errorf("Usage()[%d] = %+v, want %+v", i, counts[i], want[i])
+		}
+	}
+	if other, _ := store.Usage(context.Background(), "u2"); len(other) != 1 || other[0].Requests != 9 {
+		t.Errorf("Usage(u2) = %+v, want its own window kept", other)
+	}
+}
@@ -0,0 +1,296 @@
+// Package notifier sends gateway-initiated notifications off the request path.
+// Handlers Enqueue an event and return; workers send it with retries, and events
+// that still fail go to the dead-letter log. Shutdown drains the queue.
+package notifier
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"expvar"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+)
+
+// Event is one notification to a user
+type Event struct {
+	ID         string    `json:"id"`
+	UserID     string    `json:"user_id"`
+	Type       string    `json:"type"`
+	Title      string    `json:"title"`
+	Message    string    `json:"message"`
+	SourceID   string    `json:"source_id"`
+	EnqueuedAt time.Time `json:"enqueued_at"`
+	// ExpiresAt, when set, is when the notification stops being shown
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// Attachments are sent along when the notification is emailed
+	Attachments []Attachment `json:"attachments,omitempty"`
+}
+
+// Attachment is a file attached to a notification's email
+type Attachment struct {
+	Filename    string `json:"filename"`
+	ContentType string `json:"content_type"`
+	Content     []byte `json:"content"`
+}
+
+// Sender delivers one event, e.g. through the notification service client
+type Sender func(ctx context.Context, event *Event) error
+
+var (
+	ErrQueueFull   = errors.New("notification queue full")
+	ErrQueueClosed = errors.New("notification queue closed")
+)
+
+// Queue holds events waiting to be sent. MemoryQueue is the default; a shared
+// queue such as Redis can implement it so events survive a restart.
+type Queue interface {
+	// Push adds an event without blocking, or returns ErrQueueFull or ErrQueueClosed
+	Push(ctx context.Context, event *Event) error
+	// Pop waits for the next event. Once the queue is closed and empty it returns ErrQueueClosed.
+	Pop(ctx context.Context) (*Event, error)
+	Len() int
+	// Close stops new pushes; queued events can still be popped
+	Close()
+}
+
+// MemoryQueue is a bounded in-process queue
+type MemoryQueue struct {
+	mutex  sync.RWMutex
+	closed bool
+	events chan *Event
+}
+
+func NewMemoryQueue(size int) *MemoryQueue {
+	return &MemoryQueue{events: make(chan *Event, size)}
+}
+
+func (q *MemoryQueue) Push(_ context.Context, event *Event) error {
+	q.mutex.RLock()
+	defer q.mutex.RUnlock()
+	if q.closed {
+		return ErrQueueClosed
+	}
+	select {
+	case q.events <- event:
+		return nil
+	default:
+		return ErrQueueFull
+	}
+}
+
+func (q *MemoryQueue) Pop(ctx context.Context) (*Event, error) {
+	select {
+	case event, ok := <-q.events:
+		if !ok {
+			return nil, ErrQueueClosed
+		}
+		return event, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (q *MemoryQueue) Len() int {
+	return len(q.events)
+}
+
+func (q *MemoryQueue) Close() {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	if !q.closed {
+		q.closed = true
+		close(q.events)
+	}
+}
+
+// permanentError marks a failure that retrying can't fix
+type permanentError struct{ err error }
+
+func (e permanentError) Error() string { return e.err.Error() }
+func (e permanentError) Unwrap() error { return e.err }
+
+// Permanent wraps err so the event goes straight to the dead-letter log
+func Permanent(err error) error {
+	return permanentError{err}
+}
+
+// Options tune the workers
+type Options struct {
+	Workers     int
+	QueueSize   int
+	MaxAttempts int
+	BaseBackoff time.Duration
+	Timeout     time.Duration
+}
+
+// queueFullBackoff is how long EnqueueWait waits before trying a full queue again
+const queueFullBackoff = 50 * time.Millisecond
+
+// metrics counts events by outcome and reports the queue depth
+var metrics = expvar.NewMap("notifications")
+
+// Notifier sends queued events on a pool of workers
+type Notifier struct {
+	queue Queue
+	send  Sender
+	opts  Options
+	wg    sync.WaitGroup
+
+	// abort cuts retries short once the shutdown deadline has passed
+	abort  context.Context
+	cancel context.CancelFunc
+}
+
+// New starts the workers. A nil queue uses a MemoryQueue of opts.QueueSize.
+func New(queue Queue, send Sender, opts Options) *Notifier {
+	if opts.Workers <= 0 {
+		opts.Workers = 4
+	}
+	if opts.QueueSize <= 0 {
+		opts.QueueSize = 1000
+	}
+	if opts.MaxAttempts <= 0 {
+		opts.MaxAttempts = 5
+	}
+	if opts.BaseBackoff <= 0 {
+		opts.BaseBackoff = time.Second
+	}
+	if opts.Timeout <= 0 {
+		opts.Timeout = 5 * time.Second
+	}
+	if queue == nil {
+		queue = NewMemoryQueue(opts.QueueSize)
+	}
+	n := &Notifier{queue: queue, send: send, opts: opts}
+	n.abort, n.cancel = context.WithCancel(context.Background())
+	metrics.Set("queue_depth", expvar.Func(func() any { return queue.Len() }))
+
+	n.wg.Add(opts.Workers)
+	for i := 0; i < opts.Workers; i++ {
+		go n.work()
+	}
+	return n
+}
+
+// Enqueue queues an event and returns at once. An event that can't be queued is
+// dead-lettered and the error returned; callers usually only log it.
+func (n *Notifier) Enqueue(ctx context.Context, event *Event) error {
+	return n.push(ctx, event, false)
+}
+
+// EnqueueWait is Enqueue for bulk senders: while the queue is full it waits for
+// room until ctx ends, instead of dropping the event
+func (n *Notifier) EnqueueWait(ctx context.Context, event *Event) error {
+	return n.push(ctx, event, true)
+}
+
+func (n *Notifier) push(ctx context.Context, event *Event, wait bool) error {
+	if event.ID == "" {
+		event.ID = newID()
+	}
+	event.EnqueuedAt = time.Now().UTC()
+	err := n.queue.Push(ctx, event)
+	for wait && errors.Is(err, ErrQueueFull) {
+		select {
+		case <-time.After(queueFullBackoff):
+			err = n.queue.Push(ctx, event)
+		case <-ctx.Done():
+			err = ctx.Err()
+		}
+	}
+	if err != nil {
+		metrics.Add("dropped", 1)
+		deadLetter(event, 0, err.Error())
+		return err
+	}
+	metrics.Add("enqueued", 1)
+	return nil
+}
+
+// Shutdown stops accepting events and waits for the queued ones to be sent. When
+// ctx ends first, retries stop, what is left goes to the dead-letter log and
+// ctx's error is returned.
+func (n *Notifier) Shutdown(ctx context.Context) error {
+	n.queue.Close()
+	done := make(chan struct{})
+	go func() {
+		n.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		n.cancel()
+		<-done
+		return ctx.Err()
+	}
+}
+
+func (n *Notifier) work() {
+	defer n.wg.Done()
+	for {
+		event, err := n.queue.Pop(context.Background())
+		if err != nil {
+			return
+		}
+		n.deliver(event)
+	}
+}
+
+// deliver retries with exponential backoff before giving up to the dead-letter log
+func (n *Notifier) deliver(event *Event) {
+	backoff := n.opts.BaseBackoff
+	var lastErr error
+	for attempt := 1; attempt <= n.opts.MaxAttempts; attempt++ {
+		if n.abort.Err() != nil {
+			deadLetter(event, attempt-1, "shutdown deadline passed")
+			metrics.Add("failed", 1)
+			return
+		}
+		ctx, cancel := context.WithTimeout(n.abort, n.opts.Timeout)
+		lastErr = n.send(ctx, event)
+		cancel()
+		if lastErr == nil {
+			metrics.Add("sent", 1)
+			return
+		}
+		var permanent permanentError
+		if errors.As(lastErr, &permanent) {
+			deadLetter(event, attempt, lastErr.Error())
+			metrics.Add("failed", 1)
+			return
+		}
+		log.Printf("Notifier: attempt %d/%d of %s to %s failed: %v", attempt, n.opts.MaxAttempts, event.Type, event.UserID, lastErr)
+		if attempt < n.opts.MaxAttempts {
+			metrics.Add("retries", 1)
+			select {
+			case <-time.After(backoff):
+			case <-n.abort.Done():
+			}
+			backoff *= 2
+		}
+	}
+	deadLetter(event, n.opts.MaxAttempts, lastErr.Error())
+	metrics.Add("failed", 1)
+}
+
+// deadLetter records an event that could not be sent so it can be replayed by hand
+func deadLetter(event *Event, attempts int, reason string) {
+	payload, _ := json.Marshal(event)
+	log.Printf("Notification dead letter: event=%s type=%s user=%s attempts=%d reason=%q payload=%s",
+		event.ID, event.Type, event.UserID, attempts, reason, payload)
+}
+
+func newID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return fmt.Sprintf("%d", time.Now().UnixNano())
+	}
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,52 @@
+package utils
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// warningAgent names the gateway in Warning headers
+const warningAgent = "skillsync-api-gateway"
+
+// MarkPartial records on an aggregated response body that some of its data is
+// missing because the named dependencies failed. It sets "partial": true and adds
+// the dependencies to "missing"; repeated names are recorded once.
+func MarkPartial(body map[string]interface{}, dependencies ...string) {
+	if len(dependencies) == 0 {
+		return
+	}
+	missing, _ := body["missing"].([]string)
+	for _, dependency := range dependencies {
+		seen := false
+		for _, name := range missing {
+			if name == dependency {
+				seen = true
+				break
+			}
+		}
+		if !seen {
+			missing = append(missing, dependency)
+		}
+	}
+	body["partial"] = true
+	body["missing"] = missing
+}
+
+// WarnPartial adds a "Warning: 199" header for each dependency MarkPartial
+// recorded on body, so clients that only look at headers can tell too. Call it
+// before writing the response.
+func WarnPartial(c *gin.Context, body map[string]interface{}) {
+	missing, _ := body["missing"].([]string)
+	for _, dependency := range missing {
+		c.Writer.Header().Add("Warning", "199 "+warningAgent+" "+strconv.Quote(dependency+" unavailable, response is incomplete"))
+	}
+}
+
+// RespondPartial marks body as missing the failed dependencies, sets the
+// Warning headers and writes it with code
+func RespondPartial(c *gin.Context, code int, body map[string]interface{}, dependencies ...string) {
+	MarkPartial(body, dependencies...)
+	WarnPartial(c, body)
+	c.JSON(code, body)
+}
@@ -0,0 +1,66 @@
+package utils
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestMarkPartial(t *testing.T) {
+	tests := []struct {
+		name         string
+		body         map[string]interface{}
+		dependencies []string
+		wantPartial  bool
+		wantMissing  []string
+	}{
+		{"nothing missing", map[string]interface{}{}, nil, false, nil},
+		{"one dependency", map[string]interface{}{}, []string{"employer_profiles"}, true, []string{"employer_profiles"}},
+		{"repeated names once", map[string]interface{}{}, []string{"chat", "chat", "notifications"}, true, []string{"chat", "notifications"}},
+		{"added to earlier marks", map[string]interface{}{"partial": true, "missing": []string{"chat"}}, []string{"notifications", "chat"}, true, []string{"chat", "notifications"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			MarkPartial(tt.body, tt.dependencies...)
+			partial, _ := tt.body["partial"].(bool)
+			if partial != tt.wantPartial {
+				t.Errorf("partial = %v, want %v", partial, tt.wantPartial)
+			}
+			missing, _ := tt.body["missing"].([]string)
+			if !reflect.DeepEqual(missing, tt.wantMissing) {
+				t.Errorf("missing = %v, want %v", missing, tt.wantMissing)
+			}
+		})
+	}
+}
+
+func TestRespondPartial(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	tests := []struct {
+		name         string
+		dependencies []string
+		wantWarnings []string
+	}{
+		{"complete", nil, nil},
+		{"two missing", []string{"chat", "notifications"}, []string{
+			`199 skillsync-api-gateway "chat unavailable, response is incomplete"`,
+			`199 skillsync-api-gateway "notifications unavailable, response is incomplete"`,
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			RespondPartial(c, http.StatusOK, map[string]interface{}{"jobs": []int{1}}, tt.dependencies...)
+			if got := w.Header().Values("Warning"); !reflect.DeepEqual(got, tt.wantWarnings) {
+				t.Errorf("Warning = %q, want %q", got, tt.wantWarnings)
+			}
+			if w.Code != http.StatusOK {
+				t.Errorf("status = %d", w.Code)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package utils
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// breachCheckTimeout bounds the Have I Been Pwned lookup; slower answers fail open
+const breachCheckTimeout = 500 * time.Millisecond
+
+// passwordPolicy is rebuilt from the PASSWORD_* settings by Configure
+var passwordPolicy = newPasswordPolicy(cfg.Password)
+
+// CheckPassword applies the password policy to a new password sent in field. email
+// is used to reject passwords equal to its local part and may be empty.
+func CheckPassword(ctx context.Context, field, newPassword, email string) []FieldError {
+	ctx, cancel := context.WithTimeout(ctx, breachCheckTimeout)
+	defer cancel()
+
+	violations, err := passwordPolicy.Check(ctx, newPassword, email)
+	if err != nil {
+		log.Printf("Password breach check failed, skipping it: %v", err)
+		return nil
+	}
+	fields := make([]FieldError, 0, len(violations))
+	for _, v := range violations {
+		fields = append(fields, FieldError{Field: field, Code: v.Code, Message: v.Message})
+	}
+	return fields
+}
+
+// RejectWeakPassword writes a 400 and returns true when the new password breaks the policy
+func RejectWeakPassword(c *gin.Context, field, newPassword, email string) bool {
+	fields := CheckPassword(c.Request.Context(), field, newPassword, email)
+	if len(fields) == 0 {
+		return false
+	}
+	c.JSON(http.StatusBadRequest, gin.H{
+		"error":  "Password does not meet the requirements",
+		"fields": fields,
+	})
+	return true
+}
@@ -0,0 +1,121 @@
+package password
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type fakeBreaches struct {
+	breached bool
+	err      error
+	calls    int
+}
+
+func (f *fakeBreaches) Breached(context.Context, string) (bool, error) {
+	f.calls++
+	return f.breached, f.err
+}
+
+func codes(violations []Violation) []string {
+	var result []string
+	for _, v := range violations {
+		result = append(result, v.Code)
+	}
+	return result
+}
+
+func TestPolicyCheck(t *testing.T) {
+	strict := Policy{MinLength: 10, RequireUpper: true, RequireLower: true, RequireDigit: true, RequireSymbol: true}
+	tests := []struct {
+		name      string
+		policy    Policy
+		password  string
+		email     string
+		breaches  *fakeBreaches
+		want      []string
+		wantErr   bool
+		wantCalls int
+	}{
+		{"strong", strict, "Tr0ub4dor&3x", "", nil, nil, false, 0},
+		{"short", strict, "Ab1!", "", nil, []string{TooShort}, false, 0},
+		{"length counts runes", Policy{MinLength: 4}, "ééé", "", nil, []string{TooShort}, false, 0},
+		{"every class missing", strict, "          ", "", nil, []string{MissingUpper, MissingLower, MissingDigit}, false, 0},
+		{"space is a symbol", Policy{RequireSymbol: true}, "correct horse", "", nil, nil, false, 0},
+		{"matches email", Policy{}, "Alice.Smith", "alice.smith@example.com", nil, []string{MatchesEmail}, false, 0},
+		{"email without local part", Policy{}, "", "@example.com", nil, nil, false, 0},
+		{"breached", strict, "Tr0ub4dor&3x", "", &fakeBreaches{breached: true}, []string{Breached}, false, 1},
+		{"not breached", strict, "Tr0ub4dor&3x", "", &fakeBreaches{}, nil, false, 1},
+		{"breach check skipped after other violations", strict, "short", "", &fakeBreaches{breached: true}, []string{TooShort, MissingUpper, MissingDigit, MissingSymbol}, false, 0},
+		{"breach check error", strict, "Tr0ub4dor&3x", "", &fakeBreaches{err: errors.New("timeout")}, nil, true, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := tt.policy
+			if tt.breaches != nil {
+				policy.Breaches = tt.breaches
+			}
+			violations, err := policy.Check(context.Background(), tt.password, tt.email)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Check() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := codes(violations); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Check() = %v, want %v", got, tt.want)
+			}
+			if tt.breaches != nil && tt.breaches.calls != tt.wantCalls {
+				t.Errorf("breach checker called %d times, want %d", tt.breaches.calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestHIBPChecker(t *testing.T) {
+	sum := sha1.Sum([]byte("password1"))
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+	prefix, suffix := hash[:hashPrefixSize], hash[hashPrefixSize:]
+
+	tests := []struct {
+		name    string
+		status  int
+		body    string
+		want    bool
+		wantErr bool
+	}{
+		{"listed", http.StatusOK, "0018A45C4D1DEF81644B54AB7F969B88D65:1\r\n" + suffix + ":2427\r\n", true, false},
+		{"lower case suffix", http.StatusOK, strings.ToLower(suffix) + ":3\r\n", true, false},
+		{"padding entry", http.StatusOK, suffix + ":0\r\n", false, false},
+		{"not listed", http.StatusOK, "0018A45C4D1DEF81644B54AB7F969B88D65:1\r\n", false, false},
+		{"api error", http.StatusServiceUnavailable, "", false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/range/"+prefix {
+					t.Errorf("path = %s, want only the hash prefix /range/%s", r.URL.Path, prefix)
+				}
+				if r.Header.Get("Add-Padding") != "true" {
+					t.Error("Add-Padding header not sent")
+				}
+				w.WriteHeader(tt.status)
+				fmt.Fprint(w, tt.body)
+			}))
+			defer server.Close()
+
+			checker := &HIBPChecker{URL: server.URL + "/range/", Client: server.Client()}
+			got, err := checker.Breached(context.Background(), "password1")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Breached() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Breached() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,149 @@
+// Package phone normalizes phone numbers to E.164, so "+91 98765 43210" and
+// "09876543210" dialled from India both become +919876543210.
+package phone
+
+import (
+	"errors"
+	"sort"
+	"strings"
+)
+
+var (
+	// ErrInvalid means the input isn't a phone number for its country
+	ErrInvalid = errors.New("not a valid phone number")
+	// ErrRegionRequired means a national number was given without a country
+	ErrRegionRequired = errors.New("a country is required for numbers without a + prefix")
+	// ErrUnknownRegion means the country hint isn't one the parser knows
+	ErrUnknownRegion = errors.New("unknown country")
+)
+
+// region is a country's numbering plan as far as normalizing needs it
+type region struct {
+	callingCode string
+	// trunk is the prefix dialled before national numbers within the country, if any
+	trunk string
+	// lengths are the valid lengths of the national significant number
+	lengths []int
+}
+
+// regions are keyed by ISO 3166-1 alpha-2 code. Countries sharing a calling code
+// (the +1 plan) list the same lengths, so parsing an international number doesn't
+// depend on which of them is picked.
+var regions = map[string]region{
+	"AE": {"971", "0", []int{8, 9}},
+	"AU": {"61", "0", []int{9}},
+	"BD": {"880", "0", []int{10}},
+	"BH": {"973", "", []int{8}},
+	"BR": {"55", "0", []int{10, 11}},
+	"CA": {"1", "1", []int{10}},
+	"CN": {"86", "0", []int{10, 11}},
+	"DE": {"49", "0", []int{10, 11}},
+	"ES": {"34", "", []int{9}},
+	"FR": {"33", "0", []int{9}},
+	"GB": {"44", "0", []int{9, 10}},
+	"ID": {"62", "0", []int{9, 10, 11, 12}},
+	"IE": {"353", "0", []int{9}},
+	"IN": {"91", "0", []int{10}},
+	"JP": {"81", "0", []int{9, 10}},
+	"KE": {"254", "0", []int{9}},
+	"KW": {"965", "", []int{8}},
+	"LK": {"94", "0", []int{9}},
+	"MX": {"52", "", []int{10}},
+	"MY": {"60", "0", []int{9, 10}},
+	"NG": {"234", "0", []int{10}},
+	"NL": {"31", "0", []int{9}},
+	"NP": {"977", "0", []int{10}},
+	"OM": {"968", "", []int{8}},
+	"PH": {"63", "0", []int{10}},
+	"PK": {"92", "0", []int{10}},
+	"QA": {"974", "", []int{8}},
+	"SA": {"966", "0", []int{9}},
+	"SG": {"65", "", []int{8}},
+	"US": {"1", "1", []int{10}},
+	"ZA": {"27", "0", []int{9}},
+}
+
+// byCallingCode finds a region for a calling code
+var byCallingCode = func() map[string]region {
+	m := map[string]region{}
+	for _, r := range regions {
+		m[r.callingCode] = r
+	}
+	return m
+}()
+
+// Regions lists the country codes Normalize accepts as a hint
+func Regions() []string {
+	codes := make([]string, 0, len(regions))
+	for code := range regions {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	return codes
+}
+
+// Normalize parses raw, written internationally (+ or 00 prefix) or nationally for
+// the country hint, and returns it in E.164. Spaces, dashes, dots and parentheses
+// are ignored. International numbers in countries the parser doesn't know are only
+// checked against E.164's general length.
+func Normalize(raw, hint string) (string, error) {
+	var digits strings.Builder
+	international := false
+	for i, r := range strings.TrimSpace(raw) {
+		switch {
+		case r >= '0' && r <= '9':
+			digits.WriteRune(r)
+		case r == '+' && i == 0:
+			international = true
+		case r == ' ' || r == '-' || r == '.' || r == '(' || r == ')':
+		default:
+			return "", ErrInvalid
+		}
+	}
+	number := digits.String()
+	if !international && strings.HasPrefix(number, "00") {
+		international, number = true, number[2:]
+	}
+
+	if international {
+		// Calling codes are prefix-free, so at most one of 1 to 3 digits matches
+		for n := 1; n <= 3 && n < len(number); n++ {
+			if r, ok := byCallingCode[number[:n]]; ok {
+				return national(r, number[n:])
+			}
+		}
+		if len(number) < 8 || len(number) > 15 || number[0] == '0' {
+			return "", ErrInvalid
+		}
+		return "+" + number, nil
+	}
+
+	if strings.TrimSpace(hint) == "" {
+		return "", ErrRegionRequired
+	}
+	r, ok := regions[strings.ToUpper(strings.TrimSpace(hint))]
+	if !ok {
+		return "", ErrUnknownRegion
+	}
+	if r.trunk != "" && strings.HasPrefix(number, r.trunk) && !validLength(r, number) {
+		number = strings.TrimPrefix(number, r.trunk)
+	}
+	return national(r, number)
+}
+
+// national builds the E.164 form of a national significant number in r
+func national(r region, number string) (string, error) {
+	if !validLength(r, number) || number[0] == '0' {
+		return "", ErrInvalid
+	}
+	return "+" + r.callingCode + number, nil
+}
+
+func validLength(r region, number string) bool {
+	for _, n := range r.lengths {
+		if len(number) == n {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,96 @@
+package utils
+
+import (
+	"encoding/json"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// ProblemContentType is the RFC 7807 media type errors are rendered as on request
+const ProblemContentType = "application/problem+json"
+
+// problemTypeBase prefixes an error_code to form a problem's type URI
+const problemTypeBase = "https://api.skillsync.dev/errors/"
+
+// Problem is an RFC 7807 problem document. Members of the error envelope other than
+// error and error_code (e.g. fields or allowed) are kept as extension members.
+type Problem struct {
+	Type       string
+	Title      string
+	Status     int
+	Detail     string
+	Instance   string
+	Extensions map[string]interface{}
+}
+
+func (p Problem) MarshalJSON() ([]byte, error) {
+	doc := make(map[string]interface{}, len(p.Extensions)+5)
+	for name, value := range p.Extensions {
+		doc[name] = value
+	}
+	doc["type"] = p.Type
+	doc["title"] = p.Title
+	doc["status"] = p.Status
+	if p.Detail != "" {
+		doc["detail"] = p.Detail
+	}
+	if p.Instance != "" {
+		doc["instance"] = p.Instance
+	}
+	return json.Marshal(doc)
+}
+
+// WantsProblemJSON reports whether an Accept header lists application/problem+json
+// (with a non-zero q)
+func WantsProblemJSON(accept string) bool {
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil || mediaType != ProblemContentType {
+			continue
+		}
+		if q, err := strconv.ParseFloat(params["q"], 64); err == nil && q == 0 {
+			continue
+		}
+		return true
+	}
+	return false
+}
+
+// ProblemFromEnvelope converts the gateway's {"error", "error_code", ...} envelope
+// into a problem document. status is the response's HTTP status, which for backend
+// failures comes from HTTPStatusFromGRPC. Errors without an error_code get the
+// generic "about:blank" type.
+func ProblemFromEnvelope(status int, envelope map[string]interface{}, requestID string) Problem {
+	problem := Problem{
+		Type:       "about:blank",
+		Title:      http.StatusText(status),
+		Status:     status,
+		Extensions: make(map[string]interface{}),
+	}
+	for name, value := range envelope {
+		switch name {
+		case "error":
+			if detail, ok := value.(string); ok {
+				problem.Detail = detail
+				continue
+			}
+		case "error_code":
+			if code, ok := value.(string); ok {
+				if code != "" {
+					problem.Type = problemTypeBase + code
+				}
+				continue
+			}
+		case "type", "title", "status", "detail", "instance":
+			// Reserved by RFC 7807
+			continue
+		}
+		problem.Extensions[name] = value
+	}
+	if requestID != "" {
+		problem.Instance = "urn:request:" + requestID
+	}
+	return problem
+}
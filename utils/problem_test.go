@@ -0,0 +1,105 @@
+package utils
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestWantsProblemJSON(t *testing.T) {
+	tests := []struct {
+		accept string
+		want   bool
+	}{
+		{"application/problem+json", true},
+		{"application/json, application/problem+json;q=0.9", true},
+		{"Application/Problem+JSON", true},
+		{"application/problem+json;q=0", false},
+		{"application/problem+json; q=0.0", false},
+		{"application/json", false},
+		{"*/*", false},
+		{"", false},
+		{";;;, application/problem+json", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.accept, func(t *testing.T) {
+			if got := WantsProblemJSON(tt.accept); got != tt.want {
+				t.Errorf("WantsProblemJSON(%q) = %v, want %v", tt.accept, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestProblemFromEnvelope(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    int
+		envelope  map[string]interface{}
+		requestID string
+		want      map[string]interface{}
+	}{
+		{
+			name:      "error code and extensions",
+			status:    http.StatusMethodNotAllowed,
+			envelope:  map[string]interface{}{"error": "Method POST is not allowed", "error_code": "method_not_allowed", "allowed": []interface{}{"GET"}},
+			requestID: "abc",
+			want: map[string]interface{}{
+				"type":     "https://api.skillsync.dev/errors/method_not_allowed",
+				"title":    "Method Not Allowed",
+				"status":   float64(405),
+				"detail":   "Method POST is not allowed",
+				"instance": "urn:request:abc",
+				"allowed":  []interface{}{"GET"},
+			},
+		},
+		{
+			name:     "no error code",
+			status:   http.StatusBadGateway,
+			envelope: map[string]interface{}{"error": "Failed to get jobs"},
+			want: map[string]interface{}{
+				"type":   "about:blank",
+				"title":  "Bad Gateway",
+				"status": float64(502),
+				"detail": "Failed to get jobs",
+			},
+		},
+		{
+			name:     "reserved members are dropped",
+			status:   http.StatusBadRequest,
+			envelope: map[string]interface{}{"error": "bad", "status": "x", "title": "y", "type": "z", "error_code": ""},
+			want: map[string]interface{}{
+				"type":   "about:blank",
+				"title":  "Bad Request",
+				"status": float64(400),
+				"detail": "bad",
+			},
+		},
+		{
+			name:     "non-string error is an extension",
+			status:   http.StatusBadRequest,
+			envelope: map[string]interface{}{"error": map[string]interface{}{"field": "email"}},
+			want: map[string]interface{}{
+				"type":   "about:blank",
+				"title":  "Bad Request",
+				"status": float64(400),
+				"error":  map[string]interface{}{"field": "email"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(ProblemFromEnvelope(tt.status, tt.envelope, tt.requestID))
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got map[string]interface{}
+			if err := json.Unmarshal(data, &got); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("problem = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
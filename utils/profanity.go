@@ -0,0 +1,33 @@
+package utils
+
+import (
+	"strings"
+	"unicode"
+)
+
+// profaneWords are refused in free text that other users read, such as reviews
+var profaneWords = map[string]bool{
+	"arsehole": true, "asshole": true, "bastard": true, "bitch": true, "bollocks": true,
+	"bullshit": true, "cock": true, "cunt": true, "dickhead": true,
+	"fag": true, "faggot": true, "fuck": true, "fucked": true, "fucker": true,
+	"fucking": true, "motherfucker": true, "nigga": true, "nigger": true, "prick": true,
+	"retard": true, "shit": true, "shitty": true, "slut": true, "twat": true,
+	"wanker": true, "whore": true,
+}
+
+// leetReplacer undoes the digit and symbol swaps used to get words past a filter
+var leetReplacer = strings.NewReplacer("0", "o", "1", "i", "3", "e", "4", "a", "5", "s", "7", "t", "@", "a", "$", "s")
+
+// ContainsProfanity reports whether text has a profane word, matching whole words
+// case-insensitively
+func ContainsProfanity(text string) bool {
+	words := strings.FieldsFunc(leetReplacer.Replace(strings.ToLower(text)), func(r rune) bool {
+		return !unicode.IsLetter(r)
+	})
+	for _, word := range words {
+		if profaneWords[word] {
+			return true
+		}
+	}
+	return false
+}
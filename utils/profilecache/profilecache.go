@@ -0,0 +1,132 @@
+// Package profilecache shares the caller's candidate profile between the handlers
+// and sections of one request, and briefly between requests, so an aggregation
+// endpoint reads it from the auth service once. Profile updates invalidate it.
+package profilecache
+
+import (
+	"context"
+	"expvar"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	authpb "github.com/shahal0/skillsync-protos/gen/authpb"
+
+	"skillsync-api-gateway/clients"
+	"skillsync-api-gateway/utils/cache"
+)
+
+// TTL is how long a profile is reused across requests. Only the instance that
+// handled an update invalidates its copy; others serve the old one up to TTL.
+const TTL = 30 * time.Second
+
+var (
+	profiles = cache.NewTTLCache[*authpb.CandidateProfileResponse](TTL)
+
+	// metrics counts lookups: hits served from the TTL cache, misses that called the
+	// auth service and shared ones answered by a lookup earlier in the same request
+	metrics = expvar.NewMap("profile_cache")
+)
+
+func init() {
+	metrics.Set("hit_rate", expvar.Func(func() interface{} {
+		hits, misses := counter("hits")+counter("shared"), counter("misses")
+		if hits+misses == 0 {
+			return 0.0
+		}
+		return float64(hits) / float64(hits+misses)
+	}))
+}
+
+func counter(name string) int64 {
+	if value, ok := metrics.Get(name).(*expvar.Int); ok {
+		return value.Value()
+	}
+	return 0
+}
+
+type scopeKey struct{}
+
+// scope holds the lookups of one request; concurrent Gets for the same user wait
+// for the first one instead of calling the auth service again
+type scope struct {
+	mutex   sync.Mutex
+	lookups map[string]*lookup
+}
+
+type lookup struct {
+	done    chan struct{}
+	profile *authpb.CandidateProfileResponse
+	err     error
+}
+
+// WithScope returns ctx with a request scope for Get
+func WithScope(ctx context.Context) context.Context {
+	return context.WithValue(ctx, scopeKey{}, &scope{lookups: make(map[string]*lookup)})
+}
+
+// Scope gives every request its own scope; contexts derived from the request's carry it
+func Scope() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Request = c.Request.WithContext(WithScope(c.Request.Context()))
+		c.Next()
+	}
+}
+
+// Get returns userID's candidate profile, from the request scope or the TTL cache
+// if it was read recently. ctx must carry userID's metadata for the auth service.
+// The profile is shared, so callers must not modify it.
+func Get(ctx context.Context, userID string) (*authpb.CandidateProfileResponse, error) {
+	s, ok := ctx.Value(scopeKey{}).(*scope)
+	if !ok {
+		return fetch(ctx, userID)
+	}
+
+	s.mutex.Lock()
+	if existing, ok := s.lookups[userID]; ok {
+		s.mutex.Unlock()
+		<-existing.done
+		if existing.err == nil {
+			metrics.Add("shared", 1)
+		}
+		return existing.profile, existing.err
+	}
+	current := &lookup{done: make(chan struct{})}
+	s.lookups[userID] = current
+	s.mutex.Unlock()
+
+	current.profile, current.err = fetch(ctx, userID)
+	close(current.done)
+	if current.err != nil {
+		// A later section may have more time left, so let it try again
+		s.mutex.Lock()
+		delete(s.lookups, userID)
+		s.mutex.Unlock()
+	}
+	return current.profile, current.err
+}
+
+func fetch(ctx context.Context, userID string) (*authpb.CandidateProfileResponse, error) {
+	if profile, ok := profiles.Get(userID); ok {
+		metrics.Add("hits", 1)
+		return profile, nil
+	}
+	metrics.Add("misses", 1)
+	profile, err := clients.AuthServiceClient.CandidateProfile(ctx, &authpb.CandidateProfileRequest{})
+	if err != nil {
+		return nil, err
+	}
+	profiles.Set(userID, profile)
+	return profile, nil
+}
+
+// Invalidate forgets userID's profile after it changed, here and in ctx's request
+// scope, so the next Get reads it from the auth service
+func Invalidate(ctx context.Context, userID string) {
+	profiles.Delete(userID)
+	if s, ok := ctx.Value(scopeKey{}).(*scope); ok {
+		s.mutex.Lock()
+		delete(s.lookups, userID)
+		s.mutex.Unlock()
+	}
+}
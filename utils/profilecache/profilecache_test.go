@@ -0,0 +1,141 @@
+package profilecache
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	authpb "github.com/shahal0/skillsync-protos/gen/authpb"
+	"google.golang.org/grpc"
+
+	"skillsync-api-gateway/clients"
+	"skillsync-api-gateway/utils/cache"
+)
+
+// fakeAuth answers CandidateProfile with the current name and counts the calls
+type fakeAuth struct {
+	authpb.AuthServiceClient
+	mutex sync.Mutex
+	name  string
+	err   error
+	calls int
+}
+
+func (f *fakeAuth) CandidateProfile(context.Context, *authpb.CandidateProfileRequest, ...grpc.CallOption) (*authpb.CandidateProfileResponse, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.calls++
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &authpb.CandidateProfileResponse{Id: "c1", Name: f.name}, nil
+}
+
+func (f *fakeAuth) rename(name string) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.name = name
+}
+
+// useFakeAuth swaps in a fake auth service and a fresh cache keeping profiles for ttl
+func useFakeAuth(t *testing.T, ttl time.Duration) *fakeAuth {
+	t.Helper()
+	auth := &fakeAuth{name: "Ada"}
+	previousAuth, previousProfiles := clients.AuthServiceClient, profiles
+	clients.AuthServiceClient, profiles = auth, cache.NewTTLCache[*authpb.CandidateProfileResponse](ttl)
+	t.Cleanup(func() { clients.AuthServiceClient, profiles = previousAuth, previousProfiles })
+	return auth
+}
+
+func TestGetExpiry(t *testing.T) {
+	auth := useFakeAuth(t, 20*time.Millisecond)
+
+	if profile, err := Get(context.Background(), "c1"); err != nil || profile.Name != "Ada" {
+		t.Fatalf("Get() = %v, %v, want Ada", profile, err)
+	}
+	auth.rename("Grace")
+	if profile, _ := Get(context.Background(), "c1"); profile.Name != "Ada" || auth.calls != 1 {
+		t.Errorf("Get() within the TTL = %q after %d calls, want the cached Ada after 1", profile.Name, auth.calls)
+	}
+
+	time.Sleep(30 * time.Millisecond)
+	if profile, _ := Get(context.Background(), "c1"); profile.Name != "Grace" || auth.calls != 2 {
+		t.Errorf("Get() after the TTL = %q after %d calls, want Grace after 2", profile.Name, auth.calls)
+	}
+}
+
+func TestInvalidate(t *testing.T) {
+	tests := []struct {
+		name       string
+		scoped     bool
+		invalidate string
+		wantName   string
+		wantCalls  int
+	}{
+		{"without a scope", false, "c1", "Grace", 2},
+		{"within the request scope", true, "c1", "Grace", 2},
+		{"another user", true, "c2", "Ada", 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			auth := useFakeAuth(t, time.Minute)
+			ctx := context.Background()
+			if tt.scoped {
+				ctx = WithScope(ctx)
+			}
+
+			if _, err := Get(ctx, "c1"); err != nil {
+				t.Fatal(err)
+			}
+			auth.rename("Grace")
+			Invalidate(ctx, tt.invalidate)
+
+			profile, err := Get(ctx, "c1")
+			if err != nil || profile.Name != tt.wantName {
+				t.Errorf("Get() = %v, %v, want %s", profile, err, tt.wantName)
+			}
+			if auth.calls != tt.wantCalls {
+				t.Errorf("auth called %d times, want %d", auth.calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestGetSharesWithinScope(t *testing.T) {
+	auth := useFakeAuth(t, time.Minute)
+	ctx := WithScope(context.Background())
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if profile, err := Get(ctx, "c1"); err != nil || profile.Name != "Ada" {
+				t.Errorf("Get() = %v, %v, want Ada", profile, err)
+			}
+		}()
+	}
+	wg.Wait()
+	if auth.calls != 1 {
+		t.Errorf("auth called %d times, want 1", auth.calls)
+	}
+}
+
+func TestGetErrorIsNotCached(t *testing.T) {
+	auth := useFakeAuth(t, time.Minute)
+	auth.err = errors.New("unavailable")
+	ctx := WithScope(context.Background())
+
+	if _, err := Get(ctx, "c1"); err == nil {
+		t.Fatal("Get() succeeded, want the auth service's error")
+	}
+	auth.err = nil
+	if profile, err := Get(ctx, "c1"); err != nil || profile.Name != "Ada" {
+		t.Errorf("Get() after a failure = %v, %v, want a retry that returns Ada", profile, err)
+	}
+	if auth.calls != 2 {
+		t.Errorf("auth called %d times, want 2", auth.calls)
+	}
+}
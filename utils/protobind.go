@@ -0,0 +1,217 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// protoBindBodyLimit bounds the body BindProto reads, like the JSON binding's own
+const protoBindBodyLimit = 10 << 20
+
+// ProtoBindError is a request body BindProto couldn't decode, described per field
+// the way ValidationErrors describes binding failures
+type ProtoBindError struct {
+	Fields []FieldError
+}
+
+func (e *ProtoBindError) Error() string {
+	messages := make([]string, 0, len(e.Fields))
+	for _, field := range e.Fields {
+		messages = append(messages, field.Message)
+	}
+	return strings.Join(messages, "; ")
+}
+
+// protojsonDetail finds what protojson says went wrong after its position prefix.
+// protojson's wording isn't stable, so anything not recognised is reported generically.
+var protojsonDetail = regexp.MustCompile(`\(line \d+:\d+\): (.*)$`)
+
+var (
+	unknownFieldError   = regexp.MustCompile(`^unknown field "?([^"]+)"?$`)
+	duplicateFieldError = regexp.MustCompile(`^duplicate field "?([^"]+)"?$`)
+	oneofSetError       = regexp.MustCompile(`^error parsing "?([^",]+)"?, oneof (\S+) is already set$`)
+	invalidValueError   = regexp.MustCompile(`^invalid value for (\w+) field (\w+): `)
+)
+
+// BindProto decodes the request's JSON body into msg with protojson, so enums can
+// be sent by name, oneofs and well-known types follow proto semantics, and fields
+// may use their JSON or proto names. Unknown fields are ignored unless
+// PROTO_REJECT_UNKNOWN_FIELDS is on. The error is a *ProtoBindError, ready for
+// RespondWithValidationError.
+func BindProto(c *gin.Context, msg proto.Message) error {
+	body, err := readProtoBody(c)
+	if err != nil {
+		return err
+	}
+	return unmarshalProto(body, msg)
+}
+
+// BindProtoWithExtras binds like BindProto, except that the top-level fields named
+// in extras belong to the gateway rather than msg. Each is decoded with
+// encoding/json into its pointer and taken out before the rest goes to msg.
+func BindProtoWithExtras(c *gin.Context, msg proto.Message, extras map[string]interface{}) error {
+	body, err := readProtoBody(c)
+	if err != nil {
+		return err
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(body, &fields); err != nil || fields == nil {
+		return &ProtoBindError{Fields: []FieldError{{Message: "request body must be a JSON object"}}}
+	}
+	for name, target := range extras {
+		raw, ok := fields[name]
+		if !ok {
+			continue
+		}
+		delete(fields, name)
+		if err := json.Unmarshal(raw, target); err != nil {
+			return &ProtoBindError{Fields: []FieldError{{Field: name, Message: name + " has the wrong type"}}}
+		}
+	}
+	rest, err := json.Marshal(fields)
+	if err != nil {
+		return &ProtoBindError{Fields: []FieldError{{Message: "request body is invalid"}}}
+	}
+	return unmarshalProto(rest, msg)
+}
+
+// readProtoBody reads the request body for binding, refusing a missing or blank one
+func readProtoBody(c *gin.Context) ([]byte, error) {
+	if c.Request.Body == nil {
+		return nil, &ProtoBindError{Fields: []FieldError{{Message: "request body is required"}}}
+	}
+	body, err := io.ReadAll(io.LimitReader(c.Request.Body, protoBindBodyLimit))
+	if err != nil {
+		return nil, &ProtoBindError{Fields: []FieldError{{Message: "failed to read request body"}}}
+	}
+	if len(strings.TrimSpace(string(body))) == 0 {
+		return nil, &ProtoBindError{Fields: []FieldError{{Message: "request body is required"}}}
+	}
+	return body, nil
+}
+
+func unmarshalProto(body []byte, msg proto.Message) error {
+	options := protojson.UnmarshalOptions{DiscardUnknown: !cfg.ProtoRejectUnknownFields}
+	if err := options.Unmarshal(body, msg); err != nil {
+		return &ProtoBindError{Fields: []FieldError{protoFieldError(err, msg.ProtoReflect().Descriptor())}}
+	}
+	return nil
+}
+
+// protoFieldError translates a protojson error into a field error. Fields are
+// named in snake_case, as the generated structs' JSON tags name them.
+func protoFieldError(err error, descriptor protoreflect.MessageDescriptor) FieldError {
+	if strings.Contains(err.Error(), "syntax error") || strings.Contains(err.Error(), "unexpected EOF") {
+		return FieldError{Message: "request body must be a JSON object"}
+	}
+	match := protojsonDetail.FindStringSubmatch(err.Error())
+	if match == nil {
+		return FieldError{Message: "request body is invalid"}
+	}
+	detail := match[1]
+	if m := unknownFieldError.FindStringSubmatch(detail); m != nil {
+		field := snakeCase(m[1])
+		return FieldError{Field: field, Code: "unknown_field", Message: field + " is not a known field"}
+	}
+	if m := duplicateFieldError.FindStringSubmatch(detail); m != nil {
+		field := snakeCase(m[1])
+		return FieldError{Field: field, Message: field + " is given more than once"}
+	}
+	if m := oneofSetError.FindStringSubmatch(detail); m != nil {
+		field := snakeCase(m[1])
+		others := oneofFields(descriptor, m[2])
+		return FieldError{Field: field, Code: "oneof_conflict", Message: fmt.Sprintf("only one of %s can be set", strings.Join(others, ", "))}
+	}
+	if m := invalidValueError.FindStringSubmatch(detail); m != nil {
+		field := snakeCase(m[2])
+		return FieldError{Field: field, Message: invalidValueMessage(field, m[1], findProtoField(descriptor, m[2]))}
+	}
+	return FieldError{Message: "request body is invalid"}
+}
+
+// invalidValueMessage says what a field of kind accepts
+func invalidValueMessage(field, kind string, fd protoreflect.FieldDescriptor) string {
+	switch kind {
+	case "enum":
+		if fd != nil && fd.Enum() != nil {
+			values := fd.Enum().Values()
+			names := make([]string, 0, values.Len())
+			for i := 0; i < values.Len(); i++ {
+				names = append(names, string(values.Get(i).Name()))
+			}
+			return fmt.Sprintf("%s must be one of: %s", field, strings.Join(names, ", "))
+		}
+		return field + " is not a known value"
+	case "bool":
+		return field + " must be true or false"
+	case "string", "bytes":
+		return field + " must be a string"
+	case "message":
+		return field + " must be an object"
+	default:
+		return field + " must be a number"
+	}
+}
+
+// findProtoField looks up a field by its JSON name in descriptor and the messages
+// it contains, since protojson only names the field that failed
+func findProtoField(descriptor protoreflect.MessageDescriptor, jsonName string) protoreflect.FieldDescriptor {
+	for _, md := range protoMessages(descriptor) {
+		fields := md.Fields()
+		for i := 0; i < fields.Len(); i++ {
+			if fields.Get(i).JSONName() == jsonName {
+				return fields.Get(i)
+			}
+		}
+	}
+	return nil
+}
+
+// oneofFields lists the snake_case names of the fields in the oneof called fullName
+func oneofFields(descriptor protoreflect.MessageDescriptor, fullName string) []string {
+	parent := protoreflect.FullName(fullName).Parent()
+	for _, md := range protoMessages(descriptor) {
+		if md.FullName() != parent {
+			continue
+		}
+		if od := md.Oneofs().ByName(protoreflect.FullName(fullName).Name()); od != nil {
+			names := make([]string, 0, od.Fields().Len())
+			for i := 0; i < od.Fields().Len(); i++ {
+				names = append(names, string(od.Fields().Get(i).Name()))
+			}
+			return names
+		}
+	}
+	return []string{string(protoreflect.FullName(fullName).Name())}
+}
+
+// protoMessages is descriptor and every message type reachable from its fields
+func protoMessages(descriptor protoreflect.MessageDescriptor) []protoreflect.MessageDescriptor {
+	seen := map[protoreflect.FullName]bool{}
+	var messages []protoreflect.MessageDescriptor
+	queue := []protoreflect.MessageDescriptor{descriptor}
+	for len(queue) > 0 {
+		md := queue[0]
+		queue = queue[1:]
+		if seen[md.FullName()] {
+			continue
+		}
+		seen[md.FullName()] = true
+		messages = append(messages, md)
+		fields := md.Fields()
+		for i := 0; i < fields.Len(); i++ {
+			if fields.Get(i).Message() != nil {
+				queue = append(queue, fields.Get(i).Message())
+			}
+		}
+	}
+	return messages
+}
@@ -0,0 +1,69 @@
+package utils
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	authpb "github.com/shahal0/skillsync-protos/gen/authpb"
+)
+
+func bindContext(body string) *gin.Context {
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	return c
+}
+
+func TestBindProtoWithExtras(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		reject     bool
+		wantKey    string
+		wantToken  string
+		wantErrFor string
+		wantErr    bool
+	}{
+		{name: "extra and proto fields", body: `{"object_key":"resumes/u1/cv.pdf","token":"t"}`, wantKey: "resumes/u1/cv.pdf", wantToken: "t"},
+		{name: "extra only", body: `{"object_key":"resumes/u1/cv.pdf"}`, wantKey: "resumes/u1/cv.pdf"},
+		{name: "extra absent", body: `{"token":"t"}`, wantToken: "t"},
+		{name: "extra is not an unknown field when rejecting", body: `{"object_key":"k","token":"t"}`, reject: true, wantKey: "k", wantToken: "t"},
+		{name: "unknown field still rejected", body: `{"object_key":"k","nope":1}`, reject: true, wantErrFor: "nope", wantErr: true},
+		{name: "extra of the wrong type", body: `{"object_key":42}`, wantErrFor: "object_key", wantErr: true},
+		{name: "proto field of the wrong type", body: `{"token":42}`, wantErrFor: "token", wantErr: true},
+		{name: "not an object", body: `["object_key"]`, wantErr: true},
+		{name: "null", body: `null`, wantErr: true},
+		{name: "empty", body: ` `, wantErr: true},
+	}
+	defer func(reject bool) { cfg.ProtoRejectUnknownFields = reject }(cfg.ProtoRejectUnknownFields)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg.ProtoRejectUnknownFields = tt.reject
+			var req authpb.UploadResumeRequest
+			var objectKey string
+			err := BindProtoWithExtras(bindContext(tt.body), &req, map[string]interface{}{"object_key": &objectKey})
+			if tt.wantErr {
+				var bindErr *ProtoBindError
+				if !errors.As(err, &bindErr) {
+					t.Fatalf("error = %v, want a *ProtoBindError", err)
+				}
+				if got := bindErr.Fields[0].Field; got != tt.wantErrFor {
+					t.Errorf("error field = %q, want %q", got, tt.wantErrFor)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if objectKey != tt.wantKey {
+				t.Errorf("object_key = %q, want %q", objectKey, tt.wantKey)
+			}
+			if req.GetToken() != tt.wantToken {
+				t.Errorf("token = %q, want %q", req.GetToken(), tt.wantToken)
+			}
+		})
+	}
+}
@@ -0,0 +1,150 @@
+// Package screening evaluates the rules employers set to act on new applications
+// on their own. Rules are checked against a catalog of supported fields and
+// operators when they are saved, and evaluated in order: the first that matches
+// decides what happens to the application.
+package screening
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Actions a rule can take
+const (
+	Reject    = "reject"
+	Shortlist = "shortlist"
+)
+
+// Fields a rule can test
+const (
+	// ExperienceYears is the candidate's years of experience
+	ExperienceYears = "experience_years"
+	// SkillMatchPercent is the share of the job's required skills the candidate has
+	SkillMatchPercent = "skill_match_percent"
+	// Skill is one skill the candidate lists, or doesn't
+	Skill = "skill"
+	// Location is the candidate's current location
+	Location = "location"
+)
+
+// FieldSpec describes a field rules may test
+type FieldSpec struct {
+	Field     string   `json:"field"`
+	Operators []string `json:"operators"`
+	// Numeric fields take a number as their value, others a string
+	Numeric bool `json:"numeric"`
+}
+
+// catalog is every supported field, in the order clients should offer them
+var catalog = []FieldSpec{
+	{Field: ExperienceYears, Operators: []string{"gte", "lt"}, Numeric: true},
+	{Field: SkillMatchPercent, Operators: []string{"gte", "lt"}, Numeric: true},
+	{Field: Skill, Operators: []string{"has", "lacks"}},
+	{Field: Location, Operators: []string{"is", "is_not"}},
+}
+
+// Catalog returns the supported fields and their operators
+func Catalog() []FieldSpec {
+	return append([]FieldSpec(nil), catalog...)
+}
+
+func fieldSpec(field string) (FieldSpec, bool) {
+	for _, spec := range catalog {
+		if spec.Field == field {
+			return spec, true
+		}
+	}
+	return FieldSpec{}, false
+}
+
+// Rule is one employer-defined rule. Value is a number for numeric fields.
+type Rule struct {
+	Field    string
+	Operator string
+	Value    string
+	Action   string
+	// Message is what the candidate is told when the rule fires
+	Message string
+}
+
+// Validate checks a rule against the catalog
+func Validate(rule Rule) error {
+	spec, ok := fieldSpec(rule.Field)
+	if !ok {
+		return fmt.Errorf("field %q is not supported", rule.Field)
+	}
+	supported := false
+	for _, operator := range spec.Operators {
+		supported = supported || operator == rule.Operator
+	}
+	if !supported {
+		return fmt.Errorf("field %s supports the operators %s, not %q", rule.Field, strings.Join(spec.Operators, ", "), rule.Operator)
+	}
+	if spec.Numeric {
+		value, err := strconv.ParseFloat(rule.Value, 64)
+		if err != nil || value < 0 {
+			return fmt.Errorf("field %s needs a number of at least 0 as its value", rule.Field)
+		}
+		if rule.Field == SkillMatchPercent && value > 100 {
+			return fmt.Errorf("field %s needs a percentage between 0 and 100", rule.Field)
+		}
+	} else if strings.TrimSpace(rule.Value) == "" {
+		return fmt.Errorf("field %s needs a value", rule.Field)
+	}
+	if rule.Action != Reject && rule.Action != Shortlist {
+		return fmt.Errorf("action must be %s or %s", Reject, Shortlist)
+	}
+	return nil
+}
+
+// Applicant is what rules are evaluated against
+type Applicant struct {
+	ExperienceYears int64
+	// SkillMatchPercent is nil when the job requires no skills; rules on it never
+	// match then
+	SkillMatchPercent *int
+	// Skills are the candidate's canonical skill names, lowercased
+	Skills   map[string]bool
+	Location string
+}
+
+// Matches reports whether rule applies to the applicant. A rule that doesn't
+// validate never matches.
+func Matches(rule Rule, applicant Applicant) bool {
+	if Validate(rule) != nil {
+		return false
+	}
+	switch rule.Field {
+	case ExperienceYears:
+		return compare(float64(applicant.ExperienceYears), rule)
+	case SkillMatchPercent:
+		return applicant.SkillMatchPercent != nil && compare(float64(*applicant.SkillMatchPercent), rule)
+	case Skill:
+		has := applicant.Skills[strings.ToLower(strings.TrimSpace(rule.Value))]
+		return has == (rule.Operator == "has")
+	case Location:
+		same := strings.EqualFold(strings.TrimSpace(applicant.Location), strings.TrimSpace(rule.Value))
+		return same == (rule.Operator == "is")
+	}
+	return false
+}
+
+// compare applies a numeric rule's operator to value
+func compare(value float64, rule Rule) bool {
+	threshold, _ := strconv.ParseFloat(rule.Value, 64)
+	if rule.Operator == "gte" {
+		return value >= threshold
+	}
+	return value < threshold
+}
+
+// Evaluate returns the index of the first rule that matches the applicant, or -1
+func Evaluate(rules []Rule, applicant Applicant) int {
+	for i, rule := range rules {
+		if Matches(rule, applicant) {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,97 @@
+package screening
+
+import (
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    Rule
+		wantErr string
+	}{
+		{"experience", Rule{Field: ExperienceYears, Operator: "gte", Value: "2.5", Action: Shortlist}, ""},
+		{"skill", Rule{Field: Skill, Operator: "lacks", Value: "Go", Action: Reject}, ""},
+		{"unknown field", Rule{Field: "salary", Operator: "gte", Value: "1", Action: Reject}, `field "salary" is not supported`},
+		{"operator of another field", Rule{Field: Location, Operator: "gte", Value: "Berlin", Action: Reject}, `field location supports the operators is, is_not, not "gte"`},
+		{"not a number", Rule{Field: ExperienceYears, Operator: "lt", Value: "two", Action: Reject}, "field experience_years needs a number of at least 0 as its value"},
+		{"negative", Rule{Field: ExperienceYears, Operator: "lt", Value: "-1", Action: Reject}, "field experience_years needs a number of at least 0 as its value"},
+		{"percentage over 100", Rule{Field: SkillMatchPercent, Operator: "gte", Value: "101", Action: Shortlist}, "field skill_match_percent needs a percentage between 0 and 100"},
+		{"blank value", Rule{Field: Skill, Operator: "has", Value: "  ", Action: Shortlist}, "field skill needs a value"},
+		{"unknown action", Rule{Field: Skill, Operator: "has", Value: "go", Action: "archive"}, "action must be reject or shortlist"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(tt.rule)
+			if tt.wantErr == "" && err != nil {
+				t.Errorf("Validate() error = %v", err)
+			}
+			if tt.wantErr != "" && (err == nil || err.Error() != tt.wantErr) {
+				t.Errorf("Validate() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMatches(t *testing.T) {
+	sixty := 60
+	applicant := Applicant{ExperienceYears: 3, SkillMatchPercent: &sixty, Skills: map[string]bool{"go": true, "postgresql": true}, Location: "Berlin "}
+	tests := []struct {
+		name      string
+		rule      Rule
+		applicant Applicant
+		want      bool
+	}{
+		{"experience at least", Rule{Field: ExperienceYears, Operator: "gte", Value: "3", Action: Shortlist}, applicant, true},
+		{"experience under", Rule{Field: ExperienceYears, Operator: "lt", Value: "3", Action: Reject}, applicant, false},
+		{"fractional years", Rule{Field: ExperienceYears, Operator: "lt", Value: "3.5", Action: Reject}, applicant, true},
+		{"skill match", Rule{Field: SkillMatchPercent, Operator: "lt", Value: "75", Action: Reject}, applicant, true},
+		{"no required skills", Rule{Field: SkillMatchPercent, Operator: "lt", Value: "75", Action: Reject}, Applicant{}, false},
+		{"has skill ignores case", Rule{Field: Skill, Operator: "has", Value: " Go ", Action: Shortlist}, applicant, true},
+		{"lacks skill", Rule{Field: Skill, Operator: "lacks", Value: "kubernetes", Action: Reject}, applicant, true},
+		{"lacks a skill they have", Rule{Field: Skill, Operator: "lacks", Value: "postgresql", Action: Reject}, applicant, false},
+		{"location is", Rule{Field: Location, Operator: "is", Value: "berlin", Action: Shortlist}, applicant, true},
+		{"location is not", Rule{Field: Location, Operator: "is_not", Value: "Berlin", Action: Reject}, applicant, false},
+		{"invalid rule", Rule{Field: ExperienceYears, Operator: "gte", Value: "0", Action: "archive"}, applicant, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Matches(tt.rule, tt.applicant); got != tt.want {
+				t.Errorf("Matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	rules := []Rule{
+		{Field: Skill, Operator: "lacks", Value: "go", Action: Reject},
+		{Field: ExperienceYears, Operator: "gte", Value: "5", Action: Shortlist},
+		{Field: ExperienceYears, Operator: "gte", Value: "0", Action: "archive"},
+		{Field: Location, Operator: "is", Value: "Remote", Action: Shortlist},
+	}
+	tests := []struct {
+		name      string
+		applicant Applicant
+		want      int
+	}{
+		{"first match wins", Applicant{ExperienceYears: 7}, 0},
+		{"second rule", Applicant{ExperienceYears: 7, Skills: map[string]bool{"go": true}}, 1},
+		{"invalid rules are skipped", Applicant{ExperienceYears: 1, Skills: map[string]bool{"go": true}, Location: "remote"}, 3},
+		{"none", Applicant{ExperienceYears: 1, Skills: map[string]bool{"go": true}}, -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Evaluate(rules, tt.applicant); got != tt.want {
+				t.Errorf("Evaluate() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCatalogIsACopy(t *testing.T) {
+	Catalog()[0].Field = "salary"
+	if got := Catalog()[0].Field; got != ExperienceYears {
+		t.Errorf("Catalog()[0].Field = %q after modifying a copy", got)
+	}
+}
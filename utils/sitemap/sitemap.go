@@ -0,0 +1,98 @@
+// Package sitemap writes sitemaps.org XML. URLs are encoded as they are added and
+// split into shards of at most MaxURLs, so a large catalog is only ever held as
+// its encoded XML.
+package sitemap
+
+import (
+	"bytes"
+	"encoding/xml"
+	"time"
+)
+
+// MaxURLs is the most URLs the protocol allows in one sitemap file
+const MaxURLs = 50000
+
+const (
+	urlsetOpen  = xml.Header + `<urlset xmlns="http://www.sitemaps.org/schemas/sitemap/0.9">` + "\n"
+	urlsetClose = "</urlset>\n"
+	indexOpen   = xml.Header + `<sitemapindex xmlns="http://www.sitemaps.org/schemas/sitemap/0.9">` + "\n"
+	indexClose  = "</sitemapindex>\n"
+)
+
+// URL is one page in a sitemap; LastMod is left out when zero
+type URL struct {
+	Loc     string
+	LastMod time.Time
+}
+
+// Builder collects URLs into shards
+type Builder struct {
+	shardSize int
+	shards    [][]byte
+	current   bytes.Buffer
+	count     int
+}
+
+// NewBuilder starts shards of at most shardSize URLs, MaxURLs when shardSize is
+// out of range
+func NewBuilder(shardSize int) *Builder {
+	if shardSize <= 0 || shardSize > MaxURLs {
+		shardSize = MaxURLs
+	}
+	return &Builder{shardSize: shardSize}
+}
+
+// Add appends url to the current shard, starting a new one when it is full
+func (b *Builder) Add(url URL) {
+	if b.count == b.shardSize {
+		b.closeShard()
+	}
+	if b.count == 0 {
+		b.current.WriteString(urlsetOpen)
+	}
+	b.current.WriteString("  <url><loc>")
+	xml.EscapeText(&b.current, []byte(url.Loc))
+	b.current.WriteString("</loc>")
+	if !url.LastMod.IsZero() {
+		b.current.WriteString("<lastmod>" + url.LastMod.UTC().Format(time.RFC3339) + "</lastmod>")
+	}
+	b.current.WriteString("</url>\n")
+	b.count++
+}
+
+func (b *Builder) closeShard() {
+	b.current.WriteString(urlsetClose)
+	b.shards = append(b.shards, bytes.Clone(b.current.Bytes()))
+	b.current.Reset()
+	b.count = 0
+}
+
+// Finish returns the shards, each a complete <urlset> document. There is always
+// at least one, empty when no URLs were added.
+func (b *Builder) Finish() [][]byte {
+	if b.count > 0 || len(b.shards) == 0 {
+		if b.count == 0 {
+			b.current.WriteString(urlsetOpen)
+		}
+		b.closeShard()
+	}
+	return b.shards
+}
+
+// Index returns a <sitemapindex> listing shards sitemaps, whose location is
+// shardLoc with the shard's 1-based number
+func Index(shards int, shardLoc func(n int) string, lastMod time.Time) []byte {
+	var index bytes.Buffer
+	index.WriteString(indexOpen)
+	for n := 1; n <= shards; n++ {
+		index.WriteString("  <sitemap><loc>")
+		xml.EscapeText(&index, []byte(shardLoc(n)))
+		index.WriteString("</loc>")
+		if !lastMod.IsZero() {
+			index.WriteString("<lastmod>" + lastMod.UTC().Format(time.RFC3339) + "</lastmod>")
+		}
+		index.WriteString("</sitemap>\n")
+	}
+	index.WriteString(indexClose)
+	return index.Bytes()
+}
@@ -0,0 +1,98 @@
+package sitemap
+
+import (
+	"encoding/xml"
+	"fmt"
+	"testing"
+	"time"
+)
+
+type urlset struct {
+	URLs []struct {
+		Loc     string `xml:"loc"`
+		LastMod string `xml:"lastmod"`
+	} `xml:"url"`
+}
+
+func TestBuilder(t *testing.T) {
+	tests := []struct {
+		name      string
+		shardSize int
+		urls      int
+		wantSizes []int
+	}{
+		{"empty", 10, 0, []int{0}},
+		{"one shard", 10, 3, []int{3}},
+		{"exactly full", 2, 4, []int{2, 2}},
+		{"split", 2, 5, []int{2, 2, 1}},
+		{"size out of range", -1, 3, []int{3}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := NewBuilder(tt.shardSize)
+			for i := 0; i < tt.urls; i++ {
+				b.Add(URL{Loc: fmt.Sprintf("https://skillsync.dev/jobs/%d", i)})
+			}
+			shards := b.Finish()
+			if len(shards) != len(tt.wantSizes) {
+				t.Fatalf("%d shards, want %d", len(shards), len(tt.wantSizes))
+			}
+			next := 0
+			for i, shard := range shards {
+				var set urlset
+				if err := xml.Unmarshal(shard, &set); err != nil {
+					t.Fatalf("shard %d isn't XML: %v\n%s", i, err, shard)
+				}
+				if len(set.URLs) != tt.wantSizes[i] {
+					t.Errorf("shard %d has %d URLs, want %d", i, len(set.URLs), tt.wantSizes[i])
+				}
+				for _, url := range set.URLs {
+					if want := fmt.Sprintf("https://skillsync.dev/jobs/%d", next); url.Loc != want {
+						t.Errorf("loc = %s, want %s", url.Loc, want)
+					}
+					next++
+				}
+			}
+		})
+	}
+}
+
+func TestBuilderOutput(t *testing.T) {
+	b := NewBuilder(0)
+	b.Add(URL{Loc: "https://skillsync.dev/jobs?q=go&page=2", LastMod: time.Date(2024, 5, 1, 14, 30, 0, 0, time.FixedZone("CEST", 2*60*60))})
+	b.Add(URL{Loc: "https://skillsync.dev/"})
+	want := `<?xml version="1.0" encoding="UTF-8"?>
+<urlset xmlns="http://www.sitemaps.org/schemas/sitemap/0.9">
+  <url><loc>https://skillsync.dev/jobs?q=go&amp;page=2</loc><lastmod>2024-05-01T12:30:00Z</lastmod></url>
+  <url><loc>https://skillsync.dev/</loc></url>
+</urlset>
+`
+	if got := string(b.Finish()[0]); got != want {
+		t.Errorf("Finish() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestIndex(t *testing.T) {
+	tests := []struct {
+		name    string
+		shards  int
+		lastMod time.Time
+		want    string
+	}{
+		{"none", 0, time.Time{}, ""},
+		{
+			"with lastmod", 2, time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+			"  <sitemap><loc>https://skillsync.dev/sitemap-1.xml?a=1&amp;b=2</loc><lastmod>2024-05-01T00:00:00Z</lastmod></sitemap>\n" +
+				"  <sitemap><loc>https://skillsync.dev/sitemap-2.xml?a=1&amp;b=2</loc><lastmod>2024-05-01T00:00:00Z</lastmod></sitemap>\n",
+		},
+		{"without lastmod", 1, time.Time{}, "  <sitemap><loc>https://skillsync.dev/sitemap-1.xml?a=1&amp;b=2</loc></sitemap>\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Index(tt.shards, func(n int) string { return fmt.Sprintf("https://skillsync.dev/sitemap-%d.xml?a=1&b=2", n) }, tt.lastMod)
+			if want := indexOpen + tt.want + indexClose; string(got) != want {
+				t.Errorf("Index() =\n%s\nwant\n%s", got, want)
+			}
+		})
+	}
+}
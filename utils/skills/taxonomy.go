@@ -0,0 +1,190 @@
+// Package skills matches free-text skill names against a taxonomy of canonical
+// skills and their aliases, so "GoLang", "golang" and "Go lang" all become "Go".
+package skills
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Skill is one canonical skill
+type Skill struct {
+	Name     string   `json:"name"`
+	Category string   `json:"category"`
+	Aliases  []string `json:"aliases,omitempty"`
+}
+
+// Category lists the skills filed under it
+type Category struct {
+	Name   string   `json:"name"`
+	Skills []string `json:"skills"`
+}
+
+// entry is one searchable key, a skill's normalized name or one of its aliases
+type entry struct {
+	key   string
+	alias bool
+	skill *Skill
+}
+
+// Taxonomy is an immutable index of skills; refreshing builds a new one
+type Taxonomy struct {
+	skills     []*Skill
+	byKey      map[string]*Skill
+	entries    []entry // sorted by key for prefix search
+	categories []Category
+}
+
+// New indexes skills by normalized name and alias. When two skills claim the same
+// key, the one listed first keeps it.
+func New(skills []Skill) *Taxonomy {
+	t := &Taxonomy{byKey: make(map[string]*Skill, len(skills))}
+	byCategory := map[string][]string{}
+	for i := range skills {
+		skill := &skills[i]
+		key := Normalize(skill.Name)
+		if key == "" || t.byKey[key] != nil {
+			continue
+		}
+		t.skills = append(t.skills, skill)
+		t.byKey[key] = skill
+		t.entries = append(t.entries, entry{key: key, skill: skill})
+		for _, alias := range skill.Aliases {
+			aliasKey := Normalize(alias)
+			if aliasKey == "" || t.byKey[aliasKey] != nil {
+				continue
+			}
+			t.byKey[aliasKey] = skill
+			t.entries = append(t.entries, entry{key: aliasKey, alias: true, skill: skill})
+		}
+		category := skill.Category
+		if category == "" {
+			category = "Other"
+		}
+		byCategory[category] = append(byCategory[category], skill.Name)
+	}
+	sort.Slice(t.entries, func(i, j int) bool { return t.entries[i].key < t.entries[j].key })
+	for name, members := range byCategory {
+		sort.Strings(members)
+		t.categories = append(t.categories, Category{Name: name, Skills: members})
+	}
+	sort.Slice(t.categories, func(i, j int) bool { return t.categories[i].Name < t.categories[j].Name })
+	return t
+}
+
+// Normalize folds case and drops spaces and separators, so spelling variants share a key.
+// Symbols that distinguish skills, such as the + in C++ and the # in C#, are kept.
+func Normalize(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		switch {
+		case unicode.IsSpace(r), r == '-', r == '_', r == '.':
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// Len is the number of canonical skills
+func (t *Taxonomy) Len() int {
+	return len(t.skills)
+}
+
+// Lookup finds the canonical skill for a name or alias
+func (t *Taxonomy) Lookup(name string) (Skill, bool) {
+	skill, ok := t.byKey[Normalize(name)]
+	if !ok {
+		return Skill{}, false
+	}
+	return *skill, true
+}
+
+// Categories lists every category with its skills, both sorted by name
+func (t *Taxonomy) Categories() []Category {
+	return t.categories
+}
+
+// Suggest returns up to limit skills whose name or alias starts with query. Exact
+// matches come first, then matches on a canonical name, then shorter names. When
+// there are too few, names within one typo of the query's prefix are added.
+func (t *Taxonomy) Suggest(query string, limit int) []Skill {
+	q := Normalize(query)
+	if q == "" || limit <= 0 {
+		return []Skill{}
+	}
+	var matches []entry
+	for i := sort.Search(len(t.entries), func(i int) bool { return t.entries[i].key >= q }); i < len(t.entries); i++ {
+		if !strings.HasPrefix(t.entries[i].key, q) {
+			break
+		}
+		matches = append(matches, t.entries[i])
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		a, b := matches[i], matches[j]
+		if (a.key == q) != (b.key == q) {
+			return a.key == q
+		}
+		if a.alias != b.alias {
+			return !a.alias
+		}
+		return len(a.skill.Name) < len(b.skill.Name)
+	})
+
+	suggestions := []Skill{}
+	seen := map[*Skill]bool{}
+	add := func(skill *Skill) bool {
+		if !seen[skill] {
+			seen[skill] = true
+			suggestions = append(suggestions, *skill)
+		}
+		return len(suggestions) >= limit
+	}
+	for _, match := range matches {
+		if add(match.skill) {
+			return suggestions
+		}
+	}
+	// Short queries match too much with a typo allowed
+	if len(q) < 3 {
+		return suggestions
+	}
+	for _, e := range t.entries {
+		if typoPrefix(q, e.key) && add(e.skill) {
+			break
+		}
+	}
+	return suggestions
+}
+
+// typoPrefix reports whether some prefix of key is within one edit of q
+func typoPrefix(q, key string) bool {
+	for n := len(q) - 1; n <= len(q)+1; n++ {
+		if n <= len(key) && withinOneEdit(q, key[:n]) {
+			return true
+		}
+	}
+	return false
+}
+
+// withinOneEdit reports whether a and b differ by at most one insertion, deletion
+// or substitution
+func withinOneEdit(a, b string) bool {
+	if len(a) < len(b) {
+		a, b = b, a
+	}
+	if len(a)-len(b) > 1 {
+		return false
+	}
+	for i := 0; i < len(b); i++ {
+		if a[i] == b[i] {
+			continue
+		}
+		if len(a) == len(b) {
+			return a[i+1:] == b[i+1:]
+		}
+		return a[i+1:] == b[i:]
+	}
+	return true
+}
@@ -0,0 +1,163 @@
+// Package slo counts each route's requests, failures and latency violations per
+// minute in a ring buffer, so the last minutes can be summarized against the
+// route's objective without an external metrics system. Latencies are kept in a
+// log-scale histogram, so percentiles are approximate: a reported p99 is the upper
+// bound of the bin it fell in, at most 25% above the true value.
+package slo
+
+import (
+	"math/bits"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	// subBins splits each power of two of microseconds, bounding the percentile error
+	subBins = 4
+	// maxExponent is the power of two of microseconds (about 67s) from which on
+	// latencies share the last bin
+	maxExponent = 26
+	// bins holds 1µs to 3µs one per bin, then subBins per power of two
+	bins = subBins - 1 + (maxExponent-2)*subBins
+)
+
+// bucket is one minute of a route's outcomes
+type bucket struct {
+	minute    int64
+	requests  int64
+	errors    int64
+	slow      int64
+	latencies [bins]uint32
+}
+
+// route is the ring of a route's last minutes, indexed by minute modulo its length
+type route struct {
+	mutex   sync.Mutex
+	buckets []bucket
+}
+
+// Tracker records request outcomes per route
+type Tracker struct {
+	window int
+	routes sync.Map // route name -> *route
+	now    func() time.Time
+}
+
+// New returns a Tracker keeping window's worth of whole minutes, at least one
+func New(window time.Duration) *Tracker {
+	return &Tracker{window: max(int(window/time.Minute), 1), now: time.Now}
+}
+
+// Window is how far back the tracker can summarize
+func (t *Tracker) Window() time.Duration {
+	return time.Duration(t.window) * time.Minute
+}
+
+// Record counts one request to routeName that took latency. Failed requests count
+// against the error budget and slow ones against the latency target.
+func (t *Tracker) Record(routeName string, latency time.Duration, failed, slow bool) {
+	r, ok := t.routes.Load(routeName)
+	if !ok {
+		r, _ = t.routes.LoadOrStore(routeName, &route{buckets: make([]bucket, t.window)})
+	}
+	ring := r.(*route)
+	minute := t.now().Unix() / 60
+
+	ring.mutex.Lock()
+	b := &ring.buckets[minute%int64(len(ring.buckets))]
+	if b.minute != minute {
+		*b = bucket{minute: minute}
+	}
+	b.requests++
+	if failed {
+		b.errors++
+	}
+	if slow {
+		b.slow++
+	}
+	b.latencies[bin(latency)]++
+	ring.mutex.Unlock()
+}
+
+// Stats are a route's outcomes over the summarized minutes
+type Stats struct {
+	Route    string
+	Requests int64
+	Errors   int64
+	// Slow is how many requests took longer than the latency target
+	Slow int64
+	P50  time.Duration
+	P99  time.Duration
+}
+
+// Summary returns the outcomes of every route with requests in the last window,
+// by route name. A window longer than the tracker keeps is cut to it.
+func (t *Tracker) Summary(window time.Duration) []Stats {
+	minutes := min(max(int64(window/time.Minute), 1), int64(t.window))
+	oldest := t.now().Unix()/60 - minutes + 1
+
+	var summary []Stats
+	t.routes.Range(func(key, value any) bool {
+		ring := value.(*route)
+		stats := Stats{Route: key.(string)}
+		var latencies [bins]uint32
+		ring.mutex.Lock()
+		for i := range ring.buckets {
+			b := &ring.buckets[i]
+			if b.minute < oldest || b.requests == 0 {
+				continue
+			}
+			stats.Requests += b.requests
+			stats.Errors += b.errors
+			stats.Slow += b.slow
+			for j, count := range b.latencies {
+				latencies[j] += count
+			}
+		}
+		ring.mutex.Unlock()
+		if stats.Requests > 0 {
+			stats.P50 = percentile(&latencies, stats.Requests, 0.50)
+			stats.P99 = percentile(&latencies, stats.Requests, 0.99)
+			summary = append(summary, stats)
+		}
+		return true
+	})
+	sort.Slice(summary, func(i, j int) bool { return summary[i].Route < summary[j].Route })
+	return summary
+}
+
+// bin is the histogram bin of latency: subBins bins per power of two of microseconds
+func bin(latency time.Duration) int {
+	us := uint64(max(latency.Microseconds(), 1))
+	if us < subBins {
+		return int(us) - 1
+	}
+	exponent := bits.Len64(us) - 1
+	index := subBins - 1 + (exponent-2)*subBins + int(us>>(exponent-2))&(subBins-1)
+	return min(index, bins-1)
+}
+
+// binUpperBound is the largest latency that falls in bin index
+func binUpperBound(index int) time.Duration {
+	if index < subBins-1 {
+		return time.Duration(index+1) * time.Microsecond
+	}
+	index -= subBins - 1
+	exponent, sub := index/subBins+2, index%subBins
+	return time.Duration((subBins+sub+1)<<(exponent-2)-1) * time.Microsecond
+}
+
+// percentile is the upper bound of the bin the q-th fraction of requests falls in
+func percentile(latencies *[bins]uint32, requests int64, q float64) time.Duration {
+	rank := int64(q*float64(requests)+0.5) - 1
+	rank = max(rank, 0)
+	var seen int64
+	for i, count := range latencies {
+		seen += int64(count)
+		if seen > rank {
+			return binUpperBound(i)
+		}
+	}
+	return binUpperBound(bins - 1)
+}
@@ -0,0 +1,156 @@
+package slo
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestBin(t *testing.T) {
+	tests := []struct {
+		latency time.Duration
+		want    int
+	}{
+		{0, 0},
+		{time.Microsecond, 0},
+		{3 * time.Microsecond, 2},
+		{4 * time.Microsecond, 3},
+		{7 * time.Microsecond, 6},
+		{8 * time.Microsecond, 7},
+		{9 * time.Microsecond, 7},
+		{10 * time.Microsecond, 8},
+		{time.Hour, bins - 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.latency.String(), func(t *testing.T) {
+			if got := bin(tt.latency); got != tt.want {
+				t.Errorf("bin(%v) = %d, want %d", tt.latency, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBinUpperBound(t *testing.T) {
+	for _, latency := range []time.Duration{
+		time.Microsecond, 5 * time.Microsecond, 999 * time.Microsecond, 1500 * time.Microsecond,
+		37 * time.Millisecond, 250 * time.Millisecond, 2 * time.Second, 60 * time.Second,
+	} {
+		t.Run(latency.String(), func(t *testing.T) {
+			upper := binUpperBound(bin(latency))
+			if upper < latency || float64(upper) > 1.25*float64(latency) {
+				t.Errorf("binUpperBound(bin(%v)) = %v, want within 25%% above", latency, upper)
+			}
+			// Latencies from about 67s on share the last bin
+			if next := bin(upper + time.Microsecond); next != min(bin(latency)+1, bins-1) {
+				t.Errorf("%v is in bin %d, want the next bin %d", upper+time.Microsecond, next, bin(latency)+1)
+			}
+		})
+	}
+}
+
+type outcome struct {
+	route   string
+	minute  int
+	latency time.Duration
+	failed  bool
+	slow    bool
+}
+
+func TestTrackerSummary(t *testing.T) {
+	start := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		window   time.Duration
+		outcomes []outcome
+		at       int
+		summary  time.Duration
+		want     []Stats
+	}{
+		{
+			"routes sorted by name",
+			5 * time.Minute,
+			[]outcome{{"jobs_get", 0, 2 * time.Millisecond, false, false}, {"auth_login", 0, 40 * time.Millisecond, true, true}},
+			0, 5 * time.Minute,
+			[]Stats{
+				{Route: "auth_login", Requests: 1, Errors: 1, Slow: 1, P50: binUpperBound(bin(40 * time.Millisecond)), P99: binUpperBound(bin(40 * time.Millisecond))},
+				{Route: "jobs_get", Requests: 1, P50: binUpperBound(bin(2 * time.Millisecond)), P99: binUpperBound(bin(2 * time.Millisecond))},
+			},
+		},
+		{
+			"minutes outside the summary are left out",
+			5 * time.Minute,
+			[]outcome{{"jobs_get", 0, time.Millisecond, true, false}, {"jobs_get", 3, time.Millisecond, false, false}},
+			3, time.Minute,
+			[]Stats{{Route: "jobs_get", Requests: 1, P50: binUpperBound(bin(time.Millisecond)), P99: binUpperBound(bin(time.Millisecond))}},
+		},
+		{
+			"summary cut to the window",
+			2 * time.Minute,
+			[]outcome{{"jobs_get", 0, time.Millisecond, false, false}, {"jobs_get", 1, time.Millisecond, false, false}, {"jobs_get", 2, time.Millisecond, false, false}},
+			2, time.Hour,
+			[]Stats{{Route: "jobs_get", Requests: 2, P50: binUpperBound(bin(time.Millisecond)), P99: binUpperBound(bin(time.Millisecond))}},
+		},
+		{
+			"ring slots are reused",
+			2 * time.Minute,
+			[]outcome{{"jobs_get", 0, time.Millisecond, true, false}, {"jobs_get", 2, time.Millisecond, false, false}},
+			2, 2 * time.Minute,
+			[]Stats{{Route: "jobs_get", Requests: 1, P50: binUpperBound(bin(time.Millisecond)), P99: binUpperBound(bin(time.Millisecond))}},
+		},
+		{"nothing recorded lately", 5 * time.Minute, []outcome{{"jobs_get", 0, time.Millisecond, false, false}}, 10, 5 * time.Minute, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tracker := New(tt.window)
+			for _, o := range tt.outcomes {
+				tracker.now = func() time.Time { return start.Add(time.Duration(o.minute) * time.Minute) }
+				tracker.Record(o.route, o.latency, o.failed, o.slow)
+			}
+			tracker.now = func() time.Time { return start.Add(time.Duration(tt.at)*time.Minute + 30*time.Second) }
+			if got := tracker.Summary(tt.summary); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Summary() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPercentiles(t *testing.T) {
+	tracker := New(time.Minute)
+	for i := 1; i <= 100; i++ {
+		tracker.Record("jobs_get", time.Duration(i)*time.Millisecond, false, false)
+	}
+	stats := tracker.Summary(time.Minute)[0]
+	tests := []struct {
+		name string
+		got  time.Duration
+		want time.Duration
+	}{
+		{"p50", stats.P50, 50 * time.Millisecond},
+		{"p99", stats.P99, 99 * time.Millisecond},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got < tt.want || float64(tt.got) > 1.25*float64(tt.want) {
+				t.Errorf("%s = %v, want %v or up to 25%% above", tt.name, tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewWindow(t *testing.T) {
+	tests := []struct {
+		window time.Duration
+		want   time.Duration
+	}{
+		{15 * time.Minute, 15 * time.Minute},
+		{90 * time.Second, time.Minute},
+		{0, time.Minute},
+	}
+	for _, tt := range tests {
+		t.Run(tt.window.String(), func(t *testing.T) {
+			if got := New(tt.window).Window(); got != tt.want {
+				t.Errorf("New(%v).Window() = %v, want %v", tt.window, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+)
+
+// StatusValidator checks status strings against an allowlist, ignoring case and surrounding spaces
+type StatusValidator struct {
+	name    string
+	allowed []string
+	lookup  map[string]bool
+}
+
+// NewStatusValidator builds a validator for the given canonical (upper case) values
+func NewStatusValidator(name string, values []string) *StatusValidator {
+	v := &StatusValidator{name: name, lookup: make(map[string]bool, len(values))}
+	for _, value := range values {
+		value = strings.ToUpper(strings.TrimSpace(value))
+		if value == "" || v.lookup[value] {
+			continue
+		}
+		v.lookup[value] = true
+		v.allowed = append(v.allowed, value)
+	}
+	return v
+}
+
+// Normalize returns the canonical form of status or an error naming the accepted values
+func (v *StatusValidator) Normalize(status string) (string, error) {
+	normalized := strings.ToUpper(strings.TrimSpace(status))
+	if normalized == "" {
+		return "", fmt.Errorf("%s is required; accepted values: %s", v.name, strings.Join(v.allowed, ", "))
+	}
+	if !v.lookup[normalized] {
+		return "", fmt.Errorf("invalid %s %q; accepted values: %s", v.name, status, strings.Join(v.allowed, ", "))
+	}
+	return normalized, nil
+}
+
+// Allowed returns the accepted values in their configured order
+func (v *StatusValidator) Allowed() []string {
+	return append([]string(nil), v.allowed...)
+}
+
+var (
+	jobStatuses         = NewStatusValidator("job status", cfg.JobStatuses)
+	applicationStatuses = NewStatusValidator("application status", cfg.ApplicationStatuses)
+)
+
+// JobStatuses returns the statuses a job posting can be moved to (JOB_STATUSES)
+func JobStatuses() *StatusValidator {
+	return jobStatuses
+}
+
+// ApplicationStatuses returns the statuses an application can have (APPLICATION_STATUSES)
+func ApplicationStatuses() *StatusValidator {
+	return applicationStatuses
+}
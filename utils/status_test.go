@@ -0,0 +1,49 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestStatusValidator(t *testing.T) {
+	v := NewStatusValidator("job status", []string{"open", " Closed ", "OPEN", "", "draft"})
+	if got, want := v.Allowed(), []string{"OPEN", "CLOSED", "DRAFT"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("Allowed() = %v, want %v", got, want)
+	}
+
+	tests := []struct {
+		name    string
+		status  string
+		want    string
+		wantErr string
+	}{
+		{"canonical", "OPEN", "OPEN", ""},
+		{"lower case", "closed", "CLOSED", ""},
+		{"spaces", "  Draft\t", "DRAFT", ""},
+		{"empty", "", "", "job status is required; accepted values: OPEN, CLOSED, DRAFT"},
+		{"blank", "   ", "", "job status is required; accepted values: OPEN, CLOSED, DRAFT"},
+		{"unknown", "archived", "", `invalid job status "archived"; accepted values: OPEN, CLOSED, DRAFT`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := v.Normalize(tt.status)
+			if got != tt.want {
+				t.Errorf("Normalize(%q) = %q, want %q", tt.status, got, tt.want)
+			}
+			if tt.wantErr == "" && err != nil {
+				t.Errorf("Normalize(%q) error = %v", tt.status, err)
+			}
+			if tt.wantErr != "" && (err == nil || err.Error() != tt.wantErr) {
+				t.Errorf("Normalize(%q) error = %v, want %q", tt.status, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAllowedIsACopy(t *testing.T) {
+	v := NewStatusValidator("application status", []string{"APPLIED"})
+	v.Allowed()[0] = "HIRED"
+	if got := v.Allowed()[0]; got != "APPLIED" {
+		t.Errorf("Allowed()[0] = %q after modifying a copy", got)
+	}
+}
@@ -0,0 +1,196 @@
+// Package storage presigns requests to S3-compatible object storage (AWS S3,
+// MinIO) so clients upload large files directly instead of through the gateway.
+// Requests are signed with AWS Signature Version 4 using path-style URLs.
+package storage
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	signingAlgorithm = "AWS4-HMAC-SHA256"
+	unsignedPayload  = "UNSIGNED-PAYLOAD"
+	headTimeout      = 5 * time.Second
+)
+
+// ErrNotFound is returned by Stat when the object doesn't exist
+var ErrNotFound = errors.New("object not found")
+
+// Client signs requests for one bucket
+type Client struct {
+	endpoint  *url.URL
+	bucket    string
+	region    string
+	accessKey string
+	secretKey string
+	http      *http.Client
+}
+
+// New returns a client for bucket at endpoint, e.g. https://s3.eu-west-1.amazonaws.com
+// or http://localhost:9000 for MinIO
+func New(endpoint, bucket, region, accessKey, secretKey string) (*Client, error) {
+	parsed, err := url.Parse(strings.TrimSuffix(endpoint, "/"))
+	if err != nil || parsed.Scheme == "" || parsed.Host == "" {
+		return nil, fmt.Errorf("storage endpoint %q must be an absolute URL", endpoint)
+	}
+	return &Client{
+		endpoint:  parsed,
+		bucket:    bucket,
+		region:    region,
+		accessKey: accessKey,
+		secretKey: secretKey,
+		http:      &http.Client{Timeout: headTimeout},
+	}, nil
+}
+
+// PresignPut returns a URL that accepts one PUT of key for ttl. The upload must
+// send exactly the given Content-Type and Content-Length, which are signed.
+func (s *Client) PresignPut(key, contentType string, size int64, ttl time.Duration) string {
+	return s.presign(http.MethodPut, key, map[string]string{
+		"content-type":   contentType,
+		"content-length": strconv.FormatInt(size, 10),
+	}, ttl, time.Now().UTC())
+}
+
+// ObjectURL is key's unsigned path-style URL, for backends that read the bucket with their own credentials
+func (s *Client) ObjectURL(key string) string {
+	return s.endpoint.Scheme + "://" + s.endpoint.Host + s.endpoint.Path + "/" + escapePath(s.bucket) + "/" + escapePath(key)
+}
+
+// Object is what Stat learns about a stored object
+type Object struct {
+	Key         string
+	Size        int64
+	ContentType string
+}
+
+// Stat looks key up with a HEAD request, returning ErrNotFound if it isn't there
+func (s *Client) Stat(ctx context.Context, key string) (*Object, error) {
+	ctx, cancel := context.WithTimeout(ctx, headTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, s.presign(http.MethodHead, key, nil, time.Minute, time.Now().UTC()), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.http.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return nil, ErrNotFound
+	case resp.StatusCode != http.StatusOK:
+		return nil, fmt.Errorf("storage returned %d for %s", resp.StatusCode, key)
+	}
+	return &Object{Key: key, Size: resp.ContentLength, ContentType: resp.Header.Get("Content-Type")}, nil
+}
+
+// presign builds a query-string signed URL. headers (lowercase names) must be sent
+// as given; host is always signed.
+func (s *Client) presign(method, key string, headers map[string]string, ttl time.Duration, now time.Time) string {
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	scope := date + "/" + s.region + "/s3/aws4_request"
+
+	signed := map[string]string{"host": s.endpoint.Host}
+	for name, value := range headers {
+		signed[name] = strings.TrimSpace(value)
+	}
+	names := make([]string, 0, len(signed))
+	for name := range signed {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + signed[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	query := url.Values{}
+	query.Set("X-Amz-Algorithm", signingAlgorithm)
+	query.Set("X-Amz-Credential", s.accessKey+"/"+scope)
+	query.Set("X-Amz-Date", amzDate)
+	query.Set("X-Amz-Expires", strconv.Itoa(int(ttl.Seconds())))
+	query.Set("X-Amz-SignedHeaders", signedHeaders)
+	canonicalQuery := canonicalQueryString(query)
+
+	path := s.endpoint.Path + "/" + escapePath(s.bucket) + "/" + escapePath(key)
+	canonicalRequest := strings.Join([]string{
+		method,
+		path,
+		canonicalQuery,
+		canonicalHeaders.String(),
+		signedHeaders,
+		unsignedPayload,
+	}, "\n")
+	stringToSign := strings.Join([]string{signingAlgorithm, amzDate, scope, hexSHA256(canonicalRequest)}, "\n")
+
+	signingKey := hmacSHA256([]byte("AWS4"+s.secretKey), date)
+	signingKey = hmacSHA256(signingKey, s.region)
+	signingKey = hmacSHA256(signingKey, "s3")
+	signingKey = hmacSHA256(signingKey, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	return s.endpoint.Scheme + "://" + s.endpoint.Host + path + "?" + canonicalQuery + "&X-Amz-Signature=" + signature
+}
+
+// canonicalQueryString sorts by name and escapes names and values the SigV4 way
+func canonicalQueryString(query url.Values) string {
+	names := make([]string, 0, len(query))
+	for name := range query {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	pairs := make([]string, 0, len(names))
+	for _, name := range names {
+		pairs = append(pairs, escape(name, true)+"="+escape(query.Get(name), true))
+	}
+	return strings.Join(pairs, "&")
+}
+
+// escapePath escapes an object key, keeping its slashes
+func escapePath(path string) string {
+	return escape(path, false)
+}
+
+// escape percent-encodes everything but unreserved characters, and '/' unless encodeSlash
+func escape(s string, encodeSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9',
+			c == '-', c == '_', c == '.', c == '~':
+			b.WriteByte(c)
+		case c == '/' && !encodeSlash:
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+func hexSHA256(data string) string {
+	sum := sha256.Sum256([]byte(data))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,191 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+)
+
+// FieldError describes why a single request field was rejected. Code is set for
+// rules clients are expected to handle, such as the password policy.
+type FieldError struct {
+	Field   string `json:"field"`
+	Code    string `json:"code,omitempty"`
+	Message string `json:"message"`
+}
+
+var registerValidatorsOnce sync.Once
+
+// RegisterValidators installs the gateway's custom binding rules on gin's validator
+func RegisterValidators() {
+	registerValidatorsOnce.Do(func() {
+		v, ok := binding.Validator.Engine().(*validator.Validate)
+		if !ok {
+			log.Println("Warning: gin validator engine is not go-playground/validator, custom rules not registered")
+			return
+		}
+		// Report fields by their JSON names so clients can map errors back to their payload
+		v.RegisterTagNameFunc(func(field reflect.StructField) string {
+			name := strings.SplitN(field.Tag.Get("json"), ",", 2)[0]
+			if name == "-" || name == "" {
+				return field.Name
+			}
+			return name
+		})
+		v.RegisterValidation("job_category", func(fl validator.FieldLevel) bool {
+			_, ok := NormalizeJobCategory(fl.Field().String())
+			return ok
+		})
+		v.RegisterValidation("future_date", func(fl validator.FieldLevel) bool {
+			t, err := ParseDate(fl.Field().String())
+			return err == nil && t.After(time.Now())
+		})
+		v.RegisterValidation("job_deadline", func(fl validator.FieldLevel) bool {
+			return CheckJobDeadline(fl.Field().String()) == nil
+		})
+		v.RegisterValidation("no_profanity", func(fl validator.FieldLevel) bool {
+			return !ContainsProfanity(fl.Field().String())
+		})
+	})
+}
+
+// ParseDate accepts either a full RFC3339 timestamp or a plain YYYY-MM-DD date
+func ParseDate(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse("2006-01-02", value); err == nil {
+		// A bare date is treated as the end of that day
+		return t.Add(24*time.Hour - time.Second), nil
+	}
+	return time.Time{}, fmt.Errorf("invalid date %q, expected YYYY-MM-DD or RFC3339", value)
+}
+
+// DeadlineSkew is how far the clocks of clients, the gateway and the job service may
+// disagree about a deadline before it counts as passed
+const DeadlineSkew = 5 * time.Minute
+
+// CheckJobDeadline accepts an application deadline that is in the future, give or
+// take DeadlineSkew, and no further ahead than JOB_DEADLINE_MAX_AHEAD
+func CheckJobDeadline(value string) error {
+	t, err := ParseDate(value)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	if t.Before(now.Add(-DeadlineSkew)) {
+		return errors.New("deadline must be in the future")
+	}
+	if t.After(now.Add(cfg.JobDeadlineMaxAhead)) {
+		return fmt.Errorf("deadline must be within %d days", int(cfg.JobDeadlineMaxAhead.Hours()/24))
+	}
+	return nil
+}
+
+// DeadlinePassed reports whether a job's deadline is over, allowing DeadlineSkew.
+// An empty or unreadable deadline means the job has none.
+func DeadlinePassed(value string, now time.Time) bool {
+	if strings.TrimSpace(value) == "" {
+		return false
+	}
+	t, err := ParseDate(value)
+	return err == nil && now.After(t.Add(DeadlineSkew))
+}
+
+// JobCategories returns the categories a job can be posted under (JOB_CATEGORIES)
+func JobCategories() []string {
+	return append([]string(nil), cfg.JobCategories...)
+}
+
+// NormalizeJobCategory matches category case-insensitively and returns its canonical spelling
+func NormalizeJobCategory(category string) (string, bool) {
+	category = strings.TrimSpace(category)
+	for _, known := range JobCategories() {
+		if strings.EqualFold(known, category) {
+			return known, true
+		}
+	}
+	return "", false
+}
+
+// ValidationErrors turns a binding error into per-field messages.
+// Errors that aren't validation failures (e.g. malformed JSON) come back as a single entry with no field.
+func ValidationErrors(err error) []FieldError {
+	var bindErr *ProtoBindError
+	if errors.As(err, &bindErr) {
+		return bindErr.Fields
+	}
+	var verrs validator.ValidationErrors
+	if !errors.As(err, &verrs) {
+		return []FieldError{{Message: err.Error()}}
+	}
+	fields := make([]FieldError, 0, len(verrs))
+	for _, fe := range verrs {
+		fields = append(fields, FieldError{Field: fe.Field(), Message: fieldErrorMessage(fe)})
+	}
+	return fields
+}
+
+func fieldErrorMessage(fe validator.FieldError) string {
+	switch fe.Tag() {
+	case "required":
+		return fe.Field() + " is required"
+	case "min":
+		if fe.Kind() == reflect.String {
+			return fmt.Sprintf("%s must be at least %s characters", fe.Field(), fe.Param())
+		}
+		return fmt.Sprintf("%s must be at least %s", fe.Field(), fe.Param())
+	case "max":
+		if fe.Kind() == reflect.String {
+			return fmt.Sprintf("%s must be at most %s characters", fe.Field(), fe.Param())
+		}
+		return fmt.Sprintf("%s must be at most %s", fe.Field(), fe.Param())
+	case "oneof":
+		return fmt.Sprintf("%s must be one of: %s", fe.Field(), fe.Param())
+	case "job_category":
+		return fmt.Sprintf("%s must be one of: %s", fe.Field(), strings.Join(JobCategories(), ", "))
+	case "future_date":
+		return fe.Field() + " must be a future date (YYYY-MM-DD or RFC3339)"
+	case "job_deadline":
+		return fmt.Sprintf("%s must be a future date (YYYY-MM-DD or RFC3339) within %d days", fe.Field(), int(cfg.JobDeadlineMaxAhead.Hours()/24))
+	case "no_profanity":
+		return fe.Field() + " contains language that isn't allowed"
+	case "gtefield":
+		return fmt.Sprintf("%s cannot be less than %s", fe.Field(), snakeCase(fe.Param()))
+	default:
+		return fmt.Sprintf("%s failed the %s check", fe.Field(), fe.Tag())
+	}
+}
+
+// snakeCase converts a Go field name such as SalaryMin to its JSON form salary_min
+func snakeCase(name string) string {
+	var b strings.Builder
+	for i, r := range name {
+		if r >= 'A' && r <= 'Z' {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			r += 'a' - 'A'
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// RespondWithValidationError writes the shared 400 shape for rejected request bodies
+func RespondWithValidationError(c *gin.Context, err error) {
+	c.JSON(http.StatusBadRequest, gin.H{
+		"error":  "Validation failed",
+		"fields": ValidationErrors(err),
+	})
+}
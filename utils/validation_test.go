@@ -0,0 +1,62 @@
+package utils
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDeadlinePassed(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		deadline string
+		want     bool
+	}{
+		{"none", " ", false},
+		{"unreadable", "next week", false},
+		{"future", "2024-05-01T13:00:00Z", false},
+		{"within the skew", "2024-05-01T11:56:00Z", false},
+		{"past the skew", "2024-05-01T11:54:00Z", true},
+		{"date is open all day", "2024-05-01", false},
+		{"previous day", "2024-04-30", true},
+		{"offset", "2024-05-01T13:50:00+02:00", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DeadlinePassed(tt.deadline, now); got != tt.want {
+				t.Errorf("DeadlinePassed(%q) = %v, want %v", tt.deadline, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckJobDeadline(t *testing.T) {
+	previous := cfg.JobDeadlineMaxAhead
+	defer func() { cfg.JobDeadlineMaxAhead = previous }()
+	cfg.JobDeadlineMaxAhead = 90 * 24 * time.Hour
+
+	now := time.Now()
+	tests := []struct {
+		name     string
+		deadline string
+		wantErr  string
+	}{
+		{"next month", now.AddDate(0, 1, 0).Format("2006-01-02"), ""},
+		{"today", now.Format("2006-01-02"), ""},
+		{"just passed", now.Add(-time.Minute).Format(time.RFC3339), ""},
+		{"past", now.Add(-time.Hour).Format(time.RFC3339), "deadline must be in the future"},
+		{"too far ahead", now.AddDate(0, 0, 91).Format(time.RFC3339), "deadline must be within 90 days"},
+		{"unreadable", "31/12/2024", `invalid date "31/12/2024", expected YYYY-MM-DD or RFC3339`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckJobDeadline(tt.deadline)
+			if tt.wantErr == "" && err != nil {
+				t.Errorf("CheckJobDeadline(%q) error = %v", tt.deadline, err)
+			}
+			if tt.wantErr != "" && (err == nil || err.Error() != tt.wantErr) {
+				t.Errorf("CheckJobDeadline(%q) error = %v, want %q", tt.deadline, err, tt.wantErr)
+			}
+		})
+	}
+}
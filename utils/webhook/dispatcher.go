@@ -0,0 +1,186 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"time"
+)
+
+// SignatureHeader carries the hex HMAC-SHA256 of the request body, keyed with the endpoint secret
+const SignatureHeader = "X-SkillSync-Signature"
+
+// Event is a single occurrence delivered to every endpoint registered by its employer
+type Event struct {
+	ID         string      `json:"id"`
+	Type       string      `json:"type"`
+	CreatedAt  time.Time   `json:"created_at"`
+	EmployerID string      `json:"-"`
+	JobID      uint64      `json:"-"`
+	Data       interface{} `json:"data"`
+}
+
+// Endpoint is a registered receiver URL with its shared secret
+type Endpoint struct {
+	ID     string
+	URL    string
+	Secret string
+}
+
+// Resolver finds the endpoints an event should go to. It runs on the worker, never on the request path.
+type Resolver func(ctx context.Context, event *Event) ([]Endpoint, error)
+
+// Options tune the delivery workers
+type Options struct {
+	Workers     int
+	QueueSize   int
+	MaxAttempts int
+	BaseBackoff time.Duration
+	Timeout     time.Duration
+}
+
+// Dispatcher delivers events asynchronously through a bounded queue
+type Dispatcher struct {
+	resolve Resolver
+	opts    Options
+	queue   chan *Event
+	client  *http.Client
+}
+
+// NewDispatcher starts the worker goroutines and returns the dispatcher
+func NewDispatcher(resolve Resolver, opts Options) *Dispatcher {
+	if opts.Workers <= 0 {
+		opts.Workers = 4
+	}
+	if opts.QueueSize <= 0 {
+		opts.QueueSize = 1000
+	}
+	if opts.MaxAttempts <= 0 {
+		opts.MaxAttempts = 5
+	}
+	if opts.BaseBackoff <= 0 {
+		opts.BaseBackoff = time.Second
+	}
+	if opts.Timeout <= 0 {
+		opts.Timeout = 10 * time.Second
+	}
+	d := &Dispatcher{
+		resolve: resolve,
+		opts:    opts,
+		queue:   make(chan *Event, opts.QueueSize),
+		client:  &http.Client{Timeout: opts.Timeout},
+	}
+	for i := 0; i < opts.Workers; i++ {
+		go d.work()
+	}
+	return d
+}
+
+// NewEvent builds an event with a fresh ID
+func NewEvent(eventType string, data interface{}) *Event {
+	return &Event{ID: newID(), Type: eventType, CreatedAt: time.Now().UTC(), Data: data}
+}
+
+// Publish queues an event without blocking. A full queue drops the event to the dead-letter log.
+func (d *Dispatcher) Publish(event *Event) {
+	select {
+	case d.queue <- event:
+	default:
+		deadLetter(event, "", "delivery queue full")
+	}
+}
+
+// Send delivers an event to one endpoint once and returns the receiver's status code
+func (d *Dispatcher) Send(ctx context.Context, endpoint Endpoint, event *Event) (int, error) {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return 0, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-SkillSync-Event", event.Type)
+	req.Header.Set("X-SkillSync-Delivery", event.ID)
+	req.Header.Set(SignatureHeader, "sha256="+Sign(endpoint.Secret, body))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("receiver returned %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+// Sign returns the hex HMAC-SHA256 of body keyed with secret
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// NewSecret generates a random shared secret for a new endpoint
+func NewSecret() string {
+	return "whsec_" + newID() + newID()
+}
+
+func (d *Dispatcher) work() {
+	for event := range d.queue {
+		ctx, cancel := context.WithTimeout(context.Background(), d.opts.Timeout)
+		endpoints, err := d.resolve(ctx, event)
+		cancel()
+		if err != nil {
+			deadLetter(event, "", "resolving endpoints: "+err.Error())
+			continue
+		}
+		for _, endpoint := range endpoints {
+			d.deliver(endpoint, event)
+		}
+	}
+}
+
+// deliver retries with exponential backoff before giving up to the dead-letter log
+func (d *Dispatcher) deliver(endpoint Endpoint, event *Event) {
+	backoff := d.opts.BaseBackoff
+	var lastErr error
+	for attempt := 1; attempt <= d.opts.MaxAttempts; attempt++ {
+		_, lastErr = d.Send(context.Background(), endpoint, event)
+		if lastErr == nil {
+			return
+		}
+		log.Printf("Webhook: attempt %d/%d of %s to %s failed: %v", attempt, d.opts.MaxAttempts, event.ID, endpoint.URL, lastErr)
+		if attempt < d.opts.MaxAttempts {
+			time.Sleep(backoff)
+			backoff *= 2
+		}
+	}
+	deadLetter(event, endpoint.ID, lastErr.Error())
+}
+
+// deadLetter records an event that could not be delivered so it can be replayed by hand
+func deadLetter(event *Event, endpointID, reason string) {
+	payload, _ := json.Marshal(event)
+	log.Printf("Webhook dead letter: event=%s type=%s endpoint=%s reason=%q payload=%s",
+		event.ID, event.Type, endpointID, reason, payload)
+}
+
+func newID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return fmt.Sprintf("%d", time.Now().UnixNano())
+	}
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,114 @@
+// Package webpush sends browser push notifications to Web Push subscriptions,
+// signed with the gateway's VAPID keys and encrypted for each subscription as
+// RFC 8291 requires, by github.com/SherClockHolmes/webpush-go.
+package webpush
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	webpush "github.com/SherClockHolmes/webpush-go"
+)
+
+// ErrGone means the push service no longer knows the subscription, which should
+// be deleted
+var ErrGone = errors.New("push subscription expired or unsubscribed")
+
+// ttl is how long a push service keeps a notification for an offline browser
+const ttl = 24 * time.Hour
+
+// Subscription is what a browser's PushManager.subscribe returns
+type Subscription struct {
+	Endpoint string
+	P256dh   string
+	Auth     string
+}
+
+// Payload is all a push carries; the client fetches the details from the API
+type Payload struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+}
+
+// Options are the VAPID keys and contact pushes are sent with
+type Options struct {
+	PublicKey  string
+	PrivateKey string
+	Subject    string
+}
+
+// Sender pushes notifications to subscriptions
+type Sender struct {
+	options Options
+	client  webpush.HTTPClient
+}
+
+// New returns a Sender, or nil when the keys aren't set
+func New(options Options) *Sender {
+	if options.PublicKey == "" || options.PrivateKey == "" {
+		return nil
+	}
+	return &Sender{options: options}
+}
+
+// PublicKey is the VAPID key browsers subscribe with (applicationServerKey)
+func (s *Sender) PublicKey() string {
+	return s.options.PublicKey
+}
+
+// Send pushes payload to subscription. It returns ErrGone when the push service
+// answers 404 or 410.
+func (s *Sender) Send(ctx context.Context, subscription Subscription, payload Payload) error {
+	message, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	resp, err := webpush.SendNotificationWithContext(ctx, message, &webpush.Subscription{
+		Endpoint: subscription.Endpoint,
+		Keys:     webpush.Keys{P256dh: subscription.P256dh, Auth: subscription.Auth},
+	}, &webpush.Options{
+		HTTPClient: s.client,
+		// The library adds mailto: itself unless the subject is an https URL
+		Subscriber:      strings.TrimPrefix(s.options.Subject, "mailto:"),
+		VAPIDPublicKey:  s.options.PublicKey,
+		VAPIDPrivateKey: s.options.PrivateKey,
+		TTL:             int(ttl.Seconds()),
+		Urgency:         webpush.UrgencyHigh,
+	})
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4<<10))
+	switch {
+	case resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusGone:
+		return ErrGone
+	case resp.StatusCode >= 400:
+		return fmt.Errorf("push service answered %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// AllowedEndpoint reports whether endpoint is an https URL on one of hosts or a
+// subdomain of one, so the gateway only ever posts to known push services
+func AllowedEndpoint(endpoint string, hosts []string) bool {
+	parsed, err := url.Parse(endpoint)
+	if err != nil || parsed.Scheme != "https" || parsed.User != nil || parsed.Port() != "" {
+		return false
+	}
+	host := strings.ToLower(parsed.Hostname())
+	for _, allowed := range hosts {
+		allowed = strings.ToLower(strings.TrimSpace(allowed))
+		if allowed != "" && (host == allowed || strings.HasSuffix(host, "."+allowed)) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,145 @@
+package webpush
+
+import (
+	"context"
+	"crypto/ecdh"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	webpush "github.com/SherClockHolmes/webpush-go"
+)
+
+func testSubscription(t *testing.T, endpoint string) Subscription {
+	t.Helper()
+	key, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	auth := make([]byte, 16)
+	if _, err := rand.Read(auth); err != nil {
+		t.Fatal(err)
+	}
+	return Subscription{
+		Endpoint: endpoint,
+		P256dh:   base64.RawURLEncoding.EncodeToString(key.PublicKey().Bytes()),
+		Auth:     base64.RawURLEncoding.EncodeToString(auth),
+	}
+}
+
+func testSender(t *testing.T) *Sender {
+	t.Helper()
+	private, public, err := webpush.GenerateVAPIDKeys()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sender := New(Options{PublicKey: public, PrivateKey: private, Subject: "mailto:ops@example.com"})
+	if sender == nil {
+		t.Fatal("New returned nil with both keys set")
+	}
+	return sender
+}
+
+func TestNewNeedsBothKeys(t *testing.T) {
+	tests := []struct {
+		name    string
+		options Options
+		want    bool
+	}{
+		{"both", Options{PublicKey: "pub", PrivateKey: "priv"}, true},
+		{"no public", Options{PrivateKey: "priv"}, false},
+		{"no private", Options{PublicKey: "pub"}, false},
+		{"none", Options{}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := New(tt.options) != nil; got != tt.want {
+				t.Errorf("New() != nil = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSend(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		wantErr bool
+		gone    bool
+	}{
+		{"created", http.StatusCreated, false, false},
+		{"not found", http.StatusNotFound, true, true},
+		{"gone", http.StatusGone, true, true},
+		{"server error", http.StatusInternalServerError, true, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got *http.Request
+			server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got = r
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+			sender := testSender(t)
+			sender.client = server.Client()
+
+			err := sender.Send(context.Background(), testSubscription(t, server.URL+"/push/abc"), Payload{Type: "chat_message", ID: "c1"})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Send() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if errors.Is(err, ErrGone) != tt.gone {
+				t.Errorf("Send() error = %v, want ErrGone %v", err, tt.gone)
+			}
+			if got == nil {
+				t.Fatal("push service got no request")
+			}
+			if got.Method != http.MethodPost || got.URL.Path != "/push/abc" {
+				t.Errorf("request = %s %s, want POST /push/abc", got.Method, got.URL.Path)
+			}
+			if got.Header.Get("Content-Encoding") != "aes128gcm" {
+				t.Errorf("Content-Encoding = %q, want aes128gcm", got.Header.Get("Content-Encoding"))
+			}
+			if got.Header.Get("TTL") != "86400" {
+				t.Errorf("TTL = %q, want 86400", got.Header.Get("TTL"))
+			}
+			if got.Header.Get("Urgency") != "high" {
+				t.Errorf("Urgency = %q, want high", got.Header.Get("Urgency"))
+			}
+			if auth := got.Header.Get("Authorization"); !strings.HasPrefix(auth, "vapid t=") || !strings.Contains(auth, ", k="+sender.PublicKey()) {
+				t.Errorf("Authorization = %q, want a VAPID header with the public key", auth)
+			}
+		})
+	}
+}
+
+func TestAllowedEndpoint(t *testing.T) {
+	hosts := []string{"fcm.googleapis.com", " push.services.mozilla.com ", ""}
+	tests := []struct {
+		name     string
+		endpoint string
+		want     bool
+	}{
+		{"exact host", "https://fcm.googleapis.com/fcm/send/abc", true},
+		{"subdomain", "https://updates.push.services.mozilla.com/wpush/v2/abc", true},
+		{"case insensitive", "https://FCM.googleapis.com/fcm/send/abc", true},
+		{"http", "http://fcm.googleapis.com/fcm/send/abc", false},
+		{"port", "https://fcm.googleapis.com:8443/fcm/send/abc", false},
+		{"userinfo", "https://user@fcm.googleapis.com/fcm/send/abc", false},
+		{"suffix without dot", "https://evilfcm.googleapis.com/abc", false},
+		{"lookalike", "https://fcm.googleapis.com.evil.example/abc", false},
+		{"other host", "https://example.com/push", false},
+		{"not a url", "://", false},
+		{"empty", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := AllowedEndpoint(tt.endpoint, hosts); got != tt.want {
+				t.Errorf("AllowedEndpoint(%q) = %v, want %v", tt.endpoint, got, tt.want)
+			}
+		})
+	}
+}
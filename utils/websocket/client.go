@@ -1,8 +1,6 @@
 package websocket
 
 import (
-	"bytes"
-	"encoding/json"
 	"log"
 	"time"
 
@@ -19,56 +17,52 @@ const (
 	// Send pings to peer with this period (must be less than pongWait)
 	pingPeriod = (pongWait * 9) / 10
 
-	// Maximum message size allowed from peer
-	maxMessageSize = 10000
+	// Maximum frame size allowed from peer; larger frames close the connection
+	maxMessageSize = 16 << 10
 )
 
-var (
-	newline = []byte{'\n'}
-	space   = []byte{' '}
-)
+var newline = []byte{'\n'}
 
-// ReadPump pumps messages from the WebSocket connection to the manager
+// ReadPump reads frames from the WebSocket connection and dispatches them by type.
+// Binary frames close the connection, and so do maxInvalidFrames refused frames in
+// a row; each refused frame is answered with an error frame.
 func (c *Client) ReadPump() {
 	defer func() {
-		c.Manager.unregister <- c
+		c.Manager.unregisterClient(c)
 		c.Conn.Close()
 	}()
 
 	c.Conn.SetReadLimit(maxMessageSize)
 	c.Conn.SetReadDeadline(time.Now().Add(pongWait))
-	c.Conn.SetPongHandler(func(string) error { 
+	c.Conn.SetPongHandler(func(string) error {
 		c.Conn.SetReadDeadline(time.Now().Add(pongWait))
-		return nil 
+		return nil
 	})
 
 	for {
-		_, message, err := c.Conn.ReadMessage()
+		messageType, frame, err := c.Conn.ReadMessage()
 		if err != nil {
 			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseAbnormalClosure) {
 				log.Printf("error: %v", err)
 			}
 			break
 		}
-		message = bytes.TrimSpace(bytes.Replace(message, newline, space, -1))
-		
-		// Parse the message
-		var msg Message
-		if err := json.Unmarshal(message, &msg); err != nil {
-			log.Printf("Error unmarshaling message: %v", err)
+		if messageType == websocket.BinaryMessage {
+			metrics.Add("closed_binary_frames", 1)
+			closeWith(c.Conn, websocket.CloseUnsupportedData, "binary frames are not supported")
+			break
+		}
+
+		if err := c.Manager.dispatch(c, frame); err != nil {
+			if c.Manager.refuse(c, err) {
+				metrics.Add("closed_invalid_frames", 1)
+				log.Printf("Disconnecting client %s: %d invalid frames in a row", c.ID, c.invalidFrames)
+				closeWith(c.Conn, websocket.ClosePolicyViolation, "too many invalid frames")
+				break
+			}
 			continue
 		}
-		
-		// Set sender information
-		msg.SenderID = c.ID
-		msg.SenderRole = c.Role
-		msg.SentTime = time.Now().Format("15:04:05") // HH:MM:SS format
-		
-		// Broadcast the message
-		c.Manager.broadcast <- &msg
-		
-		// TODO: Save the message to the database
-		// This will be implemented in the next step
+		c.invalidFrames = 0
 	}
 }
 
@@ -106,6 +100,7 @@ func (c *Client) WritePump() {
 			if err := w.Close(); err != nil {
 				return
 			}
+			c.written.Add(int64(n + 1))
 		case <-ticker.C:
 			c.Conn.SetWriteDeadline(time.Now().Add(writeWait))
 			if err := c.Conn.WriteMessage(websocket.PingMessage, nil); err != nil {
@@ -0,0 +1,199 @@
+package websocket
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"skillsync-api-gateway/utils/chatlimit"
+)
+
+const (
+	// maxContentLength caps a chat message's content, in characters
+	maxContentLength = 5000
+	// maxIDLength caps the receiver and conversation IDs a frame names
+	maxIDLength = 64
+	// maxMetadataEntries caps a frame's metadata
+	maxMetadataEntries = 10
+	// maxInvalidFrames in a row close the connection
+	maxInvalidFrames = 5
+)
+
+// EventHandler handles a validated frame from client. The sender fields are
+// already set from the connection, whatever the frame claimed.
+type EventHandler func(client *Client, msg *Message)
+
+// eventSchema lists what a frame of one type must carry
+type eventSchema struct {
+	receiver     bool
+	conversation bool
+	content      bool
+}
+
+// eventSchemas are checked before a frame reaches its handler. Types registered
+// with On but not listed here only need to be valid JSON.
+var eventSchemas = map[string]eventSchema{
+	"message":      {receiver: true, content: true},
+	"typing_start": {receiver: true},
+	"typing_stop":  {receiver: true},
+	"read_receipt": {receiver: true, conversation: true},
+	"ping":         {},
+}
+
+// frameError is why a frame was refused; it is sent back as an error frame
+type frameError struct {
+	Type    string `json:"type"`
+	Code    string `json:"error_code"`
+	Message string `json:"error"`
+	// RetryAfter is set on frames refused by the send limiter
+	RetryAfter int `json:"retry_after_seconds,omitempty"`
+}
+
+func (e *frameError) Error() string { return e.Code + ": " + e.Message }
+
+func invalidFrame(code, format string, args ...interface{}) *frameError {
+	return &frameError{Type: "error", Code: code, Message: fmt.Sprintf(format, args...)}
+}
+
+// On registers fn for frames of eventType, replacing any handler it had. Frames of
+// types without a handler are refused.
+func (m *Manager) On(eventType string, fn EventHandler) {
+	m.handlersMutex.Lock()
+	defer m.handlersMutex.Unlock()
+	m.handlers[eventType] = fn
+}
+
+func (m *Manager) handler(eventType string) (EventHandler, bool) {
+	m.handlersMutex.RLock()
+	defer m.handlersMutex.RUnlock()
+	fn, ok := m.handlers[eventType]
+	return fn, ok
+}
+
+// registerDefaultHandlers relays chat events to their receiver and answers pings
+func (m *Manager) registerDefaultHandlers() {
+	for _, eventType := range []string{"message", "typing_start", "typing_stop", "read_receipt"} {
+		m.On(eventType, m.relay)
+	}
+	m.On("ping", func(client *Client, msg *Message) {
+		m.reply(client, &Message{Type: "pong", SentTime: msg.SentTime})
+	})
+}
+
+// relay passes a frame on to its receiver, unless either user blocked the other.
+// Messages over the sender's budget are refused with an error frame.
+func (m *Manager) relay(client *Client, msg *Message) {
+	if msg.Type == "message" {
+		if decision := m.sendLimiter.Allow(msg.SenderID, msg.ReceiverID); !decision.Allowed {
+			metrics.Add("throttled_frames", 1)
+			m.reply(client, throttledFrame(decision))
+			return
+		}
+	}
+	if m.isBlocked(msg.SenderID, msg.ReceiverID) {
+		// Frames between users who blocked each other are dropped, not relayed
+		return
+	}
+	m.broadcast <- msg
+}
+
+// dispatch decodes and validates a frame from client and hands it to the handler
+// for its type
+func (m *Manager) dispatch(client *Client, frame []byte) error {
+	var msg Message
+	if err := json.Unmarshal(frame, &msg); err != nil {
+		return invalidFrame("invalid_json", "frame must be a JSON object")
+	}
+	msg.Type = strings.TrimSpace(msg.Type)
+	if msg.Type == "" {
+		return invalidFrame("missing_type", "frame must have a type")
+	}
+	fn, ok := m.handler(msg.Type)
+	if !ok {
+		return invalidFrame("unknown_type", "unknown frame type %q", msg.Type)
+	}
+	if err := validateFrame(&msg); err != nil {
+		return err
+	}
+
+	msg.SenderID = client.ID
+	msg.SenderRole = client.Role
+	msg.SentTime = time.Now().Format("15:04:05") // HH:MM:SS format
+	fn(client, &msg)
+	return nil
+}
+
+// validateFrame checks msg against its type's schema and the limits every frame shares
+func validateFrame(msg *Message) error {
+	schema := eventSchemas[msg.Type]
+	msg.ReceiverID = strings.TrimSpace(msg.ReceiverID)
+	msg.ConversationID = strings.TrimSpace(msg.ConversationID)
+	if schema.receiver && msg.ReceiverID == "" {
+		return invalidFrame("invalid_frame", "%s frames need a receiver_id", msg.Type)
+	}
+	if schema.conversation && msg.ConversationID == "" {
+		return invalidFrame("invalid_frame", "%s frames need a conversation_id", msg.Type)
+	}
+	if schema.content && strings.TrimSpace(msg.Content) == "" {
+		return invalidFrame("invalid_frame", "%s frames need content", msg.Type)
+	}
+	if len(msg.ReceiverID) > maxIDLength || len(msg.ConversationID) > maxIDLength {
+		return invalidFrame("invalid_frame", "receiver_id and conversation_id must be at most %d characters", maxIDLength)
+	}
+	if utf8.RuneCountInString(msg.Content) > maxContentLength {
+		return invalidFrame("invalid_frame", "content must be at most %d characters", maxContentLength)
+	}
+	if len(msg.Metadata) > maxMetadataEntries {
+		return invalidFrame("invalid_frame", "metadata must have at most %d entries", maxMetadataEntries)
+	}
+	return nil
+}
+
+// throttledFrame tells a client its message was refused by the send limiter
+func throttledFrame(decision chatlimit.Decision) *frameError {
+	message := "You are sending messages too fast, please slow down"
+	if decision.Reason == chatlimit.Muted {
+		message = "You sent too many messages and can't send more for a while"
+	}
+	return &frameError{
+		Type:       "error",
+		Code:       decision.Reason,
+		Message:    message,
+		RetryAfter: int(decision.RetryAfter.Seconds()) + 1,
+	}
+}
+
+// reply sends a frame back to one connection, dropping it if the client is gone
+// or not keeping up
+func (m *Manager) reply(client *Client, frame interface{}) {
+	data, err := json.Marshal(frame)
+	if err != nil {
+		return
+	}
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	// Send is closed once the client is unregistered
+	if !m.clients[client.ID][client] {
+		return
+	}
+	select {
+	case client.Send <- data:
+	default:
+	}
+}
+
+// refuse answers a frame that failed dispatch and reports whether the client has
+// now sent too many bad frames in a row
+func (m *Manager) refuse(client *Client, err error) bool {
+	var refused *frameError
+	if !errors.As(err, &refused) {
+		refused = invalidFrame("invalid_frame", "%v", err)
+	}
+	metrics.Add("invalid_frames_"+refused.Code, 1)
+	m.reply(client, refused)
+	client.invalidFrames++
+	return client.invalidFrames >= maxInvalidFrames
+}
@@ -0,0 +1,195 @@
+package websocket
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"skillsync-api-gateway/utils/chatlimit"
+)
+
+// dialTestClient connects userID to a manager through the real pumps
+func dialTestClient(t *testing.T, m *Manager, userID string) *websocket.Conn {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		client, err := m.Upgrade(w, r, userID, "candidate")
+		if err != nil {
+			return
+		}
+		go client.WritePump()
+		client.ReadPump()
+	}))
+	t.Cleanup(server.Close)
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+// readFrames reads the frames the server answers with until it has want of them
+// or the connection closes, and returns them with the close code, if any
+func readFrames(t *testing.T, conn *websocket.Conn, want int) ([]frameError, int) {
+	t.Helper()
+	var frames []frameError
+	conn.SetReadDeadline(time.Now().Add(2 * time.Second))
+	for len(frames) < want {
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			var closed *websocket.CloseError
+			if errors.As(err, &closed) {
+				return frames, closed.Code
+			}
+			t.Fatalf("read: %v", err)
+		}
+		// The write pump joins queued frames with newlines
+		for _, line := range bytes.Split(data, newline) {
+			var frame frameError
+			if err := json.Unmarshal(line, &frame); err != nil {
+				t.Fatalf("frame %s is not JSON: %v", line, err)
+			}
+			frames = append(frames, frame)
+		}
+	}
+	return frames, 0
+}
+
+func TestReadPumpFrames(t *testing.T) {
+	type frame struct {
+		messageType int
+		data        string
+	}
+	text := func(data string) frame { return frame{websocket.TextMessage, data} }
+	tooManyMetadata := map[string]interface{}{"type": "message", "receiver_id": "u2", "content": "hi", "metadata": map[string]string{}}
+	for i := 0; i <= maxMetadataEntries; i++ {
+		tooManyMetadata["metadata"].(map[string]string)[strings.Repeat("k", i+1)] = "v"
+	}
+	metadataFrame, _ := json.Marshal(tooManyMetadata)
+
+	tests := []struct {
+		name      string
+		frames    []frame
+		wantCodes []string
+		wantClose int
+	}{
+		{"not json", []frame{text("{")}, []string{"invalid_json"}, 0},
+		{"not an object", []frame{text(`["message"]`)}, []string{"invalid_json"}, 0},
+		{"wrong field type", []frame{text(`{"type":"message","content":42}`)}, []string{"invalid_json"}, 0},
+		{"missing type", []frame{text(`{"content":"hi"}`)}, []string{"missing_type"}, 0},
+		{"blank type", []frame{text(`{"type":"  "}`)}, []string{"missing_type"}, 0},
+		{"unknown type", []frame{text(`{"type":"dance"}`)}, []string{"unknown_type"}, 0},
+		{"message without receiver", []frame{text(`{"type":"message","content":"hi"}`)}, []string{"invalid_frame"}, 0},
+		{"message without content", []frame{text(`{"type":"message","receiver_id":"u2","content":" "}`)}, []string{"invalid_frame"}, 0},
+		{"read receipt without conversation", []frame{text(`{"type":"read_receipt","receiver_id":"u2"}`)}, []string{"invalid_frame"}, 0},
+		{"receiver too long", []frame{text(`{"type":"typing_start","receiver_id":"` + strings.Repeat("u", maxIDLength+1) + `"}`)}, []string{"invalid_frame"}, 0},
+		{"content too long", []frame{text(`{"type":"message","receiver_id":"u2","content":"` + strings.Repeat("é", maxContentLength+1) + `"}`)}, []string{"invalid_frame"}, 0},
+		{"too much metadata", []frame{text(string(metadataFrame))}, []string{"invalid_frame"}, 0},
+		{"valid frame", []frame{text(`{"type":"ping"}`)}, []string{""}, 0},
+		{"binary frame", []frame{{websocket.BinaryMessage, `{"type":"ping"}`}}, nil, websocket.CloseUnsupportedData},
+		{"oversized frame", []frame{text(`{"type":"ping","content":"` + strings.Repeat("x", maxMessageSize) + `"}`)}, nil, websocket.CloseMessageTooBig},
+		// The error frames queued before the close may not be written
+		{"too many invalid frames", []frame{text("{"), text("{"), text("{"), text("{"), text("{")}, nil, websocket.ClosePolicyViolation},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := testManager(Options{MaxConnectionsPerUser: 1, MaxConnections: 1, SendBuffer: 16})
+			m.registerDefaultHandlers()
+			conn := dialTestClient(t, m, "u1")
+
+			for _, f := range tt.frames {
+				if err := conn.WriteMessage(f.messageType, []byte(f.data)); err != nil {
+					t.Fatalf("write: %v", err)
+				}
+			}
+			wantFrames := len(tt.wantCodes)
+			if tt.wantClose != 0 {
+				// Read until the close, whatever error frames come first
+				wantFrames = len(tt.frames) + 1
+			}
+			frames, closeCode := readFrames(t, conn, wantFrames)
+			if closeCode != tt.wantClose {
+				t.Errorf("close code = %d, want %d", closeCode, tt.wantClose)
+			}
+			if len(frames) < len(tt.wantCodes) {
+				t.Fatalf("got %d frames %+v, want %d", len(frames), frames, len(tt.wantCodes))
+			}
+			for i, want := range tt.wantCodes {
+				got := frames[i]
+				if want == "" {
+					if got.Type != "pong" {
+						t.Errorf("frame %d = %+v, want a pong", i, got)
+					}
+					continue
+				}
+				if got.Type != "error" || got.Code != want || got.Message == "" {
+					t.Errorf("frame %d = %+v, want an error frame with code %s", i, got, want)
+				}
+			}
+		})
+	}
+}
+
+// TestRelayFlood floods message frames from one connection and checks the frames
+// over the budget are answered with the limiter's error frame, while another
+// user's messages are still relayed
+func TestRelayFlood(t *testing.T) {
+	m := testManager(Options{MaxConnectionsPerUser: 1, MaxConnections: 3, SendBuffer: 16})
+	m.broadcast = make(chan *Message)
+	m.registerDefaultHandlers()
+	m.SetSendLimiter(chatlimit.New(chatlimit.Options{PerSender: 3, PerRecipient: 3, FloodThreshold: 100, FloodWindow: time.Minute}))
+	go m.Start()
+	t.Cleanup(func() { close(m.broadcast) })
+
+	receiver := &Client{ID: "u2", Send: make(chan []byte, 16), Manager: m}
+	if err := m.RegisterClient(receiver); err != nil {
+		t.Fatal(err)
+	}
+	relayed := func(sender string) int {
+		count := 0
+		for {
+			select {
+			case data := <-receiver.Send:
+				var msg Message
+				json.Unmarshal(data, &msg)
+				if msg.SenderID == sender {
+					count++
+				}
+			case <-time.After(100 * time.Millisecond):
+				return count
+			}
+		}
+	}
+
+	flooder := dialTestClient(t, m, "u1")
+	for i := 0; i < 5; i++ {
+		if err := flooder.WriteMessage(websocket.TextMessage, []byte(`{"type":"message","receiver_id":"u2","content":"hi"}`)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	frames, _ := readFrames(t, flooder, 2)
+	for i, frame := range frames {
+		if frame.Type != "error" || frame.Code != chatlimit.SenderLimit || frame.RetryAfter < 1 {
+			t.Errorf("frame %d = %+v, want a %s error frame with a retry time", i, frame, chatlimit.SenderLimit)
+		}
+	}
+	if n := relayed("u1"); n != 3 {
+		t.Errorf("%d of the flooder's messages relayed, want 3", n)
+	}
+
+	other := dialTestClient(t, m, "u3")
+	if err := other.WriteMessage(websocket.TextMessage, []byte(`{"type":"message","receiver_id":"u2","content":"hello"}`)); err != nil {
+		t.Fatal(err)
+	}
+	if n := relayed("u3"); n != 1 {
+		t.Errorf("%d of the other user's messages relayed, want 1", n)
+	}
+}
@@ -2,10 +2,19 @@ package websocket
 
 import (
 	"encoding/json"
+	"errors"
+	"expvar"
 	"log"
+	"net/http"
+	"net/url"
+	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/gorilla/websocket"
+
+	"skillsync-api-gateway/utils/chatlimit"
 )
 
 // Client represents a connected WebSocket client
@@ -16,15 +25,70 @@ type Client struct {
 	Send     chan []byte
 	Manager  *Manager
 	UserInfo map[string]string // Store additional user info like name, etc.
+
+	// written counts messages WritePump got through, so a stalled reader can be told
+	// apart from a busy one
+	written   atomic.Int64
+	slowMutex sync.Mutex
+	slowTimer *time.Timer
+
+	// invalidFrames counts refused frames in a row; only ReadPump touches it
+	invalidFrames int
+}
+
+// Options bound the connections a Manager accepts
+type Options struct {
+	// MaxConnectionsPerUser and MaxConnections cap open connections per user and in total
+	MaxConnectionsPerUser int
+	MaxConnections        int
+	// SendBuffer is the size of each client's Send channel
+	SendBuffer int
+	// SlowClientTimeout is how long a client's Send channel may stay full, with
+	// nothing written to the peer, before the client is disconnected
+	SlowClientTimeout time.Duration
+	// AllowedOrigins are matched against the Origin header on upgrade; "*" allows any
+	AllowedOrigins []string
+}
+
+// DefaultOptions apply until Configure is called
+var DefaultOptions = Options{
+	MaxConnectionsPerUser: 5,
+	MaxConnections:        10000,
+	SendBuffer:            256,
+	SlowClientTimeout:     10 * time.Second,
+	AllowedOrigins:        []string{"*"},
 }
 
+var (
+	ErrUserConnectionLimit = errors.New("too many connections for this user")
+	ErrConnectionLimit     = errors.New("too many connections")
+)
+
+// metrics counts rejected upgrades by reason and clients dropped for not reading
+var metrics = expvar.NewMap("websocket")
+
 // Manager manages WebSocket connections
 type Manager struct {
-	clients    map[string]*Client
-	register   chan *Client
-	unregister chan *Client
-	broadcast  chan *Message
-	mutex      sync.RWMutex
+	// clients holds every open connection per user ID
+	clients     map[string]map[*Client]bool
+	connections int
+	options     Options
+	broadcast   chan *Message
+	mutex       sync.RWMutex
+
+	// muted holds, per user, the conversations they don't want pushes for
+	muted      map[string]map[string]bool
+	mutedMutex sync.RWMutex
+
+	// blocked reports whether either user blocked the other; nil allows everything
+	blocked func(senderID, receiverID string) bool
+
+	// sendLimiter caps message frames; it is shared with the REST send endpoint
+	sendLimiter *chatlimit.Limiter
+
+	// handlers dispatch incoming frames by type, see On
+	handlers      map[string]EventHandler
+	handlersMutex sync.RWMutex
 }
 
 // Message represents a chat message
@@ -47,11 +111,13 @@ var managerOnce sync.Once
 func NewManager() *Manager {
 	managerOnce.Do(func() {
 		globalManager = &Manager{
-			clients:    make(map[string]*Client),
-			register:   make(chan *Client),
-			unregister: make(chan *Client),
-			broadcast:  make(chan *Message),
+			clients:   make(map[string]map[*Client]bool),
+			options:   DefaultOptions,
+			broadcast: make(chan *Message),
+			muted:     make(map[string]map[string]bool),
+			handlers:  make(map[string]EventHandler),
 		}
+		globalManager.registerDefaultHandlers()
 		// Start the manager in a goroutine
 		go globalManager.Start()
 	})
@@ -66,91 +132,305 @@ func GetManager() *Manager {
 	return globalManager
 }
 
+// Configure replaces the connection limits. Connections already open are kept
+// even if they exceed the new limits.
+func (m *Manager) Configure(options Options) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.options = options
+}
+
 // Start starts the WebSocket manager
 func (m *Manager) Start() {
-	for {
-		select {
-		case client := <-m.register:
-			m.mutex.Lock()
-			m.clients[client.ID] = client
-			m.mutex.Unlock()
-			log.Printf("Client connected: %s (%s)", client.ID, client.Role)
-		
-		case client := <-m.unregister:
-			if _, ok := m.clients[client.ID]; ok {
-				m.mutex.Lock()
-				delete(m.clients, client.ID)
-				close(client.Send)
-				m.mutex.Unlock()
-				log.Printf("Client disconnected: %s", client.ID)
-			}
-		
-		case message := <-m.broadcast:
-			// Send message to specific user
-			if message.ReceiverID != "" {
-				m.mutex.RLock()
-				if client, ok := m.clients[message.ReceiverID]; ok {
-					// Marshal the message to JSON
-					jsonMessage, err := json.Marshal(message)
-					if err != nil {
-						log.Printf("Error marshaling message: %v", err)
-						continue
-					}
-					
-					select {
-					case client.Send <- jsonMessage:
-						log.Printf("Message sent to client %s", client.ID)
-					default:
-						m.mutex.RUnlock()
-						m.mutex.Lock()
-						close(client.Send)
-						delete(m.clients, client.ID)
-						m.mutex.Unlock()
-						m.mutex.RLock()
-						log.Printf("Client %s removed due to blocked channel", client.ID)
-					}
-				} else {
-					log.Printf("Client %s not found or offline", message.ReceiverID)
-				}
-				m.mutex.RUnlock()
+	for message := range m.broadcast {
+		if message.ReceiverID == "" {
+			continue
+		}
+		m.SendToUser(message.ReceiverID, message)
+	}
+}
+
+// Upgrade turns an authenticated request into a registered client. The Origin
+// header must match the allowed origins and the user and global connection
+// limits must have room; otherwise the HTTP error is written here and the
+// reason returned. The caller starts the client's ReadPump and WritePump.
+func (m *Manager) Upgrade(w http.ResponseWriter, r *http.Request, userID, role string) (*Client, error) {
+	// Refuse before the handshake so over-limit clients get a plain HTTP status
+	if err := m.admit(userID); err != nil {
+		http.Error(w, err.Error(), http.StatusTooManyRequests)
+		return nil, err
+	}
+
+	m.mutex.RLock()
+	options := m.options
+	m.mutex.RUnlock()
+	upgrader := websocket.Upgrader{
+		ReadBufferSize:  1024,
+		WriteBufferSize: 1024,
+		CheckOrigin: func(r *http.Request) bool {
+			if originAllowed(r.Header.Get("Origin"), options.AllowedOrigins) {
+				return true
 			}
+			metrics.Add("rejected_upgrades_origin", 1)
+			return false
+		},
+	}
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// The upgrader has already written the error response
+		return nil, err
+	}
+
+	client := &Client{
+		ID:      userID,
+		Role:    role,
+		Conn:    conn,
+		Send:    make(chan []byte, options.SendBuffer),
+		Manager: m,
+	}
+	if err := m.RegisterClient(client); err != nil {
+		// Another connection took the last slot during the handshake
+		closeWith(conn, websocket.CloseTryAgainLater, err.Error())
+		return nil, err
+	}
+	return client, nil
+}
+
+// originAllowed matches a browser's Origin against the allowed origins. Requests
+// without one come from non-browser clients, which CORS doesn't apply to.
+func originAllowed(origin string, allowed []string) bool {
+	if origin == "" {
+		return true
+	}
+	parsed, err := url.Parse(origin)
+	if err != nil || parsed.Host == "" {
+		return false
+	}
+	for _, candidate := range allowed {
+		if candidate == "*" || strings.EqualFold(strings.TrimSuffix(candidate, "/"), parsed.Scheme+"://"+parsed.Host) {
+			return true
 		}
 	}
+	return false
+}
+
+// admit checks the connection limits, counting a rejection against its reason
+func (m *Manager) admit(userID string) error {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	return m.checkLimits(userID)
+}
+
+// checkLimits must be called with the mutex held
+func (m *Manager) checkLimits(userID string) error {
+	if len(m.clients[userID]) >= m.options.MaxConnectionsPerUser {
+		metrics.Add("rejected_upgrades_user_limit", 1)
+		return ErrUserConnectionLimit
+	}
+	if m.connections >= m.options.MaxConnections {
+		metrics.Add("rejected_upgrades_global_limit", 1)
+		return ErrConnectionLimit
+	}
+	return nil
+}
+
+// RegisterClient registers a new client with the manager, unless a connection
+// limit is reached
+func (m *Manager) RegisterClient(client *Client) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if err := m.checkLimits(client.ID); err != nil {
+		return err
+	}
+	if m.clients[client.ID] == nil {
+		m.clients[client.ID] = make(map[*Client]bool)
+	}
+	m.clients[client.ID][client] = true
+	m.connections++
+	log.Printf("Client connected: %s (%s)", client.ID, client.Role)
+	return nil
+}
+
+// unregisterClient forgets a client and closes its Send channel; calling it
+// again for the same client does nothing
+func (m *Manager) unregisterClient(client *Client) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	connections := m.clients[client.ID]
+	if !connections[client] {
+		return
+	}
+	delete(connections, client)
+	if len(connections) == 0 {
+		delete(m.clients, client.ID)
+	}
+	m.connections--
+	close(client.Send)
+	log.Printf("Client disconnected: %s", client.ID)
 }
 
-// SendToUser sends a message to a specific user
+// SendToUser sends a message to every connection of a user, unless they muted
+// its conversation
 func (m *Manager) SendToUser(userID string, message *Message) {
+	if m.IsMuted(userID, message.ConversationID) {
+		return
+	}
+	jsonMessage, err := json.Marshal(message)
+	if err != nil {
+		log.Printf("Error marshaling message: %v", err)
+		return
+	}
+
 	m.mutex.RLock()
 	defer m.mutex.RUnlock()
-	
-	if client, ok := m.clients[userID]; ok {
-		jsonMessage, err := json.Marshal(message)
-		if err != nil {
-			log.Printf("Error marshaling message: %v", err)
-			return
-		}
-		
+
+	connections, ok := m.clients[userID]
+	if !ok {
+		log.Printf("Client %s not found or offline", userID)
+		return
+	}
+	for client := range connections {
 		select {
 		case client.Send <- jsonMessage:
-			log.Printf("Direct message sent to client %s", client.ID)
 		default:
 			log.Printf("Failed to send message to client %s, channel full", client.ID)
+			m.watchSlowClient(client)
 		}
-	} else {
-		log.Printf("Client %s not found or offline", userID)
 	}
 }
 
+// BroadcastToRole sends a message to every connection of users with role, or to
+// everyone when role is empty. Mutes don't apply, since the message belongs to no
+// conversation. It returns how many users had at least one connection reached.
+func (m *Manager) BroadcastToRole(role string, message *Message) int {
+	jsonMessage, err := json.Marshal(message)
+	if err != nil {
+		log.Printf("Error marshaling message: %v", err)
+		return 0
+	}
+
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	reached := 0
+	for _, connections := range m.clients {
+		delivered := false
+		for client := range connections {
+			if role != "" && client.Role != role {
+				continue
+			}
+			select {
+			case client.Send <- jsonMessage:
+				delivered = true
+			default:
+				m.watchSlowClient(client)
+			}
+		}
+		if delivered {
+			reached++
+		}
+	}
+	return reached
+}
+
+// watchSlowClient disconnects client if its Send channel is still full, with no
+// progress writing to the peer, once the slow-client timeout has passed. It must
+// be called with the mutex held.
+func (m *Manager) watchSlowClient(client *Client) {
+	client.slowMutex.Lock()
+	defer client.slowMutex.Unlock()
+	if client.slowTimer != nil {
+		return
+	}
+
+	written := client.written.Load()
+	timeout := m.options.SlowClientTimeout
+	client.slowTimer = time.AfterFunc(timeout, func() {
+		client.slowMutex.Lock()
+		client.slowTimer = nil
+		client.slowMutex.Unlock()
+
+		if client.written.Load() != written || len(client.Send) < cap(client.Send) {
+			return
+		}
+		metrics.Add("dropped_slow_clients", 1)
+		log.Printf("Disconnecting client %s: send queue full for %s", client.ID, timeout)
+		m.unregisterClient(client)
+		if client.Conn != nil {
+			closeWith(client.Conn, websocket.ClosePolicyViolation, "client too slow")
+		}
+	})
+}
+
+// closeWith sends a close frame with code and reason, then closes the connection.
+// It is safe to call while the pumps are running.
+func closeWith(conn *websocket.Conn, code int, reason string) {
+	conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, reason), time.Now().Add(writeWait))
+	conn.Close()
+}
+
+// SetMuted records whether userID muted a conversation. Messages in a muted
+// conversation are not pushed to that user; the chat service still stores them.
+func (m *Manager) SetMuted(userID, conversationID string, muted bool) {
+	m.mutedMutex.Lock()
+	defer m.mutedMutex.Unlock()
+
+	conversations := m.muted[userID]
+	if muted {
+		if conversations == nil {
+			conversations = make(map[string]bool)
+			m.muted[userID] = conversations
+		}
+		conversations[conversationID] = true
+		return
+	}
+	delete(conversations, conversationID)
+	if len(conversations) == 0 {
+		delete(m.muted, userID)
+	}
+}
+
+// SetBlockFilter installs the check that drops frames between users who blocked
+// each other. Call it before clients connect. It runs on the sender's read loop,
+// so it may block on a backend lookup without stalling other clients.
+func (m *Manager) SetBlockFilter(blocked func(senderID, receiverID string) bool) {
+	m.blocked = blocked
+}
+
+// SetSendLimiter installs the limiter message frames are counted against. Pass
+// the one the REST send endpoint uses, so senders can't dodge it by switching.
+// Call it before clients connect.
+func (m *Manager) SetSendLimiter(limiter *chatlimit.Limiter) {
+	m.sendLimiter = limiter
+}
+
+// isBlocked reports whether a frame from senderID to receiverID must be dropped
+func (m *Manager) isBlocked(senderID, receiverID string) bool {
+	return m.blocked != nil && receiverID != "" && m.blocked(senderID, receiverID)
+}
+
+// IsMuted reports whether userID muted the conversation
+func (m *Manager) IsMuted(userID, conversationID string) bool {
+	if conversationID == "" {
+		return false
+	}
+	m.mutedMutex.RLock()
+	defer m.mutedMutex.RUnlock()
+	return m.muted[userID][conversationID]
+}
+
 // GetConnectedUsers returns a list of connected user IDs
 func (m *Manager) GetConnectedUsers() []string {
 	m.mutex.RLock()
 	defer m.mutex.RUnlock()
-	
+
 	users := make([]string, 0, len(m.clients))
 	for id := range m.clients {
 		users = append(users, id)
 	}
-	
+
 	return users
 }
 
@@ -158,12 +438,7 @@ func (m *Manager) GetConnectedUsers() []string {
 func (m *Manager) IsUserConnected(userID string) bool {
 	m.mutex.RLock()
 	defer m.mutex.RUnlock()
-	
+
 	_, ok := m.clients[userID]
 	return ok
 }
-
-// RegisterClient registers a new client with the manager
-func (m *Manager) RegisterClient(client *Client) {
-	m.register <- client
-}
@@ -0,0 +1,136 @@
+package websocket
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func testManager(options Options) *Manager {
+	return &Manager{
+		clients:  make(map[string]map[*Client]bool),
+		options:  options,
+		muted:    make(map[string]map[string]bool),
+		handlers: make(map[string]EventHandler),
+	}
+}
+
+func TestOriginAllowed(t *testing.T) {
+	allowed := []string{"https://app.skillsync.dev", "http://localhost:3000/"}
+	tests := []struct {
+		name    string
+		origin  string
+		allowed []string
+		want    bool
+	}{
+		{"no origin", "", allowed, true},
+		{"listed", "https://app.skillsync.dev", allowed, true},
+		{"case insensitive", "HTTPS://APP.SKILLSYNC.DEV", allowed, true},
+		{"trailing slash in config", "http://localhost:3000", allowed, true},
+		{"other scheme", "http://app.skillsync.dev", allowed, false},
+		{"other port", "http://localhost:3001", allowed, false},
+		{"lookalike", "https://app.skillsync.dev.evil.example", allowed, false},
+		{"not a url", "null", allowed, false},
+		{"wildcard", "https://anything.example", []string{"*"}, true},
+		{"nothing allowed", "https://app.skillsync.dev", nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := originAllowed(tt.origin, tt.allowed); got != tt.want {
+				t.Errorf("originAllowed(%q) = %v, want %v", tt.origin, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRegisterClientLimits(t *testing.T) {
+	tests := []struct {
+		name    string
+		users   []string
+		wantErr []error
+	}{
+		{"under the limits", []string{"a", "b", "a"}, []error{nil, nil, nil}},
+		{"per user", []string{"a", "a", "a"}, []error{nil, nil, ErrUserConnectionLimit}},
+		{"global", []string{"a", "b", "c", "d"}, []error{nil, nil, nil, ErrConnectionLimit}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := testManager(Options{MaxConnectionsPerUser: 2, MaxConnections: 3, SendBuffer: 1})
+			for i, user := range tt.users {
+				err := m.RegisterClient(&Client{ID: user, Send: make(chan []byte, 1), Manager: m})
+				if !errors.Is(err, tt.wantErr[i]) {
+					t.Fatalf("connection %d for %s: error = %v, want %v", i, user, err, tt.wantErr[i])
+				}
+			}
+		})
+	}
+}
+
+func TestUpgradeRefusals(t *testing.T) {
+	tests := []struct {
+		name       string
+		origin     string
+		open       int
+		wantStatus int
+	}{
+		{"bad origin", "https://evil.example", 0, http.StatusForbidden},
+		{"user limit", "", 1, http.StatusTooManyRequests},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := testManager(Options{MaxConnectionsPerUser: 1, MaxConnections: 10, SendBuffer: 1, AllowedOrigins: []string{"https://app.skillsync.dev"}})
+			for i := 0; i < tt.open; i++ {
+				m.RegisterClient(&Client{ID: "u1", Send: make(chan []byte, 1)})
+			}
+			req := httptest.NewRequest(http.MethodGet, "/ws", nil)
+			req.Header.Set("Connection", "Upgrade")
+			req.Header.Set("Upgrade", "websocket")
+			req.Header.Set("Sec-WebSocket-Version", "13")
+			req.Header.Set("Sec-WebSocket-Key", "dGhlIHNhbXBsZSBub25jZQ==")
+			if tt.origin != "" {
+				req.Header.Set("Origin", tt.origin)
+			}
+			w := httptest.NewRecorder()
+			if _, err := m.Upgrade(w, req, "u1", "candidate"); err == nil {
+				t.Fatal("Upgrade() succeeded")
+			}
+			if w.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", w.Code, tt.wantStatus)
+			}
+		})
+	}
+}
+
+func TestSlowClientDropped(t *testing.T) {
+	m := testManager(Options{MaxConnectionsPerUser: 2, MaxConnections: 10, SendBuffer: 1, SlowClientTimeout: 20 * time.Millisecond})
+	slow := &Client{ID: "u1", Send: make(chan []byte, 1), Manager: m}
+	fine := &Client{ID: "u1", Send: make(chan []byte, 1), Manager: m}
+	m.RegisterClient(slow)
+	m.RegisterClient(fine)
+
+	m.SendToUser("u1", &Message{Type: "message", Content: "one"})
+	<-fine.Send
+	// slow never reads, so its queue stays full past the timeout
+	m.SendToUser("u1", &Message{Type: "message", Content: "two"})
+	time.Sleep(100 * time.Millisecond)
+
+	if _, open := <-slow.Send; !open {
+		t.Fatal("slow client's queue was closed before draining the queued message")
+	}
+	if _, open := <-slow.Send; open {
+		t.Error("slow client still registered after the timeout")
+	}
+	select {
+	case _, open := <-fine.Send:
+		if !open {
+			t.Error("client that kept up was dropped")
+		}
+	default:
+		t.Error("client that kept up didn't get the second message")
+	}
+	if !m.IsUserConnected("u1") {
+		t.Error("user disconnected along with the slow client")
+	}
+}